@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/zibbp/ganymede/internal/admin"
 	"github.com/zibbp/ganymede/internal/archive"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/channel"
 	"github.com/zibbp/ganymede/internal/chapter"
@@ -21,8 +22,11 @@ import (
 	_ "github.com/zibbp/ganymede/internal/kv"
 	"github.com/zibbp/ganymede/internal/live"
 	"github.com/zibbp/ganymede/internal/metrics"
+	"github.com/zibbp/ganymede/internal/notification"
+	"github.com/zibbp/ganymede/internal/permission"
 	"github.com/zibbp/ganymede/internal/playback"
 	"github.com/zibbp/ganymede/internal/playlist"
+	"github.com/zibbp/ganymede/internal/profile"
 	"github.com/zibbp/ganymede/internal/queue"
 	"github.com/zibbp/ganymede/internal/scheduler"
 	"github.com/zibbp/ganymede/internal/task"
@@ -60,6 +64,11 @@ var (
 //	@in									cookie
 //	@name								refresh-token
 
+//	@securityDefinitions.apikey	ApiTokenAuth
+//	@in							header
+//	@name						Authorization
+//	@description				API token created at /auth/tokens, sent as "Bearer <token>"
+
 func Run() error {
 
 	config.NewConfig(true)
@@ -95,8 +104,12 @@ func Run() error {
 	playlistService := playlist.NewService(store)
 	taskService := task.NewService(store, liveService, archiveService)
 	chapterService := chapter.NewService()
+	archiveProfileService := profile.NewService(store)
+	permissionService := permission.NewService(store)
+	auditService := audit.NewService(store)
+	notificationService := notification.NewService(store)
 
-	httpHandler := transportHttp.NewHandler(authService, channelService, vodService, queueService, twitchService, archiveService, adminService, userService, configService, liveService, schedulerService, playbackService, metricsService, playlistService, taskService, chapterService)
+	httpHandler := transportHttp.NewHandler(authService, channelService, vodService, queueService, twitchService, archiveService, adminService, userService, configService, liveService, schedulerService, playbackService, metricsService, playlistService, taskService, chapterService, archiveProfileService, notificationService, permissionService, auditService)
 
 	if err := httpHandler.Serve(); err != nil {
 		return err
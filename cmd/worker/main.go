@@ -22,6 +22,7 @@ type Config struct {
 	MAX_CHAT_RENDER_EXECUTIONS    int    `default:"3"`
 	MAX_VIDEO_DOWNLOAD_EXECUTIONS int    `default:"5"`
 	MAX_VIDEO_CONVERT_EXECUTIONS  int    `default:"3"`
+	MAX_WAITING_EXECUTIONS        int    `default:"500"`
 	TEMPORAL_URL                  string `default:"temporal:7233"`
 }
 
@@ -141,6 +142,14 @@ func main() {
 		"video-convert":  config.MAX_VIDEO_CONVERT_EXECUTIONS,
 	}
 
+	// activities of these queues are dispatched by queue item priority
+	// the worker accepts up to MAX_WAITING_EXECUTIONS activities and runs the configured number with the highest priority
+	prioritizedQueues := []string{"video-download", "video-convert", "chat-render"}
+	for _, queueName := range prioritizedQueues {
+		activities.RegisterDispatcher(queueName, taskQueues[queueName])
+		taskQueues[queueName] = config.MAX_WAITING_EXECUTIONS
+	}
+
 	// create worker interrupt channel
 	interrupt := make(chan os.Signal, 1)
 
@@ -181,10 +190,19 @@ func main() {
 		w.RegisterWorkflow(workflows.ConvertTwitchLiveChatWorkflow)
 		w.RegisterWorkflow(workflows.SaveTwitchVideoChapters)
 		w.RegisterWorkflow(workflows.UpdateTwitchLiveStreamArchivesWithVodIds)
+		w.RegisterWorkflow(workflows.DeleteReplacedLiveArchiveWorkflow)
+		w.RegisterWorkflow(workflows.ArchiveYoutubeVideoWorkflow)
+		w.RegisterWorkflow(workflows.DownloadYoutubeThumbnailsWorkflow)
+		w.RegisterWorkflow(workflows.SaveYoutubeVideoInfoWorkflow)
+		w.RegisterWorkflow(workflows.DownloadYoutubeVideoWorkflow)
+		w.RegisterWorkflow(workflows.ArchiveClipWorkflow)
+		w.RegisterWorkflow(workflows.DownloadTwitchClipThumbnailsWorkflow)
+		w.RegisterWorkflow(workflows.SaveTwitchClipInfoWorkflow)
 
 		w.RegisterActivity(activities.ArchiveVideoActivity)
 		w.RegisterActivity(activities.SaveTwitchVideoInfo)
 		w.RegisterActivity(activities.CreateDirectory)
+		w.RegisterActivity(activities.CancelQueueItem)
 		w.RegisterActivity(activities.DownloadTwitchThumbnails)
 		w.RegisterActivity(activities.DownloadTwitchVideo)
 		w.RegisterActivity(activities.PostprocessVideo)
@@ -196,10 +214,15 @@ func main() {
 		w.RegisterActivity(activities.DownloadTwitchLiveThumbnails)
 		w.RegisterActivity(activities.DownloadTwitchLiveVideo)
 		w.RegisterActivity(activities.SaveTwitchLiveVideoInfo)
-		w.RegisterActivity(activities.KillTwitchLiveChatDownload)
 		w.RegisterActivity(activities.ConvertTwitchLiveChat)
 		w.RegisterActivity(activities.TwitchSaveVideoChapters)
 		w.RegisterActivity(activities.UpdateTwitchLiveStreamArchivesWithVodIds)
+		w.RegisterActivity(activities.DeleteReplacedLiveArchive)
+		w.RegisterActivity(activities.SaveYoutubeVideoInfo)
+		w.RegisterActivity(activities.DownloadYoutubeThumbnails)
+		w.RegisterActivity(activities.DownloadYoutubeVideo)
+		w.RegisterActivity(activities.SaveTwitchClipInfo)
+		w.RegisterActivity(activities.DownloadTwitchClipThumbnails)
 
 		err = w.Start()
 		if err != nil {
@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ArchiveProfile is the model entity for the ArchiveProfile schema.
+type ArchiveProfile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// The quality to archive in. Empty uses the quality of the request or watched channel.
+	Quality string `json:"quality,omitempty"`
+	// FFmpeg arguments for the video convert. Empty uses the global config.
+	VideoConvert string `json:"video_convert,omitempty"`
+	// Whether the video is converted to HLS. Nil uses the global config.
+	SaveAsHls *bool `json:"save_as_hls,omitempty"`
+	// Whether the chat should be rendered.
+	RenderChat bool `json:"render_chat,omitempty"`
+	// TwitchDownloaderCLI arguments for the chat render. Empty uses the global config.
+	ChatRender string `json:"chat_render,omitempty"`
+	// Streamlink arguments for live streams. Empty uses the global config.
+	StreamlinkLive string `json:"streamlink_live,omitempty"`
+	// Storage template for the folder name. Empty uses the global config.
+	FolderTemplate string `json:"folder_template,omitempty"`
+	// Storage template for the file name. Empty uses the global config.
+	FileTemplate string `json:"file_template,omitempty"`
+	// Whether live streams are downloaded through the configured proxies, takes an enum. Default uses the global config.
+	ProxyPolicy utils.ProxyPolicy `json:"proxy_policy,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ArchiveProfileQuery when eager-loading is set.
+	Edges        ArchiveProfileEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ArchiveProfileEdges holds the relations/edges for other nodes in the graph.
+type ArchiveProfileEdges struct {
+	// Channels holds the value of the channels edge.
+	Channels []*Channel `json:"channels,omitempty"`
+	// Live holds the value of the live edge.
+	Live []*Live `json:"live,omitempty"`
+	// Vods holds the value of the vods edge.
+	Vods []*Vod `json:"vods,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ChannelsOrErr returns the Channels value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArchiveProfileEdges) ChannelsOrErr() ([]*Channel, error) {
+	if e.loadedTypes[0] {
+		return e.Channels, nil
+	}
+	return nil, &NotLoadedError{edge: "channels"}
+}
+
+// LiveOrErr returns the Live value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArchiveProfileEdges) LiveOrErr() ([]*Live, error) {
+	if e.loadedTypes[1] {
+		return e.Live, nil
+	}
+	return nil, &NotLoadedError{edge: "live"}
+}
+
+// VodsOrErr returns the Vods value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArchiveProfileEdges) VodsOrErr() ([]*Vod, error) {
+	if e.loadedTypes[2] {
+		return e.Vods, nil
+	}
+	return nil, &NotLoadedError{edge: "vods"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArchiveProfile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case archiveprofile.FieldSaveAsHls, archiveprofile.FieldRenderChat:
+			values[i] = new(sql.NullBool)
+		case archiveprofile.FieldName, archiveprofile.FieldQuality, archiveprofile.FieldVideoConvert, archiveprofile.FieldChatRender, archiveprofile.FieldStreamlinkLive, archiveprofile.FieldFolderTemplate, archiveprofile.FieldFileTemplate, archiveprofile.FieldProxyPolicy:
+			values[i] = new(sql.NullString)
+		case archiveprofile.FieldUpdatedAt, archiveprofile.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case archiveprofile.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArchiveProfile fields.
+func (ap *ArchiveProfile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case archiveprofile.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ap.ID = *value
+			}
+		case archiveprofile.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ap.Name = value.String
+			}
+		case archiveprofile.FieldQuality:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quality", values[i])
+			} else if value.Valid {
+				ap.Quality = value.String
+			}
+		case archiveprofile.FieldVideoConvert:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field video_convert", values[i])
+			} else if value.Valid {
+				ap.VideoConvert = value.String
+			}
+		case archiveprofile.FieldSaveAsHls:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field save_as_hls", values[i])
+			} else if value.Valid {
+				ap.SaveAsHls = new(bool)
+				*ap.SaveAsHls = value.Bool
+			}
+		case archiveprofile.FieldRenderChat:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field render_chat", values[i])
+			} else if value.Valid {
+				ap.RenderChat = value.Bool
+			}
+		case archiveprofile.FieldChatRender:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_render", values[i])
+			} else if value.Valid {
+				ap.ChatRender = value.String
+			}
+		case archiveprofile.FieldStreamlinkLive:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field streamlink_live", values[i])
+			} else if value.Valid {
+				ap.StreamlinkLive = value.String
+			}
+		case archiveprofile.FieldFolderTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field folder_template", values[i])
+			} else if value.Valid {
+				ap.FolderTemplate = value.String
+			}
+		case archiveprofile.FieldFileTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_template", values[i])
+			} else if value.Valid {
+				ap.FileTemplate = value.String
+			}
+		case archiveprofile.FieldProxyPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field proxy_policy", values[i])
+			} else if value.Valid {
+				ap.ProxyPolicy = utils.ProxyPolicy(value.String)
+			}
+		case archiveprofile.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ap.UpdatedAt = value.Time
+			}
+		case archiveprofile.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ap.CreatedAt = value.Time
+			}
+		default:
+			ap.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArchiveProfile.
+// This includes values selected through modifiers, order, etc.
+func (ap *ArchiveProfile) Value(name string) (ent.Value, error) {
+	return ap.selectValues.Get(name)
+}
+
+// QueryChannels queries the "channels" edge of the ArchiveProfile entity.
+func (ap *ArchiveProfile) QueryChannels() *ChannelQuery {
+	return NewArchiveProfileClient(ap.config).QueryChannels(ap)
+}
+
+// QueryLive queries the "live" edge of the ArchiveProfile entity.
+func (ap *ArchiveProfile) QueryLive() *LiveQuery {
+	return NewArchiveProfileClient(ap.config).QueryLive(ap)
+}
+
+// QueryVods queries the "vods" edge of the ArchiveProfile entity.
+func (ap *ArchiveProfile) QueryVods() *VodQuery {
+	return NewArchiveProfileClient(ap.config).QueryVods(ap)
+}
+
+// Update returns a builder for updating this ArchiveProfile.
+// Note that you need to call ArchiveProfile.Unwrap() before calling this method if this ArchiveProfile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ap *ArchiveProfile) Update() *ArchiveProfileUpdateOne {
+	return NewArchiveProfileClient(ap.config).UpdateOne(ap)
+}
+
+// Unwrap unwraps the ArchiveProfile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ap *ArchiveProfile) Unwrap() *ArchiveProfile {
+	_tx, ok := ap.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArchiveProfile is not a transactional entity")
+	}
+	ap.config.driver = _tx.drv
+	return ap
+}
+
+// String implements the fmt.Stringer.
+func (ap *ArchiveProfile) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArchiveProfile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ap.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ap.Name)
+	builder.WriteString(", ")
+	builder.WriteString("quality=")
+	builder.WriteString(ap.Quality)
+	builder.WriteString(", ")
+	builder.WriteString("video_convert=")
+	builder.WriteString(ap.VideoConvert)
+	builder.WriteString(", ")
+	if v := ap.SaveAsHls; v != nil {
+		builder.WriteString("save_as_hls=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("render_chat=")
+	builder.WriteString(fmt.Sprintf("%v", ap.RenderChat))
+	builder.WriteString(", ")
+	builder.WriteString("chat_render=")
+	builder.WriteString(ap.ChatRender)
+	builder.WriteString(", ")
+	builder.WriteString("streamlink_live=")
+	builder.WriteString(ap.StreamlinkLive)
+	builder.WriteString(", ")
+	builder.WriteString("folder_template=")
+	builder.WriteString(ap.FolderTemplate)
+	builder.WriteString(", ")
+	builder.WriteString("file_template=")
+	builder.WriteString(ap.FileTemplate)
+	builder.WriteString(", ")
+	builder.WriteString("proxy_policy=")
+	builder.WriteString(fmt.Sprintf("%v", ap.ProxyPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ap.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ap.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArchiveProfiles is a parsable slice of ArchiveProfile.
+type ArchiveProfiles []*ArchiveProfile
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archiveprofile
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+const (
+	// Label holds the string label denoting the archiveprofile type in the database.
+	Label = "archive_profile"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldQuality holds the string denoting the quality field in the database.
+	FieldQuality = "quality"
+	// FieldVideoConvert holds the string denoting the video_convert field in the database.
+	FieldVideoConvert = "video_convert"
+	// FieldSaveAsHls holds the string denoting the save_as_hls field in the database.
+	FieldSaveAsHls = "save_as_hls"
+	// FieldRenderChat holds the string denoting the render_chat field in the database.
+	FieldRenderChat = "render_chat"
+	// FieldChatRender holds the string denoting the chat_render field in the database.
+	FieldChatRender = "chat_render"
+	// FieldStreamlinkLive holds the string denoting the streamlink_live field in the database.
+	FieldStreamlinkLive = "streamlink_live"
+	// FieldFolderTemplate holds the string denoting the folder_template field in the database.
+	FieldFolderTemplate = "folder_template"
+	// FieldFileTemplate holds the string denoting the file_template field in the database.
+	FieldFileTemplate = "file_template"
+	// FieldProxyPolicy holds the string denoting the proxy_policy field in the database.
+	FieldProxyPolicy = "proxy_policy"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeChannels holds the string denoting the channels edge name in mutations.
+	EdgeChannels = "channels"
+	// EdgeLive holds the string denoting the live edge name in mutations.
+	EdgeLive = "live"
+	// EdgeVods holds the string denoting the vods edge name in mutations.
+	EdgeVods = "vods"
+	// Table holds the table name of the archiveprofile in the database.
+	Table = "archive_profiles"
+	// ChannelsTable is the table that holds the channels relation/edge.
+	ChannelsTable = "channels"
+	// ChannelsInverseTable is the table name for the Channel entity.
+	// It exists in this package in order to avoid circular dependency with the "channel" package.
+	ChannelsInverseTable = "channels"
+	// ChannelsColumn is the table column denoting the channels relation/edge.
+	ChannelsColumn = "archive_profile_channels"
+	// LiveTable is the table that holds the live relation/edge.
+	LiveTable = "lives"
+	// LiveInverseTable is the table name for the Live entity.
+	// It exists in this package in order to avoid circular dependency with the "live" package.
+	LiveInverseTable = "lives"
+	// LiveColumn is the table column denoting the live relation/edge.
+	LiveColumn = "archive_profile_live"
+	// VodsTable is the table that holds the vods relation/edge.
+	VodsTable = "vods"
+	// VodsInverseTable is the table name for the Vod entity.
+	// It exists in this package in order to avoid circular dependency with the "vod" package.
+	VodsInverseTable = "vods"
+	// VodsColumn is the table column denoting the vods relation/edge.
+	VodsColumn = "archive_profile_vods"
+)
+
+// Columns holds all SQL columns for archiveprofile fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldQuality,
+	FieldVideoConvert,
+	FieldSaveAsHls,
+	FieldRenderChat,
+	FieldChatRender,
+	FieldStreamlinkLive,
+	FieldFolderTemplate,
+	FieldFileTemplate,
+	FieldProxyPolicy,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultRenderChat holds the default value on creation for the "render_chat" field.
+	DefaultRenderChat bool
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+const DefaultProxyPolicy utils.ProxyPolicy = "default"
+
+// ProxyPolicyValidator is a validator for the "proxy_policy" field enum values. It is called by the builders before save.
+func ProxyPolicyValidator(pp utils.ProxyPolicy) error {
+	switch pp {
+	case "default", "enabled", "disabled":
+		return nil
+	default:
+		return fmt.Errorf("archiveprofile: invalid enum value for proxy_policy field: %q", pp)
+	}
+}
+
+// OrderOption defines the ordering options for the ArchiveProfile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByQuality orders the results by the quality field.
+func ByQuality(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuality, opts...).ToFunc()
+}
+
+// ByVideoConvert orders the results by the video_convert field.
+func ByVideoConvert(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoConvert, opts...).ToFunc()
+}
+
+// BySaveAsHls orders the results by the save_as_hls field.
+func BySaveAsHls(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSaveAsHls, opts...).ToFunc()
+}
+
+// ByRenderChat orders the results by the render_chat field.
+func ByRenderChat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenderChat, opts...).ToFunc()
+}
+
+// ByChatRender orders the results by the chat_render field.
+func ByChatRender(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatRender, opts...).ToFunc()
+}
+
+// ByStreamlinkLive orders the results by the streamlink_live field.
+func ByStreamlinkLive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStreamlinkLive, opts...).ToFunc()
+}
+
+// ByFolderTemplate orders the results by the folder_template field.
+func ByFolderTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFolderTemplate, opts...).ToFunc()
+}
+
+// ByFileTemplate orders the results by the file_template field.
+func ByFileTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileTemplate, opts...).ToFunc()
+}
+
+// ByProxyPolicy orders the results by the proxy_policy field.
+func ByProxyPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProxyPolicy, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByChannelsCount orders the results by channels count.
+func ByChannelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChannelsStep(), opts...)
+	}
+}
+
+// ByChannels orders the results by channels terms.
+func ByChannels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChannelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLiveCount orders the results by live count.
+func ByLiveCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLiveStep(), opts...)
+	}
+}
+
+// ByLive orders the results by live terms.
+func ByLive(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLiveStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVodsCount orders the results by vods count.
+func ByVodsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVodsStep(), opts...)
+	}
+}
+
+// ByVods orders the results by vods terms.
+func ByVods(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVodsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newChannelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChannelsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChannelsTable, ChannelsColumn),
+	)
+}
+func newLiveStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LiveInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LiveTable, LiveColumn),
+	)
+}
+func newVodsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VodsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VodsTable, VodsColumn),
+	)
+}
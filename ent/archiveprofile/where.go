@@ -0,0 +1,852 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archiveprofile
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldName, v))
+}
+
+// Quality applies equality check predicate on the "quality" field. It's identical to QualityEQ.
+func Quality(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldQuality, v))
+}
+
+// VideoConvert applies equality check predicate on the "video_convert" field. It's identical to VideoConvertEQ.
+func VideoConvert(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldVideoConvert, v))
+}
+
+// SaveAsHls applies equality check predicate on the "save_as_hls" field. It's identical to SaveAsHlsEQ.
+func SaveAsHls(v bool) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldSaveAsHls, v))
+}
+
+// RenderChat applies equality check predicate on the "render_chat" field. It's identical to RenderChatEQ.
+func RenderChat(v bool) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldRenderChat, v))
+}
+
+// ChatRender applies equality check predicate on the "chat_render" field. It's identical to ChatRenderEQ.
+func ChatRender(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldChatRender, v))
+}
+
+// StreamlinkLive applies equality check predicate on the "streamlink_live" field. It's identical to StreamlinkLiveEQ.
+func StreamlinkLive(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldStreamlinkLive, v))
+}
+
+// FolderTemplate applies equality check predicate on the "folder_template" field. It's identical to FolderTemplateEQ.
+func FolderTemplate(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldFolderTemplate, v))
+}
+
+// FileTemplate applies equality check predicate on the "file_template" field. It's identical to FileTemplateEQ.
+func FileTemplate(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldFileTemplate, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContainsFold(FieldName, v))
+}
+
+// QualityEQ applies the EQ predicate on the "quality" field.
+func QualityEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldQuality, v))
+}
+
+// QualityNEQ applies the NEQ predicate on the "quality" field.
+func QualityNEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldQuality, v))
+}
+
+// QualityIn applies the In predicate on the "quality" field.
+func QualityIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIn(FieldQuality, vs...))
+}
+
+// QualityNotIn applies the NotIn predicate on the "quality" field.
+func QualityNotIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldQuality, vs...))
+}
+
+// QualityGT applies the GT predicate on the "quality" field.
+func QualityGT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGT(FieldQuality, v))
+}
+
+// QualityGTE applies the GTE predicate on the "quality" field.
+func QualityGTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGTE(FieldQuality, v))
+}
+
+// QualityLT applies the LT predicate on the "quality" field.
+func QualityLT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLT(FieldQuality, v))
+}
+
+// QualityLTE applies the LTE predicate on the "quality" field.
+func QualityLTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLTE(FieldQuality, v))
+}
+
+// QualityContains applies the Contains predicate on the "quality" field.
+func QualityContains(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContains(FieldQuality, v))
+}
+
+// QualityHasPrefix applies the HasPrefix predicate on the "quality" field.
+func QualityHasPrefix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasPrefix(FieldQuality, v))
+}
+
+// QualityHasSuffix applies the HasSuffix predicate on the "quality" field.
+func QualityHasSuffix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasSuffix(FieldQuality, v))
+}
+
+// QualityIsNil applies the IsNil predicate on the "quality" field.
+func QualityIsNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIsNull(FieldQuality))
+}
+
+// QualityNotNil applies the NotNil predicate on the "quality" field.
+func QualityNotNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotNull(FieldQuality))
+}
+
+// QualityEqualFold applies the EqualFold predicate on the "quality" field.
+func QualityEqualFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEqualFold(FieldQuality, v))
+}
+
+// QualityContainsFold applies the ContainsFold predicate on the "quality" field.
+func QualityContainsFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContainsFold(FieldQuality, v))
+}
+
+// VideoConvertEQ applies the EQ predicate on the "video_convert" field.
+func VideoConvertEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldVideoConvert, v))
+}
+
+// VideoConvertNEQ applies the NEQ predicate on the "video_convert" field.
+func VideoConvertNEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldVideoConvert, v))
+}
+
+// VideoConvertIn applies the In predicate on the "video_convert" field.
+func VideoConvertIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIn(FieldVideoConvert, vs...))
+}
+
+// VideoConvertNotIn applies the NotIn predicate on the "video_convert" field.
+func VideoConvertNotIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldVideoConvert, vs...))
+}
+
+// VideoConvertGT applies the GT predicate on the "video_convert" field.
+func VideoConvertGT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGT(FieldVideoConvert, v))
+}
+
+// VideoConvertGTE applies the GTE predicate on the "video_convert" field.
+func VideoConvertGTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGTE(FieldVideoConvert, v))
+}
+
+// VideoConvertLT applies the LT predicate on the "video_convert" field.
+func VideoConvertLT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLT(FieldVideoConvert, v))
+}
+
+// VideoConvertLTE applies the LTE predicate on the "video_convert" field.
+func VideoConvertLTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLTE(FieldVideoConvert, v))
+}
+
+// VideoConvertContains applies the Contains predicate on the "video_convert" field.
+func VideoConvertContains(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContains(FieldVideoConvert, v))
+}
+
+// VideoConvertHasPrefix applies the HasPrefix predicate on the "video_convert" field.
+func VideoConvertHasPrefix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasPrefix(FieldVideoConvert, v))
+}
+
+// VideoConvertHasSuffix applies the HasSuffix predicate on the "video_convert" field.
+func VideoConvertHasSuffix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasSuffix(FieldVideoConvert, v))
+}
+
+// VideoConvertIsNil applies the IsNil predicate on the "video_convert" field.
+func VideoConvertIsNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIsNull(FieldVideoConvert))
+}
+
+// VideoConvertNotNil applies the NotNil predicate on the "video_convert" field.
+func VideoConvertNotNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotNull(FieldVideoConvert))
+}
+
+// VideoConvertEqualFold applies the EqualFold predicate on the "video_convert" field.
+func VideoConvertEqualFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEqualFold(FieldVideoConvert, v))
+}
+
+// VideoConvertContainsFold applies the ContainsFold predicate on the "video_convert" field.
+func VideoConvertContainsFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContainsFold(FieldVideoConvert, v))
+}
+
+// SaveAsHlsEQ applies the EQ predicate on the "save_as_hls" field.
+func SaveAsHlsEQ(v bool) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldSaveAsHls, v))
+}
+
+// SaveAsHlsNEQ applies the NEQ predicate on the "save_as_hls" field.
+func SaveAsHlsNEQ(v bool) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldSaveAsHls, v))
+}
+
+// SaveAsHlsIsNil applies the IsNil predicate on the "save_as_hls" field.
+func SaveAsHlsIsNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIsNull(FieldSaveAsHls))
+}
+
+// SaveAsHlsNotNil applies the NotNil predicate on the "save_as_hls" field.
+func SaveAsHlsNotNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotNull(FieldSaveAsHls))
+}
+
+// RenderChatEQ applies the EQ predicate on the "render_chat" field.
+func RenderChatEQ(v bool) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldRenderChat, v))
+}
+
+// RenderChatNEQ applies the NEQ predicate on the "render_chat" field.
+func RenderChatNEQ(v bool) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldRenderChat, v))
+}
+
+// ChatRenderEQ applies the EQ predicate on the "chat_render" field.
+func ChatRenderEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldChatRender, v))
+}
+
+// ChatRenderNEQ applies the NEQ predicate on the "chat_render" field.
+func ChatRenderNEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldChatRender, v))
+}
+
+// ChatRenderIn applies the In predicate on the "chat_render" field.
+func ChatRenderIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIn(FieldChatRender, vs...))
+}
+
+// ChatRenderNotIn applies the NotIn predicate on the "chat_render" field.
+func ChatRenderNotIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldChatRender, vs...))
+}
+
+// ChatRenderGT applies the GT predicate on the "chat_render" field.
+func ChatRenderGT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGT(FieldChatRender, v))
+}
+
+// ChatRenderGTE applies the GTE predicate on the "chat_render" field.
+func ChatRenderGTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGTE(FieldChatRender, v))
+}
+
+// ChatRenderLT applies the LT predicate on the "chat_render" field.
+func ChatRenderLT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLT(FieldChatRender, v))
+}
+
+// ChatRenderLTE applies the LTE predicate on the "chat_render" field.
+func ChatRenderLTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLTE(FieldChatRender, v))
+}
+
+// ChatRenderContains applies the Contains predicate on the "chat_render" field.
+func ChatRenderContains(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContains(FieldChatRender, v))
+}
+
+// ChatRenderHasPrefix applies the HasPrefix predicate on the "chat_render" field.
+func ChatRenderHasPrefix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasPrefix(FieldChatRender, v))
+}
+
+// ChatRenderHasSuffix applies the HasSuffix predicate on the "chat_render" field.
+func ChatRenderHasSuffix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasSuffix(FieldChatRender, v))
+}
+
+// ChatRenderIsNil applies the IsNil predicate on the "chat_render" field.
+func ChatRenderIsNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIsNull(FieldChatRender))
+}
+
+// ChatRenderNotNil applies the NotNil predicate on the "chat_render" field.
+func ChatRenderNotNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotNull(FieldChatRender))
+}
+
+// ChatRenderEqualFold applies the EqualFold predicate on the "chat_render" field.
+func ChatRenderEqualFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEqualFold(FieldChatRender, v))
+}
+
+// ChatRenderContainsFold applies the ContainsFold predicate on the "chat_render" field.
+func ChatRenderContainsFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContainsFold(FieldChatRender, v))
+}
+
+// StreamlinkLiveEQ applies the EQ predicate on the "streamlink_live" field.
+func StreamlinkLiveEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldStreamlinkLive, v))
+}
+
+// StreamlinkLiveNEQ applies the NEQ predicate on the "streamlink_live" field.
+func StreamlinkLiveNEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldStreamlinkLive, v))
+}
+
+// StreamlinkLiveIn applies the In predicate on the "streamlink_live" field.
+func StreamlinkLiveIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIn(FieldStreamlinkLive, vs...))
+}
+
+// StreamlinkLiveNotIn applies the NotIn predicate on the "streamlink_live" field.
+func StreamlinkLiveNotIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldStreamlinkLive, vs...))
+}
+
+// StreamlinkLiveGT applies the GT predicate on the "streamlink_live" field.
+func StreamlinkLiveGT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGT(FieldStreamlinkLive, v))
+}
+
+// StreamlinkLiveGTE applies the GTE predicate on the "streamlink_live" field.
+func StreamlinkLiveGTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGTE(FieldStreamlinkLive, v))
+}
+
+// StreamlinkLiveLT applies the LT predicate on the "streamlink_live" field.
+func StreamlinkLiveLT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLT(FieldStreamlinkLive, v))
+}
+
+// StreamlinkLiveLTE applies the LTE predicate on the "streamlink_live" field.
+func StreamlinkLiveLTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLTE(FieldStreamlinkLive, v))
+}
+
+// StreamlinkLiveContains applies the Contains predicate on the "streamlink_live" field.
+func StreamlinkLiveContains(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContains(FieldStreamlinkLive, v))
+}
+
+// StreamlinkLiveHasPrefix applies the HasPrefix predicate on the "streamlink_live" field.
+func StreamlinkLiveHasPrefix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasPrefix(FieldStreamlinkLive, v))
+}
+
+// StreamlinkLiveHasSuffix applies the HasSuffix predicate on the "streamlink_live" field.
+func StreamlinkLiveHasSuffix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasSuffix(FieldStreamlinkLive, v))
+}
+
+// StreamlinkLiveIsNil applies the IsNil predicate on the "streamlink_live" field.
+func StreamlinkLiveIsNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIsNull(FieldStreamlinkLive))
+}
+
+// StreamlinkLiveNotNil applies the NotNil predicate on the "streamlink_live" field.
+func StreamlinkLiveNotNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotNull(FieldStreamlinkLive))
+}
+
+// StreamlinkLiveEqualFold applies the EqualFold predicate on the "streamlink_live" field.
+func StreamlinkLiveEqualFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEqualFold(FieldStreamlinkLive, v))
+}
+
+// StreamlinkLiveContainsFold applies the ContainsFold predicate on the "streamlink_live" field.
+func StreamlinkLiveContainsFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContainsFold(FieldStreamlinkLive, v))
+}
+
+// FolderTemplateEQ applies the EQ predicate on the "folder_template" field.
+func FolderTemplateEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldFolderTemplate, v))
+}
+
+// FolderTemplateNEQ applies the NEQ predicate on the "folder_template" field.
+func FolderTemplateNEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldFolderTemplate, v))
+}
+
+// FolderTemplateIn applies the In predicate on the "folder_template" field.
+func FolderTemplateIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIn(FieldFolderTemplate, vs...))
+}
+
+// FolderTemplateNotIn applies the NotIn predicate on the "folder_template" field.
+func FolderTemplateNotIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldFolderTemplate, vs...))
+}
+
+// FolderTemplateGT applies the GT predicate on the "folder_template" field.
+func FolderTemplateGT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGT(FieldFolderTemplate, v))
+}
+
+// FolderTemplateGTE applies the GTE predicate on the "folder_template" field.
+func FolderTemplateGTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGTE(FieldFolderTemplate, v))
+}
+
+// FolderTemplateLT applies the LT predicate on the "folder_template" field.
+func FolderTemplateLT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLT(FieldFolderTemplate, v))
+}
+
+// FolderTemplateLTE applies the LTE predicate on the "folder_template" field.
+func FolderTemplateLTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLTE(FieldFolderTemplate, v))
+}
+
+// FolderTemplateContains applies the Contains predicate on the "folder_template" field.
+func FolderTemplateContains(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContains(FieldFolderTemplate, v))
+}
+
+// FolderTemplateHasPrefix applies the HasPrefix predicate on the "folder_template" field.
+func FolderTemplateHasPrefix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasPrefix(FieldFolderTemplate, v))
+}
+
+// FolderTemplateHasSuffix applies the HasSuffix predicate on the "folder_template" field.
+func FolderTemplateHasSuffix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasSuffix(FieldFolderTemplate, v))
+}
+
+// FolderTemplateIsNil applies the IsNil predicate on the "folder_template" field.
+func FolderTemplateIsNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIsNull(FieldFolderTemplate))
+}
+
+// FolderTemplateNotNil applies the NotNil predicate on the "folder_template" field.
+func FolderTemplateNotNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotNull(FieldFolderTemplate))
+}
+
+// FolderTemplateEqualFold applies the EqualFold predicate on the "folder_template" field.
+func FolderTemplateEqualFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEqualFold(FieldFolderTemplate, v))
+}
+
+// FolderTemplateContainsFold applies the ContainsFold predicate on the "folder_template" field.
+func FolderTemplateContainsFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContainsFold(FieldFolderTemplate, v))
+}
+
+// FileTemplateEQ applies the EQ predicate on the "file_template" field.
+func FileTemplateEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldFileTemplate, v))
+}
+
+// FileTemplateNEQ applies the NEQ predicate on the "file_template" field.
+func FileTemplateNEQ(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldFileTemplate, v))
+}
+
+// FileTemplateIn applies the In predicate on the "file_template" field.
+func FileTemplateIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIn(FieldFileTemplate, vs...))
+}
+
+// FileTemplateNotIn applies the NotIn predicate on the "file_template" field.
+func FileTemplateNotIn(vs ...string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldFileTemplate, vs...))
+}
+
+// FileTemplateGT applies the GT predicate on the "file_template" field.
+func FileTemplateGT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGT(FieldFileTemplate, v))
+}
+
+// FileTemplateGTE applies the GTE predicate on the "file_template" field.
+func FileTemplateGTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGTE(FieldFileTemplate, v))
+}
+
+// FileTemplateLT applies the LT predicate on the "file_template" field.
+func FileTemplateLT(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLT(FieldFileTemplate, v))
+}
+
+// FileTemplateLTE applies the LTE predicate on the "file_template" field.
+func FileTemplateLTE(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLTE(FieldFileTemplate, v))
+}
+
+// FileTemplateContains applies the Contains predicate on the "file_template" field.
+func FileTemplateContains(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContains(FieldFileTemplate, v))
+}
+
+// FileTemplateHasPrefix applies the HasPrefix predicate on the "file_template" field.
+func FileTemplateHasPrefix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasPrefix(FieldFileTemplate, v))
+}
+
+// FileTemplateHasSuffix applies the HasSuffix predicate on the "file_template" field.
+func FileTemplateHasSuffix(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldHasSuffix(FieldFileTemplate, v))
+}
+
+// FileTemplateIsNil applies the IsNil predicate on the "file_template" field.
+func FileTemplateIsNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIsNull(FieldFileTemplate))
+}
+
+// FileTemplateNotNil applies the NotNil predicate on the "file_template" field.
+func FileTemplateNotNil() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotNull(FieldFileTemplate))
+}
+
+// FileTemplateEqualFold applies the EqualFold predicate on the "file_template" field.
+func FileTemplateEqualFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEqualFold(FieldFileTemplate, v))
+}
+
+// FileTemplateContainsFold applies the ContainsFold predicate on the "file_template" field.
+func FileTemplateContainsFold(v string) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldContainsFold(FieldFileTemplate, v))
+}
+
+// ProxyPolicyEQ applies the EQ predicate on the "proxy_policy" field.
+func ProxyPolicyEQ(v utils.ProxyPolicy) predicate.ArchiveProfile {
+	vc := v
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldProxyPolicy, vc))
+}
+
+// ProxyPolicyNEQ applies the NEQ predicate on the "proxy_policy" field.
+func ProxyPolicyNEQ(v utils.ProxyPolicy) predicate.ArchiveProfile {
+	vc := v
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldProxyPolicy, vc))
+}
+
+// ProxyPolicyIn applies the In predicate on the "proxy_policy" field.
+func ProxyPolicyIn(vs ...utils.ProxyPolicy) predicate.ArchiveProfile {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ArchiveProfile(sql.FieldIn(FieldProxyPolicy, v...))
+}
+
+// ProxyPolicyNotIn applies the NotIn predicate on the "proxy_policy" field.
+func ProxyPolicyNotIn(vs ...utils.ProxyPolicy) predicate.ArchiveProfile {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldProxyPolicy, v...))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasChannels applies the HasEdge predicate on the "channels" edge.
+func HasChannels() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChannelsTable, ChannelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChannelsWith applies the HasEdge predicate on the "channels" edge with a given conditions (other predicates).
+func HasChannelsWith(preds ...predicate.Channel) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(func(s *sql.Selector) {
+		step := newChannelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLive applies the HasEdge predicate on the "live" edge.
+func HasLive() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LiveTable, LiveColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLiveWith applies the HasEdge predicate on the "live" edge with a given conditions (other predicates).
+func HasLiveWith(preds ...predicate.Live) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(func(s *sql.Selector) {
+		step := newLiveStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVods applies the HasEdge predicate on the "vods" edge.
+func HasVods() predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VodsTable, VodsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVodsWith applies the HasEdge predicate on the "vods" edge with a given conditions (other predicates).
+func HasVodsWith(preds ...predicate.Vod) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(func(s *sql.Selector) {
+		step := newVodsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArchiveProfile) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArchiveProfile) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArchiveProfile) predicate.ArchiveProfile {
+	return predicate.ArchiveProfile(sql.NotPredicates(p))
+}
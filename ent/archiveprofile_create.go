@@ -0,0 +1,1383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ArchiveProfileCreate is the builder for creating a ArchiveProfile entity.
+type ArchiveProfileCreate struct {
+	config
+	mutation *ArchiveProfileMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (apc *ArchiveProfileCreate) SetName(s string) *ArchiveProfileCreate {
+	apc.mutation.SetName(s)
+	return apc
+}
+
+// SetQuality sets the "quality" field.
+func (apc *ArchiveProfileCreate) SetQuality(s string) *ArchiveProfileCreate {
+	apc.mutation.SetQuality(s)
+	return apc
+}
+
+// SetNillableQuality sets the "quality" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableQuality(s *string) *ArchiveProfileCreate {
+	if s != nil {
+		apc.SetQuality(*s)
+	}
+	return apc
+}
+
+// SetVideoConvert sets the "video_convert" field.
+func (apc *ArchiveProfileCreate) SetVideoConvert(s string) *ArchiveProfileCreate {
+	apc.mutation.SetVideoConvert(s)
+	return apc
+}
+
+// SetNillableVideoConvert sets the "video_convert" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableVideoConvert(s *string) *ArchiveProfileCreate {
+	if s != nil {
+		apc.SetVideoConvert(*s)
+	}
+	return apc
+}
+
+// SetSaveAsHls sets the "save_as_hls" field.
+func (apc *ArchiveProfileCreate) SetSaveAsHls(b bool) *ArchiveProfileCreate {
+	apc.mutation.SetSaveAsHls(b)
+	return apc
+}
+
+// SetNillableSaveAsHls sets the "save_as_hls" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableSaveAsHls(b *bool) *ArchiveProfileCreate {
+	if b != nil {
+		apc.SetSaveAsHls(*b)
+	}
+	return apc
+}
+
+// SetRenderChat sets the "render_chat" field.
+func (apc *ArchiveProfileCreate) SetRenderChat(b bool) *ArchiveProfileCreate {
+	apc.mutation.SetRenderChat(b)
+	return apc
+}
+
+// SetNillableRenderChat sets the "render_chat" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableRenderChat(b *bool) *ArchiveProfileCreate {
+	if b != nil {
+		apc.SetRenderChat(*b)
+	}
+	return apc
+}
+
+// SetChatRender sets the "chat_render" field.
+func (apc *ArchiveProfileCreate) SetChatRender(s string) *ArchiveProfileCreate {
+	apc.mutation.SetChatRender(s)
+	return apc
+}
+
+// SetNillableChatRender sets the "chat_render" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableChatRender(s *string) *ArchiveProfileCreate {
+	if s != nil {
+		apc.SetChatRender(*s)
+	}
+	return apc
+}
+
+// SetStreamlinkLive sets the "streamlink_live" field.
+func (apc *ArchiveProfileCreate) SetStreamlinkLive(s string) *ArchiveProfileCreate {
+	apc.mutation.SetStreamlinkLive(s)
+	return apc
+}
+
+// SetNillableStreamlinkLive sets the "streamlink_live" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableStreamlinkLive(s *string) *ArchiveProfileCreate {
+	if s != nil {
+		apc.SetStreamlinkLive(*s)
+	}
+	return apc
+}
+
+// SetFolderTemplate sets the "folder_template" field.
+func (apc *ArchiveProfileCreate) SetFolderTemplate(s string) *ArchiveProfileCreate {
+	apc.mutation.SetFolderTemplate(s)
+	return apc
+}
+
+// SetNillableFolderTemplate sets the "folder_template" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableFolderTemplate(s *string) *ArchiveProfileCreate {
+	if s != nil {
+		apc.SetFolderTemplate(*s)
+	}
+	return apc
+}
+
+// SetFileTemplate sets the "file_template" field.
+func (apc *ArchiveProfileCreate) SetFileTemplate(s string) *ArchiveProfileCreate {
+	apc.mutation.SetFileTemplate(s)
+	return apc
+}
+
+// SetNillableFileTemplate sets the "file_template" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableFileTemplate(s *string) *ArchiveProfileCreate {
+	if s != nil {
+		apc.SetFileTemplate(*s)
+	}
+	return apc
+}
+
+// SetProxyPolicy sets the "proxy_policy" field.
+func (apc *ArchiveProfileCreate) SetProxyPolicy(up utils.ProxyPolicy) *ArchiveProfileCreate {
+	apc.mutation.SetProxyPolicy(up)
+	return apc
+}
+
+// SetNillableProxyPolicy sets the "proxy_policy" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableProxyPolicy(up *utils.ProxyPolicy) *ArchiveProfileCreate {
+	if up != nil {
+		apc.SetProxyPolicy(*up)
+	}
+	return apc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (apc *ArchiveProfileCreate) SetUpdatedAt(t time.Time) *ArchiveProfileCreate {
+	apc.mutation.SetUpdatedAt(t)
+	return apc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableUpdatedAt(t *time.Time) *ArchiveProfileCreate {
+	if t != nil {
+		apc.SetUpdatedAt(*t)
+	}
+	return apc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (apc *ArchiveProfileCreate) SetCreatedAt(t time.Time) *ArchiveProfileCreate {
+	apc.mutation.SetCreatedAt(t)
+	return apc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableCreatedAt(t *time.Time) *ArchiveProfileCreate {
+	if t != nil {
+		apc.SetCreatedAt(*t)
+	}
+	return apc
+}
+
+// SetID sets the "id" field.
+func (apc *ArchiveProfileCreate) SetID(u uuid.UUID) *ArchiveProfileCreate {
+	apc.mutation.SetID(u)
+	return apc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (apc *ArchiveProfileCreate) SetNillableID(u *uuid.UUID) *ArchiveProfileCreate {
+	if u != nil {
+		apc.SetID(*u)
+	}
+	return apc
+}
+
+// AddChannelIDs adds the "channels" edge to the Channel entity by IDs.
+func (apc *ArchiveProfileCreate) AddChannelIDs(ids ...uuid.UUID) *ArchiveProfileCreate {
+	apc.mutation.AddChannelIDs(ids...)
+	return apc
+}
+
+// AddChannels adds the "channels" edges to the Channel entity.
+func (apc *ArchiveProfileCreate) AddChannels(c ...*Channel) *ArchiveProfileCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return apc.AddChannelIDs(ids...)
+}
+
+// AddLiveIDs adds the "live" edge to the Live entity by IDs.
+func (apc *ArchiveProfileCreate) AddLiveIDs(ids ...uuid.UUID) *ArchiveProfileCreate {
+	apc.mutation.AddLiveIDs(ids...)
+	return apc
+}
+
+// AddLive adds the "live" edges to the Live entity.
+func (apc *ArchiveProfileCreate) AddLive(l ...*Live) *ArchiveProfileCreate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return apc.AddLiveIDs(ids...)
+}
+
+// AddVodIDs adds the "vods" edge to the Vod entity by IDs.
+func (apc *ArchiveProfileCreate) AddVodIDs(ids ...uuid.UUID) *ArchiveProfileCreate {
+	apc.mutation.AddVodIDs(ids...)
+	return apc
+}
+
+// AddVods adds the "vods" edges to the Vod entity.
+func (apc *ArchiveProfileCreate) AddVods(v ...*Vod) *ArchiveProfileCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return apc.AddVodIDs(ids...)
+}
+
+// Mutation returns the ArchiveProfileMutation object of the builder.
+func (apc *ArchiveProfileCreate) Mutation() *ArchiveProfileMutation {
+	return apc.mutation
+}
+
+// Save creates the ArchiveProfile in the database.
+func (apc *ArchiveProfileCreate) Save(ctx context.Context) (*ArchiveProfile, error) {
+	apc.defaults()
+	return withHooks(ctx, apc.sqlSave, apc.mutation, apc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (apc *ArchiveProfileCreate) SaveX(ctx context.Context) *ArchiveProfile {
+	v, err := apc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apc *ArchiveProfileCreate) Exec(ctx context.Context) error {
+	_, err := apc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apc *ArchiveProfileCreate) ExecX(ctx context.Context) {
+	if err := apc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apc *ArchiveProfileCreate) defaults() {
+	if _, ok := apc.mutation.RenderChat(); !ok {
+		v := archiveprofile.DefaultRenderChat
+		apc.mutation.SetRenderChat(v)
+	}
+	if _, ok := apc.mutation.ProxyPolicy(); !ok {
+		v := archiveprofile.DefaultProxyPolicy
+		apc.mutation.SetProxyPolicy(v)
+	}
+	if _, ok := apc.mutation.UpdatedAt(); !ok {
+		v := archiveprofile.DefaultUpdatedAt()
+		apc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := apc.mutation.CreatedAt(); !ok {
+		v := archiveprofile.DefaultCreatedAt()
+		apc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := apc.mutation.ID(); !ok {
+		v := archiveprofile.DefaultID()
+		apc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apc *ArchiveProfileCreate) check() error {
+	if _, ok := apc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ArchiveProfile.name"`)}
+	}
+	if _, ok := apc.mutation.RenderChat(); !ok {
+		return &ValidationError{Name: "render_chat", err: errors.New(`ent: missing required field "ArchiveProfile.render_chat"`)}
+	}
+	if _, ok := apc.mutation.ProxyPolicy(); !ok {
+		return &ValidationError{Name: "proxy_policy", err: errors.New(`ent: missing required field "ArchiveProfile.proxy_policy"`)}
+	}
+	if v, ok := apc.mutation.ProxyPolicy(); ok {
+		if err := archiveprofile.ProxyPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "proxy_policy", err: fmt.Errorf(`ent: validator failed for field "ArchiveProfile.proxy_policy": %w`, err)}
+		}
+	}
+	if _, ok := apc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ArchiveProfile.updated_at"`)}
+	}
+	if _, ok := apc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ArchiveProfile.created_at"`)}
+	}
+	return nil
+}
+
+func (apc *ArchiveProfileCreate) sqlSave(ctx context.Context) (*ArchiveProfile, error) {
+	if err := apc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := apc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, apc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	apc.mutation.id = &_node.ID
+	apc.mutation.done = true
+	return _node, nil
+}
+
+func (apc *ArchiveProfileCreate) createSpec() (*ArchiveProfile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArchiveProfile{config: apc.config}
+		_spec = sqlgraph.NewCreateSpec(archiveprofile.Table, sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = apc.conflict
+	if id, ok := apc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := apc.mutation.Name(); ok {
+		_spec.SetField(archiveprofile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := apc.mutation.Quality(); ok {
+		_spec.SetField(archiveprofile.FieldQuality, field.TypeString, value)
+		_node.Quality = value
+	}
+	if value, ok := apc.mutation.VideoConvert(); ok {
+		_spec.SetField(archiveprofile.FieldVideoConvert, field.TypeString, value)
+		_node.VideoConvert = value
+	}
+	if value, ok := apc.mutation.SaveAsHls(); ok {
+		_spec.SetField(archiveprofile.FieldSaveAsHls, field.TypeBool, value)
+		_node.SaveAsHls = &value
+	}
+	if value, ok := apc.mutation.RenderChat(); ok {
+		_spec.SetField(archiveprofile.FieldRenderChat, field.TypeBool, value)
+		_node.RenderChat = value
+	}
+	if value, ok := apc.mutation.ChatRender(); ok {
+		_spec.SetField(archiveprofile.FieldChatRender, field.TypeString, value)
+		_node.ChatRender = value
+	}
+	if value, ok := apc.mutation.StreamlinkLive(); ok {
+		_spec.SetField(archiveprofile.FieldStreamlinkLive, field.TypeString, value)
+		_node.StreamlinkLive = value
+	}
+	if value, ok := apc.mutation.FolderTemplate(); ok {
+		_spec.SetField(archiveprofile.FieldFolderTemplate, field.TypeString, value)
+		_node.FolderTemplate = value
+	}
+	if value, ok := apc.mutation.FileTemplate(); ok {
+		_spec.SetField(archiveprofile.FieldFileTemplate, field.TypeString, value)
+		_node.FileTemplate = value
+	}
+	if value, ok := apc.mutation.ProxyPolicy(); ok {
+		_spec.SetField(archiveprofile.FieldProxyPolicy, field.TypeEnum, value)
+		_node.ProxyPolicy = value
+	}
+	if value, ok := apc.mutation.UpdatedAt(); ok {
+		_spec.SetField(archiveprofile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := apc.mutation.CreatedAt(); ok {
+		_spec.SetField(archiveprofile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := apc.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.ChannelsTable,
+			Columns: []string{archiveprofile.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := apc.mutation.LiveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.LiveTable,
+			Columns: []string{archiveprofile.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := apc.mutation.VodsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.VodsTable,
+			Columns: []string{archiveprofile.VodsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArchiveProfile.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArchiveProfileUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (apc *ArchiveProfileCreate) OnConflict(opts ...sql.ConflictOption) *ArchiveProfileUpsertOne {
+	apc.conflict = opts
+	return &ArchiveProfileUpsertOne{
+		create: apc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArchiveProfile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (apc *ArchiveProfileCreate) OnConflictColumns(columns ...string) *ArchiveProfileUpsertOne {
+	apc.conflict = append(apc.conflict, sql.ConflictColumns(columns...))
+	return &ArchiveProfileUpsertOne{
+		create: apc,
+	}
+}
+
+type (
+	// ArchiveProfileUpsertOne is the builder for "upsert"-ing
+	//  one ArchiveProfile node.
+	ArchiveProfileUpsertOne struct {
+		create *ArchiveProfileCreate
+	}
+
+	// ArchiveProfileUpsert is the "OnConflict" setter.
+	ArchiveProfileUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *ArchiveProfileUpsert) SetName(v string) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateName() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldName)
+	return u
+}
+
+// SetQuality sets the "quality" field.
+func (u *ArchiveProfileUpsert) SetQuality(v string) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldQuality, v)
+	return u
+}
+
+// UpdateQuality sets the "quality" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateQuality() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldQuality)
+	return u
+}
+
+// ClearQuality clears the value of the "quality" field.
+func (u *ArchiveProfileUpsert) ClearQuality() *ArchiveProfileUpsert {
+	u.SetNull(archiveprofile.FieldQuality)
+	return u
+}
+
+// SetVideoConvert sets the "video_convert" field.
+func (u *ArchiveProfileUpsert) SetVideoConvert(v string) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldVideoConvert, v)
+	return u
+}
+
+// UpdateVideoConvert sets the "video_convert" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateVideoConvert() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldVideoConvert)
+	return u
+}
+
+// ClearVideoConvert clears the value of the "video_convert" field.
+func (u *ArchiveProfileUpsert) ClearVideoConvert() *ArchiveProfileUpsert {
+	u.SetNull(archiveprofile.FieldVideoConvert)
+	return u
+}
+
+// SetSaveAsHls sets the "save_as_hls" field.
+func (u *ArchiveProfileUpsert) SetSaveAsHls(v bool) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldSaveAsHls, v)
+	return u
+}
+
+// UpdateSaveAsHls sets the "save_as_hls" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateSaveAsHls() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldSaveAsHls)
+	return u
+}
+
+// ClearSaveAsHls clears the value of the "save_as_hls" field.
+func (u *ArchiveProfileUpsert) ClearSaveAsHls() *ArchiveProfileUpsert {
+	u.SetNull(archiveprofile.FieldSaveAsHls)
+	return u
+}
+
+// SetRenderChat sets the "render_chat" field.
+func (u *ArchiveProfileUpsert) SetRenderChat(v bool) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldRenderChat, v)
+	return u
+}
+
+// UpdateRenderChat sets the "render_chat" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateRenderChat() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldRenderChat)
+	return u
+}
+
+// SetChatRender sets the "chat_render" field.
+func (u *ArchiveProfileUpsert) SetChatRender(v string) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldChatRender, v)
+	return u
+}
+
+// UpdateChatRender sets the "chat_render" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateChatRender() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldChatRender)
+	return u
+}
+
+// ClearChatRender clears the value of the "chat_render" field.
+func (u *ArchiveProfileUpsert) ClearChatRender() *ArchiveProfileUpsert {
+	u.SetNull(archiveprofile.FieldChatRender)
+	return u
+}
+
+// SetStreamlinkLive sets the "streamlink_live" field.
+func (u *ArchiveProfileUpsert) SetStreamlinkLive(v string) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldStreamlinkLive, v)
+	return u
+}
+
+// UpdateStreamlinkLive sets the "streamlink_live" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateStreamlinkLive() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldStreamlinkLive)
+	return u
+}
+
+// ClearStreamlinkLive clears the value of the "streamlink_live" field.
+func (u *ArchiveProfileUpsert) ClearStreamlinkLive() *ArchiveProfileUpsert {
+	u.SetNull(archiveprofile.FieldStreamlinkLive)
+	return u
+}
+
+// SetFolderTemplate sets the "folder_template" field.
+func (u *ArchiveProfileUpsert) SetFolderTemplate(v string) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldFolderTemplate, v)
+	return u
+}
+
+// UpdateFolderTemplate sets the "folder_template" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateFolderTemplate() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldFolderTemplate)
+	return u
+}
+
+// ClearFolderTemplate clears the value of the "folder_template" field.
+func (u *ArchiveProfileUpsert) ClearFolderTemplate() *ArchiveProfileUpsert {
+	u.SetNull(archiveprofile.FieldFolderTemplate)
+	return u
+}
+
+// SetFileTemplate sets the "file_template" field.
+func (u *ArchiveProfileUpsert) SetFileTemplate(v string) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldFileTemplate, v)
+	return u
+}
+
+// UpdateFileTemplate sets the "file_template" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateFileTemplate() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldFileTemplate)
+	return u
+}
+
+// ClearFileTemplate clears the value of the "file_template" field.
+func (u *ArchiveProfileUpsert) ClearFileTemplate() *ArchiveProfileUpsert {
+	u.SetNull(archiveprofile.FieldFileTemplate)
+	return u
+}
+
+// SetProxyPolicy sets the "proxy_policy" field.
+func (u *ArchiveProfileUpsert) SetProxyPolicy(v utils.ProxyPolicy) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldProxyPolicy, v)
+	return u
+}
+
+// UpdateProxyPolicy sets the "proxy_policy" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateProxyPolicy() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldProxyPolicy)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArchiveProfileUpsert) SetUpdatedAt(v time.Time) *ArchiveProfileUpsert {
+	u.Set(archiveprofile.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArchiveProfileUpsert) UpdateUpdatedAt() *ArchiveProfileUpsert {
+	u.SetExcluded(archiveprofile.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ArchiveProfile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(archiveprofile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ArchiveProfileUpsertOne) UpdateNewValues() *ArchiveProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(archiveprofile.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(archiveprofile.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArchiveProfile.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ArchiveProfileUpsertOne) Ignore() *ArchiveProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArchiveProfileUpsertOne) DoNothing() *ArchiveProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArchiveProfileCreate.OnConflict
+// documentation for more info.
+func (u *ArchiveProfileUpsertOne) Update(set func(*ArchiveProfileUpsert)) *ArchiveProfileUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArchiveProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ArchiveProfileUpsertOne) SetName(v string) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateName() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetQuality sets the "quality" field.
+func (u *ArchiveProfileUpsertOne) SetQuality(v string) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetQuality(v)
+	})
+}
+
+// UpdateQuality sets the "quality" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateQuality() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateQuality()
+	})
+}
+
+// ClearQuality clears the value of the "quality" field.
+func (u *ArchiveProfileUpsertOne) ClearQuality() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearQuality()
+	})
+}
+
+// SetVideoConvert sets the "video_convert" field.
+func (u *ArchiveProfileUpsertOne) SetVideoConvert(v string) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetVideoConvert(v)
+	})
+}
+
+// UpdateVideoConvert sets the "video_convert" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateVideoConvert() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateVideoConvert()
+	})
+}
+
+// ClearVideoConvert clears the value of the "video_convert" field.
+func (u *ArchiveProfileUpsertOne) ClearVideoConvert() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearVideoConvert()
+	})
+}
+
+// SetSaveAsHls sets the "save_as_hls" field.
+func (u *ArchiveProfileUpsertOne) SetSaveAsHls(v bool) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetSaveAsHls(v)
+	})
+}
+
+// UpdateSaveAsHls sets the "save_as_hls" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateSaveAsHls() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateSaveAsHls()
+	})
+}
+
+// ClearSaveAsHls clears the value of the "save_as_hls" field.
+func (u *ArchiveProfileUpsertOne) ClearSaveAsHls() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearSaveAsHls()
+	})
+}
+
+// SetRenderChat sets the "render_chat" field.
+func (u *ArchiveProfileUpsertOne) SetRenderChat(v bool) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetRenderChat(v)
+	})
+}
+
+// UpdateRenderChat sets the "render_chat" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateRenderChat() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateRenderChat()
+	})
+}
+
+// SetChatRender sets the "chat_render" field.
+func (u *ArchiveProfileUpsertOne) SetChatRender(v string) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetChatRender(v)
+	})
+}
+
+// UpdateChatRender sets the "chat_render" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateChatRender() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateChatRender()
+	})
+}
+
+// ClearChatRender clears the value of the "chat_render" field.
+func (u *ArchiveProfileUpsertOne) ClearChatRender() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearChatRender()
+	})
+}
+
+// SetStreamlinkLive sets the "streamlink_live" field.
+func (u *ArchiveProfileUpsertOne) SetStreamlinkLive(v string) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetStreamlinkLive(v)
+	})
+}
+
+// UpdateStreamlinkLive sets the "streamlink_live" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateStreamlinkLive() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateStreamlinkLive()
+	})
+}
+
+// ClearStreamlinkLive clears the value of the "streamlink_live" field.
+func (u *ArchiveProfileUpsertOne) ClearStreamlinkLive() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearStreamlinkLive()
+	})
+}
+
+// SetFolderTemplate sets the "folder_template" field.
+func (u *ArchiveProfileUpsertOne) SetFolderTemplate(v string) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetFolderTemplate(v)
+	})
+}
+
+// UpdateFolderTemplate sets the "folder_template" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateFolderTemplate() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateFolderTemplate()
+	})
+}
+
+// ClearFolderTemplate clears the value of the "folder_template" field.
+func (u *ArchiveProfileUpsertOne) ClearFolderTemplate() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearFolderTemplate()
+	})
+}
+
+// SetFileTemplate sets the "file_template" field.
+func (u *ArchiveProfileUpsertOne) SetFileTemplate(v string) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetFileTemplate(v)
+	})
+}
+
+// UpdateFileTemplate sets the "file_template" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateFileTemplate() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateFileTemplate()
+	})
+}
+
+// ClearFileTemplate clears the value of the "file_template" field.
+func (u *ArchiveProfileUpsertOne) ClearFileTemplate() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearFileTemplate()
+	})
+}
+
+// SetProxyPolicy sets the "proxy_policy" field.
+func (u *ArchiveProfileUpsertOne) SetProxyPolicy(v utils.ProxyPolicy) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetProxyPolicy(v)
+	})
+}
+
+// UpdateProxyPolicy sets the "proxy_policy" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateProxyPolicy() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateProxyPolicy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArchiveProfileUpsertOne) SetUpdatedAt(v time.Time) *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertOne) UpdateUpdatedAt() *ArchiveProfileUpsertOne {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ArchiveProfileUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArchiveProfileCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArchiveProfileUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ArchiveProfileUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ArchiveProfileUpsertOne.ID is not supported by MySQL driver. Use ArchiveProfileUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ArchiveProfileUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ArchiveProfileCreateBulk is the builder for creating many ArchiveProfile entities in bulk.
+type ArchiveProfileCreateBulk struct {
+	config
+	err      error
+	builders []*ArchiveProfileCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ArchiveProfile entities in the database.
+func (apcb *ArchiveProfileCreateBulk) Save(ctx context.Context) ([]*ArchiveProfile, error) {
+	if apcb.err != nil {
+		return nil, apcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(apcb.builders))
+	nodes := make([]*ArchiveProfile, len(apcb.builders))
+	mutators := make([]Mutator, len(apcb.builders))
+	for i := range apcb.builders {
+		func(i int, root context.Context) {
+			builder := apcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArchiveProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, apcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = apcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, apcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, apcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apcb *ArchiveProfileCreateBulk) SaveX(ctx context.Context) []*ArchiveProfile {
+	v, err := apcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (apcb *ArchiveProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := apcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apcb *ArchiveProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := apcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ArchiveProfile.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ArchiveProfileUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (apcb *ArchiveProfileCreateBulk) OnConflict(opts ...sql.ConflictOption) *ArchiveProfileUpsertBulk {
+	apcb.conflict = opts
+	return &ArchiveProfileUpsertBulk{
+		create: apcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ArchiveProfile.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (apcb *ArchiveProfileCreateBulk) OnConflictColumns(columns ...string) *ArchiveProfileUpsertBulk {
+	apcb.conflict = append(apcb.conflict, sql.ConflictColumns(columns...))
+	return &ArchiveProfileUpsertBulk{
+		create: apcb,
+	}
+}
+
+// ArchiveProfileUpsertBulk is the builder for "upsert"-ing
+// a bulk of ArchiveProfile nodes.
+type ArchiveProfileUpsertBulk struct {
+	create *ArchiveProfileCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ArchiveProfile.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(archiveprofile.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ArchiveProfileUpsertBulk) UpdateNewValues() *ArchiveProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(archiveprofile.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(archiveprofile.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ArchiveProfile.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ArchiveProfileUpsertBulk) Ignore() *ArchiveProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ArchiveProfileUpsertBulk) DoNothing() *ArchiveProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ArchiveProfileCreateBulk.OnConflict
+// documentation for more info.
+func (u *ArchiveProfileUpsertBulk) Update(set func(*ArchiveProfileUpsert)) *ArchiveProfileUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ArchiveProfileUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ArchiveProfileUpsertBulk) SetName(v string) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateName() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetQuality sets the "quality" field.
+func (u *ArchiveProfileUpsertBulk) SetQuality(v string) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetQuality(v)
+	})
+}
+
+// UpdateQuality sets the "quality" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateQuality() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateQuality()
+	})
+}
+
+// ClearQuality clears the value of the "quality" field.
+func (u *ArchiveProfileUpsertBulk) ClearQuality() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearQuality()
+	})
+}
+
+// SetVideoConvert sets the "video_convert" field.
+func (u *ArchiveProfileUpsertBulk) SetVideoConvert(v string) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetVideoConvert(v)
+	})
+}
+
+// UpdateVideoConvert sets the "video_convert" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateVideoConvert() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateVideoConvert()
+	})
+}
+
+// ClearVideoConvert clears the value of the "video_convert" field.
+func (u *ArchiveProfileUpsertBulk) ClearVideoConvert() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearVideoConvert()
+	})
+}
+
+// SetSaveAsHls sets the "save_as_hls" field.
+func (u *ArchiveProfileUpsertBulk) SetSaveAsHls(v bool) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetSaveAsHls(v)
+	})
+}
+
+// UpdateSaveAsHls sets the "save_as_hls" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateSaveAsHls() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateSaveAsHls()
+	})
+}
+
+// ClearSaveAsHls clears the value of the "save_as_hls" field.
+func (u *ArchiveProfileUpsertBulk) ClearSaveAsHls() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearSaveAsHls()
+	})
+}
+
+// SetRenderChat sets the "render_chat" field.
+func (u *ArchiveProfileUpsertBulk) SetRenderChat(v bool) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetRenderChat(v)
+	})
+}
+
+// UpdateRenderChat sets the "render_chat" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateRenderChat() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateRenderChat()
+	})
+}
+
+// SetChatRender sets the "chat_render" field.
+func (u *ArchiveProfileUpsertBulk) SetChatRender(v string) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetChatRender(v)
+	})
+}
+
+// UpdateChatRender sets the "chat_render" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateChatRender() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateChatRender()
+	})
+}
+
+// ClearChatRender clears the value of the "chat_render" field.
+func (u *ArchiveProfileUpsertBulk) ClearChatRender() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearChatRender()
+	})
+}
+
+// SetStreamlinkLive sets the "streamlink_live" field.
+func (u *ArchiveProfileUpsertBulk) SetStreamlinkLive(v string) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetStreamlinkLive(v)
+	})
+}
+
+// UpdateStreamlinkLive sets the "streamlink_live" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateStreamlinkLive() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateStreamlinkLive()
+	})
+}
+
+// ClearStreamlinkLive clears the value of the "streamlink_live" field.
+func (u *ArchiveProfileUpsertBulk) ClearStreamlinkLive() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearStreamlinkLive()
+	})
+}
+
+// SetFolderTemplate sets the "folder_template" field.
+func (u *ArchiveProfileUpsertBulk) SetFolderTemplate(v string) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetFolderTemplate(v)
+	})
+}
+
+// UpdateFolderTemplate sets the "folder_template" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateFolderTemplate() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateFolderTemplate()
+	})
+}
+
+// ClearFolderTemplate clears the value of the "folder_template" field.
+func (u *ArchiveProfileUpsertBulk) ClearFolderTemplate() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearFolderTemplate()
+	})
+}
+
+// SetFileTemplate sets the "file_template" field.
+func (u *ArchiveProfileUpsertBulk) SetFileTemplate(v string) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetFileTemplate(v)
+	})
+}
+
+// UpdateFileTemplate sets the "file_template" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateFileTemplate() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateFileTemplate()
+	})
+}
+
+// ClearFileTemplate clears the value of the "file_template" field.
+func (u *ArchiveProfileUpsertBulk) ClearFileTemplate() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.ClearFileTemplate()
+	})
+}
+
+// SetProxyPolicy sets the "proxy_policy" field.
+func (u *ArchiveProfileUpsertBulk) SetProxyPolicy(v utils.ProxyPolicy) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetProxyPolicy(v)
+	})
+}
+
+// UpdateProxyPolicy sets the "proxy_policy" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateProxyPolicy() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateProxyPolicy()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArchiveProfileUpsertBulk) SetUpdatedAt(v time.Time) *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArchiveProfileUpsertBulk) UpdateUpdatedAt() *ArchiveProfileUpsertBulk {
+	return u.Update(func(s *ArchiveProfileUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ArchiveProfileUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ArchiveProfileCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ArchiveProfileCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ArchiveProfileUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
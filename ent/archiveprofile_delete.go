@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// ArchiveProfileDelete is the builder for deleting a ArchiveProfile entity.
+type ArchiveProfileDelete struct {
+	config
+	hooks    []Hook
+	mutation *ArchiveProfileMutation
+}
+
+// Where appends a list predicates to the ArchiveProfileDelete builder.
+func (apd *ArchiveProfileDelete) Where(ps ...predicate.ArchiveProfile) *ArchiveProfileDelete {
+	apd.mutation.Where(ps...)
+	return apd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (apd *ArchiveProfileDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, apd.sqlExec, apd.mutation, apd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apd *ArchiveProfileDelete) ExecX(ctx context.Context) int {
+	n, err := apd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (apd *ArchiveProfileDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(archiveprofile.Table, sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID))
+	if ps := apd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, apd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	apd.mutation.done = true
+	return affected, err
+}
+
+// ArchiveProfileDeleteOne is the builder for deleting a single ArchiveProfile entity.
+type ArchiveProfileDeleteOne struct {
+	apd *ArchiveProfileDelete
+}
+
+// Where appends a list predicates to the ArchiveProfileDelete builder.
+func (apdo *ArchiveProfileDeleteOne) Where(ps ...predicate.ArchiveProfile) *ArchiveProfileDeleteOne {
+	apdo.apd.mutation.Where(ps...)
+	return apdo
+}
+
+// Exec executes the deletion query.
+func (apdo *ArchiveProfileDeleteOne) Exec(ctx context.Context) error {
+	n, err := apdo.apd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{archiveprofile.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apdo *ArchiveProfileDeleteOne) ExecX(ctx context.Context) {
+	if err := apdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,757 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// ArchiveProfileQuery is the builder for querying ArchiveProfile entities.
+type ArchiveProfileQuery struct {
+	config
+	ctx          *QueryContext
+	order        []archiveprofile.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ArchiveProfile
+	withChannels *ChannelQuery
+	withLive     *LiveQuery
+	withVods     *VodQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ArchiveProfileQuery builder.
+func (apq *ArchiveProfileQuery) Where(ps ...predicate.ArchiveProfile) *ArchiveProfileQuery {
+	apq.predicates = append(apq.predicates, ps...)
+	return apq
+}
+
+// Limit the number of records to be returned by this query.
+func (apq *ArchiveProfileQuery) Limit(limit int) *ArchiveProfileQuery {
+	apq.ctx.Limit = &limit
+	return apq
+}
+
+// Offset to start from.
+func (apq *ArchiveProfileQuery) Offset(offset int) *ArchiveProfileQuery {
+	apq.ctx.Offset = &offset
+	return apq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (apq *ArchiveProfileQuery) Unique(unique bool) *ArchiveProfileQuery {
+	apq.ctx.Unique = &unique
+	return apq
+}
+
+// Order specifies how the records should be ordered.
+func (apq *ArchiveProfileQuery) Order(o ...archiveprofile.OrderOption) *ArchiveProfileQuery {
+	apq.order = append(apq.order, o...)
+	return apq
+}
+
+// QueryChannels chains the current query on the "channels" edge.
+func (apq *ArchiveProfileQuery) QueryChannels() *ChannelQuery {
+	query := (&ChannelClient{config: apq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := apq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := apq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archiveprofile.Table, archiveprofile.FieldID, selector),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, archiveprofile.ChannelsTable, archiveprofile.ChannelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(apq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLive chains the current query on the "live" edge.
+func (apq *ArchiveProfileQuery) QueryLive() *LiveQuery {
+	query := (&LiveClient{config: apq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := apq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := apq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archiveprofile.Table, archiveprofile.FieldID, selector),
+			sqlgraph.To(live.Table, live.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, archiveprofile.LiveTable, archiveprofile.LiveColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(apq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVods chains the current query on the "vods" edge.
+func (apq *ArchiveProfileQuery) QueryVods() *VodQuery {
+	query := (&VodClient{config: apq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := apq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := apq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archiveprofile.Table, archiveprofile.FieldID, selector),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, archiveprofile.VodsTable, archiveprofile.VodsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(apq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ArchiveProfile entity from the query.
+// Returns a *NotFoundError when no ArchiveProfile was found.
+func (apq *ArchiveProfileQuery) First(ctx context.Context) (*ArchiveProfile, error) {
+	nodes, err := apq.Limit(1).All(setContextOp(ctx, apq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{archiveprofile.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (apq *ArchiveProfileQuery) FirstX(ctx context.Context) *ArchiveProfile {
+	node, err := apq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ArchiveProfile ID from the query.
+// Returns a *NotFoundError when no ArchiveProfile ID was found.
+func (apq *ArchiveProfileQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = apq.Limit(1).IDs(setContextOp(ctx, apq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{archiveprofile.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (apq *ArchiveProfileQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := apq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ArchiveProfile entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ArchiveProfile entity is found.
+// Returns a *NotFoundError when no ArchiveProfile entities are found.
+func (apq *ArchiveProfileQuery) Only(ctx context.Context) (*ArchiveProfile, error) {
+	nodes, err := apq.Limit(2).All(setContextOp(ctx, apq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{archiveprofile.Label}
+	default:
+		return nil, &NotSingularError{archiveprofile.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (apq *ArchiveProfileQuery) OnlyX(ctx context.Context) *ArchiveProfile {
+	node, err := apq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ArchiveProfile ID in the query.
+// Returns a *NotSingularError when more than one ArchiveProfile ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (apq *ArchiveProfileQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = apq.Limit(2).IDs(setContextOp(ctx, apq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{archiveprofile.Label}
+	default:
+		err = &NotSingularError{archiveprofile.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (apq *ArchiveProfileQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := apq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ArchiveProfiles.
+func (apq *ArchiveProfileQuery) All(ctx context.Context) ([]*ArchiveProfile, error) {
+	ctx = setContextOp(ctx, apq.ctx, "All")
+	if err := apq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ArchiveProfile, *ArchiveProfileQuery]()
+	return withInterceptors[[]*ArchiveProfile](ctx, apq, qr, apq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (apq *ArchiveProfileQuery) AllX(ctx context.Context) []*ArchiveProfile {
+	nodes, err := apq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ArchiveProfile IDs.
+func (apq *ArchiveProfileQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if apq.ctx.Unique == nil && apq.path != nil {
+		apq.Unique(true)
+	}
+	ctx = setContextOp(ctx, apq.ctx, "IDs")
+	if err = apq.Select(archiveprofile.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (apq *ArchiveProfileQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := apq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (apq *ArchiveProfileQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, apq.ctx, "Count")
+	if err := apq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, apq, querierCount[*ArchiveProfileQuery](), apq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (apq *ArchiveProfileQuery) CountX(ctx context.Context) int {
+	count, err := apq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (apq *ArchiveProfileQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, apq.ctx, "Exist")
+	switch _, err := apq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (apq *ArchiveProfileQuery) ExistX(ctx context.Context) bool {
+	exist, err := apq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ArchiveProfileQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (apq *ArchiveProfileQuery) Clone() *ArchiveProfileQuery {
+	if apq == nil {
+		return nil
+	}
+	return &ArchiveProfileQuery{
+		config:       apq.config,
+		ctx:          apq.ctx.Clone(),
+		order:        append([]archiveprofile.OrderOption{}, apq.order...),
+		inters:       append([]Interceptor{}, apq.inters...),
+		predicates:   append([]predicate.ArchiveProfile{}, apq.predicates...),
+		withChannels: apq.withChannels.Clone(),
+		withLive:     apq.withLive.Clone(),
+		withVods:     apq.withVods.Clone(),
+		// clone intermediate query.
+		sql:  apq.sql.Clone(),
+		path: apq.path,
+	}
+}
+
+// WithChannels tells the query-builder to eager-load the nodes that are connected to
+// the "channels" edge. The optional arguments are used to configure the query builder of the edge.
+func (apq *ArchiveProfileQuery) WithChannels(opts ...func(*ChannelQuery)) *ArchiveProfileQuery {
+	query := (&ChannelClient{config: apq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	apq.withChannels = query
+	return apq
+}
+
+// WithLive tells the query-builder to eager-load the nodes that are connected to
+// the "live" edge. The optional arguments are used to configure the query builder of the edge.
+func (apq *ArchiveProfileQuery) WithLive(opts ...func(*LiveQuery)) *ArchiveProfileQuery {
+	query := (&LiveClient{config: apq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	apq.withLive = query
+	return apq
+}
+
+// WithVods tells the query-builder to eager-load the nodes that are connected to
+// the "vods" edge. The optional arguments are used to configure the query builder of the edge.
+func (apq *ArchiveProfileQuery) WithVods(opts ...func(*VodQuery)) *ArchiveProfileQuery {
+	query := (&VodClient{config: apq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	apq.withVods = query
+	return apq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ArchiveProfile.Query().
+//		GroupBy(archiveprofile.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (apq *ArchiveProfileQuery) GroupBy(field string, fields ...string) *ArchiveProfileGroupBy {
+	apq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ArchiveProfileGroupBy{build: apq}
+	grbuild.flds = &apq.ctx.Fields
+	grbuild.label = archiveprofile.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.ArchiveProfile.Query().
+//		Select(archiveprofile.FieldName).
+//		Scan(ctx, &v)
+func (apq *ArchiveProfileQuery) Select(fields ...string) *ArchiveProfileSelect {
+	apq.ctx.Fields = append(apq.ctx.Fields, fields...)
+	sbuild := &ArchiveProfileSelect{ArchiveProfileQuery: apq}
+	sbuild.label = archiveprofile.Label
+	sbuild.flds, sbuild.scan = &apq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ArchiveProfileSelect configured with the given aggregations.
+func (apq *ArchiveProfileQuery) Aggregate(fns ...AggregateFunc) *ArchiveProfileSelect {
+	return apq.Select().Aggregate(fns...)
+}
+
+func (apq *ArchiveProfileQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range apq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, apq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range apq.ctx.Fields {
+		if !archiveprofile.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if apq.path != nil {
+		prev, err := apq.path(ctx)
+		if err != nil {
+			return err
+		}
+		apq.sql = prev
+	}
+	return nil
+}
+
+func (apq *ArchiveProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ArchiveProfile, error) {
+	var (
+		nodes       = []*ArchiveProfile{}
+		_spec       = apq.querySpec()
+		loadedTypes = [3]bool{
+			apq.withChannels != nil,
+			apq.withLive != nil,
+			apq.withVods != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ArchiveProfile).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ArchiveProfile{config: apq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, apq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := apq.withChannels; query != nil {
+		if err := apq.loadChannels(ctx, query, nodes,
+			func(n *ArchiveProfile) { n.Edges.Channels = []*Channel{} },
+			func(n *ArchiveProfile, e *Channel) { n.Edges.Channels = append(n.Edges.Channels, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := apq.withLive; query != nil {
+		if err := apq.loadLive(ctx, query, nodes,
+			func(n *ArchiveProfile) { n.Edges.Live = []*Live{} },
+			func(n *ArchiveProfile, e *Live) { n.Edges.Live = append(n.Edges.Live, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := apq.withVods; query != nil {
+		if err := apq.loadVods(ctx, query, nodes,
+			func(n *ArchiveProfile) { n.Edges.Vods = []*Vod{} },
+			func(n *ArchiveProfile, e *Vod) { n.Edges.Vods = append(n.Edges.Vods, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (apq *ArchiveProfileQuery) loadChannels(ctx context.Context, query *ChannelQuery, nodes []*ArchiveProfile, init func(*ArchiveProfile), assign func(*ArchiveProfile, *Channel)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ArchiveProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Channel(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(archiveprofile.ChannelsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.archive_profile_channels
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "archive_profile_channels" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "archive_profile_channels" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (apq *ArchiveProfileQuery) loadLive(ctx context.Context, query *LiveQuery, nodes []*ArchiveProfile, init func(*ArchiveProfile), assign func(*ArchiveProfile, *Live)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ArchiveProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Live(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(archiveprofile.LiveColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.archive_profile_live
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "archive_profile_live" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "archive_profile_live" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (apq *ArchiveProfileQuery) loadVods(ctx context.Context, query *VodQuery, nodes []*ArchiveProfile, init func(*ArchiveProfile), assign func(*ArchiveProfile, *Vod)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ArchiveProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Vod(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(archiveprofile.VodsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.archive_profile_vods
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "archive_profile_vods" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "archive_profile_vods" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (apq *ArchiveProfileQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := apq.querySpec()
+	_spec.Node.Columns = apq.ctx.Fields
+	if len(apq.ctx.Fields) > 0 {
+		_spec.Unique = apq.ctx.Unique != nil && *apq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, apq.driver, _spec)
+}
+
+func (apq *ArchiveProfileQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(archiveprofile.Table, archiveprofile.Columns, sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID))
+	_spec.From = apq.sql
+	if unique := apq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if apq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := apq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archiveprofile.FieldID)
+		for i := range fields {
+			if fields[i] != archiveprofile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := apq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := apq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := apq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := apq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (apq *ArchiveProfileQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(apq.driver.Dialect())
+	t1 := builder.Table(archiveprofile.Table)
+	columns := apq.ctx.Fields
+	if len(columns) == 0 {
+		columns = archiveprofile.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if apq.sql != nil {
+		selector = apq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if apq.ctx.Unique != nil && *apq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range apq.predicates {
+		p(selector)
+	}
+	for _, p := range apq.order {
+		p(selector)
+	}
+	if offset := apq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := apq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ArchiveProfileGroupBy is the group-by builder for ArchiveProfile entities.
+type ArchiveProfileGroupBy struct {
+	selector
+	build *ArchiveProfileQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (apgb *ArchiveProfileGroupBy) Aggregate(fns ...AggregateFunc) *ArchiveProfileGroupBy {
+	apgb.fns = append(apgb.fns, fns...)
+	return apgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (apgb *ArchiveProfileGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, apgb.build.ctx, "GroupBy")
+	if err := apgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArchiveProfileQuery, *ArchiveProfileGroupBy](ctx, apgb.build, apgb, apgb.build.inters, v)
+}
+
+func (apgb *ArchiveProfileGroupBy) sqlScan(ctx context.Context, root *ArchiveProfileQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(apgb.fns))
+	for _, fn := range apgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*apgb.flds)+len(apgb.fns))
+		for _, f := range *apgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*apgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := apgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ArchiveProfileSelect is the builder for selecting fields of ArchiveProfile entities.
+type ArchiveProfileSelect struct {
+	*ArchiveProfileQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (aps *ArchiveProfileSelect) Aggregate(fns ...AggregateFunc) *ArchiveProfileSelect {
+	aps.fns = append(aps.fns, fns...)
+	return aps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aps *ArchiveProfileSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aps.ctx, "Select")
+	if err := aps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArchiveProfileQuery, *ArchiveProfileSelect](ctx, aps.ArchiveProfileQuery, aps, aps.inters, v)
+}
+
+func (aps *ArchiveProfileSelect) sqlScan(ctx context.Context, root *ArchiveProfileQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(aps.fns))
+	for _, fn := range aps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*aps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ArchiveProfileUpdate is the builder for updating ArchiveProfile entities.
+type ArchiveProfileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArchiveProfileMutation
+}
+
+// Where appends a list predicates to the ArchiveProfileUpdate builder.
+func (apu *ArchiveProfileUpdate) Where(ps ...predicate.ArchiveProfile) *ArchiveProfileUpdate {
+	apu.mutation.Where(ps...)
+	return apu
+}
+
+// SetName sets the "name" field.
+func (apu *ArchiveProfileUpdate) SetName(s string) *ArchiveProfileUpdate {
+	apu.mutation.SetName(s)
+	return apu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (apu *ArchiveProfileUpdate) SetNillableName(s *string) *ArchiveProfileUpdate {
+	if s != nil {
+		apu.SetName(*s)
+	}
+	return apu
+}
+
+// SetQuality sets the "quality" field.
+func (apu *ArchiveProfileUpdate) SetQuality(s string) *ArchiveProfileUpdate {
+	apu.mutation.SetQuality(s)
+	return apu
+}
+
+// SetNillableQuality sets the "quality" field if the given value is not nil.
+func (apu *ArchiveProfileUpdate) SetNillableQuality(s *string) *ArchiveProfileUpdate {
+	if s != nil {
+		apu.SetQuality(*s)
+	}
+	return apu
+}
+
+// ClearQuality clears the value of the "quality" field.
+func (apu *ArchiveProfileUpdate) ClearQuality() *ArchiveProfileUpdate {
+	apu.mutation.ClearQuality()
+	return apu
+}
+
+// SetVideoConvert sets the "video_convert" field.
+func (apu *ArchiveProfileUpdate) SetVideoConvert(s string) *ArchiveProfileUpdate {
+	apu.mutation.SetVideoConvert(s)
+	return apu
+}
+
+// SetNillableVideoConvert sets the "video_convert" field if the given value is not nil.
+func (apu *ArchiveProfileUpdate) SetNillableVideoConvert(s *string) *ArchiveProfileUpdate {
+	if s != nil {
+		apu.SetVideoConvert(*s)
+	}
+	return apu
+}
+
+// ClearVideoConvert clears the value of the "video_convert" field.
+func (apu *ArchiveProfileUpdate) ClearVideoConvert() *ArchiveProfileUpdate {
+	apu.mutation.ClearVideoConvert()
+	return apu
+}
+
+// SetSaveAsHls sets the "save_as_hls" field.
+func (apu *ArchiveProfileUpdate) SetSaveAsHls(b bool) *ArchiveProfileUpdate {
+	apu.mutation.SetSaveAsHls(b)
+	return apu
+}
+
+// SetNillableSaveAsHls sets the "save_as_hls" field if the given value is not nil.
+func (apu *ArchiveProfileUpdate) SetNillableSaveAsHls(b *bool) *ArchiveProfileUpdate {
+	if b != nil {
+		apu.SetSaveAsHls(*b)
+	}
+	return apu
+}
+
+// ClearSaveAsHls clears the value of the "save_as_hls" field.
+func (apu *ArchiveProfileUpdate) ClearSaveAsHls() *ArchiveProfileUpdate {
+	apu.mutation.ClearSaveAsHls()
+	return apu
+}
+
+// SetRenderChat sets the "render_chat" field.
+func (apu *ArchiveProfileUpdate) SetRenderChat(b bool) *ArchiveProfileUpdate {
+	apu.mutation.SetRenderChat(b)
+	return apu
+}
+
+// SetNillableRenderChat sets the "render_chat" field if the given value is not nil.
+func (apu *ArchiveProfileUpdate) SetNillableRenderChat(b *bool) *ArchiveProfileUpdate {
+	if b != nil {
+		apu.SetRenderChat(*b)
+	}
+	return apu
+}
+
+// SetChatRender sets the "chat_render" field.
+func (apu *ArchiveProfileUpdate) SetChatRender(s string) *ArchiveProfileUpdate {
+	apu.mutation.SetChatRender(s)
+	return apu
+}
+
+// SetNillableChatRender sets the "chat_render" field if the given value is not nil.
+func (apu *ArchiveProfileUpdate) SetNillableChatRender(s *string) *ArchiveProfileUpdate {
+	if s != nil {
+		apu.SetChatRender(*s)
+	}
+	return apu
+}
+
+// ClearChatRender clears the value of the "chat_render" field.
+func (apu *ArchiveProfileUpdate) ClearChatRender() *ArchiveProfileUpdate {
+	apu.mutation.ClearChatRender()
+	return apu
+}
+
+// SetStreamlinkLive sets the "streamlink_live" field.
+func (apu *ArchiveProfileUpdate) SetStreamlinkLive(s string) *ArchiveProfileUpdate {
+	apu.mutation.SetStreamlinkLive(s)
+	return apu
+}
+
+// SetNillableStreamlinkLive sets the "streamlink_live" field if the given value is not nil.
+func (apu *ArchiveProfileUpdate) SetNillableStreamlinkLive(s *string) *ArchiveProfileUpdate {
+	if s != nil {
+		apu.SetStreamlinkLive(*s)
+	}
+	return apu
+}
+
+// ClearStreamlinkLive clears the value of the "streamlink_live" field.
+func (apu *ArchiveProfileUpdate) ClearStreamlinkLive() *ArchiveProfileUpdate {
+	apu.mutation.ClearStreamlinkLive()
+	return apu
+}
+
+// SetFolderTemplate sets the "folder_template" field.
+func (apu *ArchiveProfileUpdate) SetFolderTemplate(s string) *ArchiveProfileUpdate {
+	apu.mutation.SetFolderTemplate(s)
+	return apu
+}
+
+// SetNillableFolderTemplate sets the "folder_template" field if the given value is not nil.
+func (apu *ArchiveProfileUpdate) SetNillableFolderTemplate(s *string) *ArchiveProfileUpdate {
+	if s != nil {
+		apu.SetFolderTemplate(*s)
+	}
+	return apu
+}
+
+// ClearFolderTemplate clears the value of the "folder_template" field.
+func (apu *ArchiveProfileUpdate) ClearFolderTemplate() *ArchiveProfileUpdate {
+	apu.mutation.ClearFolderTemplate()
+	return apu
+}
+
+// SetFileTemplate sets the "file_template" field.
+func (apu *ArchiveProfileUpdate) SetFileTemplate(s string) *ArchiveProfileUpdate {
+	apu.mutation.SetFileTemplate(s)
+	return apu
+}
+
+// SetNillableFileTemplate sets the "file_template" field if the given value is not nil.
+func (apu *ArchiveProfileUpdate) SetNillableFileTemplate(s *string) *ArchiveProfileUpdate {
+	if s != nil {
+		apu.SetFileTemplate(*s)
+	}
+	return apu
+}
+
+// ClearFileTemplate clears the value of the "file_template" field.
+func (apu *ArchiveProfileUpdate) ClearFileTemplate() *ArchiveProfileUpdate {
+	apu.mutation.ClearFileTemplate()
+	return apu
+}
+
+// SetProxyPolicy sets the "proxy_policy" field.
+func (apu *ArchiveProfileUpdate) SetProxyPolicy(up utils.ProxyPolicy) *ArchiveProfileUpdate {
+	apu.mutation.SetProxyPolicy(up)
+	return apu
+}
+
+// SetNillableProxyPolicy sets the "proxy_policy" field if the given value is not nil.
+func (apu *ArchiveProfileUpdate) SetNillableProxyPolicy(up *utils.ProxyPolicy) *ArchiveProfileUpdate {
+	if up != nil {
+		apu.SetProxyPolicy(*up)
+	}
+	return apu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (apu *ArchiveProfileUpdate) SetUpdatedAt(t time.Time) *ArchiveProfileUpdate {
+	apu.mutation.SetUpdatedAt(t)
+	return apu
+}
+
+// AddChannelIDs adds the "channels" edge to the Channel entity by IDs.
+func (apu *ArchiveProfileUpdate) AddChannelIDs(ids ...uuid.UUID) *ArchiveProfileUpdate {
+	apu.mutation.AddChannelIDs(ids...)
+	return apu
+}
+
+// AddChannels adds the "channels" edges to the Channel entity.
+func (apu *ArchiveProfileUpdate) AddChannels(c ...*Channel) *ArchiveProfileUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return apu.AddChannelIDs(ids...)
+}
+
+// AddLiveIDs adds the "live" edge to the Live entity by IDs.
+func (apu *ArchiveProfileUpdate) AddLiveIDs(ids ...uuid.UUID) *ArchiveProfileUpdate {
+	apu.mutation.AddLiveIDs(ids...)
+	return apu
+}
+
+// AddLive adds the "live" edges to the Live entity.
+func (apu *ArchiveProfileUpdate) AddLive(l ...*Live) *ArchiveProfileUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return apu.AddLiveIDs(ids...)
+}
+
+// AddVodIDs adds the "vods" edge to the Vod entity by IDs.
+func (apu *ArchiveProfileUpdate) AddVodIDs(ids ...uuid.UUID) *ArchiveProfileUpdate {
+	apu.mutation.AddVodIDs(ids...)
+	return apu
+}
+
+// AddVods adds the "vods" edges to the Vod entity.
+func (apu *ArchiveProfileUpdate) AddVods(v ...*Vod) *ArchiveProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return apu.AddVodIDs(ids...)
+}
+
+// Mutation returns the ArchiveProfileMutation object of the builder.
+func (apu *ArchiveProfileUpdate) Mutation() *ArchiveProfileMutation {
+	return apu.mutation
+}
+
+// ClearChannels clears all "channels" edges to the Channel entity.
+func (apu *ArchiveProfileUpdate) ClearChannels() *ArchiveProfileUpdate {
+	apu.mutation.ClearChannels()
+	return apu
+}
+
+// RemoveChannelIDs removes the "channels" edge to Channel entities by IDs.
+func (apu *ArchiveProfileUpdate) RemoveChannelIDs(ids ...uuid.UUID) *ArchiveProfileUpdate {
+	apu.mutation.RemoveChannelIDs(ids...)
+	return apu
+}
+
+// RemoveChannels removes "channels" edges to Channel entities.
+func (apu *ArchiveProfileUpdate) RemoveChannels(c ...*Channel) *ArchiveProfileUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return apu.RemoveChannelIDs(ids...)
+}
+
+// ClearLive clears all "live" edges to the Live entity.
+func (apu *ArchiveProfileUpdate) ClearLive() *ArchiveProfileUpdate {
+	apu.mutation.ClearLive()
+	return apu
+}
+
+// RemoveLiveIDs removes the "live" edge to Live entities by IDs.
+func (apu *ArchiveProfileUpdate) RemoveLiveIDs(ids ...uuid.UUID) *ArchiveProfileUpdate {
+	apu.mutation.RemoveLiveIDs(ids...)
+	return apu
+}
+
+// RemoveLive removes "live" edges to Live entities.
+func (apu *ArchiveProfileUpdate) RemoveLive(l ...*Live) *ArchiveProfileUpdate {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return apu.RemoveLiveIDs(ids...)
+}
+
+// ClearVods clears all "vods" edges to the Vod entity.
+func (apu *ArchiveProfileUpdate) ClearVods() *ArchiveProfileUpdate {
+	apu.mutation.ClearVods()
+	return apu
+}
+
+// RemoveVodIDs removes the "vods" edge to Vod entities by IDs.
+func (apu *ArchiveProfileUpdate) RemoveVodIDs(ids ...uuid.UUID) *ArchiveProfileUpdate {
+	apu.mutation.RemoveVodIDs(ids...)
+	return apu
+}
+
+// RemoveVods removes "vods" edges to Vod entities.
+func (apu *ArchiveProfileUpdate) RemoveVods(v ...*Vod) *ArchiveProfileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return apu.RemoveVodIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (apu *ArchiveProfileUpdate) Save(ctx context.Context) (int, error) {
+	apu.defaults()
+	return withHooks(ctx, apu.sqlSave, apu.mutation, apu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apu *ArchiveProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := apu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (apu *ArchiveProfileUpdate) Exec(ctx context.Context) error {
+	_, err := apu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apu *ArchiveProfileUpdate) ExecX(ctx context.Context) {
+	if err := apu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apu *ArchiveProfileUpdate) defaults() {
+	if _, ok := apu.mutation.UpdatedAt(); !ok {
+		v := archiveprofile.UpdateDefaultUpdatedAt()
+		apu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apu *ArchiveProfileUpdate) check() error {
+	if v, ok := apu.mutation.ProxyPolicy(); ok {
+		if err := archiveprofile.ProxyPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "proxy_policy", err: fmt.Errorf(`ent: validator failed for field "ArchiveProfile.proxy_policy": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (apu *ArchiveProfileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := apu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archiveprofile.Table, archiveprofile.Columns, sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID))
+	if ps := apu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apu.mutation.Name(); ok {
+		_spec.SetField(archiveprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := apu.mutation.Quality(); ok {
+		_spec.SetField(archiveprofile.FieldQuality, field.TypeString, value)
+	}
+	if apu.mutation.QualityCleared() {
+		_spec.ClearField(archiveprofile.FieldQuality, field.TypeString)
+	}
+	if value, ok := apu.mutation.VideoConvert(); ok {
+		_spec.SetField(archiveprofile.FieldVideoConvert, field.TypeString, value)
+	}
+	if apu.mutation.VideoConvertCleared() {
+		_spec.ClearField(archiveprofile.FieldVideoConvert, field.TypeString)
+	}
+	if value, ok := apu.mutation.SaveAsHls(); ok {
+		_spec.SetField(archiveprofile.FieldSaveAsHls, field.TypeBool, value)
+	}
+	if apu.mutation.SaveAsHlsCleared() {
+		_spec.ClearField(archiveprofile.FieldSaveAsHls, field.TypeBool)
+	}
+	if value, ok := apu.mutation.RenderChat(); ok {
+		_spec.SetField(archiveprofile.FieldRenderChat, field.TypeBool, value)
+	}
+	if value, ok := apu.mutation.ChatRender(); ok {
+		_spec.SetField(archiveprofile.FieldChatRender, field.TypeString, value)
+	}
+	if apu.mutation.ChatRenderCleared() {
+		_spec.ClearField(archiveprofile.FieldChatRender, field.TypeString)
+	}
+	if value, ok := apu.mutation.StreamlinkLive(); ok {
+		_spec.SetField(archiveprofile.FieldStreamlinkLive, field.TypeString, value)
+	}
+	if apu.mutation.StreamlinkLiveCleared() {
+		_spec.ClearField(archiveprofile.FieldStreamlinkLive, field.TypeString)
+	}
+	if value, ok := apu.mutation.FolderTemplate(); ok {
+		_spec.SetField(archiveprofile.FieldFolderTemplate, field.TypeString, value)
+	}
+	if apu.mutation.FolderTemplateCleared() {
+		_spec.ClearField(archiveprofile.FieldFolderTemplate, field.TypeString)
+	}
+	if value, ok := apu.mutation.FileTemplate(); ok {
+		_spec.SetField(archiveprofile.FieldFileTemplate, field.TypeString, value)
+	}
+	if apu.mutation.FileTemplateCleared() {
+		_spec.ClearField(archiveprofile.FieldFileTemplate, field.TypeString)
+	}
+	if value, ok := apu.mutation.ProxyPolicy(); ok {
+		_spec.SetField(archiveprofile.FieldProxyPolicy, field.TypeEnum, value)
+	}
+	if value, ok := apu.mutation.UpdatedAt(); ok {
+		_spec.SetField(archiveprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if apu.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.ChannelsTable,
+			Columns: []string{archiveprofile.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apu.mutation.RemovedChannelsIDs(); len(nodes) > 0 && !apu.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.ChannelsTable,
+			Columns: []string{archiveprofile.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apu.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.ChannelsTable,
+			Columns: []string{archiveprofile.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if apu.mutation.LiveCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.LiveTable,
+			Columns: []string{archiveprofile.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apu.mutation.RemovedLiveIDs(); len(nodes) > 0 && !apu.mutation.LiveCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.LiveTable,
+			Columns: []string{archiveprofile.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apu.mutation.LiveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.LiveTable,
+			Columns: []string{archiveprofile.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if apu.mutation.VodsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.VodsTable,
+			Columns: []string{archiveprofile.VodsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apu.mutation.RemovedVodsIDs(); len(nodes) > 0 && !apu.mutation.VodsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.VodsTable,
+			Columns: []string{archiveprofile.VodsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apu.mutation.VodsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.VodsTable,
+			Columns: []string{archiveprofile.VodsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, apu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archiveprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	apu.mutation.done = true
+	return n, nil
+}
+
+// ArchiveProfileUpdateOne is the builder for updating a single ArchiveProfile entity.
+type ArchiveProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArchiveProfileMutation
+}
+
+// SetName sets the "name" field.
+func (apuo *ArchiveProfileUpdateOne) SetName(s string) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetName(s)
+	return apuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (apuo *ArchiveProfileUpdateOne) SetNillableName(s *string) *ArchiveProfileUpdateOne {
+	if s != nil {
+		apuo.SetName(*s)
+	}
+	return apuo
+}
+
+// SetQuality sets the "quality" field.
+func (apuo *ArchiveProfileUpdateOne) SetQuality(s string) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetQuality(s)
+	return apuo
+}
+
+// SetNillableQuality sets the "quality" field if the given value is not nil.
+func (apuo *ArchiveProfileUpdateOne) SetNillableQuality(s *string) *ArchiveProfileUpdateOne {
+	if s != nil {
+		apuo.SetQuality(*s)
+	}
+	return apuo
+}
+
+// ClearQuality clears the value of the "quality" field.
+func (apuo *ArchiveProfileUpdateOne) ClearQuality() *ArchiveProfileUpdateOne {
+	apuo.mutation.ClearQuality()
+	return apuo
+}
+
+// SetVideoConvert sets the "video_convert" field.
+func (apuo *ArchiveProfileUpdateOne) SetVideoConvert(s string) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetVideoConvert(s)
+	return apuo
+}
+
+// SetNillableVideoConvert sets the "video_convert" field if the given value is not nil.
+func (apuo *ArchiveProfileUpdateOne) SetNillableVideoConvert(s *string) *ArchiveProfileUpdateOne {
+	if s != nil {
+		apuo.SetVideoConvert(*s)
+	}
+	return apuo
+}
+
+// ClearVideoConvert clears the value of the "video_convert" field.
+func (apuo *ArchiveProfileUpdateOne) ClearVideoConvert() *ArchiveProfileUpdateOne {
+	apuo.mutation.ClearVideoConvert()
+	return apuo
+}
+
+// SetSaveAsHls sets the "save_as_hls" field.
+func (apuo *ArchiveProfileUpdateOne) SetSaveAsHls(b bool) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetSaveAsHls(b)
+	return apuo
+}
+
+// SetNillableSaveAsHls sets the "save_as_hls" field if the given value is not nil.
+func (apuo *ArchiveProfileUpdateOne) SetNillableSaveAsHls(b *bool) *ArchiveProfileUpdateOne {
+	if b != nil {
+		apuo.SetSaveAsHls(*b)
+	}
+	return apuo
+}
+
+// ClearSaveAsHls clears the value of the "save_as_hls" field.
+func (apuo *ArchiveProfileUpdateOne) ClearSaveAsHls() *ArchiveProfileUpdateOne {
+	apuo.mutation.ClearSaveAsHls()
+	return apuo
+}
+
+// SetRenderChat sets the "render_chat" field.
+func (apuo *ArchiveProfileUpdateOne) SetRenderChat(b bool) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetRenderChat(b)
+	return apuo
+}
+
+// SetNillableRenderChat sets the "render_chat" field if the given value is not nil.
+func (apuo *ArchiveProfileUpdateOne) SetNillableRenderChat(b *bool) *ArchiveProfileUpdateOne {
+	if b != nil {
+		apuo.SetRenderChat(*b)
+	}
+	return apuo
+}
+
+// SetChatRender sets the "chat_render" field.
+func (apuo *ArchiveProfileUpdateOne) SetChatRender(s string) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetChatRender(s)
+	return apuo
+}
+
+// SetNillableChatRender sets the "chat_render" field if the given value is not nil.
+func (apuo *ArchiveProfileUpdateOne) SetNillableChatRender(s *string) *ArchiveProfileUpdateOne {
+	if s != nil {
+		apuo.SetChatRender(*s)
+	}
+	return apuo
+}
+
+// ClearChatRender clears the value of the "chat_render" field.
+func (apuo *ArchiveProfileUpdateOne) ClearChatRender() *ArchiveProfileUpdateOne {
+	apuo.mutation.ClearChatRender()
+	return apuo
+}
+
+// SetStreamlinkLive sets the "streamlink_live" field.
+func (apuo *ArchiveProfileUpdateOne) SetStreamlinkLive(s string) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetStreamlinkLive(s)
+	return apuo
+}
+
+// SetNillableStreamlinkLive sets the "streamlink_live" field if the given value is not nil.
+func (apuo *ArchiveProfileUpdateOne) SetNillableStreamlinkLive(s *string) *ArchiveProfileUpdateOne {
+	if s != nil {
+		apuo.SetStreamlinkLive(*s)
+	}
+	return apuo
+}
+
+// ClearStreamlinkLive clears the value of the "streamlink_live" field.
+func (apuo *ArchiveProfileUpdateOne) ClearStreamlinkLive() *ArchiveProfileUpdateOne {
+	apuo.mutation.ClearStreamlinkLive()
+	return apuo
+}
+
+// SetFolderTemplate sets the "folder_template" field.
+func (apuo *ArchiveProfileUpdateOne) SetFolderTemplate(s string) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetFolderTemplate(s)
+	return apuo
+}
+
+// SetNillableFolderTemplate sets the "folder_template" field if the given value is not nil.
+func (apuo *ArchiveProfileUpdateOne) SetNillableFolderTemplate(s *string) *ArchiveProfileUpdateOne {
+	if s != nil {
+		apuo.SetFolderTemplate(*s)
+	}
+	return apuo
+}
+
+// ClearFolderTemplate clears the value of the "folder_template" field.
+func (apuo *ArchiveProfileUpdateOne) ClearFolderTemplate() *ArchiveProfileUpdateOne {
+	apuo.mutation.ClearFolderTemplate()
+	return apuo
+}
+
+// SetFileTemplate sets the "file_template" field.
+func (apuo *ArchiveProfileUpdateOne) SetFileTemplate(s string) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetFileTemplate(s)
+	return apuo
+}
+
+// SetNillableFileTemplate sets the "file_template" field if the given value is not nil.
+func (apuo *ArchiveProfileUpdateOne) SetNillableFileTemplate(s *string) *ArchiveProfileUpdateOne {
+	if s != nil {
+		apuo.SetFileTemplate(*s)
+	}
+	return apuo
+}
+
+// ClearFileTemplate clears the value of the "file_template" field.
+func (apuo *ArchiveProfileUpdateOne) ClearFileTemplate() *ArchiveProfileUpdateOne {
+	apuo.mutation.ClearFileTemplate()
+	return apuo
+}
+
+// SetProxyPolicy sets the "proxy_policy" field.
+func (apuo *ArchiveProfileUpdateOne) SetProxyPolicy(up utils.ProxyPolicy) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetProxyPolicy(up)
+	return apuo
+}
+
+// SetNillableProxyPolicy sets the "proxy_policy" field if the given value is not nil.
+func (apuo *ArchiveProfileUpdateOne) SetNillableProxyPolicy(up *utils.ProxyPolicy) *ArchiveProfileUpdateOne {
+	if up != nil {
+		apuo.SetProxyPolicy(*up)
+	}
+	return apuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (apuo *ArchiveProfileUpdateOne) SetUpdatedAt(t time.Time) *ArchiveProfileUpdateOne {
+	apuo.mutation.SetUpdatedAt(t)
+	return apuo
+}
+
+// AddChannelIDs adds the "channels" edge to the Channel entity by IDs.
+func (apuo *ArchiveProfileUpdateOne) AddChannelIDs(ids ...uuid.UUID) *ArchiveProfileUpdateOne {
+	apuo.mutation.AddChannelIDs(ids...)
+	return apuo
+}
+
+// AddChannels adds the "channels" edges to the Channel entity.
+func (apuo *ArchiveProfileUpdateOne) AddChannels(c ...*Channel) *ArchiveProfileUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return apuo.AddChannelIDs(ids...)
+}
+
+// AddLiveIDs adds the "live" edge to the Live entity by IDs.
+func (apuo *ArchiveProfileUpdateOne) AddLiveIDs(ids ...uuid.UUID) *ArchiveProfileUpdateOne {
+	apuo.mutation.AddLiveIDs(ids...)
+	return apuo
+}
+
+// AddLive adds the "live" edges to the Live entity.
+func (apuo *ArchiveProfileUpdateOne) AddLive(l ...*Live) *ArchiveProfileUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return apuo.AddLiveIDs(ids...)
+}
+
+// AddVodIDs adds the "vods" edge to the Vod entity by IDs.
+func (apuo *ArchiveProfileUpdateOne) AddVodIDs(ids ...uuid.UUID) *ArchiveProfileUpdateOne {
+	apuo.mutation.AddVodIDs(ids...)
+	return apuo
+}
+
+// AddVods adds the "vods" edges to the Vod entity.
+func (apuo *ArchiveProfileUpdateOne) AddVods(v ...*Vod) *ArchiveProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return apuo.AddVodIDs(ids...)
+}
+
+// Mutation returns the ArchiveProfileMutation object of the builder.
+func (apuo *ArchiveProfileUpdateOne) Mutation() *ArchiveProfileMutation {
+	return apuo.mutation
+}
+
+// ClearChannels clears all "channels" edges to the Channel entity.
+func (apuo *ArchiveProfileUpdateOne) ClearChannels() *ArchiveProfileUpdateOne {
+	apuo.mutation.ClearChannels()
+	return apuo
+}
+
+// RemoveChannelIDs removes the "channels" edge to Channel entities by IDs.
+func (apuo *ArchiveProfileUpdateOne) RemoveChannelIDs(ids ...uuid.UUID) *ArchiveProfileUpdateOne {
+	apuo.mutation.RemoveChannelIDs(ids...)
+	return apuo
+}
+
+// RemoveChannels removes "channels" edges to Channel entities.
+func (apuo *ArchiveProfileUpdateOne) RemoveChannels(c ...*Channel) *ArchiveProfileUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return apuo.RemoveChannelIDs(ids...)
+}
+
+// ClearLive clears all "live" edges to the Live entity.
+func (apuo *ArchiveProfileUpdateOne) ClearLive() *ArchiveProfileUpdateOne {
+	apuo.mutation.ClearLive()
+	return apuo
+}
+
+// RemoveLiveIDs removes the "live" edge to Live entities by IDs.
+func (apuo *ArchiveProfileUpdateOne) RemoveLiveIDs(ids ...uuid.UUID) *ArchiveProfileUpdateOne {
+	apuo.mutation.RemoveLiveIDs(ids...)
+	return apuo
+}
+
+// RemoveLive removes "live" edges to Live entities.
+func (apuo *ArchiveProfileUpdateOne) RemoveLive(l ...*Live) *ArchiveProfileUpdateOne {
+	ids := make([]uuid.UUID, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return apuo.RemoveLiveIDs(ids...)
+}
+
+// ClearVods clears all "vods" edges to the Vod entity.
+func (apuo *ArchiveProfileUpdateOne) ClearVods() *ArchiveProfileUpdateOne {
+	apuo.mutation.ClearVods()
+	return apuo
+}
+
+// RemoveVodIDs removes the "vods" edge to Vod entities by IDs.
+func (apuo *ArchiveProfileUpdateOne) RemoveVodIDs(ids ...uuid.UUID) *ArchiveProfileUpdateOne {
+	apuo.mutation.RemoveVodIDs(ids...)
+	return apuo
+}
+
+// RemoveVods removes "vods" edges to Vod entities.
+func (apuo *ArchiveProfileUpdateOne) RemoveVods(v ...*Vod) *ArchiveProfileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return apuo.RemoveVodIDs(ids...)
+}
+
+// Where appends a list predicates to the ArchiveProfileUpdate builder.
+func (apuo *ArchiveProfileUpdateOne) Where(ps ...predicate.ArchiveProfile) *ArchiveProfileUpdateOne {
+	apuo.mutation.Where(ps...)
+	return apuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (apuo *ArchiveProfileUpdateOne) Select(field string, fields ...string) *ArchiveProfileUpdateOne {
+	apuo.fields = append([]string{field}, fields...)
+	return apuo
+}
+
+// Save executes the query and returns the updated ArchiveProfile entity.
+func (apuo *ArchiveProfileUpdateOne) Save(ctx context.Context) (*ArchiveProfile, error) {
+	apuo.defaults()
+	return withHooks(ctx, apuo.sqlSave, apuo.mutation, apuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (apuo *ArchiveProfileUpdateOne) SaveX(ctx context.Context) *ArchiveProfile {
+	node, err := apuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (apuo *ArchiveProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := apuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (apuo *ArchiveProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := apuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (apuo *ArchiveProfileUpdateOne) defaults() {
+	if _, ok := apuo.mutation.UpdatedAt(); !ok {
+		v := archiveprofile.UpdateDefaultUpdatedAt()
+		apuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (apuo *ArchiveProfileUpdateOne) check() error {
+	if v, ok := apuo.mutation.ProxyPolicy(); ok {
+		if err := archiveprofile.ProxyPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "proxy_policy", err: fmt.Errorf(`ent: validator failed for field "ArchiveProfile.proxy_policy": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (apuo *ArchiveProfileUpdateOne) sqlSave(ctx context.Context) (_node *ArchiveProfile, err error) {
+	if err := apuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archiveprofile.Table, archiveprofile.Columns, sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID))
+	id, ok := apuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArchiveProfile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := apuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archiveprofile.FieldID)
+		for _, f := range fields {
+			if !archiveprofile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != archiveprofile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := apuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := apuo.mutation.Name(); ok {
+		_spec.SetField(archiveprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := apuo.mutation.Quality(); ok {
+		_spec.SetField(archiveprofile.FieldQuality, field.TypeString, value)
+	}
+	if apuo.mutation.QualityCleared() {
+		_spec.ClearField(archiveprofile.FieldQuality, field.TypeString)
+	}
+	if value, ok := apuo.mutation.VideoConvert(); ok {
+		_spec.SetField(archiveprofile.FieldVideoConvert, field.TypeString, value)
+	}
+	if apuo.mutation.VideoConvertCleared() {
+		_spec.ClearField(archiveprofile.FieldVideoConvert, field.TypeString)
+	}
+	if value, ok := apuo.mutation.SaveAsHls(); ok {
+		_spec.SetField(archiveprofile.FieldSaveAsHls, field.TypeBool, value)
+	}
+	if apuo.mutation.SaveAsHlsCleared() {
+		_spec.ClearField(archiveprofile.FieldSaveAsHls, field.TypeBool)
+	}
+	if value, ok := apuo.mutation.RenderChat(); ok {
+		_spec.SetField(archiveprofile.FieldRenderChat, field.TypeBool, value)
+	}
+	if value, ok := apuo.mutation.ChatRender(); ok {
+		_spec.SetField(archiveprofile.FieldChatRender, field.TypeString, value)
+	}
+	if apuo.mutation.ChatRenderCleared() {
+		_spec.ClearField(archiveprofile.FieldChatRender, field.TypeString)
+	}
+	if value, ok := apuo.mutation.StreamlinkLive(); ok {
+		_spec.SetField(archiveprofile.FieldStreamlinkLive, field.TypeString, value)
+	}
+	if apuo.mutation.StreamlinkLiveCleared() {
+		_spec.ClearField(archiveprofile.FieldStreamlinkLive, field.TypeString)
+	}
+	if value, ok := apuo.mutation.FolderTemplate(); ok {
+		_spec.SetField(archiveprofile.FieldFolderTemplate, field.TypeString, value)
+	}
+	if apuo.mutation.FolderTemplateCleared() {
+		_spec.ClearField(archiveprofile.FieldFolderTemplate, field.TypeString)
+	}
+	if value, ok := apuo.mutation.FileTemplate(); ok {
+		_spec.SetField(archiveprofile.FieldFileTemplate, field.TypeString, value)
+	}
+	if apuo.mutation.FileTemplateCleared() {
+		_spec.ClearField(archiveprofile.FieldFileTemplate, field.TypeString)
+	}
+	if value, ok := apuo.mutation.ProxyPolicy(); ok {
+		_spec.SetField(archiveprofile.FieldProxyPolicy, field.TypeEnum, value)
+	}
+	if value, ok := apuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(archiveprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if apuo.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.ChannelsTable,
+			Columns: []string{archiveprofile.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apuo.mutation.RemovedChannelsIDs(); len(nodes) > 0 && !apuo.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.ChannelsTable,
+			Columns: []string{archiveprofile.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apuo.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.ChannelsTable,
+			Columns: []string{archiveprofile.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if apuo.mutation.LiveCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.LiveTable,
+			Columns: []string{archiveprofile.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apuo.mutation.RemovedLiveIDs(); len(nodes) > 0 && !apuo.mutation.LiveCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.LiveTable,
+			Columns: []string{archiveprofile.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apuo.mutation.LiveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.LiveTable,
+			Columns: []string{archiveprofile.LiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(live.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if apuo.mutation.VodsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.VodsTable,
+			Columns: []string{archiveprofile.VodsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apuo.mutation.RemovedVodsIDs(); len(nodes) > 0 && !apuo.mutation.VodsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.VodsTable,
+			Columns: []string{archiveprofile.VodsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := apuo.mutation.VodsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   archiveprofile.VodsTable,
+			Columns: []string{archiveprofile.VodsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ArchiveProfile{config: apuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, apuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archiveprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	apuo.mutation.done = true
+	return _node, nil
+}
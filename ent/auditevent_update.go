@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/auditevent"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// AuditEventUpdate is the builder for updating AuditEvent entities.
+type AuditEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (aeu *AuditEventUpdate) Where(ps ...predicate.AuditEvent) *AuditEventUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// SetAction sets the "action" field.
+func (aeu *AuditEventUpdate) SetAction(ua utils.AuditAction) *AuditEventUpdate {
+	aeu.mutation.SetAction(ua)
+	return aeu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableAction(ua *utils.AuditAction) *AuditEventUpdate {
+	if ua != nil {
+		aeu.SetAction(*ua)
+	}
+	return aeu
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aeu *AuditEventUpdate) SetEntityType(s string) *AuditEventUpdate {
+	aeu.mutation.SetEntityType(s)
+	return aeu
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableEntityType(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetEntityType(*s)
+	}
+	return aeu
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aeu *AuditEventUpdate) SetEntityID(s string) *AuditEventUpdate {
+	aeu.mutation.SetEntityID(s)
+	return aeu
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableEntityID(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetEntityID(*s)
+	}
+	return aeu
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (aeu *AuditEventUpdate) ClearEntityID() *AuditEventUpdate {
+	aeu.mutation.ClearEntityID()
+	return aeu
+}
+
+// SetUserID sets the "user_id" field.
+func (aeu *AuditEventUpdate) SetUserID(u uuid.UUID) *AuditEventUpdate {
+	aeu.mutation.SetUserID(u)
+	return aeu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableUserID(u *uuid.UUID) *AuditEventUpdate {
+	if u != nil {
+		aeu.SetUserID(*u)
+	}
+	return aeu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (aeu *AuditEventUpdate) ClearUserID() *AuditEventUpdate {
+	aeu.mutation.ClearUserID()
+	return aeu
+}
+
+// SetUsername sets the "username" field.
+func (aeu *AuditEventUpdate) SetUsername(s string) *AuditEventUpdate {
+	aeu.mutation.SetUsername(s)
+	return aeu
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableUsername(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetUsername(*s)
+	}
+	return aeu
+}
+
+// ClearUsername clears the value of the "username" field.
+func (aeu *AuditEventUpdate) ClearUsername() *AuditEventUpdate {
+	aeu.mutation.ClearUsername()
+	return aeu
+}
+
+// SetIP sets the "ip" field.
+func (aeu *AuditEventUpdate) SetIP(s string) *AuditEventUpdate {
+	aeu.mutation.SetIP(s)
+	return aeu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aeu *AuditEventUpdate) SetNillableIP(s *string) *AuditEventUpdate {
+	if s != nil {
+		aeu.SetIP(*s)
+	}
+	return aeu
+}
+
+// ClearIP clears the value of the "ip" field.
+func (aeu *AuditEventUpdate) ClearIP() *AuditEventUpdate {
+	aeu.mutation.ClearIP()
+	return aeu
+}
+
+// SetDetails sets the "details" field.
+func (aeu *AuditEventUpdate) SetDetails(m map[string]interface{}) *AuditEventUpdate {
+	aeu.mutation.SetDetails(m)
+	return aeu
+}
+
+// ClearDetails clears the value of the "details" field.
+func (aeu *AuditEventUpdate) ClearDetails() *AuditEventUpdate {
+	aeu.mutation.ClearDetails()
+	return aeu
+}
+
+// SetChanges sets the "changes" field.
+func (aeu *AuditEventUpdate) SetChanges(mc map[string]utils.AuditChange) *AuditEventUpdate {
+	aeu.mutation.SetChanges(mc)
+	return aeu
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (aeu *AuditEventUpdate) ClearChanges() *AuditEventUpdate {
+	aeu.mutation.ClearChanges()
+	return aeu
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aeu *AuditEventUpdate) Mutation() *AuditEventMutation {
+	return aeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AuditEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aeu.sqlSave, aeu.mutation, aeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AuditEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AuditEventUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AuditEventUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeu *AuditEventUpdate) check() error {
+	if v, ok := aeu.mutation.Action(); ok {
+		if err := auditevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aeu *AuditEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeUUID))
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeu.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := aeu.mutation.EntityType(); ok {
+		_spec.SetField(auditevent.FieldEntityType, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.EntityID(); ok {
+		_spec.SetField(auditevent.FieldEntityID, field.TypeString, value)
+	}
+	if aeu.mutation.EntityIDCleared() {
+		_spec.ClearField(auditevent.FieldEntityID, field.TypeString)
+	}
+	if value, ok := aeu.mutation.UserID(); ok {
+		_spec.SetField(auditevent.FieldUserID, field.TypeUUID, value)
+	}
+	if aeu.mutation.UserIDCleared() {
+		_spec.ClearField(auditevent.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := aeu.mutation.Username(); ok {
+		_spec.SetField(auditevent.FieldUsername, field.TypeString, value)
+	}
+	if aeu.mutation.UsernameCleared() {
+		_spec.ClearField(auditevent.FieldUsername, field.TypeString)
+	}
+	if value, ok := aeu.mutation.IP(); ok {
+		_spec.SetField(auditevent.FieldIP, field.TypeString, value)
+	}
+	if aeu.mutation.IPCleared() {
+		_spec.ClearField(auditevent.FieldIP, field.TypeString)
+	}
+	if value, ok := aeu.mutation.Details(); ok {
+		_spec.SetField(auditevent.FieldDetails, field.TypeJSON, value)
+	}
+	if aeu.mutation.DetailsCleared() {
+		_spec.ClearField(auditevent.FieldDetails, field.TypeJSON)
+	}
+	if value, ok := aeu.mutation.Changes(); ok {
+		_spec.SetField(auditevent.FieldChanges, field.TypeJSON, value)
+	}
+	if aeu.mutation.ChangesCleared() {
+		_spec.ClearField(auditevent.FieldChanges, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aeu.mutation.done = true
+	return n, nil
+}
+
+// AuditEventUpdateOne is the builder for updating a single AuditEvent entity.
+type AuditEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditEventMutation
+}
+
+// SetAction sets the "action" field.
+func (aeuo *AuditEventUpdateOne) SetAction(ua utils.AuditAction) *AuditEventUpdateOne {
+	aeuo.mutation.SetAction(ua)
+	return aeuo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableAction(ua *utils.AuditAction) *AuditEventUpdateOne {
+	if ua != nil {
+		aeuo.SetAction(*ua)
+	}
+	return aeuo
+}
+
+// SetEntityType sets the "entity_type" field.
+func (aeuo *AuditEventUpdateOne) SetEntityType(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetEntityType(s)
+	return aeuo
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableEntityType(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetEntityType(*s)
+	}
+	return aeuo
+}
+
+// SetEntityID sets the "entity_id" field.
+func (aeuo *AuditEventUpdateOne) SetEntityID(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetEntityID(s)
+	return aeuo
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableEntityID(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetEntityID(*s)
+	}
+	return aeuo
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (aeuo *AuditEventUpdateOne) ClearEntityID() *AuditEventUpdateOne {
+	aeuo.mutation.ClearEntityID()
+	return aeuo
+}
+
+// SetUserID sets the "user_id" field.
+func (aeuo *AuditEventUpdateOne) SetUserID(u uuid.UUID) *AuditEventUpdateOne {
+	aeuo.mutation.SetUserID(u)
+	return aeuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableUserID(u *uuid.UUID) *AuditEventUpdateOne {
+	if u != nil {
+		aeuo.SetUserID(*u)
+	}
+	return aeuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (aeuo *AuditEventUpdateOne) ClearUserID() *AuditEventUpdateOne {
+	aeuo.mutation.ClearUserID()
+	return aeuo
+}
+
+// SetUsername sets the "username" field.
+func (aeuo *AuditEventUpdateOne) SetUsername(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetUsername(s)
+	return aeuo
+}
+
+// SetNillableUsername sets the "username" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableUsername(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetUsername(*s)
+	}
+	return aeuo
+}
+
+// ClearUsername clears the value of the "username" field.
+func (aeuo *AuditEventUpdateOne) ClearUsername() *AuditEventUpdateOne {
+	aeuo.mutation.ClearUsername()
+	return aeuo
+}
+
+// SetIP sets the "ip" field.
+func (aeuo *AuditEventUpdateOne) SetIP(s string) *AuditEventUpdateOne {
+	aeuo.mutation.SetIP(s)
+	return aeuo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aeuo *AuditEventUpdateOne) SetNillableIP(s *string) *AuditEventUpdateOne {
+	if s != nil {
+		aeuo.SetIP(*s)
+	}
+	return aeuo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (aeuo *AuditEventUpdateOne) ClearIP() *AuditEventUpdateOne {
+	aeuo.mutation.ClearIP()
+	return aeuo
+}
+
+// SetDetails sets the "details" field.
+func (aeuo *AuditEventUpdateOne) SetDetails(m map[string]interface{}) *AuditEventUpdateOne {
+	aeuo.mutation.SetDetails(m)
+	return aeuo
+}
+
+// ClearDetails clears the value of the "details" field.
+func (aeuo *AuditEventUpdateOne) ClearDetails() *AuditEventUpdateOne {
+	aeuo.mutation.ClearDetails()
+	return aeuo
+}
+
+// SetChanges sets the "changes" field.
+func (aeuo *AuditEventUpdateOne) SetChanges(mc map[string]utils.AuditChange) *AuditEventUpdateOne {
+	aeuo.mutation.SetChanges(mc)
+	return aeuo
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (aeuo *AuditEventUpdateOne) ClearChanges() *AuditEventUpdateOne {
+	aeuo.mutation.ClearChanges()
+	return aeuo
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aeuo *AuditEventUpdateOne) Mutation() *AuditEventMutation {
+	return aeuo.mutation
+}
+
+// Where appends a list predicates to the AuditEventUpdate builder.
+func (aeuo *AuditEventUpdateOne) Where(ps ...predicate.AuditEvent) *AuditEventUpdateOne {
+	aeuo.mutation.Where(ps...)
+	return aeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *AuditEventUpdateOne) Select(field string, fields ...string) *AuditEventUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated AuditEvent entity.
+func (aeuo *AuditEventUpdateOne) Save(ctx context.Context) (*AuditEvent, error) {
+	return withHooks(ctx, aeuo.sqlSave, aeuo.mutation, aeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AuditEventUpdateOne) SaveX(ctx context.Context) *AuditEvent {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AuditEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AuditEventUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeuo *AuditEventUpdateOne) check() error {
+	if v, ok := aeuo.mutation.Action(); ok {
+		if err := auditevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditEvent.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aeuo *AuditEventUpdateOne) sqlSave(ctx context.Context) (_node *AuditEvent, err error) {
+	if err := aeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(auditevent.Table, auditevent.Columns, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeUUID))
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditevent.FieldID)
+		for _, f := range fields {
+			if !auditevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeuo.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := aeuo.mutation.EntityType(); ok {
+		_spec.SetField(auditevent.FieldEntityType, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.EntityID(); ok {
+		_spec.SetField(auditevent.FieldEntityID, field.TypeString, value)
+	}
+	if aeuo.mutation.EntityIDCleared() {
+		_spec.ClearField(auditevent.FieldEntityID, field.TypeString)
+	}
+	if value, ok := aeuo.mutation.UserID(); ok {
+		_spec.SetField(auditevent.FieldUserID, field.TypeUUID, value)
+	}
+	if aeuo.mutation.UserIDCleared() {
+		_spec.ClearField(auditevent.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := aeuo.mutation.Username(); ok {
+		_spec.SetField(auditevent.FieldUsername, field.TypeString, value)
+	}
+	if aeuo.mutation.UsernameCleared() {
+		_spec.ClearField(auditevent.FieldUsername, field.TypeString)
+	}
+	if value, ok := aeuo.mutation.IP(); ok {
+		_spec.SetField(auditevent.FieldIP, field.TypeString, value)
+	}
+	if aeuo.mutation.IPCleared() {
+		_spec.ClearField(auditevent.FieldIP, field.TypeString)
+	}
+	if value, ok := aeuo.mutation.Details(); ok {
+		_spec.SetField(auditevent.FieldDetails, field.TypeJSON, value)
+	}
+	if aeuo.mutation.DetailsCleared() {
+		_spec.ClearField(auditevent.FieldDetails, field.TypeJSON)
+	}
+	if value, ok := aeuo.mutation.Changes(); ok {
+		_spec.SetField(auditevent.FieldChanges, field.TypeJSON, value)
+	}
+	if aeuo.mutation.ChangesCleared() {
+		_spec.ClearField(auditevent.FieldChanges, field.TypeJSON)
+	}
+	_node = &AuditEvent{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aeuo.mutation.done = true
+	return _node, nil
+}
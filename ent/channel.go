@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,7 +11,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // Channel is the model entity for the Channel schema.
@@ -18,6 +21,8 @@ type Channel struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The time the entity was moved to the trash.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// The external ID of the channel.
 	ExtID string `json:"ext_id,omitempty"`
 	// Name holds the value of the "name" field.
@@ -26,18 +31,42 @@ type Channel struct {
 	DisplayName string `json:"display_name,omitempty"`
 	// ImagePath holds the value of the "image_path" field.
 	ImagePath string `json:"image_path,omitempty"`
+	// The platform the channel is from, takes an enum.
+	Platform utils.VodPlatform `json:"platform,omitempty"`
 	// Retention holds the value of the "retention" field.
 	Retention bool `json:"retention,omitempty"`
-	// RetentionDays holds the value of the "retention_days" field.
+	// Prune videos archived more than this many days ago. 0 disables the rule.
 	RetentionDays int64 `json:"retention_days,omitempty"`
+	// Retention days by video type overriding retention_days. 0 never prunes the type by age.
+	RetentionTypeDays map[utils.VodType]int64 `json:"retention_type_days,omitempty"`
+	// Prune videos that are not in this many newest videos. 0 disables the rule.
+	RetentionKeepLast int64 `json:"retention_keep_last,omitempty"`
+	// Prune the oldest videos while the videos of the channel use more than this many GB. 0 disables the rule.
+	RetentionMaxSizeGB int64 `json:"retention_max_size_gb,omitempty"`
+	// Whether videos in a playlist are never pruned.
+	RetentionProtectPlaylists bool `json:"retention_protect_playlists,omitempty"`
+	// Whether videos watched by a user are never pruned.
+	RetentionProtectWatched bool `json:"retention_protect_watched,omitempty"`
+	// What is deleted from pruned videos, takes an enum.
+	RetentionAction utils.RetentionAction `json:"retention_action,omitempty"`
+	// Whether videos are moved to the cold storage root by the lifecycle rules.
+	ColdStorage bool `json:"cold_storage,omitempty"`
+	// Move videos archived more than this many days ago. 0 disables the rule.
+	ColdStorageDays int64 `json:"cold_storage_days,omitempty"`
+	// Move videos not watched in this many days. 0 disables the rule.
+	ColdStorageUnwatchedDays int64 `json:"cold_storage_unwatched_days,omitempty"`
+	// Whether only admins and users granted a channel permission can see the channel and its videos.
+	Restricted bool `json:"restricted,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ChannelQuery when eager-loading is set.
-	Edges        ChannelEdges `json:"edges"`
-	selectValues sql.SelectValues
+	Edges                              ChannelEdges `json:"edges"`
+	archive_profile_channels           *uuid.UUID
+	notification_subscription_channels *uuid.UUID
+	selectValues                       sql.SelectValues
 }
 
 // ChannelEdges holds the relations/edges for other nodes in the graph.
@@ -46,9 +75,13 @@ type ChannelEdges struct {
 	Vods []*Vod `json:"vods,omitempty"`
 	// Live holds the value of the live edge.
 	Live []*Live `json:"live,omitempty"`
+	// ArchiveProfile holds the value of the archive_profile edge.
+	ArchiveProfile *ArchiveProfile `json:"archive_profile,omitempty"`
+	// Permissions holds the value of the permissions edge.
+	Permissions []*ChannelPermission `json:"permissions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // VodsOrErr returns the Vods value or an error if the edge
@@ -69,21 +102,47 @@ func (e ChannelEdges) LiveOrErr() ([]*Live, error) {
 	return nil, &NotLoadedError{edge: "live"}
 }
 
+// ArchiveProfileOrErr returns the ArchiveProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChannelEdges) ArchiveProfileOrErr() (*ArchiveProfile, error) {
+	if e.ArchiveProfile != nil {
+		return e.ArchiveProfile, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: archiveprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "archive_profile"}
+}
+
+// PermissionsOrErr returns the Permissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ChannelEdges) PermissionsOrErr() ([]*ChannelPermission, error) {
+	if e.loadedTypes[3] {
+		return e.Permissions, nil
+	}
+	return nil, &NotLoadedError{edge: "permissions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Channel) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case channel.FieldRetention:
+		case channel.FieldRetentionTypeDays:
+			values[i] = new([]byte)
+		case channel.FieldRetention, channel.FieldRetentionProtectPlaylists, channel.FieldRetentionProtectWatched, channel.FieldColdStorage, channel.FieldRestricted:
 			values[i] = new(sql.NullBool)
-		case channel.FieldRetentionDays:
+		case channel.FieldRetentionDays, channel.FieldRetentionKeepLast, channel.FieldRetentionMaxSizeGB, channel.FieldColdStorageDays, channel.FieldColdStorageUnwatchedDays:
 			values[i] = new(sql.NullInt64)
-		case channel.FieldExtID, channel.FieldName, channel.FieldDisplayName, channel.FieldImagePath:
+		case channel.FieldExtID, channel.FieldName, channel.FieldDisplayName, channel.FieldImagePath, channel.FieldPlatform, channel.FieldRetentionAction:
 			values[i] = new(sql.NullString)
-		case channel.FieldUpdatedAt, channel.FieldCreatedAt:
+		case channel.FieldDeletedAt, channel.FieldUpdatedAt, channel.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case channel.FieldID:
 			values[i] = new(uuid.UUID)
+		case channel.ForeignKeys[0]: // archive_profile_channels
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case channel.ForeignKeys[1]: // notification_subscription_channels
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -105,6 +164,13 @@ func (c *Channel) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				c.ID = *value
 			}
+		case channel.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
+			}
 		case channel.FieldExtID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field ext_id", values[i])
@@ -129,6 +195,12 @@ func (c *Channel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.ImagePath = value.String
 			}
+		case channel.FieldPlatform:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field platform", values[i])
+			} else if value.Valid {
+				c.Platform = utils.VodPlatform(value.String)
+			}
 		case channel.FieldRetention:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field retention", values[i])
@@ -141,6 +213,68 @@ func (c *Channel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.RetentionDays = value.Int64
 			}
+		case channel.FieldRetentionTypeDays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_type_days", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.RetentionTypeDays); err != nil {
+					return fmt.Errorf("unmarshal field retention_type_days: %w", err)
+				}
+			}
+		case channel.FieldRetentionKeepLast:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_keep_last", values[i])
+			} else if value.Valid {
+				c.RetentionKeepLast = value.Int64
+			}
+		case channel.FieldRetentionMaxSizeGB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_max_size_gb", values[i])
+			} else if value.Valid {
+				c.RetentionMaxSizeGB = value.Int64
+			}
+		case channel.FieldRetentionProtectPlaylists:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_protect_playlists", values[i])
+			} else if value.Valid {
+				c.RetentionProtectPlaylists = value.Bool
+			}
+		case channel.FieldRetentionProtectWatched:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_protect_watched", values[i])
+			} else if value.Valid {
+				c.RetentionProtectWatched = value.Bool
+			}
+		case channel.FieldRetentionAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_action", values[i])
+			} else if value.Valid {
+				c.RetentionAction = utils.RetentionAction(value.String)
+			}
+		case channel.FieldColdStorage:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cold_storage", values[i])
+			} else if value.Valid {
+				c.ColdStorage = value.Bool
+			}
+		case channel.FieldColdStorageDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cold_storage_days", values[i])
+			} else if value.Valid {
+				c.ColdStorageDays = value.Int64
+			}
+		case channel.FieldColdStorageUnwatchedDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cold_storage_unwatched_days", values[i])
+			} else if value.Valid {
+				c.ColdStorageUnwatchedDays = value.Int64
+			}
+		case channel.FieldRestricted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field restricted", values[i])
+			} else if value.Valid {
+				c.Restricted = value.Bool
+			}
 		case channel.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -153,6 +287,20 @@ func (c *Channel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.CreatedAt = value.Time
 			}
+		case channel.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field archive_profile_channels", values[i])
+			} else if value.Valid {
+				c.archive_profile_channels = new(uuid.UUID)
+				*c.archive_profile_channels = *value.S.(*uuid.UUID)
+			}
+		case channel.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field notification_subscription_channels", values[i])
+			} else if value.Valid {
+				c.notification_subscription_channels = new(uuid.UUID)
+				*c.notification_subscription_channels = *value.S.(*uuid.UUID)
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -176,6 +324,16 @@ func (c *Channel) QueryLive() *LiveQuery {
 	return NewChannelClient(c.config).QueryLive(c)
 }
 
+// QueryArchiveProfile queries the "archive_profile" edge of the Channel entity.
+func (c *Channel) QueryArchiveProfile() *ArchiveProfileQuery {
+	return NewChannelClient(c.config).QueryArchiveProfile(c)
+}
+
+// QueryPermissions queries the "permissions" edge of the Channel entity.
+func (c *Channel) QueryPermissions() *ChannelPermissionQuery {
+	return NewChannelClient(c.config).QueryPermissions(c)
+}
+
 // Update returns a builder for updating this Channel.
 // Note that you need to call Channel.Unwrap() before calling this method if this Channel
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -199,6 +357,11 @@ func (c *Channel) String() string {
 	var builder strings.Builder
 	builder.WriteString("Channel(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("ext_id=")
 	builder.WriteString(c.ExtID)
 	builder.WriteString(", ")
@@ -211,12 +374,45 @@ func (c *Channel) String() string {
 	builder.WriteString("image_path=")
 	builder.WriteString(c.ImagePath)
 	builder.WriteString(", ")
+	builder.WriteString("platform=")
+	builder.WriteString(fmt.Sprintf("%v", c.Platform))
+	builder.WriteString(", ")
 	builder.WriteString("retention=")
 	builder.WriteString(fmt.Sprintf("%v", c.Retention))
 	builder.WriteString(", ")
 	builder.WriteString("retention_days=")
 	builder.WriteString(fmt.Sprintf("%v", c.RetentionDays))
 	builder.WriteString(", ")
+	builder.WriteString("retention_type_days=")
+	builder.WriteString(fmt.Sprintf("%v", c.RetentionTypeDays))
+	builder.WriteString(", ")
+	builder.WriteString("retention_keep_last=")
+	builder.WriteString(fmt.Sprintf("%v", c.RetentionKeepLast))
+	builder.WriteString(", ")
+	builder.WriteString("retention_max_size_gb=")
+	builder.WriteString(fmt.Sprintf("%v", c.RetentionMaxSizeGB))
+	builder.WriteString(", ")
+	builder.WriteString("retention_protect_playlists=")
+	builder.WriteString(fmt.Sprintf("%v", c.RetentionProtectPlaylists))
+	builder.WriteString(", ")
+	builder.WriteString("retention_protect_watched=")
+	builder.WriteString(fmt.Sprintf("%v", c.RetentionProtectWatched))
+	builder.WriteString(", ")
+	builder.WriteString("retention_action=")
+	builder.WriteString(fmt.Sprintf("%v", c.RetentionAction))
+	builder.WriteString(", ")
+	builder.WriteString("cold_storage=")
+	builder.WriteString(fmt.Sprintf("%v", c.ColdStorage))
+	builder.WriteString(", ")
+	builder.WriteString("cold_storage_days=")
+	builder.WriteString(fmt.Sprintf("%v", c.ColdStorageDays))
+	builder.WriteString(", ")
+	builder.WriteString("cold_storage_unwatched_days=")
+	builder.WriteString(fmt.Sprintf("%v", c.ColdStorageUnwatchedDays))
+	builder.WriteString(", ")
+	builder.WriteString("restricted=")
+	builder.WriteString(fmt.Sprintf("%v", c.Restricted))
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,11 +3,14 @@
 package channel
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 const (
@@ -15,6 +18,8 @@ const (
 	Label = "channel"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldExtID holds the string denoting the ext_id field in the database.
 	FieldExtID = "ext_id"
 	// FieldName holds the string denoting the name field in the database.
@@ -23,10 +28,32 @@ const (
 	FieldDisplayName = "display_name"
 	// FieldImagePath holds the string denoting the image_path field in the database.
 	FieldImagePath = "image_path"
+	// FieldPlatform holds the string denoting the platform field in the database.
+	FieldPlatform = "platform"
 	// FieldRetention holds the string denoting the retention field in the database.
 	FieldRetention = "retention"
 	// FieldRetentionDays holds the string denoting the retention_days field in the database.
 	FieldRetentionDays = "retention_days"
+	// FieldRetentionTypeDays holds the string denoting the retention_type_days field in the database.
+	FieldRetentionTypeDays = "retention_type_days"
+	// FieldRetentionKeepLast holds the string denoting the retention_keep_last field in the database.
+	FieldRetentionKeepLast = "retention_keep_last"
+	// FieldRetentionMaxSizeGB holds the string denoting the retention_max_size_gb field in the database.
+	FieldRetentionMaxSizeGB = "retention_max_size_gb"
+	// FieldRetentionProtectPlaylists holds the string denoting the retention_protect_playlists field in the database.
+	FieldRetentionProtectPlaylists = "retention_protect_playlists"
+	// FieldRetentionProtectWatched holds the string denoting the retention_protect_watched field in the database.
+	FieldRetentionProtectWatched = "retention_protect_watched"
+	// FieldRetentionAction holds the string denoting the retention_action field in the database.
+	FieldRetentionAction = "retention_action"
+	// FieldColdStorage holds the string denoting the cold_storage field in the database.
+	FieldColdStorage = "cold_storage"
+	// FieldColdStorageDays holds the string denoting the cold_storage_days field in the database.
+	FieldColdStorageDays = "cold_storage_days"
+	// FieldColdStorageUnwatchedDays holds the string denoting the cold_storage_unwatched_days field in the database.
+	FieldColdStorageUnwatchedDays = "cold_storage_unwatched_days"
+	// FieldRestricted holds the string denoting the restricted field in the database.
+	FieldRestricted = "restricted"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -35,6 +62,10 @@ const (
 	EdgeVods = "vods"
 	// EdgeLive holds the string denoting the live edge name in mutations.
 	EdgeLive = "live"
+	// EdgeArchiveProfile holds the string denoting the archive_profile edge name in mutations.
+	EdgeArchiveProfile = "archive_profile"
+	// EdgePermissions holds the string denoting the permissions edge name in mutations.
+	EdgePermissions = "permissions"
 	// Table holds the table name of the channel in the database.
 	Table = "channels"
 	// VodsTable is the table that holds the vods relation/edge.
@@ -51,21 +82,54 @@ const (
 	LiveInverseTable = "lives"
 	// LiveColumn is the table column denoting the live relation/edge.
 	LiveColumn = "channel_live"
+	// ArchiveProfileTable is the table that holds the archive_profile relation/edge.
+	ArchiveProfileTable = "channels"
+	// ArchiveProfileInverseTable is the table name for the ArchiveProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "archiveprofile" package.
+	ArchiveProfileInverseTable = "archive_profiles"
+	// ArchiveProfileColumn is the table column denoting the archive_profile relation/edge.
+	ArchiveProfileColumn = "archive_profile_channels"
+	// PermissionsTable is the table that holds the permissions relation/edge.
+	PermissionsTable = "channel_permissions"
+	// PermissionsInverseTable is the table name for the ChannelPermission entity.
+	// It exists in this package in order to avoid circular dependency with the "channelpermission" package.
+	PermissionsInverseTable = "channel_permissions"
+	// PermissionsColumn is the table column denoting the permissions relation/edge.
+	PermissionsColumn = "channel_permissions"
 )
 
 // Columns holds all SQL columns for channel fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
 	FieldExtID,
 	FieldName,
 	FieldDisplayName,
 	FieldImagePath,
+	FieldPlatform,
 	FieldRetention,
 	FieldRetentionDays,
+	FieldRetentionTypeDays,
+	FieldRetentionKeepLast,
+	FieldRetentionMaxSizeGB,
+	FieldRetentionProtectPlaylists,
+	FieldRetentionProtectWatched,
+	FieldRetentionAction,
+	FieldColdStorage,
+	FieldColdStorageDays,
+	FieldColdStorageUnwatchedDays,
+	FieldRestricted,
 	FieldUpdatedAt,
 	FieldCreatedAt,
 }
 
+// ForeignKeys holds the SQL foreign-keys that are owned by the "channels"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"archive_profile_channels",
+	"notification_subscription_channels",
+}
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -73,12 +137,31 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/zibbp/ganymede/ent/runtime"
 var (
+	Interceptors [1]ent.Interceptor
 	// DefaultRetention holds the default value on creation for the "retention" field.
 	DefaultRetention bool
+	// DefaultRetentionProtectPlaylists holds the default value on creation for the "retention_protect_playlists" field.
+	DefaultRetentionProtectPlaylists bool
+	// DefaultRetentionProtectWatched holds the default value on creation for the "retention_protect_watched" field.
+	DefaultRetentionProtectWatched bool
+	// DefaultColdStorage holds the default value on creation for the "cold_storage" field.
+	DefaultColdStorage bool
+	// DefaultRestricted holds the default value on creation for the "restricted" field.
+	DefaultRestricted bool
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
@@ -89,6 +172,30 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+const DefaultPlatform utils.VodPlatform = "twitch"
+
+// PlatformValidator is a validator for the "platform" field enum values. It is called by the builders before save.
+func PlatformValidator(pl utils.VodPlatform) error {
+	switch pl {
+	case "twitch", "youtube":
+		return nil
+	default:
+		return fmt.Errorf("channel: invalid enum value for platform field: %q", pl)
+	}
+}
+
+const DefaultRetentionAction utils.RetentionAction = "delete"
+
+// RetentionActionValidator is a validator for the "retention_action" field enum values. It is called by the builders before save.
+func RetentionActionValidator(ra utils.RetentionAction) error {
+	switch ra {
+	case "delete", "delete_video", "delete_chat_video":
+		return nil
+	default:
+		return fmt.Errorf("channel: invalid enum value for retention_action field: %q", ra)
+	}
+}
+
 // OrderOption defines the ordering options for the Channel queries.
 type OrderOption func(*sql.Selector)
 
@@ -97,6 +204,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByExtID orders the results by the ext_id field.
 func ByExtID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExtID, opts...).ToFunc()
@@ -117,6 +229,11 @@ func ByImagePath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldImagePath, opts...).ToFunc()
 }
 
+// ByPlatform orders the results by the platform field.
+func ByPlatform(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlatform, opts...).ToFunc()
+}
+
 // ByRetention orders the results by the retention field.
 func ByRetention(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRetention, opts...).ToFunc()
@@ -127,6 +244,51 @@ func ByRetentionDays(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRetentionDays, opts...).ToFunc()
 }
 
+// ByRetentionKeepLast orders the results by the retention_keep_last field.
+func ByRetentionKeepLast(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionKeepLast, opts...).ToFunc()
+}
+
+// ByRetentionMaxSizeGB orders the results by the retention_max_size_gb field.
+func ByRetentionMaxSizeGB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionMaxSizeGB, opts...).ToFunc()
+}
+
+// ByRetentionProtectPlaylists orders the results by the retention_protect_playlists field.
+func ByRetentionProtectPlaylists(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionProtectPlaylists, opts...).ToFunc()
+}
+
+// ByRetentionProtectWatched orders the results by the retention_protect_watched field.
+func ByRetentionProtectWatched(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionProtectWatched, opts...).ToFunc()
+}
+
+// ByRetentionAction orders the results by the retention_action field.
+func ByRetentionAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionAction, opts...).ToFunc()
+}
+
+// ByColdStorage orders the results by the cold_storage field.
+func ByColdStorage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColdStorage, opts...).ToFunc()
+}
+
+// ByColdStorageDays orders the results by the cold_storage_days field.
+func ByColdStorageDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColdStorageDays, opts...).ToFunc()
+}
+
+// ByColdStorageUnwatchedDays orders the results by the cold_storage_unwatched_days field.
+func ByColdStorageUnwatchedDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColdStorageUnwatchedDays, opts...).ToFunc()
+}
+
+// ByRestricted orders the results by the restricted field.
+func ByRestricted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestricted, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -164,6 +326,27 @@ func ByLive(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newLiveStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByArchiveProfileField orders the results by archive_profile field.
+func ByArchiveProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArchiveProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPermissionsCount orders the results by permissions count.
+func ByPermissionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPermissionsStep(), opts...)
+	}
+}
+
+// ByPermissions orders the results by permissions terms.
+func ByPermissions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPermissionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newVodsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -178,3 +361,17 @@ func newLiveStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, LiveTable, LiveColumn),
 	)
 }
+func newArchiveProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArchiveProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ArchiveProfileTable, ArchiveProfileColumn),
+	)
+}
+func newPermissionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PermissionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PermissionsTable, PermissionsColumn),
+	)
+}
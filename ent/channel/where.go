@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ID filters vertices based on their ID field.
@@ -56,6 +57,11 @@ func IDLTE(id uuid.UUID) predicate.Channel {
 	return predicate.Channel(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // ExtID applies equality check predicate on the "ext_id" field. It's identical to ExtIDEQ.
 func ExtID(v string) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldExtID, v))
@@ -86,6 +92,46 @@ func RetentionDays(v int64) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldRetentionDays, v))
 }
 
+// RetentionKeepLast applies equality check predicate on the "retention_keep_last" field. It's identical to RetentionKeepLastEQ.
+func RetentionKeepLast(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionKeepLast, v))
+}
+
+// RetentionMaxSizeGB applies equality check predicate on the "retention_max_size_gb" field. It's identical to RetentionMaxSizeGBEQ.
+func RetentionMaxSizeGB(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionMaxSizeGB, v))
+}
+
+// RetentionProtectPlaylists applies equality check predicate on the "retention_protect_playlists" field. It's identical to RetentionProtectPlaylistsEQ.
+func RetentionProtectPlaylists(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionProtectPlaylists, v))
+}
+
+// RetentionProtectWatched applies equality check predicate on the "retention_protect_watched" field. It's identical to RetentionProtectWatchedEQ.
+func RetentionProtectWatched(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionProtectWatched, v))
+}
+
+// ColdStorage applies equality check predicate on the "cold_storage" field. It's identical to ColdStorageEQ.
+func ColdStorage(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldColdStorage, v))
+}
+
+// ColdStorageDays applies equality check predicate on the "cold_storage_days" field. It's identical to ColdStorageDaysEQ.
+func ColdStorageDays(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldColdStorageDays, v))
+}
+
+// ColdStorageUnwatchedDays applies equality check predicate on the "cold_storage_unwatched_days" field. It's identical to ColdStorageUnwatchedDaysEQ.
+func ColdStorageUnwatchedDays(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldColdStorageUnwatchedDays, v))
+}
+
+// Restricted applies equality check predicate on the "restricted" field. It's identical to RestrictedEQ.
+func Restricted(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRestricted, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldUpdatedAt, v))
@@ -96,6 +142,56 @@ func CreatedAt(v time.Time) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Channel {
+	return predicate.Channel(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Channel {
+	return predicate.Channel(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Channel {
+	return predicate.Channel(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // ExtIDEQ applies the EQ predicate on the "ext_id" field.
 func ExtIDEQ(v string) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldExtID, v))
@@ -366,6 +462,36 @@ func ImagePathContainsFold(v string) predicate.Channel {
 	return predicate.Channel(sql.FieldContainsFold(FieldImagePath, v))
 }
 
+// PlatformEQ applies the EQ predicate on the "platform" field.
+func PlatformEQ(v utils.VodPlatform) predicate.Channel {
+	vc := v
+	return predicate.Channel(sql.FieldEQ(FieldPlatform, vc))
+}
+
+// PlatformNEQ applies the NEQ predicate on the "platform" field.
+func PlatformNEQ(v utils.VodPlatform) predicate.Channel {
+	vc := v
+	return predicate.Channel(sql.FieldNEQ(FieldPlatform, vc))
+}
+
+// PlatformIn applies the In predicate on the "platform" field.
+func PlatformIn(vs ...utils.VodPlatform) predicate.Channel {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Channel(sql.FieldIn(FieldPlatform, v...))
+}
+
+// PlatformNotIn applies the NotIn predicate on the "platform" field.
+func PlatformNotIn(vs ...utils.VodPlatform) predicate.Channel {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Channel(sql.FieldNotIn(FieldPlatform, v...))
+}
+
 // RetentionEQ applies the EQ predicate on the "retention" field.
 func RetentionEQ(v bool) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldRetention, v))
@@ -426,6 +552,286 @@ func RetentionDaysNotNil() predicate.Channel {
 	return predicate.Channel(sql.FieldNotNull(FieldRetentionDays))
 }
 
+// RetentionTypeDaysIsNil applies the IsNil predicate on the "retention_type_days" field.
+func RetentionTypeDaysIsNil() predicate.Channel {
+	return predicate.Channel(sql.FieldIsNull(FieldRetentionTypeDays))
+}
+
+// RetentionTypeDaysNotNil applies the NotNil predicate on the "retention_type_days" field.
+func RetentionTypeDaysNotNil() predicate.Channel {
+	return predicate.Channel(sql.FieldNotNull(FieldRetentionTypeDays))
+}
+
+// RetentionKeepLastEQ applies the EQ predicate on the "retention_keep_last" field.
+func RetentionKeepLastEQ(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionKeepLast, v))
+}
+
+// RetentionKeepLastNEQ applies the NEQ predicate on the "retention_keep_last" field.
+func RetentionKeepLastNEQ(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldRetentionKeepLast, v))
+}
+
+// RetentionKeepLastIn applies the In predicate on the "retention_keep_last" field.
+func RetentionKeepLastIn(vs ...int64) predicate.Channel {
+	return predicate.Channel(sql.FieldIn(FieldRetentionKeepLast, vs...))
+}
+
+// RetentionKeepLastNotIn applies the NotIn predicate on the "retention_keep_last" field.
+func RetentionKeepLastNotIn(vs ...int64) predicate.Channel {
+	return predicate.Channel(sql.FieldNotIn(FieldRetentionKeepLast, vs...))
+}
+
+// RetentionKeepLastGT applies the GT predicate on the "retention_keep_last" field.
+func RetentionKeepLastGT(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldGT(FieldRetentionKeepLast, v))
+}
+
+// RetentionKeepLastGTE applies the GTE predicate on the "retention_keep_last" field.
+func RetentionKeepLastGTE(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldGTE(FieldRetentionKeepLast, v))
+}
+
+// RetentionKeepLastLT applies the LT predicate on the "retention_keep_last" field.
+func RetentionKeepLastLT(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldLT(FieldRetentionKeepLast, v))
+}
+
+// RetentionKeepLastLTE applies the LTE predicate on the "retention_keep_last" field.
+func RetentionKeepLastLTE(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldLTE(FieldRetentionKeepLast, v))
+}
+
+// RetentionKeepLastIsNil applies the IsNil predicate on the "retention_keep_last" field.
+func RetentionKeepLastIsNil() predicate.Channel {
+	return predicate.Channel(sql.FieldIsNull(FieldRetentionKeepLast))
+}
+
+// RetentionKeepLastNotNil applies the NotNil predicate on the "retention_keep_last" field.
+func RetentionKeepLastNotNil() predicate.Channel {
+	return predicate.Channel(sql.FieldNotNull(FieldRetentionKeepLast))
+}
+
+// RetentionMaxSizeGBEQ applies the EQ predicate on the "retention_max_size_gb" field.
+func RetentionMaxSizeGBEQ(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionMaxSizeGB, v))
+}
+
+// RetentionMaxSizeGBNEQ applies the NEQ predicate on the "retention_max_size_gb" field.
+func RetentionMaxSizeGBNEQ(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldRetentionMaxSizeGB, v))
+}
+
+// RetentionMaxSizeGBIn applies the In predicate on the "retention_max_size_gb" field.
+func RetentionMaxSizeGBIn(vs ...int64) predicate.Channel {
+	return predicate.Channel(sql.FieldIn(FieldRetentionMaxSizeGB, vs...))
+}
+
+// RetentionMaxSizeGBNotIn applies the NotIn predicate on the "retention_max_size_gb" field.
+func RetentionMaxSizeGBNotIn(vs ...int64) predicate.Channel {
+	return predicate.Channel(sql.FieldNotIn(FieldRetentionMaxSizeGB, vs...))
+}
+
+// RetentionMaxSizeGBGT applies the GT predicate on the "retention_max_size_gb" field.
+func RetentionMaxSizeGBGT(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldGT(FieldRetentionMaxSizeGB, v))
+}
+
+// RetentionMaxSizeGBGTE applies the GTE predicate on the "retention_max_size_gb" field.
+func RetentionMaxSizeGBGTE(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldGTE(FieldRetentionMaxSizeGB, v))
+}
+
+// RetentionMaxSizeGBLT applies the LT predicate on the "retention_max_size_gb" field.
+func RetentionMaxSizeGBLT(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldLT(FieldRetentionMaxSizeGB, v))
+}
+
+// RetentionMaxSizeGBLTE applies the LTE predicate on the "retention_max_size_gb" field.
+func RetentionMaxSizeGBLTE(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldLTE(FieldRetentionMaxSizeGB, v))
+}
+
+// RetentionMaxSizeGBIsNil applies the IsNil predicate on the "retention_max_size_gb" field.
+func RetentionMaxSizeGBIsNil() predicate.Channel {
+	return predicate.Channel(sql.FieldIsNull(FieldRetentionMaxSizeGB))
+}
+
+// RetentionMaxSizeGBNotNil applies the NotNil predicate on the "retention_max_size_gb" field.
+func RetentionMaxSizeGBNotNil() predicate.Channel {
+	return predicate.Channel(sql.FieldNotNull(FieldRetentionMaxSizeGB))
+}
+
+// RetentionProtectPlaylistsEQ applies the EQ predicate on the "retention_protect_playlists" field.
+func RetentionProtectPlaylistsEQ(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionProtectPlaylists, v))
+}
+
+// RetentionProtectPlaylistsNEQ applies the NEQ predicate on the "retention_protect_playlists" field.
+func RetentionProtectPlaylistsNEQ(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldRetentionProtectPlaylists, v))
+}
+
+// RetentionProtectWatchedEQ applies the EQ predicate on the "retention_protect_watched" field.
+func RetentionProtectWatchedEQ(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRetentionProtectWatched, v))
+}
+
+// RetentionProtectWatchedNEQ applies the NEQ predicate on the "retention_protect_watched" field.
+func RetentionProtectWatchedNEQ(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldRetentionProtectWatched, v))
+}
+
+// RetentionActionEQ applies the EQ predicate on the "retention_action" field.
+func RetentionActionEQ(v utils.RetentionAction) predicate.Channel {
+	vc := v
+	return predicate.Channel(sql.FieldEQ(FieldRetentionAction, vc))
+}
+
+// RetentionActionNEQ applies the NEQ predicate on the "retention_action" field.
+func RetentionActionNEQ(v utils.RetentionAction) predicate.Channel {
+	vc := v
+	return predicate.Channel(sql.FieldNEQ(FieldRetentionAction, vc))
+}
+
+// RetentionActionIn applies the In predicate on the "retention_action" field.
+func RetentionActionIn(vs ...utils.RetentionAction) predicate.Channel {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Channel(sql.FieldIn(FieldRetentionAction, v...))
+}
+
+// RetentionActionNotIn applies the NotIn predicate on the "retention_action" field.
+func RetentionActionNotIn(vs ...utils.RetentionAction) predicate.Channel {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Channel(sql.FieldNotIn(FieldRetentionAction, v...))
+}
+
+// ColdStorageEQ applies the EQ predicate on the "cold_storage" field.
+func ColdStorageEQ(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldColdStorage, v))
+}
+
+// ColdStorageNEQ applies the NEQ predicate on the "cold_storage" field.
+func ColdStorageNEQ(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldColdStorage, v))
+}
+
+// ColdStorageDaysEQ applies the EQ predicate on the "cold_storage_days" field.
+func ColdStorageDaysEQ(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldColdStorageDays, v))
+}
+
+// ColdStorageDaysNEQ applies the NEQ predicate on the "cold_storage_days" field.
+func ColdStorageDaysNEQ(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldColdStorageDays, v))
+}
+
+// ColdStorageDaysIn applies the In predicate on the "cold_storage_days" field.
+func ColdStorageDaysIn(vs ...int64) predicate.Channel {
+	return predicate.Channel(sql.FieldIn(FieldColdStorageDays, vs...))
+}
+
+// ColdStorageDaysNotIn applies the NotIn predicate on the "cold_storage_days" field.
+func ColdStorageDaysNotIn(vs ...int64) predicate.Channel {
+	return predicate.Channel(sql.FieldNotIn(FieldColdStorageDays, vs...))
+}
+
+// ColdStorageDaysGT applies the GT predicate on the "cold_storage_days" field.
+func ColdStorageDaysGT(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldGT(FieldColdStorageDays, v))
+}
+
+// ColdStorageDaysGTE applies the GTE predicate on the "cold_storage_days" field.
+func ColdStorageDaysGTE(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldGTE(FieldColdStorageDays, v))
+}
+
+// ColdStorageDaysLT applies the LT predicate on the "cold_storage_days" field.
+func ColdStorageDaysLT(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldLT(FieldColdStorageDays, v))
+}
+
+// ColdStorageDaysLTE applies the LTE predicate on the "cold_storage_days" field.
+func ColdStorageDaysLTE(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldLTE(FieldColdStorageDays, v))
+}
+
+// ColdStorageDaysIsNil applies the IsNil predicate on the "cold_storage_days" field.
+func ColdStorageDaysIsNil() predicate.Channel {
+	return predicate.Channel(sql.FieldIsNull(FieldColdStorageDays))
+}
+
+// ColdStorageDaysNotNil applies the NotNil predicate on the "cold_storage_days" field.
+func ColdStorageDaysNotNil() predicate.Channel {
+	return predicate.Channel(sql.FieldNotNull(FieldColdStorageDays))
+}
+
+// ColdStorageUnwatchedDaysEQ applies the EQ predicate on the "cold_storage_unwatched_days" field.
+func ColdStorageUnwatchedDaysEQ(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldColdStorageUnwatchedDays, v))
+}
+
+// ColdStorageUnwatchedDaysNEQ applies the NEQ predicate on the "cold_storage_unwatched_days" field.
+func ColdStorageUnwatchedDaysNEQ(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldColdStorageUnwatchedDays, v))
+}
+
+// ColdStorageUnwatchedDaysIn applies the In predicate on the "cold_storage_unwatched_days" field.
+func ColdStorageUnwatchedDaysIn(vs ...int64) predicate.Channel {
+	return predicate.Channel(sql.FieldIn(FieldColdStorageUnwatchedDays, vs...))
+}
+
+// ColdStorageUnwatchedDaysNotIn applies the NotIn predicate on the "cold_storage_unwatched_days" field.
+func ColdStorageUnwatchedDaysNotIn(vs ...int64) predicate.Channel {
+	return predicate.Channel(sql.FieldNotIn(FieldColdStorageUnwatchedDays, vs...))
+}
+
+// ColdStorageUnwatchedDaysGT applies the GT predicate on the "cold_storage_unwatched_days" field.
+func ColdStorageUnwatchedDaysGT(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldGT(FieldColdStorageUnwatchedDays, v))
+}
+
+// ColdStorageUnwatchedDaysGTE applies the GTE predicate on the "cold_storage_unwatched_days" field.
+func ColdStorageUnwatchedDaysGTE(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldGTE(FieldColdStorageUnwatchedDays, v))
+}
+
+// ColdStorageUnwatchedDaysLT applies the LT predicate on the "cold_storage_unwatched_days" field.
+func ColdStorageUnwatchedDaysLT(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldLT(FieldColdStorageUnwatchedDays, v))
+}
+
+// ColdStorageUnwatchedDaysLTE applies the LTE predicate on the "cold_storage_unwatched_days" field.
+func ColdStorageUnwatchedDaysLTE(v int64) predicate.Channel {
+	return predicate.Channel(sql.FieldLTE(FieldColdStorageUnwatchedDays, v))
+}
+
+// ColdStorageUnwatchedDaysIsNil applies the IsNil predicate on the "cold_storage_unwatched_days" field.
+func ColdStorageUnwatchedDaysIsNil() predicate.Channel {
+	return predicate.Channel(sql.FieldIsNull(FieldColdStorageUnwatchedDays))
+}
+
+// ColdStorageUnwatchedDaysNotNil applies the NotNil predicate on the "cold_storage_unwatched_days" field.
+func ColdStorageUnwatchedDaysNotNil() predicate.Channel {
+	return predicate.Channel(sql.FieldNotNull(FieldColdStorageUnwatchedDays))
+}
+
+// RestrictedEQ applies the EQ predicate on the "restricted" field.
+func RestrictedEQ(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldRestricted, v))
+}
+
+// RestrictedNEQ applies the NEQ predicate on the "restricted" field.
+func RestrictedNEQ(v bool) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldRestricted, v))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldUpdatedAt, v))
@@ -552,6 +958,52 @@ func HasLiveWith(preds ...predicate.Live) predicate.Channel {
 	})
 }
 
+// HasArchiveProfile applies the HasEdge predicate on the "archive_profile" edge.
+func HasArchiveProfile() predicate.Channel {
+	return predicate.Channel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ArchiveProfileTable, ArchiveProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArchiveProfileWith applies the HasEdge predicate on the "archive_profile" edge with a given conditions (other predicates).
+func HasArchiveProfileWith(preds ...predicate.ArchiveProfile) predicate.Channel {
+	return predicate.Channel(func(s *sql.Selector) {
+		step := newArchiveProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPermissions applies the HasEdge predicate on the "permissions" edge.
+func HasPermissions() predicate.Channel {
+	return predicate.Channel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PermissionsTable, PermissionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPermissionsWith applies the HasEdge predicate on the "permissions" edge with a given conditions (other predicates).
+func HasPermissionsWith(preds ...predicate.ChannelPermission) predicate.Channel {
+	return predicate.Channel(func(s *sql.Selector) {
+		step := newPermissionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Channel) predicate.Channel {
 	return predicate.Channel(sql.AndPredicates(predicates...))
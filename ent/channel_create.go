@@ -13,9 +13,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ChannelCreate is the builder for creating a Channel entity.
@@ -26,6 +29,20 @@ type ChannelCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *ChannelCreate) SetDeletedAt(t time.Time) *ChannelCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillableDeletedAt(t *time.Time) *ChannelCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
 // SetExtID sets the "ext_id" field.
 func (cc *ChannelCreate) SetExtID(s string) *ChannelCreate {
 	cc.mutation.SetExtID(s)
@@ -58,6 +75,20 @@ func (cc *ChannelCreate) SetImagePath(s string) *ChannelCreate {
 	return cc
 }
 
+// SetPlatform sets the "platform" field.
+func (cc *ChannelCreate) SetPlatform(up utils.VodPlatform) *ChannelCreate {
+	cc.mutation.SetPlatform(up)
+	return cc
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillablePlatform(up *utils.VodPlatform) *ChannelCreate {
+	if up != nil {
+		cc.SetPlatform(*up)
+	}
+	return cc
+}
+
 // SetRetention sets the "retention" field.
 func (cc *ChannelCreate) SetRetention(b bool) *ChannelCreate {
 	cc.mutation.SetRetention(b)
@@ -86,6 +117,138 @@ func (cc *ChannelCreate) SetNillableRetentionDays(i *int64) *ChannelCreate {
 	return cc
 }
 
+// SetRetentionTypeDays sets the "retention_type_days" field.
+func (cc *ChannelCreate) SetRetentionTypeDays(mt map[utils.VodType]int64) *ChannelCreate {
+	cc.mutation.SetRetentionTypeDays(mt)
+	return cc
+}
+
+// SetRetentionKeepLast sets the "retention_keep_last" field.
+func (cc *ChannelCreate) SetRetentionKeepLast(i int64) *ChannelCreate {
+	cc.mutation.SetRetentionKeepLast(i)
+	return cc
+}
+
+// SetNillableRetentionKeepLast sets the "retention_keep_last" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillableRetentionKeepLast(i *int64) *ChannelCreate {
+	if i != nil {
+		cc.SetRetentionKeepLast(*i)
+	}
+	return cc
+}
+
+// SetRetentionMaxSizeGB sets the "retention_max_size_gb" field.
+func (cc *ChannelCreate) SetRetentionMaxSizeGB(i int64) *ChannelCreate {
+	cc.mutation.SetRetentionMaxSizeGB(i)
+	return cc
+}
+
+// SetNillableRetentionMaxSizeGB sets the "retention_max_size_gb" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillableRetentionMaxSizeGB(i *int64) *ChannelCreate {
+	if i != nil {
+		cc.SetRetentionMaxSizeGB(*i)
+	}
+	return cc
+}
+
+// SetRetentionProtectPlaylists sets the "retention_protect_playlists" field.
+func (cc *ChannelCreate) SetRetentionProtectPlaylists(b bool) *ChannelCreate {
+	cc.mutation.SetRetentionProtectPlaylists(b)
+	return cc
+}
+
+// SetNillableRetentionProtectPlaylists sets the "retention_protect_playlists" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillableRetentionProtectPlaylists(b *bool) *ChannelCreate {
+	if b != nil {
+		cc.SetRetentionProtectPlaylists(*b)
+	}
+	return cc
+}
+
+// SetRetentionProtectWatched sets the "retention_protect_watched" field.
+func (cc *ChannelCreate) SetRetentionProtectWatched(b bool) *ChannelCreate {
+	cc.mutation.SetRetentionProtectWatched(b)
+	return cc
+}
+
+// SetNillableRetentionProtectWatched sets the "retention_protect_watched" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillableRetentionProtectWatched(b *bool) *ChannelCreate {
+	if b != nil {
+		cc.SetRetentionProtectWatched(*b)
+	}
+	return cc
+}
+
+// SetRetentionAction sets the "retention_action" field.
+func (cc *ChannelCreate) SetRetentionAction(ua utils.RetentionAction) *ChannelCreate {
+	cc.mutation.SetRetentionAction(ua)
+	return cc
+}
+
+// SetNillableRetentionAction sets the "retention_action" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillableRetentionAction(ua *utils.RetentionAction) *ChannelCreate {
+	if ua != nil {
+		cc.SetRetentionAction(*ua)
+	}
+	return cc
+}
+
+// SetColdStorage sets the "cold_storage" field.
+func (cc *ChannelCreate) SetColdStorage(b bool) *ChannelCreate {
+	cc.mutation.SetColdStorage(b)
+	return cc
+}
+
+// SetNillableColdStorage sets the "cold_storage" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillableColdStorage(b *bool) *ChannelCreate {
+	if b != nil {
+		cc.SetColdStorage(*b)
+	}
+	return cc
+}
+
+// SetColdStorageDays sets the "cold_storage_days" field.
+func (cc *ChannelCreate) SetColdStorageDays(i int64) *ChannelCreate {
+	cc.mutation.SetColdStorageDays(i)
+	return cc
+}
+
+// SetNillableColdStorageDays sets the "cold_storage_days" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillableColdStorageDays(i *int64) *ChannelCreate {
+	if i != nil {
+		cc.SetColdStorageDays(*i)
+	}
+	return cc
+}
+
+// SetColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field.
+func (cc *ChannelCreate) SetColdStorageUnwatchedDays(i int64) *ChannelCreate {
+	cc.mutation.SetColdStorageUnwatchedDays(i)
+	return cc
+}
+
+// SetNillableColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillableColdStorageUnwatchedDays(i *int64) *ChannelCreate {
+	if i != nil {
+		cc.SetColdStorageUnwatchedDays(*i)
+	}
+	return cc
+}
+
+// SetRestricted sets the "restricted" field.
+func (cc *ChannelCreate) SetRestricted(b bool) *ChannelCreate {
+	cc.mutation.SetRestricted(b)
+	return cc
+}
+
+// SetNillableRestricted sets the "restricted" field if the given value is not nil.
+func (cc *ChannelCreate) SetNillableRestricted(b *bool) *ChannelCreate {
+	if b != nil {
+		cc.SetRestricted(*b)
+	}
+	return cc
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cc *ChannelCreate) SetUpdatedAt(t time.Time) *ChannelCreate {
 	cc.mutation.SetUpdatedAt(t)
@@ -158,6 +321,40 @@ func (cc *ChannelCreate) AddLive(l ...*Live) *ChannelCreate {
 	return cc.AddLiveIDs(ids...)
 }
 
+// SetArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID.
+func (cc *ChannelCreate) SetArchiveProfileID(id uuid.UUID) *ChannelCreate {
+	cc.mutation.SetArchiveProfileID(id)
+	return cc
+}
+
+// SetNillableArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID if the given value is not nil.
+func (cc *ChannelCreate) SetNillableArchiveProfileID(id *uuid.UUID) *ChannelCreate {
+	if id != nil {
+		cc = cc.SetArchiveProfileID(*id)
+	}
+	return cc
+}
+
+// SetArchiveProfile sets the "archive_profile" edge to the ArchiveProfile entity.
+func (cc *ChannelCreate) SetArchiveProfile(a *ArchiveProfile) *ChannelCreate {
+	return cc.SetArchiveProfileID(a.ID)
+}
+
+// AddPermissionIDs adds the "permissions" edge to the ChannelPermission entity by IDs.
+func (cc *ChannelCreate) AddPermissionIDs(ids ...uuid.UUID) *ChannelCreate {
+	cc.mutation.AddPermissionIDs(ids...)
+	return cc
+}
+
+// AddPermissions adds the "permissions" edges to the ChannelPermission entity.
+func (cc *ChannelCreate) AddPermissions(c ...*ChannelPermission) *ChannelCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddPermissionIDs(ids...)
+}
+
 // Mutation returns the ChannelMutation object of the builder.
 func (cc *ChannelCreate) Mutation() *ChannelMutation {
 	return cc.mutation
@@ -193,10 +390,34 @@ func (cc *ChannelCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cc *ChannelCreate) defaults() {
+	if _, ok := cc.mutation.Platform(); !ok {
+		v := channel.DefaultPlatform
+		cc.mutation.SetPlatform(v)
+	}
 	if _, ok := cc.mutation.Retention(); !ok {
 		v := channel.DefaultRetention
 		cc.mutation.SetRetention(v)
 	}
+	if _, ok := cc.mutation.RetentionProtectPlaylists(); !ok {
+		v := channel.DefaultRetentionProtectPlaylists
+		cc.mutation.SetRetentionProtectPlaylists(v)
+	}
+	if _, ok := cc.mutation.RetentionProtectWatched(); !ok {
+		v := channel.DefaultRetentionProtectWatched
+		cc.mutation.SetRetentionProtectWatched(v)
+	}
+	if _, ok := cc.mutation.RetentionAction(); !ok {
+		v := channel.DefaultRetentionAction
+		cc.mutation.SetRetentionAction(v)
+	}
+	if _, ok := cc.mutation.ColdStorage(); !ok {
+		v := channel.DefaultColdStorage
+		cc.mutation.SetColdStorage(v)
+	}
+	if _, ok := cc.mutation.Restricted(); !ok {
+		v := channel.DefaultRestricted
+		cc.mutation.SetRestricted(v)
+	}
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
 		v := channel.DefaultUpdatedAt()
 		cc.mutation.SetUpdatedAt(v)
@@ -222,9 +443,37 @@ func (cc *ChannelCreate) check() error {
 	if _, ok := cc.mutation.ImagePath(); !ok {
 		return &ValidationError{Name: "image_path", err: errors.New(`ent: missing required field "Channel.image_path"`)}
 	}
+	if _, ok := cc.mutation.Platform(); !ok {
+		return &ValidationError{Name: "platform", err: errors.New(`ent: missing required field "Channel.platform"`)}
+	}
+	if v, ok := cc.mutation.Platform(); ok {
+		if err := channel.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Channel.platform": %w`, err)}
+		}
+	}
 	if _, ok := cc.mutation.Retention(); !ok {
 		return &ValidationError{Name: "retention", err: errors.New(`ent: missing required field "Channel.retention"`)}
 	}
+	if _, ok := cc.mutation.RetentionProtectPlaylists(); !ok {
+		return &ValidationError{Name: "retention_protect_playlists", err: errors.New(`ent: missing required field "Channel.retention_protect_playlists"`)}
+	}
+	if _, ok := cc.mutation.RetentionProtectWatched(); !ok {
+		return &ValidationError{Name: "retention_protect_watched", err: errors.New(`ent: missing required field "Channel.retention_protect_watched"`)}
+	}
+	if _, ok := cc.mutation.RetentionAction(); !ok {
+		return &ValidationError{Name: "retention_action", err: errors.New(`ent: missing required field "Channel.retention_action"`)}
+	}
+	if v, ok := cc.mutation.RetentionAction(); ok {
+		if err := channel.RetentionActionValidator(v); err != nil {
+			return &ValidationError{Name: "retention_action", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_action": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.ColdStorage(); !ok {
+		return &ValidationError{Name: "cold_storage", err: errors.New(`ent: missing required field "Channel.cold_storage"`)}
+	}
+	if _, ok := cc.mutation.Restricted(); !ok {
+		return &ValidationError{Name: "restricted", err: errors.New(`ent: missing required field "Channel.restricted"`)}
+	}
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Channel.updated_at"`)}
 	}
@@ -267,6 +516,10 @@ func (cc *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.SetField(channel.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := cc.mutation.ExtID(); ok {
 		_spec.SetField(channel.FieldExtID, field.TypeString, value)
 		_node.ExtID = value
@@ -283,6 +536,10 @@ func (cc *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 		_spec.SetField(channel.FieldImagePath, field.TypeString, value)
 		_node.ImagePath = value
 	}
+	if value, ok := cc.mutation.Platform(); ok {
+		_spec.SetField(channel.FieldPlatform, field.TypeEnum, value)
+		_node.Platform = value
+	}
 	if value, ok := cc.mutation.Retention(); ok {
 		_spec.SetField(channel.FieldRetention, field.TypeBool, value)
 		_node.Retention = value
@@ -291,6 +548,46 @@ func (cc *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 		_spec.SetField(channel.FieldRetentionDays, field.TypeInt64, value)
 		_node.RetentionDays = value
 	}
+	if value, ok := cc.mutation.RetentionTypeDays(); ok {
+		_spec.SetField(channel.FieldRetentionTypeDays, field.TypeJSON, value)
+		_node.RetentionTypeDays = value
+	}
+	if value, ok := cc.mutation.RetentionKeepLast(); ok {
+		_spec.SetField(channel.FieldRetentionKeepLast, field.TypeInt64, value)
+		_node.RetentionKeepLast = value
+	}
+	if value, ok := cc.mutation.RetentionMaxSizeGB(); ok {
+		_spec.SetField(channel.FieldRetentionMaxSizeGB, field.TypeInt64, value)
+		_node.RetentionMaxSizeGB = value
+	}
+	if value, ok := cc.mutation.RetentionProtectPlaylists(); ok {
+		_spec.SetField(channel.FieldRetentionProtectPlaylists, field.TypeBool, value)
+		_node.RetentionProtectPlaylists = value
+	}
+	if value, ok := cc.mutation.RetentionProtectWatched(); ok {
+		_spec.SetField(channel.FieldRetentionProtectWatched, field.TypeBool, value)
+		_node.RetentionProtectWatched = value
+	}
+	if value, ok := cc.mutation.RetentionAction(); ok {
+		_spec.SetField(channel.FieldRetentionAction, field.TypeEnum, value)
+		_node.RetentionAction = value
+	}
+	if value, ok := cc.mutation.ColdStorage(); ok {
+		_spec.SetField(channel.FieldColdStorage, field.TypeBool, value)
+		_node.ColdStorage = value
+	}
+	if value, ok := cc.mutation.ColdStorageDays(); ok {
+		_spec.SetField(channel.FieldColdStorageDays, field.TypeInt64, value)
+		_node.ColdStorageDays = value
+	}
+	if value, ok := cc.mutation.ColdStorageUnwatchedDays(); ok {
+		_spec.SetField(channel.FieldColdStorageUnwatchedDays, field.TypeInt64, value)
+		_node.ColdStorageUnwatchedDays = value
+	}
+	if value, ok := cc.mutation.Restricted(); ok {
+		_spec.SetField(channel.FieldRestricted, field.TypeBool, value)
+		_node.Restricted = value
+	}
 	if value, ok := cc.mutation.UpdatedAt(); ok {
 		_spec.SetField(channel.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -331,6 +628,39 @@ func (cc *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.ArchiveProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channel.ArchiveProfileTable,
+			Columns: []string{channel.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.archive_profile_channels = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.PermissionsTable,
+			Columns: []string{channel.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -338,7 +668,7 @@ func (cc *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Channel.Create().
-//		SetExtID(v).
+//		SetDeletedAt(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -347,7 +677,7 @@ func (cc *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.ChannelUpsert) {
-//			SetExtID(v+v).
+//			SetDeletedAt(v+v).
 //		}).
 //		Exec(ctx)
 func (cc *ChannelCreate) OnConflict(opts ...sql.ConflictOption) *ChannelUpsertOne {
@@ -383,6 +713,24 @@ type (
 	}
 )
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ChannelUpsert) SetDeletedAt(v time.Time) *ChannelUpsert {
+	u.Set(channel.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateDeletedAt() *ChannelUpsert {
+	u.SetExcluded(channel.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ChannelUpsert) ClearDeletedAt() *ChannelUpsert {
+	u.SetNull(channel.FieldDeletedAt)
+	return u
+}
+
 // SetExtID sets the "ext_id" field.
 func (u *ChannelUpsert) SetExtID(v string) *ChannelUpsert {
 	u.Set(channel.FieldExtID, v)
@@ -437,6 +785,18 @@ func (u *ChannelUpsert) UpdateImagePath() *ChannelUpsert {
 	return u
 }
 
+// SetPlatform sets the "platform" field.
+func (u *ChannelUpsert) SetPlatform(v utils.VodPlatform) *ChannelUpsert {
+	u.Set(channel.FieldPlatform, v)
+	return u
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdatePlatform() *ChannelUpsert {
+	u.SetExcluded(channel.FieldPlatform)
+	return u
+}
+
 // SetRetention sets the "retention" field.
 func (u *ChannelUpsert) SetRetention(v bool) *ChannelUpsert {
 	u.Set(channel.FieldRetention, v)
@@ -473,6 +833,180 @@ func (u *ChannelUpsert) ClearRetentionDays() *ChannelUpsert {
 	return u
 }
 
+// SetRetentionTypeDays sets the "retention_type_days" field.
+func (u *ChannelUpsert) SetRetentionTypeDays(v map[utils.VodType]int64) *ChannelUpsert {
+	u.Set(channel.FieldRetentionTypeDays, v)
+	return u
+}
+
+// UpdateRetentionTypeDays sets the "retention_type_days" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionTypeDays() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionTypeDays)
+	return u
+}
+
+// ClearRetentionTypeDays clears the value of the "retention_type_days" field.
+func (u *ChannelUpsert) ClearRetentionTypeDays() *ChannelUpsert {
+	u.SetNull(channel.FieldRetentionTypeDays)
+	return u
+}
+
+// SetRetentionKeepLast sets the "retention_keep_last" field.
+func (u *ChannelUpsert) SetRetentionKeepLast(v int64) *ChannelUpsert {
+	u.Set(channel.FieldRetentionKeepLast, v)
+	return u
+}
+
+// UpdateRetentionKeepLast sets the "retention_keep_last" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionKeepLast() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionKeepLast)
+	return u
+}
+
+// AddRetentionKeepLast adds v to the "retention_keep_last" field.
+func (u *ChannelUpsert) AddRetentionKeepLast(v int64) *ChannelUpsert {
+	u.Add(channel.FieldRetentionKeepLast, v)
+	return u
+}
+
+// ClearRetentionKeepLast clears the value of the "retention_keep_last" field.
+func (u *ChannelUpsert) ClearRetentionKeepLast() *ChannelUpsert {
+	u.SetNull(channel.FieldRetentionKeepLast)
+	return u
+}
+
+// SetRetentionMaxSizeGB sets the "retention_max_size_gb" field.
+func (u *ChannelUpsert) SetRetentionMaxSizeGB(v int64) *ChannelUpsert {
+	u.Set(channel.FieldRetentionMaxSizeGB, v)
+	return u
+}
+
+// UpdateRetentionMaxSizeGB sets the "retention_max_size_gb" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionMaxSizeGB() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionMaxSizeGB)
+	return u
+}
+
+// AddRetentionMaxSizeGB adds v to the "retention_max_size_gb" field.
+func (u *ChannelUpsert) AddRetentionMaxSizeGB(v int64) *ChannelUpsert {
+	u.Add(channel.FieldRetentionMaxSizeGB, v)
+	return u
+}
+
+// ClearRetentionMaxSizeGB clears the value of the "retention_max_size_gb" field.
+func (u *ChannelUpsert) ClearRetentionMaxSizeGB() *ChannelUpsert {
+	u.SetNull(channel.FieldRetentionMaxSizeGB)
+	return u
+}
+
+// SetRetentionProtectPlaylists sets the "retention_protect_playlists" field.
+func (u *ChannelUpsert) SetRetentionProtectPlaylists(v bool) *ChannelUpsert {
+	u.Set(channel.FieldRetentionProtectPlaylists, v)
+	return u
+}
+
+// UpdateRetentionProtectPlaylists sets the "retention_protect_playlists" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionProtectPlaylists() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionProtectPlaylists)
+	return u
+}
+
+// SetRetentionProtectWatched sets the "retention_protect_watched" field.
+func (u *ChannelUpsert) SetRetentionProtectWatched(v bool) *ChannelUpsert {
+	u.Set(channel.FieldRetentionProtectWatched, v)
+	return u
+}
+
+// UpdateRetentionProtectWatched sets the "retention_protect_watched" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionProtectWatched() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionProtectWatched)
+	return u
+}
+
+// SetRetentionAction sets the "retention_action" field.
+func (u *ChannelUpsert) SetRetentionAction(v utils.RetentionAction) *ChannelUpsert {
+	u.Set(channel.FieldRetentionAction, v)
+	return u
+}
+
+// UpdateRetentionAction sets the "retention_action" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRetentionAction() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRetentionAction)
+	return u
+}
+
+// SetColdStorage sets the "cold_storage" field.
+func (u *ChannelUpsert) SetColdStorage(v bool) *ChannelUpsert {
+	u.Set(channel.FieldColdStorage, v)
+	return u
+}
+
+// UpdateColdStorage sets the "cold_storage" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateColdStorage() *ChannelUpsert {
+	u.SetExcluded(channel.FieldColdStorage)
+	return u
+}
+
+// SetColdStorageDays sets the "cold_storage_days" field.
+func (u *ChannelUpsert) SetColdStorageDays(v int64) *ChannelUpsert {
+	u.Set(channel.FieldColdStorageDays, v)
+	return u
+}
+
+// UpdateColdStorageDays sets the "cold_storage_days" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateColdStorageDays() *ChannelUpsert {
+	u.SetExcluded(channel.FieldColdStorageDays)
+	return u
+}
+
+// AddColdStorageDays adds v to the "cold_storage_days" field.
+func (u *ChannelUpsert) AddColdStorageDays(v int64) *ChannelUpsert {
+	u.Add(channel.FieldColdStorageDays, v)
+	return u
+}
+
+// ClearColdStorageDays clears the value of the "cold_storage_days" field.
+func (u *ChannelUpsert) ClearColdStorageDays() *ChannelUpsert {
+	u.SetNull(channel.FieldColdStorageDays)
+	return u
+}
+
+// SetColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field.
+func (u *ChannelUpsert) SetColdStorageUnwatchedDays(v int64) *ChannelUpsert {
+	u.Set(channel.FieldColdStorageUnwatchedDays, v)
+	return u
+}
+
+// UpdateColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateColdStorageUnwatchedDays() *ChannelUpsert {
+	u.SetExcluded(channel.FieldColdStorageUnwatchedDays)
+	return u
+}
+
+// AddColdStorageUnwatchedDays adds v to the "cold_storage_unwatched_days" field.
+func (u *ChannelUpsert) AddColdStorageUnwatchedDays(v int64) *ChannelUpsert {
+	u.Add(channel.FieldColdStorageUnwatchedDays, v)
+	return u
+}
+
+// ClearColdStorageUnwatchedDays clears the value of the "cold_storage_unwatched_days" field.
+func (u *ChannelUpsert) ClearColdStorageUnwatchedDays() *ChannelUpsert {
+	u.SetNull(channel.FieldColdStorageUnwatchedDays)
+	return u
+}
+
+// SetRestricted sets the "restricted" field.
+func (u *ChannelUpsert) SetRestricted(v bool) *ChannelUpsert {
+	u.Set(channel.FieldRestricted, v)
+	return u
+}
+
+// UpdateRestricted sets the "restricted" field to the value that was provided on create.
+func (u *ChannelUpsert) UpdateRestricted() *ChannelUpsert {
+	u.SetExcluded(channel.FieldRestricted)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ChannelUpsert) SetUpdatedAt(v time.Time) *ChannelUpsert {
 	u.Set(channel.FieldUpdatedAt, v)
@@ -536,6 +1070,27 @@ func (u *ChannelUpsertOne) Update(set func(*ChannelUpsert)) *ChannelUpsertOne {
 	return u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ChannelUpsertOne) SetDeletedAt(v time.Time) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateDeletedAt() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ChannelUpsertOne) ClearDeletedAt() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // SetExtID sets the "ext_id" field.
 func (u *ChannelUpsertOne) SetExtID(v string) *ChannelUpsertOne {
 	return u.Update(func(s *ChannelUpsert) {
@@ -599,6 +1154,20 @@ func (u *ChannelUpsertOne) UpdateImagePath() *ChannelUpsertOne {
 	})
 }
 
+// SetPlatform sets the "platform" field.
+func (u *ChannelUpsertOne) SetPlatform(v utils.VodPlatform) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdatePlatform() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
 // SetRetention sets the "retention" field.
 func (u *ChannelUpsertOne) SetRetention(v bool) *ChannelUpsertOne {
 	return u.Update(func(s *ChannelUpsert) {
@@ -641,6 +1210,209 @@ func (u *ChannelUpsertOne) ClearRetentionDays() *ChannelUpsertOne {
 	})
 }
 
+// SetRetentionTypeDays sets the "retention_type_days" field.
+func (u *ChannelUpsertOne) SetRetentionTypeDays(v map[utils.VodType]int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionTypeDays(v)
+	})
+}
+
+// UpdateRetentionTypeDays sets the "retention_type_days" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionTypeDays() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionTypeDays()
+	})
+}
+
+// ClearRetentionTypeDays clears the value of the "retention_type_days" field.
+func (u *ChannelUpsertOne) ClearRetentionTypeDays() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearRetentionTypeDays()
+	})
+}
+
+// SetRetentionKeepLast sets the "retention_keep_last" field.
+func (u *ChannelUpsertOne) SetRetentionKeepLast(v int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionKeepLast(v)
+	})
+}
+
+// AddRetentionKeepLast adds v to the "retention_keep_last" field.
+func (u *ChannelUpsertOne) AddRetentionKeepLast(v int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddRetentionKeepLast(v)
+	})
+}
+
+// UpdateRetentionKeepLast sets the "retention_keep_last" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionKeepLast() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionKeepLast()
+	})
+}
+
+// ClearRetentionKeepLast clears the value of the "retention_keep_last" field.
+func (u *ChannelUpsertOne) ClearRetentionKeepLast() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearRetentionKeepLast()
+	})
+}
+
+// SetRetentionMaxSizeGB sets the "retention_max_size_gb" field.
+func (u *ChannelUpsertOne) SetRetentionMaxSizeGB(v int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionMaxSizeGB(v)
+	})
+}
+
+// AddRetentionMaxSizeGB adds v to the "retention_max_size_gb" field.
+func (u *ChannelUpsertOne) AddRetentionMaxSizeGB(v int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddRetentionMaxSizeGB(v)
+	})
+}
+
+// UpdateRetentionMaxSizeGB sets the "retention_max_size_gb" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionMaxSizeGB() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionMaxSizeGB()
+	})
+}
+
+// ClearRetentionMaxSizeGB clears the value of the "retention_max_size_gb" field.
+func (u *ChannelUpsertOne) ClearRetentionMaxSizeGB() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearRetentionMaxSizeGB()
+	})
+}
+
+// SetRetentionProtectPlaylists sets the "retention_protect_playlists" field.
+func (u *ChannelUpsertOne) SetRetentionProtectPlaylists(v bool) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionProtectPlaylists(v)
+	})
+}
+
+// UpdateRetentionProtectPlaylists sets the "retention_protect_playlists" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionProtectPlaylists() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionProtectPlaylists()
+	})
+}
+
+// SetRetentionProtectWatched sets the "retention_protect_watched" field.
+func (u *ChannelUpsertOne) SetRetentionProtectWatched(v bool) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionProtectWatched(v)
+	})
+}
+
+// UpdateRetentionProtectWatched sets the "retention_protect_watched" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionProtectWatched() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionProtectWatched()
+	})
+}
+
+// SetRetentionAction sets the "retention_action" field.
+func (u *ChannelUpsertOne) SetRetentionAction(v utils.RetentionAction) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionAction(v)
+	})
+}
+
+// UpdateRetentionAction sets the "retention_action" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRetentionAction() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionAction()
+	})
+}
+
+// SetColdStorage sets the "cold_storage" field.
+func (u *ChannelUpsertOne) SetColdStorage(v bool) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetColdStorage(v)
+	})
+}
+
+// UpdateColdStorage sets the "cold_storage" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateColdStorage() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateColdStorage()
+	})
+}
+
+// SetColdStorageDays sets the "cold_storage_days" field.
+func (u *ChannelUpsertOne) SetColdStorageDays(v int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetColdStorageDays(v)
+	})
+}
+
+// AddColdStorageDays adds v to the "cold_storage_days" field.
+func (u *ChannelUpsertOne) AddColdStorageDays(v int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddColdStorageDays(v)
+	})
+}
+
+// UpdateColdStorageDays sets the "cold_storage_days" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateColdStorageDays() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateColdStorageDays()
+	})
+}
+
+// ClearColdStorageDays clears the value of the "cold_storage_days" field.
+func (u *ChannelUpsertOne) ClearColdStorageDays() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearColdStorageDays()
+	})
+}
+
+// SetColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field.
+func (u *ChannelUpsertOne) SetColdStorageUnwatchedDays(v int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetColdStorageUnwatchedDays(v)
+	})
+}
+
+// AddColdStorageUnwatchedDays adds v to the "cold_storage_unwatched_days" field.
+func (u *ChannelUpsertOne) AddColdStorageUnwatchedDays(v int64) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddColdStorageUnwatchedDays(v)
+	})
+}
+
+// UpdateColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateColdStorageUnwatchedDays() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateColdStorageUnwatchedDays()
+	})
+}
+
+// ClearColdStorageUnwatchedDays clears the value of the "cold_storage_unwatched_days" field.
+func (u *ChannelUpsertOne) ClearColdStorageUnwatchedDays() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearColdStorageUnwatchedDays()
+	})
+}
+
+// SetRestricted sets the "restricted" field.
+func (u *ChannelUpsertOne) SetRestricted(v bool) *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRestricted(v)
+	})
+}
+
+// UpdateRestricted sets the "restricted" field to the value that was provided on create.
+func (u *ChannelUpsertOne) UpdateRestricted() *ChannelUpsertOne {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRestricted()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ChannelUpsertOne) SetUpdatedAt(v time.Time) *ChannelUpsertOne {
 	return u.Update(func(s *ChannelUpsert) {
@@ -791,7 +1563,7 @@ func (ccb *ChannelCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.ChannelUpsert) {
-//			SetExtID(v+v).
+//			SetDeletedAt(v+v).
 //		}).
 //		Exec(ctx)
 func (ccb *ChannelCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChannelUpsertBulk {
@@ -873,6 +1645,27 @@ func (u *ChannelUpsertBulk) Update(set func(*ChannelUpsert)) *ChannelUpsertBulk
 	return u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ChannelUpsertBulk) SetDeletedAt(v time.Time) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateDeletedAt() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ChannelUpsertBulk) ClearDeletedAt() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // SetExtID sets the "ext_id" field.
 func (u *ChannelUpsertBulk) SetExtID(v string) *ChannelUpsertBulk {
 	return u.Update(func(s *ChannelUpsert) {
@@ -936,6 +1729,20 @@ func (u *ChannelUpsertBulk) UpdateImagePath() *ChannelUpsertBulk {
 	})
 }
 
+// SetPlatform sets the "platform" field.
+func (u *ChannelUpsertBulk) SetPlatform(v utils.VodPlatform) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetPlatform(v)
+	})
+}
+
+// UpdatePlatform sets the "platform" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdatePlatform() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdatePlatform()
+	})
+}
+
 // SetRetention sets the "retention" field.
 func (u *ChannelUpsertBulk) SetRetention(v bool) *ChannelUpsertBulk {
 	return u.Update(func(s *ChannelUpsert) {
@@ -978,6 +1785,209 @@ func (u *ChannelUpsertBulk) ClearRetentionDays() *ChannelUpsertBulk {
 	})
 }
 
+// SetRetentionTypeDays sets the "retention_type_days" field.
+func (u *ChannelUpsertBulk) SetRetentionTypeDays(v map[utils.VodType]int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionTypeDays(v)
+	})
+}
+
+// UpdateRetentionTypeDays sets the "retention_type_days" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionTypeDays() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionTypeDays()
+	})
+}
+
+// ClearRetentionTypeDays clears the value of the "retention_type_days" field.
+func (u *ChannelUpsertBulk) ClearRetentionTypeDays() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearRetentionTypeDays()
+	})
+}
+
+// SetRetentionKeepLast sets the "retention_keep_last" field.
+func (u *ChannelUpsertBulk) SetRetentionKeepLast(v int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionKeepLast(v)
+	})
+}
+
+// AddRetentionKeepLast adds v to the "retention_keep_last" field.
+func (u *ChannelUpsertBulk) AddRetentionKeepLast(v int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddRetentionKeepLast(v)
+	})
+}
+
+// UpdateRetentionKeepLast sets the "retention_keep_last" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionKeepLast() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionKeepLast()
+	})
+}
+
+// ClearRetentionKeepLast clears the value of the "retention_keep_last" field.
+func (u *ChannelUpsertBulk) ClearRetentionKeepLast() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearRetentionKeepLast()
+	})
+}
+
+// SetRetentionMaxSizeGB sets the "retention_max_size_gb" field.
+func (u *ChannelUpsertBulk) SetRetentionMaxSizeGB(v int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionMaxSizeGB(v)
+	})
+}
+
+// AddRetentionMaxSizeGB adds v to the "retention_max_size_gb" field.
+func (u *ChannelUpsertBulk) AddRetentionMaxSizeGB(v int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddRetentionMaxSizeGB(v)
+	})
+}
+
+// UpdateRetentionMaxSizeGB sets the "retention_max_size_gb" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionMaxSizeGB() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionMaxSizeGB()
+	})
+}
+
+// ClearRetentionMaxSizeGB clears the value of the "retention_max_size_gb" field.
+func (u *ChannelUpsertBulk) ClearRetentionMaxSizeGB() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearRetentionMaxSizeGB()
+	})
+}
+
+// SetRetentionProtectPlaylists sets the "retention_protect_playlists" field.
+func (u *ChannelUpsertBulk) SetRetentionProtectPlaylists(v bool) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionProtectPlaylists(v)
+	})
+}
+
+// UpdateRetentionProtectPlaylists sets the "retention_protect_playlists" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionProtectPlaylists() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionProtectPlaylists()
+	})
+}
+
+// SetRetentionProtectWatched sets the "retention_protect_watched" field.
+func (u *ChannelUpsertBulk) SetRetentionProtectWatched(v bool) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionProtectWatched(v)
+	})
+}
+
+// UpdateRetentionProtectWatched sets the "retention_protect_watched" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionProtectWatched() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionProtectWatched()
+	})
+}
+
+// SetRetentionAction sets the "retention_action" field.
+func (u *ChannelUpsertBulk) SetRetentionAction(v utils.RetentionAction) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRetentionAction(v)
+	})
+}
+
+// UpdateRetentionAction sets the "retention_action" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRetentionAction() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRetentionAction()
+	})
+}
+
+// SetColdStorage sets the "cold_storage" field.
+func (u *ChannelUpsertBulk) SetColdStorage(v bool) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetColdStorage(v)
+	})
+}
+
+// UpdateColdStorage sets the "cold_storage" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateColdStorage() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateColdStorage()
+	})
+}
+
+// SetColdStorageDays sets the "cold_storage_days" field.
+func (u *ChannelUpsertBulk) SetColdStorageDays(v int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetColdStorageDays(v)
+	})
+}
+
+// AddColdStorageDays adds v to the "cold_storage_days" field.
+func (u *ChannelUpsertBulk) AddColdStorageDays(v int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddColdStorageDays(v)
+	})
+}
+
+// UpdateColdStorageDays sets the "cold_storage_days" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateColdStorageDays() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateColdStorageDays()
+	})
+}
+
+// ClearColdStorageDays clears the value of the "cold_storage_days" field.
+func (u *ChannelUpsertBulk) ClearColdStorageDays() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearColdStorageDays()
+	})
+}
+
+// SetColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field.
+func (u *ChannelUpsertBulk) SetColdStorageUnwatchedDays(v int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetColdStorageUnwatchedDays(v)
+	})
+}
+
+// AddColdStorageUnwatchedDays adds v to the "cold_storage_unwatched_days" field.
+func (u *ChannelUpsertBulk) AddColdStorageUnwatchedDays(v int64) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.AddColdStorageUnwatchedDays(v)
+	})
+}
+
+// UpdateColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateColdStorageUnwatchedDays() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateColdStorageUnwatchedDays()
+	})
+}
+
+// ClearColdStorageUnwatchedDays clears the value of the "cold_storage_unwatched_days" field.
+func (u *ChannelUpsertBulk) ClearColdStorageUnwatchedDays() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.ClearColdStorageUnwatchedDays()
+	})
+}
+
+// SetRestricted sets the "restricted" field.
+func (u *ChannelUpsertBulk) SetRestricted(v bool) *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.SetRestricted(v)
+	})
+}
+
+// UpdateRestricted sets the "restricted" field to the value that was provided on create.
+func (u *ChannelUpsertBulk) UpdateRestricted() *ChannelUpsertBulk {
+	return u.Update(func(s *ChannelUpsert) {
+		s.UpdateRestricted()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ChannelUpsertBulk) SetUpdatedAt(v time.Time) *ChannelUpsertBulk {
 	return u.Update(func(s *ChannelUpsert) {
@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/ent/vod"
@@ -21,12 +23,15 @@ import (
 // ChannelQuery is the builder for querying Channel entities.
 type ChannelQuery struct {
 	config
-	ctx        *QueryContext
-	order      []channel.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Channel
-	withVods   *VodQuery
-	withLive   *LiveQuery
+	ctx                *QueryContext
+	order              []channel.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Channel
+	withVods           *VodQuery
+	withLive           *LiveQuery
+	withArchiveProfile *ArchiveProfileQuery
+	withPermissions    *ChannelPermissionQuery
+	withFKs            bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +112,50 @@ func (cq *ChannelQuery) QueryLive() *LiveQuery {
 	return query
 }
 
+// QueryArchiveProfile chains the current query on the "archive_profile" edge.
+func (cq *ChannelQuery) QueryArchiveProfile() *ArchiveProfileQuery {
+	query := (&ArchiveProfileClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, selector),
+			sqlgraph.To(archiveprofile.Table, archiveprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, channel.ArchiveProfileTable, channel.ArchiveProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPermissions chains the current query on the "permissions" edge.
+func (cq *ChannelQuery) QueryPermissions() *ChannelPermissionQuery {
+	query := (&ChannelPermissionClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, selector),
+			sqlgraph.To(channelpermission.Table, channelpermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, channel.PermissionsTable, channel.PermissionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Channel entity from the query.
 // Returns a *NotFoundError when no Channel was found.
 func (cq *ChannelQuery) First(ctx context.Context) (*Channel, error) {
@@ -294,13 +343,15 @@ func (cq *ChannelQuery) Clone() *ChannelQuery {
 		return nil
 	}
 	return &ChannelQuery{
-		config:     cq.config,
-		ctx:        cq.ctx.Clone(),
-		order:      append([]channel.OrderOption{}, cq.order...),
-		inters:     append([]Interceptor{}, cq.inters...),
-		predicates: append([]predicate.Channel{}, cq.predicates...),
-		withVods:   cq.withVods.Clone(),
-		withLive:   cq.withLive.Clone(),
+		config:             cq.config,
+		ctx:                cq.ctx.Clone(),
+		order:              append([]channel.OrderOption{}, cq.order...),
+		inters:             append([]Interceptor{}, cq.inters...),
+		predicates:         append([]predicate.Channel{}, cq.predicates...),
+		withVods:           cq.withVods.Clone(),
+		withLive:           cq.withLive.Clone(),
+		withArchiveProfile: cq.withArchiveProfile.Clone(),
+		withPermissions:    cq.withPermissions.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -329,18 +380,40 @@ func (cq *ChannelQuery) WithLive(opts ...func(*LiveQuery)) *ChannelQuery {
 	return cq
 }
 
+// WithArchiveProfile tells the query-builder to eager-load the nodes that are connected to
+// the "archive_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ChannelQuery) WithArchiveProfile(opts ...func(*ArchiveProfileQuery)) *ChannelQuery {
+	query := (&ArchiveProfileClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withArchiveProfile = query
+	return cq
+}
+
+// WithPermissions tells the query-builder to eager-load the nodes that are connected to
+// the "permissions" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ChannelQuery) WithPermissions(opts ...func(*ChannelPermissionQuery)) *ChannelQuery {
+	query := (&ChannelPermissionClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withPermissions = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		ExtID string `json:"ext_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Channel.Query().
-//		GroupBy(channel.FieldExtID).
+//		GroupBy(channel.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (cq *ChannelQuery) GroupBy(field string, fields ...string) *ChannelGroupBy {
@@ -358,11 +431,11 @@ func (cq *ChannelQuery) GroupBy(field string, fields ...string) *ChannelGroupBy
 // Example:
 //
 //	var v []struct {
-//		ExtID string `json:"ext_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Channel.Query().
-//		Select(channel.FieldExtID).
+//		Select(channel.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (cq *ChannelQuery) Select(fields ...string) *ChannelSelect {
 	cq.ctx.Fields = append(cq.ctx.Fields, fields...)
@@ -406,12 +479,21 @@ func (cq *ChannelQuery) prepareQuery(ctx context.Context) error {
 func (cq *ChannelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Channel, error) {
 	var (
 		nodes       = []*Channel{}
+		withFKs     = cq.withFKs
 		_spec       = cq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			cq.withVods != nil,
 			cq.withLive != nil,
+			cq.withArchiveProfile != nil,
+			cq.withPermissions != nil,
 		}
 	)
+	if cq.withArchiveProfile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, channel.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Channel).scanValues(nil, columns)
 	}
@@ -444,6 +526,19 @@ func (cq *ChannelQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Chan
 			return nil, err
 		}
 	}
+	if query := cq.withArchiveProfile; query != nil {
+		if err := cq.loadArchiveProfile(ctx, query, nodes, nil,
+			func(n *Channel, e *ArchiveProfile) { n.Edges.ArchiveProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withPermissions; query != nil {
+		if err := cq.loadPermissions(ctx, query, nodes,
+			func(n *Channel) { n.Edges.Permissions = []*ChannelPermission{} },
+			func(n *Channel, e *ChannelPermission) { n.Edges.Permissions = append(n.Edges.Permissions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -509,6 +604,69 @@ func (cq *ChannelQuery) loadLive(ctx context.Context, query *LiveQuery, nodes []
 	}
 	return nil
 }
+func (cq *ChannelQuery) loadArchiveProfile(ctx context.Context, query *ArchiveProfileQuery, nodes []*Channel, init func(*Channel), assign func(*Channel, *ArchiveProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Channel)
+	for i := range nodes {
+		if nodes[i].archive_profile_channels == nil {
+			continue
+		}
+		fk := *nodes[i].archive_profile_channels
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(archiveprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "archive_profile_channels" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cq *ChannelQuery) loadPermissions(ctx context.Context, query *ChannelPermissionQuery, nodes []*Channel, init func(*Channel), assign func(*Channel, *ChannelPermission)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Channel)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ChannelPermission(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(channel.PermissionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.channel_permissions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "channel_permissions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "channel_permissions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (cq *ChannelQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
@@ -12,10 +12,13 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ChannelUpdate is the builder for updating Channel entities.
@@ -31,6 +34,26 @@ func (cu *ChannelUpdate) Where(ps ...predicate.Channel) *ChannelUpdate {
 	return cu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *ChannelUpdate) SetDeletedAt(t time.Time) *ChannelUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableDeletedAt(t *time.Time) *ChannelUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *ChannelUpdate) ClearDeletedAt() *ChannelUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
 // SetExtID sets the "ext_id" field.
 func (cu *ChannelUpdate) SetExtID(s string) *ChannelUpdate {
 	cu.mutation.SetExtID(s)
@@ -93,6 +116,20 @@ func (cu *ChannelUpdate) SetNillableImagePath(s *string) *ChannelUpdate {
 	return cu
 }
 
+// SetPlatform sets the "platform" field.
+func (cu *ChannelUpdate) SetPlatform(up utils.VodPlatform) *ChannelUpdate {
+	cu.mutation.SetPlatform(up)
+	return cu
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillablePlatform(up *utils.VodPlatform) *ChannelUpdate {
+	if up != nil {
+		cu.SetPlatform(*up)
+	}
+	return cu
+}
+
 // SetRetention sets the "retention" field.
 func (cu *ChannelUpdate) SetRetention(b bool) *ChannelUpdate {
 	cu.mutation.SetRetention(b)
@@ -134,6 +171,196 @@ func (cu *ChannelUpdate) ClearRetentionDays() *ChannelUpdate {
 	return cu
 }
 
+// SetRetentionTypeDays sets the "retention_type_days" field.
+func (cu *ChannelUpdate) SetRetentionTypeDays(mt map[utils.VodType]int64) *ChannelUpdate {
+	cu.mutation.SetRetentionTypeDays(mt)
+	return cu
+}
+
+// ClearRetentionTypeDays clears the value of the "retention_type_days" field.
+func (cu *ChannelUpdate) ClearRetentionTypeDays() *ChannelUpdate {
+	cu.mutation.ClearRetentionTypeDays()
+	return cu
+}
+
+// SetRetentionKeepLast sets the "retention_keep_last" field.
+func (cu *ChannelUpdate) SetRetentionKeepLast(i int64) *ChannelUpdate {
+	cu.mutation.ResetRetentionKeepLast()
+	cu.mutation.SetRetentionKeepLast(i)
+	return cu
+}
+
+// SetNillableRetentionKeepLast sets the "retention_keep_last" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableRetentionKeepLast(i *int64) *ChannelUpdate {
+	if i != nil {
+		cu.SetRetentionKeepLast(*i)
+	}
+	return cu
+}
+
+// AddRetentionKeepLast adds i to the "retention_keep_last" field.
+func (cu *ChannelUpdate) AddRetentionKeepLast(i int64) *ChannelUpdate {
+	cu.mutation.AddRetentionKeepLast(i)
+	return cu
+}
+
+// ClearRetentionKeepLast clears the value of the "retention_keep_last" field.
+func (cu *ChannelUpdate) ClearRetentionKeepLast() *ChannelUpdate {
+	cu.mutation.ClearRetentionKeepLast()
+	return cu
+}
+
+// SetRetentionMaxSizeGB sets the "retention_max_size_gb" field.
+func (cu *ChannelUpdate) SetRetentionMaxSizeGB(i int64) *ChannelUpdate {
+	cu.mutation.ResetRetentionMaxSizeGB()
+	cu.mutation.SetRetentionMaxSizeGB(i)
+	return cu
+}
+
+// SetNillableRetentionMaxSizeGB sets the "retention_max_size_gb" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableRetentionMaxSizeGB(i *int64) *ChannelUpdate {
+	if i != nil {
+		cu.SetRetentionMaxSizeGB(*i)
+	}
+	return cu
+}
+
+// AddRetentionMaxSizeGB adds i to the "retention_max_size_gb" field.
+func (cu *ChannelUpdate) AddRetentionMaxSizeGB(i int64) *ChannelUpdate {
+	cu.mutation.AddRetentionMaxSizeGB(i)
+	return cu
+}
+
+// ClearRetentionMaxSizeGB clears the value of the "retention_max_size_gb" field.
+func (cu *ChannelUpdate) ClearRetentionMaxSizeGB() *ChannelUpdate {
+	cu.mutation.ClearRetentionMaxSizeGB()
+	return cu
+}
+
+// SetRetentionProtectPlaylists sets the "retention_protect_playlists" field.
+func (cu *ChannelUpdate) SetRetentionProtectPlaylists(b bool) *ChannelUpdate {
+	cu.mutation.SetRetentionProtectPlaylists(b)
+	return cu
+}
+
+// SetNillableRetentionProtectPlaylists sets the "retention_protect_playlists" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableRetentionProtectPlaylists(b *bool) *ChannelUpdate {
+	if b != nil {
+		cu.SetRetentionProtectPlaylists(*b)
+	}
+	return cu
+}
+
+// SetRetentionProtectWatched sets the "retention_protect_watched" field.
+func (cu *ChannelUpdate) SetRetentionProtectWatched(b bool) *ChannelUpdate {
+	cu.mutation.SetRetentionProtectWatched(b)
+	return cu
+}
+
+// SetNillableRetentionProtectWatched sets the "retention_protect_watched" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableRetentionProtectWatched(b *bool) *ChannelUpdate {
+	if b != nil {
+		cu.SetRetentionProtectWatched(*b)
+	}
+	return cu
+}
+
+// SetRetentionAction sets the "retention_action" field.
+func (cu *ChannelUpdate) SetRetentionAction(ua utils.RetentionAction) *ChannelUpdate {
+	cu.mutation.SetRetentionAction(ua)
+	return cu
+}
+
+// SetNillableRetentionAction sets the "retention_action" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableRetentionAction(ua *utils.RetentionAction) *ChannelUpdate {
+	if ua != nil {
+		cu.SetRetentionAction(*ua)
+	}
+	return cu
+}
+
+// SetColdStorage sets the "cold_storage" field.
+func (cu *ChannelUpdate) SetColdStorage(b bool) *ChannelUpdate {
+	cu.mutation.SetColdStorage(b)
+	return cu
+}
+
+// SetNillableColdStorage sets the "cold_storage" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableColdStorage(b *bool) *ChannelUpdate {
+	if b != nil {
+		cu.SetColdStorage(*b)
+	}
+	return cu
+}
+
+// SetColdStorageDays sets the "cold_storage_days" field.
+func (cu *ChannelUpdate) SetColdStorageDays(i int64) *ChannelUpdate {
+	cu.mutation.ResetColdStorageDays()
+	cu.mutation.SetColdStorageDays(i)
+	return cu
+}
+
+// SetNillableColdStorageDays sets the "cold_storage_days" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableColdStorageDays(i *int64) *ChannelUpdate {
+	if i != nil {
+		cu.SetColdStorageDays(*i)
+	}
+	return cu
+}
+
+// AddColdStorageDays adds i to the "cold_storage_days" field.
+func (cu *ChannelUpdate) AddColdStorageDays(i int64) *ChannelUpdate {
+	cu.mutation.AddColdStorageDays(i)
+	return cu
+}
+
+// ClearColdStorageDays clears the value of the "cold_storage_days" field.
+func (cu *ChannelUpdate) ClearColdStorageDays() *ChannelUpdate {
+	cu.mutation.ClearColdStorageDays()
+	return cu
+}
+
+// SetColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field.
+func (cu *ChannelUpdate) SetColdStorageUnwatchedDays(i int64) *ChannelUpdate {
+	cu.mutation.ResetColdStorageUnwatchedDays()
+	cu.mutation.SetColdStorageUnwatchedDays(i)
+	return cu
+}
+
+// SetNillableColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableColdStorageUnwatchedDays(i *int64) *ChannelUpdate {
+	if i != nil {
+		cu.SetColdStorageUnwatchedDays(*i)
+	}
+	return cu
+}
+
+// AddColdStorageUnwatchedDays adds i to the "cold_storage_unwatched_days" field.
+func (cu *ChannelUpdate) AddColdStorageUnwatchedDays(i int64) *ChannelUpdate {
+	cu.mutation.AddColdStorageUnwatchedDays(i)
+	return cu
+}
+
+// ClearColdStorageUnwatchedDays clears the value of the "cold_storage_unwatched_days" field.
+func (cu *ChannelUpdate) ClearColdStorageUnwatchedDays() *ChannelUpdate {
+	cu.mutation.ClearColdStorageUnwatchedDays()
+	return cu
+}
+
+// SetRestricted sets the "restricted" field.
+func (cu *ChannelUpdate) SetRestricted(b bool) *ChannelUpdate {
+	cu.mutation.SetRestricted(b)
+	return cu
+}
+
+// SetNillableRestricted sets the "restricted" field if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableRestricted(b *bool) *ChannelUpdate {
+	if b != nil {
+		cu.SetRestricted(*b)
+	}
+	return cu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cu *ChannelUpdate) SetUpdatedAt(t time.Time) *ChannelUpdate {
 	cu.mutation.SetUpdatedAt(t)
@@ -170,6 +397,40 @@ func (cu *ChannelUpdate) AddLive(l ...*Live) *ChannelUpdate {
 	return cu.AddLiveIDs(ids...)
 }
 
+// SetArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID.
+func (cu *ChannelUpdate) SetArchiveProfileID(id uuid.UUID) *ChannelUpdate {
+	cu.mutation.SetArchiveProfileID(id)
+	return cu
+}
+
+// SetNillableArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID if the given value is not nil.
+func (cu *ChannelUpdate) SetNillableArchiveProfileID(id *uuid.UUID) *ChannelUpdate {
+	if id != nil {
+		cu = cu.SetArchiveProfileID(*id)
+	}
+	return cu
+}
+
+// SetArchiveProfile sets the "archive_profile" edge to the ArchiveProfile entity.
+func (cu *ChannelUpdate) SetArchiveProfile(a *ArchiveProfile) *ChannelUpdate {
+	return cu.SetArchiveProfileID(a.ID)
+}
+
+// AddPermissionIDs adds the "permissions" edge to the ChannelPermission entity by IDs.
+func (cu *ChannelUpdate) AddPermissionIDs(ids ...uuid.UUID) *ChannelUpdate {
+	cu.mutation.AddPermissionIDs(ids...)
+	return cu
+}
+
+// AddPermissions adds the "permissions" edges to the ChannelPermission entity.
+func (cu *ChannelUpdate) AddPermissions(c ...*ChannelPermission) *ChannelUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddPermissionIDs(ids...)
+}
+
 // Mutation returns the ChannelMutation object of the builder.
 func (cu *ChannelUpdate) Mutation() *ChannelMutation {
 	return cu.mutation
@@ -217,6 +478,33 @@ func (cu *ChannelUpdate) RemoveLive(l ...*Live) *ChannelUpdate {
 	return cu.RemoveLiveIDs(ids...)
 }
 
+// ClearArchiveProfile clears the "archive_profile" edge to the ArchiveProfile entity.
+func (cu *ChannelUpdate) ClearArchiveProfile() *ChannelUpdate {
+	cu.mutation.ClearArchiveProfile()
+	return cu
+}
+
+// ClearPermissions clears all "permissions" edges to the ChannelPermission entity.
+func (cu *ChannelUpdate) ClearPermissions() *ChannelUpdate {
+	cu.mutation.ClearPermissions()
+	return cu
+}
+
+// RemovePermissionIDs removes the "permissions" edge to ChannelPermission entities by IDs.
+func (cu *ChannelUpdate) RemovePermissionIDs(ids ...uuid.UUID) *ChannelUpdate {
+	cu.mutation.RemovePermissionIDs(ids...)
+	return cu
+}
+
+// RemovePermissions removes "permissions" edges to ChannelPermission entities.
+func (cu *ChannelUpdate) RemovePermissions(c ...*ChannelPermission) *ChannelUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemovePermissionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *ChannelUpdate) Save(ctx context.Context) (int, error) {
 	cu.defaults()
@@ -253,7 +541,25 @@ func (cu *ChannelUpdate) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cu *ChannelUpdate) check() error {
+	if v, ok := cu.mutation.Platform(); ok {
+		if err := channel.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Channel.platform": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.RetentionAction(); ok {
+		if err := channel.RetentionActionValidator(v); err != nil {
+			return &ValidationError{Name: "retention_action", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_action": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (cu *ChannelUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(channel.Table, channel.Columns, sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID))
 	if ps := cu.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -262,6 +568,12 @@ func (cu *ChannelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(channel.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(channel.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := cu.mutation.ExtID(); ok {
 		_spec.SetField(channel.FieldExtID, field.TypeString, value)
 	}
@@ -277,6 +589,9 @@ func (cu *ChannelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.ImagePath(); ok {
 		_spec.SetField(channel.FieldImagePath, field.TypeString, value)
 	}
+	if value, ok := cu.mutation.Platform(); ok {
+		_spec.SetField(channel.FieldPlatform, field.TypeEnum, value)
+	}
 	if value, ok := cu.mutation.Retention(); ok {
 		_spec.SetField(channel.FieldRetention, field.TypeBool, value)
 	}
@@ -289,6 +604,63 @@ func (cu *ChannelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.RetentionDaysCleared() {
 		_spec.ClearField(channel.FieldRetentionDays, field.TypeInt64)
 	}
+	if value, ok := cu.mutation.RetentionTypeDays(); ok {
+		_spec.SetField(channel.FieldRetentionTypeDays, field.TypeJSON, value)
+	}
+	if cu.mutation.RetentionTypeDaysCleared() {
+		_spec.ClearField(channel.FieldRetentionTypeDays, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.RetentionKeepLast(); ok {
+		_spec.SetField(channel.FieldRetentionKeepLast, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedRetentionKeepLast(); ok {
+		_spec.AddField(channel.FieldRetentionKeepLast, field.TypeInt64, value)
+	}
+	if cu.mutation.RetentionKeepLastCleared() {
+		_spec.ClearField(channel.FieldRetentionKeepLast, field.TypeInt64)
+	}
+	if value, ok := cu.mutation.RetentionMaxSizeGB(); ok {
+		_spec.SetField(channel.FieldRetentionMaxSizeGB, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedRetentionMaxSizeGB(); ok {
+		_spec.AddField(channel.FieldRetentionMaxSizeGB, field.TypeInt64, value)
+	}
+	if cu.mutation.RetentionMaxSizeGBCleared() {
+		_spec.ClearField(channel.FieldRetentionMaxSizeGB, field.TypeInt64)
+	}
+	if value, ok := cu.mutation.RetentionProtectPlaylists(); ok {
+		_spec.SetField(channel.FieldRetentionProtectPlaylists, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.RetentionProtectWatched(); ok {
+		_spec.SetField(channel.FieldRetentionProtectWatched, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.RetentionAction(); ok {
+		_spec.SetField(channel.FieldRetentionAction, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.ColdStorage(); ok {
+		_spec.SetField(channel.FieldColdStorage, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.ColdStorageDays(); ok {
+		_spec.SetField(channel.FieldColdStorageDays, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedColdStorageDays(); ok {
+		_spec.AddField(channel.FieldColdStorageDays, field.TypeInt64, value)
+	}
+	if cu.mutation.ColdStorageDaysCleared() {
+		_spec.ClearField(channel.FieldColdStorageDays, field.TypeInt64)
+	}
+	if value, ok := cu.mutation.ColdStorageUnwatchedDays(); ok {
+		_spec.SetField(channel.FieldColdStorageUnwatchedDays, field.TypeInt64, value)
+	}
+	if value, ok := cu.mutation.AddedColdStorageUnwatchedDays(); ok {
+		_spec.AddField(channel.FieldColdStorageUnwatchedDays, field.TypeInt64, value)
+	}
+	if cu.mutation.ColdStorageUnwatchedDaysCleared() {
+		_spec.ClearField(channel.FieldColdStorageUnwatchedDays, field.TypeInt64)
+	}
+	if value, ok := cu.mutation.Restricted(); ok {
+		_spec.SetField(channel.FieldRestricted, field.TypeBool, value)
+	}
 	if value, ok := cu.mutation.UpdatedAt(); ok {
 		_spec.SetField(channel.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -382,6 +754,80 @@ func (cu *ChannelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.ArchiveProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channel.ArchiveProfileTable,
+			Columns: []string{channel.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ArchiveProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channel.ArchiveProfileTable,
+			Columns: []string{channel.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.PermissionsTable,
+			Columns: []string{channel.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !cu.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.PermissionsTable,
+			Columns: []string{channel.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.PermissionsTable,
+			Columns: []string{channel.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{channel.Label}
@@ -402,6 +848,26 @@ type ChannelUpdateOne struct {
 	mutation *ChannelMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *ChannelUpdateOne) SetDeletedAt(t time.Time) *ChannelUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableDeletedAt(t *time.Time) *ChannelUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *ChannelUpdateOne) ClearDeletedAt() *ChannelUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
 // SetExtID sets the "ext_id" field.
 func (cuo *ChannelUpdateOne) SetExtID(s string) *ChannelUpdateOne {
 	cuo.mutation.SetExtID(s)
@@ -464,6 +930,20 @@ func (cuo *ChannelUpdateOne) SetNillableImagePath(s *string) *ChannelUpdateOne {
 	return cuo
 }
 
+// SetPlatform sets the "platform" field.
+func (cuo *ChannelUpdateOne) SetPlatform(up utils.VodPlatform) *ChannelUpdateOne {
+	cuo.mutation.SetPlatform(up)
+	return cuo
+}
+
+// SetNillablePlatform sets the "platform" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillablePlatform(up *utils.VodPlatform) *ChannelUpdateOne {
+	if up != nil {
+		cuo.SetPlatform(*up)
+	}
+	return cuo
+}
+
 // SetRetention sets the "retention" field.
 func (cuo *ChannelUpdateOne) SetRetention(b bool) *ChannelUpdateOne {
 	cuo.mutation.SetRetention(b)
@@ -505,6 +985,196 @@ func (cuo *ChannelUpdateOne) ClearRetentionDays() *ChannelUpdateOne {
 	return cuo
 }
 
+// SetRetentionTypeDays sets the "retention_type_days" field.
+func (cuo *ChannelUpdateOne) SetRetentionTypeDays(mt map[utils.VodType]int64) *ChannelUpdateOne {
+	cuo.mutation.SetRetentionTypeDays(mt)
+	return cuo
+}
+
+// ClearRetentionTypeDays clears the value of the "retention_type_days" field.
+func (cuo *ChannelUpdateOne) ClearRetentionTypeDays() *ChannelUpdateOne {
+	cuo.mutation.ClearRetentionTypeDays()
+	return cuo
+}
+
+// SetRetentionKeepLast sets the "retention_keep_last" field.
+func (cuo *ChannelUpdateOne) SetRetentionKeepLast(i int64) *ChannelUpdateOne {
+	cuo.mutation.ResetRetentionKeepLast()
+	cuo.mutation.SetRetentionKeepLast(i)
+	return cuo
+}
+
+// SetNillableRetentionKeepLast sets the "retention_keep_last" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableRetentionKeepLast(i *int64) *ChannelUpdateOne {
+	if i != nil {
+		cuo.SetRetentionKeepLast(*i)
+	}
+	return cuo
+}
+
+// AddRetentionKeepLast adds i to the "retention_keep_last" field.
+func (cuo *ChannelUpdateOne) AddRetentionKeepLast(i int64) *ChannelUpdateOne {
+	cuo.mutation.AddRetentionKeepLast(i)
+	return cuo
+}
+
+// ClearRetentionKeepLast clears the value of the "retention_keep_last" field.
+func (cuo *ChannelUpdateOne) ClearRetentionKeepLast() *ChannelUpdateOne {
+	cuo.mutation.ClearRetentionKeepLast()
+	return cuo
+}
+
+// SetRetentionMaxSizeGB sets the "retention_max_size_gb" field.
+func (cuo *ChannelUpdateOne) SetRetentionMaxSizeGB(i int64) *ChannelUpdateOne {
+	cuo.mutation.ResetRetentionMaxSizeGB()
+	cuo.mutation.SetRetentionMaxSizeGB(i)
+	return cuo
+}
+
+// SetNillableRetentionMaxSizeGB sets the "retention_max_size_gb" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableRetentionMaxSizeGB(i *int64) *ChannelUpdateOne {
+	if i != nil {
+		cuo.SetRetentionMaxSizeGB(*i)
+	}
+	return cuo
+}
+
+// AddRetentionMaxSizeGB adds i to the "retention_max_size_gb" field.
+func (cuo *ChannelUpdateOne) AddRetentionMaxSizeGB(i int64) *ChannelUpdateOne {
+	cuo.mutation.AddRetentionMaxSizeGB(i)
+	return cuo
+}
+
+// ClearRetentionMaxSizeGB clears the value of the "retention_max_size_gb" field.
+func (cuo *ChannelUpdateOne) ClearRetentionMaxSizeGB() *ChannelUpdateOne {
+	cuo.mutation.ClearRetentionMaxSizeGB()
+	return cuo
+}
+
+// SetRetentionProtectPlaylists sets the "retention_protect_playlists" field.
+func (cuo *ChannelUpdateOne) SetRetentionProtectPlaylists(b bool) *ChannelUpdateOne {
+	cuo.mutation.SetRetentionProtectPlaylists(b)
+	return cuo
+}
+
+// SetNillableRetentionProtectPlaylists sets the "retention_protect_playlists" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableRetentionProtectPlaylists(b *bool) *ChannelUpdateOne {
+	if b != nil {
+		cuo.SetRetentionProtectPlaylists(*b)
+	}
+	return cuo
+}
+
+// SetRetentionProtectWatched sets the "retention_protect_watched" field.
+func (cuo *ChannelUpdateOne) SetRetentionProtectWatched(b bool) *ChannelUpdateOne {
+	cuo.mutation.SetRetentionProtectWatched(b)
+	return cuo
+}
+
+// SetNillableRetentionProtectWatched sets the "retention_protect_watched" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableRetentionProtectWatched(b *bool) *ChannelUpdateOne {
+	if b != nil {
+		cuo.SetRetentionProtectWatched(*b)
+	}
+	return cuo
+}
+
+// SetRetentionAction sets the "retention_action" field.
+func (cuo *ChannelUpdateOne) SetRetentionAction(ua utils.RetentionAction) *ChannelUpdateOne {
+	cuo.mutation.SetRetentionAction(ua)
+	return cuo
+}
+
+// SetNillableRetentionAction sets the "retention_action" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableRetentionAction(ua *utils.RetentionAction) *ChannelUpdateOne {
+	if ua != nil {
+		cuo.SetRetentionAction(*ua)
+	}
+	return cuo
+}
+
+// SetColdStorage sets the "cold_storage" field.
+func (cuo *ChannelUpdateOne) SetColdStorage(b bool) *ChannelUpdateOne {
+	cuo.mutation.SetColdStorage(b)
+	return cuo
+}
+
+// SetNillableColdStorage sets the "cold_storage" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableColdStorage(b *bool) *ChannelUpdateOne {
+	if b != nil {
+		cuo.SetColdStorage(*b)
+	}
+	return cuo
+}
+
+// SetColdStorageDays sets the "cold_storage_days" field.
+func (cuo *ChannelUpdateOne) SetColdStorageDays(i int64) *ChannelUpdateOne {
+	cuo.mutation.ResetColdStorageDays()
+	cuo.mutation.SetColdStorageDays(i)
+	return cuo
+}
+
+// SetNillableColdStorageDays sets the "cold_storage_days" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableColdStorageDays(i *int64) *ChannelUpdateOne {
+	if i != nil {
+		cuo.SetColdStorageDays(*i)
+	}
+	return cuo
+}
+
+// AddColdStorageDays adds i to the "cold_storage_days" field.
+func (cuo *ChannelUpdateOne) AddColdStorageDays(i int64) *ChannelUpdateOne {
+	cuo.mutation.AddColdStorageDays(i)
+	return cuo
+}
+
+// ClearColdStorageDays clears the value of the "cold_storage_days" field.
+func (cuo *ChannelUpdateOne) ClearColdStorageDays() *ChannelUpdateOne {
+	cuo.mutation.ClearColdStorageDays()
+	return cuo
+}
+
+// SetColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field.
+func (cuo *ChannelUpdateOne) SetColdStorageUnwatchedDays(i int64) *ChannelUpdateOne {
+	cuo.mutation.ResetColdStorageUnwatchedDays()
+	cuo.mutation.SetColdStorageUnwatchedDays(i)
+	return cuo
+}
+
+// SetNillableColdStorageUnwatchedDays sets the "cold_storage_unwatched_days" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableColdStorageUnwatchedDays(i *int64) *ChannelUpdateOne {
+	if i != nil {
+		cuo.SetColdStorageUnwatchedDays(*i)
+	}
+	return cuo
+}
+
+// AddColdStorageUnwatchedDays adds i to the "cold_storage_unwatched_days" field.
+func (cuo *ChannelUpdateOne) AddColdStorageUnwatchedDays(i int64) *ChannelUpdateOne {
+	cuo.mutation.AddColdStorageUnwatchedDays(i)
+	return cuo
+}
+
+// ClearColdStorageUnwatchedDays clears the value of the "cold_storage_unwatched_days" field.
+func (cuo *ChannelUpdateOne) ClearColdStorageUnwatchedDays() *ChannelUpdateOne {
+	cuo.mutation.ClearColdStorageUnwatchedDays()
+	return cuo
+}
+
+// SetRestricted sets the "restricted" field.
+func (cuo *ChannelUpdateOne) SetRestricted(b bool) *ChannelUpdateOne {
+	cuo.mutation.SetRestricted(b)
+	return cuo
+}
+
+// SetNillableRestricted sets the "restricted" field if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableRestricted(b *bool) *ChannelUpdateOne {
+	if b != nil {
+		cuo.SetRestricted(*b)
+	}
+	return cuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cuo *ChannelUpdateOne) SetUpdatedAt(t time.Time) *ChannelUpdateOne {
 	cuo.mutation.SetUpdatedAt(t)
@@ -541,6 +1211,40 @@ func (cuo *ChannelUpdateOne) AddLive(l ...*Live) *ChannelUpdateOne {
 	return cuo.AddLiveIDs(ids...)
 }
 
+// SetArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID.
+func (cuo *ChannelUpdateOne) SetArchiveProfileID(id uuid.UUID) *ChannelUpdateOne {
+	cuo.mutation.SetArchiveProfileID(id)
+	return cuo
+}
+
+// SetNillableArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID if the given value is not nil.
+func (cuo *ChannelUpdateOne) SetNillableArchiveProfileID(id *uuid.UUID) *ChannelUpdateOne {
+	if id != nil {
+		cuo = cuo.SetArchiveProfileID(*id)
+	}
+	return cuo
+}
+
+// SetArchiveProfile sets the "archive_profile" edge to the ArchiveProfile entity.
+func (cuo *ChannelUpdateOne) SetArchiveProfile(a *ArchiveProfile) *ChannelUpdateOne {
+	return cuo.SetArchiveProfileID(a.ID)
+}
+
+// AddPermissionIDs adds the "permissions" edge to the ChannelPermission entity by IDs.
+func (cuo *ChannelUpdateOne) AddPermissionIDs(ids ...uuid.UUID) *ChannelUpdateOne {
+	cuo.mutation.AddPermissionIDs(ids...)
+	return cuo
+}
+
+// AddPermissions adds the "permissions" edges to the ChannelPermission entity.
+func (cuo *ChannelUpdateOne) AddPermissions(c ...*ChannelPermission) *ChannelUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddPermissionIDs(ids...)
+}
+
 // Mutation returns the ChannelMutation object of the builder.
 func (cuo *ChannelUpdateOne) Mutation() *ChannelMutation {
 	return cuo.mutation
@@ -588,6 +1292,33 @@ func (cuo *ChannelUpdateOne) RemoveLive(l ...*Live) *ChannelUpdateOne {
 	return cuo.RemoveLiveIDs(ids...)
 }
 
+// ClearArchiveProfile clears the "archive_profile" edge to the ArchiveProfile entity.
+func (cuo *ChannelUpdateOne) ClearArchiveProfile() *ChannelUpdateOne {
+	cuo.mutation.ClearArchiveProfile()
+	return cuo
+}
+
+// ClearPermissions clears all "permissions" edges to the ChannelPermission entity.
+func (cuo *ChannelUpdateOne) ClearPermissions() *ChannelUpdateOne {
+	cuo.mutation.ClearPermissions()
+	return cuo
+}
+
+// RemovePermissionIDs removes the "permissions" edge to ChannelPermission entities by IDs.
+func (cuo *ChannelUpdateOne) RemovePermissionIDs(ids ...uuid.UUID) *ChannelUpdateOne {
+	cuo.mutation.RemovePermissionIDs(ids...)
+	return cuo
+}
+
+// RemovePermissions removes "permissions" edges to ChannelPermission entities.
+func (cuo *ChannelUpdateOne) RemovePermissions(c ...*ChannelPermission) *ChannelUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemovePermissionIDs(ids...)
+}
+
 // Where appends a list predicates to the ChannelUpdate builder.
 func (cuo *ChannelUpdateOne) Where(ps ...predicate.Channel) *ChannelUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -637,7 +1368,25 @@ func (cuo *ChannelUpdateOne) defaults() {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cuo *ChannelUpdateOne) check() error {
+	if v, ok := cuo.mutation.Platform(); ok {
+		if err := channel.PlatformValidator(v); err != nil {
+			return &ValidationError{Name: "platform", err: fmt.Errorf(`ent: validator failed for field "Channel.platform": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.RetentionAction(); ok {
+		if err := channel.RetentionActionValidator(v); err != nil {
+			return &ValidationError{Name: "retention_action", err: fmt.Errorf(`ent: validator failed for field "Channel.retention_action": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (cuo *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(channel.Table, channel.Columns, sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID))
 	id, ok := cuo.mutation.ID()
 	if !ok {
@@ -663,6 +1412,12 @@ func (cuo *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err e
 			}
 		}
 	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(channel.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(channel.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := cuo.mutation.ExtID(); ok {
 		_spec.SetField(channel.FieldExtID, field.TypeString, value)
 	}
@@ -678,6 +1433,9 @@ func (cuo *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err e
 	if value, ok := cuo.mutation.ImagePath(); ok {
 		_spec.SetField(channel.FieldImagePath, field.TypeString, value)
 	}
+	if value, ok := cuo.mutation.Platform(); ok {
+		_spec.SetField(channel.FieldPlatform, field.TypeEnum, value)
+	}
 	if value, ok := cuo.mutation.Retention(); ok {
 		_spec.SetField(channel.FieldRetention, field.TypeBool, value)
 	}
@@ -690,6 +1448,63 @@ func (cuo *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err e
 	if cuo.mutation.RetentionDaysCleared() {
 		_spec.ClearField(channel.FieldRetentionDays, field.TypeInt64)
 	}
+	if value, ok := cuo.mutation.RetentionTypeDays(); ok {
+		_spec.SetField(channel.FieldRetentionTypeDays, field.TypeJSON, value)
+	}
+	if cuo.mutation.RetentionTypeDaysCleared() {
+		_spec.ClearField(channel.FieldRetentionTypeDays, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.RetentionKeepLast(); ok {
+		_spec.SetField(channel.FieldRetentionKeepLast, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedRetentionKeepLast(); ok {
+		_spec.AddField(channel.FieldRetentionKeepLast, field.TypeInt64, value)
+	}
+	if cuo.mutation.RetentionKeepLastCleared() {
+		_spec.ClearField(channel.FieldRetentionKeepLast, field.TypeInt64)
+	}
+	if value, ok := cuo.mutation.RetentionMaxSizeGB(); ok {
+		_spec.SetField(channel.FieldRetentionMaxSizeGB, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedRetentionMaxSizeGB(); ok {
+		_spec.AddField(channel.FieldRetentionMaxSizeGB, field.TypeInt64, value)
+	}
+	if cuo.mutation.RetentionMaxSizeGBCleared() {
+		_spec.ClearField(channel.FieldRetentionMaxSizeGB, field.TypeInt64)
+	}
+	if value, ok := cuo.mutation.RetentionProtectPlaylists(); ok {
+		_spec.SetField(channel.FieldRetentionProtectPlaylists, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.RetentionProtectWatched(); ok {
+		_spec.SetField(channel.FieldRetentionProtectWatched, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.RetentionAction(); ok {
+		_spec.SetField(channel.FieldRetentionAction, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.ColdStorage(); ok {
+		_spec.SetField(channel.FieldColdStorage, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.ColdStorageDays(); ok {
+		_spec.SetField(channel.FieldColdStorageDays, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedColdStorageDays(); ok {
+		_spec.AddField(channel.FieldColdStorageDays, field.TypeInt64, value)
+	}
+	if cuo.mutation.ColdStorageDaysCleared() {
+		_spec.ClearField(channel.FieldColdStorageDays, field.TypeInt64)
+	}
+	if value, ok := cuo.mutation.ColdStorageUnwatchedDays(); ok {
+		_spec.SetField(channel.FieldColdStorageUnwatchedDays, field.TypeInt64, value)
+	}
+	if value, ok := cuo.mutation.AddedColdStorageUnwatchedDays(); ok {
+		_spec.AddField(channel.FieldColdStorageUnwatchedDays, field.TypeInt64, value)
+	}
+	if cuo.mutation.ColdStorageUnwatchedDaysCleared() {
+		_spec.ClearField(channel.FieldColdStorageUnwatchedDays, field.TypeInt64)
+	}
+	if value, ok := cuo.mutation.Restricted(); ok {
+		_spec.SetField(channel.FieldRestricted, field.TypeBool, value)
+	}
 	if value, ok := cuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(channel.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -783,6 +1598,80 @@ func (cuo *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.ArchiveProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channel.ArchiveProfileTable,
+			Columns: []string{channel.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ArchiveProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channel.ArchiveProfileTable,
+			Columns: []string{channel.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.PermissionsTable,
+			Columns: []string{channel.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !cuo.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.PermissionsTable,
+			Columns: []string{channel.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   channel.PermissionsTable,
+			Columns: []string{channel.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Channel{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
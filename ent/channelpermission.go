@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
+	"github.com/zibbp/ganymede/ent/group"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ChannelPermission is the model entity for the ChannelPermission schema.
+type ChannelPermission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The access granted to the channel, takes an enum.
+	Access utils.ChannelAccess `json:"access,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChannelPermissionQuery when eager-loading is set.
+	Edges                     ChannelPermissionEdges `json:"edges"`
+	channel_permissions       *uuid.UUID
+	group_channel_permissions *uuid.UUID
+	user_channel_permissions  *uuid.UUID
+	selectValues              sql.SelectValues
+}
+
+// ChannelPermissionEdges holds the relations/edges for other nodes in the graph.
+type ChannelPermissionEdges struct {
+	// Channel holds the value of the channel edge.
+	Channel *Channel `json:"channel,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Group holds the value of the group edge.
+	Group *Group `json:"group,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ChannelOrErr returns the Channel value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChannelPermissionEdges) ChannelOrErr() (*Channel, error) {
+	if e.Channel != nil {
+		return e.Channel, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: channel.Label}
+	}
+	return nil, &NotLoadedError{edge: "channel"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChannelPermissionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChannelPermissionEdges) GroupOrErr() (*Group, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: group.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChannelPermission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case channelpermission.FieldAccess:
+			values[i] = new(sql.NullString)
+		case channelpermission.FieldUpdatedAt, channelpermission.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case channelpermission.FieldID:
+			values[i] = new(uuid.UUID)
+		case channelpermission.ForeignKeys[0]: // channel_permissions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case channelpermission.ForeignKeys[1]: // group_channel_permissions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case channelpermission.ForeignKeys[2]: // user_channel_permissions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChannelPermission fields.
+func (cp *ChannelPermission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case channelpermission.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cp.ID = *value
+			}
+		case channelpermission.FieldAccess:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access", values[i])
+			} else if value.Valid {
+				cp.Access = utils.ChannelAccess(value.String)
+			}
+		case channelpermission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cp.UpdatedAt = value.Time
+			}
+		case channelpermission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cp.CreatedAt = value.Time
+			}
+		case channelpermission.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field channel_permissions", values[i])
+			} else if value.Valid {
+				cp.channel_permissions = new(uuid.UUID)
+				*cp.channel_permissions = *value.S.(*uuid.UUID)
+			}
+		case channelpermission.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_channel_permissions", values[i])
+			} else if value.Valid {
+				cp.group_channel_permissions = new(uuid.UUID)
+				*cp.group_channel_permissions = *value.S.(*uuid.UUID)
+			}
+		case channelpermission.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_channel_permissions", values[i])
+			} else if value.Valid {
+				cp.user_channel_permissions = new(uuid.UUID)
+				*cp.user_channel_permissions = *value.S.(*uuid.UUID)
+			}
+		default:
+			cp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChannelPermission.
+// This includes values selected through modifiers, order, etc.
+func (cp *ChannelPermission) Value(name string) (ent.Value, error) {
+	return cp.selectValues.Get(name)
+}
+
+// QueryChannel queries the "channel" edge of the ChannelPermission entity.
+func (cp *ChannelPermission) QueryChannel() *ChannelQuery {
+	return NewChannelPermissionClient(cp.config).QueryChannel(cp)
+}
+
+// QueryUser queries the "user" edge of the ChannelPermission entity.
+func (cp *ChannelPermission) QueryUser() *UserQuery {
+	return NewChannelPermissionClient(cp.config).QueryUser(cp)
+}
+
+// QueryGroup queries the "group" edge of the ChannelPermission entity.
+func (cp *ChannelPermission) QueryGroup() *GroupQuery {
+	return NewChannelPermissionClient(cp.config).QueryGroup(cp)
+}
+
+// Update returns a builder for updating this ChannelPermission.
+// Note that you need to call ChannelPermission.Unwrap() before calling this method if this ChannelPermission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cp *ChannelPermission) Update() *ChannelPermissionUpdateOne {
+	return NewChannelPermissionClient(cp.config).UpdateOne(cp)
+}
+
+// Unwrap unwraps the ChannelPermission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cp *ChannelPermission) Unwrap() *ChannelPermission {
+	_tx, ok := cp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChannelPermission is not a transactional entity")
+	}
+	cp.config.driver = _tx.drv
+	return cp
+}
+
+// String implements the fmt.Stringer.
+func (cp *ChannelPermission) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChannelPermission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cp.ID))
+	builder.WriteString("access=")
+	builder.WriteString(fmt.Sprintf("%v", cp.Access))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChannelPermissions is a parsable slice of ChannelPermission.
+type ChannelPermissions []*ChannelPermission
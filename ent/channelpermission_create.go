@@ -0,0 +1,729 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
+	"github.com/zibbp/ganymede/ent/group"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ChannelPermissionCreate is the builder for creating a ChannelPermission entity.
+type ChannelPermissionCreate struct {
+	config
+	mutation *ChannelPermissionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetAccess sets the "access" field.
+func (cpc *ChannelPermissionCreate) SetAccess(ua utils.ChannelAccess) *ChannelPermissionCreate {
+	cpc.mutation.SetAccess(ua)
+	return cpc
+}
+
+// SetNillableAccess sets the "access" field if the given value is not nil.
+func (cpc *ChannelPermissionCreate) SetNillableAccess(ua *utils.ChannelAccess) *ChannelPermissionCreate {
+	if ua != nil {
+		cpc.SetAccess(*ua)
+	}
+	return cpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cpc *ChannelPermissionCreate) SetUpdatedAt(t time.Time) *ChannelPermissionCreate {
+	cpc.mutation.SetUpdatedAt(t)
+	return cpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cpc *ChannelPermissionCreate) SetNillableUpdatedAt(t *time.Time) *ChannelPermissionCreate {
+	if t != nil {
+		cpc.SetUpdatedAt(*t)
+	}
+	return cpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cpc *ChannelPermissionCreate) SetCreatedAt(t time.Time) *ChannelPermissionCreate {
+	cpc.mutation.SetCreatedAt(t)
+	return cpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cpc *ChannelPermissionCreate) SetNillableCreatedAt(t *time.Time) *ChannelPermissionCreate {
+	if t != nil {
+		cpc.SetCreatedAt(*t)
+	}
+	return cpc
+}
+
+// SetID sets the "id" field.
+func (cpc *ChannelPermissionCreate) SetID(u uuid.UUID) *ChannelPermissionCreate {
+	cpc.mutation.SetID(u)
+	return cpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cpc *ChannelPermissionCreate) SetNillableID(u *uuid.UUID) *ChannelPermissionCreate {
+	if u != nil {
+		cpc.SetID(*u)
+	}
+	return cpc
+}
+
+// SetChannelID sets the "channel" edge to the Channel entity by ID.
+func (cpc *ChannelPermissionCreate) SetChannelID(id uuid.UUID) *ChannelPermissionCreate {
+	cpc.mutation.SetChannelID(id)
+	return cpc
+}
+
+// SetChannel sets the "channel" edge to the Channel entity.
+func (cpc *ChannelPermissionCreate) SetChannel(c *Channel) *ChannelPermissionCreate {
+	return cpc.SetChannelID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (cpc *ChannelPermissionCreate) SetUserID(id uuid.UUID) *ChannelPermissionCreate {
+	cpc.mutation.SetUserID(id)
+	return cpc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (cpc *ChannelPermissionCreate) SetNillableUserID(id *uuid.UUID) *ChannelPermissionCreate {
+	if id != nil {
+		cpc = cpc.SetUserID(*id)
+	}
+	return cpc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cpc *ChannelPermissionCreate) SetUser(u *User) *ChannelPermissionCreate {
+	return cpc.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (cpc *ChannelPermissionCreate) SetGroupID(id uuid.UUID) *ChannelPermissionCreate {
+	cpc.mutation.SetGroupID(id)
+	return cpc
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (cpc *ChannelPermissionCreate) SetNillableGroupID(id *uuid.UUID) *ChannelPermissionCreate {
+	if id != nil {
+		cpc = cpc.SetGroupID(*id)
+	}
+	return cpc
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (cpc *ChannelPermissionCreate) SetGroup(g *Group) *ChannelPermissionCreate {
+	return cpc.SetGroupID(g.ID)
+}
+
+// Mutation returns the ChannelPermissionMutation object of the builder.
+func (cpc *ChannelPermissionCreate) Mutation() *ChannelPermissionMutation {
+	return cpc.mutation
+}
+
+// Save creates the ChannelPermission in the database.
+func (cpc *ChannelPermissionCreate) Save(ctx context.Context) (*ChannelPermission, error) {
+	cpc.defaults()
+	return withHooks(ctx, cpc.sqlSave, cpc.mutation, cpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cpc *ChannelPermissionCreate) SaveX(ctx context.Context) *ChannelPermission {
+	v, err := cpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpc *ChannelPermissionCreate) Exec(ctx context.Context) error {
+	_, err := cpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpc *ChannelPermissionCreate) ExecX(ctx context.Context) {
+	if err := cpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cpc *ChannelPermissionCreate) defaults() {
+	if _, ok := cpc.mutation.Access(); !ok {
+		v := channelpermission.DefaultAccess
+		cpc.mutation.SetAccess(v)
+	}
+	if _, ok := cpc.mutation.UpdatedAt(); !ok {
+		v := channelpermission.DefaultUpdatedAt()
+		cpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cpc.mutation.CreatedAt(); !ok {
+		v := channelpermission.DefaultCreatedAt()
+		cpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cpc.mutation.ID(); !ok {
+		v := channelpermission.DefaultID()
+		cpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpc *ChannelPermissionCreate) check() error {
+	if _, ok := cpc.mutation.Access(); !ok {
+		return &ValidationError{Name: "access", err: errors.New(`ent: missing required field "ChannelPermission.access"`)}
+	}
+	if v, ok := cpc.mutation.Access(); ok {
+		if err := channelpermission.AccessValidator(v); err != nil {
+			return &ValidationError{Name: "access", err: fmt.Errorf(`ent: validator failed for field "ChannelPermission.access": %w`, err)}
+		}
+	}
+	if _, ok := cpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ChannelPermission.updated_at"`)}
+	}
+	if _, ok := cpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ChannelPermission.created_at"`)}
+	}
+	if _, ok := cpc.mutation.ChannelID(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required edge "ChannelPermission.channel"`)}
+	}
+	return nil
+}
+
+func (cpc *ChannelPermissionCreate) sqlSave(ctx context.Context) (*ChannelPermission, error) {
+	if err := cpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cpc.mutation.id = &_node.ID
+	cpc.mutation.done = true
+	return _node, nil
+}
+
+func (cpc *ChannelPermissionCreate) createSpec() (*ChannelPermission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChannelPermission{config: cpc.config}
+		_spec = sqlgraph.NewCreateSpec(channelpermission.Table, sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cpc.conflict
+	if id, ok := cpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cpc.mutation.Access(); ok {
+		_spec.SetField(channelpermission.FieldAccess, field.TypeEnum, value)
+		_node.Access = value
+	}
+	if value, ok := cpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(channelpermission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cpc.mutation.CreatedAt(); ok {
+		_spec.SetField(channelpermission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := cpc.mutation.ChannelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.ChannelTable,
+			Columns: []string{channelpermission.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.channel_permissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cpc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.UserTable,
+			Columns: []string{channelpermission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_channel_permissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cpc.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.GroupTable,
+			Columns: []string{channelpermission.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.group_channel_permissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChannelPermission.Create().
+//		SetAccess(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChannelPermissionUpsert) {
+//			SetAccess(v+v).
+//		}).
+//		Exec(ctx)
+func (cpc *ChannelPermissionCreate) OnConflict(opts ...sql.ConflictOption) *ChannelPermissionUpsertOne {
+	cpc.conflict = opts
+	return &ChannelPermissionUpsertOne{
+		create: cpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChannelPermission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cpc *ChannelPermissionCreate) OnConflictColumns(columns ...string) *ChannelPermissionUpsertOne {
+	cpc.conflict = append(cpc.conflict, sql.ConflictColumns(columns...))
+	return &ChannelPermissionUpsertOne{
+		create: cpc,
+	}
+}
+
+type (
+	// ChannelPermissionUpsertOne is the builder for "upsert"-ing
+	//  one ChannelPermission node.
+	ChannelPermissionUpsertOne struct {
+		create *ChannelPermissionCreate
+	}
+
+	// ChannelPermissionUpsert is the "OnConflict" setter.
+	ChannelPermissionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetAccess sets the "access" field.
+func (u *ChannelPermissionUpsert) SetAccess(v utils.ChannelAccess) *ChannelPermissionUpsert {
+	u.Set(channelpermission.FieldAccess, v)
+	return u
+}
+
+// UpdateAccess sets the "access" field to the value that was provided on create.
+func (u *ChannelPermissionUpsert) UpdateAccess() *ChannelPermissionUpsert {
+	u.SetExcluded(channelpermission.FieldAccess)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChannelPermissionUpsert) SetUpdatedAt(v time.Time) *ChannelPermissionUpsert {
+	u.Set(channelpermission.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChannelPermissionUpsert) UpdateUpdatedAt() *ChannelPermissionUpsert {
+	u.SetExcluded(channelpermission.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ChannelPermission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(channelpermission.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChannelPermissionUpsertOne) UpdateNewValues() *ChannelPermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(channelpermission.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(channelpermission.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChannelPermission.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChannelPermissionUpsertOne) Ignore() *ChannelPermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChannelPermissionUpsertOne) DoNothing() *ChannelPermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChannelPermissionCreate.OnConflict
+// documentation for more info.
+func (u *ChannelPermissionUpsertOne) Update(set func(*ChannelPermissionUpsert)) *ChannelPermissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChannelPermissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccess sets the "access" field.
+func (u *ChannelPermissionUpsertOne) SetAccess(v utils.ChannelAccess) *ChannelPermissionUpsertOne {
+	return u.Update(func(s *ChannelPermissionUpsert) {
+		s.SetAccess(v)
+	})
+}
+
+// UpdateAccess sets the "access" field to the value that was provided on create.
+func (u *ChannelPermissionUpsertOne) UpdateAccess() *ChannelPermissionUpsertOne {
+	return u.Update(func(s *ChannelPermissionUpsert) {
+		s.UpdateAccess()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChannelPermissionUpsertOne) SetUpdatedAt(v time.Time) *ChannelPermissionUpsertOne {
+	return u.Update(func(s *ChannelPermissionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChannelPermissionUpsertOne) UpdateUpdatedAt() *ChannelPermissionUpsertOne {
+	return u.Update(func(s *ChannelPermissionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChannelPermissionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChannelPermissionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChannelPermissionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChannelPermissionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChannelPermissionUpsertOne.ID is not supported by MySQL driver. Use ChannelPermissionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChannelPermissionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChannelPermissionCreateBulk is the builder for creating many ChannelPermission entities in bulk.
+type ChannelPermissionCreateBulk struct {
+	config
+	err      error
+	builders []*ChannelPermissionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ChannelPermission entities in the database.
+func (cpcb *ChannelPermissionCreateBulk) Save(ctx context.Context) ([]*ChannelPermission, error) {
+	if cpcb.err != nil {
+		return nil, cpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cpcb.builders))
+	nodes := make([]*ChannelPermission, len(cpcb.builders))
+	mutators := make([]Mutator, len(cpcb.builders))
+	for i := range cpcb.builders {
+		func(i int, root context.Context) {
+			builder := cpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChannelPermissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpcb *ChannelPermissionCreateBulk) SaveX(ctx context.Context) []*ChannelPermission {
+	v, err := cpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cpcb *ChannelPermissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpcb *ChannelPermissionCreateBulk) ExecX(ctx context.Context) {
+	if err := cpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChannelPermission.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChannelPermissionUpsert) {
+//			SetAccess(v+v).
+//		}).
+//		Exec(ctx)
+func (cpcb *ChannelPermissionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChannelPermissionUpsertBulk {
+	cpcb.conflict = opts
+	return &ChannelPermissionUpsertBulk{
+		create: cpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChannelPermission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cpcb *ChannelPermissionCreateBulk) OnConflictColumns(columns ...string) *ChannelPermissionUpsertBulk {
+	cpcb.conflict = append(cpcb.conflict, sql.ConflictColumns(columns...))
+	return &ChannelPermissionUpsertBulk{
+		create: cpcb,
+	}
+}
+
+// ChannelPermissionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChannelPermission nodes.
+type ChannelPermissionUpsertBulk struct {
+	create *ChannelPermissionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChannelPermission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(channelpermission.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChannelPermissionUpsertBulk) UpdateNewValues() *ChannelPermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(channelpermission.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(channelpermission.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChannelPermission.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChannelPermissionUpsertBulk) Ignore() *ChannelPermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChannelPermissionUpsertBulk) DoNothing() *ChannelPermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChannelPermissionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChannelPermissionUpsertBulk) Update(set func(*ChannelPermissionUpsert)) *ChannelPermissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChannelPermissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetAccess sets the "access" field.
+func (u *ChannelPermissionUpsertBulk) SetAccess(v utils.ChannelAccess) *ChannelPermissionUpsertBulk {
+	return u.Update(func(s *ChannelPermissionUpsert) {
+		s.SetAccess(v)
+	})
+}
+
+// UpdateAccess sets the "access" field to the value that was provided on create.
+func (u *ChannelPermissionUpsertBulk) UpdateAccess() *ChannelPermissionUpsertBulk {
+	return u.Update(func(s *ChannelPermissionUpsert) {
+		s.UpdateAccess()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ChannelPermissionUpsertBulk) SetUpdatedAt(v time.Time) *ChannelPermissionUpsertBulk {
+	return u.Update(func(s *ChannelPermissionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ChannelPermissionUpsertBulk) UpdateUpdatedAt() *ChannelPermissionUpsertBulk {
+	return u.Update(func(s *ChannelPermissionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ChannelPermissionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChannelPermissionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChannelPermissionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChannelPermissionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
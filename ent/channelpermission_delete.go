@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zibbp/ganymede/ent/channelpermission"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// ChannelPermissionDelete is the builder for deleting a ChannelPermission entity.
+type ChannelPermissionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChannelPermissionMutation
+}
+
+// Where appends a list predicates to the ChannelPermissionDelete builder.
+func (cpd *ChannelPermissionDelete) Where(ps ...predicate.ChannelPermission) *ChannelPermissionDelete {
+	cpd.mutation.Where(ps...)
+	return cpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cpd *ChannelPermissionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cpd.sqlExec, cpd.mutation, cpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpd *ChannelPermissionDelete) ExecX(ctx context.Context) int {
+	n, err := cpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cpd *ChannelPermissionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(channelpermission.Table, sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID))
+	if ps := cpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cpd.mutation.done = true
+	return affected, err
+}
+
+// ChannelPermissionDeleteOne is the builder for deleting a single ChannelPermission entity.
+type ChannelPermissionDeleteOne struct {
+	cpd *ChannelPermissionDelete
+}
+
+// Where appends a list predicates to the ChannelPermissionDelete builder.
+func (cpdo *ChannelPermissionDeleteOne) Where(ps ...predicate.ChannelPermission) *ChannelPermissionDeleteOne {
+	cpdo.cpd.mutation.Where(ps...)
+	return cpdo
+}
+
+// Exec executes the deletion query.
+func (cpdo *ChannelPermissionDeleteOne) Exec(ctx context.Context) error {
+	n, err := cpdo.cpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{channelpermission.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpdo *ChannelPermissionDeleteOne) ExecX(ctx context.Context) {
+	if err := cpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
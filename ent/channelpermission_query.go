@@ -0,0 +1,764 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
+	"github.com/zibbp/ganymede/ent/group"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/user"
+)
+
+// ChannelPermissionQuery is the builder for querying ChannelPermission entities.
+type ChannelPermissionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []channelpermission.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ChannelPermission
+	withChannel *ChannelQuery
+	withUser    *UserQuery
+	withGroup   *GroupQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ChannelPermissionQuery builder.
+func (cpq *ChannelPermissionQuery) Where(ps ...predicate.ChannelPermission) *ChannelPermissionQuery {
+	cpq.predicates = append(cpq.predicates, ps...)
+	return cpq
+}
+
+// Limit the number of records to be returned by this query.
+func (cpq *ChannelPermissionQuery) Limit(limit int) *ChannelPermissionQuery {
+	cpq.ctx.Limit = &limit
+	return cpq
+}
+
+// Offset to start from.
+func (cpq *ChannelPermissionQuery) Offset(offset int) *ChannelPermissionQuery {
+	cpq.ctx.Offset = &offset
+	return cpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cpq *ChannelPermissionQuery) Unique(unique bool) *ChannelPermissionQuery {
+	cpq.ctx.Unique = &unique
+	return cpq
+}
+
+// Order specifies how the records should be ordered.
+func (cpq *ChannelPermissionQuery) Order(o ...channelpermission.OrderOption) *ChannelPermissionQuery {
+	cpq.order = append(cpq.order, o...)
+	return cpq
+}
+
+// QueryChannel chains the current query on the "channel" edge.
+func (cpq *ChannelPermissionQuery) QueryChannel() *ChannelQuery {
+	query := (&ChannelClient{config: cpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channelpermission.Table, channelpermission.FieldID, selector),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, channelpermission.ChannelTable, channelpermission.ChannelColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (cpq *ChannelPermissionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: cpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channelpermission.Table, channelpermission.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, channelpermission.UserTable, channelpermission.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroup chains the current query on the "group" edge.
+func (cpq *ChannelPermissionQuery) QueryGroup() *GroupQuery {
+	query := (&GroupClient{config: cpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channelpermission.Table, channelpermission.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, channelpermission.GroupTable, channelpermission.GroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ChannelPermission entity from the query.
+// Returns a *NotFoundError when no ChannelPermission was found.
+func (cpq *ChannelPermissionQuery) First(ctx context.Context) (*ChannelPermission, error) {
+	nodes, err := cpq.Limit(1).All(setContextOp(ctx, cpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{channelpermission.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cpq *ChannelPermissionQuery) FirstX(ctx context.Context) *ChannelPermission {
+	node, err := cpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ChannelPermission ID from the query.
+// Returns a *NotFoundError when no ChannelPermission ID was found.
+func (cpq *ChannelPermissionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cpq.Limit(1).IDs(setContextOp(ctx, cpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{channelpermission.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cpq *ChannelPermissionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := cpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ChannelPermission entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ChannelPermission entity is found.
+// Returns a *NotFoundError when no ChannelPermission entities are found.
+func (cpq *ChannelPermissionQuery) Only(ctx context.Context) (*ChannelPermission, error) {
+	nodes, err := cpq.Limit(2).All(setContextOp(ctx, cpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{channelpermission.Label}
+	default:
+		return nil, &NotSingularError{channelpermission.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cpq *ChannelPermissionQuery) OnlyX(ctx context.Context) *ChannelPermission {
+	node, err := cpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ChannelPermission ID in the query.
+// Returns a *NotSingularError when more than one ChannelPermission ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cpq *ChannelPermissionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = cpq.Limit(2).IDs(setContextOp(ctx, cpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{channelpermission.Label}
+	default:
+		err = &NotSingularError{channelpermission.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cpq *ChannelPermissionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := cpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ChannelPermissions.
+func (cpq *ChannelPermissionQuery) All(ctx context.Context) ([]*ChannelPermission, error) {
+	ctx = setContextOp(ctx, cpq.ctx, "All")
+	if err := cpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ChannelPermission, *ChannelPermissionQuery]()
+	return withInterceptors[[]*ChannelPermission](ctx, cpq, qr, cpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cpq *ChannelPermissionQuery) AllX(ctx context.Context) []*ChannelPermission {
+	nodes, err := cpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ChannelPermission IDs.
+func (cpq *ChannelPermissionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if cpq.ctx.Unique == nil && cpq.path != nil {
+		cpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cpq.ctx, "IDs")
+	if err = cpq.Select(channelpermission.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cpq *ChannelPermissionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := cpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cpq *ChannelPermissionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cpq.ctx, "Count")
+	if err := cpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cpq, querierCount[*ChannelPermissionQuery](), cpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cpq *ChannelPermissionQuery) CountX(ctx context.Context) int {
+	count, err := cpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cpq *ChannelPermissionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cpq.ctx, "Exist")
+	switch _, err := cpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cpq *ChannelPermissionQuery) ExistX(ctx context.Context) bool {
+	exist, err := cpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ChannelPermissionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cpq *ChannelPermissionQuery) Clone() *ChannelPermissionQuery {
+	if cpq == nil {
+		return nil
+	}
+	return &ChannelPermissionQuery{
+		config:      cpq.config,
+		ctx:         cpq.ctx.Clone(),
+		order:       append([]channelpermission.OrderOption{}, cpq.order...),
+		inters:      append([]Interceptor{}, cpq.inters...),
+		predicates:  append([]predicate.ChannelPermission{}, cpq.predicates...),
+		withChannel: cpq.withChannel.Clone(),
+		withUser:    cpq.withUser.Clone(),
+		withGroup:   cpq.withGroup.Clone(),
+		// clone intermediate query.
+		sql:  cpq.sql.Clone(),
+		path: cpq.path,
+	}
+}
+
+// WithChannel tells the query-builder to eager-load the nodes that are connected to
+// the "channel" edge. The optional arguments are used to configure the query builder of the edge.
+func (cpq *ChannelPermissionQuery) WithChannel(opts ...func(*ChannelQuery)) *ChannelPermissionQuery {
+	query := (&ChannelClient{config: cpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cpq.withChannel = query
+	return cpq
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (cpq *ChannelPermissionQuery) WithUser(opts ...func(*UserQuery)) *ChannelPermissionQuery {
+	query := (&UserClient{config: cpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cpq.withUser = query
+	return cpq
+}
+
+// WithGroup tells the query-builder to eager-load the nodes that are connected to
+// the "group" edge. The optional arguments are used to configure the query builder of the edge.
+func (cpq *ChannelPermissionQuery) WithGroup(opts ...func(*GroupQuery)) *ChannelPermissionQuery {
+	query := (&GroupClient{config: cpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cpq.withGroup = query
+	return cpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Access utils.ChannelAccess `json:"access,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ChannelPermission.Query().
+//		GroupBy(channelpermission.FieldAccess).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cpq *ChannelPermissionQuery) GroupBy(field string, fields ...string) *ChannelPermissionGroupBy {
+	cpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ChannelPermissionGroupBy{build: cpq}
+	grbuild.flds = &cpq.ctx.Fields
+	grbuild.label = channelpermission.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Access utils.ChannelAccess `json:"access,omitempty"`
+//	}
+//
+//	client.ChannelPermission.Query().
+//		Select(channelpermission.FieldAccess).
+//		Scan(ctx, &v)
+func (cpq *ChannelPermissionQuery) Select(fields ...string) *ChannelPermissionSelect {
+	cpq.ctx.Fields = append(cpq.ctx.Fields, fields...)
+	sbuild := &ChannelPermissionSelect{ChannelPermissionQuery: cpq}
+	sbuild.label = channelpermission.Label
+	sbuild.flds, sbuild.scan = &cpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ChannelPermissionSelect configured with the given aggregations.
+func (cpq *ChannelPermissionQuery) Aggregate(fns ...AggregateFunc) *ChannelPermissionSelect {
+	return cpq.Select().Aggregate(fns...)
+}
+
+func (cpq *ChannelPermissionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cpq.ctx.Fields {
+		if !channelpermission.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cpq.path != nil {
+		prev, err := cpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cpq.sql = prev
+	}
+	return nil
+}
+
+func (cpq *ChannelPermissionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ChannelPermission, error) {
+	var (
+		nodes       = []*ChannelPermission{}
+		withFKs     = cpq.withFKs
+		_spec       = cpq.querySpec()
+		loadedTypes = [3]bool{
+			cpq.withChannel != nil,
+			cpq.withUser != nil,
+			cpq.withGroup != nil,
+		}
+	)
+	if cpq.withChannel != nil || cpq.withUser != nil || cpq.withGroup != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, channelpermission.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ChannelPermission).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ChannelPermission{config: cpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cpq.withChannel; query != nil {
+		if err := cpq.loadChannel(ctx, query, nodes, nil,
+			func(n *ChannelPermission, e *Channel) { n.Edges.Channel = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cpq.withUser; query != nil {
+		if err := cpq.loadUser(ctx, query, nodes, nil,
+			func(n *ChannelPermission, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cpq.withGroup; query != nil {
+		if err := cpq.loadGroup(ctx, query, nodes, nil,
+			func(n *ChannelPermission, e *Group) { n.Edges.Group = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cpq *ChannelPermissionQuery) loadChannel(ctx context.Context, query *ChannelQuery, nodes []*ChannelPermission, init func(*ChannelPermission), assign func(*ChannelPermission, *Channel)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ChannelPermission)
+	for i := range nodes {
+		if nodes[i].channel_permissions == nil {
+			continue
+		}
+		fk := *nodes[i].channel_permissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(channel.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "channel_permissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cpq *ChannelPermissionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ChannelPermission, init func(*ChannelPermission), assign func(*ChannelPermission, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ChannelPermission)
+	for i := range nodes {
+		if nodes[i].user_channel_permissions == nil {
+			continue
+		}
+		fk := *nodes[i].user_channel_permissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_channel_permissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (cpq *ChannelPermissionQuery) loadGroup(ctx context.Context, query *GroupQuery, nodes []*ChannelPermission, init func(*ChannelPermission), assign func(*ChannelPermission, *Group)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ChannelPermission)
+	for i := range nodes {
+		if nodes[i].group_channel_permissions == nil {
+			continue
+		}
+		fk := *nodes[i].group_channel_permissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(group.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "group_channel_permissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cpq *ChannelPermissionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cpq.querySpec()
+	_spec.Node.Columns = cpq.ctx.Fields
+	if len(cpq.ctx.Fields) > 0 {
+		_spec.Unique = cpq.ctx.Unique != nil && *cpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cpq.driver, _spec)
+}
+
+func (cpq *ChannelPermissionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(channelpermission.Table, channelpermission.Columns, sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID))
+	_spec.From = cpq.sql
+	if unique := cpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, channelpermission.FieldID)
+		for i := range fields {
+			if fields[i] != channelpermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := cpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cpq *ChannelPermissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cpq.driver.Dialect())
+	t1 := builder.Table(channelpermission.Table)
+	columns := cpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = channelpermission.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cpq.sql != nil {
+		selector = cpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cpq.ctx.Unique != nil && *cpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cpq.predicates {
+		p(selector)
+	}
+	for _, p := range cpq.order {
+		p(selector)
+	}
+	if offset := cpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ChannelPermissionGroupBy is the group-by builder for ChannelPermission entities.
+type ChannelPermissionGroupBy struct {
+	selector
+	build *ChannelPermissionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cpgb *ChannelPermissionGroupBy) Aggregate(fns ...AggregateFunc) *ChannelPermissionGroupBy {
+	cpgb.fns = append(cpgb.fns, fns...)
+	return cpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cpgb *ChannelPermissionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cpgb.build.ctx, "GroupBy")
+	if err := cpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChannelPermissionQuery, *ChannelPermissionGroupBy](ctx, cpgb.build, cpgb, cpgb.build.inters, v)
+}
+
+func (cpgb *ChannelPermissionGroupBy) sqlScan(ctx context.Context, root *ChannelPermissionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cpgb.fns))
+	for _, fn := range cpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cpgb.flds)+len(cpgb.fns))
+		for _, f := range *cpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ChannelPermissionSelect is the builder for selecting fields of ChannelPermission entities.
+type ChannelPermissionSelect struct {
+	*ChannelPermissionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cps *ChannelPermissionSelect) Aggregate(fns ...AggregateFunc) *ChannelPermissionSelect {
+	cps.fns = append(cps.fns, fns...)
+	return cps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cps *ChannelPermissionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cps.ctx, "Select")
+	if err := cps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChannelPermissionQuery, *ChannelPermissionSelect](ctx, cps.ChannelPermissionQuery, cps, cps.inters, v)
+}
+
+func (cps *ChannelPermissionSelect) sqlScan(ctx context.Context, root *ChannelPermissionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cps.fns))
+	for _, fn := range cps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
+	"github.com/zibbp/ganymede/ent/group"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ChannelPermissionUpdate is the builder for updating ChannelPermission entities.
+type ChannelPermissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChannelPermissionMutation
+}
+
+// Where appends a list predicates to the ChannelPermissionUpdate builder.
+func (cpu *ChannelPermissionUpdate) Where(ps ...predicate.ChannelPermission) *ChannelPermissionUpdate {
+	cpu.mutation.Where(ps...)
+	return cpu
+}
+
+// SetAccess sets the "access" field.
+func (cpu *ChannelPermissionUpdate) SetAccess(ua utils.ChannelAccess) *ChannelPermissionUpdate {
+	cpu.mutation.SetAccess(ua)
+	return cpu
+}
+
+// SetNillableAccess sets the "access" field if the given value is not nil.
+func (cpu *ChannelPermissionUpdate) SetNillableAccess(ua *utils.ChannelAccess) *ChannelPermissionUpdate {
+	if ua != nil {
+		cpu.SetAccess(*ua)
+	}
+	return cpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cpu *ChannelPermissionUpdate) SetUpdatedAt(t time.Time) *ChannelPermissionUpdate {
+	cpu.mutation.SetUpdatedAt(t)
+	return cpu
+}
+
+// SetChannelID sets the "channel" edge to the Channel entity by ID.
+func (cpu *ChannelPermissionUpdate) SetChannelID(id uuid.UUID) *ChannelPermissionUpdate {
+	cpu.mutation.SetChannelID(id)
+	return cpu
+}
+
+// SetChannel sets the "channel" edge to the Channel entity.
+func (cpu *ChannelPermissionUpdate) SetChannel(c *Channel) *ChannelPermissionUpdate {
+	return cpu.SetChannelID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (cpu *ChannelPermissionUpdate) SetUserID(id uuid.UUID) *ChannelPermissionUpdate {
+	cpu.mutation.SetUserID(id)
+	return cpu
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (cpu *ChannelPermissionUpdate) SetNillableUserID(id *uuid.UUID) *ChannelPermissionUpdate {
+	if id != nil {
+		cpu = cpu.SetUserID(*id)
+	}
+	return cpu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cpu *ChannelPermissionUpdate) SetUser(u *User) *ChannelPermissionUpdate {
+	return cpu.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (cpu *ChannelPermissionUpdate) SetGroupID(id uuid.UUID) *ChannelPermissionUpdate {
+	cpu.mutation.SetGroupID(id)
+	return cpu
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (cpu *ChannelPermissionUpdate) SetNillableGroupID(id *uuid.UUID) *ChannelPermissionUpdate {
+	if id != nil {
+		cpu = cpu.SetGroupID(*id)
+	}
+	return cpu
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (cpu *ChannelPermissionUpdate) SetGroup(g *Group) *ChannelPermissionUpdate {
+	return cpu.SetGroupID(g.ID)
+}
+
+// Mutation returns the ChannelPermissionMutation object of the builder.
+func (cpu *ChannelPermissionUpdate) Mutation() *ChannelPermissionMutation {
+	return cpu.mutation
+}
+
+// ClearChannel clears the "channel" edge to the Channel entity.
+func (cpu *ChannelPermissionUpdate) ClearChannel() *ChannelPermissionUpdate {
+	cpu.mutation.ClearChannel()
+	return cpu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cpu *ChannelPermissionUpdate) ClearUser() *ChannelPermissionUpdate {
+	cpu.mutation.ClearUser()
+	return cpu
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (cpu *ChannelPermissionUpdate) ClearGroup() *ChannelPermissionUpdate {
+	cpu.mutation.ClearGroup()
+	return cpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cpu *ChannelPermissionUpdate) Save(ctx context.Context) (int, error) {
+	cpu.defaults()
+	return withHooks(ctx, cpu.sqlSave, cpu.mutation, cpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpu *ChannelPermissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cpu *ChannelPermissionUpdate) Exec(ctx context.Context) error {
+	_, err := cpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpu *ChannelPermissionUpdate) ExecX(ctx context.Context) {
+	if err := cpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cpu *ChannelPermissionUpdate) defaults() {
+	if _, ok := cpu.mutation.UpdatedAt(); !ok {
+		v := channelpermission.UpdateDefaultUpdatedAt()
+		cpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpu *ChannelPermissionUpdate) check() error {
+	if v, ok := cpu.mutation.Access(); ok {
+		if err := channelpermission.AccessValidator(v); err != nil {
+			return &ValidationError{Name: "access", err: fmt.Errorf(`ent: validator failed for field "ChannelPermission.access": %w`, err)}
+		}
+	}
+	if _, ok := cpu.mutation.ChannelID(); cpu.mutation.ChannelCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ChannelPermission.channel"`)
+	}
+	return nil
+}
+
+func (cpu *ChannelPermissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(channelpermission.Table, channelpermission.Columns, sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID))
+	if ps := cpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cpu.mutation.Access(); ok {
+		_spec.SetField(channelpermission.FieldAccess, field.TypeEnum, value)
+	}
+	if value, ok := cpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(channelpermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cpu.mutation.ChannelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.ChannelTable,
+			Columns: []string{channelpermission.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cpu.mutation.ChannelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.ChannelTable,
+			Columns: []string{channelpermission.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cpu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.UserTable,
+			Columns: []string{channelpermission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cpu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.UserTable,
+			Columns: []string{channelpermission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cpu.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.GroupTable,
+			Columns: []string{channelpermission.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cpu.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.GroupTable,
+			Columns: []string{channelpermission.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{channelpermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cpu.mutation.done = true
+	return n, nil
+}
+
+// ChannelPermissionUpdateOne is the builder for updating a single ChannelPermission entity.
+type ChannelPermissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChannelPermissionMutation
+}
+
+// SetAccess sets the "access" field.
+func (cpuo *ChannelPermissionUpdateOne) SetAccess(ua utils.ChannelAccess) *ChannelPermissionUpdateOne {
+	cpuo.mutation.SetAccess(ua)
+	return cpuo
+}
+
+// SetNillableAccess sets the "access" field if the given value is not nil.
+func (cpuo *ChannelPermissionUpdateOne) SetNillableAccess(ua *utils.ChannelAccess) *ChannelPermissionUpdateOne {
+	if ua != nil {
+		cpuo.SetAccess(*ua)
+	}
+	return cpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cpuo *ChannelPermissionUpdateOne) SetUpdatedAt(t time.Time) *ChannelPermissionUpdateOne {
+	cpuo.mutation.SetUpdatedAt(t)
+	return cpuo
+}
+
+// SetChannelID sets the "channel" edge to the Channel entity by ID.
+func (cpuo *ChannelPermissionUpdateOne) SetChannelID(id uuid.UUID) *ChannelPermissionUpdateOne {
+	cpuo.mutation.SetChannelID(id)
+	return cpuo
+}
+
+// SetChannel sets the "channel" edge to the Channel entity.
+func (cpuo *ChannelPermissionUpdateOne) SetChannel(c *Channel) *ChannelPermissionUpdateOne {
+	return cpuo.SetChannelID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (cpuo *ChannelPermissionUpdateOne) SetUserID(id uuid.UUID) *ChannelPermissionUpdateOne {
+	cpuo.mutation.SetUserID(id)
+	return cpuo
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (cpuo *ChannelPermissionUpdateOne) SetNillableUserID(id *uuid.UUID) *ChannelPermissionUpdateOne {
+	if id != nil {
+		cpuo = cpuo.SetUserID(*id)
+	}
+	return cpuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (cpuo *ChannelPermissionUpdateOne) SetUser(u *User) *ChannelPermissionUpdateOne {
+	return cpuo.SetUserID(u.ID)
+}
+
+// SetGroupID sets the "group" edge to the Group entity by ID.
+func (cpuo *ChannelPermissionUpdateOne) SetGroupID(id uuid.UUID) *ChannelPermissionUpdateOne {
+	cpuo.mutation.SetGroupID(id)
+	return cpuo
+}
+
+// SetNillableGroupID sets the "group" edge to the Group entity by ID if the given value is not nil.
+func (cpuo *ChannelPermissionUpdateOne) SetNillableGroupID(id *uuid.UUID) *ChannelPermissionUpdateOne {
+	if id != nil {
+		cpuo = cpuo.SetGroupID(*id)
+	}
+	return cpuo
+}
+
+// SetGroup sets the "group" edge to the Group entity.
+func (cpuo *ChannelPermissionUpdateOne) SetGroup(g *Group) *ChannelPermissionUpdateOne {
+	return cpuo.SetGroupID(g.ID)
+}
+
+// Mutation returns the ChannelPermissionMutation object of the builder.
+func (cpuo *ChannelPermissionUpdateOne) Mutation() *ChannelPermissionMutation {
+	return cpuo.mutation
+}
+
+// ClearChannel clears the "channel" edge to the Channel entity.
+func (cpuo *ChannelPermissionUpdateOne) ClearChannel() *ChannelPermissionUpdateOne {
+	cpuo.mutation.ClearChannel()
+	return cpuo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (cpuo *ChannelPermissionUpdateOne) ClearUser() *ChannelPermissionUpdateOne {
+	cpuo.mutation.ClearUser()
+	return cpuo
+}
+
+// ClearGroup clears the "group" edge to the Group entity.
+func (cpuo *ChannelPermissionUpdateOne) ClearGroup() *ChannelPermissionUpdateOne {
+	cpuo.mutation.ClearGroup()
+	return cpuo
+}
+
+// Where appends a list predicates to the ChannelPermissionUpdate builder.
+func (cpuo *ChannelPermissionUpdateOne) Where(ps ...predicate.ChannelPermission) *ChannelPermissionUpdateOne {
+	cpuo.mutation.Where(ps...)
+	return cpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cpuo *ChannelPermissionUpdateOne) Select(field string, fields ...string) *ChannelPermissionUpdateOne {
+	cpuo.fields = append([]string{field}, fields...)
+	return cpuo
+}
+
+// Save executes the query and returns the updated ChannelPermission entity.
+func (cpuo *ChannelPermissionUpdateOne) Save(ctx context.Context) (*ChannelPermission, error) {
+	cpuo.defaults()
+	return withHooks(ctx, cpuo.sqlSave, cpuo.mutation, cpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cpuo *ChannelPermissionUpdateOne) SaveX(ctx context.Context) *ChannelPermission {
+	node, err := cpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cpuo *ChannelPermissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cpuo *ChannelPermissionUpdateOne) ExecX(ctx context.Context) {
+	if err := cpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cpuo *ChannelPermissionUpdateOne) defaults() {
+	if _, ok := cpuo.mutation.UpdatedAt(); !ok {
+		v := channelpermission.UpdateDefaultUpdatedAt()
+		cpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cpuo *ChannelPermissionUpdateOne) check() error {
+	if v, ok := cpuo.mutation.Access(); ok {
+		if err := channelpermission.AccessValidator(v); err != nil {
+			return &ValidationError{Name: "access", err: fmt.Errorf(`ent: validator failed for field "ChannelPermission.access": %w`, err)}
+		}
+	}
+	if _, ok := cpuo.mutation.ChannelID(); cpuo.mutation.ChannelCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ChannelPermission.channel"`)
+	}
+	return nil
+}
+
+func (cpuo *ChannelPermissionUpdateOne) sqlSave(ctx context.Context) (_node *ChannelPermission, err error) {
+	if err := cpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(channelpermission.Table, channelpermission.Columns, sqlgraph.NewFieldSpec(channelpermission.FieldID, field.TypeUUID))
+	id, ok := cpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChannelPermission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, channelpermission.FieldID)
+		for _, f := range fields {
+			if !channelpermission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != channelpermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cpuo.mutation.Access(); ok {
+		_spec.SetField(channelpermission.FieldAccess, field.TypeEnum, value)
+	}
+	if value, ok := cpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(channelpermission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if cpuo.mutation.ChannelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.ChannelTable,
+			Columns: []string{channelpermission.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cpuo.mutation.ChannelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.ChannelTable,
+			Columns: []string{channelpermission.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cpuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.UserTable,
+			Columns: []string{channelpermission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cpuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.UserTable,
+			Columns: []string{channelpermission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cpuo.mutation.GroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.GroupTable,
+			Columns: []string{channelpermission.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cpuo.mutation.GroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   channelpermission.GroupTable,
+			Columns: []string{channelpermission.GroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ChannelPermission{config: cpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{channelpermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cpuo.mutation.done = true
+	return _node, nil
+}
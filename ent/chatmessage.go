@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// ChatMessage is the model entity for the ChatMessage schema.
+type ChatMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The ID of the comment on the platform.
+	CommentID string `json:"comment_id,omitempty"`
+	// The offset of the comment from the start of the video.
+	ContentOffsetSeconds float64 `json:"content_offset_seconds,omitempty"`
+	// CommenterID holds the value of the "commenter_id" field.
+	CommenterID string `json:"commenter_id,omitempty"`
+	// CommenterName holds the value of the "commenter_name" field.
+	CommenterName string `json:"commenter_name,omitempty"`
+	// CommenterDisplayName holds the value of the "commenter_display_name" field.
+	CommenterDisplayName string `json:"commenter_display_name,omitempty"`
+	// The IDs of the badges of the commenter.
+	CommenterBadges []string `json:"commenter_badges,omitempty"`
+	// The text of the comment.
+	Body string `json:"body,omitempty"`
+	// The comment as it is stored in the chat file.
+	Data string `json:"data,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ChatMessageQuery when eager-loading is set.
+	Edges             ChatMessageEdges `json:"edges"`
+	vod_chat_messages *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// ChatMessageEdges holds the relations/edges for other nodes in the graph.
+type ChatMessageEdges struct {
+	// Vod holds the value of the vod edge.
+	Vod *Vod `json:"vod,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VodOrErr returns the Vod value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ChatMessageEdges) VodOrErr() (*Vod, error) {
+	if e.Vod != nil {
+		return e.Vod, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: vod.Label}
+	}
+	return nil, &NotLoadedError{edge: "vod"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChatMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chatmessage.FieldCommenterBadges:
+			values[i] = new([]byte)
+		case chatmessage.FieldContentOffsetSeconds:
+			values[i] = new(sql.NullFloat64)
+		case chatmessage.FieldCommentID, chatmessage.FieldCommenterID, chatmessage.FieldCommenterName, chatmessage.FieldCommenterDisplayName, chatmessage.FieldBody, chatmessage.FieldData:
+			values[i] = new(sql.NullString)
+		case chatmessage.FieldID:
+			values[i] = new(uuid.UUID)
+		case chatmessage.ForeignKeys[0]: // vod_chat_messages
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChatMessage fields.
+func (cm *ChatMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chatmessage.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cm.ID = *value
+			}
+		case chatmessage.FieldCommentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_id", values[i])
+			} else if value.Valid {
+				cm.CommentID = value.String
+			}
+		case chatmessage.FieldContentOffsetSeconds:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field content_offset_seconds", values[i])
+			} else if value.Valid {
+				cm.ContentOffsetSeconds = value.Float64
+			}
+		case chatmessage.FieldCommenterID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commenter_id", values[i])
+			} else if value.Valid {
+				cm.CommenterID = value.String
+			}
+		case chatmessage.FieldCommenterName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commenter_name", values[i])
+			} else if value.Valid {
+				cm.CommenterName = value.String
+			}
+		case chatmessage.FieldCommenterDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field commenter_display_name", values[i])
+			} else if value.Valid {
+				cm.CommenterDisplayName = value.String
+			}
+		case chatmessage.FieldCommenterBadges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field commenter_badges", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cm.CommenterBadges); err != nil {
+					return fmt.Errorf("unmarshal field commenter_badges: %w", err)
+				}
+			}
+		case chatmessage.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				cm.Body = value.String
+			}
+		case chatmessage.FieldData:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value.Valid {
+				cm.Data = value.String
+			}
+		case chatmessage.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_chat_messages", values[i])
+			} else if value.Valid {
+				cm.vod_chat_messages = new(uuid.UUID)
+				*cm.vod_chat_messages = *value.S.(*uuid.UUID)
+			}
+		default:
+			cm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChatMessage.
+// This includes values selected through modifiers, order, etc.
+func (cm *ChatMessage) Value(name string) (ent.Value, error) {
+	return cm.selectValues.Get(name)
+}
+
+// QueryVod queries the "vod" edge of the ChatMessage entity.
+func (cm *ChatMessage) QueryVod() *VodQuery {
+	return NewChatMessageClient(cm.config).QueryVod(cm)
+}
+
+// Update returns a builder for updating this ChatMessage.
+// Note that you need to call ChatMessage.Unwrap() before calling this method if this ChatMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cm *ChatMessage) Update() *ChatMessageUpdateOne {
+	return NewChatMessageClient(cm.config).UpdateOne(cm)
+}
+
+// Unwrap unwraps the ChatMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cm *ChatMessage) Unwrap() *ChatMessage {
+	_tx, ok := cm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ChatMessage is not a transactional entity")
+	}
+	cm.config.driver = _tx.drv
+	return cm
+}
+
+// String implements the fmt.Stringer.
+func (cm *ChatMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChatMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cm.ID))
+	builder.WriteString("comment_id=")
+	builder.WriteString(cm.CommentID)
+	builder.WriteString(", ")
+	builder.WriteString("content_offset_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", cm.ContentOffsetSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("commenter_id=")
+	builder.WriteString(cm.CommenterID)
+	builder.WriteString(", ")
+	builder.WriteString("commenter_name=")
+	builder.WriteString(cm.CommenterName)
+	builder.WriteString(", ")
+	builder.WriteString("commenter_display_name=")
+	builder.WriteString(cm.CommenterDisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("commenter_badges=")
+	builder.WriteString(fmt.Sprintf("%v", cm.CommenterBadges))
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(cm.Body)
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(cm.Data)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChatMessages is a parsable slice of ChatMessage.
+type ChatMessages []*ChatMessage
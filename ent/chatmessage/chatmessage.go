@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chatmessage
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the chatmessage type in the database.
+	Label = "chat_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCommentID holds the string denoting the comment_id field in the database.
+	FieldCommentID = "comment_id"
+	// FieldContentOffsetSeconds holds the string denoting the content_offset_seconds field in the database.
+	FieldContentOffsetSeconds = "content_offset_seconds"
+	// FieldCommenterID holds the string denoting the commenter_id field in the database.
+	FieldCommenterID = "commenter_id"
+	// FieldCommenterName holds the string denoting the commenter_name field in the database.
+	FieldCommenterName = "commenter_name"
+	// FieldCommenterDisplayName holds the string denoting the commenter_display_name field in the database.
+	FieldCommenterDisplayName = "commenter_display_name"
+	// FieldCommenterBadges holds the string denoting the commenter_badges field in the database.
+	FieldCommenterBadges = "commenter_badges"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
+	// EdgeVod holds the string denoting the vod edge name in mutations.
+	EdgeVod = "vod"
+	// Table holds the table name of the chatmessage in the database.
+	Table = "chat_messages"
+	// VodTable is the table that holds the vod relation/edge.
+	VodTable = "chat_messages"
+	// VodInverseTable is the table name for the Vod entity.
+	// It exists in this package in order to avoid circular dependency with the "vod" package.
+	VodInverseTable = "vods"
+	// VodColumn is the table column denoting the vod relation/edge.
+	VodColumn = "vod_chat_messages"
+)
+
+// Columns holds all SQL columns for chatmessage fields.
+var Columns = []string{
+	FieldID,
+	FieldCommentID,
+	FieldContentOffsetSeconds,
+	FieldCommenterID,
+	FieldCommenterName,
+	FieldCommenterDisplayName,
+	FieldCommenterBadges,
+	FieldBody,
+	FieldData,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "chat_messages"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"vod_chat_messages",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ChatMessage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCommentID orders the results by the comment_id field.
+func ByCommentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommentID, opts...).ToFunc()
+}
+
+// ByContentOffsetSeconds orders the results by the content_offset_seconds field.
+func ByContentOffsetSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentOffsetSeconds, opts...).ToFunc()
+}
+
+// ByCommenterID orders the results by the commenter_id field.
+func ByCommenterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommenterID, opts...).ToFunc()
+}
+
+// ByCommenterName orders the results by the commenter_name field.
+func ByCommenterName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommenterName, opts...).ToFunc()
+}
+
+// ByCommenterDisplayName orders the results by the commenter_display_name field.
+func ByCommenterDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommenterDisplayName, opts...).ToFunc()
+}
+
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
+// ByData orders the results by the data field.
+func ByData(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldData, opts...).ToFunc()
+}
+
+// ByVodField orders the results by vod field.
+func ByVodField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVodStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newVodStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VodInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, VodTable, VodColumn),
+	)
+}
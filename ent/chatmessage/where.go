@@ -0,0 +1,618 @@
+// Code generated by ent, DO NOT EDIT.
+
+package chatmessage
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldID, id))
+}
+
+// CommentID applies equality check predicate on the "comment_id" field. It's identical to CommentIDEQ.
+func CommentID(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldCommentID, v))
+}
+
+// ContentOffsetSeconds applies equality check predicate on the "content_offset_seconds" field. It's identical to ContentOffsetSecondsEQ.
+func ContentOffsetSeconds(v float64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldContentOffsetSeconds, v))
+}
+
+// CommenterID applies equality check predicate on the "commenter_id" field. It's identical to CommenterIDEQ.
+func CommenterID(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldCommenterID, v))
+}
+
+// CommenterName applies equality check predicate on the "commenter_name" field. It's identical to CommenterNameEQ.
+func CommenterName(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldCommenterName, v))
+}
+
+// CommenterDisplayName applies equality check predicate on the "commenter_display_name" field. It's identical to CommenterDisplayNameEQ.
+func CommenterDisplayName(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldCommenterDisplayName, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldBody, v))
+}
+
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldData, v))
+}
+
+// CommentIDEQ applies the EQ predicate on the "comment_id" field.
+func CommentIDEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldCommentID, v))
+}
+
+// CommentIDNEQ applies the NEQ predicate on the "comment_id" field.
+func CommentIDNEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldCommentID, v))
+}
+
+// CommentIDIn applies the In predicate on the "comment_id" field.
+func CommentIDIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldCommentID, vs...))
+}
+
+// CommentIDNotIn applies the NotIn predicate on the "comment_id" field.
+func CommentIDNotIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldCommentID, vs...))
+}
+
+// CommentIDGT applies the GT predicate on the "comment_id" field.
+func CommentIDGT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldCommentID, v))
+}
+
+// CommentIDGTE applies the GTE predicate on the "comment_id" field.
+func CommentIDGTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldCommentID, v))
+}
+
+// CommentIDLT applies the LT predicate on the "comment_id" field.
+func CommentIDLT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldCommentID, v))
+}
+
+// CommentIDLTE applies the LTE predicate on the "comment_id" field.
+func CommentIDLTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldCommentID, v))
+}
+
+// CommentIDContains applies the Contains predicate on the "comment_id" field.
+func CommentIDContains(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContains(FieldCommentID, v))
+}
+
+// CommentIDHasPrefix applies the HasPrefix predicate on the "comment_id" field.
+func CommentIDHasPrefix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasPrefix(FieldCommentID, v))
+}
+
+// CommentIDHasSuffix applies the HasSuffix predicate on the "comment_id" field.
+func CommentIDHasSuffix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasSuffix(FieldCommentID, v))
+}
+
+// CommentIDIsNil applies the IsNil predicate on the "comment_id" field.
+func CommentIDIsNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIsNull(FieldCommentID))
+}
+
+// CommentIDNotNil applies the NotNil predicate on the "comment_id" field.
+func CommentIDNotNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotNull(FieldCommentID))
+}
+
+// CommentIDEqualFold applies the EqualFold predicate on the "comment_id" field.
+func CommentIDEqualFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEqualFold(FieldCommentID, v))
+}
+
+// CommentIDContainsFold applies the ContainsFold predicate on the "comment_id" field.
+func CommentIDContainsFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContainsFold(FieldCommentID, v))
+}
+
+// ContentOffsetSecondsEQ applies the EQ predicate on the "content_offset_seconds" field.
+func ContentOffsetSecondsEQ(v float64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldContentOffsetSeconds, v))
+}
+
+// ContentOffsetSecondsNEQ applies the NEQ predicate on the "content_offset_seconds" field.
+func ContentOffsetSecondsNEQ(v float64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldContentOffsetSeconds, v))
+}
+
+// ContentOffsetSecondsIn applies the In predicate on the "content_offset_seconds" field.
+func ContentOffsetSecondsIn(vs ...float64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldContentOffsetSeconds, vs...))
+}
+
+// ContentOffsetSecondsNotIn applies the NotIn predicate on the "content_offset_seconds" field.
+func ContentOffsetSecondsNotIn(vs ...float64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldContentOffsetSeconds, vs...))
+}
+
+// ContentOffsetSecondsGT applies the GT predicate on the "content_offset_seconds" field.
+func ContentOffsetSecondsGT(v float64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldContentOffsetSeconds, v))
+}
+
+// ContentOffsetSecondsGTE applies the GTE predicate on the "content_offset_seconds" field.
+func ContentOffsetSecondsGTE(v float64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldContentOffsetSeconds, v))
+}
+
+// ContentOffsetSecondsLT applies the LT predicate on the "content_offset_seconds" field.
+func ContentOffsetSecondsLT(v float64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldContentOffsetSeconds, v))
+}
+
+// ContentOffsetSecondsLTE applies the LTE predicate on the "content_offset_seconds" field.
+func ContentOffsetSecondsLTE(v float64) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldContentOffsetSeconds, v))
+}
+
+// CommenterIDEQ applies the EQ predicate on the "commenter_id" field.
+func CommenterIDEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldCommenterID, v))
+}
+
+// CommenterIDNEQ applies the NEQ predicate on the "commenter_id" field.
+func CommenterIDNEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldCommenterID, v))
+}
+
+// CommenterIDIn applies the In predicate on the "commenter_id" field.
+func CommenterIDIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldCommenterID, vs...))
+}
+
+// CommenterIDNotIn applies the NotIn predicate on the "commenter_id" field.
+func CommenterIDNotIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldCommenterID, vs...))
+}
+
+// CommenterIDGT applies the GT predicate on the "commenter_id" field.
+func CommenterIDGT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldCommenterID, v))
+}
+
+// CommenterIDGTE applies the GTE predicate on the "commenter_id" field.
+func CommenterIDGTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldCommenterID, v))
+}
+
+// CommenterIDLT applies the LT predicate on the "commenter_id" field.
+func CommenterIDLT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldCommenterID, v))
+}
+
+// CommenterIDLTE applies the LTE predicate on the "commenter_id" field.
+func CommenterIDLTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldCommenterID, v))
+}
+
+// CommenterIDContains applies the Contains predicate on the "commenter_id" field.
+func CommenterIDContains(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContains(FieldCommenterID, v))
+}
+
+// CommenterIDHasPrefix applies the HasPrefix predicate on the "commenter_id" field.
+func CommenterIDHasPrefix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasPrefix(FieldCommenterID, v))
+}
+
+// CommenterIDHasSuffix applies the HasSuffix predicate on the "commenter_id" field.
+func CommenterIDHasSuffix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasSuffix(FieldCommenterID, v))
+}
+
+// CommenterIDIsNil applies the IsNil predicate on the "commenter_id" field.
+func CommenterIDIsNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIsNull(FieldCommenterID))
+}
+
+// CommenterIDNotNil applies the NotNil predicate on the "commenter_id" field.
+func CommenterIDNotNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotNull(FieldCommenterID))
+}
+
+// CommenterIDEqualFold applies the EqualFold predicate on the "commenter_id" field.
+func CommenterIDEqualFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEqualFold(FieldCommenterID, v))
+}
+
+// CommenterIDContainsFold applies the ContainsFold predicate on the "commenter_id" field.
+func CommenterIDContainsFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContainsFold(FieldCommenterID, v))
+}
+
+// CommenterNameEQ applies the EQ predicate on the "commenter_name" field.
+func CommenterNameEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldCommenterName, v))
+}
+
+// CommenterNameNEQ applies the NEQ predicate on the "commenter_name" field.
+func CommenterNameNEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldCommenterName, v))
+}
+
+// CommenterNameIn applies the In predicate on the "commenter_name" field.
+func CommenterNameIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldCommenterName, vs...))
+}
+
+// CommenterNameNotIn applies the NotIn predicate on the "commenter_name" field.
+func CommenterNameNotIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldCommenterName, vs...))
+}
+
+// CommenterNameGT applies the GT predicate on the "commenter_name" field.
+func CommenterNameGT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldCommenterName, v))
+}
+
+// CommenterNameGTE applies the GTE predicate on the "commenter_name" field.
+func CommenterNameGTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldCommenterName, v))
+}
+
+// CommenterNameLT applies the LT predicate on the "commenter_name" field.
+func CommenterNameLT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldCommenterName, v))
+}
+
+// CommenterNameLTE applies the LTE predicate on the "commenter_name" field.
+func CommenterNameLTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldCommenterName, v))
+}
+
+// CommenterNameContains applies the Contains predicate on the "commenter_name" field.
+func CommenterNameContains(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContains(FieldCommenterName, v))
+}
+
+// CommenterNameHasPrefix applies the HasPrefix predicate on the "commenter_name" field.
+func CommenterNameHasPrefix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasPrefix(FieldCommenterName, v))
+}
+
+// CommenterNameHasSuffix applies the HasSuffix predicate on the "commenter_name" field.
+func CommenterNameHasSuffix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasSuffix(FieldCommenterName, v))
+}
+
+// CommenterNameIsNil applies the IsNil predicate on the "commenter_name" field.
+func CommenterNameIsNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIsNull(FieldCommenterName))
+}
+
+// CommenterNameNotNil applies the NotNil predicate on the "commenter_name" field.
+func CommenterNameNotNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotNull(FieldCommenterName))
+}
+
+// CommenterNameEqualFold applies the EqualFold predicate on the "commenter_name" field.
+func CommenterNameEqualFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEqualFold(FieldCommenterName, v))
+}
+
+// CommenterNameContainsFold applies the ContainsFold predicate on the "commenter_name" field.
+func CommenterNameContainsFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContainsFold(FieldCommenterName, v))
+}
+
+// CommenterDisplayNameEQ applies the EQ predicate on the "commenter_display_name" field.
+func CommenterDisplayNameEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldCommenterDisplayName, v))
+}
+
+// CommenterDisplayNameNEQ applies the NEQ predicate on the "commenter_display_name" field.
+func CommenterDisplayNameNEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldCommenterDisplayName, v))
+}
+
+// CommenterDisplayNameIn applies the In predicate on the "commenter_display_name" field.
+func CommenterDisplayNameIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldCommenterDisplayName, vs...))
+}
+
+// CommenterDisplayNameNotIn applies the NotIn predicate on the "commenter_display_name" field.
+func CommenterDisplayNameNotIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldCommenterDisplayName, vs...))
+}
+
+// CommenterDisplayNameGT applies the GT predicate on the "commenter_display_name" field.
+func CommenterDisplayNameGT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldCommenterDisplayName, v))
+}
+
+// CommenterDisplayNameGTE applies the GTE predicate on the "commenter_display_name" field.
+func CommenterDisplayNameGTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldCommenterDisplayName, v))
+}
+
+// CommenterDisplayNameLT applies the LT predicate on the "commenter_display_name" field.
+func CommenterDisplayNameLT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldCommenterDisplayName, v))
+}
+
+// CommenterDisplayNameLTE applies the LTE predicate on the "commenter_display_name" field.
+func CommenterDisplayNameLTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldCommenterDisplayName, v))
+}
+
+// CommenterDisplayNameContains applies the Contains predicate on the "commenter_display_name" field.
+func CommenterDisplayNameContains(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContains(FieldCommenterDisplayName, v))
+}
+
+// CommenterDisplayNameHasPrefix applies the HasPrefix predicate on the "commenter_display_name" field.
+func CommenterDisplayNameHasPrefix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasPrefix(FieldCommenterDisplayName, v))
+}
+
+// CommenterDisplayNameHasSuffix applies the HasSuffix predicate on the "commenter_display_name" field.
+func CommenterDisplayNameHasSuffix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasSuffix(FieldCommenterDisplayName, v))
+}
+
+// CommenterDisplayNameIsNil applies the IsNil predicate on the "commenter_display_name" field.
+func CommenterDisplayNameIsNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIsNull(FieldCommenterDisplayName))
+}
+
+// CommenterDisplayNameNotNil applies the NotNil predicate on the "commenter_display_name" field.
+func CommenterDisplayNameNotNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotNull(FieldCommenterDisplayName))
+}
+
+// CommenterDisplayNameEqualFold applies the EqualFold predicate on the "commenter_display_name" field.
+func CommenterDisplayNameEqualFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEqualFold(FieldCommenterDisplayName, v))
+}
+
+// CommenterDisplayNameContainsFold applies the ContainsFold predicate on the "commenter_display_name" field.
+func CommenterDisplayNameContainsFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContainsFold(FieldCommenterDisplayName, v))
+}
+
+// CommenterBadgesIsNil applies the IsNil predicate on the "commenter_badges" field.
+func CommenterBadgesIsNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIsNull(FieldCommenterBadges))
+}
+
+// CommenterBadgesNotNil applies the NotNil predicate on the "commenter_badges" field.
+func CommenterBadgesNotNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotNull(FieldCommenterBadges))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyIsNil applies the IsNil predicate on the "body" field.
+func BodyIsNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIsNull(FieldBody))
+}
+
+// BodyNotNil applies the NotNil predicate on the "body" field.
+func BodyNotNil() predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotNull(FieldBody))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContainsFold(FieldBody, v))
+}
+
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEQ(FieldData, v))
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNEQ(FieldData, v))
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldIn(FieldData, vs...))
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldNotIn(FieldData, vs...))
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGT(FieldData, v))
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldGTE(FieldData, v))
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLT(FieldData, v))
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldLTE(FieldData, v))
+}
+
+// DataContains applies the Contains predicate on the "data" field.
+func DataContains(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContains(FieldData, v))
+}
+
+// DataHasPrefix applies the HasPrefix predicate on the "data" field.
+func DataHasPrefix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasPrefix(FieldData, v))
+}
+
+// DataHasSuffix applies the HasSuffix predicate on the "data" field.
+func DataHasSuffix(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldHasSuffix(FieldData, v))
+}
+
+// DataEqualFold applies the EqualFold predicate on the "data" field.
+func DataEqualFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldEqualFold(FieldData, v))
+}
+
+// DataContainsFold applies the ContainsFold predicate on the "data" field.
+func DataContainsFold(v string) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.FieldContainsFold(FieldData, v))
+}
+
+// HasVod applies the HasEdge predicate on the "vod" edge.
+func HasVod() predicate.ChatMessage {
+	return predicate.ChatMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VodTable, VodColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVodWith applies the HasEdge predicate on the "vod" edge with a given conditions (other predicates).
+func HasVodWith(preds ...predicate.Vod) predicate.ChatMessage {
+	return predicate.ChatMessage(func(s *sql.Selector) {
+		step := newVodStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChatMessage) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChatMessage) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChatMessage) predicate.ChatMessage {
+	return predicate.ChatMessage(sql.NotPredicates(p))
+}
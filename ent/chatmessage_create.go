@@ -0,0 +1,1073 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// ChatMessageCreate is the builder for creating a ChatMessage entity.
+type ChatMessageCreate struct {
+	config
+	mutation *ChatMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCommentID sets the "comment_id" field.
+func (cmc *ChatMessageCreate) SetCommentID(s string) *ChatMessageCreate {
+	cmc.mutation.SetCommentID(s)
+	return cmc
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (cmc *ChatMessageCreate) SetNillableCommentID(s *string) *ChatMessageCreate {
+	if s != nil {
+		cmc.SetCommentID(*s)
+	}
+	return cmc
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (cmc *ChatMessageCreate) SetContentOffsetSeconds(f float64) *ChatMessageCreate {
+	cmc.mutation.SetContentOffsetSeconds(f)
+	return cmc
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (cmc *ChatMessageCreate) SetCommenterID(s string) *ChatMessageCreate {
+	cmc.mutation.SetCommenterID(s)
+	return cmc
+}
+
+// SetNillableCommenterID sets the "commenter_id" field if the given value is not nil.
+func (cmc *ChatMessageCreate) SetNillableCommenterID(s *string) *ChatMessageCreate {
+	if s != nil {
+		cmc.SetCommenterID(*s)
+	}
+	return cmc
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (cmc *ChatMessageCreate) SetCommenterName(s string) *ChatMessageCreate {
+	cmc.mutation.SetCommenterName(s)
+	return cmc
+}
+
+// SetNillableCommenterName sets the "commenter_name" field if the given value is not nil.
+func (cmc *ChatMessageCreate) SetNillableCommenterName(s *string) *ChatMessageCreate {
+	if s != nil {
+		cmc.SetCommenterName(*s)
+	}
+	return cmc
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (cmc *ChatMessageCreate) SetCommenterDisplayName(s string) *ChatMessageCreate {
+	cmc.mutation.SetCommenterDisplayName(s)
+	return cmc
+}
+
+// SetNillableCommenterDisplayName sets the "commenter_display_name" field if the given value is not nil.
+func (cmc *ChatMessageCreate) SetNillableCommenterDisplayName(s *string) *ChatMessageCreate {
+	if s != nil {
+		cmc.SetCommenterDisplayName(*s)
+	}
+	return cmc
+}
+
+// SetCommenterBadges sets the "commenter_badges" field.
+func (cmc *ChatMessageCreate) SetCommenterBadges(s []string) *ChatMessageCreate {
+	cmc.mutation.SetCommenterBadges(s)
+	return cmc
+}
+
+// SetBody sets the "body" field.
+func (cmc *ChatMessageCreate) SetBody(s string) *ChatMessageCreate {
+	cmc.mutation.SetBody(s)
+	return cmc
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (cmc *ChatMessageCreate) SetNillableBody(s *string) *ChatMessageCreate {
+	if s != nil {
+		cmc.SetBody(*s)
+	}
+	return cmc
+}
+
+// SetData sets the "data" field.
+func (cmc *ChatMessageCreate) SetData(s string) *ChatMessageCreate {
+	cmc.mutation.SetData(s)
+	return cmc
+}
+
+// SetID sets the "id" field.
+func (cmc *ChatMessageCreate) SetID(u uuid.UUID) *ChatMessageCreate {
+	cmc.mutation.SetID(u)
+	return cmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cmc *ChatMessageCreate) SetNillableID(u *uuid.UUID) *ChatMessageCreate {
+	if u != nil {
+		cmc.SetID(*u)
+	}
+	return cmc
+}
+
+// SetVodID sets the "vod" edge to the Vod entity by ID.
+func (cmc *ChatMessageCreate) SetVodID(id uuid.UUID) *ChatMessageCreate {
+	cmc.mutation.SetVodID(id)
+	return cmc
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (cmc *ChatMessageCreate) SetVod(v *Vod) *ChatMessageCreate {
+	return cmc.SetVodID(v.ID)
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (cmc *ChatMessageCreate) Mutation() *ChatMessageMutation {
+	return cmc.mutation
+}
+
+// Save creates the ChatMessage in the database.
+func (cmc *ChatMessageCreate) Save(ctx context.Context) (*ChatMessage, error) {
+	cmc.defaults()
+	return withHooks(ctx, cmc.sqlSave, cmc.mutation, cmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cmc *ChatMessageCreate) SaveX(ctx context.Context) *ChatMessage {
+	v, err := cmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cmc *ChatMessageCreate) Exec(ctx context.Context) error {
+	_, err := cmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmc *ChatMessageCreate) ExecX(ctx context.Context) {
+	if err := cmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cmc *ChatMessageCreate) defaults() {
+	if _, ok := cmc.mutation.ID(); !ok {
+		v := chatmessage.DefaultID()
+		cmc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmc *ChatMessageCreate) check() error {
+	if _, ok := cmc.mutation.ContentOffsetSeconds(); !ok {
+		return &ValidationError{Name: "content_offset_seconds", err: errors.New(`ent: missing required field "ChatMessage.content_offset_seconds"`)}
+	}
+	if _, ok := cmc.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`ent: missing required field "ChatMessage.data"`)}
+	}
+	if _, ok := cmc.mutation.VodID(); !ok {
+		return &ValidationError{Name: "vod", err: errors.New(`ent: missing required edge "ChatMessage.vod"`)}
+	}
+	return nil
+}
+
+func (cmc *ChatMessageCreate) sqlSave(ctx context.Context) (*ChatMessage, error) {
+	if err := cmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cmc.mutation.id = &_node.ID
+	cmc.mutation.done = true
+	return _node, nil
+}
+
+func (cmc *ChatMessageCreate) createSpec() (*ChatMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChatMessage{config: cmc.config}
+		_spec = sqlgraph.NewCreateSpec(chatmessage.Table, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = cmc.conflict
+	if id, ok := cmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cmc.mutation.CommentID(); ok {
+		_spec.SetField(chatmessage.FieldCommentID, field.TypeString, value)
+		_node.CommentID = value
+	}
+	if value, ok := cmc.mutation.ContentOffsetSeconds(); ok {
+		_spec.SetField(chatmessage.FieldContentOffsetSeconds, field.TypeFloat64, value)
+		_node.ContentOffsetSeconds = value
+	}
+	if value, ok := cmc.mutation.CommenterID(); ok {
+		_spec.SetField(chatmessage.FieldCommenterID, field.TypeString, value)
+		_node.CommenterID = value
+	}
+	if value, ok := cmc.mutation.CommenterName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterName, field.TypeString, value)
+		_node.CommenterName = value
+	}
+	if value, ok := cmc.mutation.CommenterDisplayName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterDisplayName, field.TypeString, value)
+		_node.CommenterDisplayName = value
+	}
+	if value, ok := cmc.mutation.CommenterBadges(); ok {
+		_spec.SetField(chatmessage.FieldCommenterBadges, field.TypeJSON, value)
+		_node.CommenterBadges = value
+	}
+	if value, ok := cmc.mutation.Body(); ok {
+		_spec.SetField(chatmessage.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := cmc.mutation.Data(); ok {
+		_spec.SetField(chatmessage.FieldData, field.TypeString, value)
+		_node.Data = value
+	}
+	if nodes := cmc.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.VodTable,
+			Columns: []string{chatmessage.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.vod_chat_messages = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatMessage.Create().
+//		SetCommentID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatMessageUpsert) {
+//			SetCommentID(v+v).
+//		}).
+//		Exec(ctx)
+func (cmc *ChatMessageCreate) OnConflict(opts ...sql.ConflictOption) *ChatMessageUpsertOne {
+	cmc.conflict = opts
+	return &ChatMessageUpsertOne{
+		create: cmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cmc *ChatMessageCreate) OnConflictColumns(columns ...string) *ChatMessageUpsertOne {
+	cmc.conflict = append(cmc.conflict, sql.ConflictColumns(columns...))
+	return &ChatMessageUpsertOne{
+		create: cmc,
+	}
+}
+
+type (
+	// ChatMessageUpsertOne is the builder for "upsert"-ing
+	//  one ChatMessage node.
+	ChatMessageUpsertOne struct {
+		create *ChatMessageCreate
+	}
+
+	// ChatMessageUpsert is the "OnConflict" setter.
+	ChatMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCommentID sets the "comment_id" field.
+func (u *ChatMessageUpsert) SetCommentID(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldCommentID, v)
+	return u
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateCommentID() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldCommentID)
+	return u
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (u *ChatMessageUpsert) ClearCommentID() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldCommentID)
+	return u
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (u *ChatMessageUpsert) SetContentOffsetSeconds(v float64) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldContentOffsetSeconds, v)
+	return u
+}
+
+// UpdateContentOffsetSeconds sets the "content_offset_seconds" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateContentOffsetSeconds() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldContentOffsetSeconds)
+	return u
+}
+
+// AddContentOffsetSeconds adds v to the "content_offset_seconds" field.
+func (u *ChatMessageUpsert) AddContentOffsetSeconds(v float64) *ChatMessageUpsert {
+	u.Add(chatmessage.FieldContentOffsetSeconds, v)
+	return u
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (u *ChatMessageUpsert) SetCommenterID(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldCommenterID, v)
+	return u
+}
+
+// UpdateCommenterID sets the "commenter_id" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateCommenterID() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldCommenterID)
+	return u
+}
+
+// ClearCommenterID clears the value of the "commenter_id" field.
+func (u *ChatMessageUpsert) ClearCommenterID() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldCommenterID)
+	return u
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (u *ChatMessageUpsert) SetCommenterName(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldCommenterName, v)
+	return u
+}
+
+// UpdateCommenterName sets the "commenter_name" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateCommenterName() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldCommenterName)
+	return u
+}
+
+// ClearCommenterName clears the value of the "commenter_name" field.
+func (u *ChatMessageUpsert) ClearCommenterName() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldCommenterName)
+	return u
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (u *ChatMessageUpsert) SetCommenterDisplayName(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldCommenterDisplayName, v)
+	return u
+}
+
+// UpdateCommenterDisplayName sets the "commenter_display_name" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateCommenterDisplayName() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldCommenterDisplayName)
+	return u
+}
+
+// ClearCommenterDisplayName clears the value of the "commenter_display_name" field.
+func (u *ChatMessageUpsert) ClearCommenterDisplayName() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldCommenterDisplayName)
+	return u
+}
+
+// SetCommenterBadges sets the "commenter_badges" field.
+func (u *ChatMessageUpsert) SetCommenterBadges(v []string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldCommenterBadges, v)
+	return u
+}
+
+// UpdateCommenterBadges sets the "commenter_badges" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateCommenterBadges() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldCommenterBadges)
+	return u
+}
+
+// ClearCommenterBadges clears the value of the "commenter_badges" field.
+func (u *ChatMessageUpsert) ClearCommenterBadges() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldCommenterBadges)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *ChatMessageUpsert) SetBody(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateBody() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldBody)
+	return u
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *ChatMessageUpsert) ClearBody() *ChatMessageUpsert {
+	u.SetNull(chatmessage.FieldBody)
+	return u
+}
+
+// SetData sets the "data" field.
+func (u *ChatMessageUpsert) SetData(v string) *ChatMessageUpsert {
+	u.Set(chatmessage.FieldData, v)
+	return u
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *ChatMessageUpsert) UpdateData() *ChatMessageUpsert {
+	u.SetExcluded(chatmessage.FieldData)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatMessageUpsertOne) UpdateNewValues() *ChatMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(chatmessage.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChatMessageUpsertOne) Ignore() *ChatMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatMessageUpsertOne) DoNothing() *ChatMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatMessageCreate.OnConflict
+// documentation for more info.
+func (u *ChatMessageUpsertOne) Update(set func(*ChatMessageUpsert)) *ChatMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (u *ChatMessageUpsertOne) SetCommentID(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommentID(v)
+	})
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateCommentID() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommentID()
+	})
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (u *ChatMessageUpsertOne) ClearCommentID() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommentID()
+	})
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (u *ChatMessageUpsertOne) SetContentOffsetSeconds(v float64) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetContentOffsetSeconds(v)
+	})
+}
+
+// AddContentOffsetSeconds adds v to the "content_offset_seconds" field.
+func (u *ChatMessageUpsertOne) AddContentOffsetSeconds(v float64) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.AddContentOffsetSeconds(v)
+	})
+}
+
+// UpdateContentOffsetSeconds sets the "content_offset_seconds" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateContentOffsetSeconds() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateContentOffsetSeconds()
+	})
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (u *ChatMessageUpsertOne) SetCommenterID(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterID(v)
+	})
+}
+
+// UpdateCommenterID sets the "commenter_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateCommenterID() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterID()
+	})
+}
+
+// ClearCommenterID clears the value of the "commenter_id" field.
+func (u *ChatMessageUpsertOne) ClearCommenterID() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterID()
+	})
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (u *ChatMessageUpsertOne) SetCommenterName(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterName(v)
+	})
+}
+
+// UpdateCommenterName sets the "commenter_name" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateCommenterName() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterName()
+	})
+}
+
+// ClearCommenterName clears the value of the "commenter_name" field.
+func (u *ChatMessageUpsertOne) ClearCommenterName() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterName()
+	})
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (u *ChatMessageUpsertOne) SetCommenterDisplayName(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterDisplayName(v)
+	})
+}
+
+// UpdateCommenterDisplayName sets the "commenter_display_name" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateCommenterDisplayName() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterDisplayName()
+	})
+}
+
+// ClearCommenterDisplayName clears the value of the "commenter_display_name" field.
+func (u *ChatMessageUpsertOne) ClearCommenterDisplayName() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterDisplayName()
+	})
+}
+
+// SetCommenterBadges sets the "commenter_badges" field.
+func (u *ChatMessageUpsertOne) SetCommenterBadges(v []string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterBadges(v)
+	})
+}
+
+// UpdateCommenterBadges sets the "commenter_badges" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateCommenterBadges() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterBadges()
+	})
+}
+
+// ClearCommenterBadges clears the value of the "commenter_badges" field.
+func (u *ChatMessageUpsertOne) ClearCommenterBadges() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterBadges()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *ChatMessageUpsertOne) SetBody(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateBody() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *ChatMessageUpsertOne) ClearBody() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearBody()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *ChatMessageUpsertOne) SetData(v string) *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *ChatMessageUpsertOne) UpdateData() *ChatMessageUpsertOne {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateData()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChatMessageUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ChatMessageUpsertOne.ID is not supported by MySQL driver. Use ChatMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChatMessageUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChatMessageCreateBulk is the builder for creating many ChatMessage entities in bulk.
+type ChatMessageCreateBulk struct {
+	config
+	err      error
+	builders []*ChatMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ChatMessage entities in the database.
+func (cmcb *ChatMessageCreateBulk) Save(ctx context.Context) ([]*ChatMessage, error) {
+	if cmcb.err != nil {
+		return nil, cmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cmcb.builders))
+	nodes := make([]*ChatMessage, len(cmcb.builders))
+	mutators := make([]Mutator, len(cmcb.builders))
+	for i := range cmcb.builders {
+		func(i int, root context.Context) {
+			builder := cmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChatMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmcb *ChatMessageCreateBulk) SaveX(ctx context.Context) []*ChatMessage {
+	v, err := cmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cmcb *ChatMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := cmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmcb *ChatMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := cmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChatMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChatMessageUpsert) {
+//			SetCommentID(v+v).
+//		}).
+//		Exec(ctx)
+func (cmcb *ChatMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChatMessageUpsertBulk {
+	cmcb.conflict = opts
+	return &ChatMessageUpsertBulk{
+		create: cmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cmcb *ChatMessageCreateBulk) OnConflictColumns(columns ...string) *ChatMessageUpsertBulk {
+	cmcb.conflict = append(cmcb.conflict, sql.ConflictColumns(columns...))
+	return &ChatMessageUpsertBulk{
+		create: cmcb,
+	}
+}
+
+// ChatMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChatMessage nodes.
+type ChatMessageUpsertBulk struct {
+	create *ChatMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chatmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChatMessageUpsertBulk) UpdateNewValues() *ChatMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(chatmessage.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChatMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChatMessageUpsertBulk) Ignore() *ChatMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChatMessageUpsertBulk) DoNothing() *ChatMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChatMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChatMessageUpsertBulk) Update(set func(*ChatMessageUpsert)) *ChatMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChatMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCommentID sets the "comment_id" field.
+func (u *ChatMessageUpsertBulk) SetCommentID(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommentID(v)
+	})
+}
+
+// UpdateCommentID sets the "comment_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateCommentID() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommentID()
+	})
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (u *ChatMessageUpsertBulk) ClearCommentID() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommentID()
+	})
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (u *ChatMessageUpsertBulk) SetContentOffsetSeconds(v float64) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetContentOffsetSeconds(v)
+	})
+}
+
+// AddContentOffsetSeconds adds v to the "content_offset_seconds" field.
+func (u *ChatMessageUpsertBulk) AddContentOffsetSeconds(v float64) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.AddContentOffsetSeconds(v)
+	})
+}
+
+// UpdateContentOffsetSeconds sets the "content_offset_seconds" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateContentOffsetSeconds() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateContentOffsetSeconds()
+	})
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (u *ChatMessageUpsertBulk) SetCommenterID(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterID(v)
+	})
+}
+
+// UpdateCommenterID sets the "commenter_id" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateCommenterID() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterID()
+	})
+}
+
+// ClearCommenterID clears the value of the "commenter_id" field.
+func (u *ChatMessageUpsertBulk) ClearCommenterID() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterID()
+	})
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (u *ChatMessageUpsertBulk) SetCommenterName(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterName(v)
+	})
+}
+
+// UpdateCommenterName sets the "commenter_name" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateCommenterName() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterName()
+	})
+}
+
+// ClearCommenterName clears the value of the "commenter_name" field.
+func (u *ChatMessageUpsertBulk) ClearCommenterName() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterName()
+	})
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (u *ChatMessageUpsertBulk) SetCommenterDisplayName(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterDisplayName(v)
+	})
+}
+
+// UpdateCommenterDisplayName sets the "commenter_display_name" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateCommenterDisplayName() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterDisplayName()
+	})
+}
+
+// ClearCommenterDisplayName clears the value of the "commenter_display_name" field.
+func (u *ChatMessageUpsertBulk) ClearCommenterDisplayName() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterDisplayName()
+	})
+}
+
+// SetCommenterBadges sets the "commenter_badges" field.
+func (u *ChatMessageUpsertBulk) SetCommenterBadges(v []string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetCommenterBadges(v)
+	})
+}
+
+// UpdateCommenterBadges sets the "commenter_badges" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateCommenterBadges() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateCommenterBadges()
+	})
+}
+
+// ClearCommenterBadges clears the value of the "commenter_badges" field.
+func (u *ChatMessageUpsertBulk) ClearCommenterBadges() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearCommenterBadges()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *ChatMessageUpsertBulk) SetBody(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateBody() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *ChatMessageUpsertBulk) ClearBody() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.ClearBody()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *ChatMessageUpsertBulk) SetData(v string) *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *ChatMessageUpsertBulk) UpdateData() *ChatMessageUpsertBulk {
+	return u.Update(func(s *ChatMessageUpsert) {
+		s.UpdateData()
+	})
+}
+
+// Exec executes the query.
+func (u *ChatMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ChatMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ChatMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChatMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// ChatMessageDelete is the builder for deleting a ChatMessage entity.
+type ChatMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChatMessageMutation
+}
+
+// Where appends a list predicates to the ChatMessageDelete builder.
+func (cmd *ChatMessageDelete) Where(ps ...predicate.ChatMessage) *ChatMessageDelete {
+	cmd.mutation.Where(ps...)
+	return cmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cmd *ChatMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cmd.sqlExec, cmd.mutation, cmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmd *ChatMessageDelete) ExecX(ctx context.Context) int {
+	n, err := cmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cmd *ChatMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(chatmessage.Table, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID))
+	if ps := cmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cmd.mutation.done = true
+	return affected, err
+}
+
+// ChatMessageDeleteOne is the builder for deleting a single ChatMessage entity.
+type ChatMessageDeleteOne struct {
+	cmd *ChatMessageDelete
+}
+
+// Where appends a list predicates to the ChatMessageDelete builder.
+func (cmdo *ChatMessageDeleteOne) Where(ps ...predicate.ChatMessage) *ChatMessageDeleteOne {
+	cmdo.cmd.mutation.Where(ps...)
+	return cmdo
+}
+
+// Exec executes the deletion query.
+func (cmdo *ChatMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := cmdo.cmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{chatmessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmdo *ChatMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := cmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,698 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// ChatMessageUpdate is the builder for updating ChatMessage entities.
+type ChatMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ChatMessageMutation
+}
+
+// Where appends a list predicates to the ChatMessageUpdate builder.
+func (cmu *ChatMessageUpdate) Where(ps ...predicate.ChatMessage) *ChatMessageUpdate {
+	cmu.mutation.Where(ps...)
+	return cmu
+}
+
+// SetCommentID sets the "comment_id" field.
+func (cmu *ChatMessageUpdate) SetCommentID(s string) *ChatMessageUpdate {
+	cmu.mutation.SetCommentID(s)
+	return cmu
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableCommentID(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetCommentID(*s)
+	}
+	return cmu
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (cmu *ChatMessageUpdate) ClearCommentID() *ChatMessageUpdate {
+	cmu.mutation.ClearCommentID()
+	return cmu
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (cmu *ChatMessageUpdate) SetContentOffsetSeconds(f float64) *ChatMessageUpdate {
+	cmu.mutation.ResetContentOffsetSeconds()
+	cmu.mutation.SetContentOffsetSeconds(f)
+	return cmu
+}
+
+// SetNillableContentOffsetSeconds sets the "content_offset_seconds" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableContentOffsetSeconds(f *float64) *ChatMessageUpdate {
+	if f != nil {
+		cmu.SetContentOffsetSeconds(*f)
+	}
+	return cmu
+}
+
+// AddContentOffsetSeconds adds f to the "content_offset_seconds" field.
+func (cmu *ChatMessageUpdate) AddContentOffsetSeconds(f float64) *ChatMessageUpdate {
+	cmu.mutation.AddContentOffsetSeconds(f)
+	return cmu
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (cmu *ChatMessageUpdate) SetCommenterID(s string) *ChatMessageUpdate {
+	cmu.mutation.SetCommenterID(s)
+	return cmu
+}
+
+// SetNillableCommenterID sets the "commenter_id" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableCommenterID(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetCommenterID(*s)
+	}
+	return cmu
+}
+
+// ClearCommenterID clears the value of the "commenter_id" field.
+func (cmu *ChatMessageUpdate) ClearCommenterID() *ChatMessageUpdate {
+	cmu.mutation.ClearCommenterID()
+	return cmu
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (cmu *ChatMessageUpdate) SetCommenterName(s string) *ChatMessageUpdate {
+	cmu.mutation.SetCommenterName(s)
+	return cmu
+}
+
+// SetNillableCommenterName sets the "commenter_name" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableCommenterName(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetCommenterName(*s)
+	}
+	return cmu
+}
+
+// ClearCommenterName clears the value of the "commenter_name" field.
+func (cmu *ChatMessageUpdate) ClearCommenterName() *ChatMessageUpdate {
+	cmu.mutation.ClearCommenterName()
+	return cmu
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (cmu *ChatMessageUpdate) SetCommenterDisplayName(s string) *ChatMessageUpdate {
+	cmu.mutation.SetCommenterDisplayName(s)
+	return cmu
+}
+
+// SetNillableCommenterDisplayName sets the "commenter_display_name" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableCommenterDisplayName(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetCommenterDisplayName(*s)
+	}
+	return cmu
+}
+
+// ClearCommenterDisplayName clears the value of the "commenter_display_name" field.
+func (cmu *ChatMessageUpdate) ClearCommenterDisplayName() *ChatMessageUpdate {
+	cmu.mutation.ClearCommenterDisplayName()
+	return cmu
+}
+
+// SetCommenterBadges sets the "commenter_badges" field.
+func (cmu *ChatMessageUpdate) SetCommenterBadges(s []string) *ChatMessageUpdate {
+	cmu.mutation.SetCommenterBadges(s)
+	return cmu
+}
+
+// AppendCommenterBadges appends s to the "commenter_badges" field.
+func (cmu *ChatMessageUpdate) AppendCommenterBadges(s []string) *ChatMessageUpdate {
+	cmu.mutation.AppendCommenterBadges(s)
+	return cmu
+}
+
+// ClearCommenterBadges clears the value of the "commenter_badges" field.
+func (cmu *ChatMessageUpdate) ClearCommenterBadges() *ChatMessageUpdate {
+	cmu.mutation.ClearCommenterBadges()
+	return cmu
+}
+
+// SetBody sets the "body" field.
+func (cmu *ChatMessageUpdate) SetBody(s string) *ChatMessageUpdate {
+	cmu.mutation.SetBody(s)
+	return cmu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableBody(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetBody(*s)
+	}
+	return cmu
+}
+
+// ClearBody clears the value of the "body" field.
+func (cmu *ChatMessageUpdate) ClearBody() *ChatMessageUpdate {
+	cmu.mutation.ClearBody()
+	return cmu
+}
+
+// SetData sets the "data" field.
+func (cmu *ChatMessageUpdate) SetData(s string) *ChatMessageUpdate {
+	cmu.mutation.SetData(s)
+	return cmu
+}
+
+// SetNillableData sets the "data" field if the given value is not nil.
+func (cmu *ChatMessageUpdate) SetNillableData(s *string) *ChatMessageUpdate {
+	if s != nil {
+		cmu.SetData(*s)
+	}
+	return cmu
+}
+
+// SetVodID sets the "vod" edge to the Vod entity by ID.
+func (cmu *ChatMessageUpdate) SetVodID(id uuid.UUID) *ChatMessageUpdate {
+	cmu.mutation.SetVodID(id)
+	return cmu
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (cmu *ChatMessageUpdate) SetVod(v *Vod) *ChatMessageUpdate {
+	return cmu.SetVodID(v.ID)
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (cmu *ChatMessageUpdate) Mutation() *ChatMessageMutation {
+	return cmu.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (cmu *ChatMessageUpdate) ClearVod() *ChatMessageUpdate {
+	cmu.mutation.ClearVod()
+	return cmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cmu *ChatMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cmu.sqlSave, cmu.mutation, cmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmu *ChatMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := cmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cmu *ChatMessageUpdate) Exec(ctx context.Context) error {
+	_, err := cmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmu *ChatMessageUpdate) ExecX(ctx context.Context) {
+	if err := cmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmu *ChatMessageUpdate) check() error {
+	if _, ok := cmu.mutation.VodID(); cmu.mutation.VodCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ChatMessage.vod"`)
+	}
+	return nil
+}
+
+func (cmu *ChatMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatmessage.Table, chatmessage.Columns, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID))
+	if ps := cmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cmu.mutation.CommentID(); ok {
+		_spec.SetField(chatmessage.FieldCommentID, field.TypeString, value)
+	}
+	if cmu.mutation.CommentIDCleared() {
+		_spec.ClearField(chatmessage.FieldCommentID, field.TypeString)
+	}
+	if value, ok := cmu.mutation.ContentOffsetSeconds(); ok {
+		_spec.SetField(chatmessage.FieldContentOffsetSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := cmu.mutation.AddedContentOffsetSeconds(); ok {
+		_spec.AddField(chatmessage.FieldContentOffsetSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := cmu.mutation.CommenterID(); ok {
+		_spec.SetField(chatmessage.FieldCommenterID, field.TypeString, value)
+	}
+	if cmu.mutation.CommenterIDCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterID, field.TypeString)
+	}
+	if value, ok := cmu.mutation.CommenterName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterName, field.TypeString, value)
+	}
+	if cmu.mutation.CommenterNameCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterName, field.TypeString)
+	}
+	if value, ok := cmu.mutation.CommenterDisplayName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterDisplayName, field.TypeString, value)
+	}
+	if cmu.mutation.CommenterDisplayNameCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterDisplayName, field.TypeString)
+	}
+	if value, ok := cmu.mutation.CommenterBadges(); ok {
+		_spec.SetField(chatmessage.FieldCommenterBadges, field.TypeJSON, value)
+	}
+	if value, ok := cmu.mutation.AppendedCommenterBadges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chatmessage.FieldCommenterBadges, value)
+		})
+	}
+	if cmu.mutation.CommenterBadgesCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterBadges, field.TypeJSON)
+	}
+	if value, ok := cmu.mutation.Body(); ok {
+		_spec.SetField(chatmessage.FieldBody, field.TypeString, value)
+	}
+	if cmu.mutation.BodyCleared() {
+		_spec.ClearField(chatmessage.FieldBody, field.TypeString)
+	}
+	if value, ok := cmu.mutation.Data(); ok {
+		_spec.SetField(chatmessage.FieldData, field.TypeString, value)
+	}
+	if cmu.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.VodTable,
+			Columns: []string{chatmessage.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cmu.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.VodTable,
+			Columns: []string{chatmessage.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cmu.mutation.done = true
+	return n, nil
+}
+
+// ChatMessageUpdateOne is the builder for updating a single ChatMessage entity.
+type ChatMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ChatMessageMutation
+}
+
+// SetCommentID sets the "comment_id" field.
+func (cmuo *ChatMessageUpdateOne) SetCommentID(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetCommentID(s)
+	return cmuo
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableCommentID(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetCommentID(*s)
+	}
+	return cmuo
+}
+
+// ClearCommentID clears the value of the "comment_id" field.
+func (cmuo *ChatMessageUpdateOne) ClearCommentID() *ChatMessageUpdateOne {
+	cmuo.mutation.ClearCommentID()
+	return cmuo
+}
+
+// SetContentOffsetSeconds sets the "content_offset_seconds" field.
+func (cmuo *ChatMessageUpdateOne) SetContentOffsetSeconds(f float64) *ChatMessageUpdateOne {
+	cmuo.mutation.ResetContentOffsetSeconds()
+	cmuo.mutation.SetContentOffsetSeconds(f)
+	return cmuo
+}
+
+// SetNillableContentOffsetSeconds sets the "content_offset_seconds" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableContentOffsetSeconds(f *float64) *ChatMessageUpdateOne {
+	if f != nil {
+		cmuo.SetContentOffsetSeconds(*f)
+	}
+	return cmuo
+}
+
+// AddContentOffsetSeconds adds f to the "content_offset_seconds" field.
+func (cmuo *ChatMessageUpdateOne) AddContentOffsetSeconds(f float64) *ChatMessageUpdateOne {
+	cmuo.mutation.AddContentOffsetSeconds(f)
+	return cmuo
+}
+
+// SetCommenterID sets the "commenter_id" field.
+func (cmuo *ChatMessageUpdateOne) SetCommenterID(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetCommenterID(s)
+	return cmuo
+}
+
+// SetNillableCommenterID sets the "commenter_id" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableCommenterID(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetCommenterID(*s)
+	}
+	return cmuo
+}
+
+// ClearCommenterID clears the value of the "commenter_id" field.
+func (cmuo *ChatMessageUpdateOne) ClearCommenterID() *ChatMessageUpdateOne {
+	cmuo.mutation.ClearCommenterID()
+	return cmuo
+}
+
+// SetCommenterName sets the "commenter_name" field.
+func (cmuo *ChatMessageUpdateOne) SetCommenterName(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetCommenterName(s)
+	return cmuo
+}
+
+// SetNillableCommenterName sets the "commenter_name" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableCommenterName(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetCommenterName(*s)
+	}
+	return cmuo
+}
+
+// ClearCommenterName clears the value of the "commenter_name" field.
+func (cmuo *ChatMessageUpdateOne) ClearCommenterName() *ChatMessageUpdateOne {
+	cmuo.mutation.ClearCommenterName()
+	return cmuo
+}
+
+// SetCommenterDisplayName sets the "commenter_display_name" field.
+func (cmuo *ChatMessageUpdateOne) SetCommenterDisplayName(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetCommenterDisplayName(s)
+	return cmuo
+}
+
+// SetNillableCommenterDisplayName sets the "commenter_display_name" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableCommenterDisplayName(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetCommenterDisplayName(*s)
+	}
+	return cmuo
+}
+
+// ClearCommenterDisplayName clears the value of the "commenter_display_name" field.
+func (cmuo *ChatMessageUpdateOne) ClearCommenterDisplayName() *ChatMessageUpdateOne {
+	cmuo.mutation.ClearCommenterDisplayName()
+	return cmuo
+}
+
+// SetCommenterBadges sets the "commenter_badges" field.
+func (cmuo *ChatMessageUpdateOne) SetCommenterBadges(s []string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetCommenterBadges(s)
+	return cmuo
+}
+
+// AppendCommenterBadges appends s to the "commenter_badges" field.
+func (cmuo *ChatMessageUpdateOne) AppendCommenterBadges(s []string) *ChatMessageUpdateOne {
+	cmuo.mutation.AppendCommenterBadges(s)
+	return cmuo
+}
+
+// ClearCommenterBadges clears the value of the "commenter_badges" field.
+func (cmuo *ChatMessageUpdateOne) ClearCommenterBadges() *ChatMessageUpdateOne {
+	cmuo.mutation.ClearCommenterBadges()
+	return cmuo
+}
+
+// SetBody sets the "body" field.
+func (cmuo *ChatMessageUpdateOne) SetBody(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetBody(s)
+	return cmuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableBody(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetBody(*s)
+	}
+	return cmuo
+}
+
+// ClearBody clears the value of the "body" field.
+func (cmuo *ChatMessageUpdateOne) ClearBody() *ChatMessageUpdateOne {
+	cmuo.mutation.ClearBody()
+	return cmuo
+}
+
+// SetData sets the "data" field.
+func (cmuo *ChatMessageUpdateOne) SetData(s string) *ChatMessageUpdateOne {
+	cmuo.mutation.SetData(s)
+	return cmuo
+}
+
+// SetNillableData sets the "data" field if the given value is not nil.
+func (cmuo *ChatMessageUpdateOne) SetNillableData(s *string) *ChatMessageUpdateOne {
+	if s != nil {
+		cmuo.SetData(*s)
+	}
+	return cmuo
+}
+
+// SetVodID sets the "vod" edge to the Vod entity by ID.
+func (cmuo *ChatMessageUpdateOne) SetVodID(id uuid.UUID) *ChatMessageUpdateOne {
+	cmuo.mutation.SetVodID(id)
+	return cmuo
+}
+
+// SetVod sets the "vod" edge to the Vod entity.
+func (cmuo *ChatMessageUpdateOne) SetVod(v *Vod) *ChatMessageUpdateOne {
+	return cmuo.SetVodID(v.ID)
+}
+
+// Mutation returns the ChatMessageMutation object of the builder.
+func (cmuo *ChatMessageUpdateOne) Mutation() *ChatMessageMutation {
+	return cmuo.mutation
+}
+
+// ClearVod clears the "vod" edge to the Vod entity.
+func (cmuo *ChatMessageUpdateOne) ClearVod() *ChatMessageUpdateOne {
+	cmuo.mutation.ClearVod()
+	return cmuo
+}
+
+// Where appends a list predicates to the ChatMessageUpdate builder.
+func (cmuo *ChatMessageUpdateOne) Where(ps ...predicate.ChatMessage) *ChatMessageUpdateOne {
+	cmuo.mutation.Where(ps...)
+	return cmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cmuo *ChatMessageUpdateOne) Select(field string, fields ...string) *ChatMessageUpdateOne {
+	cmuo.fields = append([]string{field}, fields...)
+	return cmuo
+}
+
+// Save executes the query and returns the updated ChatMessage entity.
+func (cmuo *ChatMessageUpdateOne) Save(ctx context.Context) (*ChatMessage, error) {
+	return withHooks(ctx, cmuo.sqlSave, cmuo.mutation, cmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmuo *ChatMessageUpdateOne) SaveX(ctx context.Context) *ChatMessage {
+	node, err := cmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cmuo *ChatMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := cmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmuo *ChatMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := cmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmuo *ChatMessageUpdateOne) check() error {
+	if _, ok := cmuo.mutation.VodID(); cmuo.mutation.VodCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ChatMessage.vod"`)
+	}
+	return nil
+}
+
+func (cmuo *ChatMessageUpdateOne) sqlSave(ctx context.Context) (_node *ChatMessage, err error) {
+	if err := cmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(chatmessage.Table, chatmessage.Columns, sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID))
+	id, ok := cmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ChatMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chatmessage.FieldID)
+		for _, f := range fields {
+			if !chatmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != chatmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cmuo.mutation.CommentID(); ok {
+		_spec.SetField(chatmessage.FieldCommentID, field.TypeString, value)
+	}
+	if cmuo.mutation.CommentIDCleared() {
+		_spec.ClearField(chatmessage.FieldCommentID, field.TypeString)
+	}
+	if value, ok := cmuo.mutation.ContentOffsetSeconds(); ok {
+		_spec.SetField(chatmessage.FieldContentOffsetSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := cmuo.mutation.AddedContentOffsetSeconds(); ok {
+		_spec.AddField(chatmessage.FieldContentOffsetSeconds, field.TypeFloat64, value)
+	}
+	if value, ok := cmuo.mutation.CommenterID(); ok {
+		_spec.SetField(chatmessage.FieldCommenterID, field.TypeString, value)
+	}
+	if cmuo.mutation.CommenterIDCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterID, field.TypeString)
+	}
+	if value, ok := cmuo.mutation.CommenterName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterName, field.TypeString, value)
+	}
+	if cmuo.mutation.CommenterNameCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterName, field.TypeString)
+	}
+	if value, ok := cmuo.mutation.CommenterDisplayName(); ok {
+		_spec.SetField(chatmessage.FieldCommenterDisplayName, field.TypeString, value)
+	}
+	if cmuo.mutation.CommenterDisplayNameCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterDisplayName, field.TypeString)
+	}
+	if value, ok := cmuo.mutation.CommenterBadges(); ok {
+		_spec.SetField(chatmessage.FieldCommenterBadges, field.TypeJSON, value)
+	}
+	if value, ok := cmuo.mutation.AppendedCommenterBadges(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, chatmessage.FieldCommenterBadges, value)
+		})
+	}
+	if cmuo.mutation.CommenterBadgesCleared() {
+		_spec.ClearField(chatmessage.FieldCommenterBadges, field.TypeJSON)
+	}
+	if value, ok := cmuo.mutation.Body(); ok {
+		_spec.SetField(chatmessage.FieldBody, field.TypeString, value)
+	}
+	if cmuo.mutation.BodyCleared() {
+		_spec.ClearField(chatmessage.FieldBody, field.TypeString)
+	}
+	if value, ok := cmuo.mutation.Data(); ok {
+		_spec.SetField(chatmessage.FieldData, field.TypeString, value)
+	}
+	if cmuo.mutation.VodCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.VodTable,
+			Columns: []string{chatmessage.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cmuo.mutation.VodIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   chatmessage.VodTable,
+			Columns: []string{chatmessage.VodColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ChatMessage{config: cmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chatmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cmuo.mutation.done = true
+	return _node, nil
+}
@@ -16,15 +16,24 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zibbp/ganymede/ent/apitoken"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
+	"github.com/zibbp/ganymede/ent/auditevent"
 	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/group"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
+	"github.com/zibbp/ganymede/ent/notificationdelivery"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
 	"github.com/zibbp/ganymede/ent/playback"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/ent/session"
 	"github.com/zibbp/ganymede/ent/twitchcategory"
 	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
@@ -35,10 +44,22 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ApiToken is the client for interacting with the ApiToken builders.
+	ApiToken *ApiTokenClient
+	// ArchiveProfile is the client for interacting with the ArchiveProfile builders.
+	ArchiveProfile *ArchiveProfileClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
 	// Channel is the client for interacting with the Channel builders.
 	Channel *ChannelClient
+	// ChannelPermission is the client for interacting with the ChannelPermission builders.
+	ChannelPermission *ChannelPermissionClient
 	// Chapter is the client for interacting with the Chapter builders.
 	Chapter *ChapterClient
+	// ChatMessage is the client for interacting with the ChatMessage builders.
+	ChatMessage *ChatMessageClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
 	// Live is the client for interacting with the Live builders.
 	Live *LiveClient
 	// LiveCategory is the client for interacting with the LiveCategory builders.
@@ -47,12 +68,18 @@ type Client struct {
 	LiveTitleRegex *LiveTitleRegexClient
 	// MutedSegment is the client for interacting with the MutedSegment builders.
 	MutedSegment *MutedSegmentClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
+	// NotificationSubscription is the client for interacting with the NotificationSubscription builders.
+	NotificationSubscription *NotificationSubscriptionClient
 	// Playback is the client for interacting with the Playback builders.
 	Playback *PlaybackClient
 	// Playlist is the client for interacting with the Playlist builders.
 	Playlist *PlaylistClient
 	// Queue is the client for interacting with the Queue builders.
 	Queue *QueueClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// TwitchCategory is the client for interacting with the TwitchCategory builders.
 	TwitchCategory *TwitchCategoryClient
 	// User is the client for interacting with the User builders.
@@ -70,15 +97,24 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ApiToken = NewApiTokenClient(c.config)
+	c.ArchiveProfile = NewArchiveProfileClient(c.config)
+	c.AuditEvent = NewAuditEventClient(c.config)
 	c.Channel = NewChannelClient(c.config)
+	c.ChannelPermission = NewChannelPermissionClient(c.config)
 	c.Chapter = NewChapterClient(c.config)
+	c.ChatMessage = NewChatMessageClient(c.config)
+	c.Group = NewGroupClient(c.config)
 	c.Live = NewLiveClient(c.config)
 	c.LiveCategory = NewLiveCategoryClient(c.config)
 	c.LiveTitleRegex = NewLiveTitleRegexClient(c.config)
 	c.MutedSegment = NewMutedSegmentClient(c.config)
+	c.NotificationDelivery = NewNotificationDeliveryClient(c.config)
+	c.NotificationSubscription = NewNotificationSubscriptionClient(c.config)
 	c.Playback = NewPlaybackClient(c.config)
 	c.Playlist = NewPlaylistClient(c.config)
 	c.Queue = NewQueueClient(c.config)
+	c.Session = NewSessionClient(c.config)
 	c.TwitchCategory = NewTwitchCategoryClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.Vod = NewVodClient(c.config)
@@ -172,20 +208,29 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Channel:        NewChannelClient(cfg),
-		Chapter:        NewChapterClient(cfg),
-		Live:           NewLiveClient(cfg),
-		LiveCategory:   NewLiveCategoryClient(cfg),
-		LiveTitleRegex: NewLiveTitleRegexClient(cfg),
-		MutedSegment:   NewMutedSegmentClient(cfg),
-		Playback:       NewPlaybackClient(cfg),
-		Playlist:       NewPlaylistClient(cfg),
-		Queue:          NewQueueClient(cfg),
-		TwitchCategory: NewTwitchCategoryClient(cfg),
-		User:           NewUserClient(cfg),
-		Vod:            NewVodClient(cfg),
+		ctx:                      ctx,
+		config:                   cfg,
+		ApiToken:                 NewApiTokenClient(cfg),
+		ArchiveProfile:           NewArchiveProfileClient(cfg),
+		AuditEvent:               NewAuditEventClient(cfg),
+		Channel:                  NewChannelClient(cfg),
+		ChannelPermission:        NewChannelPermissionClient(cfg),
+		Chapter:                  NewChapterClient(cfg),
+		ChatMessage:              NewChatMessageClient(cfg),
+		Group:                    NewGroupClient(cfg),
+		Live:                     NewLiveClient(cfg),
+		LiveCategory:             NewLiveCategoryClient(cfg),
+		LiveTitleRegex:           NewLiveTitleRegexClient(cfg),
+		MutedSegment:             NewMutedSegmentClient(cfg),
+		NotificationDelivery:     NewNotificationDeliveryClient(cfg),
+		NotificationSubscription: NewNotificationSubscriptionClient(cfg),
+		Playback:                 NewPlaybackClient(cfg),
+		Playlist:                 NewPlaylistClient(cfg),
+		Queue:                    NewQueueClient(cfg),
+		Session:                  NewSessionClient(cfg),
+		TwitchCategory:           NewTwitchCategoryClient(cfg),
+		User:                     NewUserClient(cfg),
+		Vod:                      NewVodClient(cfg),
 	}, nil
 }
 
@@ -203,27 +248,36 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Channel:        NewChannelClient(cfg),
-		Chapter:        NewChapterClient(cfg),
-		Live:           NewLiveClient(cfg),
-		LiveCategory:   NewLiveCategoryClient(cfg),
-		LiveTitleRegex: NewLiveTitleRegexClient(cfg),
-		MutedSegment:   NewMutedSegmentClient(cfg),
-		Playback:       NewPlaybackClient(cfg),
-		Playlist:       NewPlaylistClient(cfg),
-		Queue:          NewQueueClient(cfg),
-		TwitchCategory: NewTwitchCategoryClient(cfg),
-		User:           NewUserClient(cfg),
-		Vod:            NewVodClient(cfg),
+		ctx:                      ctx,
+		config:                   cfg,
+		ApiToken:                 NewApiTokenClient(cfg),
+		ArchiveProfile:           NewArchiveProfileClient(cfg),
+		AuditEvent:               NewAuditEventClient(cfg),
+		Channel:                  NewChannelClient(cfg),
+		ChannelPermission:        NewChannelPermissionClient(cfg),
+		Chapter:                  NewChapterClient(cfg),
+		ChatMessage:              NewChatMessageClient(cfg),
+		Group:                    NewGroupClient(cfg),
+		Live:                     NewLiveClient(cfg),
+		LiveCategory:             NewLiveCategoryClient(cfg),
+		LiveTitleRegex:           NewLiveTitleRegexClient(cfg),
+		MutedSegment:             NewMutedSegmentClient(cfg),
+		NotificationDelivery:     NewNotificationDeliveryClient(cfg),
+		NotificationSubscription: NewNotificationSubscriptionClient(cfg),
+		Playback:                 NewPlaybackClient(cfg),
+		Playlist:                 NewPlaylistClient(cfg),
+		Queue:                    NewQueueClient(cfg),
+		Session:                  NewSessionClient(cfg),
+		TwitchCategory:           NewTwitchCategoryClient(cfg),
+		User:                     NewUserClient(cfg),
+		Vod:                      NewVodClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Channel.
+//		ApiToken.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -246,8 +300,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Channel, c.Chapter, c.Live, c.LiveCategory, c.LiveTitleRegex, c.MutedSegment,
-		c.Playback, c.Playlist, c.Queue, c.TwitchCategory, c.User, c.Vod,
+		c.ApiToken, c.ArchiveProfile, c.AuditEvent, c.Channel, c.ChannelPermission,
+		c.Chapter, c.ChatMessage, c.Group, c.Live, c.LiveCategory, c.LiveTitleRegex,
+		c.MutedSegment, c.NotificationDelivery, c.NotificationSubscription, c.Playback,
+		c.Playlist, c.Queue, c.Session, c.TwitchCategory, c.User, c.Vod,
 	} {
 		n.Use(hooks...)
 	}
@@ -257,8 +313,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Channel, c.Chapter, c.Live, c.LiveCategory, c.LiveTitleRegex, c.MutedSegment,
-		c.Playback, c.Playlist, c.Queue, c.TwitchCategory, c.User, c.Vod,
+		c.ApiToken, c.ArchiveProfile, c.AuditEvent, c.Channel, c.ChannelPermission,
+		c.Chapter, c.ChatMessage, c.Group, c.Live, c.LiveCategory, c.LiveTitleRegex,
+		c.MutedSegment, c.NotificationDelivery, c.NotificationSubscription, c.Playback,
+		c.Playlist, c.Queue, c.Session, c.TwitchCategory, c.User, c.Vod,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -267,10 +325,22 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ApiTokenMutation:
+		return c.ApiToken.mutate(ctx, m)
+	case *ArchiveProfileMutation:
+		return c.ArchiveProfile.mutate(ctx, m)
+	case *AuditEventMutation:
+		return c.AuditEvent.mutate(ctx, m)
 	case *ChannelMutation:
 		return c.Channel.mutate(ctx, m)
+	case *ChannelPermissionMutation:
+		return c.ChannelPermission.mutate(ctx, m)
 	case *ChapterMutation:
 		return c.Chapter.mutate(ctx, m)
+	case *ChatMessageMutation:
+		return c.ChatMessage.mutate(ctx, m)
+	case *GroupMutation:
+		return c.Group.mutate(ctx, m)
 	case *LiveMutation:
 		return c.Live.mutate(ctx, m)
 	case *LiveCategoryMutation:
@@ -279,12 +349,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.LiveTitleRegex.mutate(ctx, m)
 	case *MutedSegmentMutation:
 		return c.MutedSegment.mutate(ctx, m)
+	case *NotificationDeliveryMutation:
+		return c.NotificationDelivery.mutate(ctx, m)
+	case *NotificationSubscriptionMutation:
+		return c.NotificationSubscription.mutate(ctx, m)
 	case *PlaybackMutation:
 		return c.Playback.mutate(ctx, m)
 	case *PlaylistMutation:
 		return c.Playlist.mutate(ctx, m)
 	case *QueueMutation:
 		return c.Queue.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
 	case *TwitchCategoryMutation:
 		return c.TwitchCategory.mutate(ctx, m)
 	case *UserMutation:
@@ -296,6 +372,469 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// ApiTokenClient is a client for the ApiToken schema.
+type ApiTokenClient struct {
+	config
+}
+
+// NewApiTokenClient returns a client for the ApiToken from the given config.
+func NewApiTokenClient(c config) *ApiTokenClient {
+	return &ApiTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apitoken.Hooks(f(g(h())))`.
+func (c *ApiTokenClient) Use(hooks ...Hook) {
+	c.hooks.ApiToken = append(c.hooks.ApiToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apitoken.Intercept(f(g(h())))`.
+func (c *ApiTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApiToken = append(c.inters.ApiToken, interceptors...)
+}
+
+// Create returns a builder for creating a ApiToken entity.
+func (c *ApiTokenClient) Create() *ApiTokenCreate {
+	mutation := newApiTokenMutation(c.config, OpCreate)
+	return &ApiTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApiToken entities.
+func (c *ApiTokenClient) CreateBulk(builders ...*ApiTokenCreate) *ApiTokenCreateBulk {
+	return &ApiTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApiTokenClient) MapCreateBulk(slice any, setFunc func(*ApiTokenCreate, int)) *ApiTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApiTokenCreateBulk{err: fmt.Errorf("calling to ApiTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApiTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApiTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApiToken.
+func (c *ApiTokenClient) Update() *ApiTokenUpdate {
+	mutation := newApiTokenMutation(c.config, OpUpdate)
+	return &ApiTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApiTokenClient) UpdateOne(at *ApiToken) *ApiTokenUpdateOne {
+	mutation := newApiTokenMutation(c.config, OpUpdateOne, withApiToken(at))
+	return &ApiTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApiTokenClient) UpdateOneID(id uuid.UUID) *ApiTokenUpdateOne {
+	mutation := newApiTokenMutation(c.config, OpUpdateOne, withApiTokenID(id))
+	return &ApiTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApiToken.
+func (c *ApiTokenClient) Delete() *ApiTokenDelete {
+	mutation := newApiTokenMutation(c.config, OpDelete)
+	return &ApiTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApiTokenClient) DeleteOne(at *ApiToken) *ApiTokenDeleteOne {
+	return c.DeleteOneID(at.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApiTokenClient) DeleteOneID(id uuid.UUID) *ApiTokenDeleteOne {
+	builder := c.Delete().Where(apitoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApiTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for ApiToken.
+func (c *ApiTokenClient) Query() *ApiTokenQuery {
+	return &ApiTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApiToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApiToken entity by its id.
+func (c *ApiTokenClient) Get(ctx context.Context, id uuid.UUID) (*ApiToken, error) {
+	return c.Query().Where(apitoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApiTokenClient) GetX(ctx context.Context, id uuid.UUID) *ApiToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ApiToken.
+func (c *ApiTokenClient) QueryUser(at *ApiToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := at.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(apitoken.Table, apitoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, apitoken.UserTable, apitoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(at.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ApiTokenClient) Hooks() []Hook {
+	return c.hooks.ApiToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApiTokenClient) Interceptors() []Interceptor {
+	return c.inters.ApiToken
+}
+
+func (c *ApiTokenClient) mutate(ctx context.Context, m *ApiTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApiTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApiTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApiTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApiTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApiToken mutation op: %q", m.Op())
+	}
+}
+
+// ArchiveProfileClient is a client for the ArchiveProfile schema.
+type ArchiveProfileClient struct {
+	config
+}
+
+// NewArchiveProfileClient returns a client for the ArchiveProfile from the given config.
+func NewArchiveProfileClient(c config) *ArchiveProfileClient {
+	return &ArchiveProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `archiveprofile.Hooks(f(g(h())))`.
+func (c *ArchiveProfileClient) Use(hooks ...Hook) {
+	c.hooks.ArchiveProfile = append(c.hooks.ArchiveProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `archiveprofile.Intercept(f(g(h())))`.
+func (c *ArchiveProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArchiveProfile = append(c.inters.ArchiveProfile, interceptors...)
+}
+
+// Create returns a builder for creating a ArchiveProfile entity.
+func (c *ArchiveProfileClient) Create() *ArchiveProfileCreate {
+	mutation := newArchiveProfileMutation(c.config, OpCreate)
+	return &ArchiveProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ArchiveProfile entities.
+func (c *ArchiveProfileClient) CreateBulk(builders ...*ArchiveProfileCreate) *ArchiveProfileCreateBulk {
+	return &ArchiveProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArchiveProfileClient) MapCreateBulk(slice any, setFunc func(*ArchiveProfileCreate, int)) *ArchiveProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArchiveProfileCreateBulk{err: fmt.Errorf("calling to ArchiveProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ArchiveProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ArchiveProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ArchiveProfile.
+func (c *ArchiveProfileClient) Update() *ArchiveProfileUpdate {
+	mutation := newArchiveProfileMutation(c.config, OpUpdate)
+	return &ArchiveProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArchiveProfileClient) UpdateOne(ap *ArchiveProfile) *ArchiveProfileUpdateOne {
+	mutation := newArchiveProfileMutation(c.config, OpUpdateOne, withArchiveProfile(ap))
+	return &ArchiveProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArchiveProfileClient) UpdateOneID(id uuid.UUID) *ArchiveProfileUpdateOne {
+	mutation := newArchiveProfileMutation(c.config, OpUpdateOne, withArchiveProfileID(id))
+	return &ArchiveProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ArchiveProfile.
+func (c *ArchiveProfileClient) Delete() *ArchiveProfileDelete {
+	mutation := newArchiveProfileMutation(c.config, OpDelete)
+	return &ArchiveProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArchiveProfileClient) DeleteOne(ap *ArchiveProfile) *ArchiveProfileDeleteOne {
+	return c.DeleteOneID(ap.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArchiveProfileClient) DeleteOneID(id uuid.UUID) *ArchiveProfileDeleteOne {
+	builder := c.Delete().Where(archiveprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArchiveProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for ArchiveProfile.
+func (c *ArchiveProfileClient) Query() *ArchiveProfileQuery {
+	return &ArchiveProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArchiveProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ArchiveProfile entity by its id.
+func (c *ArchiveProfileClient) Get(ctx context.Context, id uuid.UUID) (*ArchiveProfile, error) {
+	return c.Query().Where(archiveprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArchiveProfileClient) GetX(ctx context.Context, id uuid.UUID) *ArchiveProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChannels queries the channels edge of a ArchiveProfile.
+func (c *ArchiveProfileClient) QueryChannels(ap *ArchiveProfile) *ChannelQuery {
+	query := (&ChannelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ap.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archiveprofile.Table, archiveprofile.FieldID, id),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, archiveprofile.ChannelsTable, archiveprofile.ChannelsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ap.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLive queries the live edge of a ArchiveProfile.
+func (c *ArchiveProfileClient) QueryLive(ap *ArchiveProfile) *LiveQuery {
+	query := (&LiveClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ap.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archiveprofile.Table, archiveprofile.FieldID, id),
+			sqlgraph.To(live.Table, live.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, archiveprofile.LiveTable, archiveprofile.LiveColumn),
+		)
+		fromV = sqlgraph.Neighbors(ap.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVods queries the vods edge of a ArchiveProfile.
+func (c *ArchiveProfileClient) QueryVods(ap *ArchiveProfile) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ap.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archiveprofile.Table, archiveprofile.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, archiveprofile.VodsTable, archiveprofile.VodsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ap.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ArchiveProfileClient) Hooks() []Hook {
+	return c.hooks.ArchiveProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArchiveProfileClient) Interceptors() []Interceptor {
+	return c.inters.ArchiveProfile
+}
+
+func (c *ArchiveProfileClient) mutate(ctx context.Context, m *ArchiveProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArchiveProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArchiveProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArchiveProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArchiveProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ArchiveProfile mutation op: %q", m.Op())
+	}
+}
+
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
+	config
+}
+
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditevent.Intercept(f(g(h())))`.
+func (c *AuditEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEvent = append(c.inters.AuditEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEvent entity.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEventClient) MapCreateBulk(slice any, setFunc func(*AuditEventCreate, int)) *AuditEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEventCreateBulk{err: fmt.Errorf("calling to AuditEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventClient) UpdateOne(ae *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(ae))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventClient) UpdateOneID(id uuid.UUID) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEventClient) DeleteOne(ae *AuditEvent) *AuditEventDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEventClient) DeleteOneID(id uuid.UUID) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id uuid.UUID) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventClient) GetX(ctx context.Context, id uuid.UUID) *AuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventClient) Hooks() []Hook {
+	return c.hooks.AuditEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEventClient) Interceptors() []Interceptor {
+	return c.inters.AuditEvent
+}
+
+func (c *AuditEventClient) mutate(ctx context.Context, m *AuditEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditEvent mutation op: %q", m.Op())
+	}
+}
+
 // ChannelClient is a client for the Channel schema.
 type ChannelClient struct {
 	config
@@ -324,79 +863,277 @@ func (c *ChannelClient) Create() *ChannelCreate {
 	return &ChannelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Channel entities.
-func (c *ChannelClient) CreateBulk(builders ...*ChannelCreate) *ChannelCreateBulk {
-	return &ChannelCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Channel entities.
+func (c *ChannelClient) CreateBulk(builders ...*ChannelCreate) *ChannelCreateBulk {
+	return &ChannelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChannelClient) MapCreateBulk(slice any, setFunc func(*ChannelCreate, int)) *ChannelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChannelCreateBulk{err: fmt.Errorf("calling to ChannelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChannelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChannelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Channel.
+func (c *ChannelClient) Update() *ChannelUpdate {
+	mutation := newChannelMutation(c.config, OpUpdate)
+	return &ChannelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChannelClient) UpdateOne(ch *Channel) *ChannelUpdateOne {
+	mutation := newChannelMutation(c.config, OpUpdateOne, withChannel(ch))
+	return &ChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChannelClient) UpdateOneID(id uuid.UUID) *ChannelUpdateOne {
+	mutation := newChannelMutation(c.config, OpUpdateOne, withChannelID(id))
+	return &ChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Channel.
+func (c *ChannelClient) Delete() *ChannelDelete {
+	mutation := newChannelMutation(c.config, OpDelete)
+	return &ChannelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChannelClient) DeleteOne(ch *Channel) *ChannelDeleteOne {
+	return c.DeleteOneID(ch.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChannelClient) DeleteOneID(id uuid.UUID) *ChannelDeleteOne {
+	builder := c.Delete().Where(channel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChannelDeleteOne{builder}
+}
+
+// Query returns a query builder for Channel.
+func (c *ChannelClient) Query() *ChannelQuery {
+	return &ChannelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChannel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Channel entity by its id.
+func (c *ChannelClient) Get(ctx context.Context, id uuid.UUID) (*Channel, error) {
+	return c.Query().Where(channel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChannelClient) GetX(ctx context.Context, id uuid.UUID) *Channel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVods queries the vods edge of a Channel.
+func (c *ChannelClient) QueryVods(ch *Channel) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, channel.VodsTable, channel.VodsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLive queries the live edge of a Channel.
+func (c *ChannelClient) QueryLive(ch *Channel) *LiveQuery {
+	query := (&LiveClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, id),
+			sqlgraph.To(live.Table, live.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, channel.LiveTable, channel.LiveColumn),
+		)
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArchiveProfile queries the archive_profile edge of a Channel.
+func (c *ChannelClient) QueryArchiveProfile(ch *Channel) *ArchiveProfileQuery {
+	query := (&ArchiveProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, id),
+			sqlgraph.To(archiveprofile.Table, archiveprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, channel.ArchiveProfileTable, channel.ArchiveProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPermissions queries the permissions edge of a Channel.
+func (c *ChannelClient) QueryPermissions(ch *Channel) *ChannelPermissionQuery {
+	query := (&ChannelPermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channel.Table, channel.FieldID, id),
+			sqlgraph.To(channelpermission.Table, channelpermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, channel.PermissionsTable, channel.PermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChannelClient) Hooks() []Hook {
+	return c.hooks.Channel
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChannelClient) Interceptors() []Interceptor {
+	inters := c.inters.Channel
+	return append(inters[:len(inters):len(inters)], channel.Interceptors[:]...)
+}
+
+func (c *ChannelClient) mutate(ctx context.Context, m *ChannelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChannelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChannelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChannelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Channel mutation op: %q", m.Op())
+	}
+}
+
+// ChannelPermissionClient is a client for the ChannelPermission schema.
+type ChannelPermissionClient struct {
+	config
+}
+
+// NewChannelPermissionClient returns a client for the ChannelPermission from the given config.
+func NewChannelPermissionClient(c config) *ChannelPermissionClient {
+	return &ChannelPermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `channelpermission.Hooks(f(g(h())))`.
+func (c *ChannelPermissionClient) Use(hooks ...Hook) {
+	c.hooks.ChannelPermission = append(c.hooks.ChannelPermission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `channelpermission.Intercept(f(g(h())))`.
+func (c *ChannelPermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChannelPermission = append(c.inters.ChannelPermission, interceptors...)
+}
+
+// Create returns a builder for creating a ChannelPermission entity.
+func (c *ChannelPermissionClient) Create() *ChannelPermissionCreate {
+	mutation := newChannelPermissionMutation(c.config, OpCreate)
+	return &ChannelPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChannelPermission entities.
+func (c *ChannelPermissionClient) CreateBulk(builders ...*ChannelPermissionCreate) *ChannelPermissionCreateBulk {
+	return &ChannelPermissionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ChannelClient) MapCreateBulk(slice any, setFunc func(*ChannelCreate, int)) *ChannelCreateBulk {
+func (c *ChannelPermissionClient) MapCreateBulk(slice any, setFunc func(*ChannelPermissionCreate, int)) *ChannelPermissionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ChannelCreateBulk{err: fmt.Errorf("calling to ChannelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ChannelPermissionCreateBulk{err: fmt.Errorf("calling to ChannelPermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ChannelCreate, rv.Len())
+	builders := make([]*ChannelPermissionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ChannelCreateBulk{config: c.config, builders: builders}
+	return &ChannelPermissionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Channel.
-func (c *ChannelClient) Update() *ChannelUpdate {
-	mutation := newChannelMutation(c.config, OpUpdate)
-	return &ChannelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ChannelPermission.
+func (c *ChannelPermissionClient) Update() *ChannelPermissionUpdate {
+	mutation := newChannelPermissionMutation(c.config, OpUpdate)
+	return &ChannelPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ChannelClient) UpdateOne(ch *Channel) *ChannelUpdateOne {
-	mutation := newChannelMutation(c.config, OpUpdateOne, withChannel(ch))
-	return &ChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChannelPermissionClient) UpdateOne(cp *ChannelPermission) *ChannelPermissionUpdateOne {
+	mutation := newChannelPermissionMutation(c.config, OpUpdateOne, withChannelPermission(cp))
+	return &ChannelPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ChannelClient) UpdateOneID(id uuid.UUID) *ChannelUpdateOne {
-	mutation := newChannelMutation(c.config, OpUpdateOne, withChannelID(id))
-	return &ChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChannelPermissionClient) UpdateOneID(id uuid.UUID) *ChannelPermissionUpdateOne {
+	mutation := newChannelPermissionMutation(c.config, OpUpdateOne, withChannelPermissionID(id))
+	return &ChannelPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Channel.
-func (c *ChannelClient) Delete() *ChannelDelete {
-	mutation := newChannelMutation(c.config, OpDelete)
-	return &ChannelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ChannelPermission.
+func (c *ChannelPermissionClient) Delete() *ChannelPermissionDelete {
+	mutation := newChannelPermissionMutation(c.config, OpDelete)
+	return &ChannelPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ChannelClient) DeleteOne(ch *Channel) *ChannelDeleteOne {
-	return c.DeleteOneID(ch.ID)
+func (c *ChannelPermissionClient) DeleteOne(cp *ChannelPermission) *ChannelPermissionDeleteOne {
+	return c.DeleteOneID(cp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ChannelClient) DeleteOneID(id uuid.UUID) *ChannelDeleteOne {
-	builder := c.Delete().Where(channel.ID(id))
+func (c *ChannelPermissionClient) DeleteOneID(id uuid.UUID) *ChannelPermissionDeleteOne {
+	builder := c.Delete().Where(channelpermission.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ChannelDeleteOne{builder}
+	return &ChannelPermissionDeleteOne{builder}
 }
 
-// Query returns a query builder for Channel.
-func (c *ChannelClient) Query() *ChannelQuery {
-	return &ChannelQuery{
+// Query returns a query builder for ChannelPermission.
+func (c *ChannelPermissionClient) Query() *ChannelPermissionQuery {
+	return &ChannelPermissionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeChannel},
+		ctx:    &QueryContext{Type: TypeChannelPermission},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Channel entity by its id.
-func (c *ChannelClient) Get(ctx context.Context, id uuid.UUID) (*Channel, error) {
-	return c.Query().Where(channel.ID(id)).Only(ctx)
+// Get returns a ChannelPermission entity by its id.
+func (c *ChannelPermissionClient) Get(ctx context.Context, id uuid.UUID) (*ChannelPermission, error) {
+	return c.Query().Where(channelpermission.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ChannelClient) GetX(ctx context.Context, id uuid.UUID) *Channel {
+func (c *ChannelPermissionClient) GetX(ctx context.Context, id uuid.UUID) *ChannelPermission {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -404,60 +1141,76 @@ func (c *ChannelClient) GetX(ctx context.Context, id uuid.UUID) *Channel {
 	return obj
 }
 
-// QueryVods queries the vods edge of a Channel.
-func (c *ChannelClient) QueryVods(ch *Channel) *VodQuery {
-	query := (&VodClient{config: c.config}).Query()
+// QueryChannel queries the channel edge of a ChannelPermission.
+func (c *ChannelPermissionClient) QueryChannel(cp *ChannelPermission) *ChannelQuery {
+	query := (&ChannelClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ch.ID
+		id := cp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(channel.Table, channel.FieldID, id),
-			sqlgraph.To(vod.Table, vod.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, channel.VodsTable, channel.VodsColumn),
+			sqlgraph.From(channelpermission.Table, channelpermission.FieldID, id),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, channelpermission.ChannelTable, channelpermission.ChannelColumn),
 		)
-		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(cp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryLive queries the live edge of a Channel.
-func (c *ChannelClient) QueryLive(ch *Channel) *LiveQuery {
-	query := (&LiveClient{config: c.config}).Query()
+// QueryUser queries the user edge of a ChannelPermission.
+func (c *ChannelPermissionClient) QueryUser(cp *ChannelPermission) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ch.ID
+		id := cp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(channel.Table, channel.FieldID, id),
-			sqlgraph.To(live.Table, live.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, channel.LiveTable, channel.LiveColumn),
+			sqlgraph.From(channelpermission.Table, channelpermission.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, channelpermission.UserTable, channelpermission.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(cp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroup queries the group edge of a ChannelPermission.
+func (c *ChannelPermissionClient) QueryGroup(cp *ChannelPermission) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(channelpermission.Table, channelpermission.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, channelpermission.GroupTable, channelpermission.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(cp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ChannelClient) Hooks() []Hook {
-	return c.hooks.Channel
+func (c *ChannelPermissionClient) Hooks() []Hook {
+	return c.hooks.ChannelPermission
 }
 
 // Interceptors returns the client interceptors.
-func (c *ChannelClient) Interceptors() []Interceptor {
-	return c.inters.Channel
+func (c *ChannelPermissionClient) Interceptors() []Interceptor {
+	return c.inters.ChannelPermission
 }
 
-func (c *ChannelClient) mutate(ctx context.Context, m *ChannelMutation) (Value, error) {
+func (c *ChannelPermissionClient) mutate(ctx context.Context, m *ChannelPermissionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ChannelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChannelPermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ChannelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChannelPermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChannelPermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ChannelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ChannelPermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Channel mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ChannelPermission mutation op: %q", m.Op())
 	}
 }
 
@@ -561,7 +1314,305 @@ func (c *ChapterClient) Get(ctx context.Context, id uuid.UUID) (*Chapter, error)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ChapterClient) GetX(ctx context.Context, id uuid.UUID) *Chapter {
+func (c *ChapterClient) GetX(ctx context.Context, id uuid.UUID) *Chapter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVod queries the vod edge of a Chapter.
+func (c *ChapterClient) QueryVod(ch *Chapter) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ch.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chapter.Table, chapter.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chapter.VodTable, chapter.VodColumn),
+		)
+		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChapterClient) Hooks() []Hook {
+	return c.hooks.Chapter
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChapterClient) Interceptors() []Interceptor {
+	return c.inters.Chapter
+}
+
+func (c *ChapterClient) mutate(ctx context.Context, m *ChapterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChapterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChapterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChapterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChapterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Chapter mutation op: %q", m.Op())
+	}
+}
+
+// ChatMessageClient is a client for the ChatMessage schema.
+type ChatMessageClient struct {
+	config
+}
+
+// NewChatMessageClient returns a client for the ChatMessage from the given config.
+func NewChatMessageClient(c config) *ChatMessageClient {
+	return &ChatMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `chatmessage.Hooks(f(g(h())))`.
+func (c *ChatMessageClient) Use(hooks ...Hook) {
+	c.hooks.ChatMessage = append(c.hooks.ChatMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `chatmessage.Intercept(f(g(h())))`.
+func (c *ChatMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChatMessage = append(c.inters.ChatMessage, interceptors...)
+}
+
+// Create returns a builder for creating a ChatMessage entity.
+func (c *ChatMessageClient) Create() *ChatMessageCreate {
+	mutation := newChatMessageMutation(c.config, OpCreate)
+	return &ChatMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ChatMessage entities.
+func (c *ChatMessageClient) CreateBulk(builders ...*ChatMessageCreate) *ChatMessageCreateBulk {
+	return &ChatMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ChatMessageClient) MapCreateBulk(slice any, setFunc func(*ChatMessageCreate, int)) *ChatMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ChatMessageCreateBulk{err: fmt.Errorf("calling to ChatMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ChatMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ChatMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ChatMessage.
+func (c *ChatMessageClient) Update() *ChatMessageUpdate {
+	mutation := newChatMessageMutation(c.config, OpUpdate)
+	return &ChatMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ChatMessageClient) UpdateOne(cm *ChatMessage) *ChatMessageUpdateOne {
+	mutation := newChatMessageMutation(c.config, OpUpdateOne, withChatMessage(cm))
+	return &ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ChatMessageClient) UpdateOneID(id uuid.UUID) *ChatMessageUpdateOne {
+	mutation := newChatMessageMutation(c.config, OpUpdateOne, withChatMessageID(id))
+	return &ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ChatMessage.
+func (c *ChatMessageClient) Delete() *ChatMessageDelete {
+	mutation := newChatMessageMutation(c.config, OpDelete)
+	return &ChatMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ChatMessageClient) DeleteOne(cm *ChatMessage) *ChatMessageDeleteOne {
+	return c.DeleteOneID(cm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ChatMessageClient) DeleteOneID(id uuid.UUID) *ChatMessageDeleteOne {
+	builder := c.Delete().Where(chatmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ChatMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for ChatMessage.
+func (c *ChatMessageClient) Query() *ChatMessageQuery {
+	return &ChatMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeChatMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ChatMessage entity by its id.
+func (c *ChatMessageClient) Get(ctx context.Context, id uuid.UUID) (*ChatMessage, error) {
+	return c.Query().Where(chatmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ChatMessageClient) GetX(ctx context.Context, id uuid.UUID) *ChatMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVod queries the vod edge of a ChatMessage.
+func (c *ChatMessageClient) QueryVod(cm *ChatMessage) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(chatmessage.Table, chatmessage.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, chatmessage.VodTable, chatmessage.VodColumn),
+		)
+		fromV = sqlgraph.Neighbors(cm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ChatMessageClient) Hooks() []Hook {
+	return c.hooks.ChatMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ChatMessageClient) Interceptors() []Interceptor {
+	return c.inters.ChatMessage
+}
+
+func (c *ChatMessageClient) mutate(ctx context.Context, m *ChatMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ChatMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ChatMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ChatMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ChatMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ChatMessage mutation op: %q", m.Op())
+	}
+}
+
+// GroupClient is a client for the Group schema.
+type GroupClient struct {
+	config
+}
+
+// NewGroupClient returns a client for the Group from the given config.
+func NewGroupClient(c config) *GroupClient {
+	return &GroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `group.Hooks(f(g(h())))`.
+func (c *GroupClient) Use(hooks ...Hook) {
+	c.hooks.Group = append(c.hooks.Group, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
+// Create returns a builder for creating a Group entity.
+func (c *GroupClient) Create() *GroupCreate {
+	mutation := newGroupMutation(c.config, OpCreate)
+	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Group entities.
+func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Group.
+func (c *GroupClient) Update() *GroupUpdate {
+	mutation := newGroupMutation(c.config, OpUpdate)
+	return &GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupClient) UpdateOne(gr *Group) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroup(gr))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupClient) UpdateOneID(id uuid.UUID) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroupID(id))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Group.
+func (c *GroupClient) Delete() *GroupDelete {
+	mutation := newGroupMutation(c.config, OpDelete)
+	return &GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupClient) DeleteOne(gr *Group) *GroupDeleteOne {
+	return c.DeleteOneID(gr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupClient) DeleteOneID(id uuid.UUID) *GroupDeleteOne {
+	builder := c.Delete().Where(group.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupDeleteOne{builder}
+}
+
+// Query returns a query builder for Group.
+func (c *GroupClient) Query() *GroupQuery {
+	return &GroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Group entity by its id.
+func (c *GroupClient) Get(ctx context.Context, id uuid.UUID) (*Group, error) {
+	return c.Query().Where(group.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupClient) GetX(ctx context.Context, id uuid.UUID) *Group {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -569,44 +1620,60 @@ func (c *ChapterClient) GetX(ctx context.Context, id uuid.UUID) *Chapter {
 	return obj
 }
 
-// QueryVod queries the vod edge of a Chapter.
-func (c *ChapterClient) QueryVod(ch *Chapter) *VodQuery {
-	query := (&VodClient{config: c.config}).Query()
+// QueryUsers queries the users edge of a Group.
+func (c *GroupClient) QueryUsers(gr *Group) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ch.ID
+		id := gr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(chapter.Table, chapter.FieldID, id),
-			sqlgraph.To(vod.Table, vod.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, chapter.VodTable, chapter.VodColumn),
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, group.UsersTable, group.UsersPrimaryKey...),
 		)
-		fromV = sqlgraph.Neighbors(ch.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChannelPermissions queries the channel_permissions edge of a Group.
+func (c *GroupClient) QueryChannelPermissions(gr *Group) *ChannelPermissionQuery {
+	query := (&ChannelPermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := gr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(channelpermission.Table, channelpermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.ChannelPermissionsTable, group.ChannelPermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(gr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ChapterClient) Hooks() []Hook {
-	return c.hooks.Chapter
+func (c *GroupClient) Hooks() []Hook {
+	return c.hooks.Group
 }
 
 // Interceptors returns the client interceptors.
-func (c *ChapterClient) Interceptors() []Interceptor {
-	return c.inters.Chapter
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
 }
 
-func (c *ChapterClient) mutate(ctx context.Context, m *ChapterMutation) (Value, error) {
+func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ChapterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ChapterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ChapterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ChapterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Chapter mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Group mutation op: %q", m.Op())
 	}
 }
 
@@ -734,6 +1801,22 @@ func (c *LiveClient) QueryChannel(l *Live) *ChannelQuery {
 	return query
 }
 
+// QueryArchiveProfile queries the archive_profile edge of a Live.
+func (c *LiveClient) QueryArchiveProfile(l *Live) *ArchiveProfileQuery {
+	query := (&ArchiveProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(live.Table, live.FieldID, id),
+			sqlgraph.To(archiveprofile.Table, archiveprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, live.ArchiveProfileTable, live.ArchiveProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryCategories queries the categories edge of a Live.
 func (c *LiveClient) QueryCategories(l *Live) *LiveCategoryQuery {
 	query := (&LiveCategoryClient{config: c.config}).Query()
@@ -1100,96 +2183,378 @@ func NewMutedSegmentClient(c config) *MutedSegmentClient {
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `mutedsegment.Hooks(f(g(h())))`.
-func (c *MutedSegmentClient) Use(hooks ...Hook) {
-	c.hooks.MutedSegment = append(c.hooks.MutedSegment, hooks...)
+// A call to `Use(f, g, h)` equals to `mutedsegment.Hooks(f(g(h())))`.
+func (c *MutedSegmentClient) Use(hooks ...Hook) {
+	c.hooks.MutedSegment = append(c.hooks.MutedSegment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mutedsegment.Intercept(f(g(h())))`.
+func (c *MutedSegmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MutedSegment = append(c.inters.MutedSegment, interceptors...)
+}
+
+// Create returns a builder for creating a MutedSegment entity.
+func (c *MutedSegmentClient) Create() *MutedSegmentCreate {
+	mutation := newMutedSegmentMutation(c.config, OpCreate)
+	return &MutedSegmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MutedSegment entities.
+func (c *MutedSegmentClient) CreateBulk(builders ...*MutedSegmentCreate) *MutedSegmentCreateBulk {
+	return &MutedSegmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MutedSegmentClient) MapCreateBulk(slice any, setFunc func(*MutedSegmentCreate, int)) *MutedSegmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MutedSegmentCreateBulk{err: fmt.Errorf("calling to MutedSegmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MutedSegmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MutedSegmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MutedSegment.
+func (c *MutedSegmentClient) Update() *MutedSegmentUpdate {
+	mutation := newMutedSegmentMutation(c.config, OpUpdate)
+	return &MutedSegmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MutedSegmentClient) UpdateOne(ms *MutedSegment) *MutedSegmentUpdateOne {
+	mutation := newMutedSegmentMutation(c.config, OpUpdateOne, withMutedSegment(ms))
+	return &MutedSegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MutedSegmentClient) UpdateOneID(id uuid.UUID) *MutedSegmentUpdateOne {
+	mutation := newMutedSegmentMutation(c.config, OpUpdateOne, withMutedSegmentID(id))
+	return &MutedSegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MutedSegment.
+func (c *MutedSegmentClient) Delete() *MutedSegmentDelete {
+	mutation := newMutedSegmentMutation(c.config, OpDelete)
+	return &MutedSegmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MutedSegmentClient) DeleteOne(ms *MutedSegment) *MutedSegmentDeleteOne {
+	return c.DeleteOneID(ms.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MutedSegmentClient) DeleteOneID(id uuid.UUID) *MutedSegmentDeleteOne {
+	builder := c.Delete().Where(mutedsegment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MutedSegmentDeleteOne{builder}
+}
+
+// Query returns a query builder for MutedSegment.
+func (c *MutedSegmentClient) Query() *MutedSegmentQuery {
+	return &MutedSegmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMutedSegment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MutedSegment entity by its id.
+func (c *MutedSegmentClient) Get(ctx context.Context, id uuid.UUID) (*MutedSegment, error) {
+	return c.Query().Where(mutedsegment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MutedSegmentClient) GetX(ctx context.Context, id uuid.UUID) *MutedSegment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVod queries the vod edge of a MutedSegment.
+func (c *MutedSegmentClient) QueryVod(ms *MutedSegment) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ms.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mutedsegment.Table, mutedsegment.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mutedsegment.VodTable, mutedsegment.VodColumn),
+		)
+		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MutedSegmentClient) Hooks() []Hook {
+	return c.hooks.MutedSegment
+}
+
+// Interceptors returns the client interceptors.
+func (c *MutedSegmentClient) Interceptors() []Interceptor {
+	return c.inters.MutedSegment
+}
+
+func (c *MutedSegmentClient) mutate(ctx context.Context, m *MutedSegmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MutedSegmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MutedSegmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MutedSegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MutedSegmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MutedSegment mutation op: %q", m.Op())
+	}
+}
+
+// NotificationDeliveryClient is a client for the NotificationDelivery schema.
+type NotificationDeliveryClient struct {
+	config
+}
+
+// NewNotificationDeliveryClient returns a client for the NotificationDelivery from the given config.
+func NewNotificationDeliveryClient(c config) *NotificationDeliveryClient {
+	return &NotificationDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationdelivery.Hooks(f(g(h())))`.
+func (c *NotificationDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.NotificationDelivery = append(c.hooks.NotificationDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `notificationdelivery.Intercept(f(g(h())))`.
+func (c *NotificationDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationDelivery = append(c.inters.NotificationDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a NotificationDelivery entity.
+func (c *NotificationDeliveryClient) Create() *NotificationDeliveryCreate {
+	mutation := newNotificationDeliveryMutation(c.config, OpCreate)
+	return &NotificationDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NotificationDelivery entities.
+func (c *NotificationDeliveryClient) CreateBulk(builders ...*NotificationDeliveryCreate) *NotificationDeliveryCreateBulk {
+	return &NotificationDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NotificationDeliveryClient) MapCreateBulk(slice any, setFunc func(*NotificationDeliveryCreate, int)) *NotificationDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NotificationDeliveryCreateBulk{err: fmt.Errorf("calling to NotificationDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NotificationDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NotificationDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Update() *NotificationDeliveryUpdate {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdate)
+	return &NotificationDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NotificationDeliveryClient) UpdateOne(nd *NotificationDelivery) *NotificationDeliveryUpdateOne {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdateOne, withNotificationDelivery(nd))
+	return &NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NotificationDeliveryClient) UpdateOneID(id uuid.UUID) *NotificationDeliveryUpdateOne {
+	mutation := newNotificationDeliveryMutation(c.config, OpUpdateOne, withNotificationDeliveryID(id))
+	return &NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Delete() *NotificationDeliveryDelete {
+	mutation := newNotificationDeliveryMutation(c.config, OpDelete)
+	return &NotificationDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NotificationDeliveryClient) DeleteOne(nd *NotificationDelivery) *NotificationDeliveryDeleteOne {
+	return c.DeleteOneID(nd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NotificationDeliveryClient) DeleteOneID(id uuid.UUID) *NotificationDeliveryDeleteOne {
+	builder := c.Delete().Where(notificationdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NotificationDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for NotificationDelivery.
+func (c *NotificationDeliveryClient) Query() *NotificationDeliveryQuery {
+	return &NotificationDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNotificationDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NotificationDelivery entity by its id.
+func (c *NotificationDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*NotificationDelivery, error) {
+	return c.Query().Where(notificationdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NotificationDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *NotificationDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NotificationDeliveryClient) Hooks() []Hook {
+	return c.hooks.NotificationDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *NotificationDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.NotificationDelivery
+}
+
+func (c *NotificationDeliveryClient) mutate(ctx context.Context, m *NotificationDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NotificationDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NotificationDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NotificationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NotificationDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NotificationDelivery mutation op: %q", m.Op())
+	}
+}
+
+// NotificationSubscriptionClient is a client for the NotificationSubscription schema.
+type NotificationSubscriptionClient struct {
+	config
+}
+
+// NewNotificationSubscriptionClient returns a client for the NotificationSubscription from the given config.
+func NewNotificationSubscriptionClient(c config) *NotificationSubscriptionClient {
+	return &NotificationSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `notificationsubscription.Hooks(f(g(h())))`.
+func (c *NotificationSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.NotificationSubscription = append(c.hooks.NotificationSubscription, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `mutedsegment.Intercept(f(g(h())))`.
-func (c *MutedSegmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.MutedSegment = append(c.inters.MutedSegment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `notificationsubscription.Intercept(f(g(h())))`.
+func (c *NotificationSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NotificationSubscription = append(c.inters.NotificationSubscription, interceptors...)
 }
 
-// Create returns a builder for creating a MutedSegment entity.
-func (c *MutedSegmentClient) Create() *MutedSegmentCreate {
-	mutation := newMutedSegmentMutation(c.config, OpCreate)
-	return &MutedSegmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a NotificationSubscription entity.
+func (c *NotificationSubscriptionClient) Create() *NotificationSubscriptionCreate {
+	mutation := newNotificationSubscriptionMutation(c.config, OpCreate)
+	return &NotificationSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of MutedSegment entities.
-func (c *MutedSegmentClient) CreateBulk(builders ...*MutedSegmentCreate) *MutedSegmentCreateBulk {
-	return &MutedSegmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of NotificationSubscription entities.
+func (c *NotificationSubscriptionClient) CreateBulk(builders ...*NotificationSubscriptionCreate) *NotificationSubscriptionCreateBulk {
+	return &NotificationSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MutedSegmentClient) MapCreateBulk(slice any, setFunc func(*MutedSegmentCreate, int)) *MutedSegmentCreateBulk {
+func (c *NotificationSubscriptionClient) MapCreateBulk(slice any, setFunc func(*NotificationSubscriptionCreate, int)) *NotificationSubscriptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MutedSegmentCreateBulk{err: fmt.Errorf("calling to MutedSegmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &NotificationSubscriptionCreateBulk{err: fmt.Errorf("calling to NotificationSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MutedSegmentCreate, rv.Len())
+	builders := make([]*NotificationSubscriptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MutedSegmentCreateBulk{config: c.config, builders: builders}
+	return &NotificationSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for MutedSegment.
-func (c *MutedSegmentClient) Update() *MutedSegmentUpdate {
-	mutation := newMutedSegmentMutation(c.config, OpUpdate)
-	return &MutedSegmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for NotificationSubscription.
+func (c *NotificationSubscriptionClient) Update() *NotificationSubscriptionUpdate {
+	mutation := newNotificationSubscriptionMutation(c.config, OpUpdate)
+	return &NotificationSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MutedSegmentClient) UpdateOne(ms *MutedSegment) *MutedSegmentUpdateOne {
-	mutation := newMutedSegmentMutation(c.config, OpUpdateOne, withMutedSegment(ms))
-	return &MutedSegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationSubscriptionClient) UpdateOne(ns *NotificationSubscription) *NotificationSubscriptionUpdateOne {
+	mutation := newNotificationSubscriptionMutation(c.config, OpUpdateOne, withNotificationSubscription(ns))
+	return &NotificationSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MutedSegmentClient) UpdateOneID(id uuid.UUID) *MutedSegmentUpdateOne {
-	mutation := newMutedSegmentMutation(c.config, OpUpdateOne, withMutedSegmentID(id))
-	return &MutedSegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *NotificationSubscriptionClient) UpdateOneID(id uuid.UUID) *NotificationSubscriptionUpdateOne {
+	mutation := newNotificationSubscriptionMutation(c.config, OpUpdateOne, withNotificationSubscriptionID(id))
+	return &NotificationSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for MutedSegment.
-func (c *MutedSegmentClient) Delete() *MutedSegmentDelete {
-	mutation := newMutedSegmentMutation(c.config, OpDelete)
-	return &MutedSegmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for NotificationSubscription.
+func (c *NotificationSubscriptionClient) Delete() *NotificationSubscriptionDelete {
+	mutation := newNotificationSubscriptionMutation(c.config, OpDelete)
+	return &NotificationSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MutedSegmentClient) DeleteOne(ms *MutedSegment) *MutedSegmentDeleteOne {
-	return c.DeleteOneID(ms.ID)
+func (c *NotificationSubscriptionClient) DeleteOne(ns *NotificationSubscription) *NotificationSubscriptionDeleteOne {
+	return c.DeleteOneID(ns.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MutedSegmentClient) DeleteOneID(id uuid.UUID) *MutedSegmentDeleteOne {
-	builder := c.Delete().Where(mutedsegment.ID(id))
+func (c *NotificationSubscriptionClient) DeleteOneID(id uuid.UUID) *NotificationSubscriptionDeleteOne {
+	builder := c.Delete().Where(notificationsubscription.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MutedSegmentDeleteOne{builder}
+	return &NotificationSubscriptionDeleteOne{builder}
 }
 
-// Query returns a query builder for MutedSegment.
-func (c *MutedSegmentClient) Query() *MutedSegmentQuery {
-	return &MutedSegmentQuery{
+// Query returns a query builder for NotificationSubscription.
+func (c *NotificationSubscriptionClient) Query() *NotificationSubscriptionQuery {
+	return &NotificationSubscriptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMutedSegment},
+		ctx:    &QueryContext{Type: TypeNotificationSubscription},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a MutedSegment entity by its id.
-func (c *MutedSegmentClient) Get(ctx context.Context, id uuid.UUID) (*MutedSegment, error) {
-	return c.Query().Where(mutedsegment.ID(id)).Only(ctx)
+// Get returns a NotificationSubscription entity by its id.
+func (c *NotificationSubscriptionClient) Get(ctx context.Context, id uuid.UUID) (*NotificationSubscription, error) {
+	return c.Query().Where(notificationsubscription.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *MutedSegmentClient) GetX(ctx context.Context, id uuid.UUID) *MutedSegment {
+func (c *NotificationSubscriptionClient) GetX(ctx context.Context, id uuid.UUID) *NotificationSubscription {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1197,44 +2562,60 @@ func (c *MutedSegmentClient) GetX(ctx context.Context, id uuid.UUID) *MutedSegme
 	return obj
 }
 
-// QueryVod queries the vod edge of a MutedSegment.
-func (c *MutedSegmentClient) QueryVod(ms *MutedSegment) *VodQuery {
-	query := (&VodClient{config: c.config}).Query()
+// QueryUser queries the user edge of a NotificationSubscription.
+func (c *NotificationSubscriptionClient) QueryUser(ns *NotificationSubscription) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ms.ID
+		id := ns.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(mutedsegment.Table, mutedsegment.FieldID, id),
-			sqlgraph.To(vod.Table, vod.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, mutedsegment.VodTable, mutedsegment.VodColumn),
+			sqlgraph.From(notificationsubscription.Table, notificationsubscription.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationsubscription.UserTable, notificationsubscription.UserColumn),
 		)
-		fromV = sqlgraph.Neighbors(ms.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ns.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChannels queries the channels edge of a NotificationSubscription.
+func (c *NotificationSubscriptionClient) QueryChannels(ns *NotificationSubscription) *ChannelQuery {
+	query := (&ChannelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ns.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationsubscription.Table, notificationsubscription.FieldID, id),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, notificationsubscription.ChannelsTable, notificationsubscription.ChannelsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ns.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *MutedSegmentClient) Hooks() []Hook {
-	return c.hooks.MutedSegment
+func (c *NotificationSubscriptionClient) Hooks() []Hook {
+	return c.hooks.NotificationSubscription
 }
 
 // Interceptors returns the client interceptors.
-func (c *MutedSegmentClient) Interceptors() []Interceptor {
-	return c.inters.MutedSegment
+func (c *NotificationSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.NotificationSubscription
 }
 
-func (c *MutedSegmentClient) mutate(ctx context.Context, m *MutedSegmentMutation) (Value, error) {
+func (c *NotificationSubscriptionClient) mutate(ctx context.Context, m *NotificationSubscriptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&MutedSegmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&MutedSegmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&MutedSegmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&NotificationSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&MutedSegmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&NotificationSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown MutedSegment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown NotificationSubscription mutation op: %q", m.Op())
 	}
 }
 
@@ -1669,6 +3050,155 @@ func (c *QueueClient) mutate(ctx context.Context, m *QueueMutation) (Value, erro
 	}
 }
 
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
+	config
+}
+
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionClient) UpdateOne(s *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(s))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionClient) UpdateOneID(id uuid.UUID) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionClient) DeleteOne(s *Session) *SessionDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionClient) DeleteOneID(id uuid.UUID) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionDeleteOne{builder}
+}
+
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id uuid.UUID) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionClient) GetX(ctx context.Context, id uuid.UUID) *Session {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Session.
+func (c *SessionClient) QueryUser(s *Session) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.UserTable, session.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
+}
+
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+	}
+}
+
 // TwitchCategoryClient is a client for the TwitchCategory schema.
 type TwitchCategoryClient struct {
 	config
@@ -1910,6 +3440,86 @@ func (c *UserClient) GetX(ctx context.Context, id uuid.UUID) *User {
 	return obj
 }
 
+// QueryNotificationSubscriptions queries the notification_subscriptions edge of a User.
+func (c *UserClient) QueryNotificationSubscriptions(u *User) *NotificationSubscriptionQuery {
+	query := (&NotificationSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(notificationsubscription.Table, notificationsubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.NotificationSubscriptionsTable, user.NotificationSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPITokens queries the api_tokens edge of a User.
+func (c *UserClient) QueryAPITokens(u *User) *ApiTokenQuery {
+	query := (&ApiTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(apitoken.Table, apitoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.APITokensTable, user.APITokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a User.
+func (c *UserClient) QuerySessions(u *User) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChannelPermissions queries the channel_permissions edge of a User.
+func (c *UserClient) QueryChannelPermissions(u *User) *ChannelPermissionQuery {
+	query := (&ChannelPermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(channelpermission.Table, channelpermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ChannelPermissionsTable, user.ChannelPermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroups queries the groups edge of a User.
+func (c *UserClient) QueryGroups(u *User) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, user.GroupsTable, user.GroupsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -2123,6 +3733,86 @@ func (c *VodClient) QueryMutedSegments(v *Vod) *MutedSegmentQuery {
 	return query
 }
 
+// QueryClipSource queries the clip_source edge of a Vod.
+func (c *VodClient) QueryClipSource(v *Vod) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := v.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vod.ClipSourceTable, vod.ClipSourceColumn),
+		)
+		fromV = sqlgraph.Neighbors(v.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryClips queries the clips edge of a Vod.
+func (c *VodClient) QueryClips(v *Vod) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := v.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ClipsTable, vod.ClipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(v.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAlternate queries the alternate edge of a Vod.
+func (c *VodClient) QueryAlternate(v *Vod) *VodQuery {
+	query := (&VodClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := v.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, vod.AlternateTable, vod.AlternateColumn),
+		)
+		fromV = sqlgraph.Neighbors(v.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryArchiveProfile queries the archive_profile edge of a Vod.
+func (c *VodClient) QueryArchiveProfile(v *Vod) *ArchiveProfileQuery {
+	query := (&ArchiveProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := v.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(archiveprofile.Table, archiveprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vod.ArchiveProfileTable, vod.ArchiveProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(v.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChatMessages queries the chat_messages edge of a Vod.
+func (c *VodClient) QueryChatMessages(v *Vod) *ChatMessageQuery {
+	query := (&ChatMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := v.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, id),
+			sqlgraph.To(chatmessage.Table, chatmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ChatMessagesTable, vod.ChatMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(v.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *VodClient) Hooks() []Hook {
 	return c.hooks.Vod
@@ -2130,7 +3820,8 @@ func (c *VodClient) Hooks() []Hook {
 
 // Interceptors returns the client interceptors.
 func (c *VodClient) Interceptors() []Interceptor {
-	return c.inters.Vod
+	inters := c.inters.Vod
+	return append(inters[:len(inters):len(inters)], vod.Interceptors[:]...)
 }
 
 func (c *VodClient) mutate(ctx context.Context, m *VodMutation) (Value, error) {
@@ -2151,11 +3842,15 @@ func (c *VodClient) mutate(ctx context.Context, m *VodMutation) (Value, error) {
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Channel, Chapter, Live, LiveCategory, LiveTitleRegex, MutedSegment, Playback,
-		Playlist, Queue, TwitchCategory, User, Vod []ent.Hook
+		ApiToken, ArchiveProfile, AuditEvent, Channel, ChannelPermission, Chapter,
+		ChatMessage, Group, Live, LiveCategory, LiveTitleRegex, MutedSegment,
+		NotificationDelivery, NotificationSubscription, Playback, Playlist, Queue,
+		Session, TwitchCategory, User, Vod []ent.Hook
 	}
 	inters struct {
-		Channel, Chapter, Live, LiveCategory, LiveTitleRegex, MutedSegment, Playback,
-		Playlist, Queue, TwitchCategory, User, Vod []ent.Interceptor
+		ApiToken, ArchiveProfile, AuditEvent, Channel, ChannelPermission, Chapter,
+		ChatMessage, Group, Live, LiveCategory, LiveTitleRegex, MutedSegment,
+		NotificationDelivery, NotificationSubscription, Playback, Playlist, Queue,
+		Session, TwitchCategory, User, Vod []ent.Interceptor
 	}
 )
@@ -12,15 +12,24 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/zibbp/ganymede/ent/apitoken"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
+	"github.com/zibbp/ganymede/ent/auditevent"
 	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/group"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
+	"github.com/zibbp/ganymede/ent/notificationdelivery"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
 	"github.com/zibbp/ganymede/ent/playback"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/ent/session"
 	"github.com/zibbp/ganymede/ent/twitchcategory"
 	"github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/ent/vod"
@@ -84,18 +93,27 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			channel.Table:        channel.ValidColumn,
-			chapter.Table:        chapter.ValidColumn,
-			live.Table:           live.ValidColumn,
-			livecategory.Table:   livecategory.ValidColumn,
-			livetitleregex.Table: livetitleregex.ValidColumn,
-			mutedsegment.Table:   mutedsegment.ValidColumn,
-			playback.Table:       playback.ValidColumn,
-			playlist.Table:       playlist.ValidColumn,
-			queue.Table:          queue.ValidColumn,
-			twitchcategory.Table: twitchcategory.ValidColumn,
-			user.Table:           user.ValidColumn,
-			vod.Table:            vod.ValidColumn,
+			apitoken.Table:                 apitoken.ValidColumn,
+			archiveprofile.Table:           archiveprofile.ValidColumn,
+			auditevent.Table:               auditevent.ValidColumn,
+			channel.Table:                  channel.ValidColumn,
+			channelpermission.Table:        channelpermission.ValidColumn,
+			chapter.Table:                  chapter.ValidColumn,
+			chatmessage.Table:              chatmessage.ValidColumn,
+			group.Table:                    group.ValidColumn,
+			live.Table:                     live.ValidColumn,
+			livecategory.Table:             livecategory.ValidColumn,
+			livetitleregex.Table:           livetitleregex.ValidColumn,
+			mutedsegment.Table:             mutedsegment.ValidColumn,
+			notificationdelivery.Table:     notificationdelivery.ValidColumn,
+			notificationsubscription.Table: notificationsubscription.ValidColumn,
+			playback.Table:                 playback.ValidColumn,
+			playlist.Table:                 playlist.ValidColumn,
+			queue.Table:                    queue.ValidColumn,
+			session.Table:                  session.ValidColumn,
+			twitchcategory.Table:           twitchcategory.ValidColumn,
+			user.Table:                     user.ValidColumn,
+			vod.Table:                      vod.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,749 @@
+// Code generated by ent, DO NOT EDIT.
+
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/apitoken"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
+	"github.com/zibbp/ganymede/ent/auditevent"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
+	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/group"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/livecategory"
+	"github.com/zibbp/ganymede/ent/livetitleregex"
+	"github.com/zibbp/ganymede/ent/mutedsegment"
+	"github.com/zibbp/ganymede/ent/notificationdelivery"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/playback"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/ent/session"
+	"github.com/zibbp/ganymede/ent/twitchcategory"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// The Query interface represents an operation that queries a graph.
+// By using this interface, users can write generic code that manipulates
+// query builders of different types.
+type Query interface {
+	// Type returns the string representation of the query type.
+	Type() string
+	// Limit the number of records to be returned by this query.
+	Limit(int)
+	// Offset to start from.
+	Offset(int)
+	// Unique configures the query builder to filter duplicate records.
+	Unique(bool)
+	// Order specifies how the records should be ordered.
+	Order(...func(*sql.Selector))
+	// WhereP appends storage-level predicates to the query builder. Using this method, users
+	// can use type-assertion to append predicates that do not depend on any generated package.
+	WhereP(...func(*sql.Selector))
+}
+
+// The Func type is an adapter that allows ordinary functions to be used as interceptors.
+// Unlike traversal functions, interceptors are skipped during graph traversals. Note that the
+// implementation of Func is different from the one defined in entgo.io/ent.InterceptFunc.
+type Func func(context.Context, Query) error
+
+// Intercept calls f(ctx, q) and then applied the next Querier.
+func (f Func) Intercept(next ent.Querier) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		query, err := NewQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		if err := f(ctx, query); err != nil {
+			return nil, err
+		}
+		return next.Query(ctx, q)
+	})
+}
+
+// The TraverseFunc type is an adapter to allow the use of ordinary function as Traverser.
+// If f is a function with the appropriate signature, TraverseFunc(f) is a Traverser that calls f.
+type TraverseFunc func(context.Context, Query) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFunc) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
+	query, err := NewQuery(q)
+	if err != nil {
+		return err
+	}
+	return f(ctx, query)
+}
+
+// The ApiTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ApiTokenFunc func(context.Context, *ent.ApiTokenQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ApiTokenFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ApiTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ApiTokenQuery", q)
+}
+
+// The TraverseApiToken type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseApiToken func(context.Context, *ent.ApiTokenQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseApiToken) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseApiToken) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ApiTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ApiTokenQuery", q)
+}
+
+// The ArchiveProfileFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ArchiveProfileFunc func(context.Context, *ent.ArchiveProfileQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ArchiveProfileFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ArchiveProfileQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ArchiveProfileQuery", q)
+}
+
+// The TraverseArchiveProfile type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseArchiveProfile func(context.Context, *ent.ArchiveProfileQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseArchiveProfile) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseArchiveProfile) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ArchiveProfileQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ArchiveProfileQuery", q)
+}
+
+// The AuditEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditEventFunc func(context.Context, *ent.AuditEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AuditEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AuditEventQuery", q)
+}
+
+// The TraverseAuditEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditEvent func(context.Context, *ent.AuditEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AuditEventQuery", q)
+}
+
+// The ChannelFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ChannelFunc func(context.Context, *ent.ChannelQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ChannelFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ChannelQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ChannelQuery", q)
+}
+
+// The TraverseChannel type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseChannel func(context.Context, *ent.ChannelQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseChannel) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseChannel) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ChannelQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ChannelQuery", q)
+}
+
+// The ChannelPermissionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ChannelPermissionFunc func(context.Context, *ent.ChannelPermissionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ChannelPermissionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ChannelPermissionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ChannelPermissionQuery", q)
+}
+
+// The TraverseChannelPermission type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseChannelPermission func(context.Context, *ent.ChannelPermissionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseChannelPermission) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseChannelPermission) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ChannelPermissionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ChannelPermissionQuery", q)
+}
+
+// The ChapterFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ChapterFunc func(context.Context, *ent.ChapterQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ChapterFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ChapterQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ChapterQuery", q)
+}
+
+// The TraverseChapter type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseChapter func(context.Context, *ent.ChapterQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseChapter) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseChapter) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ChapterQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ChapterQuery", q)
+}
+
+// The ChatMessageFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ChatMessageFunc func(context.Context, *ent.ChatMessageQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ChatMessageFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ChatMessageQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ChatMessageQuery", q)
+}
+
+// The TraverseChatMessage type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseChatMessage func(context.Context, *ent.ChatMessageQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseChatMessage) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseChatMessage) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ChatMessageQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ChatMessageQuery", q)
+}
+
+// The GroupFunc type is an adapter to allow the use of ordinary function as a Querier.
+type GroupFunc func(context.Context, *ent.GroupQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f GroupFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.GroupQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.GroupQuery", q)
+}
+
+// The TraverseGroup type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseGroup func(context.Context, *ent.GroupQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseGroup) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseGroup) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.GroupQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.GroupQuery", q)
+}
+
+// The LiveFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LiveFunc func(context.Context, *ent.LiveQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LiveFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LiveQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LiveQuery", q)
+}
+
+// The TraverseLive type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLive func(context.Context, *ent.LiveQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLive) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLive) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LiveQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LiveQuery", q)
+}
+
+// The LiveCategoryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LiveCategoryFunc func(context.Context, *ent.LiveCategoryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LiveCategoryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LiveCategoryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LiveCategoryQuery", q)
+}
+
+// The TraverseLiveCategory type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLiveCategory func(context.Context, *ent.LiveCategoryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLiveCategory) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLiveCategory) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LiveCategoryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LiveCategoryQuery", q)
+}
+
+// The LiveTitleRegexFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LiveTitleRegexFunc func(context.Context, *ent.LiveTitleRegexQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LiveTitleRegexFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LiveTitleRegexQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LiveTitleRegexQuery", q)
+}
+
+// The TraverseLiveTitleRegex type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLiveTitleRegex func(context.Context, *ent.LiveTitleRegexQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLiveTitleRegex) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLiveTitleRegex) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LiveTitleRegexQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LiveTitleRegexQuery", q)
+}
+
+// The MutedSegmentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type MutedSegmentFunc func(context.Context, *ent.MutedSegmentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f MutedSegmentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.MutedSegmentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.MutedSegmentQuery", q)
+}
+
+// The TraverseMutedSegment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseMutedSegment func(context.Context, *ent.MutedSegmentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseMutedSegment) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseMutedSegment) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MutedSegmentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.MutedSegmentQuery", q)
+}
+
+// The NotificationDeliveryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type NotificationDeliveryFunc func(context.Context, *ent.NotificationDeliveryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f NotificationDeliveryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.NotificationDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.NotificationDeliveryQuery", q)
+}
+
+// The TraverseNotificationDelivery type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseNotificationDelivery func(context.Context, *ent.NotificationDeliveryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseNotificationDelivery) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseNotificationDelivery) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.NotificationDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.NotificationDeliveryQuery", q)
+}
+
+// The NotificationSubscriptionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type NotificationSubscriptionFunc func(context.Context, *ent.NotificationSubscriptionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f NotificationSubscriptionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.NotificationSubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.NotificationSubscriptionQuery", q)
+}
+
+// The TraverseNotificationSubscription type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseNotificationSubscription func(context.Context, *ent.NotificationSubscriptionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseNotificationSubscription) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseNotificationSubscription) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.NotificationSubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.NotificationSubscriptionQuery", q)
+}
+
+// The PlaybackFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PlaybackFunc func(context.Context, *ent.PlaybackQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PlaybackFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PlaybackQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PlaybackQuery", q)
+}
+
+// The TraversePlayback type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePlayback func(context.Context, *ent.PlaybackQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePlayback) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePlayback) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PlaybackQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PlaybackQuery", q)
+}
+
+// The PlaylistFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PlaylistFunc func(context.Context, *ent.PlaylistQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PlaylistFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PlaylistQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PlaylistQuery", q)
+}
+
+// The TraversePlaylist type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePlaylist func(context.Context, *ent.PlaylistQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePlaylist) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePlaylist) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PlaylistQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PlaylistQuery", q)
+}
+
+// The QueueFunc type is an adapter to allow the use of ordinary function as a Querier.
+type QueueFunc func(context.Context, *ent.QueueQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f QueueFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.QueueQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.QueueQuery", q)
+}
+
+// The TraverseQueue type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseQueue func(context.Context, *ent.QueueQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseQueue) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseQueue) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.QueueQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.QueueQuery", q)
+}
+
+// The SessionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SessionFunc func(context.Context, *ent.SessionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SessionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SessionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SessionQuery", q)
+}
+
+// The TraverseSession type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSession func(context.Context, *ent.SessionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSession) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSession) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SessionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SessionQuery", q)
+}
+
+// The TwitchCategoryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TwitchCategoryFunc func(context.Context, *ent.TwitchCategoryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TwitchCategoryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TwitchCategoryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TwitchCategoryQuery", q)
+}
+
+// The TraverseTwitchCategory type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTwitchCategory func(context.Context, *ent.TwitchCategoryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTwitchCategory) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTwitchCategory) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TwitchCategoryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TwitchCategoryQuery", q)
+}
+
+// The UserFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserFunc func(context.Context, *ent.UserQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UserQuery", q)
+}
+
+// The TraverseUser type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUser func(context.Context, *ent.UserQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUser) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUser) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UserQuery", q)
+}
+
+// The VodFunc type is an adapter to allow the use of ordinary function as a Querier.
+type VodFunc func(context.Context, *ent.VodQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f VodFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.VodQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.VodQuery", q)
+}
+
+// The TraverseVod type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseVod func(context.Context, *ent.VodQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseVod) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseVod) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.VodQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.VodQuery", q)
+}
+
+// NewQuery returns the generic Query interface for the given typed query.
+func NewQuery(q ent.Query) (Query, error) {
+	switch q := q.(type) {
+	case *ent.ApiTokenQuery:
+		return &query[*ent.ApiTokenQuery, predicate.ApiToken, apitoken.OrderOption]{typ: ent.TypeApiToken, tq: q}, nil
+	case *ent.ArchiveProfileQuery:
+		return &query[*ent.ArchiveProfileQuery, predicate.ArchiveProfile, archiveprofile.OrderOption]{typ: ent.TypeArchiveProfile, tq: q}, nil
+	case *ent.AuditEventQuery:
+		return &query[*ent.AuditEventQuery, predicate.AuditEvent, auditevent.OrderOption]{typ: ent.TypeAuditEvent, tq: q}, nil
+	case *ent.ChannelQuery:
+		return &query[*ent.ChannelQuery, predicate.Channel, channel.OrderOption]{typ: ent.TypeChannel, tq: q}, nil
+	case *ent.ChannelPermissionQuery:
+		return &query[*ent.ChannelPermissionQuery, predicate.ChannelPermission, channelpermission.OrderOption]{typ: ent.TypeChannelPermission, tq: q}, nil
+	case *ent.ChapterQuery:
+		return &query[*ent.ChapterQuery, predicate.Chapter, chapter.OrderOption]{typ: ent.TypeChapter, tq: q}, nil
+	case *ent.ChatMessageQuery:
+		return &query[*ent.ChatMessageQuery, predicate.ChatMessage, chatmessage.OrderOption]{typ: ent.TypeChatMessage, tq: q}, nil
+	case *ent.GroupQuery:
+		return &query[*ent.GroupQuery, predicate.Group, group.OrderOption]{typ: ent.TypeGroup, tq: q}, nil
+	case *ent.LiveQuery:
+		return &query[*ent.LiveQuery, predicate.Live, live.OrderOption]{typ: ent.TypeLive, tq: q}, nil
+	case *ent.LiveCategoryQuery:
+		return &query[*ent.LiveCategoryQuery, predicate.LiveCategory, livecategory.OrderOption]{typ: ent.TypeLiveCategory, tq: q}, nil
+	case *ent.LiveTitleRegexQuery:
+		return &query[*ent.LiveTitleRegexQuery, predicate.LiveTitleRegex, livetitleregex.OrderOption]{typ: ent.TypeLiveTitleRegex, tq: q}, nil
+	case *ent.MutedSegmentQuery:
+		return &query[*ent.MutedSegmentQuery, predicate.MutedSegment, mutedsegment.OrderOption]{typ: ent.TypeMutedSegment, tq: q}, nil
+	case *ent.NotificationDeliveryQuery:
+		return &query[*ent.NotificationDeliveryQuery, predicate.NotificationDelivery, notificationdelivery.OrderOption]{typ: ent.TypeNotificationDelivery, tq: q}, nil
+	case *ent.NotificationSubscriptionQuery:
+		return &query[*ent.NotificationSubscriptionQuery, predicate.NotificationSubscription, notificationsubscription.OrderOption]{typ: ent.TypeNotificationSubscription, tq: q}, nil
+	case *ent.PlaybackQuery:
+		return &query[*ent.PlaybackQuery, predicate.Playback, playback.OrderOption]{typ: ent.TypePlayback, tq: q}, nil
+	case *ent.PlaylistQuery:
+		return &query[*ent.PlaylistQuery, predicate.Playlist, playlist.OrderOption]{typ: ent.TypePlaylist, tq: q}, nil
+	case *ent.QueueQuery:
+		return &query[*ent.QueueQuery, predicate.Queue, queue.OrderOption]{typ: ent.TypeQueue, tq: q}, nil
+	case *ent.SessionQuery:
+		return &query[*ent.SessionQuery, predicate.Session, session.OrderOption]{typ: ent.TypeSession, tq: q}, nil
+	case *ent.TwitchCategoryQuery:
+		return &query[*ent.TwitchCategoryQuery, predicate.TwitchCategory, twitchcategory.OrderOption]{typ: ent.TypeTwitchCategory, tq: q}, nil
+	case *ent.UserQuery:
+		return &query[*ent.UserQuery, predicate.User, user.OrderOption]{typ: ent.TypeUser, tq: q}, nil
+	case *ent.VodQuery:
+		return &query[*ent.VodQuery, predicate.Vod, vod.OrderOption]{typ: ent.TypeVod, tq: q}, nil
+	default:
+		return nil, fmt.Errorf("unknown query type %T", q)
+	}
+}
+
+type query[T any, P ~func(*sql.Selector), R ~func(*sql.Selector)] struct {
+	typ string
+	tq  interface {
+		Limit(int) T
+		Offset(int) T
+		Unique(bool) T
+		Order(...R) T
+		Where(...P) T
+	}
+}
+
+func (q query[T, P, R]) Type() string {
+	return q.typ
+}
+
+func (q query[T, P, R]) Limit(limit int) {
+	q.tq.Limit(limit)
+}
+
+func (q query[T, P, R]) Offset(offset int) {
+	q.tq.Offset(offset)
+}
+
+func (q query[T, P, R]) Unique(unique bool) {
+	q.tq.Unique(unique)
+}
+
+func (q query[T, P, R]) Order(orders ...func(*sql.Selector)) {
+	rs := make([]R, len(orders))
+	for i := range orders {
+		rs[i] = orders[i]
+	}
+	q.tq.Order(rs...)
+}
+
+func (q query[T, P, R]) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]P, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	q.tq.Where(p...)
+}
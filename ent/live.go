@@ -10,8 +10,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // Live is the model entity for the Live schema.
@@ -43,28 +45,41 @@ type Live struct {
 	RenderChat bool `json:"render_chat,omitempty"`
 	// Restrict fetching videos to a certain age.
 	VideoAge int64 `json:"video_age,omitempty"`
+	// Download clips
+	DownloadClips bool `json:"download_clips,omitempty"`
+	// Minimum number of views a clip needs to be downloaded.
+	ClipsMinViews int `json:"clips_min_views,omitempty"`
+	// Only download clips created in the last X days.
+	ClipsMaxAge int64 `json:"clips_max_age,omitempty"`
+	// What to do with a live archive when the official VOD is a better copy, takes an enum.
+	VodReplacement utils.LiveVodReplacement `json:"vod_replacement,omitempty"`
+	// Archive the official VOD if the live archive is at least X seconds shorter.
+	VodReplacementMinDifference int `json:"vod_replacement_min_difference,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LiveQuery when eager-loading is set.
-	Edges        LiveEdges `json:"edges"`
-	channel_live *uuid.UUID
-	selectValues sql.SelectValues
+	Edges                LiveEdges `json:"edges"`
+	archive_profile_live *uuid.UUID
+	channel_live         *uuid.UUID
+	selectValues         sql.SelectValues
 }
 
 // LiveEdges holds the relations/edges for other nodes in the graph.
 type LiveEdges struct {
 	// Channel holds the value of the channel edge.
 	Channel *Channel `json:"channel,omitempty"`
+	// ArchiveProfile holds the value of the archive_profile edge.
+	ArchiveProfile *ArchiveProfile `json:"archive_profile,omitempty"`
 	// Categories holds the value of the categories edge.
 	Categories []*LiveCategory `json:"categories,omitempty"`
 	// TitleRegex holds the value of the title_regex edge.
 	TitleRegex []*LiveTitleRegex `json:"title_regex,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // ChannelOrErr returns the Channel value or an error if the edge
@@ -78,10 +93,21 @@ func (e LiveEdges) ChannelOrErr() (*Channel, error) {
 	return nil, &NotLoadedError{edge: "channel"}
 }
 
+// ArchiveProfileOrErr returns the ArchiveProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LiveEdges) ArchiveProfileOrErr() (*ArchiveProfile, error) {
+	if e.ArchiveProfile != nil {
+		return e.ArchiveProfile, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: archiveprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "archive_profile"}
+}
+
 // CategoriesOrErr returns the Categories value or an error if the edge
 // was not loaded in eager-loading.
 func (e LiveEdges) CategoriesOrErr() ([]*LiveCategory, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Categories, nil
 	}
 	return nil, &NotLoadedError{edge: "categories"}
@@ -90,7 +116,7 @@ func (e LiveEdges) CategoriesOrErr() ([]*LiveCategory, error) {
 // TitleRegexOrErr returns the TitleRegex value or an error if the edge
 // was not loaded in eager-loading.
 func (e LiveEdges) TitleRegexOrErr() ([]*LiveTitleRegex, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.TitleRegex, nil
 	}
 	return nil, &NotLoadedError{edge: "title_regex"}
@@ -101,17 +127,19 @@ func (*Live) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case live.FieldWatchLive, live.FieldWatchVod, live.FieldDownloadArchives, live.FieldDownloadHighlights, live.FieldDownloadUploads, live.FieldDownloadSubOnly, live.FieldIsLive, live.FieldArchiveChat, live.FieldRenderChat:
+		case live.FieldWatchLive, live.FieldWatchVod, live.FieldDownloadArchives, live.FieldDownloadHighlights, live.FieldDownloadUploads, live.FieldDownloadSubOnly, live.FieldIsLive, live.FieldArchiveChat, live.FieldRenderChat, live.FieldDownloadClips:
 			values[i] = new(sql.NullBool)
-		case live.FieldVideoAge:
+		case live.FieldVideoAge, live.FieldClipsMinViews, live.FieldClipsMaxAge, live.FieldVodReplacementMinDifference:
 			values[i] = new(sql.NullInt64)
-		case live.FieldResolution:
+		case live.FieldResolution, live.FieldVodReplacement:
 			values[i] = new(sql.NullString)
 		case live.FieldLastLive, live.FieldUpdatedAt, live.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case live.FieldID:
 			values[i] = new(uuid.UUID)
-		case live.ForeignKeys[0]: // channel_live
+		case live.ForeignKeys[0]: // archive_profile_live
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case live.ForeignKeys[1]: // channel_live
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -206,6 +234,36 @@ func (l *Live) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				l.VideoAge = value.Int64
 			}
+		case live.FieldDownloadClips:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field download_clips", values[i])
+			} else if value.Valid {
+				l.DownloadClips = value.Bool
+			}
+		case live.FieldClipsMinViews:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clips_min_views", values[i])
+			} else if value.Valid {
+				l.ClipsMinViews = int(value.Int64)
+			}
+		case live.FieldClipsMaxAge:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clips_max_age", values[i])
+			} else if value.Valid {
+				l.ClipsMaxAge = value.Int64
+			}
+		case live.FieldVodReplacement:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_replacement", values[i])
+			} else if value.Valid {
+				l.VodReplacement = utils.LiveVodReplacement(value.String)
+			}
+		case live.FieldVodReplacementMinDifference:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_replacement_min_difference", values[i])
+			} else if value.Valid {
+				l.VodReplacementMinDifference = int(value.Int64)
+			}
 		case live.FieldUpdatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
@@ -219,6 +277,13 @@ func (l *Live) assignValues(columns []string, values []any) error {
 				l.CreatedAt = value.Time
 			}
 		case live.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field archive_profile_live", values[i])
+			} else if value.Valid {
+				l.archive_profile_live = new(uuid.UUID)
+				*l.archive_profile_live = *value.S.(*uuid.UUID)
+			}
+		case live.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field channel_live", values[i])
 			} else if value.Valid {
@@ -243,6 +308,11 @@ func (l *Live) QueryChannel() *ChannelQuery {
 	return NewLiveClient(l.config).QueryChannel(l)
 }
 
+// QueryArchiveProfile queries the "archive_profile" edge of the Live entity.
+func (l *Live) QueryArchiveProfile() *ArchiveProfileQuery {
+	return NewLiveClient(l.config).QueryArchiveProfile(l)
+}
+
 // QueryCategories queries the "categories" edge of the Live entity.
 func (l *Live) QueryCategories() *LiveCategoryQuery {
 	return NewLiveClient(l.config).QueryCategories(l)
@@ -312,6 +382,21 @@ func (l *Live) String() string {
 	builder.WriteString("video_age=")
 	builder.WriteString(fmt.Sprintf("%v", l.VideoAge))
 	builder.WriteString(", ")
+	builder.WriteString("download_clips=")
+	builder.WriteString(fmt.Sprintf("%v", l.DownloadClips))
+	builder.WriteString(", ")
+	builder.WriteString("clips_min_views=")
+	builder.WriteString(fmt.Sprintf("%v", l.ClipsMinViews))
+	builder.WriteString(", ")
+	builder.WriteString("clips_max_age=")
+	builder.WriteString(fmt.Sprintf("%v", l.ClipsMaxAge))
+	builder.WriteString(", ")
+	builder.WriteString("vod_replacement=")
+	builder.WriteString(fmt.Sprintf("%v", l.VodReplacement))
+	builder.WriteString(", ")
+	builder.WriteString("vod_replacement_min_difference=")
+	builder.WriteString(fmt.Sprintf("%v", l.VodReplacementMinDifference))
+	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(l.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
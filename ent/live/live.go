@@ -3,11 +3,13 @@
 package live
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 const (
@@ -39,12 +41,24 @@ const (
 	FieldRenderChat = "render_chat"
 	// FieldVideoAge holds the string denoting the video_age field in the database.
 	FieldVideoAge = "video_age"
+	// FieldDownloadClips holds the string denoting the download_clips field in the database.
+	FieldDownloadClips = "download_clips"
+	// FieldClipsMinViews holds the string denoting the clips_min_views field in the database.
+	FieldClipsMinViews = "clips_min_views"
+	// FieldClipsMaxAge holds the string denoting the clips_max_age field in the database.
+	FieldClipsMaxAge = "clips_max_age"
+	// FieldVodReplacement holds the string denoting the vod_replacement field in the database.
+	FieldVodReplacement = "vod_replacement"
+	// FieldVodReplacementMinDifference holds the string denoting the vod_replacement_min_difference field in the database.
+	FieldVodReplacementMinDifference = "vod_replacement_min_difference"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeChannel holds the string denoting the channel edge name in mutations.
 	EdgeChannel = "channel"
+	// EdgeArchiveProfile holds the string denoting the archive_profile edge name in mutations.
+	EdgeArchiveProfile = "archive_profile"
 	// EdgeCategories holds the string denoting the categories edge name in mutations.
 	EdgeCategories = "categories"
 	// EdgeTitleRegex holds the string denoting the title_regex edge name in mutations.
@@ -58,6 +72,13 @@ const (
 	ChannelInverseTable = "channels"
 	// ChannelColumn is the table column denoting the channel relation/edge.
 	ChannelColumn = "channel_live"
+	// ArchiveProfileTable is the table that holds the archive_profile relation/edge.
+	ArchiveProfileTable = "lives"
+	// ArchiveProfileInverseTable is the table name for the ArchiveProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "archiveprofile" package.
+	ArchiveProfileInverseTable = "archive_profiles"
+	// ArchiveProfileColumn is the table column denoting the archive_profile relation/edge.
+	ArchiveProfileColumn = "archive_profile_live"
 	// CategoriesTable is the table that holds the categories relation/edge.
 	CategoriesTable = "live_categories"
 	// CategoriesInverseTable is the table name for the LiveCategory entity.
@@ -89,6 +110,11 @@ var Columns = []string{
 	FieldLastLive,
 	FieldRenderChat,
 	FieldVideoAge,
+	FieldDownloadClips,
+	FieldClipsMinViews,
+	FieldClipsMaxAge,
+	FieldVodReplacement,
+	FieldVodReplacementMinDifference,
 	FieldUpdatedAt,
 	FieldCreatedAt,
 }
@@ -96,6 +122,7 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "lives"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"archive_profile_live",
 	"channel_live",
 }
 
@@ -139,6 +166,14 @@ var (
 	DefaultRenderChat bool
 	// DefaultVideoAge holds the default value on creation for the "video_age" field.
 	DefaultVideoAge int64
+	// DefaultDownloadClips holds the default value on creation for the "download_clips" field.
+	DefaultDownloadClips bool
+	// DefaultClipsMinViews holds the default value on creation for the "clips_min_views" field.
+	DefaultClipsMinViews int
+	// DefaultClipsMaxAge holds the default value on creation for the "clips_max_age" field.
+	DefaultClipsMaxAge int64
+	// DefaultVodReplacementMinDifference holds the default value on creation for the "vod_replacement_min_difference" field.
+	DefaultVodReplacementMinDifference int
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
@@ -149,6 +184,18 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+const DefaultVodReplacement utils.LiveVodReplacement = "none"
+
+// VodReplacementValidator is a validator for the "vod_replacement" field enum values. It is called by the builders before save.
+func VodReplacementValidator(vr utils.LiveVodReplacement) error {
+	switch vr {
+	case "none", "replace", "keep_both":
+		return nil
+	default:
+		return fmt.Errorf("live: invalid enum value for vod_replacement field: %q", vr)
+	}
+}
+
 // OrderOption defines the ordering options for the Live queries.
 type OrderOption func(*sql.Selector)
 
@@ -217,6 +264,31 @@ func ByVideoAge(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVideoAge, opts...).ToFunc()
 }
 
+// ByDownloadClips orders the results by the download_clips field.
+func ByDownloadClips(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadClips, opts...).ToFunc()
+}
+
+// ByClipsMinViews orders the results by the clips_min_views field.
+func ByClipsMinViews(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClipsMinViews, opts...).ToFunc()
+}
+
+// ByClipsMaxAge orders the results by the clips_max_age field.
+func ByClipsMaxAge(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClipsMaxAge, opts...).ToFunc()
+}
+
+// ByVodReplacement orders the results by the vod_replacement field.
+func ByVodReplacement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVodReplacement, opts...).ToFunc()
+}
+
+// ByVodReplacementMinDifference orders the results by the vod_replacement_min_difference field.
+func ByVodReplacementMinDifference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVodReplacementMinDifference, opts...).ToFunc()
+}
+
 // ByUpdatedAt orders the results by the updated_at field.
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
@@ -234,6 +306,13 @@ func ByChannelField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByArchiveProfileField orders the results by archive_profile field.
+func ByArchiveProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArchiveProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByCategoriesCount orders the results by categories count.
 func ByCategoriesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -268,6 +347,13 @@ func newChannelStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ChannelTable, ChannelColumn),
 	)
 }
+func newArchiveProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArchiveProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ArchiveProfileTable, ArchiveProfileColumn),
+	)
+}
 func newCategoriesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
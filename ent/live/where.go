@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // ID filters vertices based on their ID field.
@@ -116,6 +117,26 @@ func VideoAge(v int64) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldVideoAge, v))
 }
 
+// DownloadClips applies equality check predicate on the "download_clips" field. It's identical to DownloadClipsEQ.
+func DownloadClips(v bool) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldDownloadClips, v))
+}
+
+// ClipsMinViews applies equality check predicate on the "clips_min_views" field. It's identical to ClipsMinViewsEQ.
+func ClipsMinViews(v int) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldClipsMinViews, v))
+}
+
+// ClipsMaxAge applies equality check predicate on the "clips_max_age" field. It's identical to ClipsMaxAgeEQ.
+func ClipsMaxAge(v int64) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldClipsMaxAge, v))
+}
+
+// VodReplacementMinDifference applies equality check predicate on the "vod_replacement_min_difference" field. It's identical to VodReplacementMinDifferenceEQ.
+func VodReplacementMinDifference(v int) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldVodReplacementMinDifference, v))
+}
+
 // UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
 func UpdatedAt(v time.Time) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldUpdatedAt, v))
@@ -371,6 +392,166 @@ func VideoAgeLTE(v int64) predicate.Live {
 	return predicate.Live(sql.FieldLTE(FieldVideoAge, v))
 }
 
+// DownloadClipsEQ applies the EQ predicate on the "download_clips" field.
+func DownloadClipsEQ(v bool) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldDownloadClips, v))
+}
+
+// DownloadClipsNEQ applies the NEQ predicate on the "download_clips" field.
+func DownloadClipsNEQ(v bool) predicate.Live {
+	return predicate.Live(sql.FieldNEQ(FieldDownloadClips, v))
+}
+
+// ClipsMinViewsEQ applies the EQ predicate on the "clips_min_views" field.
+func ClipsMinViewsEQ(v int) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldClipsMinViews, v))
+}
+
+// ClipsMinViewsNEQ applies the NEQ predicate on the "clips_min_views" field.
+func ClipsMinViewsNEQ(v int) predicate.Live {
+	return predicate.Live(sql.FieldNEQ(FieldClipsMinViews, v))
+}
+
+// ClipsMinViewsIn applies the In predicate on the "clips_min_views" field.
+func ClipsMinViewsIn(vs ...int) predicate.Live {
+	return predicate.Live(sql.FieldIn(FieldClipsMinViews, vs...))
+}
+
+// ClipsMinViewsNotIn applies the NotIn predicate on the "clips_min_views" field.
+func ClipsMinViewsNotIn(vs ...int) predicate.Live {
+	return predicate.Live(sql.FieldNotIn(FieldClipsMinViews, vs...))
+}
+
+// ClipsMinViewsGT applies the GT predicate on the "clips_min_views" field.
+func ClipsMinViewsGT(v int) predicate.Live {
+	return predicate.Live(sql.FieldGT(FieldClipsMinViews, v))
+}
+
+// ClipsMinViewsGTE applies the GTE predicate on the "clips_min_views" field.
+func ClipsMinViewsGTE(v int) predicate.Live {
+	return predicate.Live(sql.FieldGTE(FieldClipsMinViews, v))
+}
+
+// ClipsMinViewsLT applies the LT predicate on the "clips_min_views" field.
+func ClipsMinViewsLT(v int) predicate.Live {
+	return predicate.Live(sql.FieldLT(FieldClipsMinViews, v))
+}
+
+// ClipsMinViewsLTE applies the LTE predicate on the "clips_min_views" field.
+func ClipsMinViewsLTE(v int) predicate.Live {
+	return predicate.Live(sql.FieldLTE(FieldClipsMinViews, v))
+}
+
+// ClipsMaxAgeEQ applies the EQ predicate on the "clips_max_age" field.
+func ClipsMaxAgeEQ(v int64) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldClipsMaxAge, v))
+}
+
+// ClipsMaxAgeNEQ applies the NEQ predicate on the "clips_max_age" field.
+func ClipsMaxAgeNEQ(v int64) predicate.Live {
+	return predicate.Live(sql.FieldNEQ(FieldClipsMaxAge, v))
+}
+
+// ClipsMaxAgeIn applies the In predicate on the "clips_max_age" field.
+func ClipsMaxAgeIn(vs ...int64) predicate.Live {
+	return predicate.Live(sql.FieldIn(FieldClipsMaxAge, vs...))
+}
+
+// ClipsMaxAgeNotIn applies the NotIn predicate on the "clips_max_age" field.
+func ClipsMaxAgeNotIn(vs ...int64) predicate.Live {
+	return predicate.Live(sql.FieldNotIn(FieldClipsMaxAge, vs...))
+}
+
+// ClipsMaxAgeGT applies the GT predicate on the "clips_max_age" field.
+func ClipsMaxAgeGT(v int64) predicate.Live {
+	return predicate.Live(sql.FieldGT(FieldClipsMaxAge, v))
+}
+
+// ClipsMaxAgeGTE applies the GTE predicate on the "clips_max_age" field.
+func ClipsMaxAgeGTE(v int64) predicate.Live {
+	return predicate.Live(sql.FieldGTE(FieldClipsMaxAge, v))
+}
+
+// ClipsMaxAgeLT applies the LT predicate on the "clips_max_age" field.
+func ClipsMaxAgeLT(v int64) predicate.Live {
+	return predicate.Live(sql.FieldLT(FieldClipsMaxAge, v))
+}
+
+// ClipsMaxAgeLTE applies the LTE predicate on the "clips_max_age" field.
+func ClipsMaxAgeLTE(v int64) predicate.Live {
+	return predicate.Live(sql.FieldLTE(FieldClipsMaxAge, v))
+}
+
+// VodReplacementEQ applies the EQ predicate on the "vod_replacement" field.
+func VodReplacementEQ(v utils.LiveVodReplacement) predicate.Live {
+	vc := v
+	return predicate.Live(sql.FieldEQ(FieldVodReplacement, vc))
+}
+
+// VodReplacementNEQ applies the NEQ predicate on the "vod_replacement" field.
+func VodReplacementNEQ(v utils.LiveVodReplacement) predicate.Live {
+	vc := v
+	return predicate.Live(sql.FieldNEQ(FieldVodReplacement, vc))
+}
+
+// VodReplacementIn applies the In predicate on the "vod_replacement" field.
+func VodReplacementIn(vs ...utils.LiveVodReplacement) predicate.Live {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Live(sql.FieldIn(FieldVodReplacement, v...))
+}
+
+// VodReplacementNotIn applies the NotIn predicate on the "vod_replacement" field.
+func VodReplacementNotIn(vs ...utils.LiveVodReplacement) predicate.Live {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Live(sql.FieldNotIn(FieldVodReplacement, v...))
+}
+
+// VodReplacementMinDifferenceEQ applies the EQ predicate on the "vod_replacement_min_difference" field.
+func VodReplacementMinDifferenceEQ(v int) predicate.Live {
+	return predicate.Live(sql.FieldEQ(FieldVodReplacementMinDifference, v))
+}
+
+// VodReplacementMinDifferenceNEQ applies the NEQ predicate on the "vod_replacement_min_difference" field.
+func VodReplacementMinDifferenceNEQ(v int) predicate.Live {
+	return predicate.Live(sql.FieldNEQ(FieldVodReplacementMinDifference, v))
+}
+
+// VodReplacementMinDifferenceIn applies the In predicate on the "vod_replacement_min_difference" field.
+func VodReplacementMinDifferenceIn(vs ...int) predicate.Live {
+	return predicate.Live(sql.FieldIn(FieldVodReplacementMinDifference, vs...))
+}
+
+// VodReplacementMinDifferenceNotIn applies the NotIn predicate on the "vod_replacement_min_difference" field.
+func VodReplacementMinDifferenceNotIn(vs ...int) predicate.Live {
+	return predicate.Live(sql.FieldNotIn(FieldVodReplacementMinDifference, vs...))
+}
+
+// VodReplacementMinDifferenceGT applies the GT predicate on the "vod_replacement_min_difference" field.
+func VodReplacementMinDifferenceGT(v int) predicate.Live {
+	return predicate.Live(sql.FieldGT(FieldVodReplacementMinDifference, v))
+}
+
+// VodReplacementMinDifferenceGTE applies the GTE predicate on the "vod_replacement_min_difference" field.
+func VodReplacementMinDifferenceGTE(v int) predicate.Live {
+	return predicate.Live(sql.FieldGTE(FieldVodReplacementMinDifference, v))
+}
+
+// VodReplacementMinDifferenceLT applies the LT predicate on the "vod_replacement_min_difference" field.
+func VodReplacementMinDifferenceLT(v int) predicate.Live {
+	return predicate.Live(sql.FieldLT(FieldVodReplacementMinDifference, v))
+}
+
+// VodReplacementMinDifferenceLTE applies the LTE predicate on the "vod_replacement_min_difference" field.
+func VodReplacementMinDifferenceLTE(v int) predicate.Live {
+	return predicate.Live(sql.FieldLTE(FieldVodReplacementMinDifference, v))
+}
+
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
 func UpdatedAtEQ(v time.Time) predicate.Live {
 	return predicate.Live(sql.FieldEQ(FieldUpdatedAt, v))
@@ -474,6 +655,29 @@ func HasChannelWith(preds ...predicate.Channel) predicate.Live {
 	})
 }
 
+// HasArchiveProfile applies the HasEdge predicate on the "archive_profile" edge.
+func HasArchiveProfile() predicate.Live {
+	return predicate.Live(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ArchiveProfileTable, ArchiveProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArchiveProfileWith applies the HasEdge predicate on the "archive_profile" edge with a given conditions (other predicates).
+func HasArchiveProfileWith(preds ...predicate.ArchiveProfile) predicate.Live {
+	return predicate.Live(func(s *sql.Selector) {
+		step := newArchiveProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasCategories applies the HasEdge predicate on the "categories" edge.
 func HasCategories() predicate.Live {
 	return predicate.Live(func(s *sql.Selector) {
@@ -13,10 +13,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // LiveCreate is the builder for creating a Live entity.
@@ -195,6 +197,76 @@ func (lc *LiveCreate) SetNillableVideoAge(i *int64) *LiveCreate {
 	return lc
 }
 
+// SetDownloadClips sets the "download_clips" field.
+func (lc *LiveCreate) SetDownloadClips(b bool) *LiveCreate {
+	lc.mutation.SetDownloadClips(b)
+	return lc
+}
+
+// SetNillableDownloadClips sets the "download_clips" field if the given value is not nil.
+func (lc *LiveCreate) SetNillableDownloadClips(b *bool) *LiveCreate {
+	if b != nil {
+		lc.SetDownloadClips(*b)
+	}
+	return lc
+}
+
+// SetClipsMinViews sets the "clips_min_views" field.
+func (lc *LiveCreate) SetClipsMinViews(i int) *LiveCreate {
+	lc.mutation.SetClipsMinViews(i)
+	return lc
+}
+
+// SetNillableClipsMinViews sets the "clips_min_views" field if the given value is not nil.
+func (lc *LiveCreate) SetNillableClipsMinViews(i *int) *LiveCreate {
+	if i != nil {
+		lc.SetClipsMinViews(*i)
+	}
+	return lc
+}
+
+// SetClipsMaxAge sets the "clips_max_age" field.
+func (lc *LiveCreate) SetClipsMaxAge(i int64) *LiveCreate {
+	lc.mutation.SetClipsMaxAge(i)
+	return lc
+}
+
+// SetNillableClipsMaxAge sets the "clips_max_age" field if the given value is not nil.
+func (lc *LiveCreate) SetNillableClipsMaxAge(i *int64) *LiveCreate {
+	if i != nil {
+		lc.SetClipsMaxAge(*i)
+	}
+	return lc
+}
+
+// SetVodReplacement sets the "vod_replacement" field.
+func (lc *LiveCreate) SetVodReplacement(uvr utils.LiveVodReplacement) *LiveCreate {
+	lc.mutation.SetVodReplacement(uvr)
+	return lc
+}
+
+// SetNillableVodReplacement sets the "vod_replacement" field if the given value is not nil.
+func (lc *LiveCreate) SetNillableVodReplacement(uvr *utils.LiveVodReplacement) *LiveCreate {
+	if uvr != nil {
+		lc.SetVodReplacement(*uvr)
+	}
+	return lc
+}
+
+// SetVodReplacementMinDifference sets the "vod_replacement_min_difference" field.
+func (lc *LiveCreate) SetVodReplacementMinDifference(i int) *LiveCreate {
+	lc.mutation.SetVodReplacementMinDifference(i)
+	return lc
+}
+
+// SetNillableVodReplacementMinDifference sets the "vod_replacement_min_difference" field if the given value is not nil.
+func (lc *LiveCreate) SetNillableVodReplacementMinDifference(i *int) *LiveCreate {
+	if i != nil {
+		lc.SetVodReplacementMinDifference(*i)
+	}
+	return lc
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (lc *LiveCreate) SetUpdatedAt(t time.Time) *LiveCreate {
 	lc.mutation.SetUpdatedAt(t)
@@ -248,6 +320,25 @@ func (lc *LiveCreate) SetChannel(c *Channel) *LiveCreate {
 	return lc.SetChannelID(c.ID)
 }
 
+// SetArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID.
+func (lc *LiveCreate) SetArchiveProfileID(id uuid.UUID) *LiveCreate {
+	lc.mutation.SetArchiveProfileID(id)
+	return lc
+}
+
+// SetNillableArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID if the given value is not nil.
+func (lc *LiveCreate) SetNillableArchiveProfileID(id *uuid.UUID) *LiveCreate {
+	if id != nil {
+		lc = lc.SetArchiveProfileID(*id)
+	}
+	return lc
+}
+
+// SetArchiveProfile sets the "archive_profile" edge to the ArchiveProfile entity.
+func (lc *LiveCreate) SetArchiveProfile(a *ArchiveProfile) *LiveCreate {
+	return lc.SetArchiveProfileID(a.ID)
+}
+
 // AddCategoryIDs adds the "categories" edge to the LiveCategory entity by IDs.
 func (lc *LiveCreate) AddCategoryIDs(ids ...uuid.UUID) *LiveCreate {
 	lc.mutation.AddCategoryIDs(ids...)
@@ -361,6 +452,26 @@ func (lc *LiveCreate) defaults() {
 		v := live.DefaultVideoAge
 		lc.mutation.SetVideoAge(v)
 	}
+	if _, ok := lc.mutation.DownloadClips(); !ok {
+		v := live.DefaultDownloadClips
+		lc.mutation.SetDownloadClips(v)
+	}
+	if _, ok := lc.mutation.ClipsMinViews(); !ok {
+		v := live.DefaultClipsMinViews
+		lc.mutation.SetClipsMinViews(v)
+	}
+	if _, ok := lc.mutation.ClipsMaxAge(); !ok {
+		v := live.DefaultClipsMaxAge
+		lc.mutation.SetClipsMaxAge(v)
+	}
+	if _, ok := lc.mutation.VodReplacement(); !ok {
+		v := live.DefaultVodReplacement
+		lc.mutation.SetVodReplacement(v)
+	}
+	if _, ok := lc.mutation.VodReplacementMinDifference(); !ok {
+		v := live.DefaultVodReplacementMinDifference
+		lc.mutation.SetVodReplacementMinDifference(v)
+	}
 	if _, ok := lc.mutation.UpdatedAt(); !ok {
 		v := live.DefaultUpdatedAt()
 		lc.mutation.SetUpdatedAt(v)
@@ -410,6 +521,26 @@ func (lc *LiveCreate) check() error {
 	if _, ok := lc.mutation.VideoAge(); !ok {
 		return &ValidationError{Name: "video_age", err: errors.New(`ent: missing required field "Live.video_age"`)}
 	}
+	if _, ok := lc.mutation.DownloadClips(); !ok {
+		return &ValidationError{Name: "download_clips", err: errors.New(`ent: missing required field "Live.download_clips"`)}
+	}
+	if _, ok := lc.mutation.ClipsMinViews(); !ok {
+		return &ValidationError{Name: "clips_min_views", err: errors.New(`ent: missing required field "Live.clips_min_views"`)}
+	}
+	if _, ok := lc.mutation.ClipsMaxAge(); !ok {
+		return &ValidationError{Name: "clips_max_age", err: errors.New(`ent: missing required field "Live.clips_max_age"`)}
+	}
+	if _, ok := lc.mutation.VodReplacement(); !ok {
+		return &ValidationError{Name: "vod_replacement", err: errors.New(`ent: missing required field "Live.vod_replacement"`)}
+	}
+	if v, ok := lc.mutation.VodReplacement(); ok {
+		if err := live.VodReplacementValidator(v); err != nil {
+			return &ValidationError{Name: "vod_replacement", err: fmt.Errorf(`ent: validator failed for field "Live.vod_replacement": %w`, err)}
+		}
+	}
+	if _, ok := lc.mutation.VodReplacementMinDifference(); !ok {
+		return &ValidationError{Name: "vod_replacement_min_difference", err: errors.New(`ent: missing required field "Live.vod_replacement_min_difference"`)}
+	}
 	if _, ok := lc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Live.updated_at"`)}
 	}
@@ -503,6 +634,26 @@ func (lc *LiveCreate) createSpec() (*Live, *sqlgraph.CreateSpec) {
 		_spec.SetField(live.FieldVideoAge, field.TypeInt64, value)
 		_node.VideoAge = value
 	}
+	if value, ok := lc.mutation.DownloadClips(); ok {
+		_spec.SetField(live.FieldDownloadClips, field.TypeBool, value)
+		_node.DownloadClips = value
+	}
+	if value, ok := lc.mutation.ClipsMinViews(); ok {
+		_spec.SetField(live.FieldClipsMinViews, field.TypeInt, value)
+		_node.ClipsMinViews = value
+	}
+	if value, ok := lc.mutation.ClipsMaxAge(); ok {
+		_spec.SetField(live.FieldClipsMaxAge, field.TypeInt64, value)
+		_node.ClipsMaxAge = value
+	}
+	if value, ok := lc.mutation.VodReplacement(); ok {
+		_spec.SetField(live.FieldVodReplacement, field.TypeEnum, value)
+		_node.VodReplacement = value
+	}
+	if value, ok := lc.mutation.VodReplacementMinDifference(); ok {
+		_spec.SetField(live.FieldVodReplacementMinDifference, field.TypeInt, value)
+		_node.VodReplacementMinDifference = value
+	}
 	if value, ok := lc.mutation.UpdatedAt(); ok {
 		_spec.SetField(live.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -528,6 +679,23 @@ func (lc *LiveCreate) createSpec() (*Live, *sqlgraph.CreateSpec) {
 		_node.channel_live = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := lc.mutation.ArchiveProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   live.ArchiveProfileTable,
+			Columns: []string{live.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.archive_profile_live = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := lc.mutation.CategoriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -768,6 +936,84 @@ func (u *LiveUpsert) AddVideoAge(v int64) *LiveUpsert {
 	return u
 }
 
+// SetDownloadClips sets the "download_clips" field.
+func (u *LiveUpsert) SetDownloadClips(v bool) *LiveUpsert {
+	u.Set(live.FieldDownloadClips, v)
+	return u
+}
+
+// UpdateDownloadClips sets the "download_clips" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateDownloadClips() *LiveUpsert {
+	u.SetExcluded(live.FieldDownloadClips)
+	return u
+}
+
+// SetClipsMinViews sets the "clips_min_views" field.
+func (u *LiveUpsert) SetClipsMinViews(v int) *LiveUpsert {
+	u.Set(live.FieldClipsMinViews, v)
+	return u
+}
+
+// UpdateClipsMinViews sets the "clips_min_views" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateClipsMinViews() *LiveUpsert {
+	u.SetExcluded(live.FieldClipsMinViews)
+	return u
+}
+
+// AddClipsMinViews adds v to the "clips_min_views" field.
+func (u *LiveUpsert) AddClipsMinViews(v int) *LiveUpsert {
+	u.Add(live.FieldClipsMinViews, v)
+	return u
+}
+
+// SetClipsMaxAge sets the "clips_max_age" field.
+func (u *LiveUpsert) SetClipsMaxAge(v int64) *LiveUpsert {
+	u.Set(live.FieldClipsMaxAge, v)
+	return u
+}
+
+// UpdateClipsMaxAge sets the "clips_max_age" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateClipsMaxAge() *LiveUpsert {
+	u.SetExcluded(live.FieldClipsMaxAge)
+	return u
+}
+
+// AddClipsMaxAge adds v to the "clips_max_age" field.
+func (u *LiveUpsert) AddClipsMaxAge(v int64) *LiveUpsert {
+	u.Add(live.FieldClipsMaxAge, v)
+	return u
+}
+
+// SetVodReplacement sets the "vod_replacement" field.
+func (u *LiveUpsert) SetVodReplacement(v utils.LiveVodReplacement) *LiveUpsert {
+	u.Set(live.FieldVodReplacement, v)
+	return u
+}
+
+// UpdateVodReplacement sets the "vod_replacement" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateVodReplacement() *LiveUpsert {
+	u.SetExcluded(live.FieldVodReplacement)
+	return u
+}
+
+// SetVodReplacementMinDifference sets the "vod_replacement_min_difference" field.
+func (u *LiveUpsert) SetVodReplacementMinDifference(v int) *LiveUpsert {
+	u.Set(live.FieldVodReplacementMinDifference, v)
+	return u
+}
+
+// UpdateVodReplacementMinDifference sets the "vod_replacement_min_difference" field to the value that was provided on create.
+func (u *LiveUpsert) UpdateVodReplacementMinDifference() *LiveUpsert {
+	u.SetExcluded(live.FieldVodReplacementMinDifference)
+	return u
+}
+
+// AddVodReplacementMinDifference adds v to the "vod_replacement_min_difference" field.
+func (u *LiveUpsert) AddVodReplacementMinDifference(v int) *LiveUpsert {
+	u.Add(live.FieldVodReplacementMinDifference, v)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *LiveUpsert) SetUpdatedAt(v time.Time) *LiveUpsert {
 	u.Set(live.FieldUpdatedAt, v)
@@ -1013,6 +1259,97 @@ func (u *LiveUpsertOne) UpdateVideoAge() *LiveUpsertOne {
 	})
 }
 
+// SetDownloadClips sets the "download_clips" field.
+func (u *LiveUpsertOne) SetDownloadClips(v bool) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetDownloadClips(v)
+	})
+}
+
+// UpdateDownloadClips sets the "download_clips" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateDownloadClips() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateDownloadClips()
+	})
+}
+
+// SetClipsMinViews sets the "clips_min_views" field.
+func (u *LiveUpsertOne) SetClipsMinViews(v int) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetClipsMinViews(v)
+	})
+}
+
+// AddClipsMinViews adds v to the "clips_min_views" field.
+func (u *LiveUpsertOne) AddClipsMinViews(v int) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.AddClipsMinViews(v)
+	})
+}
+
+// UpdateClipsMinViews sets the "clips_min_views" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateClipsMinViews() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateClipsMinViews()
+	})
+}
+
+// SetClipsMaxAge sets the "clips_max_age" field.
+func (u *LiveUpsertOne) SetClipsMaxAge(v int64) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetClipsMaxAge(v)
+	})
+}
+
+// AddClipsMaxAge adds v to the "clips_max_age" field.
+func (u *LiveUpsertOne) AddClipsMaxAge(v int64) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.AddClipsMaxAge(v)
+	})
+}
+
+// UpdateClipsMaxAge sets the "clips_max_age" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateClipsMaxAge() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateClipsMaxAge()
+	})
+}
+
+// SetVodReplacement sets the "vod_replacement" field.
+func (u *LiveUpsertOne) SetVodReplacement(v utils.LiveVodReplacement) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetVodReplacement(v)
+	})
+}
+
+// UpdateVodReplacement sets the "vod_replacement" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateVodReplacement() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateVodReplacement()
+	})
+}
+
+// SetVodReplacementMinDifference sets the "vod_replacement_min_difference" field.
+func (u *LiveUpsertOne) SetVodReplacementMinDifference(v int) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetVodReplacementMinDifference(v)
+	})
+}
+
+// AddVodReplacementMinDifference adds v to the "vod_replacement_min_difference" field.
+func (u *LiveUpsertOne) AddVodReplacementMinDifference(v int) *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.AddVodReplacementMinDifference(v)
+	})
+}
+
+// UpdateVodReplacementMinDifference sets the "vod_replacement_min_difference" field to the value that was provided on create.
+func (u *LiveUpsertOne) UpdateVodReplacementMinDifference() *LiveUpsertOne {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateVodReplacementMinDifference()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *LiveUpsertOne) SetUpdatedAt(v time.Time) *LiveUpsertOne {
 	return u.Update(func(s *LiveUpsert) {
@@ -1427,6 +1764,97 @@ func (u *LiveUpsertBulk) UpdateVideoAge() *LiveUpsertBulk {
 	})
 }
 
+// SetDownloadClips sets the "download_clips" field.
+func (u *LiveUpsertBulk) SetDownloadClips(v bool) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetDownloadClips(v)
+	})
+}
+
+// UpdateDownloadClips sets the "download_clips" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateDownloadClips() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateDownloadClips()
+	})
+}
+
+// SetClipsMinViews sets the "clips_min_views" field.
+func (u *LiveUpsertBulk) SetClipsMinViews(v int) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetClipsMinViews(v)
+	})
+}
+
+// AddClipsMinViews adds v to the "clips_min_views" field.
+func (u *LiveUpsertBulk) AddClipsMinViews(v int) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.AddClipsMinViews(v)
+	})
+}
+
+// UpdateClipsMinViews sets the "clips_min_views" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateClipsMinViews() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateClipsMinViews()
+	})
+}
+
+// SetClipsMaxAge sets the "clips_max_age" field.
+func (u *LiveUpsertBulk) SetClipsMaxAge(v int64) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetClipsMaxAge(v)
+	})
+}
+
+// AddClipsMaxAge adds v to the "clips_max_age" field.
+func (u *LiveUpsertBulk) AddClipsMaxAge(v int64) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.AddClipsMaxAge(v)
+	})
+}
+
+// UpdateClipsMaxAge sets the "clips_max_age" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateClipsMaxAge() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateClipsMaxAge()
+	})
+}
+
+// SetVodReplacement sets the "vod_replacement" field.
+func (u *LiveUpsertBulk) SetVodReplacement(v utils.LiveVodReplacement) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetVodReplacement(v)
+	})
+}
+
+// UpdateVodReplacement sets the "vod_replacement" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateVodReplacement() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateVodReplacement()
+	})
+}
+
+// SetVodReplacementMinDifference sets the "vod_replacement_min_difference" field.
+func (u *LiveUpsertBulk) SetVodReplacementMinDifference(v int) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.SetVodReplacementMinDifference(v)
+	})
+}
+
+// AddVodReplacementMinDifference adds v to the "vod_replacement_min_difference" field.
+func (u *LiveUpsertBulk) AddVodReplacementMinDifference(v int) *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.AddVodReplacementMinDifference(v)
+	})
+}
+
+// UpdateVodReplacementMinDifference sets the "vod_replacement_min_difference" field to the value that was provided on create.
+func (u *LiveUpsertBulk) UpdateVodReplacementMinDifference() *LiveUpsertBulk {
+	return u.Update(func(s *LiveUpsert) {
+		s.UpdateVodReplacementMinDifference()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *LiveUpsertBulk) SetUpdatedAt(v time.Time) *LiveUpsertBulk {
 	return u.Update(func(s *LiveUpsert) {
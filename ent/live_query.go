@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
@@ -22,14 +23,15 @@ import (
 // LiveQuery is the builder for querying Live entities.
 type LiveQuery struct {
 	config
-	ctx            *QueryContext
-	order          []live.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Live
-	withChannel    *ChannelQuery
-	withCategories *LiveCategoryQuery
-	withTitleRegex *LiveTitleRegexQuery
-	withFKs        bool
+	ctx                *QueryContext
+	order              []live.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Live
+	withChannel        *ChannelQuery
+	withArchiveProfile *ArchiveProfileQuery
+	withCategories     *LiveCategoryQuery
+	withTitleRegex     *LiveTitleRegexQuery
+	withFKs            bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -88,6 +90,28 @@ func (lq *LiveQuery) QueryChannel() *ChannelQuery {
 	return query
 }
 
+// QueryArchiveProfile chains the current query on the "archive_profile" edge.
+func (lq *LiveQuery) QueryArchiveProfile() *ArchiveProfileQuery {
+	query := (&ArchiveProfileClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(live.Table, live.FieldID, selector),
+			sqlgraph.To(archiveprofile.Table, archiveprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, live.ArchiveProfileTable, live.ArchiveProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryCategories chains the current query on the "categories" edge.
 func (lq *LiveQuery) QueryCategories() *LiveCategoryQuery {
 	query := (&LiveCategoryClient{config: lq.config}).Query()
@@ -319,14 +343,15 @@ func (lq *LiveQuery) Clone() *LiveQuery {
 		return nil
 	}
 	return &LiveQuery{
-		config:         lq.config,
-		ctx:            lq.ctx.Clone(),
-		order:          append([]live.OrderOption{}, lq.order...),
-		inters:         append([]Interceptor{}, lq.inters...),
-		predicates:     append([]predicate.Live{}, lq.predicates...),
-		withChannel:    lq.withChannel.Clone(),
-		withCategories: lq.withCategories.Clone(),
-		withTitleRegex: lq.withTitleRegex.Clone(),
+		config:             lq.config,
+		ctx:                lq.ctx.Clone(),
+		order:              append([]live.OrderOption{}, lq.order...),
+		inters:             append([]Interceptor{}, lq.inters...),
+		predicates:         append([]predicate.Live{}, lq.predicates...),
+		withChannel:        lq.withChannel.Clone(),
+		withArchiveProfile: lq.withArchiveProfile.Clone(),
+		withCategories:     lq.withCategories.Clone(),
+		withTitleRegex:     lq.withTitleRegex.Clone(),
 		// clone intermediate query.
 		sql:  lq.sql.Clone(),
 		path: lq.path,
@@ -344,6 +369,17 @@ func (lq *LiveQuery) WithChannel(opts ...func(*ChannelQuery)) *LiveQuery {
 	return lq
 }
 
+// WithArchiveProfile tells the query-builder to eager-load the nodes that are connected to
+// the "archive_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LiveQuery) WithArchiveProfile(opts ...func(*ArchiveProfileQuery)) *LiveQuery {
+	query := (&ArchiveProfileClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withArchiveProfile = query
+	return lq
+}
+
 // WithCategories tells the query-builder to eager-load the nodes that are connected to
 // the "categories" edge. The optional arguments are used to configure the query builder of the edge.
 func (lq *LiveQuery) WithCategories(opts ...func(*LiveCategoryQuery)) *LiveQuery {
@@ -445,13 +481,14 @@ func (lq *LiveQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Live, e
 		nodes       = []*Live{}
 		withFKs     = lq.withFKs
 		_spec       = lq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			lq.withChannel != nil,
+			lq.withArchiveProfile != nil,
 			lq.withCategories != nil,
 			lq.withTitleRegex != nil,
 		}
 	)
-	if lq.withChannel != nil {
+	if lq.withChannel != nil || lq.withArchiveProfile != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -481,6 +518,12 @@ func (lq *LiveQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Live, e
 			return nil, err
 		}
 	}
+	if query := lq.withArchiveProfile; query != nil {
+		if err := lq.loadArchiveProfile(ctx, query, nodes, nil,
+			func(n *Live, e *ArchiveProfile) { n.Edges.ArchiveProfile = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := lq.withCategories; query != nil {
 		if err := lq.loadCategories(ctx, query, nodes,
 			func(n *Live) { n.Edges.Categories = []*LiveCategory{} },
@@ -530,6 +573,38 @@ func (lq *LiveQuery) loadChannel(ctx context.Context, query *ChannelQuery, nodes
 	}
 	return nil
 }
+func (lq *LiveQuery) loadArchiveProfile(ctx context.Context, query *ArchiveProfileQuery, nodes []*Live, init func(*Live), assign func(*Live, *ArchiveProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Live)
+	for i := range nodes {
+		if nodes[i].archive_profile_live == nil {
+			continue
+		}
+		fk := *nodes[i].archive_profile_live
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(archiveprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "archive_profile_live" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (lq *LiveQuery) loadCategories(ctx context.Context, query *LiveCategoryQuery, nodes []*Live, init func(*Live), assign func(*Live, *LiveCategory)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Live)
@@ -12,11 +12,13 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livecategory"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
 	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // LiveUpdate is the builder for updating Live entities.
@@ -213,6 +215,97 @@ func (lu *LiveUpdate) AddVideoAge(i int64) *LiveUpdate {
 	return lu
 }
 
+// SetDownloadClips sets the "download_clips" field.
+func (lu *LiveUpdate) SetDownloadClips(b bool) *LiveUpdate {
+	lu.mutation.SetDownloadClips(b)
+	return lu
+}
+
+// SetNillableDownloadClips sets the "download_clips" field if the given value is not nil.
+func (lu *LiveUpdate) SetNillableDownloadClips(b *bool) *LiveUpdate {
+	if b != nil {
+		lu.SetDownloadClips(*b)
+	}
+	return lu
+}
+
+// SetClipsMinViews sets the "clips_min_views" field.
+func (lu *LiveUpdate) SetClipsMinViews(i int) *LiveUpdate {
+	lu.mutation.ResetClipsMinViews()
+	lu.mutation.SetClipsMinViews(i)
+	return lu
+}
+
+// SetNillableClipsMinViews sets the "clips_min_views" field if the given value is not nil.
+func (lu *LiveUpdate) SetNillableClipsMinViews(i *int) *LiveUpdate {
+	if i != nil {
+		lu.SetClipsMinViews(*i)
+	}
+	return lu
+}
+
+// AddClipsMinViews adds i to the "clips_min_views" field.
+func (lu *LiveUpdate) AddClipsMinViews(i int) *LiveUpdate {
+	lu.mutation.AddClipsMinViews(i)
+	return lu
+}
+
+// SetClipsMaxAge sets the "clips_max_age" field.
+func (lu *LiveUpdate) SetClipsMaxAge(i int64) *LiveUpdate {
+	lu.mutation.ResetClipsMaxAge()
+	lu.mutation.SetClipsMaxAge(i)
+	return lu
+}
+
+// SetNillableClipsMaxAge sets the "clips_max_age" field if the given value is not nil.
+func (lu *LiveUpdate) SetNillableClipsMaxAge(i *int64) *LiveUpdate {
+	if i != nil {
+		lu.SetClipsMaxAge(*i)
+	}
+	return lu
+}
+
+// AddClipsMaxAge adds i to the "clips_max_age" field.
+func (lu *LiveUpdate) AddClipsMaxAge(i int64) *LiveUpdate {
+	lu.mutation.AddClipsMaxAge(i)
+	return lu
+}
+
+// SetVodReplacement sets the "vod_replacement" field.
+func (lu *LiveUpdate) SetVodReplacement(uvr utils.LiveVodReplacement) *LiveUpdate {
+	lu.mutation.SetVodReplacement(uvr)
+	return lu
+}
+
+// SetNillableVodReplacement sets the "vod_replacement" field if the given value is not nil.
+func (lu *LiveUpdate) SetNillableVodReplacement(uvr *utils.LiveVodReplacement) *LiveUpdate {
+	if uvr != nil {
+		lu.SetVodReplacement(*uvr)
+	}
+	return lu
+}
+
+// SetVodReplacementMinDifference sets the "vod_replacement_min_difference" field.
+func (lu *LiveUpdate) SetVodReplacementMinDifference(i int) *LiveUpdate {
+	lu.mutation.ResetVodReplacementMinDifference()
+	lu.mutation.SetVodReplacementMinDifference(i)
+	return lu
+}
+
+// SetNillableVodReplacementMinDifference sets the "vod_replacement_min_difference" field if the given value is not nil.
+func (lu *LiveUpdate) SetNillableVodReplacementMinDifference(i *int) *LiveUpdate {
+	if i != nil {
+		lu.SetVodReplacementMinDifference(*i)
+	}
+	return lu
+}
+
+// AddVodReplacementMinDifference adds i to the "vod_replacement_min_difference" field.
+func (lu *LiveUpdate) AddVodReplacementMinDifference(i int) *LiveUpdate {
+	lu.mutation.AddVodReplacementMinDifference(i)
+	return lu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (lu *LiveUpdate) SetUpdatedAt(t time.Time) *LiveUpdate {
 	lu.mutation.SetUpdatedAt(t)
@@ -230,6 +323,25 @@ func (lu *LiveUpdate) SetChannel(c *Channel) *LiveUpdate {
 	return lu.SetChannelID(c.ID)
 }
 
+// SetArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID.
+func (lu *LiveUpdate) SetArchiveProfileID(id uuid.UUID) *LiveUpdate {
+	lu.mutation.SetArchiveProfileID(id)
+	return lu
+}
+
+// SetNillableArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID if the given value is not nil.
+func (lu *LiveUpdate) SetNillableArchiveProfileID(id *uuid.UUID) *LiveUpdate {
+	if id != nil {
+		lu = lu.SetArchiveProfileID(*id)
+	}
+	return lu
+}
+
+// SetArchiveProfile sets the "archive_profile" edge to the ArchiveProfile entity.
+func (lu *LiveUpdate) SetArchiveProfile(a *ArchiveProfile) *LiveUpdate {
+	return lu.SetArchiveProfileID(a.ID)
+}
+
 // AddCategoryIDs adds the "categories" edge to the LiveCategory entity by IDs.
 func (lu *LiveUpdate) AddCategoryIDs(ids ...uuid.UUID) *LiveUpdate {
 	lu.mutation.AddCategoryIDs(ids...)
@@ -271,6 +383,12 @@ func (lu *LiveUpdate) ClearChannel() *LiveUpdate {
 	return lu
 }
 
+// ClearArchiveProfile clears the "archive_profile" edge to the ArchiveProfile entity.
+func (lu *LiveUpdate) ClearArchiveProfile() *LiveUpdate {
+	lu.mutation.ClearArchiveProfile()
+	return lu
+}
+
 // ClearCategories clears all "categories" edges to the LiveCategory entity.
 func (lu *LiveUpdate) ClearCategories() *LiveUpdate {
 	lu.mutation.ClearCategories()
@@ -351,6 +469,11 @@ func (lu *LiveUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (lu *LiveUpdate) check() error {
+	if v, ok := lu.mutation.VodReplacement(); ok {
+		if err := live.VodReplacementValidator(v); err != nil {
+			return &ValidationError{Name: "vod_replacement", err: fmt.Errorf(`ent: validator failed for field "Live.vod_replacement": %w`, err)}
+		}
+	}
 	if _, ok := lu.mutation.ChannelID(); lu.mutation.ChannelCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Live.channel"`)
 	}
@@ -411,6 +534,30 @@ func (lu *LiveUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := lu.mutation.AddedVideoAge(); ok {
 		_spec.AddField(live.FieldVideoAge, field.TypeInt64, value)
 	}
+	if value, ok := lu.mutation.DownloadClips(); ok {
+		_spec.SetField(live.FieldDownloadClips, field.TypeBool, value)
+	}
+	if value, ok := lu.mutation.ClipsMinViews(); ok {
+		_spec.SetField(live.FieldClipsMinViews, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedClipsMinViews(); ok {
+		_spec.AddField(live.FieldClipsMinViews, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.ClipsMaxAge(); ok {
+		_spec.SetField(live.FieldClipsMaxAge, field.TypeInt64, value)
+	}
+	if value, ok := lu.mutation.AddedClipsMaxAge(); ok {
+		_spec.AddField(live.FieldClipsMaxAge, field.TypeInt64, value)
+	}
+	if value, ok := lu.mutation.VodReplacement(); ok {
+		_spec.SetField(live.FieldVodReplacement, field.TypeEnum, value)
+	}
+	if value, ok := lu.mutation.VodReplacementMinDifference(); ok {
+		_spec.SetField(live.FieldVodReplacementMinDifference, field.TypeInt, value)
+	}
+	if value, ok := lu.mutation.AddedVodReplacementMinDifference(); ok {
+		_spec.AddField(live.FieldVodReplacementMinDifference, field.TypeInt, value)
+	}
 	if value, ok := lu.mutation.UpdatedAt(); ok {
 		_spec.SetField(live.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -443,6 +590,35 @@ func (lu *LiveUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if lu.mutation.ArchiveProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   live.ArchiveProfileTable,
+			Columns: []string{live.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.ArchiveProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   live.ArchiveProfileTable,
+			Columns: []string{live.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if lu.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -734,6 +910,97 @@ func (luo *LiveUpdateOne) AddVideoAge(i int64) *LiveUpdateOne {
 	return luo
 }
 
+// SetDownloadClips sets the "download_clips" field.
+func (luo *LiveUpdateOne) SetDownloadClips(b bool) *LiveUpdateOne {
+	luo.mutation.SetDownloadClips(b)
+	return luo
+}
+
+// SetNillableDownloadClips sets the "download_clips" field if the given value is not nil.
+func (luo *LiveUpdateOne) SetNillableDownloadClips(b *bool) *LiveUpdateOne {
+	if b != nil {
+		luo.SetDownloadClips(*b)
+	}
+	return luo
+}
+
+// SetClipsMinViews sets the "clips_min_views" field.
+func (luo *LiveUpdateOne) SetClipsMinViews(i int) *LiveUpdateOne {
+	luo.mutation.ResetClipsMinViews()
+	luo.mutation.SetClipsMinViews(i)
+	return luo
+}
+
+// SetNillableClipsMinViews sets the "clips_min_views" field if the given value is not nil.
+func (luo *LiveUpdateOne) SetNillableClipsMinViews(i *int) *LiveUpdateOne {
+	if i != nil {
+		luo.SetClipsMinViews(*i)
+	}
+	return luo
+}
+
+// AddClipsMinViews adds i to the "clips_min_views" field.
+func (luo *LiveUpdateOne) AddClipsMinViews(i int) *LiveUpdateOne {
+	luo.mutation.AddClipsMinViews(i)
+	return luo
+}
+
+// SetClipsMaxAge sets the "clips_max_age" field.
+func (luo *LiveUpdateOne) SetClipsMaxAge(i int64) *LiveUpdateOne {
+	luo.mutation.ResetClipsMaxAge()
+	luo.mutation.SetClipsMaxAge(i)
+	return luo
+}
+
+// SetNillableClipsMaxAge sets the "clips_max_age" field if the given value is not nil.
+func (luo *LiveUpdateOne) SetNillableClipsMaxAge(i *int64) *LiveUpdateOne {
+	if i != nil {
+		luo.SetClipsMaxAge(*i)
+	}
+	return luo
+}
+
+// AddClipsMaxAge adds i to the "clips_max_age" field.
+func (luo *LiveUpdateOne) AddClipsMaxAge(i int64) *LiveUpdateOne {
+	luo.mutation.AddClipsMaxAge(i)
+	return luo
+}
+
+// SetVodReplacement sets the "vod_replacement" field.
+func (luo *LiveUpdateOne) SetVodReplacement(uvr utils.LiveVodReplacement) *LiveUpdateOne {
+	luo.mutation.SetVodReplacement(uvr)
+	return luo
+}
+
+// SetNillableVodReplacement sets the "vod_replacement" field if the given value is not nil.
+func (luo *LiveUpdateOne) SetNillableVodReplacement(uvr *utils.LiveVodReplacement) *LiveUpdateOne {
+	if uvr != nil {
+		luo.SetVodReplacement(*uvr)
+	}
+	return luo
+}
+
+// SetVodReplacementMinDifference sets the "vod_replacement_min_difference" field.
+func (luo *LiveUpdateOne) SetVodReplacementMinDifference(i int) *LiveUpdateOne {
+	luo.mutation.ResetVodReplacementMinDifference()
+	luo.mutation.SetVodReplacementMinDifference(i)
+	return luo
+}
+
+// SetNillableVodReplacementMinDifference sets the "vod_replacement_min_difference" field if the given value is not nil.
+func (luo *LiveUpdateOne) SetNillableVodReplacementMinDifference(i *int) *LiveUpdateOne {
+	if i != nil {
+		luo.SetVodReplacementMinDifference(*i)
+	}
+	return luo
+}
+
+// AddVodReplacementMinDifference adds i to the "vod_replacement_min_difference" field.
+func (luo *LiveUpdateOne) AddVodReplacementMinDifference(i int) *LiveUpdateOne {
+	luo.mutation.AddVodReplacementMinDifference(i)
+	return luo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (luo *LiveUpdateOne) SetUpdatedAt(t time.Time) *LiveUpdateOne {
 	luo.mutation.SetUpdatedAt(t)
@@ -751,6 +1018,25 @@ func (luo *LiveUpdateOne) SetChannel(c *Channel) *LiveUpdateOne {
 	return luo.SetChannelID(c.ID)
 }
 
+// SetArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID.
+func (luo *LiveUpdateOne) SetArchiveProfileID(id uuid.UUID) *LiveUpdateOne {
+	luo.mutation.SetArchiveProfileID(id)
+	return luo
+}
+
+// SetNillableArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID if the given value is not nil.
+func (luo *LiveUpdateOne) SetNillableArchiveProfileID(id *uuid.UUID) *LiveUpdateOne {
+	if id != nil {
+		luo = luo.SetArchiveProfileID(*id)
+	}
+	return luo
+}
+
+// SetArchiveProfile sets the "archive_profile" edge to the ArchiveProfile entity.
+func (luo *LiveUpdateOne) SetArchiveProfile(a *ArchiveProfile) *LiveUpdateOne {
+	return luo.SetArchiveProfileID(a.ID)
+}
+
 // AddCategoryIDs adds the "categories" edge to the LiveCategory entity by IDs.
 func (luo *LiveUpdateOne) AddCategoryIDs(ids ...uuid.UUID) *LiveUpdateOne {
 	luo.mutation.AddCategoryIDs(ids...)
@@ -792,6 +1078,12 @@ func (luo *LiveUpdateOne) ClearChannel() *LiveUpdateOne {
 	return luo
 }
 
+// ClearArchiveProfile clears the "archive_profile" edge to the ArchiveProfile entity.
+func (luo *LiveUpdateOne) ClearArchiveProfile() *LiveUpdateOne {
+	luo.mutation.ClearArchiveProfile()
+	return luo
+}
+
 // ClearCategories clears all "categories" edges to the LiveCategory entity.
 func (luo *LiveUpdateOne) ClearCategories() *LiveUpdateOne {
 	luo.mutation.ClearCategories()
@@ -885,6 +1177,11 @@ func (luo *LiveUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (luo *LiveUpdateOne) check() error {
+	if v, ok := luo.mutation.VodReplacement(); ok {
+		if err := live.VodReplacementValidator(v); err != nil {
+			return &ValidationError{Name: "vod_replacement", err: fmt.Errorf(`ent: validator failed for field "Live.vod_replacement": %w`, err)}
+		}
+	}
 	if _, ok := luo.mutation.ChannelID(); luo.mutation.ChannelCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Live.channel"`)
 	}
@@ -962,6 +1259,30 @@ func (luo *LiveUpdateOne) sqlSave(ctx context.Context) (_node *Live, err error)
 	if value, ok := luo.mutation.AddedVideoAge(); ok {
 		_spec.AddField(live.FieldVideoAge, field.TypeInt64, value)
 	}
+	if value, ok := luo.mutation.DownloadClips(); ok {
+		_spec.SetField(live.FieldDownloadClips, field.TypeBool, value)
+	}
+	if value, ok := luo.mutation.ClipsMinViews(); ok {
+		_spec.SetField(live.FieldClipsMinViews, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedClipsMinViews(); ok {
+		_spec.AddField(live.FieldClipsMinViews, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.ClipsMaxAge(); ok {
+		_spec.SetField(live.FieldClipsMaxAge, field.TypeInt64, value)
+	}
+	if value, ok := luo.mutation.AddedClipsMaxAge(); ok {
+		_spec.AddField(live.FieldClipsMaxAge, field.TypeInt64, value)
+	}
+	if value, ok := luo.mutation.VodReplacement(); ok {
+		_spec.SetField(live.FieldVodReplacement, field.TypeEnum, value)
+	}
+	if value, ok := luo.mutation.VodReplacementMinDifference(); ok {
+		_spec.SetField(live.FieldVodReplacementMinDifference, field.TypeInt, value)
+	}
+	if value, ok := luo.mutation.AddedVodReplacementMinDifference(); ok {
+		_spec.AddField(live.FieldVodReplacementMinDifference, field.TypeInt, value)
+	}
 	if value, ok := luo.mutation.UpdatedAt(); ok {
 		_spec.SetField(live.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -994,6 +1315,35 @@ func (luo *LiveUpdateOne) sqlSave(ctx context.Context) (_node *Live, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if luo.mutation.ArchiveProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   live.ArchiveProfileTable,
+			Columns: []string{live.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.ArchiveProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   live.ArchiveProfileTable,
+			Columns: []string{live.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if luo.mutation.CategoriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -3,28 +3,173 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// APITokensColumns holds the columns for the "api_tokens" table.
+	APITokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "editor", "archiver", "user"}},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_api_tokens", Type: field.TypeUUID},
+	}
+	// APITokensTable holds the schema information for the "api_tokens" table.
+	APITokensTable = &schema.Table{
+		Name:       "api_tokens",
+		Columns:    APITokensColumns,
+		PrimaryKey: []*schema.Column{APITokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_tokens_users_api_tokens",
+				Columns:    []*schema.Column{APITokensColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ArchiveProfilesColumns holds the columns for the "archive_profiles" table.
+	ArchiveProfilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "quality", Type: field.TypeString, Nullable: true},
+		{Name: "video_convert", Type: field.TypeString, Nullable: true},
+		{Name: "save_as_hls", Type: field.TypeBool, Nullable: true},
+		{Name: "render_chat", Type: field.TypeBool, Default: true},
+		{Name: "chat_render", Type: field.TypeString, Nullable: true},
+		{Name: "streamlink_live", Type: field.TypeString, Nullable: true},
+		{Name: "folder_template", Type: field.TypeString, Nullable: true},
+		{Name: "file_template", Type: field.TypeString, Nullable: true},
+		{Name: "proxy_policy", Type: field.TypeEnum, Enums: []string{"default", "enabled", "disabled"}, Default: "default"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ArchiveProfilesTable holds the schema information for the "archive_profiles" table.
+	ArchiveProfilesTable = &schema.Table{
+		Name:       "archive_profiles",
+		Columns:    ArchiveProfilesColumns,
+		PrimaryKey: []*schema.Column{ArchiveProfilesColumns[0]},
+	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"vod_delete", "channel_delete", "vod_trash", "vod_restore", "channel_trash", "channel_restore", "config_update", "user_update", "user_delete", "queue_stop", "queue_cancel", "task_start", "vod_prune", "api_token_create", "api_token_revoke", "channel_permission_grant", "channel_permission_revoke", "channel_visibility"}},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "username", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[9]},
+			},
+			{
+				Name:    "auditevent_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[2], AuditEventsColumns[3]},
+			},
+		},
+	}
 	// ChannelsColumns holds the columns for the "channels" table.
 	ChannelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "ext_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "display_name", Type: field.TypeString, Unique: true},
 		{Name: "image_path", Type: field.TypeString},
+		{Name: "platform", Type: field.TypeEnum, Enums: []string{"twitch", "youtube"}, Default: "twitch"},
 		{Name: "retention", Type: field.TypeBool, Default: false},
 		{Name: "retention_days", Type: field.TypeInt64, Nullable: true},
+		{Name: "retention_type_days", Type: field.TypeJSON, Nullable: true},
+		{Name: "retention_keep_last", Type: field.TypeInt64, Nullable: true},
+		{Name: "retention_max_size_gb", Type: field.TypeInt64, Nullable: true},
+		{Name: "retention_protect_playlists", Type: field.TypeBool, Default: true},
+		{Name: "retention_protect_watched", Type: field.TypeBool, Default: true},
+		{Name: "retention_action", Type: field.TypeEnum, Enums: []string{"delete", "delete_video", "delete_chat_video"}, Default: "delete"},
+		{Name: "cold_storage", Type: field.TypeBool, Default: false},
+		{Name: "cold_storage_days", Type: field.TypeInt64, Nullable: true},
+		{Name: "cold_storage_unwatched_days", Type: field.TypeInt64, Nullable: true},
+		{Name: "restricted", Type: field.TypeBool, Default: false},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "archive_profile_channels", Type: field.TypeUUID, Nullable: true},
+		{Name: "notification_subscription_channels", Type: field.TypeUUID, Nullable: true},
 	}
 	// ChannelsTable holds the schema information for the "channels" table.
 	ChannelsTable = &schema.Table{
 		Name:       "channels",
 		Columns:    ChannelsColumns,
 		PrimaryKey: []*schema.Column{ChannelsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "channels_archive_profiles_channels",
+				Columns:    []*schema.Column{ChannelsColumns[21]},
+				RefColumns: []*schema.Column{ArchiveProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "channels_notification_subscriptions_channels",
+				Columns:    []*schema.Column{ChannelsColumns[22]},
+				RefColumns: []*schema.Column{NotificationSubscriptionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ChannelPermissionsColumns holds the columns for the "channel_permissions" table.
+	ChannelPermissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "access", Type: field.TypeEnum, Enums: []string{"view", "archive", "edit"}, Default: "view"},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "channel_permissions", Type: field.TypeUUID},
+		{Name: "group_channel_permissions", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_channel_permissions", Type: field.TypeUUID, Nullable: true},
+	}
+	// ChannelPermissionsTable holds the schema information for the "channel_permissions" table.
+	ChannelPermissionsTable = &schema.Table{
+		Name:       "channel_permissions",
+		Columns:    ChannelPermissionsColumns,
+		PrimaryKey: []*schema.Column{ChannelPermissionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "channel_permissions_channels_permissions",
+				Columns:    []*schema.Column{ChannelPermissionsColumns[4]},
+				RefColumns: []*schema.Column{ChannelsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "channel_permissions_groups_channel_permissions",
+				Columns:    []*schema.Column{ChannelPermissionsColumns[5]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "channel_permissions_users_channel_permissions",
+				Columns:    []*schema.Column{ChannelPermissionsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 	}
 	// ChaptersColumns holds the columns for the "chapters" table.
 	ChaptersColumns = []*schema.Column{
@@ -49,6 +194,87 @@ var (
 			},
 		},
 	}
+	// ChatMessagesColumns holds the columns for the "chat_messages" table.
+	ChatMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "comment_id", Type: field.TypeString, Nullable: true},
+		{Name: "content_offset_seconds", Type: field.TypeFloat64},
+		{Name: "commenter_id", Type: field.TypeString, Nullable: true},
+		{Name: "commenter_name", Type: field.TypeString, Nullable: true},
+		{Name: "commenter_display_name", Type: field.TypeString, Nullable: true},
+		{Name: "commenter_badges", Type: field.TypeJSON, Nullable: true},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "data", Type: field.TypeString, Size: 2147483647},
+		{Name: "vod_chat_messages", Type: field.TypeUUID},
+	}
+	// ChatMessagesTable holds the schema information for the "chat_messages" table.
+	ChatMessagesTable = &schema.Table{
+		Name:       "chat_messages",
+		Columns:    ChatMessagesColumns,
+		PrimaryKey: []*schema.Column{ChatMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "chat_messages_vods_chat_messages",
+				Columns:    []*schema.Column{ChatMessagesColumns[9]},
+				RefColumns: []*schema.Column{VodsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chatmessage_content_offset_seconds_vod_chat_messages",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[2], ChatMessagesColumns[9]},
+			},
+			{
+				Name:    "chatmessage_body",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+			{
+				Name:    "chatmessage_commenter_name",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+			{
+				Name:    "chatmessage_commenter_display_name",
+				Unique:  false,
+				Columns: []*schema.Column{ChatMessagesColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+		},
+	}
+	// GroupsColumns holds the columns for the "groups" table.
+	GroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// GroupsTable holds the schema information for the "groups" table.
+	GroupsTable = &schema.Table{
+		Name:       "groups",
+		Columns:    GroupsColumns,
+		PrimaryKey: []*schema.Column{GroupsColumns[0]},
+	}
 	// LivesColumns holds the columns for the "lives" table.
 	LivesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -64,8 +290,14 @@ var (
 		{Name: "last_live", Type: field.TypeTime},
 		{Name: "render_chat", Type: field.TypeBool, Default: true},
 		{Name: "video_age", Type: field.TypeInt64, Default: 0},
+		{Name: "download_clips", Type: field.TypeBool, Default: false},
+		{Name: "clips_min_views", Type: field.TypeInt, Default: 0},
+		{Name: "clips_max_age", Type: field.TypeInt64, Default: 7},
+		{Name: "vod_replacement", Type: field.TypeEnum, Enums: []string{"none", "replace", "keep_both"}, Default: "none"},
+		{Name: "vod_replacement_min_difference", Type: field.TypeInt, Default: 60},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "archive_profile_live", Type: field.TypeUUID, Nullable: true},
 		{Name: "channel_live", Type: field.TypeUUID},
 	}
 	// LivesTable holds the schema information for the "lives" table.
@@ -74,9 +306,15 @@ var (
 		Columns:    LivesColumns,
 		PrimaryKey: []*schema.Column{LivesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "lives_archive_profiles_live",
+				Columns:    []*schema.Column{LivesColumns[20]},
+				RefColumns: []*schema.Column{ArchiveProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "lives_channels_live",
-				Columns:    []*schema.Column{LivesColumns[15]},
+				Columns:    []*schema.Column{LivesColumns[21]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -145,6 +383,72 @@ var (
 			},
 		},
 	}
+	// NotificationDeliveriesColumns holds the columns for the "notification_deliveries" table.
+	NotificationDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "event", Type: field.TypeEnum, Enums: []string{"video_success", "live_success", "error", "is_live", "playlist_vod"}},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"webhook", "discord", "apprise", "ntfy", "gotify", "smtp", "matrix"}},
+		{Name: "target", Type: field.TypeString},
+		{Name: "subscription_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "title", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "json_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// NotificationDeliveriesTable holds the schema information for the "notification_deliveries" table.
+	NotificationDeliveriesTable = &schema.Table{
+		Name:       "notification_deliveries",
+		Columns:    NotificationDeliveriesColumns,
+		PrimaryKey: []*schema.Column{NotificationDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationdelivery_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[5], NotificationDeliveriesColumns[15]},
+			},
+			{
+				Name:    "notificationdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[5], NotificationDeliveriesColumns[12]},
+			},
+		},
+	}
+	// NotificationSubscriptionsColumns holds the columns for the "notification_subscriptions" table.
+	NotificationSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"webhook", "discord", "apprise", "ntfy", "gotify", "smtp", "matrix"}, Default: "webhook"},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "token", Type: field.TypeString, Nullable: true},
+		{Name: "room", Type: field.TypeString, Nullable: true},
+		{Name: "template", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_notification_subscriptions", Type: field.TypeUUID},
+	}
+	// NotificationSubscriptionsTable holds the schema information for the "notification_subscriptions" table.
+	NotificationSubscriptionsTable = &schema.Table{
+		Name:       "notification_subscriptions",
+		Columns:    NotificationSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{NotificationSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_subscriptions_users_notification_subscriptions",
+				Columns:    []*schema.Column{NotificationSubscriptionsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// PlaybacksColumns holds the columns for the "playbacks" table.
 	PlaybacksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -181,19 +485,21 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "live_archive", Type: field.TypeBool, Default: false},
 		{Name: "on_hold", Type: field.TypeBool, Default: false},
+		{Name: "priority", Type: field.TypeInt, Default: 50},
+		{Name: "paused", Type: field.TypeBool, Default: false},
 		{Name: "video_processing", Type: field.TypeBool, Default: true},
 		{Name: "chat_processing", Type: field.TypeBool, Default: true},
 		{Name: "processing", Type: field.TypeBool, Default: true},
-		{Name: "task_vod_create_folder", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
-		{Name: "task_vod_download_thumbnail", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
-		{Name: "task_vod_save_info", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
-		{Name: "task_video_download", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
-		{Name: "task_video_convert", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
-		{Name: "task_video_move", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
-		{Name: "task_chat_download", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
-		{Name: "task_chat_convert", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
-		{Name: "task_chat_render", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
-		{Name: "task_chat_move", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed"}, Default: "pending"},
+		{Name: "task_vod_create_folder", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
+		{Name: "task_vod_download_thumbnail", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
+		{Name: "task_vod_save_info", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
+		{Name: "task_video_download", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
+		{Name: "task_video_convert", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
+		{Name: "task_video_move", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
+		{Name: "task_chat_download", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
+		{Name: "task_chat_convert", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
+		{Name: "task_chat_render", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
+		{Name: "task_chat_move", Type: field.TypeEnum, Nullable: true, Enums: []string{"success", "running", "pending", "failed", "cancelled"}, Default: "pending"},
 		{Name: "chat_start", Type: field.TypeTime, Nullable: true},
 		{Name: "render_chat", Type: field.TypeBool, Nullable: true, Default: true},
 		{Name: "workflow_id", Type: field.TypeString, Nullable: true},
@@ -210,12 +516,38 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "queues_vods_queue",
-				Columns:    []*schema.Column{QueuesColumns[22]},
+				Columns:    []*schema.Column{QueuesColumns[24]},
 				RefColumns: []*schema.Column{VodsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "refresh_token_id", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_sessions", Type: field.TypeUUID},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// TwitchCategoriesColumns holds the columns for the "twitch_categories" table.
 	TwitchCategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -252,6 +584,7 @@ var (
 	// VodsColumns holds the columns for the "vods" table.
 	VodsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "ext_id", Type: field.TypeString},
 		{Name: "platform", Type: field.TypeEnum, Enums: []string{"twitch", "youtube"}, Default: "twitch"},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"archive", "live", "highlight", "upload", "clip"}, Default: "archive"},
@@ -281,10 +614,25 @@ var (
 		{Name: "tmp_video_hls_path", Type: field.TypeString, Nullable: true},
 		{Name: "locked", Type: field.TypeBool, Default: false},
 		{Name: "local_views", Type: field.TypeInt, Default: 0},
+		{Name: "last_viewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "clip_ext_vod_id", Type: field.TypeString, Nullable: true},
+		{Name: "clip_vod_offset", Type: field.TypeInt, Nullable: true},
+		{Name: "reconnects", Type: field.TypeInt, Default: 0},
+		{Name: "cold_storage_at", Type: field.TypeTime, Nullable: true},
+		{Name: "chat_imported_at", Type: field.TypeTime, Nullable: true},
+		{Name: "health", Type: field.TypeEnum, Enums: []string{"unverified", "healthy", "unhealthy"}, Default: "unverified"},
+		{Name: "health_issues", Type: field.TypeJSON, Nullable: true},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "video_pruned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "trash_path", Type: field.TypeString, Nullable: true},
+		{Name: "storage_backend", Type: field.TypeEnum, Enums: []string{"local", "s3"}, Default: "local"},
 		{Name: "streamed_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "archive_profile_vods", Type: field.TypeUUID, Nullable: true},
 		{Name: "channel_vods", Type: field.TypeUUID},
+		{Name: "vod_clips", Type: field.TypeUUID, Nullable: true},
+		{Name: "vod_alternate", Type: field.TypeUUID, Unique: true, Nullable: true},
 	}
 	// VodsTable holds the schema information for the "vods" table.
 	VodsTable = &schema.Table{
@@ -292,12 +640,55 @@ var (
 		Columns:    VodsColumns,
 		PrimaryKey: []*schema.Column{VodsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vods_archive_profiles_vods",
+				Columns:    []*schema.Column{VodsColumns[46]},
+				RefColumns: []*schema.Column{ArchiveProfilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "vods_channels_vods",
-				Columns:    []*schema.Column{VodsColumns[33]},
+				Columns:    []*schema.Column{VodsColumns[47]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "vods_vods_clips",
+				Columns:    []*schema.Column{VodsColumns[48]},
+				RefColumns: []*schema.Column{VodsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "vods_vods_alternate",
+				Columns:    []*schema.Column{VodsColumns[49]},
+				RefColumns: []*schema.Column{VodsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// GroupUsersColumns holds the columns for the "group_users" table.
+	GroupUsersColumns = []*schema.Column{
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// GroupUsersTable holds the schema information for the "group_users" table.
+	GroupUsersTable = &schema.Table{
+		Name:       "group_users",
+		Columns:    GroupUsersColumns,
+		PrimaryKey: []*schema.Column{GroupUsersColumns[0], GroupUsersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_users_group_id",
+				Columns:    []*schema.Column{GroupUsersColumns[0]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_users_user_id",
+				Columns:    []*schema.Column{GroupUsersColumns[1]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 	}
 	// PlaylistVodsColumns holds the columns for the "playlist_vods" table.
@@ -327,30 +718,55 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APITokensTable,
+		ArchiveProfilesTable,
+		AuditEventsTable,
 		ChannelsTable,
+		ChannelPermissionsTable,
 		ChaptersTable,
+		ChatMessagesTable,
+		GroupsTable,
 		LivesTable,
 		LiveCategoriesTable,
 		LiveTitleRegexesTable,
 		MutedSegmentsTable,
+		NotificationDeliveriesTable,
+		NotificationSubscriptionsTable,
 		PlaybacksTable,
 		PlaylistsTable,
 		QueuesTable,
+		SessionsTable,
 		TwitchCategoriesTable,
 		UsersTable,
 		VodsTable,
+		GroupUsersTable,
 		PlaylistVodsTable,
 	}
 )
 
 func init() {
+	APITokensTable.ForeignKeys[0].RefTable = UsersTable
+	ChannelsTable.ForeignKeys[0].RefTable = ArchiveProfilesTable
+	ChannelsTable.ForeignKeys[1].RefTable = NotificationSubscriptionsTable
+	ChannelPermissionsTable.ForeignKeys[0].RefTable = ChannelsTable
+	ChannelPermissionsTable.ForeignKeys[1].RefTable = GroupsTable
+	ChannelPermissionsTable.ForeignKeys[2].RefTable = UsersTable
 	ChaptersTable.ForeignKeys[0].RefTable = VodsTable
-	LivesTable.ForeignKeys[0].RefTable = ChannelsTable
+	ChatMessagesTable.ForeignKeys[0].RefTable = VodsTable
+	LivesTable.ForeignKeys[0].RefTable = ArchiveProfilesTable
+	LivesTable.ForeignKeys[1].RefTable = ChannelsTable
 	LiveCategoriesTable.ForeignKeys[0].RefTable = LivesTable
 	LiveTitleRegexesTable.ForeignKeys[0].RefTable = LivesTable
 	MutedSegmentsTable.ForeignKeys[0].RefTable = VodsTable
+	NotificationSubscriptionsTable.ForeignKeys[0].RefTable = UsersTable
 	QueuesTable.ForeignKeys[0].RefTable = VodsTable
-	VodsTable.ForeignKeys[0].RefTable = ChannelsTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	VodsTable.ForeignKeys[0].RefTable = ArchiveProfilesTable
+	VodsTable.ForeignKeys[1].RefTable = ChannelsTable
+	VodsTable.ForeignKeys[2].RefTable = VodsTable
+	VodsTable.ForeignKeys[3].RefTable = VodsTable
+	GroupUsersTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupUsersTable.ForeignKeys[1].RefTable = UsersTable
 	PlaylistVodsTable.ForeignKeys[0].RefTable = PlaylistsTable
 	PlaylistVodsTable.ForeignKeys[1].RefTable = VodsTable
 }
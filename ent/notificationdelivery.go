@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/notificationdelivery"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationDelivery is the model entity for the NotificationDelivery schema.
+type NotificationDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The event the notification was sent for, takes an enum.
+	Event utils.NotificationEvent `json:"event,omitempty"`
+	// The provider the notification was sent with, takes an enum.
+	Provider utils.NotificationProvider `json:"provider,omitempty"`
+	// The name of the notification target.
+	Target string `json:"target,omitempty"`
+	// The subscription the notification was sent to, if it was not sent to a configured target.
+	SubscriptionID *uuid.UUID `json:"subscription_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status utils.TaskStatus `json:"status,omitempty"`
+	// The number of delivery attempts.
+	Attempts int `json:"attempts,omitempty"`
+	// The HTTP status code of the last attempt.
+	StatusCode int `json:"status_code,omitempty"`
+	// The error of the last attempt.
+	Error string `json:"error,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// The JSON object rendered by the template, sent instead of the default request body.
+	JSONBody string `json:"json_body,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// DeliveredAt holds the value of the "delivered_at" field.
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationdelivery.FieldSubscriptionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case notificationdelivery.FieldAttempts, notificationdelivery.FieldStatusCode:
+			values[i] = new(sql.NullInt64)
+		case notificationdelivery.FieldEvent, notificationdelivery.FieldProvider, notificationdelivery.FieldTarget, notificationdelivery.FieldStatus, notificationdelivery.FieldError, notificationdelivery.FieldTitle, notificationdelivery.FieldBody, notificationdelivery.FieldJSONBody:
+			values[i] = new(sql.NullString)
+		case notificationdelivery.FieldNextAttemptAt, notificationdelivery.FieldDeliveredAt, notificationdelivery.FieldUpdatedAt, notificationdelivery.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case notificationdelivery.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationDelivery fields.
+func (nd *NotificationDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationdelivery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				nd.ID = *value
+			}
+		case notificationdelivery.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				nd.Event = utils.NotificationEvent(value.String)
+			}
+		case notificationdelivery.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				nd.Provider = utils.NotificationProvider(value.String)
+			}
+		case notificationdelivery.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				nd.Target = value.String
+			}
+		case notificationdelivery.FieldSubscriptionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field subscription_id", values[i])
+			} else if value.Valid {
+				nd.SubscriptionID = new(uuid.UUID)
+				*nd.SubscriptionID = *value.S.(*uuid.UUID)
+			}
+		case notificationdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				nd.Status = utils.TaskStatus(value.String)
+			}
+		case notificationdelivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				nd.Attempts = int(value.Int64)
+			}
+		case notificationdelivery.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				nd.StatusCode = int(value.Int64)
+			}
+		case notificationdelivery.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				nd.Error = value.String
+			}
+		case notificationdelivery.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				nd.Title = value.String
+			}
+		case notificationdelivery.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				nd.Body = value.String
+			}
+		case notificationdelivery.FieldJSONBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field json_body", values[i])
+			} else if value.Valid {
+				nd.JSONBody = value.String
+			}
+		case notificationdelivery.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				nd.NextAttemptAt = new(time.Time)
+				*nd.NextAttemptAt = value.Time
+			}
+		case notificationdelivery.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				nd.DeliveredAt = new(time.Time)
+				*nd.DeliveredAt = value.Time
+			}
+		case notificationdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				nd.UpdatedAt = value.Time
+			}
+		case notificationdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nd.CreatedAt = value.Time
+			}
+		default:
+			nd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationDelivery.
+// This includes values selected through modifiers, order, etc.
+func (nd *NotificationDelivery) Value(name string) (ent.Value, error) {
+	return nd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NotificationDelivery.
+// Note that you need to call NotificationDelivery.Unwrap() before calling this method if this NotificationDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nd *NotificationDelivery) Update() *NotificationDeliveryUpdateOne {
+	return NewNotificationDeliveryClient(nd.config).UpdateOne(nd)
+}
+
+// Unwrap unwraps the NotificationDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nd *NotificationDelivery) Unwrap() *NotificationDelivery {
+	_tx, ok := nd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationDelivery is not a transactional entity")
+	}
+	nd.config.driver = _tx.drv
+	return nd
+}
+
+// String implements the fmt.Stringer.
+func (nd *NotificationDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nd.ID))
+	builder.WriteString("event=")
+	builder.WriteString(fmt.Sprintf("%v", nd.Event))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(fmt.Sprintf("%v", nd.Provider))
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(nd.Target)
+	builder.WriteString(", ")
+	if v := nd.SubscriptionID; v != nil {
+		builder.WriteString("subscription_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", nd.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", nd.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("status_code=")
+	builder.WriteString(fmt.Sprintf("%v", nd.StatusCode))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(nd.Error)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(nd.Title)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(nd.Body)
+	builder.WriteString(", ")
+	builder.WriteString("json_body=")
+	builder.WriteString(nd.JSONBody)
+	builder.WriteString(", ")
+	if v := nd.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := nd.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(nd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(nd.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationDeliveries is a parsable slice of NotificationDelivery.
+type NotificationDeliveries []*NotificationDelivery
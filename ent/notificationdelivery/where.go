@@ -0,0 +1,907 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationdelivery
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldID, id))
+}
+
+// Target applies equality check predicate on the "target" field. It's identical to TargetEQ.
+func Target(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldTarget, v))
+}
+
+// SubscriptionID applies equality check predicate on the "subscription_id" field. It's identical to SubscriptionIDEQ.
+func SubscriptionID(v uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldAttempts, v))
+}
+
+// StatusCode applies equality check predicate on the "status_code" field. It's identical to StatusCodeEQ.
+func StatusCode(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldError, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldTitle, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldBody, v))
+}
+
+// JSONBody applies equality check predicate on the "json_body" field. It's identical to JSONBodyEQ.
+func JSONBody(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldJSONBody, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// DeliveredAt applies equality check predicate on the "delivered_at" field. It's identical to DeliveredAtEQ.
+func DeliveredAt(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EventEQ applies the EQ predicate on the "event" field.
+func EventEQ(v utils.NotificationEvent) predicate.NotificationDelivery {
+	vc := v
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldEvent, vc))
+}
+
+// EventNEQ applies the NEQ predicate on the "event" field.
+func EventNEQ(v utils.NotificationEvent) predicate.NotificationDelivery {
+	vc := v
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldEvent, vc))
+}
+
+// EventIn applies the In predicate on the "event" field.
+func EventIn(vs ...utils.NotificationEvent) predicate.NotificationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationDelivery(sql.FieldIn(FieldEvent, v...))
+}
+
+// EventNotIn applies the NotIn predicate on the "event" field.
+func EventNotIn(vs ...utils.NotificationEvent) predicate.NotificationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldEvent, v...))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v utils.NotificationProvider) predicate.NotificationDelivery {
+	vc := v
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldProvider, vc))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v utils.NotificationProvider) predicate.NotificationDelivery {
+	vc := v
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldProvider, vc))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...utils.NotificationProvider) predicate.NotificationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationDelivery(sql.FieldIn(FieldProvider, v...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...utils.NotificationProvider) predicate.NotificationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldProvider, v...))
+}
+
+// TargetEQ applies the EQ predicate on the "target" field.
+func TargetEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldTarget, v))
+}
+
+// TargetNEQ applies the NEQ predicate on the "target" field.
+func TargetNEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldTarget, v))
+}
+
+// TargetIn applies the In predicate on the "target" field.
+func TargetIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldTarget, vs...))
+}
+
+// TargetNotIn applies the NotIn predicate on the "target" field.
+func TargetNotIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldTarget, vs...))
+}
+
+// TargetGT applies the GT predicate on the "target" field.
+func TargetGT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldTarget, v))
+}
+
+// TargetGTE applies the GTE predicate on the "target" field.
+func TargetGTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldTarget, v))
+}
+
+// TargetLT applies the LT predicate on the "target" field.
+func TargetLT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldTarget, v))
+}
+
+// TargetLTE applies the LTE predicate on the "target" field.
+func TargetLTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldTarget, v))
+}
+
+// TargetContains applies the Contains predicate on the "target" field.
+func TargetContains(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContains(FieldTarget, v))
+}
+
+// TargetHasPrefix applies the HasPrefix predicate on the "target" field.
+func TargetHasPrefix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasPrefix(FieldTarget, v))
+}
+
+// TargetHasSuffix applies the HasSuffix predicate on the "target" field.
+func TargetHasSuffix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasSuffix(FieldTarget, v))
+}
+
+// TargetEqualFold applies the EqualFold predicate on the "target" field.
+func TargetEqualFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldTarget, v))
+}
+
+// TargetContainsFold applies the ContainsFold predicate on the "target" field.
+func TargetContainsFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldTarget, v))
+}
+
+// SubscriptionIDEQ applies the EQ predicate on the "subscription_id" field.
+func SubscriptionIDEQ(v uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDNEQ applies the NEQ predicate on the "subscription_id" field.
+func SubscriptionIDNEQ(v uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIn applies the In predicate on the "subscription_id" field.
+func SubscriptionIDIn(vs ...uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDNotIn applies the NotIn predicate on the "subscription_id" field.
+func SubscriptionIDNotIn(vs ...uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldSubscriptionID, vs...))
+}
+
+// SubscriptionIDGT applies the GT predicate on the "subscription_id" field.
+func SubscriptionIDGT(v uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDGTE applies the GTE predicate on the "subscription_id" field.
+func SubscriptionIDGTE(v uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLT applies the LT predicate on the "subscription_id" field.
+func SubscriptionIDLT(v uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDLTE applies the LTE predicate on the "subscription_id" field.
+func SubscriptionIDLTE(v uuid.UUID) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldSubscriptionID, v))
+}
+
+// SubscriptionIDIsNil applies the IsNil predicate on the "subscription_id" field.
+func SubscriptionIDIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldSubscriptionID))
+}
+
+// SubscriptionIDNotNil applies the NotNil predicate on the "subscription_id" field.
+func SubscriptionIDNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldSubscriptionID))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v utils.TaskStatus) predicate.NotificationDelivery {
+	vc := v
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v utils.TaskStatus) predicate.NotificationDelivery {
+	vc := v
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...utils.TaskStatus) predicate.NotificationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationDelivery(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...utils.TaskStatus) predicate.NotificationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldAttempts, v))
+}
+
+// StatusCodeEQ applies the EQ predicate on the "status_code" field.
+func StatusCodeEQ(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// StatusCodeNEQ applies the NEQ predicate on the "status_code" field.
+func StatusCodeNEQ(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldStatusCode, v))
+}
+
+// StatusCodeIn applies the In predicate on the "status_code" field.
+func StatusCodeIn(vs ...int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeNotIn applies the NotIn predicate on the "status_code" field.
+func StatusCodeNotIn(vs ...int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeGT applies the GT predicate on the "status_code" field.
+func StatusCodeGT(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldStatusCode, v))
+}
+
+// StatusCodeGTE applies the GTE predicate on the "status_code" field.
+func StatusCodeGTE(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldStatusCode, v))
+}
+
+// StatusCodeLT applies the LT predicate on the "status_code" field.
+func StatusCodeLT(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldStatusCode, v))
+}
+
+// StatusCodeLTE applies the LTE predicate on the "status_code" field.
+func StatusCodeLTE(v int) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldStatusCode, v))
+}
+
+// StatusCodeIsNil applies the IsNil predicate on the "status_code" field.
+func StatusCodeIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldStatusCode))
+}
+
+// StatusCodeNotNil applies the NotNil predicate on the "status_code" field.
+func StatusCodeNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldStatusCode))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldError, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyIsNil applies the IsNil predicate on the "body" field.
+func BodyIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldBody))
+}
+
+// BodyNotNil applies the NotNil predicate on the "body" field.
+func BodyNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldBody))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldBody, v))
+}
+
+// JSONBodyEQ applies the EQ predicate on the "json_body" field.
+func JSONBodyEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldJSONBody, v))
+}
+
+// JSONBodyNEQ applies the NEQ predicate on the "json_body" field.
+func JSONBodyNEQ(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldJSONBody, v))
+}
+
+// JSONBodyIn applies the In predicate on the "json_body" field.
+func JSONBodyIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldJSONBody, vs...))
+}
+
+// JSONBodyNotIn applies the NotIn predicate on the "json_body" field.
+func JSONBodyNotIn(vs ...string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldJSONBody, vs...))
+}
+
+// JSONBodyGT applies the GT predicate on the "json_body" field.
+func JSONBodyGT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldJSONBody, v))
+}
+
+// JSONBodyGTE applies the GTE predicate on the "json_body" field.
+func JSONBodyGTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldJSONBody, v))
+}
+
+// JSONBodyLT applies the LT predicate on the "json_body" field.
+func JSONBodyLT(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldJSONBody, v))
+}
+
+// JSONBodyLTE applies the LTE predicate on the "json_body" field.
+func JSONBodyLTE(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldJSONBody, v))
+}
+
+// JSONBodyContains applies the Contains predicate on the "json_body" field.
+func JSONBodyContains(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContains(FieldJSONBody, v))
+}
+
+// JSONBodyHasPrefix applies the HasPrefix predicate on the "json_body" field.
+func JSONBodyHasPrefix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasPrefix(FieldJSONBody, v))
+}
+
+// JSONBodyHasSuffix applies the HasSuffix predicate on the "json_body" field.
+func JSONBodyHasSuffix(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldHasSuffix(FieldJSONBody, v))
+}
+
+// JSONBodyIsNil applies the IsNil predicate on the "json_body" field.
+func JSONBodyIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldJSONBody))
+}
+
+// JSONBodyNotNil applies the NotNil predicate on the "json_body" field.
+func JSONBodyNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldJSONBody))
+}
+
+// JSONBodyEqualFold applies the EqualFold predicate on the "json_body" field.
+func JSONBodyEqualFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEqualFold(FieldJSONBody, v))
+}
+
+// JSONBodyContainsFold applies the ContainsFold predicate on the "json_body" field.
+func JSONBodyContainsFold(v string) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldContainsFold(FieldJSONBody, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
+// DeliveredAtEQ applies the EQ predicate on the "delivered_at" field.
+func DeliveredAtEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtNEQ applies the NEQ predicate on the "delivered_at" field.
+func DeliveredAtNEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIn applies the In predicate on the "delivered_at" field.
+func DeliveredAtIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtNotIn applies the NotIn predicate on the "delivered_at" field.
+func DeliveredAtNotIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldDeliveredAt, vs...))
+}
+
+// DeliveredAtGT applies the GT predicate on the "delivered_at" field.
+func DeliveredAtGT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtGTE applies the GTE predicate on the "delivered_at" field.
+func DeliveredAtGTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLT applies the LT predicate on the "delivered_at" field.
+func DeliveredAtLT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldDeliveredAt, v))
+}
+
+// DeliveredAtLTE applies the LTE predicate on the "delivered_at" field.
+func DeliveredAtLTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldDeliveredAt, v))
+}
+
+// DeliveredAtIsNil applies the IsNil predicate on the "delivered_at" field.
+func DeliveredAtIsNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIsNull(FieldDeliveredAt))
+}
+
+// DeliveredAtNotNil applies the NotNil predicate on the "delivered_at" field.
+func DeliveredAtNotNil() predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotNull(FieldDeliveredAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationDelivery) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationDelivery) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationDelivery) predicate.NotificationDelivery {
+	return predicate.NotificationDelivery(sql.NotPredicates(p))
+}
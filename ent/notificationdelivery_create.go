@@ -0,0 +1,1521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/notificationdelivery"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationDeliveryCreate is the builder for creating a NotificationDelivery entity.
+type NotificationDeliveryCreate struct {
+	config
+	mutation *NotificationDeliveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetEvent sets the "event" field.
+func (ndc *NotificationDeliveryCreate) SetEvent(ue utils.NotificationEvent) *NotificationDeliveryCreate {
+	ndc.mutation.SetEvent(ue)
+	return ndc
+}
+
+// SetProvider sets the "provider" field.
+func (ndc *NotificationDeliveryCreate) SetProvider(up utils.NotificationProvider) *NotificationDeliveryCreate {
+	ndc.mutation.SetProvider(up)
+	return ndc
+}
+
+// SetTarget sets the "target" field.
+func (ndc *NotificationDeliveryCreate) SetTarget(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetTarget(s)
+	return ndc
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (ndc *NotificationDeliveryCreate) SetSubscriptionID(u uuid.UUID) *NotificationDeliveryCreate {
+	ndc.mutation.SetSubscriptionID(u)
+	return ndc
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableSubscriptionID(u *uuid.UUID) *NotificationDeliveryCreate {
+	if u != nil {
+		ndc.SetSubscriptionID(*u)
+	}
+	return ndc
+}
+
+// SetStatus sets the "status" field.
+func (ndc *NotificationDeliveryCreate) SetStatus(us utils.TaskStatus) *NotificationDeliveryCreate {
+	ndc.mutation.SetStatus(us)
+	return ndc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableStatus(us *utils.TaskStatus) *NotificationDeliveryCreate {
+	if us != nil {
+		ndc.SetStatus(*us)
+	}
+	return ndc
+}
+
+// SetAttempts sets the "attempts" field.
+func (ndc *NotificationDeliveryCreate) SetAttempts(i int) *NotificationDeliveryCreate {
+	ndc.mutation.SetAttempts(i)
+	return ndc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableAttempts(i *int) *NotificationDeliveryCreate {
+	if i != nil {
+		ndc.SetAttempts(*i)
+	}
+	return ndc
+}
+
+// SetStatusCode sets the "status_code" field.
+func (ndc *NotificationDeliveryCreate) SetStatusCode(i int) *NotificationDeliveryCreate {
+	ndc.mutation.SetStatusCode(i)
+	return ndc
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableStatusCode(i *int) *NotificationDeliveryCreate {
+	if i != nil {
+		ndc.SetStatusCode(*i)
+	}
+	return ndc
+}
+
+// SetError sets the "error" field.
+func (ndc *NotificationDeliveryCreate) SetError(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetError(s)
+	return ndc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableError(s *string) *NotificationDeliveryCreate {
+	if s != nil {
+		ndc.SetError(*s)
+	}
+	return ndc
+}
+
+// SetTitle sets the "title" field.
+func (ndc *NotificationDeliveryCreate) SetTitle(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetTitle(s)
+	return ndc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableTitle(s *string) *NotificationDeliveryCreate {
+	if s != nil {
+		ndc.SetTitle(*s)
+	}
+	return ndc
+}
+
+// SetBody sets the "body" field.
+func (ndc *NotificationDeliveryCreate) SetBody(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetBody(s)
+	return ndc
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableBody(s *string) *NotificationDeliveryCreate {
+	if s != nil {
+		ndc.SetBody(*s)
+	}
+	return ndc
+}
+
+// SetJSONBody sets the "json_body" field.
+func (ndc *NotificationDeliveryCreate) SetJSONBody(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetJSONBody(s)
+	return ndc
+}
+
+// SetNillableJSONBody sets the "json_body" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableJSONBody(s *string) *NotificationDeliveryCreate {
+	if s != nil {
+		ndc.SetJSONBody(*s)
+	}
+	return ndc
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (ndc *NotificationDeliveryCreate) SetNextAttemptAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetNextAttemptAt(t)
+	return ndc
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableNextAttemptAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetNextAttemptAt(*t)
+	}
+	return ndc
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (ndc *NotificationDeliveryCreate) SetDeliveredAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetDeliveredAt(t)
+	return ndc
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableDeliveredAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetDeliveredAt(*t)
+	}
+	return ndc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ndc *NotificationDeliveryCreate) SetUpdatedAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetUpdatedAt(t)
+	return ndc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableUpdatedAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetUpdatedAt(*t)
+	}
+	return ndc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ndc *NotificationDeliveryCreate) SetCreatedAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetCreatedAt(t)
+	return ndc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableCreatedAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetCreatedAt(*t)
+	}
+	return ndc
+}
+
+// SetID sets the "id" field.
+func (ndc *NotificationDeliveryCreate) SetID(u uuid.UUID) *NotificationDeliveryCreate {
+	ndc.mutation.SetID(u)
+	return ndc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableID(u *uuid.UUID) *NotificationDeliveryCreate {
+	if u != nil {
+		ndc.SetID(*u)
+	}
+	return ndc
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (ndc *NotificationDeliveryCreate) Mutation() *NotificationDeliveryMutation {
+	return ndc.mutation
+}
+
+// Save creates the NotificationDelivery in the database.
+func (ndc *NotificationDeliveryCreate) Save(ctx context.Context) (*NotificationDelivery, error) {
+	ndc.defaults()
+	return withHooks(ctx, ndc.sqlSave, ndc.mutation, ndc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ndc *NotificationDeliveryCreate) SaveX(ctx context.Context) *NotificationDelivery {
+	v, err := ndc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ndc *NotificationDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := ndc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndc *NotificationDeliveryCreate) ExecX(ctx context.Context) {
+	if err := ndc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ndc *NotificationDeliveryCreate) defaults() {
+	if _, ok := ndc.mutation.Status(); !ok {
+		v := notificationdelivery.DefaultStatus
+		ndc.mutation.SetStatus(v)
+	}
+	if _, ok := ndc.mutation.Attempts(); !ok {
+		v := notificationdelivery.DefaultAttempts
+		ndc.mutation.SetAttempts(v)
+	}
+	if _, ok := ndc.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.DefaultUpdatedAt()
+		ndc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ndc.mutation.CreatedAt(); !ok {
+		v := notificationdelivery.DefaultCreatedAt()
+		ndc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ndc.mutation.ID(); !ok {
+		v := notificationdelivery.DefaultID()
+		ndc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ndc *NotificationDeliveryCreate) check() error {
+	if _, ok := ndc.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "NotificationDelivery.event"`)}
+	}
+	if v, ok := ndc.mutation.Event(); ok {
+		if err := notificationdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.event": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "NotificationDelivery.provider"`)}
+	}
+	if v, ok := ndc.mutation.Provider(); ok {
+		if err := notificationdelivery.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.provider": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "NotificationDelivery.target"`)}
+	}
+	if _, ok := ndc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "NotificationDelivery.status"`)}
+	}
+	if v, ok := ndc.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "NotificationDelivery.attempts"`)}
+	}
+	if _, ok := ndc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationDelivery.updated_at"`)}
+	}
+	if _, ok := ndc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationDelivery.created_at"`)}
+	}
+	return nil
+}
+
+func (ndc *NotificationDeliveryCreate) sqlSave(ctx context.Context) (*NotificationDelivery, error) {
+	if err := ndc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ndc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ndc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ndc.mutation.id = &_node.ID
+	ndc.mutation.done = true
+	return _node, nil
+}
+
+func (ndc *NotificationDeliveryCreate) createSpec() (*NotificationDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationDelivery{config: ndc.config}
+		_spec = sqlgraph.NewCreateSpec(notificationdelivery.Table, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = ndc.conflict
+	if id, ok := ndc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ndc.mutation.Event(); ok {
+		_spec.SetField(notificationdelivery.FieldEvent, field.TypeEnum, value)
+		_node.Event = value
+	}
+	if value, ok := ndc.mutation.Provider(); ok {
+		_spec.SetField(notificationdelivery.FieldProvider, field.TypeEnum, value)
+		_node.Provider = value
+	}
+	if value, ok := ndc.mutation.Target(); ok {
+		_spec.SetField(notificationdelivery.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if value, ok := ndc.mutation.SubscriptionID(); ok {
+		_spec.SetField(notificationdelivery.FieldSubscriptionID, field.TypeUUID, value)
+		_node.SubscriptionID = &value
+	}
+	if value, ok := ndc.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ndc.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := ndc.mutation.StatusCode(); ok {
+		_spec.SetField(notificationdelivery.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = value
+	}
+	if value, ok := ndc.mutation.Error(); ok {
+		_spec.SetField(notificationdelivery.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := ndc.mutation.Title(); ok {
+		_spec.SetField(notificationdelivery.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := ndc.mutation.Body(); ok {
+		_spec.SetField(notificationdelivery.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := ndc.mutation.JSONBody(); ok {
+		_spec.SetField(notificationdelivery.FieldJSONBody, field.TypeString, value)
+		_node.JSONBody = value
+	}
+	if value, ok := ndc.mutation.NextAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := ndc.mutation.DeliveredAt(); ok {
+		_spec.SetField(notificationdelivery.FieldDeliveredAt, field.TypeTime, value)
+		_node.DeliveredAt = &value
+	}
+	if value, ok := ndc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ndc.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationDelivery.Create().
+//		SetEvent(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationDeliveryUpsert) {
+//			SetEvent(v+v).
+//		}).
+//		Exec(ctx)
+func (ndc *NotificationDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *NotificationDeliveryUpsertOne {
+	ndc.conflict = opts
+	return &NotificationDeliveryUpsertOne{
+		create: ndc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ndc *NotificationDeliveryCreate) OnConflictColumns(columns ...string) *NotificationDeliveryUpsertOne {
+	ndc.conflict = append(ndc.conflict, sql.ConflictColumns(columns...))
+	return &NotificationDeliveryUpsertOne{
+		create: ndc,
+	}
+}
+
+type (
+	// NotificationDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one NotificationDelivery node.
+	NotificationDeliveryUpsertOne struct {
+		create *NotificationDeliveryCreate
+	}
+
+	// NotificationDeliveryUpsert is the "OnConflict" setter.
+	NotificationDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEvent sets the "event" field.
+func (u *NotificationDeliveryUpsert) SetEvent(v utils.NotificationEvent) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldEvent, v)
+	return u
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateEvent() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldEvent)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *NotificationDeliveryUpsert) SetProvider(v utils.NotificationProvider) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateProvider() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldProvider)
+	return u
+}
+
+// SetTarget sets the "target" field.
+func (u *NotificationDeliveryUpsert) SetTarget(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldTarget, v)
+	return u
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateTarget() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldTarget)
+	return u
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *NotificationDeliveryUpsert) SetSubscriptionID(v uuid.UUID) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldSubscriptionID, v)
+	return u
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateSubscriptionID() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldSubscriptionID)
+	return u
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (u *NotificationDeliveryUpsert) ClearSubscriptionID() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldSubscriptionID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *NotificationDeliveryUpsert) SetStatus(v utils.TaskStatus) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateStatus() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NotificationDeliveryUpsert) SetAttempts(v int) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateAttempts() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NotificationDeliveryUpsert) AddAttempts(v int) *NotificationDeliveryUpsert {
+	u.Add(notificationdelivery.FieldAttempts, v)
+	return u
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *NotificationDeliveryUpsert) SetStatusCode(v int) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldStatusCode, v)
+	return u
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateStatusCode() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldStatusCode)
+	return u
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *NotificationDeliveryUpsert) AddStatusCode(v int) *NotificationDeliveryUpsert {
+	u.Add(notificationdelivery.FieldStatusCode, v)
+	return u
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (u *NotificationDeliveryUpsert) ClearStatusCode() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldStatusCode)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *NotificationDeliveryUpsert) SetError(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateError() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *NotificationDeliveryUpsert) ClearError() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldError)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationDeliveryUpsert) SetTitle(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateTitle() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldTitle)
+	return u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *NotificationDeliveryUpsert) ClearTitle() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldTitle)
+	return u
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationDeliveryUpsert) SetBody(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldBody, v)
+	return u
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateBody() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldBody)
+	return u
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *NotificationDeliveryUpsert) ClearBody() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldBody)
+	return u
+}
+
+// SetJSONBody sets the "json_body" field.
+func (u *NotificationDeliveryUpsert) SetJSONBody(v string) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldJSONBody, v)
+	return u
+}
+
+// UpdateJSONBody sets the "json_body" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateJSONBody() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldJSONBody)
+	return u
+}
+
+// ClearJSONBody clears the value of the "json_body" field.
+func (u *NotificationDeliveryUpsert) ClearJSONBody() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldJSONBody)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsert) SetNextAttemptAt(v time.Time) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateNextAttemptAt() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldNextAttemptAt)
+	return u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsert) ClearNextAttemptAt() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldNextAttemptAt)
+	return u
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *NotificationDeliveryUpsert) SetDeliveredAt(v time.Time) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldDeliveredAt, v)
+	return u
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateDeliveredAt() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldDeliveredAt)
+	return u
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *NotificationDeliveryUpsert) ClearDeliveredAt() *NotificationDeliveryUpsert {
+	u.SetNull(notificationdelivery.FieldDeliveredAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDeliveryUpsert) SetUpdatedAt(v time.Time) *NotificationDeliveryUpsert {
+	u.Set(notificationdelivery.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsert) UpdateUpdatedAt() *NotificationDeliveryUpsert {
+	u.SetExcluded(notificationdelivery.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationDeliveryUpsertOne) UpdateNewValues() *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notificationdelivery.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notificationdelivery.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationDeliveryUpsertOne) Ignore() *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationDeliveryUpsertOne) DoNothing() *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *NotificationDeliveryUpsertOne) Update(set func(*NotificationDeliveryUpsert)) *NotificationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEvent sets the "event" field.
+func (u *NotificationDeliveryUpsertOne) SetEvent(v utils.NotificationEvent) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetEvent(v)
+	})
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateEvent() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateEvent()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *NotificationDeliveryUpsertOne) SetProvider(v utils.NotificationProvider) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateProvider() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetTarget sets the "target" field.
+func (u *NotificationDeliveryUpsertOne) SetTarget(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetTarget(v)
+	})
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateTarget() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateTarget()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *NotificationDeliveryUpsertOne) SetSubscriptionID(v uuid.UUID) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateSubscriptionID() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (u *NotificationDeliveryUpsertOne) ClearSubscriptionID() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearSubscriptionID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *NotificationDeliveryUpsertOne) SetStatus(v utils.TaskStatus) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateStatus() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NotificationDeliveryUpsertOne) SetAttempts(v int) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NotificationDeliveryUpsertOne) AddAttempts(v int) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateAttempts() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *NotificationDeliveryUpsertOne) SetStatusCode(v int) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetStatusCode(v)
+	})
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *NotificationDeliveryUpsertOne) AddStatusCode(v int) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.AddStatusCode(v)
+	})
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateStatusCode() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateStatusCode()
+	})
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (u *NotificationDeliveryUpsertOne) ClearStatusCode() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearStatusCode()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *NotificationDeliveryUpsertOne) SetError(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateError() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *NotificationDeliveryUpsertOne) ClearError() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationDeliveryUpsertOne) SetTitle(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateTitle() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *NotificationDeliveryUpsertOne) ClearTitle() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationDeliveryUpsertOne) SetBody(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateBody() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *NotificationDeliveryUpsertOne) ClearBody() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearBody()
+	})
+}
+
+// SetJSONBody sets the "json_body" field.
+func (u *NotificationDeliveryUpsertOne) SetJSONBody(v string) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetJSONBody(v)
+	})
+}
+
+// UpdateJSONBody sets the "json_body" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateJSONBody() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateJSONBody()
+	})
+}
+
+// ClearJSONBody clears the value of the "json_body" field.
+func (u *NotificationDeliveryUpsertOne) ClearJSONBody() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearJSONBody()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsertOne) SetNextAttemptAt(v time.Time) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateNextAttemptAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsertOne) ClearNextAttemptAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *NotificationDeliveryUpsertOne) SetDeliveredAt(v time.Time) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateDeliveredAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *NotificationDeliveryUpsertOne) ClearDeliveredAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDeliveryUpsertOne) SetUpdatedAt(v time.Time) *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertOne) UpdateUpdatedAt() *NotificationDeliveryUpsertOne {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationDeliveryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NotificationDeliveryUpsertOne.ID is not supported by MySQL driver. Use NotificationDeliveryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationDeliveryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationDeliveryCreateBulk is the builder for creating many NotificationDelivery entities in bulk.
+type NotificationDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationDeliveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationDelivery entities in the database.
+func (ndcb *NotificationDeliveryCreateBulk) Save(ctx context.Context) ([]*NotificationDelivery, error) {
+	if ndcb.err != nil {
+		return nil, ndcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ndcb.builders))
+	nodes := make([]*NotificationDelivery, len(ndcb.builders))
+	mutators := make([]Mutator, len(ndcb.builders))
+	for i := range ndcb.builders {
+		func(i int, root context.Context) {
+			builder := ndcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ndcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ndcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ndcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ndcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ndcb *NotificationDeliveryCreateBulk) SaveX(ctx context.Context) []*NotificationDelivery {
+	v, err := ndcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ndcb *NotificationDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := ndcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndcb *NotificationDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := ndcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationDeliveryUpsert) {
+//			SetEvent(v+v).
+//		}).
+//		Exec(ctx)
+func (ndcb *NotificationDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationDeliveryUpsertBulk {
+	ndcb.conflict = opts
+	return &NotificationDeliveryUpsertBulk{
+		create: ndcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ndcb *NotificationDeliveryCreateBulk) OnConflictColumns(columns ...string) *NotificationDeliveryUpsertBulk {
+	ndcb.conflict = append(ndcb.conflict, sql.ConflictColumns(columns...))
+	return &NotificationDeliveryUpsertBulk{
+		create: ndcb,
+	}
+}
+
+// NotificationDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationDelivery nodes.
+type NotificationDeliveryUpsertBulk struct {
+	create *NotificationDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationDeliveryUpsertBulk) UpdateNewValues() *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notificationdelivery.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notificationdelivery.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationDeliveryUpsertBulk) Ignore() *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationDeliveryUpsertBulk) DoNothing() *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationDeliveryUpsertBulk) Update(set func(*NotificationDeliveryUpsert)) *NotificationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEvent sets the "event" field.
+func (u *NotificationDeliveryUpsertBulk) SetEvent(v utils.NotificationEvent) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetEvent(v)
+	})
+}
+
+// UpdateEvent sets the "event" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateEvent() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateEvent()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *NotificationDeliveryUpsertBulk) SetProvider(v utils.NotificationProvider) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateProvider() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetTarget sets the "target" field.
+func (u *NotificationDeliveryUpsertBulk) SetTarget(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetTarget(v)
+	})
+}
+
+// UpdateTarget sets the "target" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateTarget() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateTarget()
+	})
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (u *NotificationDeliveryUpsertBulk) SetSubscriptionID(v uuid.UUID) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetSubscriptionID(v)
+	})
+}
+
+// UpdateSubscriptionID sets the "subscription_id" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateSubscriptionID() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateSubscriptionID()
+	})
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (u *NotificationDeliveryUpsertBulk) ClearSubscriptionID() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearSubscriptionID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *NotificationDeliveryUpsertBulk) SetStatus(v utils.TaskStatus) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateStatus() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *NotificationDeliveryUpsertBulk) SetAttempts(v int) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *NotificationDeliveryUpsertBulk) AddAttempts(v int) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateAttempts() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetStatusCode sets the "status_code" field.
+func (u *NotificationDeliveryUpsertBulk) SetStatusCode(v int) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetStatusCode(v)
+	})
+}
+
+// AddStatusCode adds v to the "status_code" field.
+func (u *NotificationDeliveryUpsertBulk) AddStatusCode(v int) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.AddStatusCode(v)
+	})
+}
+
+// UpdateStatusCode sets the "status_code" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateStatusCode() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateStatusCode()
+	})
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (u *NotificationDeliveryUpsertBulk) ClearStatusCode() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearStatusCode()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *NotificationDeliveryUpsertBulk) SetError(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateError() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *NotificationDeliveryUpsertBulk) ClearError() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *NotificationDeliveryUpsertBulk) SetTitle(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateTitle() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *NotificationDeliveryUpsertBulk) ClearTitle() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetBody sets the "body" field.
+func (u *NotificationDeliveryUpsertBulk) SetBody(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetBody(v)
+	})
+}
+
+// UpdateBody sets the "body" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateBody() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateBody()
+	})
+}
+
+// ClearBody clears the value of the "body" field.
+func (u *NotificationDeliveryUpsertBulk) ClearBody() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearBody()
+	})
+}
+
+// SetJSONBody sets the "json_body" field.
+func (u *NotificationDeliveryUpsertBulk) SetJSONBody(v string) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetJSONBody(v)
+	})
+}
+
+// UpdateJSONBody sets the "json_body" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateJSONBody() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateJSONBody()
+	})
+}
+
+// ClearJSONBody clears the value of the "json_body" field.
+func (u *NotificationDeliveryUpsertBulk) ClearJSONBody() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearJSONBody()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsertBulk) SetNextAttemptAt(v time.Time) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateNextAttemptAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (u *NotificationDeliveryUpsertBulk) ClearNextAttemptAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearNextAttemptAt()
+	})
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (u *NotificationDeliveryUpsertBulk) SetDeliveredAt(v time.Time) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetDeliveredAt(v)
+	})
+}
+
+// UpdateDeliveredAt sets the "delivered_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateDeliveredAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateDeliveredAt()
+	})
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (u *NotificationDeliveryUpsertBulk) ClearDeliveredAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.ClearDeliveredAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationDeliveryUpsertBulk) SetUpdatedAt(v time.Time) *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationDeliveryUpsertBulk) UpdateUpdatedAt() *NotificationDeliveryUpsertBulk {
+	return u.Update(func(s *NotificationDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zibbp/ganymede/ent/notificationdelivery"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// NotificationDeliveryDelete is the builder for deleting a NotificationDelivery entity.
+type NotificationDeliveryDelete struct {
+	config
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// Where appends a list predicates to the NotificationDeliveryDelete builder.
+func (ndd *NotificationDeliveryDelete) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryDelete {
+	ndd.mutation.Where(ps...)
+	return ndd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ndd *NotificationDeliveryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ndd.sqlExec, ndd.mutation, ndd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndd *NotificationDeliveryDelete) ExecX(ctx context.Context) int {
+	n, err := ndd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ndd *NotificationDeliveryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(notificationdelivery.Table, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeUUID))
+	if ps := ndd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ndd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ndd.mutation.done = true
+	return affected, err
+}
+
+// NotificationDeliveryDeleteOne is the builder for deleting a single NotificationDelivery entity.
+type NotificationDeliveryDeleteOne struct {
+	ndd *NotificationDeliveryDelete
+}
+
+// Where appends a list predicates to the NotificationDeliveryDelete builder.
+func (nddo *NotificationDeliveryDeleteOne) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryDeleteOne {
+	nddo.ndd.mutation.Where(ps...)
+	return nddo
+}
+
+// Exec executes the deletion query.
+func (nddo *NotificationDeliveryDeleteOne) Exec(ctx context.Context) error {
+	n, err := nddo.ndd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{notificationdelivery.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nddo *NotificationDeliveryDeleteOne) ExecX(ctx context.Context) {
+	if err := nddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,886 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/notificationdelivery"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationDeliveryUpdate is the builder for updating NotificationDelivery entities.
+type NotificationDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// Where appends a list predicates to the NotificationDeliveryUpdate builder.
+func (ndu *NotificationDeliveryUpdate) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryUpdate {
+	ndu.mutation.Where(ps...)
+	return ndu
+}
+
+// SetEvent sets the "event" field.
+func (ndu *NotificationDeliveryUpdate) SetEvent(ue utils.NotificationEvent) *NotificationDeliveryUpdate {
+	ndu.mutation.SetEvent(ue)
+	return ndu
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableEvent(ue *utils.NotificationEvent) *NotificationDeliveryUpdate {
+	if ue != nil {
+		ndu.SetEvent(*ue)
+	}
+	return ndu
+}
+
+// SetProvider sets the "provider" field.
+func (ndu *NotificationDeliveryUpdate) SetProvider(up utils.NotificationProvider) *NotificationDeliveryUpdate {
+	ndu.mutation.SetProvider(up)
+	return ndu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableProvider(up *utils.NotificationProvider) *NotificationDeliveryUpdate {
+	if up != nil {
+		ndu.SetProvider(*up)
+	}
+	return ndu
+}
+
+// SetTarget sets the "target" field.
+func (ndu *NotificationDeliveryUpdate) SetTarget(s string) *NotificationDeliveryUpdate {
+	ndu.mutation.SetTarget(s)
+	return ndu
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableTarget(s *string) *NotificationDeliveryUpdate {
+	if s != nil {
+		ndu.SetTarget(*s)
+	}
+	return ndu
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (ndu *NotificationDeliveryUpdate) SetSubscriptionID(u uuid.UUID) *NotificationDeliveryUpdate {
+	ndu.mutation.SetSubscriptionID(u)
+	return ndu
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableSubscriptionID(u *uuid.UUID) *NotificationDeliveryUpdate {
+	if u != nil {
+		ndu.SetSubscriptionID(*u)
+	}
+	return ndu
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (ndu *NotificationDeliveryUpdate) ClearSubscriptionID() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearSubscriptionID()
+	return ndu
+}
+
+// SetStatus sets the "status" field.
+func (ndu *NotificationDeliveryUpdate) SetStatus(us utils.TaskStatus) *NotificationDeliveryUpdate {
+	ndu.mutation.SetStatus(us)
+	return ndu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableStatus(us *utils.TaskStatus) *NotificationDeliveryUpdate {
+	if us != nil {
+		ndu.SetStatus(*us)
+	}
+	return ndu
+}
+
+// SetAttempts sets the "attempts" field.
+func (ndu *NotificationDeliveryUpdate) SetAttempts(i int) *NotificationDeliveryUpdate {
+	ndu.mutation.ResetAttempts()
+	ndu.mutation.SetAttempts(i)
+	return ndu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableAttempts(i *int) *NotificationDeliveryUpdate {
+	if i != nil {
+		ndu.SetAttempts(*i)
+	}
+	return ndu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (ndu *NotificationDeliveryUpdate) AddAttempts(i int) *NotificationDeliveryUpdate {
+	ndu.mutation.AddAttempts(i)
+	return ndu
+}
+
+// SetStatusCode sets the "status_code" field.
+func (ndu *NotificationDeliveryUpdate) SetStatusCode(i int) *NotificationDeliveryUpdate {
+	ndu.mutation.ResetStatusCode()
+	ndu.mutation.SetStatusCode(i)
+	return ndu
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableStatusCode(i *int) *NotificationDeliveryUpdate {
+	if i != nil {
+		ndu.SetStatusCode(*i)
+	}
+	return ndu
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (ndu *NotificationDeliveryUpdate) AddStatusCode(i int) *NotificationDeliveryUpdate {
+	ndu.mutation.AddStatusCode(i)
+	return ndu
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (ndu *NotificationDeliveryUpdate) ClearStatusCode() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearStatusCode()
+	return ndu
+}
+
+// SetError sets the "error" field.
+func (ndu *NotificationDeliveryUpdate) SetError(s string) *NotificationDeliveryUpdate {
+	ndu.mutation.SetError(s)
+	return ndu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableError(s *string) *NotificationDeliveryUpdate {
+	if s != nil {
+		ndu.SetError(*s)
+	}
+	return ndu
+}
+
+// ClearError clears the value of the "error" field.
+func (ndu *NotificationDeliveryUpdate) ClearError() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearError()
+	return ndu
+}
+
+// SetTitle sets the "title" field.
+func (ndu *NotificationDeliveryUpdate) SetTitle(s string) *NotificationDeliveryUpdate {
+	ndu.mutation.SetTitle(s)
+	return ndu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableTitle(s *string) *NotificationDeliveryUpdate {
+	if s != nil {
+		ndu.SetTitle(*s)
+	}
+	return ndu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (ndu *NotificationDeliveryUpdate) ClearTitle() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearTitle()
+	return ndu
+}
+
+// SetBody sets the "body" field.
+func (ndu *NotificationDeliveryUpdate) SetBody(s string) *NotificationDeliveryUpdate {
+	ndu.mutation.SetBody(s)
+	return ndu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableBody(s *string) *NotificationDeliveryUpdate {
+	if s != nil {
+		ndu.SetBody(*s)
+	}
+	return ndu
+}
+
+// ClearBody clears the value of the "body" field.
+func (ndu *NotificationDeliveryUpdate) ClearBody() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearBody()
+	return ndu
+}
+
+// SetJSONBody sets the "json_body" field.
+func (ndu *NotificationDeliveryUpdate) SetJSONBody(s string) *NotificationDeliveryUpdate {
+	ndu.mutation.SetJSONBody(s)
+	return ndu
+}
+
+// SetNillableJSONBody sets the "json_body" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableJSONBody(s *string) *NotificationDeliveryUpdate {
+	if s != nil {
+		ndu.SetJSONBody(*s)
+	}
+	return ndu
+}
+
+// ClearJSONBody clears the value of the "json_body" field.
+func (ndu *NotificationDeliveryUpdate) ClearJSONBody() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearJSONBody()
+	return ndu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (ndu *NotificationDeliveryUpdate) SetNextAttemptAt(t time.Time) *NotificationDeliveryUpdate {
+	ndu.mutation.SetNextAttemptAt(t)
+	return ndu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableNextAttemptAt(t *time.Time) *NotificationDeliveryUpdate {
+	if t != nil {
+		ndu.SetNextAttemptAt(*t)
+	}
+	return ndu
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (ndu *NotificationDeliveryUpdate) ClearNextAttemptAt() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearNextAttemptAt()
+	return ndu
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (ndu *NotificationDeliveryUpdate) SetDeliveredAt(t time.Time) *NotificationDeliveryUpdate {
+	ndu.mutation.SetDeliveredAt(t)
+	return ndu
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableDeliveredAt(t *time.Time) *NotificationDeliveryUpdate {
+	if t != nil {
+		ndu.SetDeliveredAt(*t)
+	}
+	return ndu
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (ndu *NotificationDeliveryUpdate) ClearDeliveredAt() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearDeliveredAt()
+	return ndu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ndu *NotificationDeliveryUpdate) SetUpdatedAt(t time.Time) *NotificationDeliveryUpdate {
+	ndu.mutation.SetUpdatedAt(t)
+	return ndu
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (ndu *NotificationDeliveryUpdate) Mutation() *NotificationDeliveryMutation {
+	return ndu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ndu *NotificationDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	ndu.defaults()
+	return withHooks(ctx, ndu.sqlSave, ndu.mutation, ndu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ndu *NotificationDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ndu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ndu *NotificationDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := ndu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndu *NotificationDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := ndu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ndu *NotificationDeliveryUpdate) defaults() {
+	if _, ok := ndu.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.UpdateDefaultUpdatedAt()
+		ndu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ndu *NotificationDeliveryUpdate) check() error {
+	if v, ok := ndu.mutation.Event(); ok {
+		if err := notificationdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.event": %w`, err)}
+		}
+	}
+	if v, ok := ndu.mutation.Provider(); ok {
+		if err := notificationdelivery.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.provider": %w`, err)}
+		}
+	}
+	if v, ok := ndu.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ndu *NotificationDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ndu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeUUID))
+	if ps := ndu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ndu.mutation.Event(); ok {
+		_spec.SetField(notificationdelivery.FieldEvent, field.TypeEnum, value)
+	}
+	if value, ok := ndu.mutation.Provider(); ok {
+		_spec.SetField(notificationdelivery.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := ndu.mutation.Target(); ok {
+		_spec.SetField(notificationdelivery.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := ndu.mutation.SubscriptionID(); ok {
+		_spec.SetField(notificationdelivery.FieldSubscriptionID, field.TypeUUID, value)
+	}
+	if ndu.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldSubscriptionID, field.TypeUUID)
+	}
+	if value, ok := ndu.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := ndu.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ndu.mutation.AddedAttempts(); ok {
+		_spec.AddField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := ndu.mutation.StatusCode(); ok {
+		_spec.SetField(notificationdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := ndu.mutation.AddedStatusCode(); ok {
+		_spec.AddField(notificationdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if ndu.mutation.StatusCodeCleared() {
+		_spec.ClearField(notificationdelivery.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := ndu.mutation.Error(); ok {
+		_spec.SetField(notificationdelivery.FieldError, field.TypeString, value)
+	}
+	if ndu.mutation.ErrorCleared() {
+		_spec.ClearField(notificationdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := ndu.mutation.Title(); ok {
+		_spec.SetField(notificationdelivery.FieldTitle, field.TypeString, value)
+	}
+	if ndu.mutation.TitleCleared() {
+		_spec.ClearField(notificationdelivery.FieldTitle, field.TypeString)
+	}
+	if value, ok := ndu.mutation.Body(); ok {
+		_spec.SetField(notificationdelivery.FieldBody, field.TypeString, value)
+	}
+	if ndu.mutation.BodyCleared() {
+		_spec.ClearField(notificationdelivery.FieldBody, field.TypeString)
+	}
+	if value, ok := ndu.mutation.JSONBody(); ok {
+		_spec.SetField(notificationdelivery.FieldJSONBody, field.TypeString, value)
+	}
+	if ndu.mutation.JSONBodyCleared() {
+		_spec.ClearField(notificationdelivery.FieldJSONBody, field.TypeString)
+	}
+	if value, ok := ndu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if ndu.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := ndu.mutation.DeliveredAt(); ok {
+		_spec.SetField(notificationdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if ndu.mutation.DeliveredAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := ndu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ndu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ndu.mutation.done = true
+	return n, nil
+}
+
+// NotificationDeliveryUpdateOne is the builder for updating a single NotificationDelivery entity.
+type NotificationDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// SetEvent sets the "event" field.
+func (nduo *NotificationDeliveryUpdateOne) SetEvent(ue utils.NotificationEvent) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetEvent(ue)
+	return nduo
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableEvent(ue *utils.NotificationEvent) *NotificationDeliveryUpdateOne {
+	if ue != nil {
+		nduo.SetEvent(*ue)
+	}
+	return nduo
+}
+
+// SetProvider sets the "provider" field.
+func (nduo *NotificationDeliveryUpdateOne) SetProvider(up utils.NotificationProvider) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetProvider(up)
+	return nduo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableProvider(up *utils.NotificationProvider) *NotificationDeliveryUpdateOne {
+	if up != nil {
+		nduo.SetProvider(*up)
+	}
+	return nduo
+}
+
+// SetTarget sets the "target" field.
+func (nduo *NotificationDeliveryUpdateOne) SetTarget(s string) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetTarget(s)
+	return nduo
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableTarget(s *string) *NotificationDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetTarget(*s)
+	}
+	return nduo
+}
+
+// SetSubscriptionID sets the "subscription_id" field.
+func (nduo *NotificationDeliveryUpdateOne) SetSubscriptionID(u uuid.UUID) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetSubscriptionID(u)
+	return nduo
+}
+
+// SetNillableSubscriptionID sets the "subscription_id" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableSubscriptionID(u *uuid.UUID) *NotificationDeliveryUpdateOne {
+	if u != nil {
+		nduo.SetSubscriptionID(*u)
+	}
+	return nduo
+}
+
+// ClearSubscriptionID clears the value of the "subscription_id" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearSubscriptionID() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearSubscriptionID()
+	return nduo
+}
+
+// SetStatus sets the "status" field.
+func (nduo *NotificationDeliveryUpdateOne) SetStatus(us utils.TaskStatus) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetStatus(us)
+	return nduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableStatus(us *utils.TaskStatus) *NotificationDeliveryUpdateOne {
+	if us != nil {
+		nduo.SetStatus(*us)
+	}
+	return nduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (nduo *NotificationDeliveryUpdateOne) SetAttempts(i int) *NotificationDeliveryUpdateOne {
+	nduo.mutation.ResetAttempts()
+	nduo.mutation.SetAttempts(i)
+	return nduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableAttempts(i *int) *NotificationDeliveryUpdateOne {
+	if i != nil {
+		nduo.SetAttempts(*i)
+	}
+	return nduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (nduo *NotificationDeliveryUpdateOne) AddAttempts(i int) *NotificationDeliveryUpdateOne {
+	nduo.mutation.AddAttempts(i)
+	return nduo
+}
+
+// SetStatusCode sets the "status_code" field.
+func (nduo *NotificationDeliveryUpdateOne) SetStatusCode(i int) *NotificationDeliveryUpdateOne {
+	nduo.mutation.ResetStatusCode()
+	nduo.mutation.SetStatusCode(i)
+	return nduo
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableStatusCode(i *int) *NotificationDeliveryUpdateOne {
+	if i != nil {
+		nduo.SetStatusCode(*i)
+	}
+	return nduo
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (nduo *NotificationDeliveryUpdateOne) AddStatusCode(i int) *NotificationDeliveryUpdateOne {
+	nduo.mutation.AddStatusCode(i)
+	return nduo
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearStatusCode() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearStatusCode()
+	return nduo
+}
+
+// SetError sets the "error" field.
+func (nduo *NotificationDeliveryUpdateOne) SetError(s string) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetError(s)
+	return nduo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableError(s *string) *NotificationDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetError(*s)
+	}
+	return nduo
+}
+
+// ClearError clears the value of the "error" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearError() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearError()
+	return nduo
+}
+
+// SetTitle sets the "title" field.
+func (nduo *NotificationDeliveryUpdateOne) SetTitle(s string) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetTitle(s)
+	return nduo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableTitle(s *string) *NotificationDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetTitle(*s)
+	}
+	return nduo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearTitle() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearTitle()
+	return nduo
+}
+
+// SetBody sets the "body" field.
+func (nduo *NotificationDeliveryUpdateOne) SetBody(s string) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetBody(s)
+	return nduo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableBody(s *string) *NotificationDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetBody(*s)
+	}
+	return nduo
+}
+
+// ClearBody clears the value of the "body" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearBody() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearBody()
+	return nduo
+}
+
+// SetJSONBody sets the "json_body" field.
+func (nduo *NotificationDeliveryUpdateOne) SetJSONBody(s string) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetJSONBody(s)
+	return nduo
+}
+
+// SetNillableJSONBody sets the "json_body" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableJSONBody(s *string) *NotificationDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetJSONBody(*s)
+	}
+	return nduo
+}
+
+// ClearJSONBody clears the value of the "json_body" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearJSONBody() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearJSONBody()
+	return nduo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (nduo *NotificationDeliveryUpdateOne) SetNextAttemptAt(t time.Time) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetNextAttemptAt(t)
+	return nduo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableNextAttemptAt(t *time.Time) *NotificationDeliveryUpdateOne {
+	if t != nil {
+		nduo.SetNextAttemptAt(*t)
+	}
+	return nduo
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearNextAttemptAt() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearNextAttemptAt()
+	return nduo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (nduo *NotificationDeliveryUpdateOne) SetDeliveredAt(t time.Time) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetDeliveredAt(t)
+	return nduo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableDeliveredAt(t *time.Time) *NotificationDeliveryUpdateOne {
+	if t != nil {
+		nduo.SetDeliveredAt(*t)
+	}
+	return nduo
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearDeliveredAt() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearDeliveredAt()
+	return nduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nduo *NotificationDeliveryUpdateOne) SetUpdatedAt(t time.Time) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetUpdatedAt(t)
+	return nduo
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (nduo *NotificationDeliveryUpdateOne) Mutation() *NotificationDeliveryMutation {
+	return nduo.mutation
+}
+
+// Where appends a list predicates to the NotificationDeliveryUpdate builder.
+func (nduo *NotificationDeliveryUpdateOne) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryUpdateOne {
+	nduo.mutation.Where(ps...)
+	return nduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nduo *NotificationDeliveryUpdateOne) Select(field string, fields ...string) *NotificationDeliveryUpdateOne {
+	nduo.fields = append([]string{field}, fields...)
+	return nduo
+}
+
+// Save executes the query and returns the updated NotificationDelivery entity.
+func (nduo *NotificationDeliveryUpdateOne) Save(ctx context.Context) (*NotificationDelivery, error) {
+	nduo.defaults()
+	return withHooks(ctx, nduo.sqlSave, nduo.mutation, nduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nduo *NotificationDeliveryUpdateOne) SaveX(ctx context.Context) *NotificationDelivery {
+	node, err := nduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nduo *NotificationDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := nduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nduo *NotificationDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := nduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nduo *NotificationDeliveryUpdateOne) defaults() {
+	if _, ok := nduo.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.UpdateDefaultUpdatedAt()
+		nduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nduo *NotificationDeliveryUpdateOne) check() error {
+	if v, ok := nduo.mutation.Event(); ok {
+		if err := notificationdelivery.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.event": %w`, err)}
+		}
+	}
+	if v, ok := nduo.mutation.Provider(); ok {
+		if err := notificationdelivery.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.provider": %w`, err)}
+		}
+	}
+	if v, ok := nduo.mutation.Status(); ok {
+		if err := notificationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (nduo *NotificationDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *NotificationDelivery, err error) {
+	if err := nduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeUUID))
+	id, ok := nduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationdelivery.FieldID)
+		for _, f := range fields {
+			if !notificationdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nduo.mutation.Event(); ok {
+		_spec.SetField(notificationdelivery.FieldEvent, field.TypeEnum, value)
+	}
+	if value, ok := nduo.mutation.Provider(); ok {
+		_spec.SetField(notificationdelivery.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := nduo.mutation.Target(); ok {
+		_spec.SetField(notificationdelivery.FieldTarget, field.TypeString, value)
+	}
+	if value, ok := nduo.mutation.SubscriptionID(); ok {
+		_spec.SetField(notificationdelivery.FieldSubscriptionID, field.TypeUUID, value)
+	}
+	if nduo.mutation.SubscriptionIDCleared() {
+		_spec.ClearField(notificationdelivery.FieldSubscriptionID, field.TypeUUID)
+	}
+	if value, ok := nduo.mutation.Status(); ok {
+		_spec.SetField(notificationdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := nduo.mutation.Attempts(); ok {
+		_spec.SetField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := nduo.mutation.AddedAttempts(); ok {
+		_spec.AddField(notificationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := nduo.mutation.StatusCode(); ok {
+		_spec.SetField(notificationdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := nduo.mutation.AddedStatusCode(); ok {
+		_spec.AddField(notificationdelivery.FieldStatusCode, field.TypeInt, value)
+	}
+	if nduo.mutation.StatusCodeCleared() {
+		_spec.ClearField(notificationdelivery.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := nduo.mutation.Error(); ok {
+		_spec.SetField(notificationdelivery.FieldError, field.TypeString, value)
+	}
+	if nduo.mutation.ErrorCleared() {
+		_spec.ClearField(notificationdelivery.FieldError, field.TypeString)
+	}
+	if value, ok := nduo.mutation.Title(); ok {
+		_spec.SetField(notificationdelivery.FieldTitle, field.TypeString, value)
+	}
+	if nduo.mutation.TitleCleared() {
+		_spec.ClearField(notificationdelivery.FieldTitle, field.TypeString)
+	}
+	if value, ok := nduo.mutation.Body(); ok {
+		_spec.SetField(notificationdelivery.FieldBody, field.TypeString, value)
+	}
+	if nduo.mutation.BodyCleared() {
+		_spec.ClearField(notificationdelivery.FieldBody, field.TypeString)
+	}
+	if value, ok := nduo.mutation.JSONBody(); ok {
+		_spec.SetField(notificationdelivery.FieldJSONBody, field.TypeString, value)
+	}
+	if nduo.mutation.JSONBodyCleared() {
+		_spec.ClearField(notificationdelivery.FieldJSONBody, field.TypeString)
+	}
+	if value, ok := nduo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(notificationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if nduo.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := nduo.mutation.DeliveredAt(); ok {
+		_spec.SetField(notificationdelivery.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if nduo.mutation.DeliveredAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := nduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &NotificationDelivery{config: nduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationSubscription is the model entity for the NotificationSubscription schema.
+type NotificationSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// The events the subscription receives.
+	Events []utils.NotificationEvent `json:"events,omitempty"`
+	// The provider notifications are sent with, takes an enum.
+	Provider utils.NotificationProvider `json:"provider,omitempty"`
+	// The URL of the target, the webhook of the user is used if empty.
+	URL string `json:"url,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"-"`
+	// Room holds the value of the "room" field.
+	Room string `json:"room,omitempty"`
+	// The template of the notifications, the template of the event is used if empty.
+	Template string `json:"template,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationSubscriptionQuery when eager-loading is set.
+	Edges                           NotificationSubscriptionEdges `json:"edges"`
+	user_notification_subscriptions *uuid.UUID
+	selectValues                    sql.SelectValues
+}
+
+// NotificationSubscriptionEdges holds the relations/edges for other nodes in the graph.
+type NotificationSubscriptionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Channels holds the value of the channels edge.
+	Channels []*Channel `json:"channels,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationSubscriptionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ChannelsOrErr returns the Channels value or an error if the edge
+// was not loaded in eager-loading.
+func (e NotificationSubscriptionEdges) ChannelsOrErr() ([]*Channel, error) {
+	if e.loadedTypes[1] {
+		return e.Channels, nil
+	}
+	return nil, &NotLoadedError{edge: "channels"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationsubscription.FieldEvents:
+			values[i] = new([]byte)
+		case notificationsubscription.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case notificationsubscription.FieldName, notificationsubscription.FieldProvider, notificationsubscription.FieldURL, notificationsubscription.FieldToken, notificationsubscription.FieldRoom, notificationsubscription.FieldTemplate:
+			values[i] = new(sql.NullString)
+		case notificationsubscription.FieldUpdatedAt, notificationsubscription.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case notificationsubscription.FieldID:
+			values[i] = new(uuid.UUID)
+		case notificationsubscription.ForeignKeys[0]: // user_notification_subscriptions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationSubscription fields.
+func (ns *NotificationSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationsubscription.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ns.ID = *value
+			}
+		case notificationsubscription.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ns.Name = value.String
+			}
+		case notificationsubscription.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ns.Enabled = value.Bool
+			}
+		case notificationsubscription.FieldEvents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field events", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ns.Events); err != nil {
+					return fmt.Errorf("unmarshal field events: %w", err)
+				}
+			}
+		case notificationsubscription.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				ns.Provider = utils.NotificationProvider(value.String)
+			}
+		case notificationsubscription.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				ns.URL = value.String
+			}
+		case notificationsubscription.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				ns.Token = value.String
+			}
+		case notificationsubscription.FieldRoom:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field room", values[i])
+			} else if value.Valid {
+				ns.Room = value.String
+			}
+		case notificationsubscription.FieldTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template", values[i])
+			} else if value.Valid {
+				ns.Template = value.String
+			}
+		case notificationsubscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ns.UpdatedAt = value.Time
+			}
+		case notificationsubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ns.CreatedAt = value.Time
+			}
+		case notificationsubscription.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_notification_subscriptions", values[i])
+			} else if value.Valid {
+				ns.user_notification_subscriptions = new(uuid.UUID)
+				*ns.user_notification_subscriptions = *value.S.(*uuid.UUID)
+			}
+		default:
+			ns.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationSubscription.
+// This includes values selected through modifiers, order, etc.
+func (ns *NotificationSubscription) Value(name string) (ent.Value, error) {
+	return ns.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the NotificationSubscription entity.
+func (ns *NotificationSubscription) QueryUser() *UserQuery {
+	return NewNotificationSubscriptionClient(ns.config).QueryUser(ns)
+}
+
+// QueryChannels queries the "channels" edge of the NotificationSubscription entity.
+func (ns *NotificationSubscription) QueryChannels() *ChannelQuery {
+	return NewNotificationSubscriptionClient(ns.config).QueryChannels(ns)
+}
+
+// Update returns a builder for updating this NotificationSubscription.
+// Note that you need to call NotificationSubscription.Unwrap() before calling this method if this NotificationSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ns *NotificationSubscription) Update() *NotificationSubscriptionUpdateOne {
+	return NewNotificationSubscriptionClient(ns.config).UpdateOne(ns)
+}
+
+// Unwrap unwraps the NotificationSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ns *NotificationSubscription) Unwrap() *NotificationSubscription {
+	_tx, ok := ns.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationSubscription is not a transactional entity")
+	}
+	ns.config.driver = _tx.drv
+	return ns
+}
+
+// String implements the fmt.Stringer.
+func (ns *NotificationSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ns.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ns.Name)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ns.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("events=")
+	builder.WriteString(fmt.Sprintf("%v", ns.Events))
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(fmt.Sprintf("%v", ns.Provider))
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(ns.URL)
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("room=")
+	builder.WriteString(ns.Room)
+	builder.WriteString(", ")
+	builder.WriteString("template=")
+	builder.WriteString(ns.Template)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ns.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ns.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationSubscriptions is a parsable slice of NotificationSubscription.
+type NotificationSubscriptions []*NotificationSubscription
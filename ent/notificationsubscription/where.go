@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package notificationsubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldEnabled, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldURL, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldToken, v))
+}
+
+// Room applies equality check predicate on the "room" field. It's identical to RoomEQ.
+func Room(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldRoom, v))
+}
+
+// Template applies equality check predicate on the "template" field. It's identical to TemplateEQ.
+func Template(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldTemplate, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v utils.NotificationProvider) predicate.NotificationSubscription {
+	vc := v
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldProvider, vc))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v utils.NotificationProvider) predicate.NotificationSubscription {
+	vc := v
+	return predicate.NotificationSubscription(sql.FieldNEQ(FieldProvider, vc))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...utils.NotificationProvider) predicate.NotificationSubscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationSubscription(sql.FieldIn(FieldProvider, v...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...utils.NotificationProvider) predicate.NotificationSubscription {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NotificationSubscription(sql.FieldNotIn(FieldProvider, v...))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLIsNil applies the IsNil predicate on the "url" field.
+func URLIsNil() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIsNull(FieldURL))
+}
+
+// URLNotNil applies the NotNil predicate on the "url" field.
+func URLNotNil() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotNull(FieldURL))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldContainsFold(FieldURL, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenIsNil applies the IsNil predicate on the "token" field.
+func TokenIsNil() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIsNull(FieldToken))
+}
+
+// TokenNotNil applies the NotNil predicate on the "token" field.
+func TokenNotNil() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotNull(FieldToken))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldContainsFold(FieldToken, v))
+}
+
+// RoomEQ applies the EQ predicate on the "room" field.
+func RoomEQ(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldRoom, v))
+}
+
+// RoomNEQ applies the NEQ predicate on the "room" field.
+func RoomNEQ(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNEQ(FieldRoom, v))
+}
+
+// RoomIn applies the In predicate on the "room" field.
+func RoomIn(vs ...string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIn(FieldRoom, vs...))
+}
+
+// RoomNotIn applies the NotIn predicate on the "room" field.
+func RoomNotIn(vs ...string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotIn(FieldRoom, vs...))
+}
+
+// RoomGT applies the GT predicate on the "room" field.
+func RoomGT(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGT(FieldRoom, v))
+}
+
+// RoomGTE applies the GTE predicate on the "room" field.
+func RoomGTE(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGTE(FieldRoom, v))
+}
+
+// RoomLT applies the LT predicate on the "room" field.
+func RoomLT(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLT(FieldRoom, v))
+}
+
+// RoomLTE applies the LTE predicate on the "room" field.
+func RoomLTE(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLTE(FieldRoom, v))
+}
+
+// RoomContains applies the Contains predicate on the "room" field.
+func RoomContains(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldContains(FieldRoom, v))
+}
+
+// RoomHasPrefix applies the HasPrefix predicate on the "room" field.
+func RoomHasPrefix(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldHasPrefix(FieldRoom, v))
+}
+
+// RoomHasSuffix applies the HasSuffix predicate on the "room" field.
+func RoomHasSuffix(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldHasSuffix(FieldRoom, v))
+}
+
+// RoomIsNil applies the IsNil predicate on the "room" field.
+func RoomIsNil() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIsNull(FieldRoom))
+}
+
+// RoomNotNil applies the NotNil predicate on the "room" field.
+func RoomNotNil() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotNull(FieldRoom))
+}
+
+// RoomEqualFold applies the EqualFold predicate on the "room" field.
+func RoomEqualFold(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEqualFold(FieldRoom, v))
+}
+
+// RoomContainsFold applies the ContainsFold predicate on the "room" field.
+func RoomContainsFold(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldContainsFold(FieldRoom, v))
+}
+
+// TemplateEQ applies the EQ predicate on the "template" field.
+func TemplateEQ(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldTemplate, v))
+}
+
+// TemplateNEQ applies the NEQ predicate on the "template" field.
+func TemplateNEQ(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNEQ(FieldTemplate, v))
+}
+
+// TemplateIn applies the In predicate on the "template" field.
+func TemplateIn(vs ...string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIn(FieldTemplate, vs...))
+}
+
+// TemplateNotIn applies the NotIn predicate on the "template" field.
+func TemplateNotIn(vs ...string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotIn(FieldTemplate, vs...))
+}
+
+// TemplateGT applies the GT predicate on the "template" field.
+func TemplateGT(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGT(FieldTemplate, v))
+}
+
+// TemplateGTE applies the GTE predicate on the "template" field.
+func TemplateGTE(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGTE(FieldTemplate, v))
+}
+
+// TemplateLT applies the LT predicate on the "template" field.
+func TemplateLT(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLT(FieldTemplate, v))
+}
+
+// TemplateLTE applies the LTE predicate on the "template" field.
+func TemplateLTE(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLTE(FieldTemplate, v))
+}
+
+// TemplateContains applies the Contains predicate on the "template" field.
+func TemplateContains(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldContains(FieldTemplate, v))
+}
+
+// TemplateHasPrefix applies the HasPrefix predicate on the "template" field.
+func TemplateHasPrefix(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldHasPrefix(FieldTemplate, v))
+}
+
+// TemplateHasSuffix applies the HasSuffix predicate on the "template" field.
+func TemplateHasSuffix(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldHasSuffix(FieldTemplate, v))
+}
+
+// TemplateIsNil applies the IsNil predicate on the "template" field.
+func TemplateIsNil() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIsNull(FieldTemplate))
+}
+
+// TemplateNotNil applies the NotNil predicate on the "template" field.
+func TemplateNotNil() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotNull(FieldTemplate))
+}
+
+// TemplateEqualFold applies the EqualFold predicate on the "template" field.
+func TemplateEqualFold(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEqualFold(FieldTemplate, v))
+}
+
+// TemplateContainsFold applies the ContainsFold predicate on the "template" field.
+func TemplateContainsFold(v string) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldContainsFold(FieldTemplate, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChannels applies the HasEdge predicate on the "channels" edge.
+func HasChannels() predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChannelsTable, ChannelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChannelsWith applies the HasEdge predicate on the "channels" edge with a given conditions (other predicates).
+func HasChannelsWith(preds ...predicate.Channel) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(func(s *sql.Selector) {
+		step := newChannelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NotificationSubscription) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NotificationSubscription) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NotificationSubscription) predicate.NotificationSubscription {
+	return predicate.NotificationSubscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,1195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationSubscriptionCreate is the builder for creating a NotificationSubscription entity.
+type NotificationSubscriptionCreate struct {
+	config
+	mutation *NotificationSubscriptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (nsc *NotificationSubscriptionCreate) SetName(s string) *NotificationSubscriptionCreate {
+	nsc.mutation.SetName(s)
+	return nsc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nsc *NotificationSubscriptionCreate) SetNillableName(s *string) *NotificationSubscriptionCreate {
+	if s != nil {
+		nsc.SetName(*s)
+	}
+	return nsc
+}
+
+// SetEnabled sets the "enabled" field.
+func (nsc *NotificationSubscriptionCreate) SetEnabled(b bool) *NotificationSubscriptionCreate {
+	nsc.mutation.SetEnabled(b)
+	return nsc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (nsc *NotificationSubscriptionCreate) SetNillableEnabled(b *bool) *NotificationSubscriptionCreate {
+	if b != nil {
+		nsc.SetEnabled(*b)
+	}
+	return nsc
+}
+
+// SetEvents sets the "events" field.
+func (nsc *NotificationSubscriptionCreate) SetEvents(ue []utils.NotificationEvent) *NotificationSubscriptionCreate {
+	nsc.mutation.SetEvents(ue)
+	return nsc
+}
+
+// SetProvider sets the "provider" field.
+func (nsc *NotificationSubscriptionCreate) SetProvider(up utils.NotificationProvider) *NotificationSubscriptionCreate {
+	nsc.mutation.SetProvider(up)
+	return nsc
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (nsc *NotificationSubscriptionCreate) SetNillableProvider(up *utils.NotificationProvider) *NotificationSubscriptionCreate {
+	if up != nil {
+		nsc.SetProvider(*up)
+	}
+	return nsc
+}
+
+// SetURL sets the "url" field.
+func (nsc *NotificationSubscriptionCreate) SetURL(s string) *NotificationSubscriptionCreate {
+	nsc.mutation.SetURL(s)
+	return nsc
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (nsc *NotificationSubscriptionCreate) SetNillableURL(s *string) *NotificationSubscriptionCreate {
+	if s != nil {
+		nsc.SetURL(*s)
+	}
+	return nsc
+}
+
+// SetToken sets the "token" field.
+func (nsc *NotificationSubscriptionCreate) SetToken(s string) *NotificationSubscriptionCreate {
+	nsc.mutation.SetToken(s)
+	return nsc
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (nsc *NotificationSubscriptionCreate) SetNillableToken(s *string) *NotificationSubscriptionCreate {
+	if s != nil {
+		nsc.SetToken(*s)
+	}
+	return nsc
+}
+
+// SetRoom sets the "room" field.
+func (nsc *NotificationSubscriptionCreate) SetRoom(s string) *NotificationSubscriptionCreate {
+	nsc.mutation.SetRoom(s)
+	return nsc
+}
+
+// SetNillableRoom sets the "room" field if the given value is not nil.
+func (nsc *NotificationSubscriptionCreate) SetNillableRoom(s *string) *NotificationSubscriptionCreate {
+	if s != nil {
+		nsc.SetRoom(*s)
+	}
+	return nsc
+}
+
+// SetTemplate sets the "template" field.
+func (nsc *NotificationSubscriptionCreate) SetTemplate(s string) *NotificationSubscriptionCreate {
+	nsc.mutation.SetTemplate(s)
+	return nsc
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (nsc *NotificationSubscriptionCreate) SetNillableTemplate(s *string) *NotificationSubscriptionCreate {
+	if s != nil {
+		nsc.SetTemplate(*s)
+	}
+	return nsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nsc *NotificationSubscriptionCreate) SetUpdatedAt(t time.Time) *NotificationSubscriptionCreate {
+	nsc.mutation.SetUpdatedAt(t)
+	return nsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (nsc *NotificationSubscriptionCreate) SetNillableUpdatedAt(t *time.Time) *NotificationSubscriptionCreate {
+	if t != nil {
+		nsc.SetUpdatedAt(*t)
+	}
+	return nsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nsc *NotificationSubscriptionCreate) SetCreatedAt(t time.Time) *NotificationSubscriptionCreate {
+	nsc.mutation.SetCreatedAt(t)
+	return nsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nsc *NotificationSubscriptionCreate) SetNillableCreatedAt(t *time.Time) *NotificationSubscriptionCreate {
+	if t != nil {
+		nsc.SetCreatedAt(*t)
+	}
+	return nsc
+}
+
+// SetID sets the "id" field.
+func (nsc *NotificationSubscriptionCreate) SetID(u uuid.UUID) *NotificationSubscriptionCreate {
+	nsc.mutation.SetID(u)
+	return nsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (nsc *NotificationSubscriptionCreate) SetNillableID(u *uuid.UUID) *NotificationSubscriptionCreate {
+	if u != nil {
+		nsc.SetID(*u)
+	}
+	return nsc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (nsc *NotificationSubscriptionCreate) SetUserID(id uuid.UUID) *NotificationSubscriptionCreate {
+	nsc.mutation.SetUserID(id)
+	return nsc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nsc *NotificationSubscriptionCreate) SetUser(u *User) *NotificationSubscriptionCreate {
+	return nsc.SetUserID(u.ID)
+}
+
+// AddChannelIDs adds the "channels" edge to the Channel entity by IDs.
+func (nsc *NotificationSubscriptionCreate) AddChannelIDs(ids ...uuid.UUID) *NotificationSubscriptionCreate {
+	nsc.mutation.AddChannelIDs(ids...)
+	return nsc
+}
+
+// AddChannels adds the "channels" edges to the Channel entity.
+func (nsc *NotificationSubscriptionCreate) AddChannels(c ...*Channel) *NotificationSubscriptionCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return nsc.AddChannelIDs(ids...)
+}
+
+// Mutation returns the NotificationSubscriptionMutation object of the builder.
+func (nsc *NotificationSubscriptionCreate) Mutation() *NotificationSubscriptionMutation {
+	return nsc.mutation
+}
+
+// Save creates the NotificationSubscription in the database.
+func (nsc *NotificationSubscriptionCreate) Save(ctx context.Context) (*NotificationSubscription, error) {
+	nsc.defaults()
+	return withHooks(ctx, nsc.sqlSave, nsc.mutation, nsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nsc *NotificationSubscriptionCreate) SaveX(ctx context.Context) *NotificationSubscription {
+	v, err := nsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nsc *NotificationSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := nsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nsc *NotificationSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := nsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nsc *NotificationSubscriptionCreate) defaults() {
+	if _, ok := nsc.mutation.Enabled(); !ok {
+		v := notificationsubscription.DefaultEnabled
+		nsc.mutation.SetEnabled(v)
+	}
+	if _, ok := nsc.mutation.Provider(); !ok {
+		v := notificationsubscription.DefaultProvider
+		nsc.mutation.SetProvider(v)
+	}
+	if _, ok := nsc.mutation.UpdatedAt(); !ok {
+		v := notificationsubscription.DefaultUpdatedAt()
+		nsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := nsc.mutation.CreatedAt(); !ok {
+		v := notificationsubscription.DefaultCreatedAt()
+		nsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := nsc.mutation.ID(); !ok {
+		v := notificationsubscription.DefaultID()
+		nsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nsc *NotificationSubscriptionCreate) check() error {
+	if _, ok := nsc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "NotificationSubscription.enabled"`)}
+	}
+	if _, ok := nsc.mutation.Events(); !ok {
+		return &ValidationError{Name: "events", err: errors.New(`ent: missing required field "NotificationSubscription.events"`)}
+	}
+	if _, ok := nsc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "NotificationSubscription.provider"`)}
+	}
+	if v, ok := nsc.mutation.Provider(); ok {
+		if err := notificationsubscription.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "NotificationSubscription.provider": %w`, err)}
+		}
+	}
+	if _, ok := nsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationSubscription.updated_at"`)}
+	}
+	if _, ok := nsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationSubscription.created_at"`)}
+	}
+	if _, ok := nsc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "NotificationSubscription.user"`)}
+	}
+	return nil
+}
+
+func (nsc *NotificationSubscriptionCreate) sqlSave(ctx context.Context) (*NotificationSubscription, error) {
+	if err := nsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	nsc.mutation.id = &_node.ID
+	nsc.mutation.done = true
+	return _node, nil
+}
+
+func (nsc *NotificationSubscriptionCreate) createSpec() (*NotificationSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationSubscription{config: nsc.config}
+		_spec = sqlgraph.NewCreateSpec(notificationsubscription.Table, sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = nsc.conflict
+	if id, ok := nsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := nsc.mutation.Name(); ok {
+		_spec.SetField(notificationsubscription.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := nsc.mutation.Enabled(); ok {
+		_spec.SetField(notificationsubscription.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := nsc.mutation.Events(); ok {
+		_spec.SetField(notificationsubscription.FieldEvents, field.TypeJSON, value)
+		_node.Events = value
+	}
+	if value, ok := nsc.mutation.Provider(); ok {
+		_spec.SetField(notificationsubscription.FieldProvider, field.TypeEnum, value)
+		_node.Provider = value
+	}
+	if value, ok := nsc.mutation.URL(); ok {
+		_spec.SetField(notificationsubscription.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := nsc.mutation.Token(); ok {
+		_spec.SetField(notificationsubscription.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := nsc.mutation.Room(); ok {
+		_spec.SetField(notificationsubscription.FieldRoom, field.TypeString, value)
+		_node.Room = value
+	}
+	if value, ok := nsc.mutation.Template(); ok {
+		_spec.SetField(notificationsubscription.FieldTemplate, field.TypeString, value)
+		_node.Template = value
+	}
+	if value, ok := nsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationsubscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := nsc.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := nsc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.UserTable,
+			Columns: []string{notificationsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_notification_subscriptions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nsc.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationsubscription.ChannelsTable,
+			Columns: []string{notificationsubscription.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationSubscription.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationSubscriptionUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (nsc *NotificationSubscriptionCreate) OnConflict(opts ...sql.ConflictOption) *NotificationSubscriptionUpsertOne {
+	nsc.conflict = opts
+	return &NotificationSubscriptionUpsertOne{
+		create: nsc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nsc *NotificationSubscriptionCreate) OnConflictColumns(columns ...string) *NotificationSubscriptionUpsertOne {
+	nsc.conflict = append(nsc.conflict, sql.ConflictColumns(columns...))
+	return &NotificationSubscriptionUpsertOne{
+		create: nsc,
+	}
+}
+
+type (
+	// NotificationSubscriptionUpsertOne is the builder for "upsert"-ing
+	//  one NotificationSubscription node.
+	NotificationSubscriptionUpsertOne struct {
+		create *NotificationSubscriptionCreate
+	}
+
+	// NotificationSubscriptionUpsert is the "OnConflict" setter.
+	NotificationSubscriptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *NotificationSubscriptionUpsert) SetName(v string) *NotificationSubscriptionUpsert {
+	u.Set(notificationsubscription.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsert) UpdateName() *NotificationSubscriptionUpsert {
+	u.SetExcluded(notificationsubscription.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *NotificationSubscriptionUpsert) ClearName() *NotificationSubscriptionUpsert {
+	u.SetNull(notificationsubscription.FieldName)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *NotificationSubscriptionUpsert) SetEnabled(v bool) *NotificationSubscriptionUpsert {
+	u.Set(notificationsubscription.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsert) UpdateEnabled() *NotificationSubscriptionUpsert {
+	u.SetExcluded(notificationsubscription.FieldEnabled)
+	return u
+}
+
+// SetEvents sets the "events" field.
+func (u *NotificationSubscriptionUpsert) SetEvents(v []utils.NotificationEvent) *NotificationSubscriptionUpsert {
+	u.Set(notificationsubscription.FieldEvents, v)
+	return u
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsert) UpdateEvents() *NotificationSubscriptionUpsert {
+	u.SetExcluded(notificationsubscription.FieldEvents)
+	return u
+}
+
+// SetProvider sets the "provider" field.
+func (u *NotificationSubscriptionUpsert) SetProvider(v utils.NotificationProvider) *NotificationSubscriptionUpsert {
+	u.Set(notificationsubscription.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsert) UpdateProvider() *NotificationSubscriptionUpsert {
+	u.SetExcluded(notificationsubscription.FieldProvider)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *NotificationSubscriptionUpsert) SetURL(v string) *NotificationSubscriptionUpsert {
+	u.Set(notificationsubscription.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsert) UpdateURL() *NotificationSubscriptionUpsert {
+	u.SetExcluded(notificationsubscription.FieldURL)
+	return u
+}
+
+// ClearURL clears the value of the "url" field.
+func (u *NotificationSubscriptionUpsert) ClearURL() *NotificationSubscriptionUpsert {
+	u.SetNull(notificationsubscription.FieldURL)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *NotificationSubscriptionUpsert) SetToken(v string) *NotificationSubscriptionUpsert {
+	u.Set(notificationsubscription.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsert) UpdateToken() *NotificationSubscriptionUpsert {
+	u.SetExcluded(notificationsubscription.FieldToken)
+	return u
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *NotificationSubscriptionUpsert) ClearToken() *NotificationSubscriptionUpsert {
+	u.SetNull(notificationsubscription.FieldToken)
+	return u
+}
+
+// SetRoom sets the "room" field.
+func (u *NotificationSubscriptionUpsert) SetRoom(v string) *NotificationSubscriptionUpsert {
+	u.Set(notificationsubscription.FieldRoom, v)
+	return u
+}
+
+// UpdateRoom sets the "room" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsert) UpdateRoom() *NotificationSubscriptionUpsert {
+	u.SetExcluded(notificationsubscription.FieldRoom)
+	return u
+}
+
+// ClearRoom clears the value of the "room" field.
+func (u *NotificationSubscriptionUpsert) ClearRoom() *NotificationSubscriptionUpsert {
+	u.SetNull(notificationsubscription.FieldRoom)
+	return u
+}
+
+// SetTemplate sets the "template" field.
+func (u *NotificationSubscriptionUpsert) SetTemplate(v string) *NotificationSubscriptionUpsert {
+	u.Set(notificationsubscription.FieldTemplate, v)
+	return u
+}
+
+// UpdateTemplate sets the "template" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsert) UpdateTemplate() *NotificationSubscriptionUpsert {
+	u.SetExcluded(notificationsubscription.FieldTemplate)
+	return u
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (u *NotificationSubscriptionUpsert) ClearTemplate() *NotificationSubscriptionUpsert {
+	u.SetNull(notificationsubscription.FieldTemplate)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationSubscriptionUpsert) SetUpdatedAt(v time.Time) *NotificationSubscriptionUpsert {
+	u.Set(notificationsubscription.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsert) UpdateUpdatedAt() *NotificationSubscriptionUpsert {
+	u.SetExcluded(notificationsubscription.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.NotificationSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationsubscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationSubscriptionUpsertOne) UpdateNewValues() *NotificationSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(notificationsubscription.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(notificationsubscription.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationSubscription.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NotificationSubscriptionUpsertOne) Ignore() *NotificationSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationSubscriptionUpsertOne) DoNothing() *NotificationSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationSubscriptionCreate.OnConflict
+// documentation for more info.
+func (u *NotificationSubscriptionUpsertOne) Update(set func(*NotificationSubscriptionUpsert)) *NotificationSubscriptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NotificationSubscriptionUpsertOne) SetName(v string) *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertOne) UpdateName() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *NotificationSubscriptionUpsertOne) ClearName() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *NotificationSubscriptionUpsertOne) SetEnabled(v bool) *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertOne) UpdateEnabled() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetEvents sets the "events" field.
+func (u *NotificationSubscriptionUpsertOne) SetEvents(v []utils.NotificationEvent) *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertOne) UpdateEvents() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *NotificationSubscriptionUpsertOne) SetProvider(v utils.NotificationProvider) *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertOne) UpdateProvider() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *NotificationSubscriptionUpsertOne) SetURL(v string) *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertOne) UpdateURL() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// ClearURL clears the value of the "url" field.
+func (u *NotificationSubscriptionUpsertOne) ClearURL() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.ClearURL()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *NotificationSubscriptionUpsertOne) SetToken(v string) *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertOne) UpdateToken() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *NotificationSubscriptionUpsertOne) ClearToken() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.ClearToken()
+	})
+}
+
+// SetRoom sets the "room" field.
+func (u *NotificationSubscriptionUpsertOne) SetRoom(v string) *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetRoom(v)
+	})
+}
+
+// UpdateRoom sets the "room" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertOne) UpdateRoom() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateRoom()
+	})
+}
+
+// ClearRoom clears the value of the "room" field.
+func (u *NotificationSubscriptionUpsertOne) ClearRoom() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.ClearRoom()
+	})
+}
+
+// SetTemplate sets the "template" field.
+func (u *NotificationSubscriptionUpsertOne) SetTemplate(v string) *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetTemplate(v)
+	})
+}
+
+// UpdateTemplate sets the "template" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertOne) UpdateTemplate() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateTemplate()
+	})
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (u *NotificationSubscriptionUpsertOne) ClearTemplate() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.ClearTemplate()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationSubscriptionUpsertOne) SetUpdatedAt(v time.Time) *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertOne) UpdateUpdatedAt() *NotificationSubscriptionUpsertOne {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationSubscriptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationSubscriptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationSubscriptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NotificationSubscriptionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: NotificationSubscriptionUpsertOne.ID is not supported by MySQL driver. Use NotificationSubscriptionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NotificationSubscriptionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NotificationSubscriptionCreateBulk is the builder for creating many NotificationSubscription entities in bulk.
+type NotificationSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationSubscriptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NotificationSubscription entities in the database.
+func (nscb *NotificationSubscriptionCreateBulk) Save(ctx context.Context) ([]*NotificationSubscription, error) {
+	if nscb.err != nil {
+		return nil, nscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(nscb.builders))
+	nodes := make([]*NotificationSubscription, len(nscb.builders))
+	mutators := make([]Mutator, len(nscb.builders))
+	for i := range nscb.builders {
+		func(i int, root context.Context) {
+			builder := nscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = nscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nscb *NotificationSubscriptionCreateBulk) SaveX(ctx context.Context) []*NotificationSubscription {
+	v, err := nscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nscb *NotificationSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := nscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nscb *NotificationSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := nscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NotificationSubscription.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NotificationSubscriptionUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (nscb *NotificationSubscriptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *NotificationSubscriptionUpsertBulk {
+	nscb.conflict = opts
+	return &NotificationSubscriptionUpsertBulk{
+		create: nscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NotificationSubscription.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nscb *NotificationSubscriptionCreateBulk) OnConflictColumns(columns ...string) *NotificationSubscriptionUpsertBulk {
+	nscb.conflict = append(nscb.conflict, sql.ConflictColumns(columns...))
+	return &NotificationSubscriptionUpsertBulk{
+		create: nscb,
+	}
+}
+
+// NotificationSubscriptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of NotificationSubscription nodes.
+type NotificationSubscriptionUpsertBulk struct {
+	create *NotificationSubscriptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NotificationSubscription.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(notificationsubscription.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *NotificationSubscriptionUpsertBulk) UpdateNewValues() *NotificationSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(notificationsubscription.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(notificationsubscription.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NotificationSubscription.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NotificationSubscriptionUpsertBulk) Ignore() *NotificationSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NotificationSubscriptionUpsertBulk) DoNothing() *NotificationSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NotificationSubscriptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *NotificationSubscriptionUpsertBulk) Update(set func(*NotificationSubscriptionUpsert)) *NotificationSubscriptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NotificationSubscriptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *NotificationSubscriptionUpsertBulk) SetName(v string) *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertBulk) UpdateName() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *NotificationSubscriptionUpsertBulk) ClearName() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *NotificationSubscriptionUpsertBulk) SetEnabled(v bool) *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertBulk) UpdateEnabled() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetEvents sets the "events" field.
+func (u *NotificationSubscriptionUpsertBulk) SetEvents(v []utils.NotificationEvent) *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetEvents(v)
+	})
+}
+
+// UpdateEvents sets the "events" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertBulk) UpdateEvents() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateEvents()
+	})
+}
+
+// SetProvider sets the "provider" field.
+func (u *NotificationSubscriptionUpsertBulk) SetProvider(v utils.NotificationProvider) *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetProvider(v)
+	})
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertBulk) UpdateProvider() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateProvider()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *NotificationSubscriptionUpsertBulk) SetURL(v string) *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertBulk) UpdateURL() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// ClearURL clears the value of the "url" field.
+func (u *NotificationSubscriptionUpsertBulk) ClearURL() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.ClearURL()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *NotificationSubscriptionUpsertBulk) SetToken(v string) *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertBulk) UpdateToken() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *NotificationSubscriptionUpsertBulk) ClearToken() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.ClearToken()
+	})
+}
+
+// SetRoom sets the "room" field.
+func (u *NotificationSubscriptionUpsertBulk) SetRoom(v string) *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetRoom(v)
+	})
+}
+
+// UpdateRoom sets the "room" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertBulk) UpdateRoom() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateRoom()
+	})
+}
+
+// ClearRoom clears the value of the "room" field.
+func (u *NotificationSubscriptionUpsertBulk) ClearRoom() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.ClearRoom()
+	})
+}
+
+// SetTemplate sets the "template" field.
+func (u *NotificationSubscriptionUpsertBulk) SetTemplate(v string) *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetTemplate(v)
+	})
+}
+
+// UpdateTemplate sets the "template" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertBulk) UpdateTemplate() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateTemplate()
+	})
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (u *NotificationSubscriptionUpsertBulk) ClearTemplate() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.ClearTemplate()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *NotificationSubscriptionUpsertBulk) SetUpdatedAt(v time.Time) *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *NotificationSubscriptionUpsertBulk) UpdateUpdatedAt() *NotificationSubscriptionUpsertBulk {
+	return u.Update(func(s *NotificationSubscriptionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NotificationSubscriptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NotificationSubscriptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NotificationSubscriptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NotificationSubscriptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/predicate"
+)
+
+// NotificationSubscriptionDelete is the builder for deleting a NotificationSubscription entity.
+type NotificationSubscriptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *NotificationSubscriptionMutation
+}
+
+// Where appends a list predicates to the NotificationSubscriptionDelete builder.
+func (nsd *NotificationSubscriptionDelete) Where(ps ...predicate.NotificationSubscription) *NotificationSubscriptionDelete {
+	nsd.mutation.Where(ps...)
+	return nsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nsd *NotificationSubscriptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, nsd.sqlExec, nsd.mutation, nsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nsd *NotificationSubscriptionDelete) ExecX(ctx context.Context) int {
+	n, err := nsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nsd *NotificationSubscriptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(notificationsubscription.Table, sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID))
+	if ps := nsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, nsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	nsd.mutation.done = true
+	return affected, err
+}
+
+// NotificationSubscriptionDeleteOne is the builder for deleting a single NotificationSubscription entity.
+type NotificationSubscriptionDeleteOne struct {
+	nsd *NotificationSubscriptionDelete
+}
+
+// Where appends a list predicates to the NotificationSubscriptionDelete builder.
+func (nsdo *NotificationSubscriptionDeleteOne) Where(ps ...predicate.NotificationSubscription) *NotificationSubscriptionDeleteOne {
+	nsdo.nsd.mutation.Where(ps...)
+	return nsdo
+}
+
+// Exec executes the deletion query.
+func (nsdo *NotificationSubscriptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := nsdo.nsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{notificationsubscription.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nsdo *NotificationSubscriptionDeleteOne) ExecX(ctx context.Context) {
+	if err := nsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/user"
+)
+
+// NotificationSubscriptionQuery is the builder for querying NotificationSubscription entities.
+type NotificationSubscriptionQuery struct {
+	config
+	ctx          *QueryContext
+	order        []notificationsubscription.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.NotificationSubscription
+	withUser     *UserQuery
+	withChannels *ChannelQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NotificationSubscriptionQuery builder.
+func (nsq *NotificationSubscriptionQuery) Where(ps ...predicate.NotificationSubscription) *NotificationSubscriptionQuery {
+	nsq.predicates = append(nsq.predicates, ps...)
+	return nsq
+}
+
+// Limit the number of records to be returned by this query.
+func (nsq *NotificationSubscriptionQuery) Limit(limit int) *NotificationSubscriptionQuery {
+	nsq.ctx.Limit = &limit
+	return nsq
+}
+
+// Offset to start from.
+func (nsq *NotificationSubscriptionQuery) Offset(offset int) *NotificationSubscriptionQuery {
+	nsq.ctx.Offset = &offset
+	return nsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (nsq *NotificationSubscriptionQuery) Unique(unique bool) *NotificationSubscriptionQuery {
+	nsq.ctx.Unique = &unique
+	return nsq
+}
+
+// Order specifies how the records should be ordered.
+func (nsq *NotificationSubscriptionQuery) Order(o ...notificationsubscription.OrderOption) *NotificationSubscriptionQuery {
+	nsq.order = append(nsq.order, o...)
+	return nsq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (nsq *NotificationSubscriptionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: nsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationsubscription.Table, notificationsubscription.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, notificationsubscription.UserTable, notificationsubscription.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChannels chains the current query on the "channels" edge.
+func (nsq *NotificationSubscriptionQuery) QueryChannels() *ChannelQuery {
+	query := (&ChannelClient{config: nsq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := nsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := nsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(notificationsubscription.Table, notificationsubscription.FieldID, selector),
+			sqlgraph.To(channel.Table, channel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, notificationsubscription.ChannelsTable, notificationsubscription.ChannelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(nsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first NotificationSubscription entity from the query.
+// Returns a *NotFoundError when no NotificationSubscription was found.
+func (nsq *NotificationSubscriptionQuery) First(ctx context.Context) (*NotificationSubscription, error) {
+	nodes, err := nsq.Limit(1).All(setContextOp(ctx, nsq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{notificationsubscription.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nsq *NotificationSubscriptionQuery) FirstX(ctx context.Context) *NotificationSubscription {
+	node, err := nsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NotificationSubscription ID from the query.
+// Returns a *NotFoundError when no NotificationSubscription ID was found.
+func (nsq *NotificationSubscriptionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = nsq.Limit(1).IDs(setContextOp(ctx, nsq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{notificationsubscription.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (nsq *NotificationSubscriptionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := nsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NotificationSubscription entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NotificationSubscription entity is found.
+// Returns a *NotFoundError when no NotificationSubscription entities are found.
+func (nsq *NotificationSubscriptionQuery) Only(ctx context.Context) (*NotificationSubscription, error) {
+	nodes, err := nsq.Limit(2).All(setContextOp(ctx, nsq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{notificationsubscription.Label}
+	default:
+		return nil, &NotSingularError{notificationsubscription.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nsq *NotificationSubscriptionQuery) OnlyX(ctx context.Context) *NotificationSubscription {
+	node, err := nsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NotificationSubscription ID in the query.
+// Returns a *NotSingularError when more than one NotificationSubscription ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (nsq *NotificationSubscriptionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = nsq.Limit(2).IDs(setContextOp(ctx, nsq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{notificationsubscription.Label}
+	default:
+		err = &NotSingularError{notificationsubscription.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (nsq *NotificationSubscriptionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := nsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NotificationSubscriptions.
+func (nsq *NotificationSubscriptionQuery) All(ctx context.Context) ([]*NotificationSubscription, error) {
+	ctx = setContextOp(ctx, nsq.ctx, "All")
+	if err := nsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NotificationSubscription, *NotificationSubscriptionQuery]()
+	return withInterceptors[[]*NotificationSubscription](ctx, nsq, qr, nsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nsq *NotificationSubscriptionQuery) AllX(ctx context.Context) []*NotificationSubscription {
+	nodes, err := nsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NotificationSubscription IDs.
+func (nsq *NotificationSubscriptionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if nsq.ctx.Unique == nil && nsq.path != nil {
+		nsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, nsq.ctx, "IDs")
+	if err = nsq.Select(notificationsubscription.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nsq *NotificationSubscriptionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := nsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nsq *NotificationSubscriptionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, nsq.ctx, "Count")
+	if err := nsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, nsq, querierCount[*NotificationSubscriptionQuery](), nsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nsq *NotificationSubscriptionQuery) CountX(ctx context.Context) int {
+	count, err := nsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nsq *NotificationSubscriptionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, nsq.ctx, "Exist")
+	switch _, err := nsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nsq *NotificationSubscriptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := nsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NotificationSubscriptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nsq *NotificationSubscriptionQuery) Clone() *NotificationSubscriptionQuery {
+	if nsq == nil {
+		return nil
+	}
+	return &NotificationSubscriptionQuery{
+		config:       nsq.config,
+		ctx:          nsq.ctx.Clone(),
+		order:        append([]notificationsubscription.OrderOption{}, nsq.order...),
+		inters:       append([]Interceptor{}, nsq.inters...),
+		predicates:   append([]predicate.NotificationSubscription{}, nsq.predicates...),
+		withUser:     nsq.withUser.Clone(),
+		withChannels: nsq.withChannels.Clone(),
+		// clone intermediate query.
+		sql:  nsq.sql.Clone(),
+		path: nsq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (nsq *NotificationSubscriptionQuery) WithUser(opts ...func(*UserQuery)) *NotificationSubscriptionQuery {
+	query := (&UserClient{config: nsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nsq.withUser = query
+	return nsq
+}
+
+// WithChannels tells the query-builder to eager-load the nodes that are connected to
+// the "channels" edge. The optional arguments are used to configure the query builder of the edge.
+func (nsq *NotificationSubscriptionQuery) WithChannels(opts ...func(*ChannelQuery)) *NotificationSubscriptionQuery {
+	query := (&ChannelClient{config: nsq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	nsq.withChannels = query
+	return nsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NotificationSubscription.Query().
+//		GroupBy(notificationsubscription.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (nsq *NotificationSubscriptionQuery) GroupBy(field string, fields ...string) *NotificationSubscriptionGroupBy {
+	nsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NotificationSubscriptionGroupBy{build: nsq}
+	grbuild.flds = &nsq.ctx.Fields
+	grbuild.label = notificationsubscription.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.NotificationSubscription.Query().
+//		Select(notificationsubscription.FieldName).
+//		Scan(ctx, &v)
+func (nsq *NotificationSubscriptionQuery) Select(fields ...string) *NotificationSubscriptionSelect {
+	nsq.ctx.Fields = append(nsq.ctx.Fields, fields...)
+	sbuild := &NotificationSubscriptionSelect{NotificationSubscriptionQuery: nsq}
+	sbuild.label = notificationsubscription.Label
+	sbuild.flds, sbuild.scan = &nsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NotificationSubscriptionSelect configured with the given aggregations.
+func (nsq *NotificationSubscriptionQuery) Aggregate(fns ...AggregateFunc) *NotificationSubscriptionSelect {
+	return nsq.Select().Aggregate(fns...)
+}
+
+func (nsq *NotificationSubscriptionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range nsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, nsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range nsq.ctx.Fields {
+		if !notificationsubscription.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if nsq.path != nil {
+		prev, err := nsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nsq.sql = prev
+	}
+	return nil
+}
+
+func (nsq *NotificationSubscriptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NotificationSubscription, error) {
+	var (
+		nodes       = []*NotificationSubscription{}
+		withFKs     = nsq.withFKs
+		_spec       = nsq.querySpec()
+		loadedTypes = [2]bool{
+			nsq.withUser != nil,
+			nsq.withChannels != nil,
+		}
+	)
+	if nsq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationsubscription.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NotificationSubscription).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NotificationSubscription{config: nsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := nsq.withUser; query != nil {
+		if err := nsq.loadUser(ctx, query, nodes, nil,
+			func(n *NotificationSubscription, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := nsq.withChannels; query != nil {
+		if err := nsq.loadChannels(ctx, query, nodes,
+			func(n *NotificationSubscription) { n.Edges.Channels = []*Channel{} },
+			func(n *NotificationSubscription, e *Channel) { n.Edges.Channels = append(n.Edges.Channels, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (nsq *NotificationSubscriptionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*NotificationSubscription, init func(*NotificationSubscription), assign func(*NotificationSubscription, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*NotificationSubscription)
+	for i := range nodes {
+		if nodes[i].user_notification_subscriptions == nil {
+			continue
+		}
+		fk := *nodes[i].user_notification_subscriptions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_notification_subscriptions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (nsq *NotificationSubscriptionQuery) loadChannels(ctx context.Context, query *ChannelQuery, nodes []*NotificationSubscription, init func(*NotificationSubscription), assign func(*NotificationSubscription, *Channel)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*NotificationSubscription)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Channel(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(notificationsubscription.ChannelsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.notification_subscription_channels
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "notification_subscription_channels" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "notification_subscription_channels" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (nsq *NotificationSubscriptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := nsq.querySpec()
+	_spec.Node.Columns = nsq.ctx.Fields
+	if len(nsq.ctx.Fields) > 0 {
+		_spec.Unique = nsq.ctx.Unique != nil && *nsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, nsq.driver, _spec)
+}
+
+func (nsq *NotificationSubscriptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(notificationsubscription.Table, notificationsubscription.Columns, sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID))
+	_spec.From = nsq.sql
+	if unique := nsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if nsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := nsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationsubscription.FieldID)
+		for i := range fields {
+			if fields[i] != notificationsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := nsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nsq *NotificationSubscriptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(nsq.driver.Dialect())
+	t1 := builder.Table(notificationsubscription.Table)
+	columns := nsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = notificationsubscription.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if nsq.sql != nil {
+		selector = nsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if nsq.ctx.Unique != nil && *nsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range nsq.predicates {
+		p(selector)
+	}
+	for _, p := range nsq.order {
+		p(selector)
+	}
+	if offset := nsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NotificationSubscriptionGroupBy is the group-by builder for NotificationSubscription entities.
+type NotificationSubscriptionGroupBy struct {
+	selector
+	build *NotificationSubscriptionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (nsgb *NotificationSubscriptionGroupBy) Aggregate(fns ...AggregateFunc) *NotificationSubscriptionGroupBy {
+	nsgb.fns = append(nsgb.fns, fns...)
+	return nsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nsgb *NotificationSubscriptionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nsgb.build.ctx, "GroupBy")
+	if err := nsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NotificationSubscriptionQuery, *NotificationSubscriptionGroupBy](ctx, nsgb.build, nsgb, nsgb.build.inters, v)
+}
+
+func (nsgb *NotificationSubscriptionGroupBy) sqlScan(ctx context.Context, root *NotificationSubscriptionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(nsgb.fns))
+	for _, fn := range nsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*nsgb.flds)+len(nsgb.fns))
+		for _, f := range *nsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*nsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NotificationSubscriptionSelect is the builder for selecting fields of NotificationSubscription entities.
+type NotificationSubscriptionSelect struct {
+	*NotificationSubscriptionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nss *NotificationSubscriptionSelect) Aggregate(fns ...AggregateFunc) *NotificationSubscriptionSelect {
+	nss.fns = append(nss.fns, fns...)
+	return nss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nss *NotificationSubscriptionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nss.ctx, "Select")
+	if err := nss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NotificationSubscriptionQuery, *NotificationSubscriptionSelect](ctx, nss.NotificationSubscriptionQuery, nss, nss.inters, v)
+}
+
+func (nss *NotificationSubscriptionSelect) sqlScan(ctx context.Context, root *NotificationSubscriptionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nss.fns))
+	for _, fn := range nss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,871 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationSubscriptionUpdate is the builder for updating NotificationSubscription entities.
+type NotificationSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationSubscriptionMutation
+}
+
+// Where appends a list predicates to the NotificationSubscriptionUpdate builder.
+func (nsu *NotificationSubscriptionUpdate) Where(ps ...predicate.NotificationSubscription) *NotificationSubscriptionUpdate {
+	nsu.mutation.Where(ps...)
+	return nsu
+}
+
+// SetName sets the "name" field.
+func (nsu *NotificationSubscriptionUpdate) SetName(s string) *NotificationSubscriptionUpdate {
+	nsu.mutation.SetName(s)
+	return nsu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nsu *NotificationSubscriptionUpdate) SetNillableName(s *string) *NotificationSubscriptionUpdate {
+	if s != nil {
+		nsu.SetName(*s)
+	}
+	return nsu
+}
+
+// ClearName clears the value of the "name" field.
+func (nsu *NotificationSubscriptionUpdate) ClearName() *NotificationSubscriptionUpdate {
+	nsu.mutation.ClearName()
+	return nsu
+}
+
+// SetEnabled sets the "enabled" field.
+func (nsu *NotificationSubscriptionUpdate) SetEnabled(b bool) *NotificationSubscriptionUpdate {
+	nsu.mutation.SetEnabled(b)
+	return nsu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (nsu *NotificationSubscriptionUpdate) SetNillableEnabled(b *bool) *NotificationSubscriptionUpdate {
+	if b != nil {
+		nsu.SetEnabled(*b)
+	}
+	return nsu
+}
+
+// SetEvents sets the "events" field.
+func (nsu *NotificationSubscriptionUpdate) SetEvents(ue []utils.NotificationEvent) *NotificationSubscriptionUpdate {
+	nsu.mutation.SetEvents(ue)
+	return nsu
+}
+
+// AppendEvents appends ue to the "events" field.
+func (nsu *NotificationSubscriptionUpdate) AppendEvents(ue []utils.NotificationEvent) *NotificationSubscriptionUpdate {
+	nsu.mutation.AppendEvents(ue)
+	return nsu
+}
+
+// SetProvider sets the "provider" field.
+func (nsu *NotificationSubscriptionUpdate) SetProvider(up utils.NotificationProvider) *NotificationSubscriptionUpdate {
+	nsu.mutation.SetProvider(up)
+	return nsu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (nsu *NotificationSubscriptionUpdate) SetNillableProvider(up *utils.NotificationProvider) *NotificationSubscriptionUpdate {
+	if up != nil {
+		nsu.SetProvider(*up)
+	}
+	return nsu
+}
+
+// SetURL sets the "url" field.
+func (nsu *NotificationSubscriptionUpdate) SetURL(s string) *NotificationSubscriptionUpdate {
+	nsu.mutation.SetURL(s)
+	return nsu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (nsu *NotificationSubscriptionUpdate) SetNillableURL(s *string) *NotificationSubscriptionUpdate {
+	if s != nil {
+		nsu.SetURL(*s)
+	}
+	return nsu
+}
+
+// ClearURL clears the value of the "url" field.
+func (nsu *NotificationSubscriptionUpdate) ClearURL() *NotificationSubscriptionUpdate {
+	nsu.mutation.ClearURL()
+	return nsu
+}
+
+// SetToken sets the "token" field.
+func (nsu *NotificationSubscriptionUpdate) SetToken(s string) *NotificationSubscriptionUpdate {
+	nsu.mutation.SetToken(s)
+	return nsu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (nsu *NotificationSubscriptionUpdate) SetNillableToken(s *string) *NotificationSubscriptionUpdate {
+	if s != nil {
+		nsu.SetToken(*s)
+	}
+	return nsu
+}
+
+// ClearToken clears the value of the "token" field.
+func (nsu *NotificationSubscriptionUpdate) ClearToken() *NotificationSubscriptionUpdate {
+	nsu.mutation.ClearToken()
+	return nsu
+}
+
+// SetRoom sets the "room" field.
+func (nsu *NotificationSubscriptionUpdate) SetRoom(s string) *NotificationSubscriptionUpdate {
+	nsu.mutation.SetRoom(s)
+	return nsu
+}
+
+// SetNillableRoom sets the "room" field if the given value is not nil.
+func (nsu *NotificationSubscriptionUpdate) SetNillableRoom(s *string) *NotificationSubscriptionUpdate {
+	if s != nil {
+		nsu.SetRoom(*s)
+	}
+	return nsu
+}
+
+// ClearRoom clears the value of the "room" field.
+func (nsu *NotificationSubscriptionUpdate) ClearRoom() *NotificationSubscriptionUpdate {
+	nsu.mutation.ClearRoom()
+	return nsu
+}
+
+// SetTemplate sets the "template" field.
+func (nsu *NotificationSubscriptionUpdate) SetTemplate(s string) *NotificationSubscriptionUpdate {
+	nsu.mutation.SetTemplate(s)
+	return nsu
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (nsu *NotificationSubscriptionUpdate) SetNillableTemplate(s *string) *NotificationSubscriptionUpdate {
+	if s != nil {
+		nsu.SetTemplate(*s)
+	}
+	return nsu
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (nsu *NotificationSubscriptionUpdate) ClearTemplate() *NotificationSubscriptionUpdate {
+	nsu.mutation.ClearTemplate()
+	return nsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nsu *NotificationSubscriptionUpdate) SetUpdatedAt(t time.Time) *NotificationSubscriptionUpdate {
+	nsu.mutation.SetUpdatedAt(t)
+	return nsu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (nsu *NotificationSubscriptionUpdate) SetUserID(id uuid.UUID) *NotificationSubscriptionUpdate {
+	nsu.mutation.SetUserID(id)
+	return nsu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nsu *NotificationSubscriptionUpdate) SetUser(u *User) *NotificationSubscriptionUpdate {
+	return nsu.SetUserID(u.ID)
+}
+
+// AddChannelIDs adds the "channels" edge to the Channel entity by IDs.
+func (nsu *NotificationSubscriptionUpdate) AddChannelIDs(ids ...uuid.UUID) *NotificationSubscriptionUpdate {
+	nsu.mutation.AddChannelIDs(ids...)
+	return nsu
+}
+
+// AddChannels adds the "channels" edges to the Channel entity.
+func (nsu *NotificationSubscriptionUpdate) AddChannels(c ...*Channel) *NotificationSubscriptionUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return nsu.AddChannelIDs(ids...)
+}
+
+// Mutation returns the NotificationSubscriptionMutation object of the builder.
+func (nsu *NotificationSubscriptionUpdate) Mutation() *NotificationSubscriptionMutation {
+	return nsu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (nsu *NotificationSubscriptionUpdate) ClearUser() *NotificationSubscriptionUpdate {
+	nsu.mutation.ClearUser()
+	return nsu
+}
+
+// ClearChannels clears all "channels" edges to the Channel entity.
+func (nsu *NotificationSubscriptionUpdate) ClearChannels() *NotificationSubscriptionUpdate {
+	nsu.mutation.ClearChannels()
+	return nsu
+}
+
+// RemoveChannelIDs removes the "channels" edge to Channel entities by IDs.
+func (nsu *NotificationSubscriptionUpdate) RemoveChannelIDs(ids ...uuid.UUID) *NotificationSubscriptionUpdate {
+	nsu.mutation.RemoveChannelIDs(ids...)
+	return nsu
+}
+
+// RemoveChannels removes "channels" edges to Channel entities.
+func (nsu *NotificationSubscriptionUpdate) RemoveChannels(c ...*Channel) *NotificationSubscriptionUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return nsu.RemoveChannelIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nsu *NotificationSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	nsu.defaults()
+	return withHooks(ctx, nsu.sqlSave, nsu.mutation, nsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nsu *NotificationSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := nsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nsu *NotificationSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := nsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nsu *NotificationSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := nsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nsu *NotificationSubscriptionUpdate) defaults() {
+	if _, ok := nsu.mutation.UpdatedAt(); !ok {
+		v := notificationsubscription.UpdateDefaultUpdatedAt()
+		nsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nsu *NotificationSubscriptionUpdate) check() error {
+	if v, ok := nsu.mutation.Provider(); ok {
+		if err := notificationsubscription.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "NotificationSubscription.provider": %w`, err)}
+		}
+	}
+	if _, ok := nsu.mutation.UserID(); nsu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationSubscription.user"`)
+	}
+	return nil
+}
+
+func (nsu *NotificationSubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationsubscription.Table, notificationsubscription.Columns, sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID))
+	if ps := nsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nsu.mutation.Name(); ok {
+		_spec.SetField(notificationsubscription.FieldName, field.TypeString, value)
+	}
+	if nsu.mutation.NameCleared() {
+		_spec.ClearField(notificationsubscription.FieldName, field.TypeString)
+	}
+	if value, ok := nsu.mutation.Enabled(); ok {
+		_spec.SetField(notificationsubscription.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := nsu.mutation.Events(); ok {
+		_spec.SetField(notificationsubscription.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := nsu.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notificationsubscription.FieldEvents, value)
+		})
+	}
+	if value, ok := nsu.mutation.Provider(); ok {
+		_spec.SetField(notificationsubscription.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := nsu.mutation.URL(); ok {
+		_spec.SetField(notificationsubscription.FieldURL, field.TypeString, value)
+	}
+	if nsu.mutation.URLCleared() {
+		_spec.ClearField(notificationsubscription.FieldURL, field.TypeString)
+	}
+	if value, ok := nsu.mutation.Token(); ok {
+		_spec.SetField(notificationsubscription.FieldToken, field.TypeString, value)
+	}
+	if nsu.mutation.TokenCleared() {
+		_spec.ClearField(notificationsubscription.FieldToken, field.TypeString)
+	}
+	if value, ok := nsu.mutation.Room(); ok {
+		_spec.SetField(notificationsubscription.FieldRoom, field.TypeString, value)
+	}
+	if nsu.mutation.RoomCleared() {
+		_spec.ClearField(notificationsubscription.FieldRoom, field.TypeString)
+	}
+	if value, ok := nsu.mutation.Template(); ok {
+		_spec.SetField(notificationsubscription.FieldTemplate, field.TypeString, value)
+	}
+	if nsu.mutation.TemplateCleared() {
+		_spec.ClearField(notificationsubscription.FieldTemplate, field.TypeString)
+	}
+	if value, ok := nsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nsu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.UserTable,
+			Columns: []string{notificationsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nsu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.UserTable,
+			Columns: []string{notificationsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nsu.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationsubscription.ChannelsTable,
+			Columns: []string{notificationsubscription.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nsu.mutation.RemovedChannelsIDs(); len(nodes) > 0 && !nsu.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationsubscription.ChannelsTable,
+			Columns: []string{notificationsubscription.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nsu.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationsubscription.ChannelsTable,
+			Columns: []string{notificationsubscription.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nsu.mutation.done = true
+	return n, nil
+}
+
+// NotificationSubscriptionUpdateOne is the builder for updating a single NotificationSubscription entity.
+type NotificationSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationSubscriptionMutation
+}
+
+// SetName sets the "name" field.
+func (nsuo *NotificationSubscriptionUpdateOne) SetName(s string) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.SetName(s)
+	return nsuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (nsuo *NotificationSubscriptionUpdateOne) SetNillableName(s *string) *NotificationSubscriptionUpdateOne {
+	if s != nil {
+		nsuo.SetName(*s)
+	}
+	return nsuo
+}
+
+// ClearName clears the value of the "name" field.
+func (nsuo *NotificationSubscriptionUpdateOne) ClearName() *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.ClearName()
+	return nsuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (nsuo *NotificationSubscriptionUpdateOne) SetEnabled(b bool) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.SetEnabled(b)
+	return nsuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (nsuo *NotificationSubscriptionUpdateOne) SetNillableEnabled(b *bool) *NotificationSubscriptionUpdateOne {
+	if b != nil {
+		nsuo.SetEnabled(*b)
+	}
+	return nsuo
+}
+
+// SetEvents sets the "events" field.
+func (nsuo *NotificationSubscriptionUpdateOne) SetEvents(ue []utils.NotificationEvent) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.SetEvents(ue)
+	return nsuo
+}
+
+// AppendEvents appends ue to the "events" field.
+func (nsuo *NotificationSubscriptionUpdateOne) AppendEvents(ue []utils.NotificationEvent) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.AppendEvents(ue)
+	return nsuo
+}
+
+// SetProvider sets the "provider" field.
+func (nsuo *NotificationSubscriptionUpdateOne) SetProvider(up utils.NotificationProvider) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.SetProvider(up)
+	return nsuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (nsuo *NotificationSubscriptionUpdateOne) SetNillableProvider(up *utils.NotificationProvider) *NotificationSubscriptionUpdateOne {
+	if up != nil {
+		nsuo.SetProvider(*up)
+	}
+	return nsuo
+}
+
+// SetURL sets the "url" field.
+func (nsuo *NotificationSubscriptionUpdateOne) SetURL(s string) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.SetURL(s)
+	return nsuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (nsuo *NotificationSubscriptionUpdateOne) SetNillableURL(s *string) *NotificationSubscriptionUpdateOne {
+	if s != nil {
+		nsuo.SetURL(*s)
+	}
+	return nsuo
+}
+
+// ClearURL clears the value of the "url" field.
+func (nsuo *NotificationSubscriptionUpdateOne) ClearURL() *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.ClearURL()
+	return nsuo
+}
+
+// SetToken sets the "token" field.
+func (nsuo *NotificationSubscriptionUpdateOne) SetToken(s string) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.SetToken(s)
+	return nsuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (nsuo *NotificationSubscriptionUpdateOne) SetNillableToken(s *string) *NotificationSubscriptionUpdateOne {
+	if s != nil {
+		nsuo.SetToken(*s)
+	}
+	return nsuo
+}
+
+// ClearToken clears the value of the "token" field.
+func (nsuo *NotificationSubscriptionUpdateOne) ClearToken() *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.ClearToken()
+	return nsuo
+}
+
+// SetRoom sets the "room" field.
+func (nsuo *NotificationSubscriptionUpdateOne) SetRoom(s string) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.SetRoom(s)
+	return nsuo
+}
+
+// SetNillableRoom sets the "room" field if the given value is not nil.
+func (nsuo *NotificationSubscriptionUpdateOne) SetNillableRoom(s *string) *NotificationSubscriptionUpdateOne {
+	if s != nil {
+		nsuo.SetRoom(*s)
+	}
+	return nsuo
+}
+
+// ClearRoom clears the value of the "room" field.
+func (nsuo *NotificationSubscriptionUpdateOne) ClearRoom() *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.ClearRoom()
+	return nsuo
+}
+
+// SetTemplate sets the "template" field.
+func (nsuo *NotificationSubscriptionUpdateOne) SetTemplate(s string) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.SetTemplate(s)
+	return nsuo
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (nsuo *NotificationSubscriptionUpdateOne) SetNillableTemplate(s *string) *NotificationSubscriptionUpdateOne {
+	if s != nil {
+		nsuo.SetTemplate(*s)
+	}
+	return nsuo
+}
+
+// ClearTemplate clears the value of the "template" field.
+func (nsuo *NotificationSubscriptionUpdateOne) ClearTemplate() *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.ClearTemplate()
+	return nsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nsuo *NotificationSubscriptionUpdateOne) SetUpdatedAt(t time.Time) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.SetUpdatedAt(t)
+	return nsuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (nsuo *NotificationSubscriptionUpdateOne) SetUserID(id uuid.UUID) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.SetUserID(id)
+	return nsuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nsuo *NotificationSubscriptionUpdateOne) SetUser(u *User) *NotificationSubscriptionUpdateOne {
+	return nsuo.SetUserID(u.ID)
+}
+
+// AddChannelIDs adds the "channels" edge to the Channel entity by IDs.
+func (nsuo *NotificationSubscriptionUpdateOne) AddChannelIDs(ids ...uuid.UUID) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.AddChannelIDs(ids...)
+	return nsuo
+}
+
+// AddChannels adds the "channels" edges to the Channel entity.
+func (nsuo *NotificationSubscriptionUpdateOne) AddChannels(c ...*Channel) *NotificationSubscriptionUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return nsuo.AddChannelIDs(ids...)
+}
+
+// Mutation returns the NotificationSubscriptionMutation object of the builder.
+func (nsuo *NotificationSubscriptionUpdateOne) Mutation() *NotificationSubscriptionMutation {
+	return nsuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (nsuo *NotificationSubscriptionUpdateOne) ClearUser() *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.ClearUser()
+	return nsuo
+}
+
+// ClearChannels clears all "channels" edges to the Channel entity.
+func (nsuo *NotificationSubscriptionUpdateOne) ClearChannels() *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.ClearChannels()
+	return nsuo
+}
+
+// RemoveChannelIDs removes the "channels" edge to Channel entities by IDs.
+func (nsuo *NotificationSubscriptionUpdateOne) RemoveChannelIDs(ids ...uuid.UUID) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.RemoveChannelIDs(ids...)
+	return nsuo
+}
+
+// RemoveChannels removes "channels" edges to Channel entities.
+func (nsuo *NotificationSubscriptionUpdateOne) RemoveChannels(c ...*Channel) *NotificationSubscriptionUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return nsuo.RemoveChannelIDs(ids...)
+}
+
+// Where appends a list predicates to the NotificationSubscriptionUpdate builder.
+func (nsuo *NotificationSubscriptionUpdateOne) Where(ps ...predicate.NotificationSubscription) *NotificationSubscriptionUpdateOne {
+	nsuo.mutation.Where(ps...)
+	return nsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nsuo *NotificationSubscriptionUpdateOne) Select(field string, fields ...string) *NotificationSubscriptionUpdateOne {
+	nsuo.fields = append([]string{field}, fields...)
+	return nsuo
+}
+
+// Save executes the query and returns the updated NotificationSubscription entity.
+func (nsuo *NotificationSubscriptionUpdateOne) Save(ctx context.Context) (*NotificationSubscription, error) {
+	nsuo.defaults()
+	return withHooks(ctx, nsuo.sqlSave, nsuo.mutation, nsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nsuo *NotificationSubscriptionUpdateOne) SaveX(ctx context.Context) *NotificationSubscription {
+	node, err := nsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nsuo *NotificationSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := nsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nsuo *NotificationSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := nsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nsuo *NotificationSubscriptionUpdateOne) defaults() {
+	if _, ok := nsuo.mutation.UpdatedAt(); !ok {
+		v := notificationsubscription.UpdateDefaultUpdatedAt()
+		nsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nsuo *NotificationSubscriptionUpdateOne) check() error {
+	if v, ok := nsuo.mutation.Provider(); ok {
+		if err := notificationsubscription.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "NotificationSubscription.provider": %w`, err)}
+		}
+	}
+	if _, ok := nsuo.mutation.UserID(); nsuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "NotificationSubscription.user"`)
+	}
+	return nil
+}
+
+func (nsuo *NotificationSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *NotificationSubscription, err error) {
+	if err := nsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationsubscription.Table, notificationsubscription.Columns, sqlgraph.NewFieldSpec(notificationsubscription.FieldID, field.TypeUUID))
+	id, ok := nsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationsubscription.FieldID)
+		for _, f := range fields {
+			if !notificationsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nsuo.mutation.Name(); ok {
+		_spec.SetField(notificationsubscription.FieldName, field.TypeString, value)
+	}
+	if nsuo.mutation.NameCleared() {
+		_spec.ClearField(notificationsubscription.FieldName, field.TypeString)
+	}
+	if value, ok := nsuo.mutation.Enabled(); ok {
+		_spec.SetField(notificationsubscription.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := nsuo.mutation.Events(); ok {
+		_spec.SetField(notificationsubscription.FieldEvents, field.TypeJSON, value)
+	}
+	if value, ok := nsuo.mutation.AppendedEvents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, notificationsubscription.FieldEvents, value)
+		})
+	}
+	if value, ok := nsuo.mutation.Provider(); ok {
+		_spec.SetField(notificationsubscription.FieldProvider, field.TypeEnum, value)
+	}
+	if value, ok := nsuo.mutation.URL(); ok {
+		_spec.SetField(notificationsubscription.FieldURL, field.TypeString, value)
+	}
+	if nsuo.mutation.URLCleared() {
+		_spec.ClearField(notificationsubscription.FieldURL, field.TypeString)
+	}
+	if value, ok := nsuo.mutation.Token(); ok {
+		_spec.SetField(notificationsubscription.FieldToken, field.TypeString, value)
+	}
+	if nsuo.mutation.TokenCleared() {
+		_spec.ClearField(notificationsubscription.FieldToken, field.TypeString)
+	}
+	if value, ok := nsuo.mutation.Room(); ok {
+		_spec.SetField(notificationsubscription.FieldRoom, field.TypeString, value)
+	}
+	if nsuo.mutation.RoomCleared() {
+		_spec.ClearField(notificationsubscription.FieldRoom, field.TypeString)
+	}
+	if value, ok := nsuo.mutation.Template(); ok {
+		_spec.SetField(notificationsubscription.FieldTemplate, field.TypeString, value)
+	}
+	if nsuo.mutation.TemplateCleared() {
+		_spec.ClearField(notificationsubscription.FieldTemplate, field.TypeString)
+	}
+	if value, ok := nsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if nsuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.UserTable,
+			Columns: []string{notificationsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nsuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notificationsubscription.UserTable,
+			Columns: []string{notificationsubscription.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nsuo.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationsubscription.ChannelsTable,
+			Columns: []string{notificationsubscription.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nsuo.mutation.RemovedChannelsIDs(); len(nodes) > 0 && !nsuo.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationsubscription.ChannelsTable,
+			Columns: []string{notificationsubscription.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nsuo.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   notificationsubscription.ChannelsTable,
+			Columns: []string{notificationsubscription.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(channel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationSubscription{config: nsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nsuo.mutation.done = true
+	return _node, nil
+}
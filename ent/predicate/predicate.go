@@ -6,12 +6,30 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApiToken is the predicate function for apitoken builders.
+type ApiToken func(*sql.Selector)
+
+// ArchiveProfile is the predicate function for archiveprofile builders.
+type ArchiveProfile func(*sql.Selector)
+
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
 // Channel is the predicate function for channel builders.
 type Channel func(*sql.Selector)
 
+// ChannelPermission is the predicate function for channelpermission builders.
+type ChannelPermission func(*sql.Selector)
+
 // Chapter is the predicate function for chapter builders.
 type Chapter func(*sql.Selector)
 
+// ChatMessage is the predicate function for chatmessage builders.
+type ChatMessage func(*sql.Selector)
+
+// Group is the predicate function for group builders.
+type Group func(*sql.Selector)
+
 // Live is the predicate function for live builders.
 type Live func(*sql.Selector)
 
@@ -24,6 +42,12 @@ type LiveTitleRegex func(*sql.Selector)
 // MutedSegment is the predicate function for mutedsegment builders.
 type MutedSegment func(*sql.Selector)
 
+// NotificationDelivery is the predicate function for notificationdelivery builders.
+type NotificationDelivery func(*sql.Selector)
+
+// NotificationSubscription is the predicate function for notificationsubscription builders.
+type NotificationSubscription func(*sql.Selector)
+
 // Playback is the predicate function for playback builders.
 type Playback func(*sql.Selector)
 
@@ -33,6 +57,9 @@ type Playlist func(*sql.Selector)
 // Queue is the predicate function for queue builders.
 type Queue func(*sql.Selector)
 
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
 // TwitchCategory is the predicate function for twitchcategory builders.
 type TwitchCategory func(*sql.Selector)
 
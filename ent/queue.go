@@ -24,6 +24,10 @@ type Queue struct {
 	LiveArchive bool `json:"live_archive,omitempty"`
 	// OnHold holds the value of the "on_hold" field.
 	OnHold bool `json:"on_hold,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// Paused holds the value of the "paused" field.
+	Paused bool `json:"paused,omitempty"`
 	// VideoProcessing holds the value of the "video_processing" field.
 	VideoProcessing bool `json:"video_processing,omitempty"`
 	// ChatProcessing holds the value of the "chat_processing" field.
@@ -94,8 +98,10 @@ func (*Queue) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case queue.FieldLiveArchive, queue.FieldOnHold, queue.FieldVideoProcessing, queue.FieldChatProcessing, queue.FieldProcessing, queue.FieldRenderChat:
+		case queue.FieldLiveArchive, queue.FieldOnHold, queue.FieldPaused, queue.FieldVideoProcessing, queue.FieldChatProcessing, queue.FieldProcessing, queue.FieldRenderChat:
 			values[i] = new(sql.NullBool)
+		case queue.FieldPriority:
+			values[i] = new(sql.NullInt64)
 		case queue.FieldTaskVodCreateFolder, queue.FieldTaskVodDownloadThumbnail, queue.FieldTaskVodSaveInfo, queue.FieldTaskVideoDownload, queue.FieldTaskVideoConvert, queue.FieldTaskVideoMove, queue.FieldTaskChatDownload, queue.FieldTaskChatConvert, queue.FieldTaskChatRender, queue.FieldTaskChatMove, queue.FieldWorkflowID, queue.FieldWorkflowRunID:
 			values[i] = new(sql.NullString)
 		case queue.FieldChatStart, queue.FieldUpdatedAt, queue.FieldCreatedAt:
@@ -137,6 +143,18 @@ func (q *Queue) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				q.OnHold = value.Bool
 			}
+		case queue.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				q.Priority = int(value.Int64)
+			}
+		case queue.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				q.Paused = value.Bool
+			}
 		case queue.FieldVideoProcessing:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field video_processing", values[i])
@@ -305,6 +323,12 @@ func (q *Queue) String() string {
 	builder.WriteString("on_hold=")
 	builder.WriteString(fmt.Sprintf("%v", q.OnHold))
 	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", q.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("paused=")
+	builder.WriteString(fmt.Sprintf("%v", q.Paused))
+	builder.WriteString(", ")
 	builder.WriteString("video_processing=")
 	builder.WriteString(fmt.Sprintf("%v", q.VideoProcessing))
 	builder.WriteString(", ")
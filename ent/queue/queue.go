@@ -21,6 +21,10 @@ const (
 	FieldLiveArchive = "live_archive"
 	// FieldOnHold holds the string denoting the on_hold field in the database.
 	FieldOnHold = "on_hold"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldPaused holds the string denoting the paused field in the database.
+	FieldPaused = "paused"
 	// FieldVideoProcessing holds the string denoting the video_processing field in the database.
 	FieldVideoProcessing = "video_processing"
 	// FieldChatProcessing holds the string denoting the chat_processing field in the database.
@@ -77,6 +81,8 @@ var Columns = []string{
 	FieldID,
 	FieldLiveArchive,
 	FieldOnHold,
+	FieldPriority,
+	FieldPaused,
 	FieldVideoProcessing,
 	FieldChatProcessing,
 	FieldProcessing,
@@ -124,6 +130,10 @@ var (
 	DefaultLiveArchive bool
 	// DefaultOnHold holds the default value on creation for the "on_hold" field.
 	DefaultOnHold bool
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultPaused holds the default value on creation for the "paused" field.
+	DefaultPaused bool
 	// DefaultVideoProcessing holds the default value on creation for the "video_processing" field.
 	DefaultVideoProcessing bool
 	// DefaultChatProcessing holds the default value on creation for the "chat_processing" field.
@@ -147,7 +157,7 @@ const DefaultTaskVodCreateFolder utils.TaskStatus = "pending"
 // TaskVodCreateFolderValidator is a validator for the "task_vod_create_folder" field enum values. It is called by the builders before save.
 func TaskVodCreateFolderValidator(tvcf utils.TaskStatus) error {
 	switch tvcf {
-	case "success", "running", "pending", "failed":
+	case "success", "running", "pending", "failed", "cancelled":
 		return nil
 	default:
 		return fmt.Errorf("queue: invalid enum value for task_vod_create_folder field: %q", tvcf)
@@ -159,7 +169,7 @@ const DefaultTaskVodDownloadThumbnail utils.TaskStatus = "pending"
 // TaskVodDownloadThumbnailValidator is a validator for the "task_vod_download_thumbnail" field enum values. It is called by the builders before save.
 func TaskVodDownloadThumbnailValidator(tvdt utils.TaskStatus) error {
 	switch tvdt {
-	case "success", "running", "pending", "failed":
+	case "success", "running", "pending", "failed", "cancelled":
 		return nil
 	default:
 		return fmt.Errorf("queue: invalid enum value for task_vod_download_thumbnail field: %q", tvdt)
@@ -171,7 +181,7 @@ const DefaultTaskVodSaveInfo utils.TaskStatus = "pending"
 // TaskVodSaveInfoValidator is a validator for the "task_vod_save_info" field enum values. It is called by the builders before save.
 func TaskVodSaveInfoValidator(tvsi utils.TaskStatus) error {
 	switch tvsi {
-	case "success", "running", "pending", "failed":
+	case "success", "running", "pending", "failed", "cancelled":
 		return nil
 	default:
 		return fmt.Errorf("queue: invalid enum value for task_vod_save_info field: %q", tvsi)
@@ -183,7 +193,7 @@ const DefaultTaskVideoDownload utils.TaskStatus = "pending"
 // TaskVideoDownloadValidator is a validator for the "task_video_download" field enum values. It is called by the builders before save.
 func TaskVideoDownloadValidator(tvd utils.TaskStatus) error {
 	switch tvd {
-	case "success", "running", "pending", "failed":
+	case "success", "running", "pending", "failed", "cancelled":
 		return nil
 	default:
 		return fmt.Errorf("queue: invalid enum value for task_video_download field: %q", tvd)
@@ -195,7 +205,7 @@ const DefaultTaskVideoConvert utils.TaskStatus = "pending"
 // TaskVideoConvertValidator is a validator for the "task_video_convert" field enum values. It is called by the builders before save.
 func TaskVideoConvertValidator(tvc utils.TaskStatus) error {
 	switch tvc {
-	case "success", "running", "pending", "failed":
+	case "success", "running", "pending", "failed", "cancelled":
 		return nil
 	default:
 		return fmt.Errorf("queue: invalid enum value for task_video_convert field: %q", tvc)
@@ -207,7 +217,7 @@ const DefaultTaskVideoMove utils.TaskStatus = "pending"
 // TaskVideoMoveValidator is a validator for the "task_video_move" field enum values. It is called by the builders before save.
 func TaskVideoMoveValidator(tvm utils.TaskStatus) error {
 	switch tvm {
-	case "success", "running", "pending", "failed":
+	case "success", "running", "pending", "failed", "cancelled":
 		return nil
 	default:
 		return fmt.Errorf("queue: invalid enum value for task_video_move field: %q", tvm)
@@ -219,7 +229,7 @@ const DefaultTaskChatDownload utils.TaskStatus = "pending"
 // TaskChatDownloadValidator is a validator for the "task_chat_download" field enum values. It is called by the builders before save.
 func TaskChatDownloadValidator(tcd utils.TaskStatus) error {
 	switch tcd {
-	case "success", "running", "pending", "failed":
+	case "success", "running", "pending", "failed", "cancelled":
 		return nil
 	default:
 		return fmt.Errorf("queue: invalid enum value for task_chat_download field: %q", tcd)
@@ -231,7 +241,7 @@ const DefaultTaskChatConvert utils.TaskStatus = "pending"
 // TaskChatConvertValidator is a validator for the "task_chat_convert" field enum values. It is called by the builders before save.
 func TaskChatConvertValidator(tcc utils.TaskStatus) error {
 	switch tcc {
-	case "success", "running", "pending", "failed":
+	case "success", "running", "pending", "failed", "cancelled":
 		return nil
 	default:
 		return fmt.Errorf("queue: invalid enum value for task_chat_convert field: %q", tcc)
@@ -243,7 +253,7 @@ const DefaultTaskChatRender utils.TaskStatus = "pending"
 // TaskChatRenderValidator is a validator for the "task_chat_render" field enum values. It is called by the builders before save.
 func TaskChatRenderValidator(tcr utils.TaskStatus) error {
 	switch tcr {
-	case "success", "running", "pending", "failed":
+	case "success", "running", "pending", "failed", "cancelled":
 		return nil
 	default:
 		return fmt.Errorf("queue: invalid enum value for task_chat_render field: %q", tcr)
@@ -255,7 +265,7 @@ const DefaultTaskChatMove utils.TaskStatus = "pending"
 // TaskChatMoveValidator is a validator for the "task_chat_move" field enum values. It is called by the builders before save.
 func TaskChatMoveValidator(tcm utils.TaskStatus) error {
 	switch tcm {
-	case "success", "running", "pending", "failed":
+	case "success", "running", "pending", "failed", "cancelled":
 		return nil
 	default:
 		return fmt.Errorf("queue: invalid enum value for task_chat_move field: %q", tcm)
@@ -280,6 +290,16 @@ func ByOnHold(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOnHold, opts...).ToFunc()
 }
 
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByPaused orders the results by the paused field.
+func ByPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaused, opts...).ToFunc()
+}
+
 // ByVideoProcessing orders the results by the video_processing field.
 func ByVideoProcessing(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVideoProcessing, opts...).ToFunc()
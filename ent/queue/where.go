@@ -67,6 +67,16 @@ func OnHold(v bool) predicate.Queue {
 	return predicate.Queue(sql.FieldEQ(FieldOnHold, v))
 }
 
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldPriority, v))
+}
+
+// Paused applies equality check predicate on the "paused" field. It's identical to PausedEQ.
+func Paused(v bool) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldPaused, v))
+}
+
 // VideoProcessing applies equality check predicate on the "video_processing" field. It's identical to VideoProcessingEQ.
 func VideoProcessing(v bool) predicate.Queue {
 	return predicate.Queue(sql.FieldEQ(FieldVideoProcessing, v))
@@ -132,6 +142,56 @@ func OnHoldNEQ(v bool) predicate.Queue {
 	return predicate.Queue(sql.FieldNEQ(FieldOnHold, v))
 }
 
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.Queue {
+	return predicate.Queue(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.Queue {
+	return predicate.Queue(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.Queue {
+	return predicate.Queue(sql.FieldLTE(FieldPriority, v))
+}
+
+// PausedEQ applies the EQ predicate on the "paused" field.
+func PausedEQ(v bool) predicate.Queue {
+	return predicate.Queue(sql.FieldEQ(FieldPaused, v))
+}
+
+// PausedNEQ applies the NEQ predicate on the "paused" field.
+func PausedNEQ(v bool) predicate.Queue {
+	return predicate.Queue(sql.FieldNEQ(FieldPaused, v))
+}
+
 // VideoProcessingEQ applies the EQ predicate on the "video_processing" field.
 func VideoProcessingEQ(v bool) predicate.Queue {
 	return predicate.Queue(sql.FieldEQ(FieldVideoProcessing, v))
@@ -54,6 +54,34 @@ func (qc *QueueCreate) SetNillableOnHold(b *bool) *QueueCreate {
 	return qc
 }
 
+// SetPriority sets the "priority" field.
+func (qc *QueueCreate) SetPriority(i int) *QueueCreate {
+	qc.mutation.SetPriority(i)
+	return qc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (qc *QueueCreate) SetNillablePriority(i *int) *QueueCreate {
+	if i != nil {
+		qc.SetPriority(*i)
+	}
+	return qc
+}
+
+// SetPaused sets the "paused" field.
+func (qc *QueueCreate) SetPaused(b bool) *QueueCreate {
+	qc.mutation.SetPaused(b)
+	return qc
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (qc *QueueCreate) SetNillablePaused(b *bool) *QueueCreate {
+	if b != nil {
+		qc.SetPaused(*b)
+	}
+	return qc
+}
+
 // SetVideoProcessing sets the "video_processing" field.
 func (qc *QueueCreate) SetVideoProcessing(b bool) *QueueCreate {
 	qc.mutation.SetVideoProcessing(b)
@@ -388,6 +416,14 @@ func (qc *QueueCreate) defaults() {
 		v := queue.DefaultOnHold
 		qc.mutation.SetOnHold(v)
 	}
+	if _, ok := qc.mutation.Priority(); !ok {
+		v := queue.DefaultPriority
+		qc.mutation.SetPriority(v)
+	}
+	if _, ok := qc.mutation.Paused(); !ok {
+		v := queue.DefaultPaused
+		qc.mutation.SetPaused(v)
+	}
 	if _, ok := qc.mutation.VideoProcessing(); !ok {
 		v := queue.DefaultVideoProcessing
 		qc.mutation.SetVideoProcessing(v)
@@ -466,6 +502,12 @@ func (qc *QueueCreate) check() error {
 	if _, ok := qc.mutation.OnHold(); !ok {
 		return &ValidationError{Name: "on_hold", err: errors.New(`ent: missing required field "Queue.on_hold"`)}
 	}
+	if _, ok := qc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Queue.priority"`)}
+	}
+	if _, ok := qc.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "Queue.paused"`)}
+	}
 	if _, ok := qc.mutation.VideoProcessing(); !ok {
 		return &ValidationError{Name: "video_processing", err: errors.New(`ent: missing required field "Queue.video_processing"`)}
 	}
@@ -578,6 +620,14 @@ func (qc *QueueCreate) createSpec() (*Queue, *sqlgraph.CreateSpec) {
 		_spec.SetField(queue.FieldOnHold, field.TypeBool, value)
 		_node.OnHold = value
 	}
+	if value, ok := qc.mutation.Priority(); ok {
+		_spec.SetField(queue.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := qc.mutation.Paused(); ok {
+		_spec.SetField(queue.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
 	if value, ok := qc.mutation.VideoProcessing(); ok {
 		_spec.SetField(queue.FieldVideoProcessing, field.TypeBool, value)
 		_node.VideoProcessing = value
@@ -747,6 +797,36 @@ func (u *QueueUpsert) UpdateOnHold() *QueueUpsert {
 	return u
 }
 
+// SetPriority sets the "priority" field.
+func (u *QueueUpsert) SetPriority(v int) *QueueUpsert {
+	u.Set(queue.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *QueueUpsert) UpdatePriority() *QueueUpsert {
+	u.SetExcluded(queue.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *QueueUpsert) AddPriority(v int) *QueueUpsert {
+	u.Add(queue.FieldPriority, v)
+	return u
+}
+
+// SetPaused sets the "paused" field.
+func (u *QueueUpsert) SetPaused(v bool) *QueueUpsert {
+	u.Set(queue.FieldPaused, v)
+	return u
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *QueueUpsert) UpdatePaused() *QueueUpsert {
+	u.SetExcluded(queue.FieldPaused)
+	return u
+}
+
 // SetVideoProcessing sets the "video_processing" field.
 func (u *QueueUpsert) SetVideoProcessing(v bool) *QueueUpsert {
 	u.Set(queue.FieldVideoProcessing, v)
@@ -1126,6 +1206,41 @@ func (u *QueueUpsertOne) UpdateOnHold() *QueueUpsertOne {
 	})
 }
 
+// SetPriority sets the "priority" field.
+func (u *QueueUpsertOne) SetPriority(v int) *QueueUpsertOne {
+	return u.Update(func(s *QueueUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *QueueUpsertOne) AddPriority(v int) *QueueUpsertOne {
+	return u.Update(func(s *QueueUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *QueueUpsertOne) UpdatePriority() *QueueUpsertOne {
+	return u.Update(func(s *QueueUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetPaused sets the "paused" field.
+func (u *QueueUpsertOne) SetPaused(v bool) *QueueUpsertOne {
+	return u.Update(func(s *QueueUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *QueueUpsertOne) UpdatePaused() *QueueUpsertOne {
+	return u.Update(func(s *QueueUpsert) {
+		s.UpdatePaused()
+	})
+}
+
 // SetVideoProcessing sets the "video_processing" field.
 func (u *QueueUpsertOne) SetVideoProcessing(v bool) *QueueUpsertOne {
 	return u.Update(func(s *QueueUpsert) {
@@ -1722,6 +1837,41 @@ func (u *QueueUpsertBulk) UpdateOnHold() *QueueUpsertBulk {
 	})
 }
 
+// SetPriority sets the "priority" field.
+func (u *QueueUpsertBulk) SetPriority(v int) *QueueUpsertBulk {
+	return u.Update(func(s *QueueUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *QueueUpsertBulk) AddPriority(v int) *QueueUpsertBulk {
+	return u.Update(func(s *QueueUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *QueueUpsertBulk) UpdatePriority() *QueueUpsertBulk {
+	return u.Update(func(s *QueueUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetPaused sets the "paused" field.
+func (u *QueueUpsertBulk) SetPaused(v bool) *QueueUpsertBulk {
+	return u.Update(func(s *QueueUpsert) {
+		s.SetPaused(v)
+	})
+}
+
+// UpdatePaused sets the "paused" field to the value that was provided on create.
+func (u *QueueUpsertBulk) UpdatePaused() *QueueUpsertBulk {
+	return u.Update(func(s *QueueUpsert) {
+		s.UpdatePaused()
+	})
+}
+
 // SetVideoProcessing sets the "video_processing" field.
 func (u *QueueUpsertBulk) SetVideoProcessing(v bool) *QueueUpsertBulk {
 	return u.Update(func(s *QueueUpsert) {
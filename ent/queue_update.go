@@ -59,6 +59,41 @@ func (qu *QueueUpdate) SetNillableOnHold(b *bool) *QueueUpdate {
 	return qu
 }
 
+// SetPriority sets the "priority" field.
+func (qu *QueueUpdate) SetPriority(i int) *QueueUpdate {
+	qu.mutation.ResetPriority()
+	qu.mutation.SetPriority(i)
+	return qu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (qu *QueueUpdate) SetNillablePriority(i *int) *QueueUpdate {
+	if i != nil {
+		qu.SetPriority(*i)
+	}
+	return qu
+}
+
+// AddPriority adds i to the "priority" field.
+func (qu *QueueUpdate) AddPriority(i int) *QueueUpdate {
+	qu.mutation.AddPriority(i)
+	return qu
+}
+
+// SetPaused sets the "paused" field.
+func (qu *QueueUpdate) SetPaused(b bool) *QueueUpdate {
+	qu.mutation.SetPaused(b)
+	return qu
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (qu *QueueUpdate) SetNillablePaused(b *bool) *QueueUpdate {
+	if b != nil {
+		qu.SetPaused(*b)
+	}
+	return qu
+}
+
 // SetVideoProcessing sets the "video_processing" field.
 func (qu *QueueUpdate) SetVideoProcessing(b bool) *QueueUpdate {
 	qu.mutation.SetVideoProcessing(b)
@@ -521,6 +556,15 @@ func (qu *QueueUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := qu.mutation.OnHold(); ok {
 		_spec.SetField(queue.FieldOnHold, field.TypeBool, value)
 	}
+	if value, ok := qu.mutation.Priority(); ok {
+		_spec.SetField(queue.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedPriority(); ok {
+		_spec.AddField(queue.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.Paused(); ok {
+		_spec.SetField(queue.FieldPaused, field.TypeBool, value)
+	}
 	if value, ok := qu.mutation.VideoProcessing(); ok {
 		_spec.SetField(queue.FieldVideoProcessing, field.TypeBool, value)
 	}
@@ -694,6 +738,41 @@ func (quo *QueueUpdateOne) SetNillableOnHold(b *bool) *QueueUpdateOne {
 	return quo
 }
 
+// SetPriority sets the "priority" field.
+func (quo *QueueUpdateOne) SetPriority(i int) *QueueUpdateOne {
+	quo.mutation.ResetPriority()
+	quo.mutation.SetPriority(i)
+	return quo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (quo *QueueUpdateOne) SetNillablePriority(i *int) *QueueUpdateOne {
+	if i != nil {
+		quo.SetPriority(*i)
+	}
+	return quo
+}
+
+// AddPriority adds i to the "priority" field.
+func (quo *QueueUpdateOne) AddPriority(i int) *QueueUpdateOne {
+	quo.mutation.AddPriority(i)
+	return quo
+}
+
+// SetPaused sets the "paused" field.
+func (quo *QueueUpdateOne) SetPaused(b bool) *QueueUpdateOne {
+	quo.mutation.SetPaused(b)
+	return quo
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (quo *QueueUpdateOne) SetNillablePaused(b *bool) *QueueUpdateOne {
+	if b != nil {
+		quo.SetPaused(*b)
+	}
+	return quo
+}
+
 // SetVideoProcessing sets the "video_processing" field.
 func (quo *QueueUpdateOne) SetVideoProcessing(b bool) *QueueUpdateOne {
 	quo.mutation.SetVideoProcessing(b)
@@ -1186,6 +1265,15 @@ func (quo *QueueUpdateOne) sqlSave(ctx context.Context) (_node *Queue, err error
 	if value, ok := quo.mutation.OnHold(); ok {
 		_spec.SetField(queue.FieldOnHold, field.TypeBool, value)
 	}
+	if value, ok := quo.mutation.Priority(); ok {
+		_spec.SetField(queue.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedPriority(); ok {
+		_spec.AddField(queue.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.Paused(); ok {
+		_spec.SetField(queue.FieldPaused, field.TypeBool, value)
+	}
 	if value, ok := quo.mutation.VideoProcessing(); ok {
 		_spec.SetField(queue.FieldVideoProcessing, field.TypeBool, value)
 	}
@@ -2,7 +2,501 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/zibbp/ganymede/ent/runtime.go
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/apitoken"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
+	"github.com/zibbp/ganymede/ent/auditevent"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
+	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/group"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/livecategory"
+	"github.com/zibbp/ganymede/ent/livetitleregex"
+	"github.com/zibbp/ganymede/ent/mutedsegment"
+	"github.com/zibbp/ganymede/ent/notificationdelivery"
+	"github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/ent/playback"
+	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/ent/schema"
+	"github.com/zibbp/ganymede/ent/session"
+	"github.com/zibbp/ganymede/ent/twitchcategory"
+	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/ent/vod"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	apitokenFields := schema.ApiToken{}.Fields()
+	_ = apitokenFields
+	// apitokenDescUpdatedAt is the schema descriptor for updated_at field.
+	apitokenDescUpdatedAt := apitokenFields[7].Descriptor()
+	// apitoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apitoken.DefaultUpdatedAt = apitokenDescUpdatedAt.Default.(func() time.Time)
+	// apitoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apitoken.UpdateDefaultUpdatedAt = apitokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apitokenDescCreatedAt is the schema descriptor for created_at field.
+	apitokenDescCreatedAt := apitokenFields[8].Descriptor()
+	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
+	// apitokenDescID is the schema descriptor for id field.
+	apitokenDescID := apitokenFields[0].Descriptor()
+	// apitoken.DefaultID holds the default value on creation for the id field.
+	apitoken.DefaultID = apitokenDescID.Default.(func() uuid.UUID)
+	archiveprofileFields := schema.ArchiveProfile{}.Fields()
+	_ = archiveprofileFields
+	// archiveprofileDescRenderChat is the schema descriptor for render_chat field.
+	archiveprofileDescRenderChat := archiveprofileFields[5].Descriptor()
+	// archiveprofile.DefaultRenderChat holds the default value on creation for the render_chat field.
+	archiveprofile.DefaultRenderChat = archiveprofileDescRenderChat.Default.(bool)
+	// archiveprofileDescUpdatedAt is the schema descriptor for updated_at field.
+	archiveprofileDescUpdatedAt := archiveprofileFields[11].Descriptor()
+	// archiveprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	archiveprofile.DefaultUpdatedAt = archiveprofileDescUpdatedAt.Default.(func() time.Time)
+	// archiveprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	archiveprofile.UpdateDefaultUpdatedAt = archiveprofileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// archiveprofileDescCreatedAt is the schema descriptor for created_at field.
+	archiveprofileDescCreatedAt := archiveprofileFields[12].Descriptor()
+	// archiveprofile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	archiveprofile.DefaultCreatedAt = archiveprofileDescCreatedAt.Default.(func() time.Time)
+	// archiveprofileDescID is the schema descriptor for id field.
+	archiveprofileDescID := archiveprofileFields[0].Descriptor()
+	// archiveprofile.DefaultID holds the default value on creation for the id field.
+	archiveprofile.DefaultID = archiveprofileDescID.Default.(func() uuid.UUID)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[9].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() uuid.UUID)
+	channelMixin := schema.Channel{}.Mixin()
+	channelMixinInters0 := channelMixin[0].Interceptors()
+	channel.Interceptors[0] = channelMixinInters0[0]
+	channelFields := schema.Channel{}.Fields()
+	_ = channelFields
+	// channelDescRetention is the schema descriptor for retention field.
+	channelDescRetention := channelFields[6].Descriptor()
+	// channel.DefaultRetention holds the default value on creation for the retention field.
+	channel.DefaultRetention = channelDescRetention.Default.(bool)
+	// channelDescRetentionProtectPlaylists is the schema descriptor for retention_protect_playlists field.
+	channelDescRetentionProtectPlaylists := channelFields[11].Descriptor()
+	// channel.DefaultRetentionProtectPlaylists holds the default value on creation for the retention_protect_playlists field.
+	channel.DefaultRetentionProtectPlaylists = channelDescRetentionProtectPlaylists.Default.(bool)
+	// channelDescRetentionProtectWatched is the schema descriptor for retention_protect_watched field.
+	channelDescRetentionProtectWatched := channelFields[12].Descriptor()
+	// channel.DefaultRetentionProtectWatched holds the default value on creation for the retention_protect_watched field.
+	channel.DefaultRetentionProtectWatched = channelDescRetentionProtectWatched.Default.(bool)
+	// channelDescColdStorage is the schema descriptor for cold_storage field.
+	channelDescColdStorage := channelFields[14].Descriptor()
+	// channel.DefaultColdStorage holds the default value on creation for the cold_storage field.
+	channel.DefaultColdStorage = channelDescColdStorage.Default.(bool)
+	// channelDescRestricted is the schema descriptor for restricted field.
+	channelDescRestricted := channelFields[17].Descriptor()
+	// channel.DefaultRestricted holds the default value on creation for the restricted field.
+	channel.DefaultRestricted = channelDescRestricted.Default.(bool)
+	// channelDescUpdatedAt is the schema descriptor for updated_at field.
+	channelDescUpdatedAt := channelFields[18].Descriptor()
+	// channel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	channel.DefaultUpdatedAt = channelDescUpdatedAt.Default.(func() time.Time)
+	// channel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	channel.UpdateDefaultUpdatedAt = channelDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// channelDescCreatedAt is the schema descriptor for created_at field.
+	channelDescCreatedAt := channelFields[19].Descriptor()
+	// channel.DefaultCreatedAt holds the default value on creation for the created_at field.
+	channel.DefaultCreatedAt = channelDescCreatedAt.Default.(func() time.Time)
+	// channelDescID is the schema descriptor for id field.
+	channelDescID := channelFields[0].Descriptor()
+	// channel.DefaultID holds the default value on creation for the id field.
+	channel.DefaultID = channelDescID.Default.(func() uuid.UUID)
+	channelpermissionFields := schema.ChannelPermission{}.Fields()
+	_ = channelpermissionFields
+	// channelpermissionDescUpdatedAt is the schema descriptor for updated_at field.
+	channelpermissionDescUpdatedAt := channelpermissionFields[2].Descriptor()
+	// channelpermission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	channelpermission.DefaultUpdatedAt = channelpermissionDescUpdatedAt.Default.(func() time.Time)
+	// channelpermission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	channelpermission.UpdateDefaultUpdatedAt = channelpermissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// channelpermissionDescCreatedAt is the schema descriptor for created_at field.
+	channelpermissionDescCreatedAt := channelpermissionFields[3].Descriptor()
+	// channelpermission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	channelpermission.DefaultCreatedAt = channelpermissionDescCreatedAt.Default.(func() time.Time)
+	// channelpermissionDescID is the schema descriptor for id field.
+	channelpermissionDescID := channelpermissionFields[0].Descriptor()
+	// channelpermission.DefaultID holds the default value on creation for the id field.
+	channelpermission.DefaultID = channelpermissionDescID.Default.(func() uuid.UUID)
+	chapterFields := schema.Chapter{}.Fields()
+	_ = chapterFields
+	// chapterDescID is the schema descriptor for id field.
+	chapterDescID := chapterFields[0].Descriptor()
+	// chapter.DefaultID holds the default value on creation for the id field.
+	chapter.DefaultID = chapterDescID.Default.(func() uuid.UUID)
+	chatmessageFields := schema.ChatMessage{}.Fields()
+	_ = chatmessageFields
+	// chatmessageDescID is the schema descriptor for id field.
+	chatmessageDescID := chatmessageFields[0].Descriptor()
+	// chatmessage.DefaultID holds the default value on creation for the id field.
+	chatmessage.DefaultID = chatmessageDescID.Default.(func() uuid.UUID)
+	groupFields := schema.Group{}.Fields()
+	_ = groupFields
+	// groupDescUpdatedAt is the schema descriptor for updated_at field.
+	groupDescUpdatedAt := groupFields[3].Descriptor()
+	// group.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	group.DefaultUpdatedAt = groupDescUpdatedAt.Default.(func() time.Time)
+	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	group.UpdateDefaultUpdatedAt = groupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// groupDescCreatedAt is the schema descriptor for created_at field.
+	groupDescCreatedAt := groupFields[4].Descriptor()
+	// group.DefaultCreatedAt holds the default value on creation for the created_at field.
+	group.DefaultCreatedAt = groupDescCreatedAt.Default.(func() time.Time)
+	// groupDescID is the schema descriptor for id field.
+	groupDescID := groupFields[0].Descriptor()
+	// group.DefaultID holds the default value on creation for the id field.
+	group.DefaultID = groupDescID.Default.(func() uuid.UUID)
+	liveFields := schema.Live{}.Fields()
+	_ = liveFields
+	// liveDescWatchLive is the schema descriptor for watch_live field.
+	liveDescWatchLive := liveFields[1].Descriptor()
+	// live.DefaultWatchLive holds the default value on creation for the watch_live field.
+	live.DefaultWatchLive = liveDescWatchLive.Default.(bool)
+	// liveDescWatchVod is the schema descriptor for watch_vod field.
+	liveDescWatchVod := liveFields[2].Descriptor()
+	// live.DefaultWatchVod holds the default value on creation for the watch_vod field.
+	live.DefaultWatchVod = liveDescWatchVod.Default.(bool)
+	// liveDescDownloadArchives is the schema descriptor for download_archives field.
+	liveDescDownloadArchives := liveFields[3].Descriptor()
+	// live.DefaultDownloadArchives holds the default value on creation for the download_archives field.
+	live.DefaultDownloadArchives = liveDescDownloadArchives.Default.(bool)
+	// liveDescDownloadHighlights is the schema descriptor for download_highlights field.
+	liveDescDownloadHighlights := liveFields[4].Descriptor()
+	// live.DefaultDownloadHighlights holds the default value on creation for the download_highlights field.
+	live.DefaultDownloadHighlights = liveDescDownloadHighlights.Default.(bool)
+	// liveDescDownloadUploads is the schema descriptor for download_uploads field.
+	liveDescDownloadUploads := liveFields[5].Descriptor()
+	// live.DefaultDownloadUploads holds the default value on creation for the download_uploads field.
+	live.DefaultDownloadUploads = liveDescDownloadUploads.Default.(bool)
+	// liveDescDownloadSubOnly is the schema descriptor for download_sub_only field.
+	liveDescDownloadSubOnly := liveFields[6].Descriptor()
+	// live.DefaultDownloadSubOnly holds the default value on creation for the download_sub_only field.
+	live.DefaultDownloadSubOnly = liveDescDownloadSubOnly.Default.(bool)
+	// liveDescIsLive is the schema descriptor for is_live field.
+	liveDescIsLive := liveFields[7].Descriptor()
+	// live.DefaultIsLive holds the default value on creation for the is_live field.
+	live.DefaultIsLive = liveDescIsLive.Default.(bool)
+	// liveDescArchiveChat is the schema descriptor for archive_chat field.
+	liveDescArchiveChat := liveFields[8].Descriptor()
+	// live.DefaultArchiveChat holds the default value on creation for the archive_chat field.
+	live.DefaultArchiveChat = liveDescArchiveChat.Default.(bool)
+	// liveDescResolution is the schema descriptor for resolution field.
+	liveDescResolution := liveFields[9].Descriptor()
+	// live.DefaultResolution holds the default value on creation for the resolution field.
+	live.DefaultResolution = liveDescResolution.Default.(string)
+	// liveDescLastLive is the schema descriptor for last_live field.
+	liveDescLastLive := liveFields[10].Descriptor()
+	// live.DefaultLastLive holds the default value on creation for the last_live field.
+	live.DefaultLastLive = liveDescLastLive.Default.(func() time.Time)
+	// liveDescRenderChat is the schema descriptor for render_chat field.
+	liveDescRenderChat := liveFields[11].Descriptor()
+	// live.DefaultRenderChat holds the default value on creation for the render_chat field.
+	live.DefaultRenderChat = liveDescRenderChat.Default.(bool)
+	// liveDescVideoAge is the schema descriptor for video_age field.
+	liveDescVideoAge := liveFields[12].Descriptor()
+	// live.DefaultVideoAge holds the default value on creation for the video_age field.
+	live.DefaultVideoAge = liveDescVideoAge.Default.(int64)
+	// liveDescDownloadClips is the schema descriptor for download_clips field.
+	liveDescDownloadClips := liveFields[13].Descriptor()
+	// live.DefaultDownloadClips holds the default value on creation for the download_clips field.
+	live.DefaultDownloadClips = liveDescDownloadClips.Default.(bool)
+	// liveDescClipsMinViews is the schema descriptor for clips_min_views field.
+	liveDescClipsMinViews := liveFields[14].Descriptor()
+	// live.DefaultClipsMinViews holds the default value on creation for the clips_min_views field.
+	live.DefaultClipsMinViews = liveDescClipsMinViews.Default.(int)
+	// liveDescClipsMaxAge is the schema descriptor for clips_max_age field.
+	liveDescClipsMaxAge := liveFields[15].Descriptor()
+	// live.DefaultClipsMaxAge holds the default value on creation for the clips_max_age field.
+	live.DefaultClipsMaxAge = liveDescClipsMaxAge.Default.(int64)
+	// liveDescVodReplacementMinDifference is the schema descriptor for vod_replacement_min_difference field.
+	liveDescVodReplacementMinDifference := liveFields[17].Descriptor()
+	// live.DefaultVodReplacementMinDifference holds the default value on creation for the vod_replacement_min_difference field.
+	live.DefaultVodReplacementMinDifference = liveDescVodReplacementMinDifference.Default.(int)
+	// liveDescUpdatedAt is the schema descriptor for updated_at field.
+	liveDescUpdatedAt := liveFields[18].Descriptor()
+	// live.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	live.DefaultUpdatedAt = liveDescUpdatedAt.Default.(func() time.Time)
+	// live.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	live.UpdateDefaultUpdatedAt = liveDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// liveDescCreatedAt is the schema descriptor for created_at field.
+	liveDescCreatedAt := liveFields[19].Descriptor()
+	// live.DefaultCreatedAt holds the default value on creation for the created_at field.
+	live.DefaultCreatedAt = liveDescCreatedAt.Default.(func() time.Time)
+	// liveDescID is the schema descriptor for id field.
+	liveDescID := liveFields[0].Descriptor()
+	// live.DefaultID holds the default value on creation for the id field.
+	live.DefaultID = liveDescID.Default.(func() uuid.UUID)
+	livecategoryFields := schema.LiveCategory{}.Fields()
+	_ = livecategoryFields
+	// livecategoryDescID is the schema descriptor for id field.
+	livecategoryDescID := livecategoryFields[0].Descriptor()
+	// livecategory.DefaultID holds the default value on creation for the id field.
+	livecategory.DefaultID = livecategoryDescID.Default.(func() uuid.UUID)
+	livetitleregexFields := schema.LiveTitleRegex{}.Fields()
+	_ = livetitleregexFields
+	// livetitleregexDescNegative is the schema descriptor for negative field.
+	livetitleregexDescNegative := livetitleregexFields[1].Descriptor()
+	// livetitleregex.DefaultNegative holds the default value on creation for the negative field.
+	livetitleregex.DefaultNegative = livetitleregexDescNegative.Default.(bool)
+	// livetitleregexDescApplyToVideos is the schema descriptor for apply_to_videos field.
+	livetitleregexDescApplyToVideos := livetitleregexFields[3].Descriptor()
+	// livetitleregex.DefaultApplyToVideos holds the default value on creation for the apply_to_videos field.
+	livetitleregex.DefaultApplyToVideos = livetitleregexDescApplyToVideos.Default.(bool)
+	// livetitleregexDescID is the schema descriptor for id field.
+	livetitleregexDescID := livetitleregexFields[0].Descriptor()
+	// livetitleregex.DefaultID holds the default value on creation for the id field.
+	livetitleregex.DefaultID = livetitleregexDescID.Default.(func() uuid.UUID)
+	mutedsegmentFields := schema.MutedSegment{}.Fields()
+	_ = mutedsegmentFields
+	// mutedsegmentDescID is the schema descriptor for id field.
+	mutedsegmentDescID := mutedsegmentFields[0].Descriptor()
+	// mutedsegment.DefaultID holds the default value on creation for the id field.
+	mutedsegment.DefaultID = mutedsegmentDescID.Default.(func() uuid.UUID)
+	notificationdeliveryFields := schema.NotificationDelivery{}.Fields()
+	_ = notificationdeliveryFields
+	// notificationdeliveryDescAttempts is the schema descriptor for attempts field.
+	notificationdeliveryDescAttempts := notificationdeliveryFields[6].Descriptor()
+	// notificationdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	notificationdelivery.DefaultAttempts = notificationdeliveryDescAttempts.Default.(int)
+	// notificationdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationdeliveryDescUpdatedAt := notificationdeliveryFields[14].Descriptor()
+	// notificationdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationdelivery.DefaultUpdatedAt = notificationdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// notificationdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationdelivery.UpdateDefaultUpdatedAt = notificationdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	notificationdeliveryDescCreatedAt := notificationdeliveryFields[15].Descriptor()
+	// notificationdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationdelivery.DefaultCreatedAt = notificationdeliveryDescCreatedAt.Default.(func() time.Time)
+	// notificationdeliveryDescID is the schema descriptor for id field.
+	notificationdeliveryDescID := notificationdeliveryFields[0].Descriptor()
+	// notificationdelivery.DefaultID holds the default value on creation for the id field.
+	notificationdelivery.DefaultID = notificationdeliveryDescID.Default.(func() uuid.UUID)
+	notificationsubscriptionFields := schema.NotificationSubscription{}.Fields()
+	_ = notificationsubscriptionFields
+	// notificationsubscriptionDescEnabled is the schema descriptor for enabled field.
+	notificationsubscriptionDescEnabled := notificationsubscriptionFields[2].Descriptor()
+	// notificationsubscription.DefaultEnabled holds the default value on creation for the enabled field.
+	notificationsubscription.DefaultEnabled = notificationsubscriptionDescEnabled.Default.(bool)
+	// notificationsubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationsubscriptionDescUpdatedAt := notificationsubscriptionFields[9].Descriptor()
+	// notificationsubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationsubscription.DefaultUpdatedAt = notificationsubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// notificationsubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationsubscription.UpdateDefaultUpdatedAt = notificationsubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	notificationsubscriptionDescCreatedAt := notificationsubscriptionFields[10].Descriptor()
+	// notificationsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationsubscription.DefaultCreatedAt = notificationsubscriptionDescCreatedAt.Default.(func() time.Time)
+	// notificationsubscriptionDescID is the schema descriptor for id field.
+	notificationsubscriptionDescID := notificationsubscriptionFields[0].Descriptor()
+	// notificationsubscription.DefaultID holds the default value on creation for the id field.
+	notificationsubscription.DefaultID = notificationsubscriptionDescID.Default.(func() uuid.UUID)
+	playbackFields := schema.Playback{}.Fields()
+	_ = playbackFields
+	// playbackDescTime is the schema descriptor for time field.
+	playbackDescTime := playbackFields[3].Descriptor()
+	// playback.DefaultTime holds the default value on creation for the time field.
+	playback.DefaultTime = playbackDescTime.Default.(int)
+	// playbackDescUpdatedAt is the schema descriptor for updated_at field.
+	playbackDescUpdatedAt := playbackFields[5].Descriptor()
+	// playback.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	playback.DefaultUpdatedAt = playbackDescUpdatedAt.Default.(func() time.Time)
+	// playback.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	playback.UpdateDefaultUpdatedAt = playbackDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// playbackDescCreatedAt is the schema descriptor for created_at field.
+	playbackDescCreatedAt := playbackFields[6].Descriptor()
+	// playback.DefaultCreatedAt holds the default value on creation for the created_at field.
+	playback.DefaultCreatedAt = playbackDescCreatedAt.Default.(func() time.Time)
+	// playbackDescID is the schema descriptor for id field.
+	playbackDescID := playbackFields[0].Descriptor()
+	// playback.DefaultID holds the default value on creation for the id field.
+	playback.DefaultID = playbackDescID.Default.(func() uuid.UUID)
+	playlistFields := schema.Playlist{}.Fields()
+	_ = playlistFields
+	// playlistDescUpdatedAt is the schema descriptor for updated_at field.
+	playlistDescUpdatedAt := playlistFields[4].Descriptor()
+	// playlist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	playlist.DefaultUpdatedAt = playlistDescUpdatedAt.Default.(func() time.Time)
+	// playlist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	playlist.UpdateDefaultUpdatedAt = playlistDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// playlistDescCreatedAt is the schema descriptor for created_at field.
+	playlistDescCreatedAt := playlistFields[5].Descriptor()
+	// playlist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	playlist.DefaultCreatedAt = playlistDescCreatedAt.Default.(func() time.Time)
+	// playlistDescID is the schema descriptor for id field.
+	playlistDescID := playlistFields[0].Descriptor()
+	// playlist.DefaultID holds the default value on creation for the id field.
+	playlist.DefaultID = playlistDescID.Default.(func() uuid.UUID)
+	queueFields := schema.Queue{}.Fields()
+	_ = queueFields
+	// queueDescLiveArchive is the schema descriptor for live_archive field.
+	queueDescLiveArchive := queueFields[1].Descriptor()
+	// queue.DefaultLiveArchive holds the default value on creation for the live_archive field.
+	queue.DefaultLiveArchive = queueDescLiveArchive.Default.(bool)
+	// queueDescOnHold is the schema descriptor for on_hold field.
+	queueDescOnHold := queueFields[2].Descriptor()
+	// queue.DefaultOnHold holds the default value on creation for the on_hold field.
+	queue.DefaultOnHold = queueDescOnHold.Default.(bool)
+	// queueDescPriority is the schema descriptor for priority field.
+	queueDescPriority := queueFields[3].Descriptor()
+	// queue.DefaultPriority holds the default value on creation for the priority field.
+	queue.DefaultPriority = queueDescPriority.Default.(int)
+	// queueDescPaused is the schema descriptor for paused field.
+	queueDescPaused := queueFields[4].Descriptor()
+	// queue.DefaultPaused holds the default value on creation for the paused field.
+	queue.DefaultPaused = queueDescPaused.Default.(bool)
+	// queueDescVideoProcessing is the schema descriptor for video_processing field.
+	queueDescVideoProcessing := queueFields[5].Descriptor()
+	// queue.DefaultVideoProcessing holds the default value on creation for the video_processing field.
+	queue.DefaultVideoProcessing = queueDescVideoProcessing.Default.(bool)
+	// queueDescChatProcessing is the schema descriptor for chat_processing field.
+	queueDescChatProcessing := queueFields[6].Descriptor()
+	// queue.DefaultChatProcessing holds the default value on creation for the chat_processing field.
+	queue.DefaultChatProcessing = queueDescChatProcessing.Default.(bool)
+	// queueDescProcessing is the schema descriptor for processing field.
+	queueDescProcessing := queueFields[7].Descriptor()
+	// queue.DefaultProcessing holds the default value on creation for the processing field.
+	queue.DefaultProcessing = queueDescProcessing.Default.(bool)
+	// queueDescRenderChat is the schema descriptor for render_chat field.
+	queueDescRenderChat := queueFields[19].Descriptor()
+	// queue.DefaultRenderChat holds the default value on creation for the render_chat field.
+	queue.DefaultRenderChat = queueDescRenderChat.Default.(bool)
+	// queueDescUpdatedAt is the schema descriptor for updated_at field.
+	queueDescUpdatedAt := queueFields[22].Descriptor()
+	// queue.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	queue.DefaultUpdatedAt = queueDescUpdatedAt.Default.(func() time.Time)
+	// queue.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	queue.UpdateDefaultUpdatedAt = queueDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// queueDescCreatedAt is the schema descriptor for created_at field.
+	queueDescCreatedAt := queueFields[23].Descriptor()
+	// queue.DefaultCreatedAt holds the default value on creation for the created_at field.
+	queue.DefaultCreatedAt = queueDescCreatedAt.Default.(func() time.Time)
+	// queueDescID is the schema descriptor for id field.
+	queueDescID := queueFields[0].Descriptor()
+	// queue.DefaultID holds the default value on creation for the id field.
+	queue.DefaultID = queueDescID.Default.(func() uuid.UUID)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	sessionDescLastSeenAt := sessionFields[3].Descriptor()
+	// session.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	session.DefaultLastSeenAt = sessionDescLastSeenAt.Default.(func() time.Time)
+	// sessionDescRefreshTokenID is the schema descriptor for refresh_token_id field.
+	sessionDescRefreshTokenID := sessionFields[5].Descriptor()
+	// session.DefaultRefreshTokenID holds the default value on creation for the refresh_token_id field.
+	session.DefaultRefreshTokenID = sessionDescRefreshTokenID.Default.(string)
+	// sessionDescUpdatedAt is the schema descriptor for updated_at field.
+	sessionDescUpdatedAt := sessionFields[6].Descriptor()
+	// session.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	session.DefaultUpdatedAt = sessionDescUpdatedAt.Default.(func() time.Time)
+	// session.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	session.UpdateDefaultUpdatedAt = sessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[7].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
+	twitchcategoryFields := schema.TwitchCategory{}.Fields()
+	_ = twitchcategoryFields
+	// twitchcategoryDescUpdatedAt is the schema descriptor for updated_at field.
+	twitchcategoryDescUpdatedAt := twitchcategoryFields[4].Descriptor()
+	// twitchcategory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	twitchcategory.DefaultUpdatedAt = twitchcategoryDescUpdatedAt.Default.(func() time.Time)
+	// twitchcategory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	twitchcategory.UpdateDefaultUpdatedAt = twitchcategoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// twitchcategoryDescCreatedAt is the schema descriptor for created_at field.
+	twitchcategoryDescCreatedAt := twitchcategoryFields[5].Descriptor()
+	// twitchcategory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	twitchcategory.DefaultCreatedAt = twitchcategoryDescCreatedAt.Default.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescOauth is the schema descriptor for oauth field.
+	userDescOauth := userFields[4].Descriptor()
+	// user.DefaultOauth holds the default value on creation for the oauth field.
+	user.DefaultOauth = userDescOauth.Default.(bool)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[7].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[8].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	vodMixin := schema.Vod{}.Mixin()
+	vodMixinInters0 := vodMixin[0].Interceptors()
+	vod.Interceptors[0] = vodMixinInters0[0]
+	vodFields := schema.Vod{}.Fields()
+	_ = vodFields
+	// vodDescDuration is the schema descriptor for duration field.
+	vodDescDuration := vodFields[5].Descriptor()
+	// vod.DefaultDuration holds the default value on creation for the duration field.
+	vod.DefaultDuration = vodDescDuration.Default.(int)
+	// vodDescViews is the schema descriptor for views field.
+	vodDescViews := vodFields[6].Descriptor()
+	// vod.DefaultViews holds the default value on creation for the views field.
+	vod.DefaultViews = vodDescViews.Default.(int)
+	// vodDescProcessing is the schema descriptor for processing field.
+	vodDescProcessing := vodFields[8].Descriptor()
+	// vod.DefaultProcessing holds the default value on creation for the processing field.
+	vod.DefaultProcessing = vodDescProcessing.Default.(bool)
+	// vodDescLocked is the schema descriptor for locked field.
+	vodDescLocked := vodFields[28].Descriptor()
+	// vod.DefaultLocked holds the default value on creation for the locked field.
+	vod.DefaultLocked = vodDescLocked.Default.(bool)
+	// vodDescLocalViews is the schema descriptor for local_views field.
+	vodDescLocalViews := vodFields[29].Descriptor()
+	// vod.DefaultLocalViews holds the default value on creation for the local_views field.
+	vod.DefaultLocalViews = vodDescLocalViews.Default.(int)
+	// vodDescReconnects is the schema descriptor for reconnects field.
+	vodDescReconnects := vodFields[33].Descriptor()
+	// vod.DefaultReconnects holds the default value on creation for the reconnects field.
+	vod.DefaultReconnects = vodDescReconnects.Default.(int)
+	// vodDescStreamedAt is the schema descriptor for streamed_at field.
+	vodDescStreamedAt := vodFields[42].Descriptor()
+	// vod.DefaultStreamedAt holds the default value on creation for the streamed_at field.
+	vod.DefaultStreamedAt = vodDescStreamedAt.Default.(func() time.Time)
+	// vodDescUpdatedAt is the schema descriptor for updated_at field.
+	vodDescUpdatedAt := vodFields[43].Descriptor()
+	// vod.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	vod.DefaultUpdatedAt = vodDescUpdatedAt.Default.(func() time.Time)
+	// vod.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	vod.UpdateDefaultUpdatedAt = vodDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// vodDescCreatedAt is the schema descriptor for created_at field.
+	vodDescCreatedAt := vodFields[44].Descriptor()
+	// vod.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vod.DefaultCreatedAt = vodDescCreatedAt.Default.(func() time.Time)
+	// vodDescID is the schema descriptor for id field.
+	vodDescID := vodFields[0].Descriptor()
+	// vod.DefaultID holds the default value on creation for the id field.
+	vod.DefaultID = vodDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.13.1"                                         // Version of ent codegen.
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ApiToken holds the schema definition for the ApiToken entity.
+type ApiToken struct {
+	ent.Schema
+}
+
+// Fields of the ApiToken.
+func (ApiToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name"),
+		field.String("token_hash").Unique().Sensitive().Comment("The SHA-256 hash of the token, the token itself is only shown when it is created."),
+		field.String("prefix").Comment("The first characters of the token to identify it."),
+		field.Enum("role").GoType(utils.Role("")).Comment("The highest role the token grants, capped to the role of the user."),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ApiToken.
+func (ApiToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("api_tokens").Unique().Required(),
+	}
+}
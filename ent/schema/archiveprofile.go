@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ArchiveProfile holds the schema definition for the ArchiveProfile entity.
+type ArchiveProfile struct {
+	ent.Schema
+}
+
+// Fields of the ArchiveProfile.
+func (ArchiveProfile) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").Unique(),
+		field.String("quality").Optional().Comment("The quality to archive in. Empty uses the quality of the request or watched channel."),
+		field.String("video_convert").Optional().Comment("FFmpeg arguments for the video convert. Empty uses the global config."),
+		field.Bool("save_as_hls").Optional().Nillable().Comment("Whether the video is converted to HLS. Nil uses the global config."),
+		field.Bool("render_chat").Default(true).Comment("Whether the chat should be rendered."),
+		field.String("chat_render").Optional().Comment("TwitchDownloaderCLI arguments for the chat render. Empty uses the global config."),
+		field.String("streamlink_live").Optional().Comment("Streamlink arguments for live streams. Empty uses the global config."),
+		field.String("folder_template").Optional().Comment("Storage template for the folder name. Empty uses the global config."),
+		field.String("file_template").Optional().Comment("Storage template for the file name. Empty uses the global config."),
+		field.Enum("proxy_policy").GoType(utils.ProxyPolicy("")).Default(string(utils.ProxyPolicyDefault)).Comment("Whether live streams are downloaded through the configured proxies, takes an enum. Default uses the global config."),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ArchiveProfile.
+func (ArchiveProfile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("channels", Channel.Type),
+		edge.To("live", Live.Type),
+		edge.To("vods", Vod.Type),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+// Events keep the user as it was so they outlive the users they record.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("action").GoType(utils.AuditAction("")).Comment("The action, takes an enum."),
+		field.String("entity_type").Comment("The type of the entity the action was done to."),
+		field.String("entity_id").Optional().Comment("The ID of the entity the action was done to."),
+		field.UUID("user_id", uuid.UUID{}).Optional().Nillable().Comment("The user who did the action. Empty for actions of the scheduler."),
+		field.String("username").Optional(),
+		field.String("ip").Optional().Comment("The IP address of the request."),
+		field.JSON("details", map[string]interface{}{}).Optional().Comment("Parameters of the action."),
+		field.JSON("changes", map[string]utils.AuditChange{}).Optional().Comment("The changed fields of the entity with their values before and after the action."),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the AuditEvent.
+func (AuditEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("entity_type", "entity_id"),
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // Channel holds the schema definition for the Channel entity.
@@ -22,17 +24,39 @@ func (Channel) Fields() []ent.Field {
 		field.String("name").Unique(),
 		field.String("display_name").Unique(),
 		field.String("image_path"),
+		field.Enum("platform").GoType(utils.VodPlatform("")).Default(string(utils.PlatformTwitch)).Comment("The platform the channel is from, takes an enum."),
 		field.Bool("retention").Default(false),
-		field.Int64("retention_days").Optional(),
+		field.Int64("retention_days").Optional().Comment("Prune videos archived more than this many days ago. 0 disables the rule."),
+		field.JSON("retention_type_days", map[utils.VodType]int64{}).Optional().Comment("Retention days by video type overriding retention_days. 0 never prunes the type by age."),
+		field.Int64("retention_keep_last").Optional().Comment("Prune videos that are not in this many newest videos. 0 disables the rule."),
+		field.Int64("retention_max_size_gb").Optional().Comment("Prune the oldest videos while the videos of the channel use more than this many GB. 0 disables the rule."),
+		field.Bool("retention_protect_playlists").Default(true).Comment("Whether videos in a playlist are never pruned."),
+		field.Bool("retention_protect_watched").Default(true).Comment("Whether videos watched by a user are never pruned."),
+		field.Enum("retention_action").GoType(utils.RetentionAction("")).Default(string(utils.RetentionDelete)).Comment("What is deleted from pruned videos, takes an enum."),
+		field.Bool("cold_storage").Default(false).Comment("Whether videos are moved to the cold storage root by the lifecycle rules."),
+		field.Int64("cold_storage_days").Optional().Comment("Move videos archived more than this many days ago. 0 disables the rule."),
+		field.Int64("cold_storage_unwatched_days").Optional().Comment("Move videos not watched in this many days. 0 disables the rule."),
+		field.Bool("restricted").Default(false).Comment("Whether only admins and users granted a channel permission can see the channel and its videos."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
 }
 
+// Mixin of the Channel.
+func (Channel) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Edges of the Channel.
 func (Channel) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("vods", Vod.Type),
 		edge.To("live", Live.Type),
+		edge.From("archive_profile", ArchiveProfile.Type).Ref("channels").Unique(),
+		edge.To("permissions", ChannelPermission.Type).Annotations(
+			entsql.OnDelete(entsql.Cascade),
+		),
 	}
 }
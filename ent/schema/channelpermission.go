@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ChannelPermission holds the schema definition for the ChannelPermission entity.
+// It grants a user or a group access to a restricted channel.
+type ChannelPermission struct {
+	ent.Schema
+}
+
+// Fields of the ChannelPermission.
+func (ChannelPermission) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("access").GoType(utils.ChannelAccess("")).Default(string(utils.ChannelAccessView)).Comment("The access granted to the channel, takes an enum."),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the ChannelPermission.
+func (ChannelPermission) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("channel", Channel.Type).Ref("permissions").Unique().Required(),
+		edge.From("user", User.Type).Ref("channel_permissions").Unique(),
+		edge.From("group", Group.Type).Ref("channel_permissions").Unique(),
+	}
+}
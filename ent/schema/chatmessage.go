@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ChatMessage holds the schema definition for the ChatMessage entity.
+type ChatMessage struct {
+	ent.Schema
+}
+
+// Fields of the ChatMessage.
+func (ChatMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("comment_id").Optional().Comment("The ID of the comment on the platform."),
+		field.Float("content_offset_seconds").Comment("The offset of the comment from the start of the video."),
+		field.String("commenter_id").Optional(),
+		field.String("commenter_name").Optional(),
+		field.String("commenter_display_name").Optional(),
+		field.Strings("commenter_badges").Optional().Comment("The IDs of the badges of the commenter."),
+		field.Text("body").Optional().Comment("The text of the comment."),
+		field.Text("data").Comment("The comment as it is stored in the chat file."),
+	}
+}
+
+// Edges of the ChatMessage.
+func (ChatMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("vod", Vod.Type).Ref("chat_messages").Unique().Required(),
+	}
+}
+
+// Indexes of the ChatMessage.
+func (ChatMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("vod").Fields("content_offset_seconds"),
+		// trigram indexes for the case-insensitive substring search of the chat, requires the pg_trgm extension
+		index.Fields("body").Annotations(chatSearchIndex()),
+		index.Fields("commenter_name").Annotations(chatSearchIndex()),
+		index.Fields("commenter_display_name").Annotations(chatSearchIndex()),
+	}
+}
+
+func chatSearchIndex() *entsql.IndexAnnotation {
+	return &entsql.IndexAnnotation{
+		Types:   map[string]string{dialect.Postgres: "GIN"},
+		OpClass: "gin_trgm_ops",
+	}
+}
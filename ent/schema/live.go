@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 // Live holds the schema definition for the Live entity.
@@ -34,6 +35,11 @@ func (Live) Fields() []ent.Field {
 		field.Time("last_live").Default(time.Now).Comment("The time the channel last went live."),
 		field.Bool("render_chat").Default(true).Comment("Whether the chat should be rendered."),
 		field.Int64("video_age").Default(0).Comment("Restrict fetching videos to a certain age."),
+		field.Bool("download_clips").Default(false).Comment("Download clips"),
+		field.Int("clips_min_views").Default(0).Comment("Minimum number of views a clip needs to be downloaded."),
+		field.Int64("clips_max_age").Default(7).Comment("Only download clips created in the last X days."),
+		field.Enum("vod_replacement").GoType(utils.LiveVodReplacement("")).Default(string(utils.LiveVodReplacementNone)).Comment("What to do with a live archive when the official VOD is a better copy, takes an enum."),
+		field.Int("vod_replacement_min_difference").Default(60).Comment("Archive the official VOD if the live archive is at least X seconds shorter."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
@@ -43,6 +49,7 @@ func (Live) Fields() []ent.Field {
 func (Live) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("channel", Channel.Type).Ref("live").Unique().Required(),
+		edge.From("archive_profile", ArchiveProfile.Type).Ref("live").Unique(),
 		edge.To("categories", LiveCategory.Type).StorageKey(edge.Column("live_id")),
 		edge.To("title_regex", LiveTitleRegex.Type).StorageKey(edge.Column("live_id")).Annotations(
 			entsql.OnDelete(entsql.Cascade),
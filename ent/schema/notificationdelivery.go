@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationDelivery holds the schema definition for the NotificationDelivery entity.
+type NotificationDelivery struct {
+	ent.Schema
+}
+
+// Fields of the NotificationDelivery.
+func (NotificationDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Enum("event").GoType(utils.NotificationEvent("")).Comment("The event the notification was sent for, takes an enum."),
+		field.Enum("provider").GoType(utils.NotificationProvider("")).Comment("The provider the notification was sent with, takes an enum."),
+		field.String("target").Comment("The name of the notification target."),
+		field.UUID("subscription_id", uuid.UUID{}).Optional().Nillable().Comment("The subscription the notification was sent to, if it was not sent to a configured target."),
+		field.Enum("status").GoType(utils.TaskStatus("")).Default(string(utils.Pending)),
+		field.Int("attempts").Default(0).Comment("The number of delivery attempts."),
+		field.Int("status_code").Optional().Comment("The HTTP status code of the last attempt."),
+		field.Text("error").Optional().Comment("The error of the last attempt."),
+		field.Text("title").Optional(),
+		field.Text("body").Optional(),
+		field.Text("json_body").Optional().Comment("The JSON object rendered by the template, sent instead of the default request body."),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		field.Time("delivered_at").Optional().Nillable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the NotificationDelivery.
+func (NotificationDelivery) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the NotificationDelivery.
+func (NotificationDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// NotificationSubscription holds the schema definition for the NotificationSubscription entity.
+type NotificationSubscription struct {
+	ent.Schema
+}
+
+// Fields of the NotificationSubscription.
+func (NotificationSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").Optional(),
+		field.Bool("enabled").Default(true),
+		field.JSON("events", []utils.NotificationEvent{}).Comment("The events the subscription receives."),
+		field.Enum("provider").GoType(utils.NotificationProvider("")).Default(string(utils.NotificationWebhook)).Comment("The provider notifications are sent with, takes an enum."),
+		field.String("url").Optional().Comment("The URL of the target, the webhook of the user is used if empty."),
+		field.String("token").Optional().Sensitive(),
+		field.String("room").Optional(),
+		field.Text("template").Optional().Comment("The template of the notifications, the template of the event is used if empty."),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the NotificationSubscription.
+func (NotificationSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("notification_subscriptions").Unique().Required(),
+		// the subscription receives events of all channels if it has no channels
+		edge.To("channels", Channel.Type),
+	}
+}
@@ -21,6 +21,10 @@ func (Queue) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.Bool("live_archive").Default(false),
 		field.Bool("on_hold").Default(false),
+		// Tasks of queue items with a higher priority are dispatched first. Live archives default to high priority.
+		field.Int("priority").Default(utils.QueuePriorityNormal),
+		// Paused queue items are not dispatched to the video-download, video-convert and chat-render queues until resumed.
+		field.Bool("paused").Default(false),
 		field.Bool("video_processing").Default(true),
 		field.Bool("chat_processing").Default(true),
 		field.Bool("processing").Default(true),
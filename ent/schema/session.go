@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Session holds the schema definition for the Session entity.
+type Session struct {
+	ent.Schema
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("user_agent").Optional().Comment("The user agent of the device the user logged in on."),
+		field.String("ip").Optional().Comment("The IP address the session was last used from."),
+		field.Time("last_seen_at").Default(time.Now),
+		field.Time("expires_at").Comment("The time the refresh token of the session expires, refreshing the tokens extends it."),
+		field.String("refresh_token_id").Optional().Default("").Sensitive().Comment("The ID of the current refresh token of the session, it changes on every refresh so used refresh tokens are rejected."),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).Ref("sessions").Unique().Required(),
+	}
+}
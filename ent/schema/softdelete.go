@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/zibbp/ganymede/ent/intercept"
+)
+
+type softDeleteKey struct{}
+
+// SkipSoftDelete returns a context whose queries include soft deleted entities, e.g. to list, restore or purge the trash.
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, softDeleteKey{}, true)
+}
+
+// SoftDeleteMixin moves entities to the trash instead of deleting them.
+// Entities with a deleted_at time are left out of all queries, including the loading of edges.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields of the SoftDeleteMixin.
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").Optional().Nillable().Comment("The time the entity was moved to the trash."),
+	}
+}
+
+// Interceptors of the SoftDeleteMixin.
+func (SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if skip, _ := ctx.Value(softDeleteKey{}).(bool); skip {
+				return nil
+			}
+			q.WhereP(sql.FieldIsNull("deleted_at"))
+			return nil
+		}),
+	}
+}
@@ -2,6 +2,8 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/zibbp/ganymede/internal/utils"
@@ -30,5 +32,19 @@ func (User) Fields() []ent.Field {
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("notification_subscriptions", NotificationSubscription.Type).Annotations(
+			entsql.OnDelete(entsql.Cascade),
+		),
+		edge.To("api_tokens", ApiToken.Type).Annotations(
+			entsql.OnDelete(entsql.Cascade),
+		),
+		edge.To("sessions", Session.Type).Annotations(
+			entsql.OnDelete(entsql.Cascade),
+		),
+		edge.To("channel_permissions", ChannelPermission.Type).Annotations(
+			entsql.OnDelete(entsql.Cascade),
+		),
+		edge.From("groups", Group.Type).Ref("users"),
+	}
 }
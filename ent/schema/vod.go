@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -48,12 +49,31 @@ func (Vod) Fields() []ent.Field {
 		field.String("tmp_video_hls_path").Optional().Comment("The path where the temporary video hls files are"),
 		field.Bool("locked").Default(false),
 		field.Int("local_views").Default(0),
+		field.Time("last_viewed_at").Optional().Nillable().Comment("The time the video was last played."),
+		field.String("clip_ext_vod_id").Optional().Comment("The external ID of the VOD a clip was created from."),
+		field.Int("clip_vod_offset").Optional().Comment("The offset in seconds of a clip in the VOD it was created from."),
+		field.Int("reconnects").Default(0).Comment("The number of times the live stream recording reconnected."),
+		field.Time("cold_storage_at").Optional().Nillable().Comment("The time the video was moved to the cold storage root."),
+		field.Time("chat_imported_at").Optional().Nillable().Comment("The time the chat was imported into the chat message table."),
+		field.Enum("health").GoType(utils.VodHealth("")).Default(string(utils.HealthUnverified)).Comment("The result of the last integrity verification, takes an enum."),
+		field.Strings("health_issues").Optional().Comment("The problems found by the last integrity verification."),
+		field.Time("verified_at").Optional().Nillable().Comment("The time the integrity of the video was last verified."),
+		field.Time("video_pruned_at").Optional().Nillable().Comment("The time the retention policy deleted the video file, keeping the metadata of the video."),
+		field.String("trash_path").Optional().Comment("The folder the files of the video were moved to in the trash."),
+		field.Enum("storage_backend").GoType(utils.StorageBackend("")).Default(string(utils.StorageLocal)).Comment("The storage backend the video files are stored in, takes an enum."),
 		field.Time("streamed_at").Default(time.Now).Comment("The time the VOD was streamed."),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
 }
 
+// Mixin of the Vod.
+func (Vod) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
 // Edges of the Vod.
 func (Vod) Edges() []ent.Edge {
 	return []ent.Edge{
@@ -62,5 +82,11 @@ func (Vod) Edges() []ent.Edge {
 		edge.From("playlists", Playlist.Type).Ref("vods"),
 		edge.To("chapters", Chapter.Type),
 		edge.To("muted_segments", MutedSegment.Type),
+		edge.To("clips", Vod.Type).From("clip_source").Unique(),
+		edge.To("alternate", Vod.Type).Unique(),
+		edge.From("archive_profile", ArchiveProfile.Type).Ref("vods").Unique(),
+		edge.To("chat_messages", ChatMessage.Type).Annotations(
+			entsql.OnDelete(entsql.Cascade),
+		),
 	}
 }
@@ -12,10 +12,22 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApiToken is the client for interacting with the ApiToken builders.
+	ApiToken *ApiTokenClient
+	// ArchiveProfile is the client for interacting with the ArchiveProfile builders.
+	ArchiveProfile *ArchiveProfileClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
 	// Channel is the client for interacting with the Channel builders.
 	Channel *ChannelClient
+	// ChannelPermission is the client for interacting with the ChannelPermission builders.
+	ChannelPermission *ChannelPermissionClient
 	// Chapter is the client for interacting with the Chapter builders.
 	Chapter *ChapterClient
+	// ChatMessage is the client for interacting with the ChatMessage builders.
+	ChatMessage *ChatMessageClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
 	// Live is the client for interacting with the Live builders.
 	Live *LiveClient
 	// LiveCategory is the client for interacting with the LiveCategory builders.
@@ -24,12 +36,18 @@ type Tx struct {
 	LiveTitleRegex *LiveTitleRegexClient
 	// MutedSegment is the client for interacting with the MutedSegment builders.
 	MutedSegment *MutedSegmentClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
+	// NotificationSubscription is the client for interacting with the NotificationSubscription builders.
+	NotificationSubscription *NotificationSubscriptionClient
 	// Playback is the client for interacting with the Playback builders.
 	Playback *PlaybackClient
 	// Playlist is the client for interacting with the Playlist builders.
 	Playlist *PlaylistClient
 	// Queue is the client for interacting with the Queue builders.
 	Queue *QueueClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
 	// TwitchCategory is the client for interacting with the TwitchCategory builders.
 	TwitchCategory *TwitchCategoryClient
 	// User is the client for interacting with the User builders.
@@ -167,15 +185,24 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApiToken = NewApiTokenClient(tx.config)
+	tx.ArchiveProfile = NewArchiveProfileClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
 	tx.Channel = NewChannelClient(tx.config)
+	tx.ChannelPermission = NewChannelPermissionClient(tx.config)
 	tx.Chapter = NewChapterClient(tx.config)
+	tx.ChatMessage = NewChatMessageClient(tx.config)
+	tx.Group = NewGroupClient(tx.config)
 	tx.Live = NewLiveClient(tx.config)
 	tx.LiveCategory = NewLiveCategoryClient(tx.config)
 	tx.LiveTitleRegex = NewLiveTitleRegexClient(tx.config)
 	tx.MutedSegment = NewMutedSegmentClient(tx.config)
+	tx.NotificationDelivery = NewNotificationDeliveryClient(tx.config)
+	tx.NotificationSubscription = NewNotificationSubscriptionClient(tx.config)
 	tx.Playback = NewPlaybackClient(tx.config)
 	tx.Playlist = NewPlaylistClient(tx.config)
 	tx.Queue = NewQueueClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
 	tx.TwitchCategory = NewTwitchCategoryClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.Vod = NewVodClient(tx.config)
@@ -188,7 +215,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Channel.QueryXXX(), the query will be executed
+// applies a query, for example: ApiToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
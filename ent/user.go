@@ -34,10 +34,75 @@ type User struct {
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
-	CreatedAt    time.Time `json:"created_at,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges        UserEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// NotificationSubscriptions holds the value of the notification_subscriptions edge.
+	NotificationSubscriptions []*NotificationSubscription `json:"notification_subscriptions,omitempty"`
+	// APITokens holds the value of the api_tokens edge.
+	APITokens []*ApiToken `json:"api_tokens,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// ChannelPermissions holds the value of the channel_permissions edge.
+	ChannelPermissions []*ChannelPermission `json:"channel_permissions,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// NotificationSubscriptionsOrErr returns the NotificationSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationSubscriptionsOrErr() ([]*NotificationSubscription, error) {
+	if e.loadedTypes[0] {
+		return e.NotificationSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "notification_subscriptions"}
+}
+
+// APITokensOrErr returns the APITokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APITokensOrErr() ([]*ApiToken, error) {
+	if e.loadedTypes[1] {
+		return e.APITokens, nil
+	}
+	return nil, &NotLoadedError{edge: "api_tokens"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[2] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// ChannelPermissionsOrErr returns the ChannelPermissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ChannelPermissionsOrErr() ([]*ChannelPermission, error) {
+	if e.loadedTypes[3] {
+		return e.ChannelPermissions, nil
+	}
+	return nil, &NotLoadedError{edge: "channel_permissions"}
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[4] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -133,6 +198,31 @@ func (u *User) Value(name string) (ent.Value, error) {
 	return u.selectValues.Get(name)
 }
 
+// QueryNotificationSubscriptions queries the "notification_subscriptions" edge of the User entity.
+func (u *User) QueryNotificationSubscriptions() *NotificationSubscriptionQuery {
+	return NewUserClient(u.config).QueryNotificationSubscriptions(u)
+}
+
+// QueryAPITokens queries the "api_tokens" edge of the User entity.
+func (u *User) QueryAPITokens() *ApiTokenQuery {
+	return NewUserClient(u.config).QueryAPITokens(u)
+}
+
+// QuerySessions queries the "sessions" edge of the User entity.
+func (u *User) QuerySessions() *SessionQuery {
+	return NewUserClient(u.config).QuerySessions(u)
+}
+
+// QueryChannelPermissions queries the "channel_permissions" edge of the User entity.
+func (u *User) QueryChannelPermissions() *ChannelPermissionQuery {
+	return NewUserClient(u.config).QueryChannelPermissions(u)
+}
+
+// QueryGroups queries the "groups" edge of the User entity.
+func (u *User) QueryGroups() *GroupQuery {
+	return NewUserClient(u.config).QueryGroups(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
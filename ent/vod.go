@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/queue"
 	"github.com/zibbp/ganymede/ent/vod"
@@ -21,6 +23,8 @@ type Vod struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
+	// The time the entity was moved to the trash.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// ExtID holds the value of the "ext_id" field.
 	ExtID string `json:"ext_id,omitempty"`
 	// The platform the VOD is from, takes an enum.
@@ -79,6 +83,30 @@ type Vod struct {
 	Locked bool `json:"locked,omitempty"`
 	// LocalViews holds the value of the "local_views" field.
 	LocalViews int `json:"local_views,omitempty"`
+	// The time the video was last played.
+	LastViewedAt *time.Time `json:"last_viewed_at,omitempty"`
+	// The external ID of the VOD a clip was created from.
+	ClipExtVodID string `json:"clip_ext_vod_id,omitempty"`
+	// The offset in seconds of a clip in the VOD it was created from.
+	ClipVodOffset int `json:"clip_vod_offset,omitempty"`
+	// The number of times the live stream recording reconnected.
+	Reconnects int `json:"reconnects,omitempty"`
+	// The time the video was moved to the cold storage root.
+	ColdStorageAt *time.Time `json:"cold_storage_at,omitempty"`
+	// The time the chat was imported into the chat message table.
+	ChatImportedAt *time.Time `json:"chat_imported_at,omitempty"`
+	// The result of the last integrity verification, takes an enum.
+	Health utils.VodHealth `json:"health,omitempty"`
+	// The problems found by the last integrity verification.
+	HealthIssues []string `json:"health_issues,omitempty"`
+	// The time the integrity of the video was last verified.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	// The time the retention policy deleted the video file, keeping the metadata of the video.
+	VideoPrunedAt *time.Time `json:"video_pruned_at,omitempty"`
+	// The folder the files of the video were moved to in the trash.
+	TrashPath string `json:"trash_path,omitempty"`
+	// The storage backend the video files are stored in, takes an enum.
+	StorageBackend utils.StorageBackend `json:"storage_backend,omitempty"`
 	// The time the VOD was streamed.
 	StreamedAt time.Time `json:"streamed_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -87,9 +115,12 @@ type Vod struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the VodQuery when eager-loading is set.
-	Edges        VodEdges `json:"edges"`
-	channel_vods *uuid.UUID
-	selectValues sql.SelectValues
+	Edges                VodEdges `json:"edges"`
+	archive_profile_vods *uuid.UUID
+	channel_vods         *uuid.UUID
+	vod_clips            *uuid.UUID
+	vod_alternate        *uuid.UUID
+	selectValues         sql.SelectValues
 }
 
 // VodEdges holds the relations/edges for other nodes in the graph.
@@ -104,9 +135,19 @@ type VodEdges struct {
 	Chapters []*Chapter `json:"chapters,omitempty"`
 	// MutedSegments holds the value of the muted_segments edge.
 	MutedSegments []*MutedSegment `json:"muted_segments,omitempty"`
+	// ClipSource holds the value of the clip_source edge.
+	ClipSource *Vod `json:"clip_source,omitempty"`
+	// Clips holds the value of the clips edge.
+	Clips []*Vod `json:"clips,omitempty"`
+	// Alternate holds the value of the alternate edge.
+	Alternate *Vod `json:"alternate,omitempty"`
+	// ArchiveProfile holds the value of the archive_profile edge.
+	ArchiveProfile *ArchiveProfile `json:"archive_profile,omitempty"`
+	// ChatMessages holds the value of the chat_messages edge.
+	ChatMessages []*ChatMessage `json:"chat_messages,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [10]bool
 }
 
 // ChannelOrErr returns the Channel value or an error if the edge
@@ -158,22 +199,81 @@ func (e VodEdges) MutedSegmentsOrErr() ([]*MutedSegment, error) {
 	return nil, &NotLoadedError{edge: "muted_segments"}
 }
 
+// ClipSourceOrErr returns the ClipSource value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VodEdges) ClipSourceOrErr() (*Vod, error) {
+	if e.ClipSource != nil {
+		return e.ClipSource, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: vod.Label}
+	}
+	return nil, &NotLoadedError{edge: "clip_source"}
+}
+
+// ClipsOrErr returns the Clips value or an error if the edge
+// was not loaded in eager-loading.
+func (e VodEdges) ClipsOrErr() ([]*Vod, error) {
+	if e.loadedTypes[6] {
+		return e.Clips, nil
+	}
+	return nil, &NotLoadedError{edge: "clips"}
+}
+
+// AlternateOrErr returns the Alternate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VodEdges) AlternateOrErr() (*Vod, error) {
+	if e.Alternate != nil {
+		return e.Alternate, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: vod.Label}
+	}
+	return nil, &NotLoadedError{edge: "alternate"}
+}
+
+// ArchiveProfileOrErr returns the ArchiveProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VodEdges) ArchiveProfileOrErr() (*ArchiveProfile, error) {
+	if e.ArchiveProfile != nil {
+		return e.ArchiveProfile, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: archiveprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "archive_profile"}
+}
+
+// ChatMessagesOrErr returns the ChatMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e VodEdges) ChatMessagesOrErr() ([]*ChatMessage, error) {
+	if e.loadedTypes[9] {
+		return e.ChatMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "chat_messages"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Vod) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case vod.FieldHealthIssues:
+			values[i] = new([]byte)
 		case vod.FieldProcessing, vod.FieldLocked:
 			values[i] = new(sql.NullBool)
-		case vod.FieldDuration, vod.FieldViews, vod.FieldLocalViews:
+		case vod.FieldDuration, vod.FieldViews, vod.FieldLocalViews, vod.FieldClipVodOffset, vod.FieldReconnects:
 			values[i] = new(sql.NullInt64)
-		case vod.FieldExtID, vod.FieldPlatform, vod.FieldType, vod.FieldTitle, vod.FieldResolution, vod.FieldThumbnailPath, vod.FieldWebThumbnailPath, vod.FieldVideoPath, vod.FieldVideoHlsPath, vod.FieldChatPath, vod.FieldLiveChatPath, vod.FieldLiveChatConvertPath, vod.FieldChatVideoPath, vod.FieldInfoPath, vod.FieldCaptionPath, vod.FieldFolderName, vod.FieldFileName, vod.FieldTmpVideoDownloadPath, vod.FieldTmpVideoConvertPath, vod.FieldTmpChatDownloadPath, vod.FieldTmpLiveChatDownloadPath, vod.FieldTmpLiveChatConvertPath, vod.FieldTmpChatRenderPath, vod.FieldTmpVideoHlsPath:
+		case vod.FieldExtID, vod.FieldPlatform, vod.FieldType, vod.FieldTitle, vod.FieldResolution, vod.FieldThumbnailPath, vod.FieldWebThumbnailPath, vod.FieldVideoPath, vod.FieldVideoHlsPath, vod.FieldChatPath, vod.FieldLiveChatPath, vod.FieldLiveChatConvertPath, vod.FieldChatVideoPath, vod.FieldInfoPath, vod.FieldCaptionPath, vod.FieldFolderName, vod.FieldFileName, vod.FieldTmpVideoDownloadPath, vod.FieldTmpVideoConvertPath, vod.FieldTmpChatDownloadPath, vod.FieldTmpLiveChatDownloadPath, vod.FieldTmpLiveChatConvertPath, vod.FieldTmpChatRenderPath, vod.FieldTmpVideoHlsPath, vod.FieldClipExtVodID, vod.FieldHealth, vod.FieldTrashPath, vod.FieldStorageBackend:
 			values[i] = new(sql.NullString)
-		case vod.FieldStreamedAt, vod.FieldUpdatedAt, vod.FieldCreatedAt:
+		case vod.FieldDeletedAt, vod.FieldLastViewedAt, vod.FieldColdStorageAt, vod.FieldChatImportedAt, vod.FieldVerifiedAt, vod.FieldVideoPrunedAt, vod.FieldStreamedAt, vod.FieldUpdatedAt, vod.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case vod.FieldID:
 			values[i] = new(uuid.UUID)
-		case vod.ForeignKeys[0]: // channel_vods
+		case vod.ForeignKeys[0]: // archive_profile_vods
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case vod.ForeignKeys[1]: // channel_vods
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case vod.ForeignKeys[2]: // vod_clips
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case vod.ForeignKeys[3]: // vod_alternate
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -196,6 +296,13 @@ func (v *Vod) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				v.ID = *value
 			}
+		case vod.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				v.DeletedAt = new(time.Time)
+				*v.DeletedAt = value.Time
+			}
 		case vod.FieldExtID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field ext_id", values[i])
@@ -370,6 +477,85 @@ func (v *Vod) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				v.LocalViews = int(value.Int64)
 			}
+		case vod.FieldLastViewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_viewed_at", values[i])
+			} else if value.Valid {
+				v.LastViewedAt = new(time.Time)
+				*v.LastViewedAt = value.Time
+			}
+		case vod.FieldClipExtVodID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field clip_ext_vod_id", values[i])
+			} else if value.Valid {
+				v.ClipExtVodID = value.String
+			}
+		case vod.FieldClipVodOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clip_vod_offset", values[i])
+			} else if value.Valid {
+				v.ClipVodOffset = int(value.Int64)
+			}
+		case vod.FieldReconnects:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reconnects", values[i])
+			} else if value.Valid {
+				v.Reconnects = int(value.Int64)
+			}
+		case vod.FieldColdStorageAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cold_storage_at", values[i])
+			} else if value.Valid {
+				v.ColdStorageAt = new(time.Time)
+				*v.ColdStorageAt = value.Time
+			}
+		case vod.FieldChatImportedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_imported_at", values[i])
+			} else if value.Valid {
+				v.ChatImportedAt = new(time.Time)
+				*v.ChatImportedAt = value.Time
+			}
+		case vod.FieldHealth:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field health", values[i])
+			} else if value.Valid {
+				v.Health = utils.VodHealth(value.String)
+			}
+		case vod.FieldHealthIssues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field health_issues", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &v.HealthIssues); err != nil {
+					return fmt.Errorf("unmarshal field health_issues: %w", err)
+				}
+			}
+		case vod.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				v.VerifiedAt = new(time.Time)
+				*v.VerifiedAt = value.Time
+			}
+		case vod.FieldVideoPrunedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field video_pruned_at", values[i])
+			} else if value.Valid {
+				v.VideoPrunedAt = new(time.Time)
+				*v.VideoPrunedAt = value.Time
+			}
+		case vod.FieldTrashPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trash_path", values[i])
+			} else if value.Valid {
+				v.TrashPath = value.String
+			}
+		case vod.FieldStorageBackend:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_backend", values[i])
+			} else if value.Valid {
+				v.StorageBackend = utils.StorageBackend(value.String)
+			}
 		case vod.FieldStreamedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field streamed_at", values[i])
@@ -389,12 +575,33 @@ func (v *Vod) assignValues(columns []string, values []any) error {
 				v.CreatedAt = value.Time
 			}
 		case vod.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field archive_profile_vods", values[i])
+			} else if value.Valid {
+				v.archive_profile_vods = new(uuid.UUID)
+				*v.archive_profile_vods = *value.S.(*uuid.UUID)
+			}
+		case vod.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field channel_vods", values[i])
 			} else if value.Valid {
 				v.channel_vods = new(uuid.UUID)
 				*v.channel_vods = *value.S.(*uuid.UUID)
 			}
+		case vod.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_clips", values[i])
+			} else if value.Valid {
+				v.vod_clips = new(uuid.UUID)
+				*v.vod_clips = *value.S.(*uuid.UUID)
+			}
+		case vod.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field vod_alternate", values[i])
+			} else if value.Valid {
+				v.vod_alternate = new(uuid.UUID)
+				*v.vod_alternate = *value.S.(*uuid.UUID)
+			}
 		default:
 			v.selectValues.Set(columns[i], values[i])
 		}
@@ -433,6 +640,31 @@ func (v *Vod) QueryMutedSegments() *MutedSegmentQuery {
 	return NewVodClient(v.config).QueryMutedSegments(v)
 }
 
+// QueryClipSource queries the "clip_source" edge of the Vod entity.
+func (v *Vod) QueryClipSource() *VodQuery {
+	return NewVodClient(v.config).QueryClipSource(v)
+}
+
+// QueryClips queries the "clips" edge of the Vod entity.
+func (v *Vod) QueryClips() *VodQuery {
+	return NewVodClient(v.config).QueryClips(v)
+}
+
+// QueryAlternate queries the "alternate" edge of the Vod entity.
+func (v *Vod) QueryAlternate() *VodQuery {
+	return NewVodClient(v.config).QueryAlternate(v)
+}
+
+// QueryArchiveProfile queries the "archive_profile" edge of the Vod entity.
+func (v *Vod) QueryArchiveProfile() *ArchiveProfileQuery {
+	return NewVodClient(v.config).QueryArchiveProfile(v)
+}
+
+// QueryChatMessages queries the "chat_messages" edge of the Vod entity.
+func (v *Vod) QueryChatMessages() *ChatMessageQuery {
+	return NewVodClient(v.config).QueryChatMessages(v)
+}
+
 // Update returns a builder for updating this Vod.
 // Note that you need to call Vod.Unwrap() before calling this method if this Vod
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -456,6 +688,11 @@ func (v *Vod) String() string {
 	var builder strings.Builder
 	builder.WriteString("Vod(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", v.ID))
+	if v := v.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("ext_id=")
 	builder.WriteString(v.ExtID)
 	builder.WriteString(", ")
@@ -543,6 +780,52 @@ func (v *Vod) String() string {
 	builder.WriteString("local_views=")
 	builder.WriteString(fmt.Sprintf("%v", v.LocalViews))
 	builder.WriteString(", ")
+	if v := v.LastViewedAt; v != nil {
+		builder.WriteString("last_viewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("clip_ext_vod_id=")
+	builder.WriteString(v.ClipExtVodID)
+	builder.WriteString(", ")
+	builder.WriteString("clip_vod_offset=")
+	builder.WriteString(fmt.Sprintf("%v", v.ClipVodOffset))
+	builder.WriteString(", ")
+	builder.WriteString("reconnects=")
+	builder.WriteString(fmt.Sprintf("%v", v.Reconnects))
+	builder.WriteString(", ")
+	if v := v.ColdStorageAt; v != nil {
+		builder.WriteString("cold_storage_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := v.ChatImportedAt; v != nil {
+		builder.WriteString("chat_imported_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("health=")
+	builder.WriteString(fmt.Sprintf("%v", v.Health))
+	builder.WriteString(", ")
+	builder.WriteString("health_issues=")
+	builder.WriteString(fmt.Sprintf("%v", v.HealthIssues))
+	builder.WriteString(", ")
+	if v := v.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := v.VideoPrunedAt; v != nil {
+		builder.WriteString("video_pruned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trash_path=")
+	builder.WriteString(v.TrashPath)
+	builder.WriteString(", ")
+	builder.WriteString("storage_backend=")
+	builder.WriteString(fmt.Sprintf("%v", v.StorageBackend))
+	builder.WriteString(", ")
 	builder.WriteString("streamed_at=")
 	builder.WriteString(v.StreamedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -17,6 +18,8 @@ const (
 	Label = "vod"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldExtID holds the string denoting the ext_id field in the database.
 	FieldExtID = "ext_id"
 	// FieldPlatform holds the string denoting the platform field in the database.
@@ -75,6 +78,30 @@ const (
 	FieldLocked = "locked"
 	// FieldLocalViews holds the string denoting the local_views field in the database.
 	FieldLocalViews = "local_views"
+	// FieldLastViewedAt holds the string denoting the last_viewed_at field in the database.
+	FieldLastViewedAt = "last_viewed_at"
+	// FieldClipExtVodID holds the string denoting the clip_ext_vod_id field in the database.
+	FieldClipExtVodID = "clip_ext_vod_id"
+	// FieldClipVodOffset holds the string denoting the clip_vod_offset field in the database.
+	FieldClipVodOffset = "clip_vod_offset"
+	// FieldReconnects holds the string denoting the reconnects field in the database.
+	FieldReconnects = "reconnects"
+	// FieldColdStorageAt holds the string denoting the cold_storage_at field in the database.
+	FieldColdStorageAt = "cold_storage_at"
+	// FieldChatImportedAt holds the string denoting the chat_imported_at field in the database.
+	FieldChatImportedAt = "chat_imported_at"
+	// FieldHealth holds the string denoting the health field in the database.
+	FieldHealth = "health"
+	// FieldHealthIssues holds the string denoting the health_issues field in the database.
+	FieldHealthIssues = "health_issues"
+	// FieldVerifiedAt holds the string denoting the verified_at field in the database.
+	FieldVerifiedAt = "verified_at"
+	// FieldVideoPrunedAt holds the string denoting the video_pruned_at field in the database.
+	FieldVideoPrunedAt = "video_pruned_at"
+	// FieldTrashPath holds the string denoting the trash_path field in the database.
+	FieldTrashPath = "trash_path"
+	// FieldStorageBackend holds the string denoting the storage_backend field in the database.
+	FieldStorageBackend = "storage_backend"
 	// FieldStreamedAt holds the string denoting the streamed_at field in the database.
 	FieldStreamedAt = "streamed_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -91,6 +118,16 @@ const (
 	EdgeChapters = "chapters"
 	// EdgeMutedSegments holds the string denoting the muted_segments edge name in mutations.
 	EdgeMutedSegments = "muted_segments"
+	// EdgeClipSource holds the string denoting the clip_source edge name in mutations.
+	EdgeClipSource = "clip_source"
+	// EdgeClips holds the string denoting the clips edge name in mutations.
+	EdgeClips = "clips"
+	// EdgeAlternate holds the string denoting the alternate edge name in mutations.
+	EdgeAlternate = "alternate"
+	// EdgeArchiveProfile holds the string denoting the archive_profile edge name in mutations.
+	EdgeArchiveProfile = "archive_profile"
+	// EdgeChatMessages holds the string denoting the chat_messages edge name in mutations.
+	EdgeChatMessages = "chat_messages"
 	// Table holds the table name of the vod in the database.
 	Table = "vods"
 	// ChannelTable is the table that holds the channel relation/edge.
@@ -126,11 +163,38 @@ const (
 	MutedSegmentsInverseTable = "muted_segments"
 	// MutedSegmentsColumn is the table column denoting the muted_segments relation/edge.
 	MutedSegmentsColumn = "vod_muted_segments"
+	// ClipSourceTable is the table that holds the clip_source relation/edge.
+	ClipSourceTable = "vods"
+	// ClipSourceColumn is the table column denoting the clip_source relation/edge.
+	ClipSourceColumn = "vod_clips"
+	// ClipsTable is the table that holds the clips relation/edge.
+	ClipsTable = "vods"
+	// ClipsColumn is the table column denoting the clips relation/edge.
+	ClipsColumn = "vod_clips"
+	// AlternateTable is the table that holds the alternate relation/edge.
+	AlternateTable = "vods"
+	// AlternateColumn is the table column denoting the alternate relation/edge.
+	AlternateColumn = "vod_alternate"
+	// ArchiveProfileTable is the table that holds the archive_profile relation/edge.
+	ArchiveProfileTable = "vods"
+	// ArchiveProfileInverseTable is the table name for the ArchiveProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "archiveprofile" package.
+	ArchiveProfileInverseTable = "archive_profiles"
+	// ArchiveProfileColumn is the table column denoting the archive_profile relation/edge.
+	ArchiveProfileColumn = "archive_profile_vods"
+	// ChatMessagesTable is the table that holds the chat_messages relation/edge.
+	ChatMessagesTable = "chat_messages"
+	// ChatMessagesInverseTable is the table name for the ChatMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "chatmessage" package.
+	ChatMessagesInverseTable = "chat_messages"
+	// ChatMessagesColumn is the table column denoting the chat_messages relation/edge.
+	ChatMessagesColumn = "vod_chat_messages"
 )
 
 // Columns holds all SQL columns for vod fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
 	FieldExtID,
 	FieldPlatform,
 	FieldType,
@@ -160,6 +224,18 @@ var Columns = []string{
 	FieldTmpVideoHlsPath,
 	FieldLocked,
 	FieldLocalViews,
+	FieldLastViewedAt,
+	FieldClipExtVodID,
+	FieldClipVodOffset,
+	FieldReconnects,
+	FieldColdStorageAt,
+	FieldChatImportedAt,
+	FieldHealth,
+	FieldHealthIssues,
+	FieldVerifiedAt,
+	FieldVideoPrunedAt,
+	FieldTrashPath,
+	FieldStorageBackend,
 	FieldStreamedAt,
 	FieldUpdatedAt,
 	FieldCreatedAt,
@@ -168,7 +244,10 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "vods"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"archive_profile_vods",
 	"channel_vods",
+	"vod_clips",
+	"vod_alternate",
 }
 
 var (
@@ -192,7 +271,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/zibbp/ganymede/ent/runtime"
 var (
+	Interceptors [1]ent.Interceptor
 	// DefaultDuration holds the default value on creation for the "duration" field.
 	DefaultDuration int
 	// DefaultViews holds the default value on creation for the "views" field.
@@ -203,6 +288,8 @@ var (
 	DefaultLocked bool
 	// DefaultLocalViews holds the default value on creation for the "local_views" field.
 	DefaultLocalViews int
+	// DefaultReconnects holds the default value on creation for the "reconnects" field.
+	DefaultReconnects int
 	// DefaultStreamedAt holds the default value on creation for the "streamed_at" field.
 	DefaultStreamedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -239,6 +326,30 @@ func TypeValidator(_type utils.VodType) error {
 	}
 }
 
+const DefaultHealth utils.VodHealth = "unverified"
+
+// HealthValidator is a validator for the "health" field enum values. It is called by the builders before save.
+func HealthValidator(h utils.VodHealth) error {
+	switch h {
+	case "unverified", "healthy", "unhealthy":
+		return nil
+	default:
+		return fmt.Errorf("vod: invalid enum value for health field: %q", h)
+	}
+}
+
+const DefaultStorageBackend utils.StorageBackend = "local"
+
+// StorageBackendValidator is a validator for the "storage_backend" field enum values. It is called by the builders before save.
+func StorageBackendValidator(sb utils.StorageBackend) error {
+	switch sb {
+	case "local", "s3":
+		return nil
+	default:
+		return fmt.Errorf("vod: invalid enum value for storage_backend field: %q", sb)
+	}
+}
+
 // OrderOption defines the ordering options for the Vod queries.
 type OrderOption func(*sql.Selector)
 
@@ -247,6 +358,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByExtID orders the results by the ext_id field.
 func ByExtID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExtID, opts...).ToFunc()
@@ -392,6 +508,61 @@ func ByLocalViews(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLocalViews, opts...).ToFunc()
 }
 
+// ByLastViewedAt orders the results by the last_viewed_at field.
+func ByLastViewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastViewedAt, opts...).ToFunc()
+}
+
+// ByClipExtVodID orders the results by the clip_ext_vod_id field.
+func ByClipExtVodID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClipExtVodID, opts...).ToFunc()
+}
+
+// ByClipVodOffset orders the results by the clip_vod_offset field.
+func ByClipVodOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClipVodOffset, opts...).ToFunc()
+}
+
+// ByReconnects orders the results by the reconnects field.
+func ByReconnects(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReconnects, opts...).ToFunc()
+}
+
+// ByColdStorageAt orders the results by the cold_storage_at field.
+func ByColdStorageAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldColdStorageAt, opts...).ToFunc()
+}
+
+// ByChatImportedAt orders the results by the chat_imported_at field.
+func ByChatImportedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChatImportedAt, opts...).ToFunc()
+}
+
+// ByHealth orders the results by the health field.
+func ByHealth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHealth, opts...).ToFunc()
+}
+
+// ByVerifiedAt orders the results by the verified_at field.
+func ByVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedAt, opts...).ToFunc()
+}
+
+// ByVideoPrunedAt orders the results by the video_pruned_at field.
+func ByVideoPrunedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVideoPrunedAt, opts...).ToFunc()
+}
+
+// ByTrashPath orders the results by the trash_path field.
+func ByTrashPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrashPath, opts...).ToFunc()
+}
+
+// ByStorageBackend orders the results by the storage_backend field.
+func ByStorageBackend(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageBackend, opts...).ToFunc()
+}
+
 // ByStreamedAt orders the results by the streamed_at field.
 func ByStreamedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStreamedAt, opts...).ToFunc()
@@ -462,6 +633,55 @@ func ByMutedSegments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMutedSegmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByClipSourceField orders the results by clip_source field.
+func ByClipSourceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClipSourceStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByClipsCount orders the results by clips count.
+func ByClipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newClipsStep(), opts...)
+	}
+}
+
+// ByClips orders the results by clips terms.
+func ByClips(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAlternateField orders the results by alternate field.
+func ByAlternateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAlternateStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByArchiveProfileField orders the results by archive_profile field.
+func ByArchiveProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArchiveProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChatMessagesCount orders the results by chat_messages count.
+func ByChatMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChatMessagesStep(), opts...)
+	}
+}
+
+// ByChatMessages orders the results by chat_messages terms.
+func ByChatMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChatMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newChannelStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -497,3 +717,38 @@ func newMutedSegmentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MutedSegmentsTable, MutedSegmentsColumn),
 	)
 }
+func newClipSourceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ClipSourceTable, ClipSourceColumn),
+	)
+}
+func newClipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ClipsTable, ClipsColumn),
+	)
+}
+func newAlternateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, AlternateTable, AlternateColumn),
+	)
+}
+func newArchiveProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArchiveProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ArchiveProfileTable, ArchiveProfileColumn),
+	)
+}
+func newChatMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChatMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChatMessagesTable, ChatMessagesColumn),
+	)
+}
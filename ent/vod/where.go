@@ -57,6 +57,11 @@ func IDLTE(id uuid.UUID) predicate.Vod {
 	return predicate.Vod(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // ExtID applies equality check predicate on the "ext_id" field. It's identical to ExtIDEQ.
 func ExtID(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldExtID, v))
@@ -192,6 +197,51 @@ func LocalViews(v int) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldLocalViews, v))
 }
 
+// LastViewedAt applies equality check predicate on the "last_viewed_at" field. It's identical to LastViewedAtEQ.
+func LastViewedAt(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldLastViewedAt, v))
+}
+
+// ClipExtVodID applies equality check predicate on the "clip_ext_vod_id" field. It's identical to ClipExtVodIDEQ.
+func ClipExtVodID(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldClipExtVodID, v))
+}
+
+// ClipVodOffset applies equality check predicate on the "clip_vod_offset" field. It's identical to ClipVodOffsetEQ.
+func ClipVodOffset(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldClipVodOffset, v))
+}
+
+// Reconnects applies equality check predicate on the "reconnects" field. It's identical to ReconnectsEQ.
+func Reconnects(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldReconnects, v))
+}
+
+// ColdStorageAt applies equality check predicate on the "cold_storage_at" field. It's identical to ColdStorageAtEQ.
+func ColdStorageAt(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldColdStorageAt, v))
+}
+
+// ChatImportedAt applies equality check predicate on the "chat_imported_at" field. It's identical to ChatImportedAtEQ.
+func ChatImportedAt(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldChatImportedAt, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verified_at" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VideoPrunedAt applies equality check predicate on the "video_pruned_at" field. It's identical to VideoPrunedAtEQ.
+func VideoPrunedAt(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldVideoPrunedAt, v))
+}
+
+// TrashPath applies equality check predicate on the "trash_path" field. It's identical to TrashPathEQ.
+func TrashPath(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldTrashPath, v))
+}
+
 // StreamedAt applies equality check predicate on the "streamed_at" field. It's identical to StreamedAtEQ.
 func StreamedAt(v time.Time) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldStreamedAt, v))
@@ -207,6 +257,56 @@ func CreatedAt(v time.Time) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // ExtIDEQ applies the EQ predicate on the "ext_id" field.
 func ExtIDEQ(v string) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldExtID, v))
@@ -2017,6 +2117,566 @@ func LocalViewsLTE(v int) predicate.Vod {
 	return predicate.Vod(sql.FieldLTE(FieldLocalViews, v))
 }
 
+// LastViewedAtEQ applies the EQ predicate on the "last_viewed_at" field.
+func LastViewedAtEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldLastViewedAt, v))
+}
+
+// LastViewedAtNEQ applies the NEQ predicate on the "last_viewed_at" field.
+func LastViewedAtNEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldLastViewedAt, v))
+}
+
+// LastViewedAtIn applies the In predicate on the "last_viewed_at" field.
+func LastViewedAtIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldLastViewedAt, vs...))
+}
+
+// LastViewedAtNotIn applies the NotIn predicate on the "last_viewed_at" field.
+func LastViewedAtNotIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldLastViewedAt, vs...))
+}
+
+// LastViewedAtGT applies the GT predicate on the "last_viewed_at" field.
+func LastViewedAtGT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldLastViewedAt, v))
+}
+
+// LastViewedAtGTE applies the GTE predicate on the "last_viewed_at" field.
+func LastViewedAtGTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldLastViewedAt, v))
+}
+
+// LastViewedAtLT applies the LT predicate on the "last_viewed_at" field.
+func LastViewedAtLT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldLastViewedAt, v))
+}
+
+// LastViewedAtLTE applies the LTE predicate on the "last_viewed_at" field.
+func LastViewedAtLTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldLastViewedAt, v))
+}
+
+// LastViewedAtIsNil applies the IsNil predicate on the "last_viewed_at" field.
+func LastViewedAtIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldLastViewedAt))
+}
+
+// LastViewedAtNotNil applies the NotNil predicate on the "last_viewed_at" field.
+func LastViewedAtNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldLastViewedAt))
+}
+
+// ClipExtVodIDEQ applies the EQ predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldClipExtVodID, v))
+}
+
+// ClipExtVodIDNEQ applies the NEQ predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDNEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldClipExtVodID, v))
+}
+
+// ClipExtVodIDIn applies the In predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldClipExtVodID, vs...))
+}
+
+// ClipExtVodIDNotIn applies the NotIn predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDNotIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldClipExtVodID, vs...))
+}
+
+// ClipExtVodIDGT applies the GT predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDGT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldClipExtVodID, v))
+}
+
+// ClipExtVodIDGTE applies the GTE predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDGTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldClipExtVodID, v))
+}
+
+// ClipExtVodIDLT applies the LT predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDLT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldClipExtVodID, v))
+}
+
+// ClipExtVodIDLTE applies the LTE predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDLTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldClipExtVodID, v))
+}
+
+// ClipExtVodIDContains applies the Contains predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDContains(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContains(FieldClipExtVodID, v))
+}
+
+// ClipExtVodIDHasPrefix applies the HasPrefix predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDHasPrefix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasPrefix(FieldClipExtVodID, v))
+}
+
+// ClipExtVodIDHasSuffix applies the HasSuffix predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDHasSuffix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasSuffix(FieldClipExtVodID, v))
+}
+
+// ClipExtVodIDIsNil applies the IsNil predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldClipExtVodID))
+}
+
+// ClipExtVodIDNotNil applies the NotNil predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldClipExtVodID))
+}
+
+// ClipExtVodIDEqualFold applies the EqualFold predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDEqualFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEqualFold(FieldClipExtVodID, v))
+}
+
+// ClipExtVodIDContainsFold applies the ContainsFold predicate on the "clip_ext_vod_id" field.
+func ClipExtVodIDContainsFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContainsFold(FieldClipExtVodID, v))
+}
+
+// ClipVodOffsetEQ applies the EQ predicate on the "clip_vod_offset" field.
+func ClipVodOffsetEQ(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldClipVodOffset, v))
+}
+
+// ClipVodOffsetNEQ applies the NEQ predicate on the "clip_vod_offset" field.
+func ClipVodOffsetNEQ(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldClipVodOffset, v))
+}
+
+// ClipVodOffsetIn applies the In predicate on the "clip_vod_offset" field.
+func ClipVodOffsetIn(vs ...int) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldClipVodOffset, vs...))
+}
+
+// ClipVodOffsetNotIn applies the NotIn predicate on the "clip_vod_offset" field.
+func ClipVodOffsetNotIn(vs ...int) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldClipVodOffset, vs...))
+}
+
+// ClipVodOffsetGT applies the GT predicate on the "clip_vod_offset" field.
+func ClipVodOffsetGT(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldClipVodOffset, v))
+}
+
+// ClipVodOffsetGTE applies the GTE predicate on the "clip_vod_offset" field.
+func ClipVodOffsetGTE(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldClipVodOffset, v))
+}
+
+// ClipVodOffsetLT applies the LT predicate on the "clip_vod_offset" field.
+func ClipVodOffsetLT(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldClipVodOffset, v))
+}
+
+// ClipVodOffsetLTE applies the LTE predicate on the "clip_vod_offset" field.
+func ClipVodOffsetLTE(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldClipVodOffset, v))
+}
+
+// ClipVodOffsetIsNil applies the IsNil predicate on the "clip_vod_offset" field.
+func ClipVodOffsetIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldClipVodOffset))
+}
+
+// ClipVodOffsetNotNil applies the NotNil predicate on the "clip_vod_offset" field.
+func ClipVodOffsetNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldClipVodOffset))
+}
+
+// ReconnectsEQ applies the EQ predicate on the "reconnects" field.
+func ReconnectsEQ(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldReconnects, v))
+}
+
+// ReconnectsNEQ applies the NEQ predicate on the "reconnects" field.
+func ReconnectsNEQ(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldReconnects, v))
+}
+
+// ReconnectsIn applies the In predicate on the "reconnects" field.
+func ReconnectsIn(vs ...int) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldReconnects, vs...))
+}
+
+// ReconnectsNotIn applies the NotIn predicate on the "reconnects" field.
+func ReconnectsNotIn(vs ...int) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldReconnects, vs...))
+}
+
+// ReconnectsGT applies the GT predicate on the "reconnects" field.
+func ReconnectsGT(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldReconnects, v))
+}
+
+// ReconnectsGTE applies the GTE predicate on the "reconnects" field.
+func ReconnectsGTE(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldReconnects, v))
+}
+
+// ReconnectsLT applies the LT predicate on the "reconnects" field.
+func ReconnectsLT(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldReconnects, v))
+}
+
+// ReconnectsLTE applies the LTE predicate on the "reconnects" field.
+func ReconnectsLTE(v int) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldReconnects, v))
+}
+
+// ColdStorageAtEQ applies the EQ predicate on the "cold_storage_at" field.
+func ColdStorageAtEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldColdStorageAt, v))
+}
+
+// ColdStorageAtNEQ applies the NEQ predicate on the "cold_storage_at" field.
+func ColdStorageAtNEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldColdStorageAt, v))
+}
+
+// ColdStorageAtIn applies the In predicate on the "cold_storage_at" field.
+func ColdStorageAtIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldColdStorageAt, vs...))
+}
+
+// ColdStorageAtNotIn applies the NotIn predicate on the "cold_storage_at" field.
+func ColdStorageAtNotIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldColdStorageAt, vs...))
+}
+
+// ColdStorageAtGT applies the GT predicate on the "cold_storage_at" field.
+func ColdStorageAtGT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldColdStorageAt, v))
+}
+
+// ColdStorageAtGTE applies the GTE predicate on the "cold_storage_at" field.
+func ColdStorageAtGTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldColdStorageAt, v))
+}
+
+// ColdStorageAtLT applies the LT predicate on the "cold_storage_at" field.
+func ColdStorageAtLT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldColdStorageAt, v))
+}
+
+// ColdStorageAtLTE applies the LTE predicate on the "cold_storage_at" field.
+func ColdStorageAtLTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldColdStorageAt, v))
+}
+
+// ColdStorageAtIsNil applies the IsNil predicate on the "cold_storage_at" field.
+func ColdStorageAtIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldColdStorageAt))
+}
+
+// ColdStorageAtNotNil applies the NotNil predicate on the "cold_storage_at" field.
+func ColdStorageAtNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldColdStorageAt))
+}
+
+// ChatImportedAtEQ applies the EQ predicate on the "chat_imported_at" field.
+func ChatImportedAtEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldChatImportedAt, v))
+}
+
+// ChatImportedAtNEQ applies the NEQ predicate on the "chat_imported_at" field.
+func ChatImportedAtNEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldChatImportedAt, v))
+}
+
+// ChatImportedAtIn applies the In predicate on the "chat_imported_at" field.
+func ChatImportedAtIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldChatImportedAt, vs...))
+}
+
+// ChatImportedAtNotIn applies the NotIn predicate on the "chat_imported_at" field.
+func ChatImportedAtNotIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldChatImportedAt, vs...))
+}
+
+// ChatImportedAtGT applies the GT predicate on the "chat_imported_at" field.
+func ChatImportedAtGT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldChatImportedAt, v))
+}
+
+// ChatImportedAtGTE applies the GTE predicate on the "chat_imported_at" field.
+func ChatImportedAtGTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldChatImportedAt, v))
+}
+
+// ChatImportedAtLT applies the LT predicate on the "chat_imported_at" field.
+func ChatImportedAtLT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldChatImportedAt, v))
+}
+
+// ChatImportedAtLTE applies the LTE predicate on the "chat_imported_at" field.
+func ChatImportedAtLTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldChatImportedAt, v))
+}
+
+// ChatImportedAtIsNil applies the IsNil predicate on the "chat_imported_at" field.
+func ChatImportedAtIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldChatImportedAt))
+}
+
+// ChatImportedAtNotNil applies the NotNil predicate on the "chat_imported_at" field.
+func ChatImportedAtNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldChatImportedAt))
+}
+
+// HealthEQ applies the EQ predicate on the "health" field.
+func HealthEQ(v utils.VodHealth) predicate.Vod {
+	vc := v
+	return predicate.Vod(sql.FieldEQ(FieldHealth, vc))
+}
+
+// HealthNEQ applies the NEQ predicate on the "health" field.
+func HealthNEQ(v utils.VodHealth) predicate.Vod {
+	vc := v
+	return predicate.Vod(sql.FieldNEQ(FieldHealth, vc))
+}
+
+// HealthIn applies the In predicate on the "health" field.
+func HealthIn(vs ...utils.VodHealth) predicate.Vod {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Vod(sql.FieldIn(FieldHealth, v...))
+}
+
+// HealthNotIn applies the NotIn predicate on the "health" field.
+func HealthNotIn(vs ...utils.VodHealth) predicate.Vod {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Vod(sql.FieldNotIn(FieldHealth, v...))
+}
+
+// HealthIssuesIsNil applies the IsNil predicate on the "health_issues" field.
+func HealthIssuesIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldHealthIssues))
+}
+
+// HealthIssuesNotNil applies the NotNil predicate on the "health_issues" field.
+func HealthIssuesNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldHealthIssues))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verified_at" field.
+func VerifiedAtEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verified_at" field.
+func VerifiedAtNEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verified_at" field.
+func VerifiedAtIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verified_at" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verified_at" field.
+func VerifiedAtGT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verified_at" field.
+func VerifiedAtGTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verified_at" field.
+func VerifiedAtLT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verified_at" field.
+func VerifiedAtLTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verified_at" field.
+func VerifiedAtIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verified_at" field.
+func VerifiedAtNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldVerifiedAt))
+}
+
+// VideoPrunedAtEQ applies the EQ predicate on the "video_pruned_at" field.
+func VideoPrunedAtEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldVideoPrunedAt, v))
+}
+
+// VideoPrunedAtNEQ applies the NEQ predicate on the "video_pruned_at" field.
+func VideoPrunedAtNEQ(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldVideoPrunedAt, v))
+}
+
+// VideoPrunedAtIn applies the In predicate on the "video_pruned_at" field.
+func VideoPrunedAtIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldVideoPrunedAt, vs...))
+}
+
+// VideoPrunedAtNotIn applies the NotIn predicate on the "video_pruned_at" field.
+func VideoPrunedAtNotIn(vs ...time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldVideoPrunedAt, vs...))
+}
+
+// VideoPrunedAtGT applies the GT predicate on the "video_pruned_at" field.
+func VideoPrunedAtGT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldVideoPrunedAt, v))
+}
+
+// VideoPrunedAtGTE applies the GTE predicate on the "video_pruned_at" field.
+func VideoPrunedAtGTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldVideoPrunedAt, v))
+}
+
+// VideoPrunedAtLT applies the LT predicate on the "video_pruned_at" field.
+func VideoPrunedAtLT(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldVideoPrunedAt, v))
+}
+
+// VideoPrunedAtLTE applies the LTE predicate on the "video_pruned_at" field.
+func VideoPrunedAtLTE(v time.Time) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldVideoPrunedAt, v))
+}
+
+// VideoPrunedAtIsNil applies the IsNil predicate on the "video_pruned_at" field.
+func VideoPrunedAtIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldVideoPrunedAt))
+}
+
+// VideoPrunedAtNotNil applies the NotNil predicate on the "video_pruned_at" field.
+func VideoPrunedAtNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldVideoPrunedAt))
+}
+
+// TrashPathEQ applies the EQ predicate on the "trash_path" field.
+func TrashPathEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEQ(FieldTrashPath, v))
+}
+
+// TrashPathNEQ applies the NEQ predicate on the "trash_path" field.
+func TrashPathNEQ(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldNEQ(FieldTrashPath, v))
+}
+
+// TrashPathIn applies the In predicate on the "trash_path" field.
+func TrashPathIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldIn(FieldTrashPath, vs...))
+}
+
+// TrashPathNotIn applies the NotIn predicate on the "trash_path" field.
+func TrashPathNotIn(vs ...string) predicate.Vod {
+	return predicate.Vod(sql.FieldNotIn(FieldTrashPath, vs...))
+}
+
+// TrashPathGT applies the GT predicate on the "trash_path" field.
+func TrashPathGT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGT(FieldTrashPath, v))
+}
+
+// TrashPathGTE applies the GTE predicate on the "trash_path" field.
+func TrashPathGTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldGTE(FieldTrashPath, v))
+}
+
+// TrashPathLT applies the LT predicate on the "trash_path" field.
+func TrashPathLT(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLT(FieldTrashPath, v))
+}
+
+// TrashPathLTE applies the LTE predicate on the "trash_path" field.
+func TrashPathLTE(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldLTE(FieldTrashPath, v))
+}
+
+// TrashPathContains applies the Contains predicate on the "trash_path" field.
+func TrashPathContains(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContains(FieldTrashPath, v))
+}
+
+// TrashPathHasPrefix applies the HasPrefix predicate on the "trash_path" field.
+func TrashPathHasPrefix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasPrefix(FieldTrashPath, v))
+}
+
+// TrashPathHasSuffix applies the HasSuffix predicate on the "trash_path" field.
+func TrashPathHasSuffix(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldHasSuffix(FieldTrashPath, v))
+}
+
+// TrashPathIsNil applies the IsNil predicate on the "trash_path" field.
+func TrashPathIsNil() predicate.Vod {
+	return predicate.Vod(sql.FieldIsNull(FieldTrashPath))
+}
+
+// TrashPathNotNil applies the NotNil predicate on the "trash_path" field.
+func TrashPathNotNil() predicate.Vod {
+	return predicate.Vod(sql.FieldNotNull(FieldTrashPath))
+}
+
+// TrashPathEqualFold applies the EqualFold predicate on the "trash_path" field.
+func TrashPathEqualFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldEqualFold(FieldTrashPath, v))
+}
+
+// TrashPathContainsFold applies the ContainsFold predicate on the "trash_path" field.
+func TrashPathContainsFold(v string) predicate.Vod {
+	return predicate.Vod(sql.FieldContainsFold(FieldTrashPath, v))
+}
+
+// StorageBackendEQ applies the EQ predicate on the "storage_backend" field.
+func StorageBackendEQ(v utils.StorageBackend) predicate.Vod {
+	vc := v
+	return predicate.Vod(sql.FieldEQ(FieldStorageBackend, vc))
+}
+
+// StorageBackendNEQ applies the NEQ predicate on the "storage_backend" field.
+func StorageBackendNEQ(v utils.StorageBackend) predicate.Vod {
+	vc := v
+	return predicate.Vod(sql.FieldNEQ(FieldStorageBackend, vc))
+}
+
+// StorageBackendIn applies the In predicate on the "storage_backend" field.
+func StorageBackendIn(vs ...utils.StorageBackend) predicate.Vod {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Vod(sql.FieldIn(FieldStorageBackend, v...))
+}
+
+// StorageBackendNotIn applies the NotIn predicate on the "storage_backend" field.
+func StorageBackendNotIn(vs ...utils.StorageBackend) predicate.Vod {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Vod(sql.FieldNotIn(FieldStorageBackend, v...))
+}
+
 // StreamedAtEQ applies the EQ predicate on the "streamed_at" field.
 func StreamedAtEQ(v time.Time) predicate.Vod {
 	return predicate.Vod(sql.FieldEQ(FieldStreamedAt, v))
@@ -2252,6 +2912,121 @@ func HasMutedSegmentsWith(preds ...predicate.MutedSegment) predicate.Vod {
 	})
 }
 
+// HasClipSource applies the HasEdge predicate on the "clip_source" edge.
+func HasClipSource() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ClipSourceTable, ClipSourceColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClipSourceWith applies the HasEdge predicate on the "clip_source" edge with a given conditions (other predicates).
+func HasClipSourceWith(preds ...predicate.Vod) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newClipSourceStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasClips applies the HasEdge predicate on the "clips" edge.
+func HasClips() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ClipsTable, ClipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClipsWith applies the HasEdge predicate on the "clips" edge with a given conditions (other predicates).
+func HasClipsWith(preds ...predicate.Vod) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newClipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAlternate applies the HasEdge predicate on the "alternate" edge.
+func HasAlternate() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, AlternateTable, AlternateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAlternateWith applies the HasEdge predicate on the "alternate" edge with a given conditions (other predicates).
+func HasAlternateWith(preds ...predicate.Vod) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newAlternateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasArchiveProfile applies the HasEdge predicate on the "archive_profile" edge.
+func HasArchiveProfile() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ArchiveProfileTable, ArchiveProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArchiveProfileWith applies the HasEdge predicate on the "archive_profile" edge with a given conditions (other predicates).
+func HasArchiveProfileWith(preds ...predicate.ArchiveProfile) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newArchiveProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChatMessages applies the HasEdge predicate on the "chat_messages" edge.
+func HasChatMessages() predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChatMessagesTable, ChatMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChatMessagesWith applies the HasEdge predicate on the "chat_messages" edge with a given conditions (other predicates).
+func HasChatMessagesWith(preds ...predicate.ChatMessage) predicate.Vod {
+	return predicate.Vod(func(s *sql.Selector) {
+		step := newChatMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Vod) predicate.Vod {
 	return predicate.Vod(sql.AndPredicates(predicates...))
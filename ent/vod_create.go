@@ -13,8 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/queue"
@@ -30,6 +32,20 @@ type VodCreate struct {
 	conflict []sql.ConflictOption
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (vc *VodCreate) SetDeletedAt(t time.Time) *VodCreate {
+	vc.mutation.SetDeletedAt(t)
+	return vc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vc *VodCreate) SetNillableDeletedAt(t *time.Time) *VodCreate {
+	if t != nil {
+		vc.SetDeletedAt(*t)
+	}
+	return vc
+}
+
 // SetExtID sets the "ext_id" field.
 func (vc *VodCreate) SetExtID(s string) *VodCreate {
 	vc.mutation.SetExtID(s)
@@ -404,6 +420,166 @@ func (vc *VodCreate) SetNillableLocalViews(i *int) *VodCreate {
 	return vc
 }
 
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (vc *VodCreate) SetLastViewedAt(t time.Time) *VodCreate {
+	vc.mutation.SetLastViewedAt(t)
+	return vc
+}
+
+// SetNillableLastViewedAt sets the "last_viewed_at" field if the given value is not nil.
+func (vc *VodCreate) SetNillableLastViewedAt(t *time.Time) *VodCreate {
+	if t != nil {
+		vc.SetLastViewedAt(*t)
+	}
+	return vc
+}
+
+// SetClipExtVodID sets the "clip_ext_vod_id" field.
+func (vc *VodCreate) SetClipExtVodID(s string) *VodCreate {
+	vc.mutation.SetClipExtVodID(s)
+	return vc
+}
+
+// SetNillableClipExtVodID sets the "clip_ext_vod_id" field if the given value is not nil.
+func (vc *VodCreate) SetNillableClipExtVodID(s *string) *VodCreate {
+	if s != nil {
+		vc.SetClipExtVodID(*s)
+	}
+	return vc
+}
+
+// SetClipVodOffset sets the "clip_vod_offset" field.
+func (vc *VodCreate) SetClipVodOffset(i int) *VodCreate {
+	vc.mutation.SetClipVodOffset(i)
+	return vc
+}
+
+// SetNillableClipVodOffset sets the "clip_vod_offset" field if the given value is not nil.
+func (vc *VodCreate) SetNillableClipVodOffset(i *int) *VodCreate {
+	if i != nil {
+		vc.SetClipVodOffset(*i)
+	}
+	return vc
+}
+
+// SetReconnects sets the "reconnects" field.
+func (vc *VodCreate) SetReconnects(i int) *VodCreate {
+	vc.mutation.SetReconnects(i)
+	return vc
+}
+
+// SetNillableReconnects sets the "reconnects" field if the given value is not nil.
+func (vc *VodCreate) SetNillableReconnects(i *int) *VodCreate {
+	if i != nil {
+		vc.SetReconnects(*i)
+	}
+	return vc
+}
+
+// SetColdStorageAt sets the "cold_storage_at" field.
+func (vc *VodCreate) SetColdStorageAt(t time.Time) *VodCreate {
+	vc.mutation.SetColdStorageAt(t)
+	return vc
+}
+
+// SetNillableColdStorageAt sets the "cold_storage_at" field if the given value is not nil.
+func (vc *VodCreate) SetNillableColdStorageAt(t *time.Time) *VodCreate {
+	if t != nil {
+		vc.SetColdStorageAt(*t)
+	}
+	return vc
+}
+
+// SetChatImportedAt sets the "chat_imported_at" field.
+func (vc *VodCreate) SetChatImportedAt(t time.Time) *VodCreate {
+	vc.mutation.SetChatImportedAt(t)
+	return vc
+}
+
+// SetNillableChatImportedAt sets the "chat_imported_at" field if the given value is not nil.
+func (vc *VodCreate) SetNillableChatImportedAt(t *time.Time) *VodCreate {
+	if t != nil {
+		vc.SetChatImportedAt(*t)
+	}
+	return vc
+}
+
+// SetHealth sets the "health" field.
+func (vc *VodCreate) SetHealth(uh utils.VodHealth) *VodCreate {
+	vc.mutation.SetHealth(uh)
+	return vc
+}
+
+// SetNillableHealth sets the "health" field if the given value is not nil.
+func (vc *VodCreate) SetNillableHealth(uh *utils.VodHealth) *VodCreate {
+	if uh != nil {
+		vc.SetHealth(*uh)
+	}
+	return vc
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (vc *VodCreate) SetHealthIssues(s []string) *VodCreate {
+	vc.mutation.SetHealthIssues(s)
+	return vc
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (vc *VodCreate) SetVerifiedAt(t time.Time) *VodCreate {
+	vc.mutation.SetVerifiedAt(t)
+	return vc
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (vc *VodCreate) SetNillableVerifiedAt(t *time.Time) *VodCreate {
+	if t != nil {
+		vc.SetVerifiedAt(*t)
+	}
+	return vc
+}
+
+// SetVideoPrunedAt sets the "video_pruned_at" field.
+func (vc *VodCreate) SetVideoPrunedAt(t time.Time) *VodCreate {
+	vc.mutation.SetVideoPrunedAt(t)
+	return vc
+}
+
+// SetNillableVideoPrunedAt sets the "video_pruned_at" field if the given value is not nil.
+func (vc *VodCreate) SetNillableVideoPrunedAt(t *time.Time) *VodCreate {
+	if t != nil {
+		vc.SetVideoPrunedAt(*t)
+	}
+	return vc
+}
+
+// SetTrashPath sets the "trash_path" field.
+func (vc *VodCreate) SetTrashPath(s string) *VodCreate {
+	vc.mutation.SetTrashPath(s)
+	return vc
+}
+
+// SetNillableTrashPath sets the "trash_path" field if the given value is not nil.
+func (vc *VodCreate) SetNillableTrashPath(s *string) *VodCreate {
+	if s != nil {
+		vc.SetTrashPath(*s)
+	}
+	return vc
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (vc *VodCreate) SetStorageBackend(ub utils.StorageBackend) *VodCreate {
+	vc.mutation.SetStorageBackend(ub)
+	return vc
+}
+
+// SetNillableStorageBackend sets the "storage_backend" field if the given value is not nil.
+func (vc *VodCreate) SetNillableStorageBackend(ub *utils.StorageBackend) *VodCreate {
+	if ub != nil {
+		vc.SetStorageBackend(*ub)
+	}
+	return vc
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (vc *VodCreate) SetStreamedAt(t time.Time) *VodCreate {
 	vc.mutation.SetStreamedAt(t)
@@ -535,6 +711,93 @@ func (vc *VodCreate) AddMutedSegments(m ...*MutedSegment) *VodCreate {
 	return vc.AddMutedSegmentIDs(ids...)
 }
 
+// SetClipSourceID sets the "clip_source" edge to the Vod entity by ID.
+func (vc *VodCreate) SetClipSourceID(id uuid.UUID) *VodCreate {
+	vc.mutation.SetClipSourceID(id)
+	return vc
+}
+
+// SetNillableClipSourceID sets the "clip_source" edge to the Vod entity by ID if the given value is not nil.
+func (vc *VodCreate) SetNillableClipSourceID(id *uuid.UUID) *VodCreate {
+	if id != nil {
+		vc = vc.SetClipSourceID(*id)
+	}
+	return vc
+}
+
+// SetClipSource sets the "clip_source" edge to the Vod entity.
+func (vc *VodCreate) SetClipSource(v *Vod) *VodCreate {
+	return vc.SetClipSourceID(v.ID)
+}
+
+// AddClipIDs adds the "clips" edge to the Vod entity by IDs.
+func (vc *VodCreate) AddClipIDs(ids ...uuid.UUID) *VodCreate {
+	vc.mutation.AddClipIDs(ids...)
+	return vc
+}
+
+// AddClips adds the "clips" edges to the Vod entity.
+func (vc *VodCreate) AddClips(v ...*Vod) *VodCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return vc.AddClipIDs(ids...)
+}
+
+// SetAlternateID sets the "alternate" edge to the Vod entity by ID.
+func (vc *VodCreate) SetAlternateID(id uuid.UUID) *VodCreate {
+	vc.mutation.SetAlternateID(id)
+	return vc
+}
+
+// SetNillableAlternateID sets the "alternate" edge to the Vod entity by ID if the given value is not nil.
+func (vc *VodCreate) SetNillableAlternateID(id *uuid.UUID) *VodCreate {
+	if id != nil {
+		vc = vc.SetAlternateID(*id)
+	}
+	return vc
+}
+
+// SetAlternate sets the "alternate" edge to the Vod entity.
+func (vc *VodCreate) SetAlternate(v *Vod) *VodCreate {
+	return vc.SetAlternateID(v.ID)
+}
+
+// SetArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID.
+func (vc *VodCreate) SetArchiveProfileID(id uuid.UUID) *VodCreate {
+	vc.mutation.SetArchiveProfileID(id)
+	return vc
+}
+
+// SetNillableArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID if the given value is not nil.
+func (vc *VodCreate) SetNillableArchiveProfileID(id *uuid.UUID) *VodCreate {
+	if id != nil {
+		vc = vc.SetArchiveProfileID(*id)
+	}
+	return vc
+}
+
+// SetArchiveProfile sets the "archive_profile" edge to the ArchiveProfile entity.
+func (vc *VodCreate) SetArchiveProfile(a *ArchiveProfile) *VodCreate {
+	return vc.SetArchiveProfileID(a.ID)
+}
+
+// AddChatMessageIDs adds the "chat_messages" edge to the ChatMessage entity by IDs.
+func (vc *VodCreate) AddChatMessageIDs(ids ...uuid.UUID) *VodCreate {
+	vc.mutation.AddChatMessageIDs(ids...)
+	return vc
+}
+
+// AddChatMessages adds the "chat_messages" edges to the ChatMessage entity.
+func (vc *VodCreate) AddChatMessages(c ...*ChatMessage) *VodCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vc.AddChatMessageIDs(ids...)
+}
+
 // Mutation returns the VodMutation object of the builder.
 func (vc *VodCreate) Mutation() *VodMutation {
 	return vc.mutation
@@ -598,6 +861,18 @@ func (vc *VodCreate) defaults() {
 		v := vod.DefaultLocalViews
 		vc.mutation.SetLocalViews(v)
 	}
+	if _, ok := vc.mutation.Reconnects(); !ok {
+		v := vod.DefaultReconnects
+		vc.mutation.SetReconnects(v)
+	}
+	if _, ok := vc.mutation.Health(); !ok {
+		v := vod.DefaultHealth
+		vc.mutation.SetHealth(v)
+	}
+	if _, ok := vc.mutation.StorageBackend(); !ok {
+		v := vod.DefaultStorageBackend
+		vc.mutation.SetStorageBackend(v)
+	}
 	if _, ok := vc.mutation.StreamedAt(); !ok {
 		v := vod.DefaultStreamedAt()
 		vc.mutation.SetStreamedAt(v)
@@ -661,6 +936,25 @@ func (vc *VodCreate) check() error {
 	if _, ok := vc.mutation.LocalViews(); !ok {
 		return &ValidationError{Name: "local_views", err: errors.New(`ent: missing required field "Vod.local_views"`)}
 	}
+	if _, ok := vc.mutation.Reconnects(); !ok {
+		return &ValidationError{Name: "reconnects", err: errors.New(`ent: missing required field "Vod.reconnects"`)}
+	}
+	if _, ok := vc.mutation.Health(); !ok {
+		return &ValidationError{Name: "health", err: errors.New(`ent: missing required field "Vod.health"`)}
+	}
+	if v, ok := vc.mutation.Health(); ok {
+		if err := vod.HealthValidator(v); err != nil {
+			return &ValidationError{Name: "health", err: fmt.Errorf(`ent: validator failed for field "Vod.health": %w`, err)}
+		}
+	}
+	if _, ok := vc.mutation.StorageBackend(); !ok {
+		return &ValidationError{Name: "storage_backend", err: errors.New(`ent: missing required field "Vod.storage_backend"`)}
+	}
+	if v, ok := vc.mutation.StorageBackend(); ok {
+		if err := vod.StorageBackendValidator(v); err != nil {
+			return &ValidationError{Name: "storage_backend", err: fmt.Errorf(`ent: validator failed for field "Vod.storage_backend": %w`, err)}
+		}
+	}
 	if _, ok := vc.mutation.StreamedAt(); !ok {
 		return &ValidationError{Name: "streamed_at", err: errors.New(`ent: missing required field "Vod.streamed_at"`)}
 	}
@@ -709,6 +1003,10 @@ func (vc *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := vc.mutation.DeletedAt(); ok {
+		_spec.SetField(vod.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := vc.mutation.ExtID(); ok {
 		_spec.SetField(vod.FieldExtID, field.TypeString, value)
 		_node.ExtID = value
@@ -825,6 +1123,54 @@ func (vc *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		_spec.SetField(vod.FieldLocalViews, field.TypeInt, value)
 		_node.LocalViews = value
 	}
+	if value, ok := vc.mutation.LastViewedAt(); ok {
+		_spec.SetField(vod.FieldLastViewedAt, field.TypeTime, value)
+		_node.LastViewedAt = &value
+	}
+	if value, ok := vc.mutation.ClipExtVodID(); ok {
+		_spec.SetField(vod.FieldClipExtVodID, field.TypeString, value)
+		_node.ClipExtVodID = value
+	}
+	if value, ok := vc.mutation.ClipVodOffset(); ok {
+		_spec.SetField(vod.FieldClipVodOffset, field.TypeInt, value)
+		_node.ClipVodOffset = value
+	}
+	if value, ok := vc.mutation.Reconnects(); ok {
+		_spec.SetField(vod.FieldReconnects, field.TypeInt, value)
+		_node.Reconnects = value
+	}
+	if value, ok := vc.mutation.ColdStorageAt(); ok {
+		_spec.SetField(vod.FieldColdStorageAt, field.TypeTime, value)
+		_node.ColdStorageAt = &value
+	}
+	if value, ok := vc.mutation.ChatImportedAt(); ok {
+		_spec.SetField(vod.FieldChatImportedAt, field.TypeTime, value)
+		_node.ChatImportedAt = &value
+	}
+	if value, ok := vc.mutation.Health(); ok {
+		_spec.SetField(vod.FieldHealth, field.TypeEnum, value)
+		_node.Health = value
+	}
+	if value, ok := vc.mutation.HealthIssues(); ok {
+		_spec.SetField(vod.FieldHealthIssues, field.TypeJSON, value)
+		_node.HealthIssues = value
+	}
+	if value, ok := vc.mutation.VerifiedAt(); ok {
+		_spec.SetField(vod.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if value, ok := vc.mutation.VideoPrunedAt(); ok {
+		_spec.SetField(vod.FieldVideoPrunedAt, field.TypeTime, value)
+		_node.VideoPrunedAt = &value
+	}
+	if value, ok := vc.mutation.TrashPath(); ok {
+		_spec.SetField(vod.FieldTrashPath, field.TypeString, value)
+		_node.TrashPath = value
+	}
+	if value, ok := vc.mutation.StorageBackend(); ok {
+		_spec.SetField(vod.FieldStorageBackend, field.TypeEnum, value)
+		_node.StorageBackend = value
+	}
 	if value, ok := vc.mutation.StreamedAt(); ok {
 		_spec.SetField(vod.FieldStreamedAt, field.TypeTime, value)
 		_node.StreamedAt = value
@@ -918,6 +1264,89 @@ func (vc *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := vc.mutation.ClipSourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vod.ClipSourceTable,
+			Columns: []string{vod.ClipSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.vod_clips = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := vc.mutation.ClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ClipsTable,
+			Columns: []string{vod.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := vc.mutation.AlternateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   vod.AlternateTable,
+			Columns: []string{vod.AlternateColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.vod_alternate = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := vc.mutation.ArchiveProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vod.ArchiveProfileTable,
+			Columns: []string{vod.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.archive_profile_vods = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := vc.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -925,7 +1354,7 @@ func (vc *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 // of the `INSERT` statement. For example:
 //
 //	client.Vod.Create().
-//		SetExtID(v).
+//		SetDeletedAt(v).
 //		OnConflict(
 //			// Update the row with the new values
 //			// the was proposed for insertion.
@@ -934,7 +1363,7 @@ func (vc *VodCreate) createSpec() (*Vod, *sqlgraph.CreateSpec) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.VodUpsert) {
-//			SetExtID(v+v).
+//			SetDeletedAt(v+v).
 //		}).
 //		Exec(ctx)
 func (vc *VodCreate) OnConflict(opts ...sql.ConflictOption) *VodUpsertOne {
@@ -970,6 +1399,24 @@ type (
 	}
 )
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *VodUpsert) SetDeletedAt(v time.Time) *VodUpsert {
+	u.Set(vod.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *VodUpsert) UpdateDeletedAt() *VodUpsert {
+	u.SetExcluded(vod.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *VodUpsert) ClearDeletedAt() *VodUpsert {
+	u.SetNull(vod.FieldDeletedAt)
+	return u
+}
+
 // SetExtID sets the "ext_id" field.
 func (u *VodUpsert) SetExtID(v string) *VodUpsert {
 	u.Set(vod.FieldExtID, v)
@@ -1444,41 +1891,251 @@ func (u *VodUpsert) AddLocalViews(v int) *VodUpsert {
 	return u
 }
 
-// SetStreamedAt sets the "streamed_at" field.
-func (u *VodUpsert) SetStreamedAt(v time.Time) *VodUpsert {
-	u.Set(vod.FieldStreamedAt, v)
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (u *VodUpsert) SetLastViewedAt(v time.Time) *VodUpsert {
+	u.Set(vod.FieldLastViewedAt, v)
 	return u
 }
 
-// UpdateStreamedAt sets the "streamed_at" field to the value that was provided on create.
-func (u *VodUpsert) UpdateStreamedAt() *VodUpsert {
-	u.SetExcluded(vod.FieldStreamedAt)
+// UpdateLastViewedAt sets the "last_viewed_at" field to the value that was provided on create.
+func (u *VodUpsert) UpdateLastViewedAt() *VodUpsert {
+	u.SetExcluded(vod.FieldLastViewedAt)
 	return u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (u *VodUpsert) SetUpdatedAt(v time.Time) *VodUpsert {
-	u.Set(vod.FieldUpdatedAt, v)
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (u *VodUpsert) ClearLastViewedAt() *VodUpsert {
+	u.SetNull(vod.FieldLastViewedAt)
 	return u
 }
 
-// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
-func (u *VodUpsert) UpdateUpdatedAt() *VodUpsert {
-	u.SetExcluded(vod.FieldUpdatedAt)
+// SetClipExtVodID sets the "clip_ext_vod_id" field.
+func (u *VodUpsert) SetClipExtVodID(v string) *VodUpsert {
+	u.Set(vod.FieldClipExtVodID, v)
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.Vod.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(vod.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
+// UpdateClipExtVodID sets the "clip_ext_vod_id" field to the value that was provided on create.
+func (u *VodUpsert) UpdateClipExtVodID() *VodUpsert {
+	u.SetExcluded(vod.FieldClipExtVodID)
+	return u
+}
+
+// ClearClipExtVodID clears the value of the "clip_ext_vod_id" field.
+func (u *VodUpsert) ClearClipExtVodID() *VodUpsert {
+	u.SetNull(vod.FieldClipExtVodID)
+	return u
+}
+
+// SetClipVodOffset sets the "clip_vod_offset" field.
+func (u *VodUpsert) SetClipVodOffset(v int) *VodUpsert {
+	u.Set(vod.FieldClipVodOffset, v)
+	return u
+}
+
+// UpdateClipVodOffset sets the "clip_vod_offset" field to the value that was provided on create.
+func (u *VodUpsert) UpdateClipVodOffset() *VodUpsert {
+	u.SetExcluded(vod.FieldClipVodOffset)
+	return u
+}
+
+// AddClipVodOffset adds v to the "clip_vod_offset" field.
+func (u *VodUpsert) AddClipVodOffset(v int) *VodUpsert {
+	u.Add(vod.FieldClipVodOffset, v)
+	return u
+}
+
+// ClearClipVodOffset clears the value of the "clip_vod_offset" field.
+func (u *VodUpsert) ClearClipVodOffset() *VodUpsert {
+	u.SetNull(vod.FieldClipVodOffset)
+	return u
+}
+
+// SetReconnects sets the "reconnects" field.
+func (u *VodUpsert) SetReconnects(v int) *VodUpsert {
+	u.Set(vod.FieldReconnects, v)
+	return u
+}
+
+// UpdateReconnects sets the "reconnects" field to the value that was provided on create.
+func (u *VodUpsert) UpdateReconnects() *VodUpsert {
+	u.SetExcluded(vod.FieldReconnects)
+	return u
+}
+
+// AddReconnects adds v to the "reconnects" field.
+func (u *VodUpsert) AddReconnects(v int) *VodUpsert {
+	u.Add(vod.FieldReconnects, v)
+	return u
+}
+
+// SetColdStorageAt sets the "cold_storage_at" field.
+func (u *VodUpsert) SetColdStorageAt(v time.Time) *VodUpsert {
+	u.Set(vod.FieldColdStorageAt, v)
+	return u
+}
+
+// UpdateColdStorageAt sets the "cold_storage_at" field to the value that was provided on create.
+func (u *VodUpsert) UpdateColdStorageAt() *VodUpsert {
+	u.SetExcluded(vod.FieldColdStorageAt)
+	return u
+}
+
+// ClearColdStorageAt clears the value of the "cold_storage_at" field.
+func (u *VodUpsert) ClearColdStorageAt() *VodUpsert {
+	u.SetNull(vod.FieldColdStorageAt)
+	return u
+}
+
+// SetChatImportedAt sets the "chat_imported_at" field.
+func (u *VodUpsert) SetChatImportedAt(v time.Time) *VodUpsert {
+	u.Set(vod.FieldChatImportedAt, v)
+	return u
+}
+
+// UpdateChatImportedAt sets the "chat_imported_at" field to the value that was provided on create.
+func (u *VodUpsert) UpdateChatImportedAt() *VodUpsert {
+	u.SetExcluded(vod.FieldChatImportedAt)
+	return u
+}
+
+// ClearChatImportedAt clears the value of the "chat_imported_at" field.
+func (u *VodUpsert) ClearChatImportedAt() *VodUpsert {
+	u.SetNull(vod.FieldChatImportedAt)
+	return u
+}
+
+// SetHealth sets the "health" field.
+func (u *VodUpsert) SetHealth(v utils.VodHealth) *VodUpsert {
+	u.Set(vod.FieldHealth, v)
+	return u
+}
+
+// UpdateHealth sets the "health" field to the value that was provided on create.
+func (u *VodUpsert) UpdateHealth() *VodUpsert {
+	u.SetExcluded(vod.FieldHealth)
+	return u
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (u *VodUpsert) SetHealthIssues(v []string) *VodUpsert {
+	u.Set(vod.FieldHealthIssues, v)
+	return u
+}
+
+// UpdateHealthIssues sets the "health_issues" field to the value that was provided on create.
+func (u *VodUpsert) UpdateHealthIssues() *VodUpsert {
+	u.SetExcluded(vod.FieldHealthIssues)
+	return u
+}
+
+// ClearHealthIssues clears the value of the "health_issues" field.
+func (u *VodUpsert) ClearHealthIssues() *VodUpsert {
+	u.SetNull(vod.FieldHealthIssues)
+	return u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *VodUpsert) SetVerifiedAt(v time.Time) *VodUpsert {
+	u.Set(vod.FieldVerifiedAt, v)
+	return u
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *VodUpsert) UpdateVerifiedAt() *VodUpsert {
+	u.SetExcluded(vod.FieldVerifiedAt)
+	return u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *VodUpsert) ClearVerifiedAt() *VodUpsert {
+	u.SetNull(vod.FieldVerifiedAt)
+	return u
+}
+
+// SetVideoPrunedAt sets the "video_pruned_at" field.
+func (u *VodUpsert) SetVideoPrunedAt(v time.Time) *VodUpsert {
+	u.Set(vod.FieldVideoPrunedAt, v)
+	return u
+}
+
+// UpdateVideoPrunedAt sets the "video_pruned_at" field to the value that was provided on create.
+func (u *VodUpsert) UpdateVideoPrunedAt() *VodUpsert {
+	u.SetExcluded(vod.FieldVideoPrunedAt)
+	return u
+}
+
+// ClearVideoPrunedAt clears the value of the "video_pruned_at" field.
+func (u *VodUpsert) ClearVideoPrunedAt() *VodUpsert {
+	u.SetNull(vod.FieldVideoPrunedAt)
+	return u
+}
+
+// SetTrashPath sets the "trash_path" field.
+func (u *VodUpsert) SetTrashPath(v string) *VodUpsert {
+	u.Set(vod.FieldTrashPath, v)
+	return u
+}
+
+// UpdateTrashPath sets the "trash_path" field to the value that was provided on create.
+func (u *VodUpsert) UpdateTrashPath() *VodUpsert {
+	u.SetExcluded(vod.FieldTrashPath)
+	return u
+}
+
+// ClearTrashPath clears the value of the "trash_path" field.
+func (u *VodUpsert) ClearTrashPath() *VodUpsert {
+	u.SetNull(vod.FieldTrashPath)
+	return u
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (u *VodUpsert) SetStorageBackend(v utils.StorageBackend) *VodUpsert {
+	u.Set(vod.FieldStorageBackend, v)
+	return u
+}
+
+// UpdateStorageBackend sets the "storage_backend" field to the value that was provided on create.
+func (u *VodUpsert) UpdateStorageBackend() *VodUpsert {
+	u.SetExcluded(vod.FieldStorageBackend)
+	return u
+}
+
+// SetStreamedAt sets the "streamed_at" field.
+func (u *VodUpsert) SetStreamedAt(v time.Time) *VodUpsert {
+	u.Set(vod.FieldStreamedAt, v)
+	return u
+}
+
+// UpdateStreamedAt sets the "streamed_at" field to the value that was provided on create.
+func (u *VodUpsert) UpdateStreamedAt() *VodUpsert {
+	u.SetExcluded(vod.FieldStreamedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VodUpsert) SetUpdatedAt(v time.Time) *VodUpsert {
+	u.Set(vod.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VodUpsert) UpdateUpdatedAt() *VodUpsert {
+	u.SetExcluded(vod.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Vod.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vod.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
 func (u *VodUpsertOne) UpdateNewValues() *VodUpsertOne {
 	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
 	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
@@ -1519,6 +2176,27 @@ func (u *VodUpsertOne) Update(set func(*VodUpsert)) *VodUpsertOne {
 	return u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *VodUpsertOne) SetDeletedAt(v time.Time) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateDeletedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *VodUpsertOne) ClearDeletedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // SetExtID sets the "ext_id" field.
 func (u *VodUpsertOne) SetExtID(v string) *VodUpsertOne {
 	return u.Update(func(s *VodUpsert) {
@@ -2072,6 +2750,251 @@ func (u *VodUpsertOne) UpdateLocalViews() *VodUpsertOne {
 	})
 }
 
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (u *VodUpsertOne) SetLastViewedAt(v time.Time) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetLastViewedAt(v)
+	})
+}
+
+// UpdateLastViewedAt sets the "last_viewed_at" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateLastViewedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateLastViewedAt()
+	})
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (u *VodUpsertOne) ClearLastViewedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearLastViewedAt()
+	})
+}
+
+// SetClipExtVodID sets the "clip_ext_vod_id" field.
+func (u *VodUpsertOne) SetClipExtVodID(v string) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetClipExtVodID(v)
+	})
+}
+
+// UpdateClipExtVodID sets the "clip_ext_vod_id" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateClipExtVodID() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateClipExtVodID()
+	})
+}
+
+// ClearClipExtVodID clears the value of the "clip_ext_vod_id" field.
+func (u *VodUpsertOne) ClearClipExtVodID() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearClipExtVodID()
+	})
+}
+
+// SetClipVodOffset sets the "clip_vod_offset" field.
+func (u *VodUpsertOne) SetClipVodOffset(v int) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetClipVodOffset(v)
+	})
+}
+
+// AddClipVodOffset adds v to the "clip_vod_offset" field.
+func (u *VodUpsertOne) AddClipVodOffset(v int) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.AddClipVodOffset(v)
+	})
+}
+
+// UpdateClipVodOffset sets the "clip_vod_offset" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateClipVodOffset() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateClipVodOffset()
+	})
+}
+
+// ClearClipVodOffset clears the value of the "clip_vod_offset" field.
+func (u *VodUpsertOne) ClearClipVodOffset() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearClipVodOffset()
+	})
+}
+
+// SetReconnects sets the "reconnects" field.
+func (u *VodUpsertOne) SetReconnects(v int) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetReconnects(v)
+	})
+}
+
+// AddReconnects adds v to the "reconnects" field.
+func (u *VodUpsertOne) AddReconnects(v int) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.AddReconnects(v)
+	})
+}
+
+// UpdateReconnects sets the "reconnects" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateReconnects() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateReconnects()
+	})
+}
+
+// SetColdStorageAt sets the "cold_storage_at" field.
+func (u *VodUpsertOne) SetColdStorageAt(v time.Time) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetColdStorageAt(v)
+	})
+}
+
+// UpdateColdStorageAt sets the "cold_storage_at" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateColdStorageAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateColdStorageAt()
+	})
+}
+
+// ClearColdStorageAt clears the value of the "cold_storage_at" field.
+func (u *VodUpsertOne) ClearColdStorageAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearColdStorageAt()
+	})
+}
+
+// SetChatImportedAt sets the "chat_imported_at" field.
+func (u *VodUpsertOne) SetChatImportedAt(v time.Time) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetChatImportedAt(v)
+	})
+}
+
+// UpdateChatImportedAt sets the "chat_imported_at" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateChatImportedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateChatImportedAt()
+	})
+}
+
+// ClearChatImportedAt clears the value of the "chat_imported_at" field.
+func (u *VodUpsertOne) ClearChatImportedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearChatImportedAt()
+	})
+}
+
+// SetHealth sets the "health" field.
+func (u *VodUpsertOne) SetHealth(v utils.VodHealth) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetHealth(v)
+	})
+}
+
+// UpdateHealth sets the "health" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateHealth() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateHealth()
+	})
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (u *VodUpsertOne) SetHealthIssues(v []string) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetHealthIssues(v)
+	})
+}
+
+// UpdateHealthIssues sets the "health_issues" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateHealthIssues() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateHealthIssues()
+	})
+}
+
+// ClearHealthIssues clears the value of the "health_issues" field.
+func (u *VodUpsertOne) ClearHealthIssues() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearHealthIssues()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *VodUpsertOne) SetVerifiedAt(v time.Time) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateVerifiedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *VodUpsertOne) ClearVerifiedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// SetVideoPrunedAt sets the "video_pruned_at" field.
+func (u *VodUpsertOne) SetVideoPrunedAt(v time.Time) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetVideoPrunedAt(v)
+	})
+}
+
+// UpdateVideoPrunedAt sets the "video_pruned_at" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateVideoPrunedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateVideoPrunedAt()
+	})
+}
+
+// ClearVideoPrunedAt clears the value of the "video_pruned_at" field.
+func (u *VodUpsertOne) ClearVideoPrunedAt() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearVideoPrunedAt()
+	})
+}
+
+// SetTrashPath sets the "trash_path" field.
+func (u *VodUpsertOne) SetTrashPath(v string) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetTrashPath(v)
+	})
+}
+
+// UpdateTrashPath sets the "trash_path" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateTrashPath() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateTrashPath()
+	})
+}
+
+// ClearTrashPath clears the value of the "trash_path" field.
+func (u *VodUpsertOne) ClearTrashPath() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearTrashPath()
+	})
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (u *VodUpsertOne) SetStorageBackend(v utils.StorageBackend) *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.SetStorageBackend(v)
+	})
+}
+
+// UpdateStorageBackend sets the "storage_backend" field to the value that was provided on create.
+func (u *VodUpsertOne) UpdateStorageBackend() *VodUpsertOne {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateStorageBackend()
+	})
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (u *VodUpsertOne) SetStreamedAt(v time.Time) *VodUpsertOne {
 	return u.Update(func(s *VodUpsert) {
@@ -2236,7 +3159,7 @@ func (vcb *VodCreateBulk) ExecX(ctx context.Context) {
 //		// Override some of the fields with custom
 //		// update values.
 //		Update(func(u *ent.VodUpsert) {
-//			SetExtID(v+v).
+//			SetDeletedAt(v+v).
 //		}).
 //		Exec(ctx)
 func (vcb *VodCreateBulk) OnConflict(opts ...sql.ConflictOption) *VodUpsertBulk {
@@ -2318,6 +3241,27 @@ func (u *VodUpsertBulk) Update(set func(*VodUpsert)) *VodUpsertBulk {
 	return u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *VodUpsertBulk) SetDeletedAt(v time.Time) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateDeletedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *VodUpsertBulk) ClearDeletedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
 // SetExtID sets the "ext_id" field.
 func (u *VodUpsertBulk) SetExtID(v string) *VodUpsertBulk {
 	return u.Update(func(s *VodUpsert) {
@@ -2871,6 +3815,251 @@ func (u *VodUpsertBulk) UpdateLocalViews() *VodUpsertBulk {
 	})
 }
 
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (u *VodUpsertBulk) SetLastViewedAt(v time.Time) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetLastViewedAt(v)
+	})
+}
+
+// UpdateLastViewedAt sets the "last_viewed_at" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateLastViewedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateLastViewedAt()
+	})
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (u *VodUpsertBulk) ClearLastViewedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearLastViewedAt()
+	})
+}
+
+// SetClipExtVodID sets the "clip_ext_vod_id" field.
+func (u *VodUpsertBulk) SetClipExtVodID(v string) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetClipExtVodID(v)
+	})
+}
+
+// UpdateClipExtVodID sets the "clip_ext_vod_id" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateClipExtVodID() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateClipExtVodID()
+	})
+}
+
+// ClearClipExtVodID clears the value of the "clip_ext_vod_id" field.
+func (u *VodUpsertBulk) ClearClipExtVodID() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearClipExtVodID()
+	})
+}
+
+// SetClipVodOffset sets the "clip_vod_offset" field.
+func (u *VodUpsertBulk) SetClipVodOffset(v int) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetClipVodOffset(v)
+	})
+}
+
+// AddClipVodOffset adds v to the "clip_vod_offset" field.
+func (u *VodUpsertBulk) AddClipVodOffset(v int) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.AddClipVodOffset(v)
+	})
+}
+
+// UpdateClipVodOffset sets the "clip_vod_offset" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateClipVodOffset() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateClipVodOffset()
+	})
+}
+
+// ClearClipVodOffset clears the value of the "clip_vod_offset" field.
+func (u *VodUpsertBulk) ClearClipVodOffset() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearClipVodOffset()
+	})
+}
+
+// SetReconnects sets the "reconnects" field.
+func (u *VodUpsertBulk) SetReconnects(v int) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetReconnects(v)
+	})
+}
+
+// AddReconnects adds v to the "reconnects" field.
+func (u *VodUpsertBulk) AddReconnects(v int) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.AddReconnects(v)
+	})
+}
+
+// UpdateReconnects sets the "reconnects" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateReconnects() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateReconnects()
+	})
+}
+
+// SetColdStorageAt sets the "cold_storage_at" field.
+func (u *VodUpsertBulk) SetColdStorageAt(v time.Time) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetColdStorageAt(v)
+	})
+}
+
+// UpdateColdStorageAt sets the "cold_storage_at" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateColdStorageAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateColdStorageAt()
+	})
+}
+
+// ClearColdStorageAt clears the value of the "cold_storage_at" field.
+func (u *VodUpsertBulk) ClearColdStorageAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearColdStorageAt()
+	})
+}
+
+// SetChatImportedAt sets the "chat_imported_at" field.
+func (u *VodUpsertBulk) SetChatImportedAt(v time.Time) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetChatImportedAt(v)
+	})
+}
+
+// UpdateChatImportedAt sets the "chat_imported_at" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateChatImportedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateChatImportedAt()
+	})
+}
+
+// ClearChatImportedAt clears the value of the "chat_imported_at" field.
+func (u *VodUpsertBulk) ClearChatImportedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearChatImportedAt()
+	})
+}
+
+// SetHealth sets the "health" field.
+func (u *VodUpsertBulk) SetHealth(v utils.VodHealth) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetHealth(v)
+	})
+}
+
+// UpdateHealth sets the "health" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateHealth() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateHealth()
+	})
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (u *VodUpsertBulk) SetHealthIssues(v []string) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetHealthIssues(v)
+	})
+}
+
+// UpdateHealthIssues sets the "health_issues" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateHealthIssues() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateHealthIssues()
+	})
+}
+
+// ClearHealthIssues clears the value of the "health_issues" field.
+func (u *VodUpsertBulk) ClearHealthIssues() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearHealthIssues()
+	})
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (u *VodUpsertBulk) SetVerifiedAt(v time.Time) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetVerifiedAt(v)
+	})
+}
+
+// UpdateVerifiedAt sets the "verified_at" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateVerifiedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateVerifiedAt()
+	})
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (u *VodUpsertBulk) ClearVerifiedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearVerifiedAt()
+	})
+}
+
+// SetVideoPrunedAt sets the "video_pruned_at" field.
+func (u *VodUpsertBulk) SetVideoPrunedAt(v time.Time) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetVideoPrunedAt(v)
+	})
+}
+
+// UpdateVideoPrunedAt sets the "video_pruned_at" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateVideoPrunedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateVideoPrunedAt()
+	})
+}
+
+// ClearVideoPrunedAt clears the value of the "video_pruned_at" field.
+func (u *VodUpsertBulk) ClearVideoPrunedAt() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearVideoPrunedAt()
+	})
+}
+
+// SetTrashPath sets the "trash_path" field.
+func (u *VodUpsertBulk) SetTrashPath(v string) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetTrashPath(v)
+	})
+}
+
+// UpdateTrashPath sets the "trash_path" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateTrashPath() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateTrashPath()
+	})
+}
+
+// ClearTrashPath clears the value of the "trash_path" field.
+func (u *VodUpsertBulk) ClearTrashPath() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.ClearTrashPath()
+	})
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (u *VodUpsertBulk) SetStorageBackend(v utils.StorageBackend) *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.SetStorageBackend(v)
+	})
+}
+
+// UpdateStorageBackend sets the "storage_backend" field to the value that was provided on create.
+func (u *VodUpsertBulk) UpdateStorageBackend() *VodUpsertBulk {
+	return u.Update(func(s *VodUpsert) {
+		s.UpdateStorageBackend()
+	})
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (u *VodUpsertBulk) SetStreamedAt(v time.Time) *VodUpsertBulk {
 	return u.Update(func(s *VodUpsert) {
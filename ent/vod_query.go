@@ -12,8 +12,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/predicate"
@@ -24,16 +26,21 @@ import (
 // VodQuery is the builder for querying Vod entities.
 type VodQuery struct {
 	config
-	ctx               *QueryContext
-	order             []vod.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Vod
-	withChannel       *ChannelQuery
-	withQueue         *QueueQuery
-	withPlaylists     *PlaylistQuery
-	withChapters      *ChapterQuery
-	withMutedSegments *MutedSegmentQuery
-	withFKs           bool
+	ctx                *QueryContext
+	order              []vod.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Vod
+	withChannel        *ChannelQuery
+	withQueue          *QueueQuery
+	withPlaylists      *PlaylistQuery
+	withChapters       *ChapterQuery
+	withMutedSegments  *MutedSegmentQuery
+	withClipSource     *VodQuery
+	withClips          *VodQuery
+	withAlternate      *VodQuery
+	withArchiveProfile *ArchiveProfileQuery
+	withChatMessages   *ChatMessageQuery
+	withFKs            bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -180,6 +187,116 @@ func (vq *VodQuery) QueryMutedSegments() *MutedSegmentQuery {
 	return query
 }
 
+// QueryClipSource chains the current query on the "clip_source" edge.
+func (vq *VodQuery) QueryClipSource() *VodQuery {
+	query := (&VodClient{config: vq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vod.ClipSourceTable, vod.ClipSourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryClips chains the current query on the "clips" edge.
+func (vq *VodQuery) QueryClips() *VodQuery {
+	query := (&VodClient{config: vq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ClipsTable, vod.ClipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAlternate chains the current query on the "alternate" edge.
+func (vq *VodQuery) QueryAlternate() *VodQuery {
+	query := (&VodClient{config: vq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(vod.Table, vod.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, vod.AlternateTable, vod.AlternateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryArchiveProfile chains the current query on the "archive_profile" edge.
+func (vq *VodQuery) QueryArchiveProfile() *ArchiveProfileQuery {
+	query := (&ArchiveProfileClient{config: vq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(archiveprofile.Table, archiveprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vod.ArchiveProfileTable, vod.ArchiveProfileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChatMessages chains the current query on the "chat_messages" edge.
+func (vq *VodQuery) QueryChatMessages() *ChatMessageQuery {
+	query := (&ChatMessageClient{config: vq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := vq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := vq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vod.Table, vod.FieldID, selector),
+			sqlgraph.To(chatmessage.Table, chatmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vod.ChatMessagesTable, vod.ChatMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(vq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Vod entity from the query.
 // Returns a *NotFoundError when no Vod was found.
 func (vq *VodQuery) First(ctx context.Context) (*Vod, error) {
@@ -367,16 +484,21 @@ func (vq *VodQuery) Clone() *VodQuery {
 		return nil
 	}
 	return &VodQuery{
-		config:            vq.config,
-		ctx:               vq.ctx.Clone(),
-		order:             append([]vod.OrderOption{}, vq.order...),
-		inters:            append([]Interceptor{}, vq.inters...),
-		predicates:        append([]predicate.Vod{}, vq.predicates...),
-		withChannel:       vq.withChannel.Clone(),
-		withQueue:         vq.withQueue.Clone(),
-		withPlaylists:     vq.withPlaylists.Clone(),
-		withChapters:      vq.withChapters.Clone(),
-		withMutedSegments: vq.withMutedSegments.Clone(),
+		config:             vq.config,
+		ctx:                vq.ctx.Clone(),
+		order:              append([]vod.OrderOption{}, vq.order...),
+		inters:             append([]Interceptor{}, vq.inters...),
+		predicates:         append([]predicate.Vod{}, vq.predicates...),
+		withChannel:        vq.withChannel.Clone(),
+		withQueue:          vq.withQueue.Clone(),
+		withPlaylists:      vq.withPlaylists.Clone(),
+		withChapters:       vq.withChapters.Clone(),
+		withMutedSegments:  vq.withMutedSegments.Clone(),
+		withClipSource:     vq.withClipSource.Clone(),
+		withClips:          vq.withClips.Clone(),
+		withAlternate:      vq.withAlternate.Clone(),
+		withArchiveProfile: vq.withArchiveProfile.Clone(),
+		withChatMessages:   vq.withChatMessages.Clone(),
 		// clone intermediate query.
 		sql:  vq.sql.Clone(),
 		path: vq.path,
@@ -438,18 +560,73 @@ func (vq *VodQuery) WithMutedSegments(opts ...func(*MutedSegmentQuery)) *VodQuer
 	return vq
 }
 
+// WithClipSource tells the query-builder to eager-load the nodes that are connected to
+// the "clip_source" edge. The optional arguments are used to configure the query builder of the edge.
+func (vq *VodQuery) WithClipSource(opts ...func(*VodQuery)) *VodQuery {
+	query := (&VodClient{config: vq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vq.withClipSource = query
+	return vq
+}
+
+// WithClips tells the query-builder to eager-load the nodes that are connected to
+// the "clips" edge. The optional arguments are used to configure the query builder of the edge.
+func (vq *VodQuery) WithClips(opts ...func(*VodQuery)) *VodQuery {
+	query := (&VodClient{config: vq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vq.withClips = query
+	return vq
+}
+
+// WithAlternate tells the query-builder to eager-load the nodes that are connected to
+// the "alternate" edge. The optional arguments are used to configure the query builder of the edge.
+func (vq *VodQuery) WithAlternate(opts ...func(*VodQuery)) *VodQuery {
+	query := (&VodClient{config: vq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vq.withAlternate = query
+	return vq
+}
+
+// WithArchiveProfile tells the query-builder to eager-load the nodes that are connected to
+// the "archive_profile" edge. The optional arguments are used to configure the query builder of the edge.
+func (vq *VodQuery) WithArchiveProfile(opts ...func(*ArchiveProfileQuery)) *VodQuery {
+	query := (&ArchiveProfileClient{config: vq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vq.withArchiveProfile = query
+	return vq
+}
+
+// WithChatMessages tells the query-builder to eager-load the nodes that are connected to
+// the "chat_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (vq *VodQuery) WithChatMessages(opts ...func(*ChatMessageQuery)) *VodQuery {
+	query := (&ChatMessageClient{config: vq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	vq.withChatMessages = query
+	return vq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		ExtID string `json:"ext_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Vod.Query().
-//		GroupBy(vod.FieldExtID).
+//		GroupBy(vod.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (vq *VodQuery) GroupBy(field string, fields ...string) *VodGroupBy {
@@ -467,11 +644,11 @@ func (vq *VodQuery) GroupBy(field string, fields ...string) *VodGroupBy {
 // Example:
 //
 //	var v []struct {
-//		ExtID string `json:"ext_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Vod.Query().
-//		Select(vod.FieldExtID).
+//		Select(vod.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (vq *VodQuery) Select(fields ...string) *VodSelect {
 	vq.ctx.Fields = append(vq.ctx.Fields, fields...)
@@ -517,15 +694,20 @@ func (vq *VodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vod, err
 		nodes       = []*Vod{}
 		withFKs     = vq.withFKs
 		_spec       = vq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [10]bool{
 			vq.withChannel != nil,
 			vq.withQueue != nil,
 			vq.withPlaylists != nil,
 			vq.withChapters != nil,
 			vq.withMutedSegments != nil,
+			vq.withClipSource != nil,
+			vq.withClips != nil,
+			vq.withAlternate != nil,
+			vq.withArchiveProfile != nil,
+			vq.withChatMessages != nil,
 		}
 	)
-	if vq.withChannel != nil {
+	if vq.withChannel != nil || vq.withClipSource != nil || vq.withAlternate != nil || vq.withArchiveProfile != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -582,6 +764,38 @@ func (vq *VodQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vod, err
 			return nil, err
 		}
 	}
+	if query := vq.withClipSource; query != nil {
+		if err := vq.loadClipSource(ctx, query, nodes, nil,
+			func(n *Vod, e *Vod) { n.Edges.ClipSource = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := vq.withClips; query != nil {
+		if err := vq.loadClips(ctx, query, nodes,
+			func(n *Vod) { n.Edges.Clips = []*Vod{} },
+			func(n *Vod, e *Vod) { n.Edges.Clips = append(n.Edges.Clips, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := vq.withAlternate; query != nil {
+		if err := vq.loadAlternate(ctx, query, nodes, nil,
+			func(n *Vod, e *Vod) { n.Edges.Alternate = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := vq.withArchiveProfile; query != nil {
+		if err := vq.loadArchiveProfile(ctx, query, nodes, nil,
+			func(n *Vod, e *ArchiveProfile) { n.Edges.ArchiveProfile = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := vq.withChatMessages; query != nil {
+		if err := vq.loadChatMessages(ctx, query, nodes,
+			func(n *Vod) { n.Edges.ChatMessages = []*ChatMessage{} },
+			func(n *Vod, e *ChatMessage) { n.Edges.ChatMessages = append(n.Edges.ChatMessages, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -768,6 +982,164 @@ func (vq *VodQuery) loadMutedSegments(ctx context.Context, query *MutedSegmentQu
 	}
 	return nil
 }
+func (vq *VodQuery) loadClipSource(ctx context.Context, query *VodQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *Vod)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Vod)
+	for i := range nodes {
+		if nodes[i].vod_clips == nil {
+			continue
+		}
+		fk := *nodes[i].vod_clips
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vod.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vod_clips" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (vq *VodQuery) loadClips(ctx context.Context, query *VodQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *Vod)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Vod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Vod(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vod.ClipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.vod_clips
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "vod_clips" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vod_clips" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (vq *VodQuery) loadAlternate(ctx context.Context, query *VodQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *Vod)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Vod)
+	for i := range nodes {
+		if nodes[i].vod_alternate == nil {
+			continue
+		}
+		fk := *nodes[i].vod_alternate
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vod.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vod_alternate" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (vq *VodQuery) loadArchiveProfile(ctx context.Context, query *ArchiveProfileQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *ArchiveProfile)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Vod)
+	for i := range nodes {
+		if nodes[i].archive_profile_vods == nil {
+			continue
+		}
+		fk := *nodes[i].archive_profile_vods
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(archiveprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "archive_profile_vods" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (vq *VodQuery) loadChatMessages(ctx context.Context, query *ChatMessageQuery, nodes []*Vod, init func(*Vod), assign func(*Vod, *ChatMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Vod)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ChatMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vod.ChatMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.vod_chat_messages
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "vod_chat_messages" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vod_chat_messages" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (vq *VodQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := vq.querySpec()
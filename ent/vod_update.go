@@ -10,10 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/chapter"
+	"github.com/zibbp/ganymede/ent/chatmessage"
 	"github.com/zibbp/ganymede/ent/mutedsegment"
 	"github.com/zibbp/ganymede/ent/playlist"
 	"github.com/zibbp/ganymede/ent/predicate"
@@ -35,6 +38,26 @@ func (vu *VodUpdate) Where(ps ...predicate.Vod) *VodUpdate {
 	return vu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (vu *VodUpdate) SetDeletedAt(t time.Time) *VodUpdate {
+	vu.mutation.SetDeletedAt(t)
+	return vu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableDeletedAt(t *time.Time) *VodUpdate {
+	if t != nil {
+		vu.SetDeletedAt(*t)
+	}
+	return vu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (vu *VodUpdate) ClearDeletedAt() *VodUpdate {
+	vu.mutation.ClearDeletedAt()
+	return vu
+}
+
 // SetExtID sets the "ext_id" field.
 func (vu *VodUpdate) SetExtID(s string) *VodUpdate {
 	vu.mutation.SetExtID(s)
@@ -570,6 +593,240 @@ func (vu *VodUpdate) AddLocalViews(i int) *VodUpdate {
 	return vu
 }
 
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (vu *VodUpdate) SetLastViewedAt(t time.Time) *VodUpdate {
+	vu.mutation.SetLastViewedAt(t)
+	return vu
+}
+
+// SetNillableLastViewedAt sets the "last_viewed_at" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableLastViewedAt(t *time.Time) *VodUpdate {
+	if t != nil {
+		vu.SetLastViewedAt(*t)
+	}
+	return vu
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (vu *VodUpdate) ClearLastViewedAt() *VodUpdate {
+	vu.mutation.ClearLastViewedAt()
+	return vu
+}
+
+// SetClipExtVodID sets the "clip_ext_vod_id" field.
+func (vu *VodUpdate) SetClipExtVodID(s string) *VodUpdate {
+	vu.mutation.SetClipExtVodID(s)
+	return vu
+}
+
+// SetNillableClipExtVodID sets the "clip_ext_vod_id" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableClipExtVodID(s *string) *VodUpdate {
+	if s != nil {
+		vu.SetClipExtVodID(*s)
+	}
+	return vu
+}
+
+// ClearClipExtVodID clears the value of the "clip_ext_vod_id" field.
+func (vu *VodUpdate) ClearClipExtVodID() *VodUpdate {
+	vu.mutation.ClearClipExtVodID()
+	return vu
+}
+
+// SetClipVodOffset sets the "clip_vod_offset" field.
+func (vu *VodUpdate) SetClipVodOffset(i int) *VodUpdate {
+	vu.mutation.ResetClipVodOffset()
+	vu.mutation.SetClipVodOffset(i)
+	return vu
+}
+
+// SetNillableClipVodOffset sets the "clip_vod_offset" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableClipVodOffset(i *int) *VodUpdate {
+	if i != nil {
+		vu.SetClipVodOffset(*i)
+	}
+	return vu
+}
+
+// AddClipVodOffset adds i to the "clip_vod_offset" field.
+func (vu *VodUpdate) AddClipVodOffset(i int) *VodUpdate {
+	vu.mutation.AddClipVodOffset(i)
+	return vu
+}
+
+// ClearClipVodOffset clears the value of the "clip_vod_offset" field.
+func (vu *VodUpdate) ClearClipVodOffset() *VodUpdate {
+	vu.mutation.ClearClipVodOffset()
+	return vu
+}
+
+// SetReconnects sets the "reconnects" field.
+func (vu *VodUpdate) SetReconnects(i int) *VodUpdate {
+	vu.mutation.ResetReconnects()
+	vu.mutation.SetReconnects(i)
+	return vu
+}
+
+// SetNillableReconnects sets the "reconnects" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableReconnects(i *int) *VodUpdate {
+	if i != nil {
+		vu.SetReconnects(*i)
+	}
+	return vu
+}
+
+// AddReconnects adds i to the "reconnects" field.
+func (vu *VodUpdate) AddReconnects(i int) *VodUpdate {
+	vu.mutation.AddReconnects(i)
+	return vu
+}
+
+// SetColdStorageAt sets the "cold_storage_at" field.
+func (vu *VodUpdate) SetColdStorageAt(t time.Time) *VodUpdate {
+	vu.mutation.SetColdStorageAt(t)
+	return vu
+}
+
+// SetNillableColdStorageAt sets the "cold_storage_at" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableColdStorageAt(t *time.Time) *VodUpdate {
+	if t != nil {
+		vu.SetColdStorageAt(*t)
+	}
+	return vu
+}
+
+// ClearColdStorageAt clears the value of the "cold_storage_at" field.
+func (vu *VodUpdate) ClearColdStorageAt() *VodUpdate {
+	vu.mutation.ClearColdStorageAt()
+	return vu
+}
+
+// SetChatImportedAt sets the "chat_imported_at" field.
+func (vu *VodUpdate) SetChatImportedAt(t time.Time) *VodUpdate {
+	vu.mutation.SetChatImportedAt(t)
+	return vu
+}
+
+// SetNillableChatImportedAt sets the "chat_imported_at" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableChatImportedAt(t *time.Time) *VodUpdate {
+	if t != nil {
+		vu.SetChatImportedAt(*t)
+	}
+	return vu
+}
+
+// ClearChatImportedAt clears the value of the "chat_imported_at" field.
+func (vu *VodUpdate) ClearChatImportedAt() *VodUpdate {
+	vu.mutation.ClearChatImportedAt()
+	return vu
+}
+
+// SetHealth sets the "health" field.
+func (vu *VodUpdate) SetHealth(uh utils.VodHealth) *VodUpdate {
+	vu.mutation.SetHealth(uh)
+	return vu
+}
+
+// SetNillableHealth sets the "health" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableHealth(uh *utils.VodHealth) *VodUpdate {
+	if uh != nil {
+		vu.SetHealth(*uh)
+	}
+	return vu
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (vu *VodUpdate) SetHealthIssues(s []string) *VodUpdate {
+	vu.mutation.SetHealthIssues(s)
+	return vu
+}
+
+// AppendHealthIssues appends s to the "health_issues" field.
+func (vu *VodUpdate) AppendHealthIssues(s []string) *VodUpdate {
+	vu.mutation.AppendHealthIssues(s)
+	return vu
+}
+
+// ClearHealthIssues clears the value of the "health_issues" field.
+func (vu *VodUpdate) ClearHealthIssues() *VodUpdate {
+	vu.mutation.ClearHealthIssues()
+	return vu
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (vu *VodUpdate) SetVerifiedAt(t time.Time) *VodUpdate {
+	vu.mutation.SetVerifiedAt(t)
+	return vu
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableVerifiedAt(t *time.Time) *VodUpdate {
+	if t != nil {
+		vu.SetVerifiedAt(*t)
+	}
+	return vu
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (vu *VodUpdate) ClearVerifiedAt() *VodUpdate {
+	vu.mutation.ClearVerifiedAt()
+	return vu
+}
+
+// SetVideoPrunedAt sets the "video_pruned_at" field.
+func (vu *VodUpdate) SetVideoPrunedAt(t time.Time) *VodUpdate {
+	vu.mutation.SetVideoPrunedAt(t)
+	return vu
+}
+
+// SetNillableVideoPrunedAt sets the "video_pruned_at" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableVideoPrunedAt(t *time.Time) *VodUpdate {
+	if t != nil {
+		vu.SetVideoPrunedAt(*t)
+	}
+	return vu
+}
+
+// ClearVideoPrunedAt clears the value of the "video_pruned_at" field.
+func (vu *VodUpdate) ClearVideoPrunedAt() *VodUpdate {
+	vu.mutation.ClearVideoPrunedAt()
+	return vu
+}
+
+// SetTrashPath sets the "trash_path" field.
+func (vu *VodUpdate) SetTrashPath(s string) *VodUpdate {
+	vu.mutation.SetTrashPath(s)
+	return vu
+}
+
+// SetNillableTrashPath sets the "trash_path" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableTrashPath(s *string) *VodUpdate {
+	if s != nil {
+		vu.SetTrashPath(*s)
+	}
+	return vu
+}
+
+// ClearTrashPath clears the value of the "trash_path" field.
+func (vu *VodUpdate) ClearTrashPath() *VodUpdate {
+	vu.mutation.ClearTrashPath()
+	return vu
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (vu *VodUpdate) SetStorageBackend(ub utils.StorageBackend) *VodUpdate {
+	vu.mutation.SetStorageBackend(ub)
+	return vu
+}
+
+// SetNillableStorageBackend sets the "storage_backend" field if the given value is not nil.
+func (vu *VodUpdate) SetNillableStorageBackend(ub *utils.StorageBackend) *VodUpdate {
+	if ub != nil {
+		vu.SetStorageBackend(*ub)
+	}
+	return vu
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (vu *VodUpdate) SetStreamedAt(t time.Time) *VodUpdate {
 	vu.mutation.SetStreamedAt(t)
@@ -665,6 +922,93 @@ func (vu *VodUpdate) AddMutedSegments(m ...*MutedSegment) *VodUpdate {
 	return vu.AddMutedSegmentIDs(ids...)
 }
 
+// SetClipSourceID sets the "clip_source" edge to the Vod entity by ID.
+func (vu *VodUpdate) SetClipSourceID(id uuid.UUID) *VodUpdate {
+	vu.mutation.SetClipSourceID(id)
+	return vu
+}
+
+// SetNillableClipSourceID sets the "clip_source" edge to the Vod entity by ID if the given value is not nil.
+func (vu *VodUpdate) SetNillableClipSourceID(id *uuid.UUID) *VodUpdate {
+	if id != nil {
+		vu = vu.SetClipSourceID(*id)
+	}
+	return vu
+}
+
+// SetClipSource sets the "clip_source" edge to the Vod entity.
+func (vu *VodUpdate) SetClipSource(v *Vod) *VodUpdate {
+	return vu.SetClipSourceID(v.ID)
+}
+
+// AddClipIDs adds the "clips" edge to the Vod entity by IDs.
+func (vu *VodUpdate) AddClipIDs(ids ...uuid.UUID) *VodUpdate {
+	vu.mutation.AddClipIDs(ids...)
+	return vu
+}
+
+// AddClips adds the "clips" edges to the Vod entity.
+func (vu *VodUpdate) AddClips(v ...*Vod) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return vu.AddClipIDs(ids...)
+}
+
+// SetAlternateID sets the "alternate" edge to the Vod entity by ID.
+func (vu *VodUpdate) SetAlternateID(id uuid.UUID) *VodUpdate {
+	vu.mutation.SetAlternateID(id)
+	return vu
+}
+
+// SetNillableAlternateID sets the "alternate" edge to the Vod entity by ID if the given value is not nil.
+func (vu *VodUpdate) SetNillableAlternateID(id *uuid.UUID) *VodUpdate {
+	if id != nil {
+		vu = vu.SetAlternateID(*id)
+	}
+	return vu
+}
+
+// SetAlternate sets the "alternate" edge to the Vod entity.
+func (vu *VodUpdate) SetAlternate(v *Vod) *VodUpdate {
+	return vu.SetAlternateID(v.ID)
+}
+
+// SetArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID.
+func (vu *VodUpdate) SetArchiveProfileID(id uuid.UUID) *VodUpdate {
+	vu.mutation.SetArchiveProfileID(id)
+	return vu
+}
+
+// SetNillableArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID if the given value is not nil.
+func (vu *VodUpdate) SetNillableArchiveProfileID(id *uuid.UUID) *VodUpdate {
+	if id != nil {
+		vu = vu.SetArchiveProfileID(*id)
+	}
+	return vu
+}
+
+// SetArchiveProfile sets the "archive_profile" edge to the ArchiveProfile entity.
+func (vu *VodUpdate) SetArchiveProfile(a *ArchiveProfile) *VodUpdate {
+	return vu.SetArchiveProfileID(a.ID)
+}
+
+// AddChatMessageIDs adds the "chat_messages" edge to the ChatMessage entity by IDs.
+func (vu *VodUpdate) AddChatMessageIDs(ids ...uuid.UUID) *VodUpdate {
+	vu.mutation.AddChatMessageIDs(ids...)
+	return vu
+}
+
+// AddChatMessages adds the "chat_messages" edges to the ChatMessage entity.
+func (vu *VodUpdate) AddChatMessages(c ...*ChatMessage) *VodUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vu.AddChatMessageIDs(ids...)
+}
+
 // Mutation returns the VodMutation object of the builder.
 func (vu *VodUpdate) Mutation() *VodMutation {
 	return vu.mutation
@@ -745,6 +1089,66 @@ func (vu *VodUpdate) RemoveMutedSegments(m ...*MutedSegment) *VodUpdate {
 	return vu.RemoveMutedSegmentIDs(ids...)
 }
 
+// ClearClipSource clears the "clip_source" edge to the Vod entity.
+func (vu *VodUpdate) ClearClipSource() *VodUpdate {
+	vu.mutation.ClearClipSource()
+	return vu
+}
+
+// ClearClips clears all "clips" edges to the Vod entity.
+func (vu *VodUpdate) ClearClips() *VodUpdate {
+	vu.mutation.ClearClips()
+	return vu
+}
+
+// RemoveClipIDs removes the "clips" edge to Vod entities by IDs.
+func (vu *VodUpdate) RemoveClipIDs(ids ...uuid.UUID) *VodUpdate {
+	vu.mutation.RemoveClipIDs(ids...)
+	return vu
+}
+
+// RemoveClips removes "clips" edges to Vod entities.
+func (vu *VodUpdate) RemoveClips(v ...*Vod) *VodUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return vu.RemoveClipIDs(ids...)
+}
+
+// ClearAlternate clears the "alternate" edge to the Vod entity.
+func (vu *VodUpdate) ClearAlternate() *VodUpdate {
+	vu.mutation.ClearAlternate()
+	return vu
+}
+
+// ClearArchiveProfile clears the "archive_profile" edge to the ArchiveProfile entity.
+func (vu *VodUpdate) ClearArchiveProfile() *VodUpdate {
+	vu.mutation.ClearArchiveProfile()
+	return vu
+}
+
+// ClearChatMessages clears all "chat_messages" edges to the ChatMessage entity.
+func (vu *VodUpdate) ClearChatMessages() *VodUpdate {
+	vu.mutation.ClearChatMessages()
+	return vu
+}
+
+// RemoveChatMessageIDs removes the "chat_messages" edge to ChatMessage entities by IDs.
+func (vu *VodUpdate) RemoveChatMessageIDs(ids ...uuid.UUID) *VodUpdate {
+	vu.mutation.RemoveChatMessageIDs(ids...)
+	return vu
+}
+
+// RemoveChatMessages removes "chat_messages" edges to ChatMessage entities.
+func (vu *VodUpdate) RemoveChatMessages(c ...*ChatMessage) *VodUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vu.RemoveChatMessageIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (vu *VodUpdate) Save(ctx context.Context) (int, error) {
 	vu.defaults()
@@ -793,6 +1197,16 @@ func (vu *VodUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Vod.type": %w`, err)}
 		}
 	}
+	if v, ok := vu.mutation.Health(); ok {
+		if err := vod.HealthValidator(v); err != nil {
+			return &ValidationError{Name: "health", err: fmt.Errorf(`ent: validator failed for field "Vod.health": %w`, err)}
+		}
+	}
+	if v, ok := vu.mutation.StorageBackend(); ok {
+		if err := vod.StorageBackendValidator(v); err != nil {
+			return &ValidationError{Name: "storage_backend", err: fmt.Errorf(`ent: validator failed for field "Vod.storage_backend": %w`, err)}
+		}
+	}
 	if _, ok := vu.mutation.ChannelID(); vu.mutation.ChannelCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Vod.channel"`)
 	}
@@ -811,6 +1225,12 @@ func (vu *VodUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := vu.mutation.DeletedAt(); ok {
+		_spec.SetField(vod.FieldDeletedAt, field.TypeTime, value)
+	}
+	if vu.mutation.DeletedAtCleared() {
+		_spec.ClearField(vod.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := vu.mutation.ExtID(); ok {
 		_spec.SetField(vod.FieldExtID, field.TypeString, value)
 	}
@@ -961,6 +1381,80 @@ func (vu *VodUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := vu.mutation.AddedLocalViews(); ok {
 		_spec.AddField(vod.FieldLocalViews, field.TypeInt, value)
 	}
+	if value, ok := vu.mutation.LastViewedAt(); ok {
+		_spec.SetField(vod.FieldLastViewedAt, field.TypeTime, value)
+	}
+	if vu.mutation.LastViewedAtCleared() {
+		_spec.ClearField(vod.FieldLastViewedAt, field.TypeTime)
+	}
+	if value, ok := vu.mutation.ClipExtVodID(); ok {
+		_spec.SetField(vod.FieldClipExtVodID, field.TypeString, value)
+	}
+	if vu.mutation.ClipExtVodIDCleared() {
+		_spec.ClearField(vod.FieldClipExtVodID, field.TypeString)
+	}
+	if value, ok := vu.mutation.ClipVodOffset(); ok {
+		_spec.SetField(vod.FieldClipVodOffset, field.TypeInt, value)
+	}
+	if value, ok := vu.mutation.AddedClipVodOffset(); ok {
+		_spec.AddField(vod.FieldClipVodOffset, field.TypeInt, value)
+	}
+	if vu.mutation.ClipVodOffsetCleared() {
+		_spec.ClearField(vod.FieldClipVodOffset, field.TypeInt)
+	}
+	if value, ok := vu.mutation.Reconnects(); ok {
+		_spec.SetField(vod.FieldReconnects, field.TypeInt, value)
+	}
+	if value, ok := vu.mutation.AddedReconnects(); ok {
+		_spec.AddField(vod.FieldReconnects, field.TypeInt, value)
+	}
+	if value, ok := vu.mutation.ColdStorageAt(); ok {
+		_spec.SetField(vod.FieldColdStorageAt, field.TypeTime, value)
+	}
+	if vu.mutation.ColdStorageAtCleared() {
+		_spec.ClearField(vod.FieldColdStorageAt, field.TypeTime)
+	}
+	if value, ok := vu.mutation.ChatImportedAt(); ok {
+		_spec.SetField(vod.FieldChatImportedAt, field.TypeTime, value)
+	}
+	if vu.mutation.ChatImportedAtCleared() {
+		_spec.ClearField(vod.FieldChatImportedAt, field.TypeTime)
+	}
+	if value, ok := vu.mutation.Health(); ok {
+		_spec.SetField(vod.FieldHealth, field.TypeEnum, value)
+	}
+	if value, ok := vu.mutation.HealthIssues(); ok {
+		_spec.SetField(vod.FieldHealthIssues, field.TypeJSON, value)
+	}
+	if value, ok := vu.mutation.AppendedHealthIssues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vod.FieldHealthIssues, value)
+		})
+	}
+	if vu.mutation.HealthIssuesCleared() {
+		_spec.ClearField(vod.FieldHealthIssues, field.TypeJSON)
+	}
+	if value, ok := vu.mutation.VerifiedAt(); ok {
+		_spec.SetField(vod.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if vu.mutation.VerifiedAtCleared() {
+		_spec.ClearField(vod.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := vu.mutation.VideoPrunedAt(); ok {
+		_spec.SetField(vod.FieldVideoPrunedAt, field.TypeTime, value)
+	}
+	if vu.mutation.VideoPrunedAtCleared() {
+		_spec.ClearField(vod.FieldVideoPrunedAt, field.TypeTime)
+	}
+	if value, ok := vu.mutation.TrashPath(); ok {
+		_spec.SetField(vod.FieldTrashPath, field.TypeString, value)
+	}
+	if vu.mutation.TrashPathCleared() {
+		_spec.ClearField(vod.FieldTrashPath, field.TypeString)
+	}
+	if value, ok := vu.mutation.StorageBackend(); ok {
+		_spec.SetField(vod.FieldStorageBackend, field.TypeEnum, value)
+	}
 	if value, ok := vu.mutation.StreamedAt(); ok {
 		_spec.SetField(vod.FieldStreamedAt, field.TypeTime, value)
 	}
@@ -1160,16 +1654,193 @@ func (vu *VodUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, vu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{vod.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if vu.mutation.ClipSourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vod.ClipSourceTable,
+			Columns: []string{vod.ClipSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	vu.mutation.done = true
-	return n, nil
+	if nodes := vu.mutation.ClipSourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vod.ClipSourceTable,
+			Columns: []string{vod.ClipSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vu.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ClipsTable,
+			Columns: []string{vod.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vu.mutation.RemovedClipsIDs(); len(nodes) > 0 && !vu.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ClipsTable,
+			Columns: []string{vod.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vu.mutation.ClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ClipsTable,
+			Columns: []string{vod.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vu.mutation.AlternateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   vod.AlternateTable,
+			Columns: []string{vod.AlternateColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vu.mutation.AlternateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   vod.AlternateTable,
+			Columns: []string{vod.AlternateColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vu.mutation.ArchiveProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vod.ArchiveProfileTable,
+			Columns: []string{vod.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vu.mutation.ArchiveProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vod.ArchiveProfileTable,
+			Columns: []string{vod.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vu.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vu.mutation.RemovedChatMessagesIDs(); len(nodes) > 0 && !vu.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vu.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, vu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	vu.mutation.done = true
+	return n, nil
 }
 
 // VodUpdateOne is the builder for updating a single Vod entity.
@@ -1180,6 +1851,26 @@ type VodUpdateOne struct {
 	mutation *VodMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (vuo *VodUpdateOne) SetDeletedAt(t time.Time) *VodUpdateOne {
+	vuo.mutation.SetDeletedAt(t)
+	return vuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableDeletedAt(t *time.Time) *VodUpdateOne {
+	if t != nil {
+		vuo.SetDeletedAt(*t)
+	}
+	return vuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (vuo *VodUpdateOne) ClearDeletedAt() *VodUpdateOne {
+	vuo.mutation.ClearDeletedAt()
+	return vuo
+}
+
 // SetExtID sets the "ext_id" field.
 func (vuo *VodUpdateOne) SetExtID(s string) *VodUpdateOne {
 	vuo.mutation.SetExtID(s)
@@ -1715,6 +2406,240 @@ func (vuo *VodUpdateOne) AddLocalViews(i int) *VodUpdateOne {
 	return vuo
 }
 
+// SetLastViewedAt sets the "last_viewed_at" field.
+func (vuo *VodUpdateOne) SetLastViewedAt(t time.Time) *VodUpdateOne {
+	vuo.mutation.SetLastViewedAt(t)
+	return vuo
+}
+
+// SetNillableLastViewedAt sets the "last_viewed_at" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableLastViewedAt(t *time.Time) *VodUpdateOne {
+	if t != nil {
+		vuo.SetLastViewedAt(*t)
+	}
+	return vuo
+}
+
+// ClearLastViewedAt clears the value of the "last_viewed_at" field.
+func (vuo *VodUpdateOne) ClearLastViewedAt() *VodUpdateOne {
+	vuo.mutation.ClearLastViewedAt()
+	return vuo
+}
+
+// SetClipExtVodID sets the "clip_ext_vod_id" field.
+func (vuo *VodUpdateOne) SetClipExtVodID(s string) *VodUpdateOne {
+	vuo.mutation.SetClipExtVodID(s)
+	return vuo
+}
+
+// SetNillableClipExtVodID sets the "clip_ext_vod_id" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableClipExtVodID(s *string) *VodUpdateOne {
+	if s != nil {
+		vuo.SetClipExtVodID(*s)
+	}
+	return vuo
+}
+
+// ClearClipExtVodID clears the value of the "clip_ext_vod_id" field.
+func (vuo *VodUpdateOne) ClearClipExtVodID() *VodUpdateOne {
+	vuo.mutation.ClearClipExtVodID()
+	return vuo
+}
+
+// SetClipVodOffset sets the "clip_vod_offset" field.
+func (vuo *VodUpdateOne) SetClipVodOffset(i int) *VodUpdateOne {
+	vuo.mutation.ResetClipVodOffset()
+	vuo.mutation.SetClipVodOffset(i)
+	return vuo
+}
+
+// SetNillableClipVodOffset sets the "clip_vod_offset" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableClipVodOffset(i *int) *VodUpdateOne {
+	if i != nil {
+		vuo.SetClipVodOffset(*i)
+	}
+	return vuo
+}
+
+// AddClipVodOffset adds i to the "clip_vod_offset" field.
+func (vuo *VodUpdateOne) AddClipVodOffset(i int) *VodUpdateOne {
+	vuo.mutation.AddClipVodOffset(i)
+	return vuo
+}
+
+// ClearClipVodOffset clears the value of the "clip_vod_offset" field.
+func (vuo *VodUpdateOne) ClearClipVodOffset() *VodUpdateOne {
+	vuo.mutation.ClearClipVodOffset()
+	return vuo
+}
+
+// SetReconnects sets the "reconnects" field.
+func (vuo *VodUpdateOne) SetReconnects(i int) *VodUpdateOne {
+	vuo.mutation.ResetReconnects()
+	vuo.mutation.SetReconnects(i)
+	return vuo
+}
+
+// SetNillableReconnects sets the "reconnects" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableReconnects(i *int) *VodUpdateOne {
+	if i != nil {
+		vuo.SetReconnects(*i)
+	}
+	return vuo
+}
+
+// AddReconnects adds i to the "reconnects" field.
+func (vuo *VodUpdateOne) AddReconnects(i int) *VodUpdateOne {
+	vuo.mutation.AddReconnects(i)
+	return vuo
+}
+
+// SetColdStorageAt sets the "cold_storage_at" field.
+func (vuo *VodUpdateOne) SetColdStorageAt(t time.Time) *VodUpdateOne {
+	vuo.mutation.SetColdStorageAt(t)
+	return vuo
+}
+
+// SetNillableColdStorageAt sets the "cold_storage_at" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableColdStorageAt(t *time.Time) *VodUpdateOne {
+	if t != nil {
+		vuo.SetColdStorageAt(*t)
+	}
+	return vuo
+}
+
+// ClearColdStorageAt clears the value of the "cold_storage_at" field.
+func (vuo *VodUpdateOne) ClearColdStorageAt() *VodUpdateOne {
+	vuo.mutation.ClearColdStorageAt()
+	return vuo
+}
+
+// SetChatImportedAt sets the "chat_imported_at" field.
+func (vuo *VodUpdateOne) SetChatImportedAt(t time.Time) *VodUpdateOne {
+	vuo.mutation.SetChatImportedAt(t)
+	return vuo
+}
+
+// SetNillableChatImportedAt sets the "chat_imported_at" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableChatImportedAt(t *time.Time) *VodUpdateOne {
+	if t != nil {
+		vuo.SetChatImportedAt(*t)
+	}
+	return vuo
+}
+
+// ClearChatImportedAt clears the value of the "chat_imported_at" field.
+func (vuo *VodUpdateOne) ClearChatImportedAt() *VodUpdateOne {
+	vuo.mutation.ClearChatImportedAt()
+	return vuo
+}
+
+// SetHealth sets the "health" field.
+func (vuo *VodUpdateOne) SetHealth(uh utils.VodHealth) *VodUpdateOne {
+	vuo.mutation.SetHealth(uh)
+	return vuo
+}
+
+// SetNillableHealth sets the "health" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableHealth(uh *utils.VodHealth) *VodUpdateOne {
+	if uh != nil {
+		vuo.SetHealth(*uh)
+	}
+	return vuo
+}
+
+// SetHealthIssues sets the "health_issues" field.
+func (vuo *VodUpdateOne) SetHealthIssues(s []string) *VodUpdateOne {
+	vuo.mutation.SetHealthIssues(s)
+	return vuo
+}
+
+// AppendHealthIssues appends s to the "health_issues" field.
+func (vuo *VodUpdateOne) AppendHealthIssues(s []string) *VodUpdateOne {
+	vuo.mutation.AppendHealthIssues(s)
+	return vuo
+}
+
+// ClearHealthIssues clears the value of the "health_issues" field.
+func (vuo *VodUpdateOne) ClearHealthIssues() *VodUpdateOne {
+	vuo.mutation.ClearHealthIssues()
+	return vuo
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (vuo *VodUpdateOne) SetVerifiedAt(t time.Time) *VodUpdateOne {
+	vuo.mutation.SetVerifiedAt(t)
+	return vuo
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableVerifiedAt(t *time.Time) *VodUpdateOne {
+	if t != nil {
+		vuo.SetVerifiedAt(*t)
+	}
+	return vuo
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (vuo *VodUpdateOne) ClearVerifiedAt() *VodUpdateOne {
+	vuo.mutation.ClearVerifiedAt()
+	return vuo
+}
+
+// SetVideoPrunedAt sets the "video_pruned_at" field.
+func (vuo *VodUpdateOne) SetVideoPrunedAt(t time.Time) *VodUpdateOne {
+	vuo.mutation.SetVideoPrunedAt(t)
+	return vuo
+}
+
+// SetNillableVideoPrunedAt sets the "video_pruned_at" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableVideoPrunedAt(t *time.Time) *VodUpdateOne {
+	if t != nil {
+		vuo.SetVideoPrunedAt(*t)
+	}
+	return vuo
+}
+
+// ClearVideoPrunedAt clears the value of the "video_pruned_at" field.
+func (vuo *VodUpdateOne) ClearVideoPrunedAt() *VodUpdateOne {
+	vuo.mutation.ClearVideoPrunedAt()
+	return vuo
+}
+
+// SetTrashPath sets the "trash_path" field.
+func (vuo *VodUpdateOne) SetTrashPath(s string) *VodUpdateOne {
+	vuo.mutation.SetTrashPath(s)
+	return vuo
+}
+
+// SetNillableTrashPath sets the "trash_path" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableTrashPath(s *string) *VodUpdateOne {
+	if s != nil {
+		vuo.SetTrashPath(*s)
+	}
+	return vuo
+}
+
+// ClearTrashPath clears the value of the "trash_path" field.
+func (vuo *VodUpdateOne) ClearTrashPath() *VodUpdateOne {
+	vuo.mutation.ClearTrashPath()
+	return vuo
+}
+
+// SetStorageBackend sets the "storage_backend" field.
+func (vuo *VodUpdateOne) SetStorageBackend(ub utils.StorageBackend) *VodUpdateOne {
+	vuo.mutation.SetStorageBackend(ub)
+	return vuo
+}
+
+// SetNillableStorageBackend sets the "storage_backend" field if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableStorageBackend(ub *utils.StorageBackend) *VodUpdateOne {
+	if ub != nil {
+		vuo.SetStorageBackend(*ub)
+	}
+	return vuo
+}
+
 // SetStreamedAt sets the "streamed_at" field.
 func (vuo *VodUpdateOne) SetStreamedAt(t time.Time) *VodUpdateOne {
 	vuo.mutation.SetStreamedAt(t)
@@ -1810,6 +2735,93 @@ func (vuo *VodUpdateOne) AddMutedSegments(m ...*MutedSegment) *VodUpdateOne {
 	return vuo.AddMutedSegmentIDs(ids...)
 }
 
+// SetClipSourceID sets the "clip_source" edge to the Vod entity by ID.
+func (vuo *VodUpdateOne) SetClipSourceID(id uuid.UUID) *VodUpdateOne {
+	vuo.mutation.SetClipSourceID(id)
+	return vuo
+}
+
+// SetNillableClipSourceID sets the "clip_source" edge to the Vod entity by ID if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableClipSourceID(id *uuid.UUID) *VodUpdateOne {
+	if id != nil {
+		vuo = vuo.SetClipSourceID(*id)
+	}
+	return vuo
+}
+
+// SetClipSource sets the "clip_source" edge to the Vod entity.
+func (vuo *VodUpdateOne) SetClipSource(v *Vod) *VodUpdateOne {
+	return vuo.SetClipSourceID(v.ID)
+}
+
+// AddClipIDs adds the "clips" edge to the Vod entity by IDs.
+func (vuo *VodUpdateOne) AddClipIDs(ids ...uuid.UUID) *VodUpdateOne {
+	vuo.mutation.AddClipIDs(ids...)
+	return vuo
+}
+
+// AddClips adds the "clips" edges to the Vod entity.
+func (vuo *VodUpdateOne) AddClips(v ...*Vod) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return vuo.AddClipIDs(ids...)
+}
+
+// SetAlternateID sets the "alternate" edge to the Vod entity by ID.
+func (vuo *VodUpdateOne) SetAlternateID(id uuid.UUID) *VodUpdateOne {
+	vuo.mutation.SetAlternateID(id)
+	return vuo
+}
+
+// SetNillableAlternateID sets the "alternate" edge to the Vod entity by ID if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableAlternateID(id *uuid.UUID) *VodUpdateOne {
+	if id != nil {
+		vuo = vuo.SetAlternateID(*id)
+	}
+	return vuo
+}
+
+// SetAlternate sets the "alternate" edge to the Vod entity.
+func (vuo *VodUpdateOne) SetAlternate(v *Vod) *VodUpdateOne {
+	return vuo.SetAlternateID(v.ID)
+}
+
+// SetArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID.
+func (vuo *VodUpdateOne) SetArchiveProfileID(id uuid.UUID) *VodUpdateOne {
+	vuo.mutation.SetArchiveProfileID(id)
+	return vuo
+}
+
+// SetNillableArchiveProfileID sets the "archive_profile" edge to the ArchiveProfile entity by ID if the given value is not nil.
+func (vuo *VodUpdateOne) SetNillableArchiveProfileID(id *uuid.UUID) *VodUpdateOne {
+	if id != nil {
+		vuo = vuo.SetArchiveProfileID(*id)
+	}
+	return vuo
+}
+
+// SetArchiveProfile sets the "archive_profile" edge to the ArchiveProfile entity.
+func (vuo *VodUpdateOne) SetArchiveProfile(a *ArchiveProfile) *VodUpdateOne {
+	return vuo.SetArchiveProfileID(a.ID)
+}
+
+// AddChatMessageIDs adds the "chat_messages" edge to the ChatMessage entity by IDs.
+func (vuo *VodUpdateOne) AddChatMessageIDs(ids ...uuid.UUID) *VodUpdateOne {
+	vuo.mutation.AddChatMessageIDs(ids...)
+	return vuo
+}
+
+// AddChatMessages adds the "chat_messages" edges to the ChatMessage entity.
+func (vuo *VodUpdateOne) AddChatMessages(c ...*ChatMessage) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vuo.AddChatMessageIDs(ids...)
+}
+
 // Mutation returns the VodMutation object of the builder.
 func (vuo *VodUpdateOne) Mutation() *VodMutation {
 	return vuo.mutation
@@ -1890,6 +2902,66 @@ func (vuo *VodUpdateOne) RemoveMutedSegments(m ...*MutedSegment) *VodUpdateOne {
 	return vuo.RemoveMutedSegmentIDs(ids...)
 }
 
+// ClearClipSource clears the "clip_source" edge to the Vod entity.
+func (vuo *VodUpdateOne) ClearClipSource() *VodUpdateOne {
+	vuo.mutation.ClearClipSource()
+	return vuo
+}
+
+// ClearClips clears all "clips" edges to the Vod entity.
+func (vuo *VodUpdateOne) ClearClips() *VodUpdateOne {
+	vuo.mutation.ClearClips()
+	return vuo
+}
+
+// RemoveClipIDs removes the "clips" edge to Vod entities by IDs.
+func (vuo *VodUpdateOne) RemoveClipIDs(ids ...uuid.UUID) *VodUpdateOne {
+	vuo.mutation.RemoveClipIDs(ids...)
+	return vuo
+}
+
+// RemoveClips removes "clips" edges to Vod entities.
+func (vuo *VodUpdateOne) RemoveClips(v ...*Vod) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return vuo.RemoveClipIDs(ids...)
+}
+
+// ClearAlternate clears the "alternate" edge to the Vod entity.
+func (vuo *VodUpdateOne) ClearAlternate() *VodUpdateOne {
+	vuo.mutation.ClearAlternate()
+	return vuo
+}
+
+// ClearArchiveProfile clears the "archive_profile" edge to the ArchiveProfile entity.
+func (vuo *VodUpdateOne) ClearArchiveProfile() *VodUpdateOne {
+	vuo.mutation.ClearArchiveProfile()
+	return vuo
+}
+
+// ClearChatMessages clears all "chat_messages" edges to the ChatMessage entity.
+func (vuo *VodUpdateOne) ClearChatMessages() *VodUpdateOne {
+	vuo.mutation.ClearChatMessages()
+	return vuo
+}
+
+// RemoveChatMessageIDs removes the "chat_messages" edge to ChatMessage entities by IDs.
+func (vuo *VodUpdateOne) RemoveChatMessageIDs(ids ...uuid.UUID) *VodUpdateOne {
+	vuo.mutation.RemoveChatMessageIDs(ids...)
+	return vuo
+}
+
+// RemoveChatMessages removes "chat_messages" edges to ChatMessage entities.
+func (vuo *VodUpdateOne) RemoveChatMessages(c ...*ChatMessage) *VodUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return vuo.RemoveChatMessageIDs(ids...)
+}
+
 // Where appends a list predicates to the VodUpdate builder.
 func (vuo *VodUpdateOne) Where(ps ...predicate.Vod) *VodUpdateOne {
 	vuo.mutation.Where(ps...)
@@ -1951,6 +3023,16 @@ func (vuo *VodUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Vod.type": %w`, err)}
 		}
 	}
+	if v, ok := vuo.mutation.Health(); ok {
+		if err := vod.HealthValidator(v); err != nil {
+			return &ValidationError{Name: "health", err: fmt.Errorf(`ent: validator failed for field "Vod.health": %w`, err)}
+		}
+	}
+	if v, ok := vuo.mutation.StorageBackend(); ok {
+		if err := vod.StorageBackendValidator(v); err != nil {
+			return &ValidationError{Name: "storage_backend", err: fmt.Errorf(`ent: validator failed for field "Vod.storage_backend": %w`, err)}
+		}
+	}
 	if _, ok := vuo.mutation.ChannelID(); vuo.mutation.ChannelCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Vod.channel"`)
 	}
@@ -1986,6 +3068,12 @@ func (vuo *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 			}
 		}
 	}
+	if value, ok := vuo.mutation.DeletedAt(); ok {
+		_spec.SetField(vod.FieldDeletedAt, field.TypeTime, value)
+	}
+	if vuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(vod.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := vuo.mutation.ExtID(); ok {
 		_spec.SetField(vod.FieldExtID, field.TypeString, value)
 	}
@@ -2136,6 +3224,80 @@ func (vuo *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 	if value, ok := vuo.mutation.AddedLocalViews(); ok {
 		_spec.AddField(vod.FieldLocalViews, field.TypeInt, value)
 	}
+	if value, ok := vuo.mutation.LastViewedAt(); ok {
+		_spec.SetField(vod.FieldLastViewedAt, field.TypeTime, value)
+	}
+	if vuo.mutation.LastViewedAtCleared() {
+		_spec.ClearField(vod.FieldLastViewedAt, field.TypeTime)
+	}
+	if value, ok := vuo.mutation.ClipExtVodID(); ok {
+		_spec.SetField(vod.FieldClipExtVodID, field.TypeString, value)
+	}
+	if vuo.mutation.ClipExtVodIDCleared() {
+		_spec.ClearField(vod.FieldClipExtVodID, field.TypeString)
+	}
+	if value, ok := vuo.mutation.ClipVodOffset(); ok {
+		_spec.SetField(vod.FieldClipVodOffset, field.TypeInt, value)
+	}
+	if value, ok := vuo.mutation.AddedClipVodOffset(); ok {
+		_spec.AddField(vod.FieldClipVodOffset, field.TypeInt, value)
+	}
+	if vuo.mutation.ClipVodOffsetCleared() {
+		_spec.ClearField(vod.FieldClipVodOffset, field.TypeInt)
+	}
+	if value, ok := vuo.mutation.Reconnects(); ok {
+		_spec.SetField(vod.FieldReconnects, field.TypeInt, value)
+	}
+	if value, ok := vuo.mutation.AddedReconnects(); ok {
+		_spec.AddField(vod.FieldReconnects, field.TypeInt, value)
+	}
+	if value, ok := vuo.mutation.ColdStorageAt(); ok {
+		_spec.SetField(vod.FieldColdStorageAt, field.TypeTime, value)
+	}
+	if vuo.mutation.ColdStorageAtCleared() {
+		_spec.ClearField(vod.FieldColdStorageAt, field.TypeTime)
+	}
+	if value, ok := vuo.mutation.ChatImportedAt(); ok {
+		_spec.SetField(vod.FieldChatImportedAt, field.TypeTime, value)
+	}
+	if vuo.mutation.ChatImportedAtCleared() {
+		_spec.ClearField(vod.FieldChatImportedAt, field.TypeTime)
+	}
+	if value, ok := vuo.mutation.Health(); ok {
+		_spec.SetField(vod.FieldHealth, field.TypeEnum, value)
+	}
+	if value, ok := vuo.mutation.HealthIssues(); ok {
+		_spec.SetField(vod.FieldHealthIssues, field.TypeJSON, value)
+	}
+	if value, ok := vuo.mutation.AppendedHealthIssues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vod.FieldHealthIssues, value)
+		})
+	}
+	if vuo.mutation.HealthIssuesCleared() {
+		_spec.ClearField(vod.FieldHealthIssues, field.TypeJSON)
+	}
+	if value, ok := vuo.mutation.VerifiedAt(); ok {
+		_spec.SetField(vod.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if vuo.mutation.VerifiedAtCleared() {
+		_spec.ClearField(vod.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := vuo.mutation.VideoPrunedAt(); ok {
+		_spec.SetField(vod.FieldVideoPrunedAt, field.TypeTime, value)
+	}
+	if vuo.mutation.VideoPrunedAtCleared() {
+		_spec.ClearField(vod.FieldVideoPrunedAt, field.TypeTime)
+	}
+	if value, ok := vuo.mutation.TrashPath(); ok {
+		_spec.SetField(vod.FieldTrashPath, field.TypeString, value)
+	}
+	if vuo.mutation.TrashPathCleared() {
+		_spec.ClearField(vod.FieldTrashPath, field.TypeString)
+	}
+	if value, ok := vuo.mutation.StorageBackend(); ok {
+		_spec.SetField(vod.FieldStorageBackend, field.TypeEnum, value)
+	}
 	if value, ok := vuo.mutation.StreamedAt(); ok {
 		_spec.SetField(vod.FieldStreamedAt, field.TypeTime, value)
 	}
@@ -2335,6 +3497,183 @@ func (vuo *VodUpdateOne) sqlSave(ctx context.Context) (_node *Vod, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if vuo.mutation.ClipSourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vod.ClipSourceTable,
+			Columns: []string{vod.ClipSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vuo.mutation.ClipSourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vod.ClipSourceTable,
+			Columns: []string{vod.ClipSourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vuo.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ClipsTable,
+			Columns: []string{vod.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vuo.mutation.RemovedClipsIDs(); len(nodes) > 0 && !vuo.mutation.ClipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ClipsTable,
+			Columns: []string{vod.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vuo.mutation.ClipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ClipsTable,
+			Columns: []string{vod.ClipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vuo.mutation.AlternateCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   vod.AlternateTable,
+			Columns: []string{vod.AlternateColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vuo.mutation.AlternateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   vod.AlternateTable,
+			Columns: []string{vod.AlternateColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vod.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vuo.mutation.ArchiveProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vod.ArchiveProfileTable,
+			Columns: []string{vod.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vuo.mutation.ArchiveProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vod.ArchiveProfileTable,
+			Columns: []string{vod.ArchiveProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archiveprofile.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if vuo.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vuo.mutation.RemovedChatMessagesIDs(); len(nodes) > 0 && !vuo.mutation.ChatMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := vuo.mutation.ChatMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vod.ChatMessagesTable,
+			Columns: []string{vod.ChatMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(chatmessage.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Vod{config: vuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,89 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/dto"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/utils"
+	"go.temporal.io/sdk/temporal"
+)
+
+func SaveTwitchClipInfo(ctx context.Context, input dto.ArchiveVideoInput) error {
+	p, err := platform.Get(input.Channel.Platform)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	_, err = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Running).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	twitchClip, err := p.GetClip(input.VideoID)
+	if err != nil {
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
+		if dbErr != nil {
+			return dbErr
+		}
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	err = utils.WriteJson(twitchClip, fmt.Sprintf("%s/%s", input.Channel.Name, input.Vod.FolderName), fmt.Sprintf("%s-info.json", input.Vod.FileName))
+	if err != nil {
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
+		if dbErr != nil {
+			return dbErr
+		}
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	_, err = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Success).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DownloadTwitchClipThumbnails(ctx context.Context, input dto.ArchiveVideoInput) error {
+	p, err := platform.Get(input.Channel.Platform)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Running).Save(ctx)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	twitchClip, err := p.GetClip(input.VideoID)
+	if err != nil {
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Failed).Save(ctx)
+		if dbErr != nil {
+			return dbErr
+		}
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	// clips only have a single thumbnail size
+	for _, fileName := range []string{"thumbnail.jpg", "web_thumbnail.jpg"} {
+		err = utils.DownloadFile(twitchClip.ThumbnailURL, fmt.Sprintf("%s/%s", input.Channel.Name, input.Vod.FolderName), fmt.Sprintf("%s-%s", input.Vod.FileName, fileName))
+		if err != nil {
+			_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Failed).Save(ctx)
+			if dbErr != nil {
+				return dbErr
+			}
+			return temporal.NewApplicationError(err.Error(), "", nil)
+		}
+	}
+
+	_, dbErr = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Success).Save(ctx)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entQueue "github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/internal/database"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+)
+
+// dispatchInterval is how often waiting tasks are re-evaluated so priority changes and resumed items are picked up.
+const dispatchInterval = 10 * time.Second
+
+// dispatcher hands out the execution slots of a task queue to the waiting tasks with the highest queue item priority.
+// Temporal dispatches activities in the order they are scheduled, so the worker accepts more activities than it runs
+// and the dispatcher decides which of them start.
+// Each worker process has its own dispatcher, so priority only orders the tasks waiting in the same worker,
+// with several workers a task with a lower priority can start on another worker first.
+type dispatcher struct {
+	mu      sync.Mutex
+	slots   int
+	running int
+	waiting map[uuid.UUID]chan struct{}
+}
+
+var (
+	dispatchersMu sync.RWMutex
+	dispatchers   = map[string]*dispatcher{}
+)
+
+// RegisterDispatcher dispatches the activities of a task queue by queue item priority, running at most slots at once.
+func RegisterDispatcher(taskQueue string, slots int) {
+	dispatchersMu.Lock()
+	defer dispatchersMu.Unlock()
+	dispatchers[taskQueue] = &dispatcher{slots: slots, waiting: map[uuid.UUID]chan struct{}{}}
+}
+
+// acquireSlot waits until the queue item is dispatched on the task queue of the activity and returns a function releasing the slot.
+// Activities of task queues without a dispatcher start immediately. An error is returned if the queue item is deleted while waiting.
+func acquireSlot(ctx context.Context, queueID uuid.UUID) (func(), error) {
+	dispatchersMu.RLock()
+	d, ok := dispatchers[activity.GetInfo(ctx).TaskQueue]
+	dispatchersMu.RUnlock()
+	if !ok {
+		return func() {}, nil
+	}
+
+	ready := make(chan struct{})
+	d.mu.Lock()
+	d.waiting[queueID] = ready
+	d.mu.Unlock()
+	d.dispatch(ctx)
+
+	ticker := time.NewTicker(dispatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ready:
+			return d.release, nil
+		case <-ticker.C:
+			// deleted queue items are never dispatched
+			exists, err := database.DB().Client.Queue.Query().Where(entQueue.ID(queueID)).Exist(ctx)
+			if err != nil {
+				log.Error().Err(err).Msgf("error checking if queue item %s exists", queueID)
+			} else if !exists {
+				d.remove(queueID, ready)
+				return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("queue item %s was deleted", queueID), "", nil)
+			}
+			activity.RecordHeartbeat(ctx, fmt.Sprintf("waiting-%s", queueID))
+			d.dispatch(ctx)
+		case <-ctx.Done():
+			d.remove(queueID, ready)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// remove stops a task from waiting, releasing its slot if it was dispatched meanwhile.
+func (d *dispatcher) remove(queueID uuid.UUID, ready chan struct{}) {
+	d.mu.Lock()
+	delete(d.waiting, queueID)
+	d.mu.Unlock()
+	select {
+	case <-ready:
+		d.release()
+	default:
+	}
+}
+
+func (d *dispatcher) release() {
+	d.mu.Lock()
+	d.running--
+	d.mu.Unlock()
+	d.dispatch(context.Background())
+}
+
+// dispatch starts the waiting tasks with the highest priority while slots are free. Paused queue items are skipped.
+func (d *dispatcher) dispatch(ctx context.Context) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	free := d.slots - d.running
+	if free <= 0 || len(d.waiting) == 0 {
+		return
+	}
+	ids := make([]uuid.UUID, 0, len(d.waiting))
+	for id := range d.waiting {
+		ids = append(ids, id)
+	}
+
+	items, err := database.DB().Client.Queue.Query().
+		Where(entQueue.IDIn(ids...), entQueue.Paused(false)).
+		Order(ent.Desc(entQueue.FieldPriority), ent.Asc(entQueue.FieldCreatedAt)).
+		Limit(free).
+		IDs(context.WithoutCancel(ctx))
+	if err != nil {
+		log.Error().Err(err).Msg("error getting queue items to dispatch")
+		return
+	}
+	for _, id := range items {
+		close(d.waiting[id])
+		delete(d.waiting, id)
+		d.running++
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/dto"
 	"github.com/zibbp/ganymede/internal/utils"
@@ -33,3 +34,37 @@ func CreateDirectory(ctx context.Context, input dto.ArchiveVideoInput) error {
 
 	return nil
 }
+
+// CancelQueueItem records a cancelled archive. The pending and running tasks of the queue item are set to cancelled and the queue item and video are no longer processing.
+func CancelQueueItem(ctx context.Context, input dto.ArchiveVideoInput) error {
+	q, err := database.DB().Client.Queue.Get(ctx, input.Queue.ID)
+	if err != nil {
+		return err
+	}
+	update := q.Update().SetVideoProcessing(false).SetChatProcessing(false).SetProcessing(false)
+	cancelTask := func(status utils.TaskStatus, set func(utils.TaskStatus) *ent.QueueUpdateOne) {
+		if status == utils.Pending || status == utils.Running {
+			set(utils.Cancelled)
+		}
+	}
+	cancelTask(q.TaskVodCreateFolder, update.SetTaskVodCreateFolder)
+	cancelTask(q.TaskVodDownloadThumbnail, update.SetTaskVodDownloadThumbnail)
+	cancelTask(q.TaskVodSaveInfo, update.SetTaskVodSaveInfo)
+	cancelTask(q.TaskVideoDownload, update.SetTaskVideoDownload)
+	cancelTask(q.TaskVideoConvert, update.SetTaskVideoConvert)
+	cancelTask(q.TaskVideoMove, update.SetTaskVideoMove)
+	cancelTask(q.TaskChatDownload, update.SetTaskChatDownload)
+	cancelTask(q.TaskChatConvert, update.SetTaskChatConvert)
+	cancelTask(q.TaskChatRender, update.SetTaskChatRender)
+	cancelTask(q.TaskChatMove, update.SetTaskChatMove)
+	if _, err := update.Save(ctx); err != nil {
+		return err
+	}
+
+	_, err = database.DB().Client.Vod.UpdateOneID(input.Vod.ID).SetProcessing(false).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
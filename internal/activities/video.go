@@ -7,30 +7,41 @@ import (
 	"strings"
 	"time"
 
-	osExec "os/exec"
-
 	"github.com/rs/zerolog/log"
-	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/ent"
 	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entChapter "github.com/zibbp/ganymede/ent/chapter"
+	entMutedSegment "github.com/zibbp/ganymede/ent/mutedsegment"
 	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/chapter"
+	"github.com/zibbp/ganymede/internal/chat"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/dto"
 	"github.com/zibbp/ganymede/internal/exec"
-	"github.com/zibbp/ganymede/internal/twitch"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/profile"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/utils"
-	"github.com/zibbp/ganymede/internal/vod"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/temporal"
 )
 
 func sendHeartbeat(ctx context.Context, msg string, stop chan bool) {
+	sendProgressHeartbeat(ctx, msg, nil, stop)
+}
+
+// sendProgressHeartbeat sends heartbeats with the latest progress of the tracker as the second detail.
+func sendProgressHeartbeat(ctx context.Context, msg string, progress *exec.ProgressTracker, stop chan bool) {
 	ticker := time.NewTicker(20 * time.Second)
 	log.Debug().Msgf("starting heartbeat %s", msg)
 	for {
 		select {
 		case <-ticker.C:
-			activity.RecordHeartbeat(ctx, msg)
+			if p := progress.Progress(); p != nil {
+				activity.RecordHeartbeat(ctx, msg, p)
+			} else {
+				activity.RecordHeartbeat(ctx, msg)
+			}
 		case <-stop:
 			log.Debug().Msgf("stopping heartbeat %s", msg)
 			ticker.Stop()
@@ -39,26 +50,21 @@ func sendHeartbeat(ctx context.Context, msg string, stop chan bool) {
 	}
 }
 
-func convertTwitchChaptersToChapters(chapters []twitch.Node, duration int) ([]chapter.Chapter, error) {
-	if len(chapters) == 0 {
-		return nil, fmt.Errorf("no chapters found")
+// failedStatus returns the status of a task that returned an error. Tasks of cancelled activities are cancelled.
+func failedStatus(ctx context.Context) utils.TaskStatus {
+	if ctx.Err() != nil {
+		return utils.Cancelled
 	}
+	return utils.Failed
+}
 
-	convertedChapters := make([]chapter.Chapter, len(chapters))
-	for i := 0; i < len(chapters); i++ {
-		convertedChapters[i].ID = chapters[i].ID
-		convertedChapters[i].Title = chapters[i].Description
-		convertedChapters[i].Type = string(chapters[i].Type)
-		convertedChapters[i].Start = int(chapters[i].PositionMilliseconds / 1000)
-
-		if i+1 < len(chapters) {
-			convertedChapters[i].End = int(chapters[i+1].PositionMilliseconds / 1000)
-		} else {
-			convertedChapters[i].End = duration
-		}
+// activityError returns the error of a failed activity.
+// The context error is returned if the activity was cancelled so Temporal records it as cancelled instead of failed.
+func activityError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
 	}
-
-	return convertedChapters, nil
+	return temporal.NewApplicationError(err.Error(), "", nil)
 }
 
 func ArchiveVideoActivity(ctx context.Context, input dto.ArchiveVideoInput) error {
@@ -66,14 +72,17 @@ func ArchiveVideoActivity(ctx context.Context, input dto.ArchiveVideoInput) erro
 }
 
 func SaveTwitchVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) error {
+	p, err := platform.Get(input.Channel.Platform)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
 
-	_, err := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Running).Save(ctx)
+	_, err = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Running).Save(ctx)
 	if err != nil {
 		return err
 	}
 
-	twitchService := twitch.NewService()
-	twitchVideo, err := twitchService.GetVodByID(input.VideoID)
+	twitchVideo, err := p.GetVideo(input.VideoID)
 	if err != nil {
 		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
 		if dbErr != nil {
@@ -83,7 +92,7 @@ func SaveTwitchVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) error
 	}
 
 	// get chapters
-	twitchChapters, err := twitch.GQLGetChapters(input.VideoID)
+	chapters, err := p.GetChapters(input.VideoID, input.Vod.Duration)
 	if err != nil {
 		_, dbEr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
 		if dbEr != nil {
@@ -91,22 +100,7 @@ func SaveTwitchVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) error
 		}
 		return temporal.NewApplicationError(err.Error(), "", nil)
 	}
-
-	// convert twitch chapters to chapters
-	// get nodes from gql response
-	var nodes []twitch.Node
-	for _, v := range twitchChapters.Data.Video.Moments.Edges {
-		nodes = append(nodes, v.Node)
-	}
-	if len(nodes) > 0 {
-		chapters, err := convertTwitchChaptersToChapters(nodes, input.Vod.Duration)
-		if err != nil {
-			_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
-			if dbErr != nil {
-				return dbErr
-			}
-			return temporal.NewApplicationError(err.Error(), "", nil)
-		}
+	if len(chapters) > 0 {
 		// add chapters to database
 		chapterService := chapter.NewService()
 		for _, c := range chapters {
@@ -124,7 +118,7 @@ func SaveTwitchVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) error
 	}
 
 	// get muted segments
-	mutedSegments, err := twitch.GQLGetMutedSegments(input.VideoID)
+	mutedSegments, err := p.GetMutedSegments(input.VideoID)
 	if err != nil {
 		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
 		if dbErr != nil {
@@ -132,16 +126,15 @@ func SaveTwitchVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) error
 		}
 		return temporal.NewApplicationError(err.Error(), "", nil)
 	}
-	cleanMutedSegments := []vod.MutedSegment{}
+	cleanMutedSegments := []platform.MutedSegment{}
 
 	// insert muted segments into database
-	for _, mutedSegment := range mutedSegments.Data.Video.MuteInfo.MutedSegmentConnection.Nodes {
-		segmentEnd := mutedSegment.Offset + mutedSegment.Duration
-		if segmentEnd > input.Vod.Duration {
-			segmentEnd = input.Vod.Duration
+	for _, mutedSegment := range mutedSegments {
+		if mutedSegment.End > input.Vod.Duration {
+			mutedSegment.End = input.Vod.Duration
 		}
 		// insert muted segment into database
-		_, err := database.DB().Client.MutedSegment.Create().SetStart(mutedSegment.Offset).SetEnd(segmentEnd).SetVod(input.Vod).Save(ctx)
+		_, err := database.DB().Client.MutedSegment.Create().SetStart(mutedSegment.Start).SetEnd(mutedSegment.End).SetVod(input.Vod).Save(ctx)
 		if err != nil {
 			_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
 			if dbErr != nil {
@@ -149,10 +142,7 @@ func SaveTwitchVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) error
 			}
 			return temporal.NewApplicationError(err.Error(), "", nil)
 		}
-		cleanMutedSegments = append(cleanMutedSegments, vod.MutedSegment{
-			Start: mutedSegment.Offset,
-			End:   segmentEnd,
-		})
+		cleanMutedSegments = append(cleanMutedSegments, mutedSegment)
 	}
 	twitchVideo.MutedSegments = cleanMutedSegments
 
@@ -174,14 +164,17 @@ func SaveTwitchVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) error
 }
 
 func SaveTwitchLiveVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) error {
+	p, err := platform.Get(input.Channel.Platform)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
 
 	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Running).Save(ctx)
 	if dbErr != nil {
 		return dbErr
 	}
 
-	twitchService := twitch.NewService()
-	stream, err := twitchService.GetStreams(fmt.Sprintf("?user_login=%s", input.Channel.Name))
+	streams, err := p.GetLiveStreams([]string{input.Channel.Name})
 	if err != nil {
 		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
 		if dbErr != nil {
@@ -190,7 +183,7 @@ func SaveTwitchLiveVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) e
 		return temporal.NewApplicationError(err.Error(), "", nil)
 	}
 
-	if len(stream.Data) == 0 {
+	if len(streams) == 0 {
 		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
 		if dbErr != nil {
 			return dbErr
@@ -198,7 +191,7 @@ func SaveTwitchLiveVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) e
 		return fmt.Errorf("no stream found for channel %s", input.Channel.Name)
 	}
 
-	twitchVideo := stream.Data[0]
+	twitchVideo := streams[0]
 
 	err = utils.WriteJson(twitchVideo, fmt.Sprintf("%s/%s", input.Channel.Name, input.Vod.FolderName), fmt.Sprintf("%s-info.json", input.Vod.FileName))
 	if err != nil {
@@ -218,14 +211,17 @@ func SaveTwitchLiveVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) e
 }
 
 func DownloadTwitchThumbnails(ctx context.Context, input dto.ArchiveVideoInput) error {
+	p, err := platform.Get(input.Channel.Platform)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
 
 	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Running).Save(ctx)
 	if dbErr != nil {
 		return dbErr
 	}
 
-	twitchService := twitch.NewService()
-	twitchVideo, err := twitchService.GetVodByID(input.VideoID)
+	twitchVideo, err := p.GetVideo(input.VideoID)
 	if err != nil {
 		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Failed).Save(ctx)
 		if dbErr != nil {
@@ -264,9 +260,12 @@ func DownloadTwitchThumbnails(ctx context.Context, input dto.ArchiveVideoInput)
 }
 
 func DownloadTwitchLiveThumbnails(ctx context.Context, input dto.ArchiveVideoInput) error {
+	p, err := platform.Get(input.Channel.Platform)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
 
-	twitchService := twitch.NewService()
-	stream, err := twitchService.GetStreams(fmt.Sprintf("?user_login=%s", input.Channel.Name))
+	streams, err := p.GetLiveStreams([]string{input.Channel.Name})
 	if err != nil {
 		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Failed).Save(ctx)
 		if dbErr != nil {
@@ -280,7 +279,7 @@ func DownloadTwitchLiveThumbnails(ctx context.Context, input dto.ArchiveVideoInp
 		return dbErr
 	}
 
-	if len(stream.Data) == 0 {
+	if len(streams) == 0 {
 		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Failed).Save(ctx)
 		if dbErr != nil {
 			return dbErr
@@ -289,7 +288,7 @@ func DownloadTwitchLiveThumbnails(ctx context.Context, input dto.ArchiveVideoInp
 		return temporal.NewApplicationError(fmt.Sprintf("no stream found for channel %s", input.Channel.Name), "", nil)
 	}
 
-	twitchVideo := stream.Data[0]
+	twitchVideo := streams[0]
 
 	fullResThumbnailUrl := replaceLivePlaceholders(twitchVideo.ThumbnailURL, "1920", "1080")
 	webResThumbnailUrl := replaceLivePlaceholders(twitchVideo.ThumbnailURL, "640", "360")
@@ -333,24 +332,32 @@ func replaceLivePlaceholders(url, width, height string) string {
 
 func DownloadTwitchVideo(ctx context.Context, input dto.ArchiveVideoInput) error {
 
+	// wait for the queue item to be dispatched by priority
+	releaseSlot, slotErr := acquireSlot(ctx, input.Queue.ID)
+	if slotErr != nil {
+		return slotErr
+	}
+	defer releaseSlot()
+
 	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoDownload(utils.Running).Save(ctx)
 	if dbErr != nil {
 		return dbErr
 	}
 
+	progress := exec.NewProgressTracker()
 	stopHeartbeat := make(chan bool)
-	go sendHeartbeat(ctx, fmt.Sprintf("download-video-%s", input.VideoID), stopHeartbeat)
+	go sendProgressHeartbeat(ctx, fmt.Sprintf("download-video-%s", input.VideoID), progress, stopHeartbeat)
 
 	// Start the download
-	err := exec.DownloadTwitchVodVideo(input.Vod)
+	err := exec.DownloadTwitchVodVideo(ctx, input.Vod, progress)
 	if err != nil {
-		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoDownload(utils.Failed).Save(ctx)
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoDownload(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 		if dbErr != nil {
 			stopHeartbeat <- true
 			return dbErr
 		}
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 
 	_, dbErr = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoDownload(utils.Success).Save(ctx)
@@ -364,17 +371,24 @@ func DownloadTwitchVideo(ctx context.Context, input dto.ArchiveVideoInput) error
 }
 
 func DownloadTwitchLiveVideo(ctx context.Context, input dto.ArchiveVideoInput, ch chan bool) error {
+	p, err := platform.Get(input.Channel.Platform)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
 
 	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoDownload(utils.Running).Save(ctx)
 	if dbErr != nil {
 		return dbErr
 	}
 
+	progress := exec.NewProgressTracker()
 	stopHeartbeat := make(chan bool)
-	go sendHeartbeat(ctx, fmt.Sprintf("download-livevideo-%s", input.VideoID), stopHeartbeat)
+	go sendProgressHeartbeat(ctx, fmt.Sprintf("download-livevideo-%s", input.VideoID), progress, stopHeartbeat)
 
 	// Start the download
-	err := exec.DownloadTwitchLiveVideo(ctx, input.Vod, input.Channel, input.LiveChatWorkflowId)
+	// the download is stopped by cancelling the activity, the recorded video is kept and the archive continues
+	err = exec.DownloadTwitchLiveVideo(ctx, input.Vod, input.Channel, input.LiveChatWorkflowId, progress)
+	ctx = context.WithoutCancel(ctx)
 	if err != nil {
 		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoDownload(utils.Failed).Save(ctx)
 		if dbErr != nil {
@@ -391,7 +405,7 @@ func DownloadTwitchLiveVideo(ctx context.Context, input dto.ArchiveVideoInput, c
 	}
 
 	// Update video duration with duration from downloaded video
-	duration, err := exec.GetVideoDuration(input.Vod.TmpVideoDownloadPath)
+	duration, err := exec.GetLiveVideoDuration(input.Vod)
 	if err != nil {
 		stopHeartbeat <- true
 		return temporal.NewApplicationError(err.Error(), "", nil)
@@ -403,7 +417,7 @@ func DownloadTwitchLiveVideo(ctx context.Context, input dto.ArchiveVideoInput, c
 	}
 
 	// attempt to find vod id of the livesstream so the external id is correct
-	videos, err := twitch.GetVideosByUser(input.Channel.ExtID, "archive")
+	videos, err := p.GetVideos(input.Channel.ExtID, "archive")
 	if err != nil {
 		stopHeartbeat <- true
 		log.Err(err).Msg("error getting videos from twitch api")
@@ -434,43 +448,83 @@ func DownloadTwitchLiveVideo(ctx context.Context, input dto.ArchiveVideoInput, c
 
 func PostprocessVideo(ctx context.Context, input dto.ArchiveVideoInput) error {
 
+	// wait for the queue item to be dispatched by priority
+	releaseSlot, slotErr := acquireSlot(ctx, input.Queue.ID)
+	if slotErr != nil {
+		return slotErr
+	}
+	defer releaseSlot()
+
 	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoConvert(utils.Running).Save(ctx)
 	if dbErr != nil {
 		return dbErr
 	}
 
+	progress := exec.NewProgressTracker()
 	stopHeartbeat := make(chan bool)
-	go sendHeartbeat(ctx, fmt.Sprintf("postprocess-video-%s", input.VideoID), stopHeartbeat)
+	go sendProgressHeartbeat(ctx, fmt.Sprintf("postprocess-video-%s", input.VideoID), progress, stopHeartbeat)
+
+	// Concatenate live stream segments if streamlink reconnected
+	gaps, err := exec.ConcatLiveVideoSegments(ctx, input.Vod)
+	if err != nil {
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoConvert(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
+		if dbErr != nil {
+			stopHeartbeat <- true
+			return dbErr
+		}
+		stopHeartbeat <- true
+		return activityError(ctx, err)
+	}
+	// record the time lost while reconnecting as muted segments
+	for _, gap := range gaps {
+		_, dbErr := database.DB().Client.MutedSegment.Create().SetStart(gap.Start).SetEnd(gap.End).SetVod(input.Vod).Save(ctx)
+		if dbErr != nil {
+			stopHeartbeat <- true
+			return dbErr
+		}
+	}
+	if len(gaps) > 0 {
+		_, dbErr := database.DB().Client.Vod.UpdateOneID(input.Vod.ID).SetReconnects(len(gaps)).Save(ctx)
+		if dbErr != nil {
+			stopHeartbeat <- true
+			return dbErr
+		}
+	}
 
 	// Start post process
-	err := exec.ConvertTwitchVodVideo(input.Vod)
+	err = exec.ConvertTwitchVodVideo(ctx, input.Vod, progress)
 	if err != nil {
-		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoConvert(utils.Failed).Save(ctx)
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoConvert(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 		if dbErr != nil {
 			stopHeartbeat <- true
 			return dbErr
 		}
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 
 	// Convert to HLS if needed
-	if viper.GetBool("archive.save_as_hls") {
-		err = exec.ConvertToHLS(input.Vod)
+	settings, err := profile.GetVodSettings(ctx, input.Vod.ID)
+	if err != nil {
+		stopHeartbeat <- true
+		return activityError(ctx, err)
+	}
+	if settings.SaveAsHls {
+		err = exec.ConvertToHLS(ctx, input.Vod, progress)
 		if err != nil {
-			_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoConvert(utils.Failed).Save(ctx)
+			_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoConvert(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 			if dbErr != nil {
 				stopHeartbeat <- true
 				return dbErr
 			}
 			stopHeartbeat <- true
-			return temporal.NewApplicationError(err.Error(), "", nil)
+			return activityError(ctx, err)
 		}
 		// delete -convert video as it is not being moved
 		err := utils.DeleteFile(input.Vod.TmpVideoConvertPath)
 		if err != nil {
 			stopHeartbeat <- true
-			return temporal.NewApplicationError(err.Error(), "", nil)
+			return activityError(ctx, err)
 		}
 	}
 
@@ -494,8 +548,18 @@ func MoveVideo(ctx context.Context, input dto.ArchiveVideoInput) error {
 	stopHeartbeat := make(chan bool)
 	go sendHeartbeat(ctx, fmt.Sprintf("move-video-%s", input.VideoID), stopHeartbeat)
 
-	if viper.GetBool("archive.save_as_hls") {
-		err := utils.MoveFolder(input.Vod.TmpVideoHlsPath, input.Vod.VideoHlsPath)
+	settings, err := profile.GetVodSettings(ctx, input.Vod.ID)
+	if err != nil {
+		stopHeartbeat <- true
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+	backend, err := storage.ForVod(input.Vod)
+	if err != nil {
+		stopHeartbeat <- true
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+	if settings.SaveAsHls {
+		err := backend.MoveFolder(ctx, input.Vod.TmpVideoHlsPath, input.Vod.VideoHlsPath)
 		if err != nil {
 			_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoMove(utils.Failed).Save(ctx)
 			if dbErr != nil {
@@ -506,7 +570,7 @@ func MoveVideo(ctx context.Context, input dto.ArchiveVideoInput) error {
 			return temporal.NewApplicationError(err.Error(), "", nil)
 		}
 	} else {
-		err := utils.MoveFile(input.Vod.TmpVideoConvertPath, input.Vod.VideoPath)
+		err := backend.MoveFile(ctx, input.Vod.TmpVideoConvertPath, input.Vod.VideoPath)
 		if err != nil {
 			_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoMove(utils.Failed).Save(ctx)
 			if dbErr != nil {
@@ -520,7 +584,7 @@ func MoveVideo(ctx context.Context, input dto.ArchiveVideoInput) error {
 
 	// Clean up files
 	// Delete source file
-	err := utils.DeleteFile(input.Vod.TmpVideoDownloadPath)
+	err = utils.DeleteFile(input.Vod.TmpVideoDownloadPath)
 	if err != nil {
 		log.Info().Err(err).Msgf("error deleting source file for vod %s", input.Vod.ID)
 	}
@@ -542,31 +606,32 @@ func DownloadTwitchChat(ctx context.Context, input dto.ArchiveVideoInput) error
 		return dbErr
 	}
 
+	progress := exec.NewProgressTracker()
 	stopHeartbeat := make(chan bool)
-	go sendHeartbeat(ctx, fmt.Sprintf("download-chat-%s", input.VideoID), stopHeartbeat)
+	go sendProgressHeartbeat(ctx, fmt.Sprintf("download-chat-%s", input.VideoID), progress, stopHeartbeat)
 
 	// Start the download
-	err := exec.DownloadTwitchVodChat(input.Vod)
+	err := exec.DownloadTwitchVodChat(ctx, input.Vod, progress)
 	if err != nil {
-		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatDownload(utils.Failed).Save(ctx)
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatDownload(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 		if dbErr != nil {
 			stopHeartbeat <- true
 			return dbErr
 		}
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 
 	// copy json to vod folder
 	err = utils.CopyFile(input.Vod.TmpChatDownloadPath, input.Vod.ChatPath)
 	if err != nil {
-		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatDownload(utils.Failed).Save(ctx)
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatDownload(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 		if dbErr != nil {
 			stopHeartbeat <- true
 			return dbErr
 		}
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 
 	_, dbErr = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatDownload(utils.Success).Save(ctx)
@@ -590,7 +655,9 @@ func DownloadTwitchLiveChat(ctx context.Context, input dto.ArchiveVideoInput) er
 	go sendHeartbeat(ctx, fmt.Sprintf("download-livechat-%s", input.VideoID), stopHeartbeat)
 
 	// Start the download
+	// the download runs until the activity is cancelled when the video download finishes, the chat is then saved
 	err := exec.DownloadTwitchLiveChat(ctx, input.Vod, input.Channel, input.Queue)
+	ctx = context.WithoutCancel(ctx)
 	if err != nil {
 		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatDownload(utils.Failed).Save(ctx)
 		if dbErr != nil {
@@ -625,24 +692,32 @@ func DownloadTwitchLiveChat(ctx context.Context, input dto.ArchiveVideoInput) er
 
 func RenderTwitchChat(ctx context.Context, input dto.ArchiveVideoInput) error {
 
+	// wait for the queue item to be dispatched by priority
+	releaseSlot, slotErr := acquireSlot(ctx, input.Queue.ID)
+	if slotErr != nil {
+		return slotErr
+	}
+	defer releaseSlot()
+
 	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatRender(utils.Running).Save(ctx)
 	if dbErr != nil {
 		return dbErr
 	}
 
+	progress := exec.NewProgressTracker()
 	stopHeartbeat := make(chan bool)
-	go sendHeartbeat(ctx, fmt.Sprintf("render-chat-%s", input.VideoID), stopHeartbeat)
+	go sendProgressHeartbeat(ctx, fmt.Sprintf("render-chat-%s", input.VideoID), progress, stopHeartbeat)
 
 	// Start the download
-	err, _ := exec.RenderTwitchVodChat(input.Vod)
+	err, _ := exec.RenderTwitchVodChat(ctx, input.Vod, progress)
 	if err != nil {
-		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatRender(utils.Failed).Save(ctx)
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatRender(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 		if dbErr != nil {
 			stopHeartbeat <- true
 			return dbErr
 		}
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 
 	_, dbErr = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatRender(utils.Success).Save(ctx)
@@ -677,8 +752,18 @@ func MoveChat(ctx context.Context, input dto.ArchiveVideoInput) error {
 		return temporal.NewApplicationError(err.Error(), "", nil)
 	}
 
+	// import the chat so the API does not read the chat file, it is imported on the first request if this fails
+	err = chat.ImportChat(ctx, database.DB().Client, input.Vod)
+	if err != nil {
+		log.Warn().Err(err).Str("vod_id", input.Vod.ID.String()).Msg("error importing chat")
+	}
+
+	// the chat json is read by the API so only the rendered chat is moved to the storage backend
 	if input.Queue.RenderChat {
-		err = utils.MoveFile(input.Vod.TmpChatRenderPath, input.Vod.ChatVideoPath)
+		backend, err := storage.ForVod(input.Vod)
+		if err == nil {
+			err = backend.MoveFile(ctx, input.Vod.TmpChatRenderPath, input.Vod.ChatVideoPath)
+		}
 		if err != nil {
 			_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatMove(utils.Failed).Save(ctx)
 			if dbErr != nil {
@@ -700,46 +785,11 @@ func MoveChat(ctx context.Context, input dto.ArchiveVideoInput) error {
 	return nil
 }
 
-func KillTwitchLiveChatDownload(ctx context.Context, input dto.ArchiveVideoInput) error {
-
-	log.Info().Str("channel", input.Channel.Name).Str("stream_id", input.Vod.ExtID).Msg("Killing chat download")
-
-	// find pid of chat_downloader to kill
-	// search for channel and unique temporary download path to ensure we do not kill a new instance
-	cmd := osExec.Command("pgrep", "-f", input.Vod.TmpLiveChatDownloadPath)
-	out, err := cmd.Output()
+func ConvertTwitchLiveChat(ctx context.Context, input dto.ArchiveVideoInput) error {
+	p, err := platform.Get(input.Channel.Platform)
 	if err != nil {
 		return temporal.NewApplicationError(err.Error(), "", nil)
 	}
-	// parse output into array of process ids
-	pids := strings.Split(strings.TrimSpace(string(out)), "\n")
-	if len(pids) > 0 {
-		log.Debug().Str("channel", input.Channel.Name).Str("stream_id", input.Vod.ExtID).Msgf("Found chat download processes to kill: %s", pids)
-
-		// kill pid
-		for _, pid := range pids {
-			cmd = osExec.Command("kill", "-15", pid)
-			_, err = cmd.Output()
-			if err != nil {
-				return temporal.NewApplicationError(err.Error(), "", nil)
-			}
-		}
-
-		log.Info().Str("channel", input.Channel.Name).Str("stream_id", input.Vod.ExtID).Msgf("Killed chat downloader for channel %s", input.Channel.Name)
-	} else {
-		// not a big enough issue to raise an error if chat downloader is not running
-		log.Warn().Str("channel", input.Channel.Name).Str("stream_id", input.Vod.ExtID).Msg("No chat download processes found")
-	}
-
-	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatDownload(utils.Success).Save(ctx)
-	if dbErr != nil {
-		return dbErr
-	}
-
-	return nil
-}
-
-func ConvertTwitchLiveChat(ctx context.Context, input dto.ArchiveVideoInput) error {
 
 	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(utils.Running).Save(ctx)
 	if dbErr != nil {
@@ -769,27 +819,27 @@ func ConvertTwitchLiveChat(ctx context.Context, input dto.ArchiveVideoInput) err
 	}
 
 	// Fetch streamer from Twitch API for their user ID
-	streamer, err := twitch.API.GetUserByLogin(input.Channel.Name)
+	streamer, err := p.GetUser(input.Channel.Name)
 	if err != nil {
 		log.Error().Err(err).Msg("error getting streamer from Twitch API")
-		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(utils.Failed).Save(ctx)
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 		if dbErr != nil {
 			stopHeartbeat <- true
 			return dbErr
 		}
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 	cID, err := strconv.Atoi(streamer.ID)
 	if err != nil {
 		log.Error().Err(err).Msg("error converting streamer ID to int")
-		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(utils.Failed).Save(ctx)
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 		if dbErr != nil {
 			stopHeartbeat <- true
 			return dbErr
 		}
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 
 	// update queue item
@@ -801,10 +851,10 @@ func ConvertTwitchLiveChat(ctx context.Context, input dto.ArchiveVideoInput) err
 	input.Queue = updatedQueue
 	log.Info().Msgf("streamer ID: %s", streamer.ID)
 	// TwitchDownloader requires the ID of the video, or at least a previous video ID
-	videos, err := twitch.GetVideosByUser(streamer.ID, "archive")
+	videos, err := p.GetVideos(streamer.ID, "archive")
 	if err != nil {
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 
 	// attempt to find vod of current livestream
@@ -823,40 +873,40 @@ func ConvertTwitchLiveChat(ctx context.Context, input dto.ArchiveVideoInput) err
 	err = utils.ConvertTwitchLiveChatToTDLChat(input.Vod.TmpLiveChatDownloadPath, input.Channel.Name, input.Vod.ID.String(), input.Vod.ExtID, cID, input.Queue.ChatStart, string(previousVideoID))
 	if err != nil {
 		log.Error().Err(err).Msg("error converting chat")
-		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(utils.Failed).Save(ctx)
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 		if dbErr != nil {
 			stopHeartbeat <- true
 			return dbErr
 		}
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 
 	// TwitchDownloader "chatupdate"
 	// Embeds emotes and badges into the chat file
-	err = exec.TwitchChatUpdate(input.Vod)
+	err = exec.TwitchChatUpdate(ctx, input.Vod)
 	if err != nil {
 		log.Error().Err(err).Msg("error updating chat")
-		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(utils.Failed).Save(ctx)
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 		if dbErr != nil {
 			stopHeartbeat <- true
 			return dbErr
 		}
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 
 	// copy converted chat
 	err = utils.CopyFile(input.Vod.TmpLiveChatConvertPath, input.Vod.LiveChatConvertPath)
 	if err != nil {
 		log.Error().Err(err).Msg("error copying chat convert")
-		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(utils.Failed).Save(ctx)
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
 		if dbErr != nil {
 			stopHeartbeat <- true
 			return dbErr
 		}
 		stopHeartbeat <- true
-		return temporal.NewApplicationError(err.Error(), "", nil)
+		return activityError(ctx, err)
 	}
 
 	_, dbErr = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskChatConvert(utils.Success).Save(ctx)
@@ -887,25 +937,19 @@ func TwitchSaveVideoChapters(ctx context.Context) error {
 		if video.ExtID == "" {
 			continue
 		}
+		p, err := platform.Get(video.Platform)
+		if err != nil {
+			continue
+		}
 		log.Debug().Msgf("getting chapters for video %s", video.ID)
 		// get chapters
-		twitchChapters, err := twitch.GQLGetChapters(video.ExtID)
+		chapters, err := p.GetChapters(video.ExtID, video.Duration)
 		if err != nil {
 			log.Error().Err(err).Msgf("error getting chapters for video %s", video.ID)
 			continue
 		}
 
-		// convert twitch chapters to chapters
-		// get nodes from gql response
-		var nodes []twitch.Node
-		for _, v := range twitchChapters.Data.Video.Moments.Edges {
-			nodes = append(nodes, v.Node)
-		}
-		if len(nodes) > 0 {
-			chapters, err := convertTwitchChaptersToChapters(nodes, video.Duration)
-			if err != nil {
-				return temporal.NewApplicationError(err.Error(), "", nil)
-			}
+		if len(chapters) > 0 {
 			// add chapters to database
 			chapterService := chapter.NewService()
 			// check if chapters already exist
@@ -946,6 +990,10 @@ func UpdateTwitchLiveStreamArchivesWithVodIds(ctx context.Context) error {
 	}
 
 	for _, channel := range channels {
+		p, err := platform.Get(channel.Platform)
+		if err != nil {
+			continue
+		}
 		log.Info().Msgf("processing channel %s", channel.Name)
 		// get all videos for channel
 		videos, err := database.DB().Client.Vod.Query().Where(entVod.HasChannelWith(entChannel.ID(channel.ID))).All(ctx)
@@ -955,7 +1003,7 @@ func UpdateTwitchLiveStreamArchivesWithVodIds(ctx context.Context) error {
 		}
 
 		// get all videos from twitch for channel
-		twitchChannelVideoss, err := twitch.GetVideosByUser(channel.ExtID, "archive")
+		twitchChannelVideoss, err := p.GetVideos(channel.ExtID, "archive")
 		if err != nil {
 			stopHeartbeat <- true
 			return temporal.NewApplicationError(err.Error(), "", nil)
@@ -986,3 +1034,63 @@ func UpdateTwitchLiveStreamArchivesWithVodIds(ctx context.Context) error {
 	stopHeartbeat <- true
 	return nil
 }
+
+// DeleteReplacedLiveArchive links the live archive replaced by the official VOD as its alternate
+// and deletes the live archive if it is replaced.
+func DeleteReplacedLiveArchive(ctx context.Context, input dto.ArchiveVideoInput) error {
+	liveVod, err := database.DB().Client.Vod.Query().Where(entVod.ID(input.ReplaceVod.ID)).WithChannel().WithQueue().Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			log.Info().Msgf("live archive %s was already deleted", input.ReplaceVod.ID)
+			return nil
+		}
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	// the live archive is only linked once the vod is archived so a failed archive does not keep it from being replaced
+	_, err = database.DB().Client.Vod.UpdateOneID(input.Vod.ID).SetAlternate(liveVod).Save(ctx)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+	if !input.DeleteReplacedVod {
+		return nil
+	}
+
+	if liveVod.Locked {
+		log.Info().Msgf("live archive %s is locked, keeping it as an alternate of %s", liveVod.ID, input.Vod.ID)
+		return nil
+	}
+
+	log.Info().Msgf("deleting live archive %s replaced by %s", liveVod.ID, input.Vod.ID)
+
+	if liveVod.Edges.Queue != nil {
+		err = database.DB().Client.Queue.DeleteOneID(liveVod.Edges.Queue.ID).Exec(ctx)
+		if err != nil {
+			return temporal.NewApplicationError(err.Error(), "", nil)
+		}
+	}
+	_, err = database.DB().Client.Chapter.Delete().Where(entChapter.HasVodWith(entVod.ID(liveVod.ID))).Exec(ctx)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+	_, err = database.DB().Client.MutedSegment.Delete().Where(entMutedSegment.HasVodWith(entVod.ID(liveVod.ID))).Exec(ctx)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	// only delete the folder if the vod was not archived into the same one
+	livePath := fmt.Sprintf("/vods/%s/%s", liveVod.Edges.Channel.Name, liveVod.FolderName)
+	if liveVod.FolderName != "" && liveVod.FolderName != input.Vod.FolderName {
+		err = storage.DeleteVodFolder(ctx, liveVod, livePath)
+		if err != nil {
+			return temporal.NewApplicationError(err.Error(), "", nil)
+		}
+	}
+
+	err = database.DB().Client.Vod.DeleteOneID(liveVod.ID).Exec(ctx)
+	if err != nil {
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	return nil
+}
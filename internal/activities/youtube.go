@@ -0,0 +1,154 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zibbp/ganymede/internal/chapter"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/dto"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/youtube"
+	"go.temporal.io/sdk/temporal"
+)
+
+func convertYoutubeChaptersToChapters(chapters []youtube.Chapter) []chapter.Chapter {
+	convertedChapters := make([]chapter.Chapter, len(chapters))
+	for i, c := range chapters {
+		convertedChapters[i].ID = fmt.Sprintf("%d", i)
+		convertedChapters[i].Title = c.Title
+		convertedChapters[i].Type = "GAME_CHANGE"
+		convertedChapters[i].Start = int(c.StartTime)
+		convertedChapters[i].End = int(c.EndTime)
+	}
+	return convertedChapters
+}
+
+func SaveYoutubeVideoInfo(ctx context.Context, input dto.ArchiveVideoInput) error {
+
+	_, err := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Running).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	youtubeService := youtube.NewService()
+	youtubeVideo, err := youtubeService.GetVideoByID(input.VideoID)
+	if err != nil {
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
+		if dbErr != nil {
+			return dbErr
+		}
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	// add chapters to database
+	if len(youtubeVideo.Chapters) > 0 {
+		chapterService := chapter.NewService()
+		for _, c := range convertYoutubeChaptersToChapters(youtubeVideo.Chapters) {
+			_, err := chapterService.CreateChapter(c, input.Vod.ID)
+			if err != nil {
+				_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
+				if dbErr != nil {
+					return dbErr
+				}
+				return temporal.NewApplicationError(err.Error(), "", nil)
+			}
+		}
+	}
+
+	err = utils.WriteJson(youtubeVideo, fmt.Sprintf("%s/%s", input.Channel.Name, input.Vod.FolderName), fmt.Sprintf("%s-info.json", input.Vod.FileName))
+	if err != nil {
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Failed).Save(ctx)
+		if dbErr != nil {
+			return dbErr
+		}
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	_, err = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodSaveInfo(utils.Success).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DownloadYoutubeThumbnails(ctx context.Context, input dto.ArchiveVideoInput) error {
+
+	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Running).Save(ctx)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	path := fmt.Sprintf("%s/%s", input.Channel.Name, input.Vod.FolderName)
+
+	// maxresdefault is not generated for every video, fall back to hqdefault
+	err := utils.DownloadFile(fmt.Sprintf("https://i.ytimg.com/vi/%s/maxresdefault.jpg", input.VideoID), path, fmt.Sprintf("%s-thumbnail.jpg", input.Vod.FileName))
+	if err != nil {
+		err = utils.DownloadFile(fmt.Sprintf("https://i.ytimg.com/vi/%s/hqdefault.jpg", input.VideoID), path, fmt.Sprintf("%s-thumbnail.jpg", input.Vod.FileName))
+		if err != nil {
+			_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Failed).Save(ctx)
+			if dbErr != nil {
+				return dbErr
+			}
+			return temporal.NewApplicationError(err.Error(), "", nil)
+		}
+	}
+
+	err = utils.DownloadFile(fmt.Sprintf("https://i.ytimg.com/vi/%s/mqdefault.jpg", input.VideoID), path, fmt.Sprintf("%s-web_thumbnail.jpg", input.Vod.FileName))
+	if err != nil {
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Failed).Save(ctx)
+		if dbErr != nil {
+			return dbErr
+		}
+		return temporal.NewApplicationError(err.Error(), "", nil)
+	}
+
+	_, dbErr = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVodDownloadThumbnail(utils.Success).Save(ctx)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	return nil
+}
+
+func DownloadYoutubeVideo(ctx context.Context, input dto.ArchiveVideoInput) error {
+
+	// wait for the queue item to be dispatched by priority
+	releaseSlot, slotErr := acquireSlot(ctx, input.Queue.ID)
+	if slotErr != nil {
+		return slotErr
+	}
+	defer releaseSlot()
+
+	_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoDownload(utils.Running).Save(ctx)
+	if dbErr != nil {
+		return dbErr
+	}
+
+	progress := exec.NewProgressTracker()
+	stopHeartbeat := make(chan bool)
+	go sendProgressHeartbeat(ctx, fmt.Sprintf("download-video-%s", input.VideoID), progress, stopHeartbeat)
+
+	// Start the download
+	err := exec.DownloadYoutubeVideo(ctx, input.Vod, progress)
+	if err != nil {
+		_, dbErr := database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoDownload(failedStatus(ctx)).Save(context.WithoutCancel(ctx))
+		if dbErr != nil {
+			stopHeartbeat <- true
+			return dbErr
+		}
+		stopHeartbeat <- true
+		return activityError(ctx, err)
+	}
+
+	_, dbErr = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetTaskVideoDownload(utils.Success).Save(ctx)
+	if dbErr != nil {
+		stopHeartbeat <- true
+		return dbErr
+	}
+
+	stopHeartbeat <- true
+	return nil
+}
@@ -3,22 +3,25 @@ package archive
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
-	"github.com/spf13/viper"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/predicate"
+	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/channel"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/dto"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/profile"
 	"github.com/zibbp/ganymede/internal/queue"
 	"github.com/zibbp/ganymede/internal/temporal"
 	"github.com/zibbp/ganymede/internal/twitch"
 	"github.com/zibbp/ganymede/internal/utils"
 	"github.com/zibbp/ganymede/internal/vod"
 	"github.com/zibbp/ganymede/internal/workflows"
+	"github.com/zibbp/ganymede/internal/youtube"
 	"go.temporal.io/sdk/client"
 )
 
@@ -28,6 +31,7 @@ type Service struct {
 	ChannelService *channel.Service
 	VodService     *vod.Service
 	QueueService   *queue.Service
+	YoutubeService *youtube.Service
 }
 
 type TwitchVodResponse struct {
@@ -36,25 +40,37 @@ type TwitchVodResponse struct {
 }
 
 func NewService(store *database.Database, twitchService *twitch.Service, channelService *channel.Service, vodService *vod.Service, queueService *queue.Service) *Service {
-	return &Service{Store: store, TwitchService: twitchService, ChannelService: channelService, VodService: vodService, QueueService: queueService}
+	return &Service{Store: store, TwitchService: twitchService, ChannelService: channelService, VodService: vodService, QueueService: queueService, YoutubeService: youtube.NewService()}
 }
 
 // ArchiveTwitchChannel - Create Twitch channel folder, profile image, and database entry.
 func (s *Service) ArchiveTwitchChannel(cName string) (*ent.Channel, error) {
 	// Fetch channel from Twitch API
-	tChannel, err := twitch.API.GetUserByLogin(cName)
+	twitchPlatform, err := platform.Get(utils.PlatformTwitch)
+	if err != nil {
+		return nil, err
+	}
+	tChannel, err := twitchPlatform.GetUser(cName)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching twitch channel: %v", err)
 	}
 
+	return s.createTwitchChannel(tChannel)
+}
+
+// createTwitchChannel creates the folder, profile image, and database entry of a Twitch channel.
+func (s *Service) createTwitchChannel(tChannel platform.User) (*ent.Channel, error) {
 	// Check if channel exists in DB
-	cCheck := s.ChannelService.CheckChannelExists(tChannel.Login)
+	cCheck, cTrashed := s.ChannelService.CheckChannelExists(tChannel.Login)
+	if cTrashed {
+		return nil, fmt.Errorf("channel is in the trash, restore it to archive it again")
+	}
 	if cCheck {
 		return nil, fmt.Errorf("channel already exists")
 	}
 
 	// Create channel folder
-	err = utils.CreateFolder(tChannel.Login)
+	err := utils.CreateFolder(tChannel.Login)
 	if err != nil {
 		return nil, fmt.Errorf("error creating channel folder: %v", err)
 	}
@@ -82,28 +98,88 @@ func (s *Service) ArchiveTwitchChannel(cName string) (*ent.Channel, error) {
 
 }
 
-func (s *Service) ArchiveTwitchVod(vID string, quality string, chat bool, renderChat bool) (*TwitchVodResponse, error) {
+// TwitchVodChannelID returns the external id of the channel of a Twitch VOD, to check the access to the channel before archiving the VOD.
+func (s *Service) TwitchVodChannelID(vID string) (string, error) {
+	twitchPlatform, err := platform.Get(utils.PlatformTwitch)
+	if err != nil {
+		return "", err
+	}
+	tVod, err := twitchPlatform.GetVideo(vID)
+	if err != nil {
+		return "", fmt.Errorf("error fetching twitch vod: %v", err)
+	}
+	return tVod.UserID, nil
+}
+
+// TwitchClipChannelID returns the external id of the channel of a Twitch clip, to check the access to the channel before archiving the clip.
+func (s *Service) TwitchClipChannelID(clipID string) (string, error) {
+	twitchPlatform, err := platform.Get(utils.PlatformTwitch)
+	if err != nil {
+		return "", err
+	}
+	tClip, err := twitchPlatform.GetClip(clipID)
+	if err != nil {
+		return "", fmt.Errorf("error fetching twitch clip: %v", err)
+	}
+	return tClip.UserID, nil
+}
+
+// YoutubeVideoChannelID returns the external id of the channel of a YouTube video, to check the access to the channel before archiving the video.
+func (s *Service) YoutubeVideoChannelID(vID string) (string, error) {
+	yVideo, err := s.YoutubeService.GetVideoByID(vID)
+	if err != nil {
+		return "", fmt.Errorf("error fetching youtube video: %v", err)
+	}
+	return yVideo.ChannelID, nil
+}
+
+// ArchiveTwitchVod archives a Twitch VOD. If profileID is nil the archive profile of the channel is used, if it has one.
+func (s *Service) ArchiveTwitchVod(vID string, quality string, chat bool, renderChat bool, profileID *uuid.UUID) (*TwitchVodResponse, error) {
+	return s.archiveTwitchVod(vID, quality, chat, renderChat, profileID, nil, false)
+}
+
+// ReplaceTwitchLiveArchive archives the official VOD of a live archive and links both as alternates once it is archived.
+// If replace is true the live archive is deleted once the VOD is archived.
+func (s *Service) ReplaceTwitchLiveArchive(liveVod *ent.Vod, vID string, quality string, chat bool, renderChat bool, profileID *uuid.UUID, replace bool) (*TwitchVodResponse, error) {
+	return s.archiveTwitchVod(vID, quality, chat, renderChat, profileID, liveVod, replace)
+}
+
+func (s *Service) archiveTwitchVod(vID string, quality string, chat bool, renderChat bool, profileID *uuid.UUID, liveVod *ent.Vod, replace bool) (*TwitchVodResponse, error) {
 	log.Debug().Msgf("Archiving video %s quality: %s chat: %t render chat: %t", vID, quality, chat, renderChat)
 	// Fetch VOD from Twitch API
-	tVod, err := s.TwitchService.GetVodByID(vID)
+	twitchPlatform, err := platform.Get(utils.PlatformTwitch)
+	if err != nil {
+		return nil, err
+	}
+	tVod, err := twitchPlatform.GetVideo(vID)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching twitch vod: %v", err)
 	}
 	// check if vod is processing
-	// the best way I know to check if a vod is processing / still being streamed
-	if strings.Contains(tVod.ThumbnailURL, "processing") {
+	if tVod.Processing {
 		return nil, fmt.Errorf("vod is still processing")
 	}
 	// Check if vod is already archived
-	vCheck, err := s.VodService.CheckVodExists(tVod.ID)
+	// the live archive being replaced shares the external id of the vod so only it is left out of the check
+	vPredicates := []predicate.Vod{entVod.ExtID(tVod.ID)}
+	if liveVod != nil {
+		vPredicates = append(vPredicates, entVod.IDNEQ(liveVod.ID))
+	}
+	vCheck, vTrashed, err := vod.Exists(context.Background(), s.Store.Client, vPredicates...)
 	if err != nil {
 		return nil, fmt.Errorf("error checking if vod exists: %v", err)
 	}
+	if vTrashed {
+		return nil, fmt.Errorf("vod is in the trash, restore or delete it to archive it again")
+	}
 	if vCheck {
 		return nil, fmt.Errorf("vod already exists")
 	}
 	// Check if channel exists
-	cCheck := s.ChannelService.CheckChannelExists(tVod.UserLogin)
+	cCheck, cTrashed := s.ChannelService.CheckChannelExists(tVod.UserLogin)
+	if cTrashed {
+		return nil, fmt.Errorf("channel is in the trash, restore it to archive its videos")
+	}
 	if !cCheck {
 		log.Debug().Msgf("channel does not exist: %s while archiving vod. creating now.", tVod.UserLogin)
 		_, err := s.ArchiveTwitchChannel(tVod.UserLogin)
@@ -117,19 +193,38 @@ func (s *Service) ArchiveTwitchVod(vID string, quality string, chat bool, render
 		return nil, fmt.Errorf("error fetching channel: %v", err)
 	}
 
+	// Apply archive profile
+	p, err := s.getArchiveProfile(profileID, dbC.ID)
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		if p.Quality != "" {
+			quality = p.Quality
+		}
+		renderChat = p.RenderChat
+	}
+
 	// Generate VOD ID for folder name
 	vUUID, err := uuid.NewUUID()
 	if err != nil {
 		return nil, fmt.Errorf("error creating vod uuid: %v", err)
 	}
 
-	// Storage templates
-	folderName, err := GetFolderName(vUUID, tVod)
+	// Create vodDto for storage templates
+	tVodDto := twitch.Vod{
+		ID:        tVod.ID,
+		UserLogin: tVod.UserLogin,
+		Title:     tVod.Title,
+		Type:      tVod.Type,
+		CreatedAt: tVod.CreatedAt.Format(time.RFC3339),
+	}
+	folderName, err := getFolderName(vUUID, tVodDto, p)
 	if err != nil {
 		log.Error().Err(err).Msg("error using template to create folder name, falling back to default")
 		folderName = fmt.Sprintf("%s-%s", tVod.ID, vUUID.String())
 	}
-	fileName, err := GetFileName(vUUID, tVod)
+	fileName, err := getFileName(vUUID, tVodDto, p)
 	if err != nil {
 		log.Error().Err(err).Msg("error using template to create file name, falling back to default")
 		fileName = tVod.ID
@@ -148,18 +243,6 @@ func (s *Service) ArchiveTwitchVod(vID string, quality string, chat bool, render
 		liveChatPath = fmt.Sprintf("%s/%s-live-chat.json", rootVodPath, fileName)
 		liveChatConvertPath = fmt.Sprintf("%s/%s-chat-convert.json", rootVodPath, fileName)
 	}
-	// Parse new Twitch API duration
-	parsedDuration, err := time.ParseDuration(tVod.Duration)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing duration: %v", err)
-	}
-
-	// Parse Twitch date to time.Time
-	parsedDate, err := time.Parse(time.RFC3339, tVod.CreatedAt)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing date: %v", err)
-	}
-
 	videoExtension := "mp4"
 
 	// Create VOD in DB
@@ -169,7 +252,7 @@ func (s *Service) ArchiveTwitchVod(vID string, quality string, chat bool, render
 		Platform:            "twitch",
 		Type:                utils.VodType(tVod.Type),
 		Title:               tVod.Title,
-		Duration:            int(parsedDuration.Seconds()),
+		Duration:            tVod.Duration,
 		Views:               int(tVod.ViewCount),
 		Resolution:          quality,
 		Processing:          true,
@@ -181,7 +264,7 @@ func (s *Service) ArchiveTwitchVod(vID string, quality string, chat bool, render
 		ChatVideoPath:       chatVideoPath,
 		LiveChatConvertPath: liveChatConvertPath,
 		InfoPath:            fmt.Sprintf("%s/%s-info.json", rootVodPath, fileName),
-		StreamedAt:          parsedDate,
+		StreamedAt:          tVod.CreatedAt,
 		FolderName:          folderName,
 		FileName:            fileName,
 		// create temporary paths
@@ -193,7 +276,7 @@ func (s *Service) ArchiveTwitchVod(vID string, quality string, chat bool, render
 		TmpChatRenderPath:       fmt.Sprintf("/tmp/%s_%s-chat.mp4", tVod.ID, vUUID),
 	}
 
-	if viper.GetBool("archive.save_as_hls") {
+	if profile.GetSettings(p).SaveAsHls {
 		vodDTO.TmpVideoHLSPath = fmt.Sprintf("/tmp/%s_%s-video_hls0", tVod.ID, vUUID)
 		vodDTO.VideoHLSPath = fmt.Sprintf("%s/%s-video_hls", rootVodPath, fileName)
 		vodDTO.VideoPath = fmt.Sprintf("%s/%s-video_hls/%s-video.m3u8", rootVodPath, fileName, tVod.ID)
@@ -204,6 +287,19 @@ func (s *Service) ArchiveTwitchVod(vID string, quality string, chat bool, render
 		return nil, fmt.Errorf("error creating vod: %v", err)
 	}
 
+	if p != nil {
+		v, err = v.Update().SetArchiveProfile(p).Save(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error setting vod archive profile: %v", err)
+		}
+	}
+
+	// Link any clips archived before the vod
+	err = s.linkClipsToVod(v)
+	if err != nil {
+		log.Error().Err(err).Msg("error linking clips to vod")
+	}
+
 	// Create queue item
 	q, err := s.QueueService.CreateQueueItem(queue.Queue{LiveArchive: false}, v.ID)
 	if err != nil {
@@ -256,6 +352,11 @@ func (s *Service) ArchiveTwitchVod(vID string, quality string, chat bool, render
 		Channel:      dbC,
 		Queue:        q,
 	}
+	// the live archive is linked by the workflow once the vod is archived
+	if liveVod != nil {
+		input.ReplaceVod = liveVod
+		input.DeleteReplacedVod = replace
+	}
 	we, err := temporal.GetTemporalClient().Client.ExecuteWorkflow(context.Background(), wfOptions, workflows.ArchiveVideoWorkflow, input)
 	if err != nil {
 		log.Error().Err(err).Msg("error starting workflow")
@@ -264,15 +365,25 @@ func (s *Service) ArchiveTwitchVod(vID string, quality string, chat bool, render
 
 	log.Debug().Msgf("workflow id %s started for vod %s", we.GetID(), vID)
 
+	// set IDs in queue
+	_, err = q.Update().SetWorkflowID(we.GetID()).SetWorkflowRunID(we.GetRunID()).Save(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("error updating queue item")
+		return nil, fmt.Errorf("error updating queue item: %v", err)
+	}
+
 	return &TwitchVodResponse{
 		VOD:   v,
 		Queue: q,
 	}, nil
 }
 
-func (s *Service) ArchiveTwitchLive(lwc *ent.Live, live twitch.Live) (*TwitchVodResponse, error) {
+func (s *Service) ArchiveTwitchLive(lwc *ent.Live, live platform.LiveStream) (*TwitchVodResponse, error) {
 	// Check if channel exists
-	cCheck := s.ChannelService.CheckChannelExists(live.UserLogin)
+	cCheck, cTrashed := s.ChannelService.CheckChannelExists(live.UserLogin)
+	if cTrashed {
+		return nil, fmt.Errorf("channel is in the trash, restore it to archive its videos")
+	}
 	if !cCheck {
 		log.Debug().Msgf("channel does not exist: %s while archiving live stream. creating now.", live.UserLogin)
 		_, err := s.ArchiveTwitchChannel(live.UserLogin)
@@ -286,6 +397,32 @@ func (s *Service) ArchiveTwitchLive(lwc *ent.Live, live twitch.Live) (*TwitchVod
 		return nil, fmt.Errorf("error fetching channel: %v", err)
 	}
 
+	// Apply archive profile
+	// live streams archived manually use a temporary watched channel that is not stored
+	var profileID *uuid.UUID
+	if lwc.ID != uuid.Nil {
+		lwcProfileID, err := s.Store.Client.Live.QueryArchiveProfile(lwc).OnlyID(context.Background())
+		if err != nil {
+			if _, ok := err.(*ent.NotFoundError); !ok {
+				return nil, fmt.Errorf("error fetching live watch archive profile: %v", err)
+			}
+		} else {
+			profileID = &lwcProfileID
+		}
+	}
+	p, err := s.getArchiveProfile(profileID, dbC.ID)
+	if err != nil {
+		return nil, err
+	}
+	quality := lwc.Resolution
+	renderChat := lwc.RenderChat
+	if p != nil {
+		if p.Quality != "" {
+			quality = p.Quality
+		}
+		renderChat = p.RenderChat
+	}
+
 	// Generate VOD ID for folder name
 	vUUID, err := uuid.NewUUID()
 	if err != nil {
@@ -298,14 +435,14 @@ func (s *Service) ArchiveTwitchLive(lwc *ent.Live, live twitch.Live) (*TwitchVod
 		UserLogin: live.UserLogin,
 		Title:     live.Title,
 		Type:      "live",
-		CreatedAt: live.StartedAt,
+		CreatedAt: live.StartedAt.Format(time.RFC3339),
 	}
-	folderName, err := GetFolderName(vUUID, tVodDto)
+	folderName, err := getFolderName(vUUID, tVodDto, p)
 	if err != nil {
 		log.Error().Err(err).Msg("error using template to create folder name, falling back to default")
 		folderName = fmt.Sprintf("%s-%s", tVodDto.ID, vUUID.String())
 	}
-	fileName, err := GetFileName(vUUID, tVodDto)
+	fileName, err := getFileName(vUUID, tVodDto, p)
 	if err != nil {
 		log.Error().Err(err).Msg("error using template to create file name, falling back to default")
 		fileName = tVodDto.ID
@@ -336,7 +473,7 @@ func (s *Service) ArchiveTwitchLive(lwc *ent.Live, live twitch.Live) (*TwitchVod
 		Title:               live.Title,
 		Duration:            1,
 		Views:               1,
-		Resolution:          lwc.Resolution,
+		Resolution:          quality,
 		Processing:          true,
 		ThumbnailPath:       fmt.Sprintf("%s/%s-thumbnail.jpg", rootVodPath, fileName),
 		WebThumbnailPath:    fmt.Sprintf("%s/%s-web_thumbnail.jpg", rootVodPath, fileName),
@@ -358,7 +495,7 @@ func (s *Service) ArchiveTwitchLive(lwc *ent.Live, live twitch.Live) (*TwitchVod
 		TmpChatRenderPath:       fmt.Sprintf("/tmp/%s_%s-chat.mp4", live.ID, vUUID),
 	}
 
-	if viper.GetBool("archive.save_as_hls") {
+	if profile.GetSettings(p).SaveAsHls {
 		vodDTO.TmpVideoHLSPath = fmt.Sprintf("/tmp/%s_%s-video_hls0", live.ID, vUUID)
 		vodDTO.VideoHLSPath = fmt.Sprintf("%s/%s-video_hls", rootVodPath, fileName)
 		vodDTO.VideoPath = fmt.Sprintf("%s/%s-video_hls/%s-video.m3u8", rootVodPath, fileName, live.ID)
@@ -369,6 +506,13 @@ func (s *Service) ArchiveTwitchLive(lwc *ent.Live, live twitch.Live) (*TwitchVod
 		return nil, fmt.Errorf("error creating vod: %v", err)
 	}
 
+	if p != nil {
+		v, err = v.Update().SetArchiveProfile(p).Save(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error setting vod archive profile: %v", err)
+		}
+	}
+
 	// Create queue item
 	q, err := s.QueueService.CreateQueueItem(queue.Queue{LiveArchive: true}, v.ID)
 	if err != nil {
@@ -389,7 +533,7 @@ func (s *Service) ArchiveTwitchLive(lwc *ent.Live, live twitch.Live) (*TwitchVod
 
 	}
 
-	if !lwc.RenderChat {
+	if !renderChat {
 		_, err := q.Update().SetTaskChatRender(utils.Success).SetRenderChat(false).Save(context.Background())
 		if err != nil {
 			return nil, fmt.Errorf("error updating queue item: %v", err)
@@ -415,9 +559,9 @@ func (s *Service) ArchiveTwitchLive(lwc *ent.Live, live twitch.Live) (*TwitchVod
 		VideoID:          live.ID,
 		Type:             "live",
 		Platform:         "twitch",
-		Resolution:       lwc.Resolution,
+		Resolution:       quality,
 		DownloadChat:     lwc.ArchiveChat,
-		RenderChat:       lwc.RenderChat,
+		RenderChat:       renderChat,
 		Vod:              v,
 		Channel:          dbC,
 		Queue:            q,
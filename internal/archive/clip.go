@@ -0,0 +1,253 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/dto"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/profile"
+	"github.com/zibbp/ganymede/internal/queue"
+	"github.com/zibbp/ganymede/internal/temporal"
+	"github.com/zibbp/ganymede/internal/twitch"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+	"github.com/zibbp/ganymede/internal/workflows"
+	"go.temporal.io/sdk/client"
+)
+
+func (s *Service) ArchiveTwitchClip(clipID string, quality string, chat bool, renderChat bool, profileID *uuid.UUID) (*TwitchVodResponse, error) {
+	log.Debug().Msgf("Archiving clip %s quality: %s chat: %t render chat: %t", clipID, quality, chat, renderChat)
+	// Fetch clip from Twitch API
+	twitchPlatform, err := platform.Get(utils.PlatformTwitch)
+	if err != nil {
+		return nil, err
+	}
+	tClip, err := twitchPlatform.GetClip(clipID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching twitch clip: %v", err)
+	}
+	// Check if clip is already archived
+	vCheck, vTrashed, err := s.VodService.CheckVodExists(tClip.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error checking if vod exists: %v", err)
+	}
+	if vTrashed {
+		return nil, fmt.Errorf("vod is in the trash, restore or delete it to archive it again")
+	}
+	if vCheck {
+		return nil, fmt.Errorf("vod already exists")
+	}
+	// Check if channel exists
+	// the clips API only returns the broadcaster display name so the channel is matched on the broadcaster id
+	dbC, err := s.Store.Client.Channel.Query().Where(entChannel.ExtID(tClip.UserID)).Only(context.Background())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); !ok {
+			return nil, fmt.Errorf("error fetching channel: %v", err)
+		}
+		log.Debug().Msgf("channel does not exist: %s while archiving clip. creating now.", tClip.UserName)
+		// the display name can differ from the login, e.g. for localized names
+		tChannel, err := twitchPlatform.GetUserByID(tClip.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching twitch channel: %v", err)
+		}
+		dbC, err = s.createTwitchChannel(tChannel)
+		if err != nil {
+			return nil, fmt.Errorf("error creating channel: %v", err)
+		}
+	}
+
+	// Apply archive profile
+	p, err := s.getArchiveProfile(profileID, dbC.ID)
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		if p.Quality != "" {
+			quality = p.Quality
+		}
+		renderChat = p.RenderChat
+	}
+
+	// Generate VOD ID for folder name
+	vUUID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, fmt.Errorf("error creating vod uuid: %v", err)
+	}
+
+	// Create vodDto for storage templates
+	tVodDto := twitch.Vod{
+		ID:        tClip.ID,
+		UserLogin: dbC.Name,
+		Title:     tClip.Title,
+		Type:      string(utils.Clip),
+		CreatedAt: tClip.CreatedAt.Format(time.RFC3339),
+	}
+	folderName, err := getFolderName(vUUID, tVodDto, p)
+	if err != nil {
+		log.Error().Err(err).Msg("error using template to create folder name, falling back to default")
+		folderName = fmt.Sprintf("%s-%s", tVodDto.ID, vUUID.String())
+	}
+	fileName, err := getFileName(vUUID, tVodDto, p)
+	if err != nil {
+		log.Error().Err(err).Msg("error using template to create file name, falling back to default")
+		fileName = tVodDto.ID
+	}
+
+	// Sets
+	rootVodPath := fmt.Sprintf("/vods/%s/%s", dbC.Name, folderName)
+	chatPath := ""
+	chatVideoPath := ""
+
+	if chat {
+		chatPath = fmt.Sprintf("%s/%s-chat.json", rootVodPath, fileName)
+		chatVideoPath = fmt.Sprintf("%s/%s-chat.mp4", rootVodPath, fileName)
+	}
+
+	videoExtension := "mp4"
+
+	// Create VOD in DB
+	vodDTO := vod.Vod{
+		ID:               vUUID,
+		ExtID:            tClip.ID,
+		Platform:         utils.PlatformTwitch,
+		Type:             utils.Clip,
+		Title:            tClip.Title,
+		Duration:         tClip.Duration,
+		Views:            int(tClip.ViewCount),
+		Resolution:       quality,
+		Processing:       true,
+		ThumbnailPath:    fmt.Sprintf("%s/%s-thumbnail.jpg", rootVodPath, fileName),
+		WebThumbnailPath: fmt.Sprintf("%s/%s-web_thumbnail.jpg", rootVodPath, fileName),
+		VideoPath:        fmt.Sprintf("%s/%s-video.%s", rootVodPath, fileName, videoExtension),
+		ChatPath:         chatPath,
+		ChatVideoPath:    chatVideoPath,
+		InfoPath:         fmt.Sprintf("%s/%s-info.json", rootVodPath, fileName),
+		StreamedAt:       tClip.CreatedAt,
+		FolderName:       folderName,
+		FileName:         fileName,
+		// create temporary paths
+		TmpVideoDownloadPath: fmt.Sprintf("/tmp/%s_%s-video.%s", tClip.ID, vUUID, videoExtension),
+		TmpVideoConvertPath:  fmt.Sprintf("/tmp/%s_%s-video-convert.%s", tClip.ID, vUUID, videoExtension),
+		TmpChatDownloadPath:  fmt.Sprintf("/tmp/%s_%s-chat.json", tClip.ID, vUUID),
+		TmpChatRenderPath:    fmt.Sprintf("/tmp/%s_%s-chat.mp4", tClip.ID, vUUID),
+	}
+
+	if profile.GetSettings(p).SaveAsHls {
+		vodDTO.TmpVideoHLSPath = fmt.Sprintf("/tmp/%s_%s-video_hls0", tClip.ID, vUUID)
+		vodDTO.VideoHLSPath = fmt.Sprintf("%s/%s-video_hls", rootVodPath, fileName)
+		vodDTO.VideoPath = fmt.Sprintf("%s/%s-video_hls/%s-video.m3u8", rootVodPath, fileName, tClip.ID)
+	}
+
+	v, err := s.VodService.CreateVod(vodDTO, dbC.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating vod: %v", err)
+	}
+
+	if p != nil {
+		v, err = v.Update().SetArchiveProfile(p).Save(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error setting vod archive profile: %v", err)
+		}
+	}
+
+	// Link the clip to the VOD it was created from
+	if tClip.VideoID != "" {
+		vUpdate := v.Update().SetClipExtVodID(tClip.VideoID).SetClipVodOffset(tClip.VodOffset)
+		sourceVod, err := s.Store.Client.Vod.Query().Where(entVod.ExtID(tClip.VideoID)).First(context.Background())
+		if err != nil {
+			if _, ok := err.(*ent.NotFoundError); !ok {
+				return nil, fmt.Errorf("error fetching source vod: %v", err)
+			}
+		} else {
+			vUpdate.SetClipSource(sourceVod)
+		}
+		v, err = vUpdate.Save(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error updating vod: %v", err)
+		}
+	}
+
+	// Create queue item
+	q, err := s.QueueService.CreateQueueItem(queue.Queue{LiveArchive: false}, v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating queue item: %v", err)
+	}
+
+	// If chat is disabled update queue
+	if !chat {
+		_, err := q.Update().SetChatProcessing(false).SetTaskChatDownload(utils.Success).SetTaskChatRender(utils.Success).SetTaskChatMove(utils.Success).Save(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error updating queue item: %v", err)
+		}
+	}
+
+	// If render chat is disabled update queue
+	if !renderChat {
+		_, err := q.Update().SetTaskChatRender(utils.Success).SetRenderChat(false).Save(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error updating queue item: %v", err)
+		}
+		_, err = v.Update().SetChatVideoPath("").Save(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error updating vod: %v", err)
+		}
+	}
+
+	// Re-query queue from DB for updated values
+	q, err = s.QueueService.GetQueueItem(q.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching queue item: %v", err)
+	}
+
+	wfOptions := client.StartWorkflowOptions{
+		ID:        vUUID.String(),
+		TaskQueue: "archive",
+	}
+
+	input := dto.ArchiveVideoInput{
+		VideoID:      tClip.ID,
+		Type:         string(utils.Clip),
+		Platform:     string(utils.PlatformTwitch),
+		Resolution:   quality,
+		DownloadChat: chat,
+		RenderChat:   renderChat,
+		Vod:          v,
+		Channel:      dbC,
+		Queue:        q,
+	}
+	we, err := temporal.GetTemporalClient().Client.ExecuteWorkflow(context.Background(), wfOptions, workflows.ArchiveClipWorkflow, input)
+	if err != nil {
+		log.Error().Err(err).Msg("error starting workflow")
+		return nil, fmt.Errorf("error starting workflow: %v", err)
+	}
+
+	log.Debug().Msgf("workflow id %s started for clip %s", we.GetID(), clipID)
+
+	// set IDs in queue
+	_, err = q.Update().SetWorkflowID(we.GetID()).SetWorkflowRunID(we.GetRunID()).Save(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("error updating queue item")
+		return nil, fmt.Errorf("error updating queue item: %v", err)
+	}
+
+	return &TwitchVodResponse{
+		VOD:   v,
+		Queue: q,
+	}, nil
+}
+
+// linkClipsToVod links archived clips to a newly archived VOD they were created from.
+func (s *Service) linkClipsToVod(v *ent.Vod) error {
+	_, err := s.Store.Client.Vod.Update().Where(entVod.ClipExtVodID(v.ExtID), entVod.Not(entVod.HasClipSource())).SetClipSource(v).Save(context.Background())
+	if err != nil {
+		return fmt.Errorf("error linking clips to vod: %v", err)
+	}
+	return nil
+}
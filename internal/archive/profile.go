@@ -0,0 +1,35 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/ent"
+	entArchiveProfile "github.com/zibbp/ganymede/ent/archiveprofile"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+)
+
+// getArchiveProfile returns the archive profile to archive a video of a channel with.
+// The given profile takes precedence over the profile attached to the channel. Nil is returned if neither is set.
+func (s *Service) getArchiveProfile(profileID *uuid.UUID, channelID uuid.UUID) (*ent.ArchiveProfile, error) {
+	if profileID != nil {
+		p, err := s.Store.Client.ArchiveProfile.Get(context.Background(), *profileID)
+		if err != nil {
+			if _, ok := err.(*ent.NotFoundError); ok {
+				return nil, fmt.Errorf("archive profile not found")
+			}
+			return nil, fmt.Errorf("error fetching archive profile: %v", err)
+		}
+		return p, nil
+	}
+
+	p, err := s.Store.Client.ArchiveProfile.Query().Where(entArchiveProfile.HasChannelsWith(entChannel.ID(channelID))).Only(context.Background())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching channel archive profile: %v", err)
+	}
+	return p, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/twitch"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -18,13 +19,24 @@ var (
 )
 
 func GetFolderName(uuid uuid.UUID, tVideoItem twitch.Vod) (string, error) {
+	return getFolderNameFromTemplate(viper.GetString("storage_templates.folder_template"), uuid, tVideoItem)
+}
+
+// getFolderName returns the folder name using the folder template of the archive profile if it has one.
+func getFolderName(uuid uuid.UUID, tVideoItem twitch.Vod, p *ent.ArchiveProfile) (string, error) {
+	if p != nil && p.FolderTemplate != "" {
+		return getFolderNameFromTemplate(p.FolderTemplate, uuid, tVideoItem)
+	}
+	return GetFolderName(uuid, tVideoItem)
+}
+
+func getFolderNameFromTemplate(folderTemplate string, uuid uuid.UUID, tVideoItem twitch.Vod) (string, error) {
 
 	variableMap, err := getVariableMap(uuid, &tVideoItem)
 	if err != nil {
 		return "", fmt.Errorf("error getting variable map: %w", err)
 	}
 
-	folderTemplate := viper.GetString("storage_templates.folder_template")
 	if folderTemplate == "" {
 		log.Error().Msg("Folder template is empty")
 		// Fallback template
@@ -50,13 +62,24 @@ func GetFolderName(uuid uuid.UUID, tVideoItem twitch.Vod) (string, error) {
 }
 
 func GetFileName(uuid uuid.UUID, tVideoItem twitch.Vod) (string, error) {
+	return getFileNameFromTemplate(viper.GetString("storage_templates.file_template"), uuid, tVideoItem)
+}
+
+// getFileName returns the file name using the file template of the archive profile if it has one.
+func getFileName(uuid uuid.UUID, tVideoItem twitch.Vod, p *ent.ArchiveProfile) (string, error) {
+	if p != nil && p.FileTemplate != "" {
+		return getFileNameFromTemplate(p.FileTemplate, uuid, tVideoItem)
+	}
+	return GetFileName(uuid, tVideoItem)
+}
+
+func getFileNameFromTemplate(fileTemplate string, uuid uuid.UUID, tVideoItem twitch.Vod) (string, error) {
 
 	variableMap, err := getVariableMap(uuid, &tVideoItem)
 	if err != nil {
 		return "", fmt.Errorf("error getting variable map: %w", err)
 	}
 
-	fileTemplate := viper.GetString("storage_templates.file_template")
 	if fileTemplate == "" {
 		log.Error().Msg("File template is empty")
 		// Fallback template
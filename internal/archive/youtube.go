@@ -0,0 +1,242 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/schema"
+	"github.com/zibbp/ganymede/internal/channel"
+	"github.com/zibbp/ganymede/internal/dto"
+	"github.com/zibbp/ganymede/internal/profile"
+	"github.com/zibbp/ganymede/internal/queue"
+	"github.com/zibbp/ganymede/internal/temporal"
+	"github.com/zibbp/ganymede/internal/twitch"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+	"github.com/zibbp/ganymede/internal/workflows"
+	"go.temporal.io/sdk/client"
+)
+
+// ArchiveYoutubeChannel - Create YouTube channel folder, profile image, and database entry.
+func (s *Service) ArchiveYoutubeChannel(cName string) (*ent.Channel, error) {
+	// Fetch channel from YouTube
+	yChannel, err := s.YoutubeService.GetChannel(cName)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching youtube channel: %v", err)
+	}
+
+	// Check if channel exists in DB, by ID as channels archived before names were namespaced have other names
+	// channels in the trash are checked too as they still hold the name
+	cCheck, err := s.Store.Client.Channel.Query().Where(entChannel.Or(entChannel.ExtID(yChannel.ID), entChannel.Name(yChannel.Login()))).First(schema.SkipSoftDelete(context.Background()))
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("error checking if channel exists: %v", err)
+	}
+	if cCheck != nil {
+		if cCheck.DeletedAt != nil {
+			return nil, fmt.Errorf("channel is in the trash, restore it to archive it again")
+		}
+		return nil, fmt.Errorf("channel already exists")
+	}
+
+	// Create channel folder
+	err = utils.CreateFolder(yChannel.Login())
+	if err != nil {
+		return nil, fmt.Errorf("error creating channel folder: %v", err)
+	}
+
+	// Download channel profile image
+	err = utils.DownloadFile(yChannel.ProfileImageURL(), yChannel.Login(), "profile.png")
+	if err != nil {
+		return nil, fmt.Errorf("error downloading channel profile image: %v", err)
+	}
+
+	// Create channel in DB
+	channelDTO := channel.Channel{
+		ExtID:       yChannel.ID,
+		Name:        yChannel.Login(),
+		DisplayName: yChannel.Name,
+		ImagePath:   fmt.Sprintf("/vods/%s/profile.png", yChannel.Login()),
+		Platform:    utils.PlatformYoutube,
+	}
+
+	dbC, err := s.ChannelService.CreateChannel(channelDTO)
+	if err != nil {
+		return nil, fmt.Errorf("error creating channel: %v", err)
+	}
+
+	return dbC, nil
+}
+
+func (s *Service) ArchiveYoutubeVideo(vID string, quality string) (*TwitchVodResponse, error) {
+	log.Debug().Msgf("Archiving youtube video %s quality: %s", vID, quality)
+	// Fetch video from YouTube
+	yVideo, err := s.YoutubeService.GetVideoByID(vID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching youtube video: %v", err)
+	}
+	// Livestreams and premieres that have not finished can't be archived as a video
+	if yVideo.LiveStatus == "is_live" || yVideo.LiveStatus == "is_upcoming" {
+		return nil, fmt.Errorf("video is still live or upcoming")
+	}
+	// Check if video is already archived
+	vCheck, vTrashed, err := s.VodService.CheckVodExists(yVideo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error checking if vod exists: %v", err)
+	}
+	if vTrashed {
+		return nil, fmt.Errorf("vod is in the trash, restore or delete it to archive it again")
+	}
+	if vCheck {
+		return nil, fmt.Errorf("vod already exists")
+	}
+	// Check if channel exists
+	dbC, err := s.Store.Client.Channel.Query().Where(entChannel.ExtID(yVideo.ChannelID)).Only(context.Background())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); !ok {
+			return nil, fmt.Errorf("error fetching channel: %v", err)
+		}
+		log.Debug().Msgf("channel does not exist: %s while archiving youtube video. creating now.", yVideo.ChannelID)
+		dbC, err = s.ArchiveYoutubeChannel(yVideo.ChannelID)
+		if err != nil {
+			return nil, fmt.Errorf("error creating channel: %v", err)
+		}
+	}
+
+	// Apply archive profile of the channel
+	p, err := s.getArchiveProfile(nil, dbC.ID)
+	if err != nil {
+		return nil, err
+	}
+	if p != nil && p.Quality != "" {
+		quality = p.Quality
+	}
+
+	// Generate VOD ID for folder name
+	vUUID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, fmt.Errorf("error creating vod uuid: %v", err)
+	}
+
+	// Create vodDto for storage templates
+	parsedDate := yVideo.CreatedAt()
+	tVodDto := twitch.Vod{
+		ID:        yVideo.ID,
+		UserLogin: dbC.Name,
+		Title:     yVideo.Title,
+		Type:      yVideo.Type(),
+		CreatedAt: parsedDate.Format(time.RFC3339),
+	}
+	folderName, err := getFolderName(vUUID, tVodDto, p)
+	if err != nil {
+		log.Error().Err(err).Msg("error using template to create folder name, falling back to default")
+		folderName = fmt.Sprintf("%s-%s", tVodDto.ID, vUUID.String())
+	}
+	fileName, err := getFileName(vUUID, tVodDto, p)
+	if err != nil {
+		log.Error().Err(err).Msg("error using template to create file name, falling back to default")
+		fileName = tVodDto.ID
+	}
+
+	// Sets
+	rootVodPath := fmt.Sprintf("/vods/%s/%s", dbC.Name, folderName)
+
+	videoExtension := "mp4"
+	// audio only downloads are not stored in a video container
+	if quality == "audio" {
+		videoExtension = "m4a"
+	}
+
+	// Create VOD in DB
+	// YouTube chat is only available as a live chat replay so it is not archived
+	vodDTO := vod.Vod{
+		ID:                   vUUID,
+		ExtID:                yVideo.ID,
+		Platform:             utils.PlatformYoutube,
+		Type:                 utils.VodType(yVideo.Type()),
+		Title:                yVideo.Title,
+		Duration:             yVideo.Duration,
+		Views:                int(yVideo.ViewCount),
+		Resolution:           quality,
+		Processing:           true,
+		ThumbnailPath:        fmt.Sprintf("%s/%s-thumbnail.jpg", rootVodPath, fileName),
+		WebThumbnailPath:     fmt.Sprintf("%s/%s-web_thumbnail.jpg", rootVodPath, fileName),
+		VideoPath:            fmt.Sprintf("%s/%s-video.%s", rootVodPath, fileName, videoExtension),
+		InfoPath:             fmt.Sprintf("%s/%s-info.json", rootVodPath, fileName),
+		StreamedAt:           parsedDate,
+		FolderName:           folderName,
+		FileName:             fileName,
+		TmpVideoDownloadPath: fmt.Sprintf("/tmp/%s_%s-video.%s", yVideo.ID, vUUID, videoExtension),
+		TmpVideoConvertPath:  fmt.Sprintf("/tmp/%s_%s-video-convert.%s", yVideo.ID, vUUID, videoExtension),
+	}
+
+	if profile.GetSettings(p).SaveAsHls {
+		vodDTO.TmpVideoHLSPath = fmt.Sprintf("/tmp/%s_%s-video_hls0", yVideo.ID, vUUID)
+		vodDTO.VideoHLSPath = fmt.Sprintf("%s/%s-video_hls", rootVodPath, fileName)
+		vodDTO.VideoPath = fmt.Sprintf("%s/%s-video_hls/%s-video.m3u8", rootVodPath, fileName, yVideo.ID)
+	}
+
+	v, err := s.VodService.CreateVod(vodDTO, dbC.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating vod: %v", err)
+	}
+
+	if p != nil {
+		v, err = v.Update().SetArchiveProfile(p).Save(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("error setting vod archive profile: %v", err)
+		}
+	}
+
+	// Create queue item
+	q, err := s.QueueService.CreateQueueItem(queue.Queue{LiveArchive: false}, v.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating queue item: %v", err)
+	}
+
+	// Chat is not archived for YouTube videos
+	q, err = q.Update().SetChatProcessing(false).SetRenderChat(false).SetTaskChatDownload(utils.Success).SetTaskChatConvert(utils.Success).SetTaskChatRender(utils.Success).SetTaskChatMove(utils.Success).Save(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error updating queue item: %v", err)
+	}
+
+	wfOptions := client.StartWorkflowOptions{
+		ID:        vUUID.String(),
+		TaskQueue: "archive",
+	}
+
+	input := dto.ArchiveVideoInput{
+		VideoID:      yVideo.ID,
+		Type:         yVideo.Type(),
+		Platform:     string(utils.PlatformYoutube),
+		Resolution:   quality,
+		DownloadChat: false,
+		RenderChat:   false,
+		Vod:          v,
+		Channel:      dbC,
+		Queue:        q,
+	}
+	we, err := temporal.GetTemporalClient().Client.ExecuteWorkflow(context.Background(), wfOptions, workflows.ArchiveYoutubeVideoWorkflow, input)
+	if err != nil {
+		log.Error().Err(err).Msg("error starting workflow")
+		return nil, fmt.Errorf("error starting workflow: %v", err)
+	}
+
+	log.Debug().Msgf("workflow id %s started for youtube video %s", we.GetID(), vID)
+
+	// set IDs in queue
+	_, err = q.Update().SetWorkflowID(we.GetID()).SetWorkflowRunID(we.GetRunID()).Save(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("error updating queue item")
+		return nil, fmt.Errorf("error updating queue item: %v", err)
+	}
+
+	return &TwitchVodResponse{
+		VOD:   v,
+		Queue: q,
+	}, nil
+}
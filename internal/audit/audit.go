@@ -0,0 +1,228 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entAuditEvent "github.com/zibbp/ganymede/ent/auditevent"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// redacted replaces the values of secret fields in the changes of an event.
+const redacted = "[redacted]"
+
+// SystemActor is the username of the events of scheduled tasks.
+const SystemActor = "system"
+
+// systemTaskKey is the key of the scheduled task in the context of its actions.
+const systemTaskKey = "audit_system_task"
+
+// secretFields are the parts of field names of secrets. Proxies and notification targets hold credentials in their URLs and headers.
+var secretFields = []string{"token", "password", "secret", "webhook", "proxies", "targets"}
+
+type Service struct {
+	Store *database.Database
+}
+
+func NewService(store *database.Database) *Service {
+	return &Service{Store: store}
+}
+
+// Event is an action recorded in the audit log.
+type Event struct {
+	Action     utils.AuditAction
+	EntityType string
+	EntityID   string
+	Details    map[string]interface{}
+	// Before and After are the entity before and after the action, only the fields that changed are recorded.
+	Before interface{}
+	After  interface{}
+}
+
+// Filter filters the events of the audit log. Empty fields match all events.
+type Filter struct {
+	Action     utils.AuditAction
+	EntityType string
+	EntityID   string
+	UserID     *uuid.UUID
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	Offset     int
+}
+
+type Pagination struct {
+	Offset     int               `json:"offset"`
+	Limit      int               `json:"limit"`
+	TotalCount int               `json:"total_count"`
+	Pages      int               `json:"pages"`
+	Data       []*ent.AuditEvent `json:"data"`
+}
+
+// requestUser is implemented by the context of authenticated requests, see auth.CustomContext.
+type requestUser interface {
+	GetUser() *ent.User
+}
+
+// NewSystemContext returns the context of the actions of a scheduled task.
+// Their events are recorded with the system actor and the task in their details.
+func NewSystemContext(ctx context.Context, task string) echo.Context {
+	req := (&http.Request{}).WithContext(ctx)
+	c := echo.New().NewContext(req, nil)
+	c.Set(systemTaskKey, task)
+	return c
+}
+
+// Record saves an event with the user and IP address of the request, or the system actor for the actions of scheduled tasks.
+// Recording is best effort, errors are logged and don't fail the action.
+func Record(c echo.Context, client *ent.Client, event Event) {
+	create := client.AuditEvent.Create().
+		SetAction(event.Action).
+		SetEntityType(event.EntityType).
+		SetEntityID(event.EntityID).
+		SetIP(c.RealIP())
+	details := event.Details
+	if r, ok := c.(requestUser); ok && r.GetUser() != nil {
+		create.SetUserID(r.GetUser().ID).SetUsername(r.GetUser().Username)
+	} else if task, ok := c.Get(systemTaskKey).(string); ok {
+		create.SetUsername(SystemActor)
+		details = map[string]interface{}{"task": task}
+		for key, value := range event.Details {
+			details[key] = value
+		}
+	}
+	if details != nil {
+		create.SetDetails(details)
+	}
+	if event.Before != nil || event.After != nil {
+		changes, err := diff(event.Before, event.After)
+		if err != nil {
+			log.Error().Err(err).Msgf("error recording changes of audit event %s", event.Action)
+		}
+		create.SetChanges(changes)
+	}
+	if _, err := create.Save(c.Request().Context()); err != nil {
+		log.Error().Err(err).Msgf("error recording audit event %s of %s %s", event.Action, event.EntityType, event.EntityID)
+	}
+}
+
+// GetEvents returns the events matching the filter, newest first.
+func (s *Service) GetEvents(c echo.Context, filter Filter) (Pagination, error) {
+	var pagination Pagination
+
+	query := s.Store.Client.AuditEvent.Query()
+	if filter.Action != "" {
+		query = query.Where(entAuditEvent.ActionEQ(filter.Action))
+	}
+	if filter.EntityType != "" {
+		query = query.Where(entAuditEvent.EntityType(filter.EntityType))
+	}
+	if filter.EntityID != "" {
+		query = query.Where(entAuditEvent.EntityID(filter.EntityID))
+	}
+	if filter.UserID != nil {
+		query = query.Where(entAuditEvent.UserID(*filter.UserID))
+	}
+	if filter.From != nil {
+		query = query.Where(entAuditEvent.CreatedAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		query = query.Where(entAuditEvent.CreatedAtLT(*filter.To))
+	}
+
+	events, err := query.Clone().Order(ent.Desc(entAuditEvent.FieldCreatedAt)).Limit(filter.Limit).Offset(filter.Offset).All(c.Request().Context())
+	if err != nil {
+		return pagination, fmt.Errorf("error getting audit events: %v", err)
+	}
+
+	totalCount, err := query.Count(c.Request().Context())
+	if err != nil {
+		return pagination, fmt.Errorf("error getting total audit event count: %v", err)
+	}
+
+	pagination.TotalCount = totalCount
+	pagination.Limit = filter.Limit
+	pagination.Offset = filter.Offset
+	pagination.Pages = int(math.Ceil(float64(totalCount) / float64(filter.Limit)))
+	pagination.Data = events
+
+	return pagination, nil
+}
+
+// diff returns the fields that differ between two values as they are encoded to JSON.
+// Nested fields are named with their path, e.g. parameters.twitch_token, and the values of secrets are redacted.
+// The update time of entities always changes and is not recorded.
+func diff(before interface{}, after interface{}) (map[string]utils.AuditChange, error) {
+	beforeFields, err := flatten(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := flatten(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]utils.AuditChange{}
+	for _, fields := range []map[string]interface{}{beforeFields, afterFields} {
+		for key := range fields {
+			if _, ok := changes[key]; ok || key == "updated_at" || reflect.DeepEqual(beforeFields[key], afterFields[key]) {
+				continue
+			}
+			change := utils.AuditChange{Before: beforeFields[key], After: afterFields[key]}
+			if secret(key) {
+				change = utils.AuditChange{Before: redacted, After: redacted}
+			}
+			changes[key] = change
+		}
+	}
+	return changes, nil
+}
+
+// flatten returns the JSON fields of a value keyed by their path.
+func flatten(v interface{}) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if v == nil {
+		return fields, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding audit value: %v", err)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("error decoding audit value: %v", err)
+	}
+	var walk func(prefix string, m map[string]interface{})
+	walk = func(prefix string, m map[string]interface{}) {
+		for key, value := range m {
+			if nested, ok := value.(map[string]interface{}); ok {
+				walk(prefix+key+".", nested)
+				continue
+			}
+			fields[prefix+key] = value
+		}
+	}
+	walk("", m)
+	return fields, nil
+}
+
+func secret(key string) bool {
+	key = strings.ToLower(key[strings.LastIndex(key, ".")+1:])
+	for _, s := range secretFields {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,313 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entChannelPermission "github.com/zibbp/ganymede/ent/channelpermission"
+	entGroup "github.com/zibbp/ganymede/ent/group"
+	"github.com/zibbp/ganymede/ent/predicate"
+	entQueue "github.com/zibbp/ganymede/ent/queue"
+	entUser "github.com/zibbp/ganymede/ent/user"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+var accessRanks = map[utils.ChannelAccess]int{
+	utils.ChannelAccessView:    1,
+	utils.ChannelAccessArchive: 2,
+	utils.ChannelAccessEdit:    3,
+}
+
+// accessRoles are the global roles that grant an access to every channel the user can see.
+var accessRoles = map[utils.ChannelAccess]utils.Role{
+	utils.ChannelAccessView:    utils.UserRole,
+	utils.ChannelAccessArchive: utils.ArchiverRole,
+	utils.ChannelAccessEdit:    utils.EditorRole,
+}
+
+// Viewer returns the user of the request, nil if the request is anonymous.
+func Viewer(c echo.Context) *ent.User {
+	if cc, ok := c.(*CustomContext); ok {
+		return cc.User
+	}
+	return nil
+}
+
+// OptionalUserMiddleware sets the user of the request if it is authenticated and lets anonymous requests through,
+// unless the require_login setting is enabled.
+func OptionalUserMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		authenticated := false
+		err := GuardMiddleware(GetUserMiddleware(func(c echo.Context) error {
+			authenticated = true
+			return next(c)
+		}))(c)
+		if authenticated {
+			return err
+		}
+		if viper.GetBool("require_login") {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid access token")
+		}
+		return next(c)
+	}
+}
+
+// VisibleChannels returns the predicates limiting a channel query to the channels a user can see.
+// Channels that are not restricted are visible to everyone, restricted channels to admins and users
+// granted a permission directly or through a group.
+func VisibleChannels(user *ent.User) []predicate.Channel {
+	if user != nil && user.Role == utils.AdminRole {
+		return nil
+	}
+	if user == nil {
+		return []predicate.Channel{entChannel.Restricted(false)}
+	}
+	return []predicate.Channel{entChannel.Or(
+		entChannel.Restricted(false),
+		entChannel.HasPermissionsWith(grantedTo(user.ID)),
+	)}
+}
+
+// VisibleVods returns the predicates limiting a vod query to the vods of the channels a user can see.
+func VisibleVods(user *ent.User) []predicate.Vod {
+	channels := VisibleChannels(user)
+	if channels == nil {
+		return nil
+	}
+	return []predicate.Vod{entVod.HasChannelWith(channels...)}
+}
+
+// CanAccessChannel returns whether a user has an access to a channel.
+// Users can view the channels that are not restricted, archive and edit them with a global role,
+// and are granted more access to a channel by its permissions. Only admins and users with a permission see restricted channels.
+func CanAccessChannel(ctx context.Context, client *ent.Client, user *ent.User, channel *ent.Channel, access utils.ChannelAccess) (bool, error) {
+	if user != nil && user.Role == utils.AdminRole {
+		return true, nil
+	}
+	var granted utils.ChannelAccess
+	if user != nil {
+		var err error
+		granted, err = grantedAccess(ctx, client, user.ID, channel.ID)
+		if err != nil {
+			return false, err
+		}
+	}
+	if accessRanks[granted] >= accessRanks[access] {
+		return true, nil
+	}
+	if channel.Restricted && granted == "" {
+		return false, nil
+	}
+	if access == utils.ChannelAccessView {
+		return true, nil
+	}
+	return user != nil && roleRanks[user.Role] >= roleRanks[accessRoles[access]], nil
+}
+
+// CheckChannelAccess returns an error if the user of the request does not have an access to a channel.
+// Channels the user can't see are reported as not found.
+func CheckChannelAccess(c echo.Context, channelID uuid.UUID, access utils.ChannelAccess) error {
+	return checkChannelAccess(c, entChannel.ID(channelID), access)
+}
+
+// ChannelAccessMiddleware requires an access to the channel with the id in the path parameter.
+func ChannelAccessMiddleware(param string, access utils.ChannelAccess) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			id, err := uuid.Parse(c.Param(param))
+			if err != nil {
+				return next(c)
+			}
+			if err := checkChannelAccess(c, entChannel.ID(id), access); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// ChannelNameAccessMiddleware requires an access to the channel with the name in the path parameter.
+func ChannelNameAccessMiddleware(param string, access utils.ChannelAccess) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if err := checkChannelAccess(c, entChannel.Name(c.Param(param)), access); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// VodAccessMiddleware requires an access to the channel of the vod with the id in the path parameter.
+func VodAccessMiddleware(param string, access utils.ChannelAccess) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			id, err := uuid.Parse(c.Param(param))
+			if err != nil {
+				return next(c)
+			}
+			if err := checkChannelAccess(c, entChannel.HasVodsWith(entVod.ID(id)), access); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// QueueAccessMiddleware requires an access to the channel of the vod of the queue item with the id in the path parameter.
+func QueueAccessMiddleware(param string, access utils.ChannelAccess) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			id, err := uuid.Parse(c.Param(param))
+			if err != nil {
+				return next(c)
+			}
+			if err := checkChannelAccess(c, entChannel.HasVodsWith(entVod.HasQueueWith(entQueue.ID(id))), access); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// CheckChannelExtIDAccess returns an error if the user of the request does not have an access to the channel with the external id.
+// Channels that are not archived yet are created by the request so they require the global role of the access.
+func CheckChannelExtIDAccess(c echo.Context, extID string, access utils.ChannelAccess) error {
+	exists, err := database.DB().Client.Channel.Query().Where(entChannel.ExtID(extID)).Exist(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if exists {
+		return checkChannelAccess(c, entChannel.ExtID(extID), access)
+	}
+	user := Viewer(c)
+	role, isToken := c.Get("api_token.role").(utils.Role)
+	if user == nil || roleRanks[user.Role] < roleRanks[accessRoles[access]] || (isToken && roleRanks[role] < roleRanks[accessRoles[access]]) {
+		return echo.NewHTTPError(http.StatusForbidden, "You are not authorized to access this resource")
+	}
+	return nil
+}
+
+// VodBodyAccessMiddleware requires an access to the channel of the vod with the id in a field of the JSON body.
+// The body is restored for the handler.
+func VodBodyAccessMiddleware(field string, access utils.ChannelAccess) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			body, err := io.ReadAll(c.Request().Body)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(body, &fields); err != nil {
+				return next(c)
+			}
+			var id uuid.UUID
+			if err := json.Unmarshal(fields[field], &id); err != nil {
+				return next(c)
+			}
+			if err := checkChannelAccess(c, entChannel.HasVodsWith(entVod.ID(id)), access); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// StaticFileAccessMiddleware requires the view access to the channel of static files served under prefix.
+// Files are stored in a folder named after their channel.
+func StaticFileAccessMiddleware(prefix string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if err := CheckStaticFileAccess(c, prefix, c.Request().URL.Path); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+// CheckStaticFileAccess returns an error if the user of the request can't view the file at filePath, a path under prefix.
+func CheckStaticFileAccess(c echo.Context, prefix string, filePath string) error {
+	filePath = path.Clean("/" + filePath)
+	if filePath != prefix && !strings.HasPrefix(filePath, prefix+"/") {
+		return echo.NewHTTPError(http.StatusNotFound, "file not found")
+	}
+	name, _, _ := strings.Cut(strings.TrimPrefix(strings.TrimPrefix(filePath, prefix), "/"), "/")
+	// hidden folders, e.g. the trash, are never served, and folders are not listed
+	if name == "" || strings.HasPrefix(name, ".") {
+		return echo.NewHTTPError(http.StatusNotFound, "file not found")
+	}
+	return checkChannelAccess(c, entChannel.Name(name), utils.ChannelAccessView)
+}
+
+// checkChannelAccess checks the access of the user of the request to the channel matching where.
+// Unknown channels are left to the handler.
+func checkChannelAccess(c echo.Context, where predicate.Channel, access utils.ChannelAccess) error {
+	ctx := c.Request().Context()
+	client := database.DB().Client
+	channel, err := client.Channel.Query().Where(where).Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	user := Viewer(c)
+	ok, err := CanAccessChannel(ctx, client, user, channel, access)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	// an API token grants at most its role on a channel, whatever the permissions of the user are
+	if role, isToken := c.Get("api_token.role").(utils.Role); isToken && roleRanks[role] < roleRanks[accessRoles[access]] {
+		ok = false
+	}
+	if ok {
+		return nil
+	}
+	if access != utils.ChannelAccessView {
+		if ok, err := CanAccessChannel(ctx, client, user, channel, utils.ChannelAccessView); err == nil && ok {
+			return echo.NewHTTPError(http.StatusForbidden, "You are not authorized to access this resource")
+		}
+	}
+	return echo.NewHTTPError(http.StatusNotFound, "channel not found")
+}
+
+// grantedAccess returns the highest access granted to a user on a channel by its permissions, empty if none.
+func grantedAccess(ctx context.Context, client *ent.Client, userID uuid.UUID, channelID uuid.UUID) (utils.ChannelAccess, error) {
+	permissions, err := client.ChannelPermission.Query().Where(
+		entChannelPermission.HasChannelWith(entChannel.ID(channelID)),
+		grantedTo(userID),
+	).All(ctx)
+	if err != nil {
+		return "", err
+	}
+	var granted utils.ChannelAccess
+	for _, permission := range permissions {
+		if accessRanks[permission.Access] > accessRanks[granted] {
+			granted = permission.Access
+		}
+	}
+	return granted, nil
+}
+
+// grantedTo matches the permissions granted to a user directly or through a group.
+func grantedTo(userID uuid.UUID) predicate.ChannelPermission {
+	return entChannelPermission.Or(
+		entChannelPermission.HasUserWith(entUser.ID(userID)),
+		entChannelPermission.HasGroupWith(entGroup.HasUsersWith(entUser.ID(userID))),
+	)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/golang-jwt/jwt/v4"
@@ -13,6 +14,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
 	"github.com/zibbp/ganymede/ent"
+	entSession "github.com/zibbp/ganymede/ent/session"
 	entUser "github.com/zibbp/ganymede/ent/user"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/user"
@@ -111,8 +113,13 @@ func (s *Service) Login(c echo.Context, uDto user.User) (*ent.User, error) {
 		Role:     u.Role,
 	}
 
+	session, err := s.createSession(c, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate JWT and set cookie
-	err = GenerateTokensAndSetCookies(&uDto, c)
+	err = GenerateTokensAndSetCookies(&uDto, session, c)
 	if err != nil {
 		return nil, fmt.Errorf("error generating tokens: %v", err)
 	}
@@ -120,9 +127,11 @@ func (s *Service) Login(c echo.Context, uDto user.User) (*ent.User, error) {
 	return u, nil
 }
 
+// Refresh generates new tokens for the session of the refresh token and extends the session.
+// Refresh tokens are rotated, a refresh token that was already used revokes the session as it may have been stolen.
 func (s *Service) Refresh(c echo.Context, refreshToken string) error {
 
-	tkn, err := jwt.Parse(refreshToken, func(token *jwt.Token) (interface{}, error) {
+	tkn, err := jwt.ParseWithClaims(refreshToken, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
@@ -135,26 +144,50 @@ func (s *Service) Refresh(c echo.Context, refreshToken string) error {
 		return fmt.Errorf("error parsing refresh token: %v", err)
 	}
 
-	if claims, ok := tkn.Claims.(jwt.MapClaims); ok && tkn.Valid {
-		uID := claims["user_id"].(string)
-		uUUID, err := uuid.Parse(uID)
-		if err != nil {
-			return fmt.Errorf("error parsing user id: %v", err)
-		}
-		u, err := s.Store.Client.User.Query().Where(entUser.ID(uUUID)).Only(c.Request().Context())
-		if err != nil {
-			return fmt.Errorf("error getting user: %v", err)
-		}
+	claims, ok := tkn.Claims.(*Claims)
+	if !ok || !tkn.Valid {
+		return fmt.Errorf("invalid refresh token")
+	}
 
-		// Generate JWT and set cookie
-		err = GenerateTokensAndSetCookies(&user.User{ID: u.ID, Username: u.Username, Role: u.Role}, c)
-		if err != nil {
-			return fmt.Errorf("error generating tokens: %v", err)
+	// revoked sessions can't be refreshed
+	session, err := AuthenticateSession(c, s.Store.Client, claims.SessionID)
+	if err != nil {
+		return fmt.Errorf("invalid refresh token")
+	}
+	if claims.ID != session.RefreshTokenID {
+		if err := s.Store.Client.Session.DeleteOneID(session.ID).Exec(c.Request().Context()); err != nil {
+			return fmt.Errorf("error deleting session: %v", err)
 		}
-		return nil
+		return fmt.Errorf("invalid refresh token")
+	}
+	u, err := s.Store.Client.User.Query().Where(entUser.ID(claims.UserID), entUser.HasSessionsWith(entSession.ID(session.ID))).Only(c.Request().Context())
+	if err != nil {
+		return fmt.Errorf("error getting user: %v", err)
+	}
+	// the refresh token is only rotated once if it is used by concurrent requests
+	updated, err := s.Store.Client.Session.Update().
+		Where(entSession.ID(session.ID), entSession.RefreshTokenID(claims.ID)).
+		SetRefreshTokenID(uuid.NewString()).
+		SetUserAgent(c.Request().UserAgent()).
+		SetExpiresAt(time.Now().Add(sessionDuration)).
+		Save(c.Request().Context())
+	if err != nil {
+		return fmt.Errorf("error updating session: %v", err)
+	}
+	if updated == 0 {
+		return fmt.Errorf("invalid refresh token")
+	}
+	session, err = s.Store.Client.Session.Get(c.Request().Context(), session.ID)
+	if err != nil {
+		return fmt.Errorf("error getting session: %v", err)
 	}
 
-	return err
+	// Generate JWT and set cookie
+	err = GenerateTokensAndSetCookies(&user.User{ID: u.ID, Username: u.Username, Role: u.Role}, session, c)
+	if err != nil {
+		return fmt.Errorf("error generating tokens: %v", err)
+	}
+	return nil
 }
 
 func (s *Service) Me(c *CustomContext) (*ent.User, error) {
@@ -181,7 +214,8 @@ func (s *Service) ChangePassword(c *CustomContext, passwordDto ChangePassword) e
 		return fmt.Errorf("error changing password: %v", err)
 	}
 
-	return nil
+	// log out the other devices, the old password may have leaked
+	return s.DeleteOtherSessions(c.Request().Context(), c.User.ID, SessionID(c))
 }
 
 func (s *Service) OAuthUserCheck(c echo.Context, idTokenClaims UserInfo) error {
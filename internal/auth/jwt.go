@@ -5,6 +5,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/user"
 	"github.com/zibbp/ganymede/internal/utils"
 	"net/http"
@@ -18,9 +19,10 @@ const (
 )
 
 type Claims struct {
-	UserID   uuid.UUID  `json:"user_id"`
-	Username string     `json:"username"`
-	Role     utils.Role `json:"role"`
+	UserID    uuid.UUID  `json:"user_id"`
+	Username  string     `json:"username"`
+	Role      utils.Role `json:"role"`
+	SessionID uuid.UUID  `json:"session_id"`
 	jwt.RegisteredClaims
 }
 
@@ -42,8 +44,10 @@ func GetJWTRefreshSecret() string {
 }
 
 // GenerateTokensAndSetCookies generates jwt token and saves it to the http-only cookie.
-func GenerateTokensAndSetCookies(user *user.User, c echo.Context) error {
-	accessToken, exp, err := generateAccessToken(user)
+// The tokens are only valid as long as the session exists, the refresh token expires with the session
+// and is only valid until the session is refreshed.
+func GenerateTokensAndSetCookies(user *user.User, session *ent.Session, c echo.Context) error {
+	accessToken, exp, err := generateAccessToken(user, session.ID)
 	if err != nil {
 		return err
 	}
@@ -51,7 +55,7 @@ func GenerateTokensAndSetCookies(user *user.User, c echo.Context) error {
 	setTokenCookie(accessTokenCookieName, accessToken, exp, c)
 
 	// Refresh
-	refreshToken, exp, err := generateRefreshToken(user)
+	refreshToken, exp, err := generateToken(user, session.ID, session.RefreshTokenID, session.ExpiresAt, []byte(GetJWTRefreshSecret()))
 	if err != nil {
 		return err
 	}
@@ -60,27 +64,23 @@ func GenerateTokensAndSetCookies(user *user.User, c echo.Context) error {
 	return nil
 }
 
-func generateAccessToken(user *user.User) (string, time.Time, error) {
+func generateAccessToken(user *user.User, sessionID uuid.UUID) (string, time.Time, error) {
 	// Declare the expiration time of the token (1h).
 	expirationTime := time.Now().Add(1 * time.Hour)
 
-	return generateToken(user, expirationTime, []byte(GetJWTSecret()))
+	return generateToken(user, sessionID, "", expirationTime, []byte(GetJWTSecret()))
 }
 
-func generateRefreshToken(user *user.User) (string, time.Time, error) {
-	// Declare the expiration time of the token - 24 hours.
-	expirationTime := time.Now().Add(30 * 24 * time.Hour)
-
-	return generateToken(user, expirationTime, []byte(GetJWTRefreshSecret()))
-}
-
-func generateToken(user *user.User, expirationTime time.Time, secret []byte) (string, time.Time, error) {
-	// Create the JWT claims, which includes the username and expiry time.
+// generateToken generates a token of the session. The token ID identifies refresh tokens, see Claims.ID.
+func generateToken(user *user.User, sessionID uuid.UUID, tokenID string, expirationTime time.Time, secret []byte) (string, time.Time, error) {
+	// Create the JWT claims, which includes the username, session and expiry time.
 	claims := &Claims{
-		UserID:   user.ID,
-		Username: user.Username,
-		Role:     user.Role,
+		UserID:    user.ID,
+		Username:  user.Username,
+		Role:      user.Role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID: tokenID,
 			// In JWT, the expiry time is expressed as unix milliseconds.
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
@@ -9,6 +9,7 @@ import (
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/utils"
 	"net/http"
+	"strings"
 )
 
 type CustomContext struct {
@@ -16,8 +17,32 @@ type CustomContext struct {
 	User *ent.User
 }
 
+// GetUser returns the user of the request.
+func (c *CustomContext) GetUser() *ent.User {
+	return c.User
+}
+
 func GuardMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
+		// Check if the request has an API token
+		if authorization := c.Request().Header.Get(echo.HeaderAuthorization); authorization != "" {
+			token, ok := strings.CutPrefix(authorization, "Bearer ")
+			if !ok {
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid access token")
+			}
+			apiToken, err := AuthenticateApiToken(c.Request().Context(), database.DB().Client, strings.TrimSpace(token))
+			if err != nil {
+				log.Debug().Err(err).Msg("API token is invalid")
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid access token")
+			}
+
+			c.Set("auth_method", "api_token")
+			c.Set("user.id", apiToken.Edges.User.ID)
+			c.Set("api_token.id", apiToken.ID)
+			c.Set("api_token.role", apiToken.Role)
+			return next(c)
+		}
+
 		// Check if user has a valid access token
 		accessToken, err := c.Cookie("access-token")
 		if err != nil {
@@ -52,7 +77,15 @@ func GuardMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid access token")
 		}
 
+		// the access token is revoked with its session
+		session, err := AuthenticateSession(c, database.DB().Client, userClaims.SessionID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Session is invalid")
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid access token")
+		}
+
 		c.Set("auth_method", "local")
+		c.Set("session.id", session.ID)
 		c.Set("user.id", userClaims.UserID)
 		c.Set("user.username", userClaims.Username)
 		c.Set("access_token", accessToken.Value)
@@ -77,6 +110,21 @@ func GetUserMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 			}
 			return next(cc)
 		}
+		if authMethod == "api_token" {
+			id := c.Get("user.id").(uuid.UUID)
+
+			user, err := database.DB().Client.User.Query().Where(entUser.ID(id)).Only(c.Request().Context())
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid access token")
+			}
+			// the token grants at most its role, whatever the role of the user is now
+			user.Role = CapRole(user.Role, c.Get("api_token.role").(utils.Role))
+			cc := &CustomContext{
+				Context: c,
+				User:    user,
+			}
+			return next(cc)
+		}
 		if authMethod == "oauth" {
 			sub := c.Get("user.sub").(string)
 			// Get user
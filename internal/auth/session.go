@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	entSession "github.com/zibbp/ganymede/ent/session"
+	entUser "github.com/zibbp/ganymede/ent/user"
+)
+
+// sessionDuration is how long a session lasts without refreshing its tokens.
+const sessionDuration = 30 * 24 * time.Hour
+
+// sessionLastSeenInterval limits how often the last seen time of a session is saved.
+const sessionLastSeenInterval = time.Minute
+
+// createSession creates a session for a user logging in on the device of the request.
+// The expired sessions of the user are deleted.
+func (s *Service) createSession(c echo.Context, userID uuid.UUID) (*ent.Session, error) {
+	ctx := c.Request().Context()
+	_, err := s.Store.Client.Session.Delete().Where(entSession.HasUserWith(entUser.ID(userID)), entSession.ExpiresAtLT(time.Now())).Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error deleting expired sessions: %v", err)
+	}
+	session, err := s.Store.Client.Session.Create().
+		SetUserID(userID).
+		SetUserAgent(c.Request().UserAgent()).
+		SetIP(c.RealIP()).
+		SetExpiresAt(time.Now().Add(sessionDuration)).
+		SetRefreshTokenID(uuid.NewString()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error creating session: %v", err)
+	}
+	return session, nil
+}
+
+// GetSessions returns the sessions of a user, most recently seen first.
+func (s *Service) GetSessions(ctx context.Context, userID uuid.UUID) ([]*ent.Session, error) {
+	sessions, err := s.Store.Client.Session.Query().Where(entSession.HasUserWith(entUser.ID(userID)), entSession.ExpiresAtGT(time.Now())).Order(ent.Desc(entSession.FieldLastSeenAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sessions: %v", err)
+	}
+	return sessions, nil
+}
+
+// DeleteSession revokes a session of a user, its tokens are no longer accepted.
+func (s *Service) DeleteSession(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	deleted, err := s.Store.Client.Session.Delete().Where(entSession.ID(id), entSession.HasUserWith(entUser.ID(userID))).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting session: %v", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("session not found")
+	}
+	return nil
+}
+
+// DeleteOtherSessions revokes all sessions of a user except the current one. Nil revokes all sessions.
+func (s *Service) DeleteOtherSessions(ctx context.Context, userID uuid.UUID, current *uuid.UUID) error {
+	query := s.Store.Client.Session.Delete().Where(entSession.HasUserWith(entUser.ID(userID)))
+	if current != nil {
+		query = query.Where(entSession.IDNEQ(*current))
+	}
+	if _, err := query.Exec(ctx); err != nil {
+		return fmt.Errorf("error deleting sessions: %v", err)
+	}
+	return nil
+}
+
+// AuthenticateSession returns the session if it is valid, and records that it was seen from the IP address of the request.
+func AuthenticateSession(c echo.Context, client *ent.Client, id uuid.UUID) (*ent.Session, error) {
+	ctx := c.Request().Context()
+	session, err := client.Session.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session")
+	}
+	if session.ExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("session expired")
+	}
+	if time.Since(session.LastSeenAt) > sessionLastSeenInterval || session.IP != c.RealIP() {
+		session, err = client.Session.UpdateOneID(session.ID).SetLastSeenAt(time.Now()).SetIP(c.RealIP()).Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error updating session: %v", err)
+		}
+	}
+	return session, nil
+}
+
+// SessionID returns the session of the request, nil if the user did not log in with a password, e.g. with an API token.
+func SessionID(c echo.Context) *uuid.UUID {
+	if id, ok := c.Get("session.id").(uuid.UUID); ok {
+		return &id
+	}
+	return nil
+}
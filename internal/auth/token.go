@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	entApiToken "github.com/zibbp/ganymede/ent/apitoken"
+	entUser "github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// apiTokenPrefix marks Ganymede API tokens so they are recognizable, e.g. by secret scanners.
+const apiTokenPrefix = "gmd_"
+
+// apiTokenLastUsedInterval limits how often the last used time of a token is saved.
+const apiTokenLastUsedInterval = time.Minute
+
+var roleRanks = map[utils.Role]int{
+	utils.UserRole:     0,
+	utils.ArchiverRole: 1,
+	utils.EditorRole:   2,
+	utils.AdminRole:    3,
+}
+
+type CreateApiToken struct {
+	Name string
+	// Role is the highest role the token grants. Empty uses the role of the user.
+	Role utils.Role
+	// ExpiresAt is when the token expires. Nil never expires.
+	ExpiresAt *time.Time
+}
+
+// CapRole returns the lower of two roles.
+func CapRole(role utils.Role, max utils.Role) utils.Role {
+	if roleRanks[role] > roleRanks[max] {
+		return max
+	}
+	return role
+}
+
+// CreateApiToken creates an API token for a user. The token is returned once, only its hash is saved.
+func (s *Service) CreateApiToken(c echo.Context, user *ent.User, tokenDto CreateApiToken) (string, *ent.ApiToken, error) {
+	role := tokenDto.Role
+	if role == "" {
+		role = user.Role
+	}
+	if CapRole(role, user.Role) != role {
+		return "", nil, fmt.Errorf("token role can't be higher than the user role")
+	}
+	if tokenDto.ExpiresAt != nil && tokenDto.ExpiresAt.Before(time.Now()) {
+		return "", nil, fmt.Errorf("token expiry must be in the future")
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, fmt.Errorf("error generating token: %v", err)
+	}
+	token := apiTokenPrefix + hex.EncodeToString(secret)
+
+	apiToken, err := s.Store.Client.ApiToken.Create().
+		SetName(tokenDto.Name).
+		SetTokenHash(hashApiToken(token)).
+		SetPrefix(token[:len(apiTokenPrefix)+6]).
+		SetRole(role).
+		SetNillableExpiresAt(tokenDto.ExpiresAt).
+		SetUserID(user.ID).
+		Save(c.Request().Context())
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating token: %v", err)
+	}
+
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditApiTokenCreate, EntityType: "api_token", EntityID: apiToken.ID.String(), Details: map[string]interface{}{"name": apiToken.Name, "prefix": apiToken.Prefix, "role": apiToken.Role, "user_id": user.ID, "expires_at": apiToken.ExpiresAt}})
+	return token, apiToken, nil
+}
+
+// GetApiTokens returns the API tokens of a user.
+func (s *Service) GetApiTokens(ctx context.Context, userID uuid.UUID) ([]*ent.ApiToken, error) {
+	tokens, err := s.Store.Client.ApiToken.Query().Where(entApiToken.HasUserWith(entUser.ID(userID))).Order(ent.Desc(entApiToken.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tokens: %v", err)
+	}
+	return tokens, nil
+}
+
+// DeleteApiToken revokes an API token. Admins can revoke the tokens of any user.
+func (s *Service) DeleteApiToken(c echo.Context, user *ent.User, id uuid.UUID) error {
+	ctx := c.Request().Context()
+	query := s.Store.Client.ApiToken.Query().Where(entApiToken.ID(id))
+	if user.Role != utils.AdminRole {
+		query = query.Where(entApiToken.HasUserWith(entUser.ID(user.ID)))
+	}
+	apiToken, err := query.Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return fmt.Errorf("token not found")
+		}
+		return fmt.Errorf("error getting token: %v", err)
+	}
+	if err := s.Store.Client.ApiToken.DeleteOne(apiToken).Exec(ctx); err != nil {
+		return fmt.Errorf("error deleting token: %v", err)
+	}
+
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditApiTokenRevoke, EntityType: "api_token", EntityID: apiToken.ID.String(), Details: map[string]interface{}{"name": apiToken.Name, "prefix": apiToken.Prefix, "role": apiToken.Role}})
+	return nil
+}
+
+// AuthenticateApiToken returns the API token and its user if the token is valid, and records that it was used.
+func AuthenticateApiToken(ctx context.Context, client *ent.Client, token string) (*ent.ApiToken, error) {
+	if !strings.HasPrefix(token, apiTokenPrefix) {
+		return nil, fmt.Errorf("invalid token")
+	}
+	apiToken, err := client.ApiToken.Query().Where(entApiToken.TokenHash(hashApiToken(token))).WithUser().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token")
+	}
+	if apiToken.ExpiresAt != nil && apiToken.ExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("token expired")
+	}
+	if apiToken.LastUsedAt == nil || time.Since(*apiToken.LastUsedAt) > apiTokenLastUsedInterval {
+		if err := client.ApiToken.UpdateOneID(apiToken.ID).SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("error updating token: %v", err)
+		}
+	}
+	return apiToken, nil
+}
+
+// hashApiToken hashes a token. Tokens are random so a fast hash is enough.
+func hashApiToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -10,9 +10,15 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/schema"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/database"
-	"github.com/zibbp/ganymede/internal/twitch"
+	"github.com/zibbp/ganymede/internal/platform"
 	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+	"github.com/zibbp/ganymede/internal/youtube"
 )
 
 type Service struct {
@@ -24,22 +30,46 @@ func NewService(store *database.Database) *Service {
 }
 
 type Channel struct {
-	ID            uuid.UUID `json:"id"`
-	ExtID         string    `json:"ext_id"`
-	Name          string    `json:"name"`
-	DisplayName   string    `json:"display_name"`
-	ImagePath     string    `json:"image_path"`
-	Retention     bool      `json:"retention"`
-	RetentionDays int64     `json:"retention_days"`
-	UpdatedAt     time.Time `json:"updated_at"`
-	CreatedAt     time.Time `json:"created_at"`
+	ID            uuid.UUID         `json:"id"`
+	ExtID         string            `json:"ext_id"`
+	Name          string            `json:"name"`
+	DisplayName   string            `json:"display_name"`
+	ImagePath     string            `json:"image_path"`
+	Platform      utils.VodPlatform `json:"platform"`
+	Retention     bool              `json:"retention"`
+	RetentionDays int64             `json:"retention_days"`
+	// Retention policy rules, see task.PruneVideos. Nil keeps them unchanged when updating.
+	RetentionTypeDays         map[utils.VodType]int64 `json:"retention_type_days"`
+	RetentionKeepLast         *int64                  `json:"retention_keep_last"`
+	RetentionMaxSizeGB        *int64                  `json:"retention_max_size_gb"`
+	RetentionProtectPlaylists *bool                   `json:"retention_protect_playlists"`
+	RetentionProtectWatched   *bool                   `json:"retention_protect_watched"`
+	RetentionAction           *utils.RetentionAction  `json:"retention_action"`
+	// Cold storage lifecycle rules. Nil keeps them unchanged when updating.
+	ColdStorage              *bool  `json:"cold_storage"`
+	ColdStorageDays          *int64 `json:"cold_storage_days"`
+	ColdStorageUnwatchedDays *int64 `json:"cold_storage_unwatched_days"`
+	// Restricted hides the channel from users without a channel permission. Nil keeps it unchanged when updating.
+	Restricted *bool `json:"restricted"`
+	// ArchiveProfileID is the archive profile used for videos of the channel. Nil removes the archive profile.
+	ArchiveProfileID *uuid.UUID `json:"archive_profile_id"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+	CreatedAt        time.Time  `json:"created_at"`
 }
 
 func (s *Service) CreateChannel(channelDto Channel) (*ent.Channel, error) {
+	if channelDto.Platform == "" {
+		channelDto.Platform = utils.PlatformTwitch
+	}
 
-	cha, err := s.Store.Client.Channel.Create().SetExtID(channelDto.ExtID).SetName(channelDto.Name).SetDisplayName(channelDto.DisplayName).SetImagePath(channelDto.ImagePath).Save(context.Background())
+	cha, err := s.Store.Client.Channel.Create().SetExtID(channelDto.ExtID).SetName(channelDto.Name).SetDisplayName(channelDto.DisplayName).SetImagePath(channelDto.ImagePath).SetPlatform(channelDto.Platform).SetNillableRestricted(channelDto.Restricted).Save(context.Background())
 	if err != nil {
 		if _, ok := err.(*ent.ConstraintError); ok {
+			// channels in the trash keep their name and external id until the trash is purged
+			trashed, tErr := s.Store.Client.Channel.Query().Where(channel.DeletedAtNotNil(), channel.Or(channel.Name(channelDto.Name), channel.DisplayName(channelDto.DisplayName), channel.And(channel.ExtID(channelDto.ExtID), channel.ExtIDNEQ("")))).Exist(schema.SkipSoftDelete(context.Background()))
+			if tErr == nil && trashed {
+				return nil, fmt.Errorf("channel is in the trash, restore it to archive it again")
+			}
 			return nil, fmt.Errorf("channel already exists: %v", err)
 		}
 		log.Debug().Err(err).Msg("error creating channel")
@@ -49,8 +79,9 @@ func (s *Service) CreateChannel(channelDto Channel) (*ent.Channel, error) {
 	return cha, nil
 }
 
-func (s *Service) GetChannels() ([]*ent.Channel, error) {
-	channels, err := s.Store.Client.Channel.Query().Order(ent.Desc(channel.FieldCreatedAt)).All(context.Background())
+// GetChannels returns the channels matching the predicates, all channels if none.
+func (s *Service) GetChannels(predicates ...predicate.Channel) ([]*ent.Channel, error) {
+	channels, err := s.Store.Client.Channel.Query().Where(predicates...).Order(ent.Desc(channel.FieldCreatedAt)).All(context.Background())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting channels")
 		return nil, fmt.Errorf("error getting channels: %v", err)
@@ -87,8 +118,17 @@ func (s *Service) GetChannelByName(cName string) (*ent.Channel, error) {
 	return cha, nil
 }
 
-func (s *Service) DeleteChannel(channelID uuid.UUID) error {
-	err := s.Store.Client.Channel.DeleteOneID(channelID).Exec(context.Background())
+// DeleteChannel permanently deletes a channel, including a channel in the trash.
+func (s *Service) DeleteChannel(c echo.Context, channelID uuid.UUID) error {
+	ctx := schema.SkipSoftDelete(c.Request().Context())
+	cha, err := s.Store.Client.Channel.Get(ctx, channelID)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return fmt.Errorf("channel not found")
+		}
+		return fmt.Errorf("error deleting channel: %v", err)
+	}
+	err = s.Store.Client.Channel.DeleteOneID(channelID).Exec(ctx)
 	if err != nil {
 		// if channel not found
 		if _, ok := err.(*ent.NotFoundError); ok {
@@ -98,11 +138,115 @@ func (s *Service) DeleteChannel(channelID uuid.UUID) error {
 		return fmt.Errorf("error deleting channel: %v", err)
 	}
 
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditChannelDelete, EntityType: "channel", EntityID: channelID.String(), Details: map[string]interface{}{"name": cha.Name}})
+	return nil
+}
+
+// TrashChannel moves a channel and its videos to the trash, see vod.MoveToTrash.
+// Channels with locked videos or videos in a playlist are only moved if force is set.
+func (s *Service) TrashChannel(c echo.Context, channelID uuid.UUID, deleteFiles bool, force bool) error {
+	ctx := c.Request().Context()
+	cha, err := s.Store.Client.Channel.Get(ctx, channelID)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return fmt.Errorf("channel not found")
+		}
+		return fmt.Errorf("error getting channel: %v", err)
+	}
+	vods, err := s.Store.Client.Vod.Query().Where(entVod.HasChannelWith(channel.ID(channelID))).WithChannel().WithPlaylists().All(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting channel vods: %v", err)
+	}
+	if !force {
+		for _, v := range vods {
+			if v.Locked {
+				return fmt.Errorf("channel has locked vods")
+			}
+			if len(v.Edges.Playlists) > 0 {
+				return fmt.Errorf("channel has vods in a playlist")
+			}
+		}
+	}
+
+	// the videos share the deleted_at time of the channel so they are restored with it
+	deletedAt := time.Now()
+	for _, v := range vods {
+		if err := vod.MoveToTrash(ctx, s.Store.Client, v, deleteFiles, deletedAt); err != nil {
+			return err
+		}
+	}
+	_, err = s.Store.Client.Channel.UpdateOneID(channelID).SetDeletedAt(deletedAt).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("error moving channel to the trash: %v", err)
+	}
+
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditChannelTrash, EntityType: "channel", EntityID: channelID.String(), Details: map[string]interface{}{"name": cha.Name, "vods": len(vods), "delete_files": deleteFiles}})
+	return nil
+}
+
+// RestoreChannel moves a channel out of the trash with the videos that were moved to the trash with it.
+func (s *Service) RestoreChannel(c echo.Context, channelID uuid.UUID) error {
+	ctx := schema.SkipSoftDelete(c.Request().Context())
+	cha, err := s.Store.Client.Channel.Query().Where(channel.ID(channelID), channel.DeletedAtNotNil()).Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return fmt.Errorf("channel not found")
+		}
+		return fmt.Errorf("error getting channel: %v", err)
+	}
+	vods, err := s.Store.Client.Vod.Query().Where(entVod.HasChannelWith(channel.ID(channelID)), entVod.DeletedAt(*cha.DeletedAt)).WithChannel().All(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting channel vods: %v", err)
+	}
+
+	_, err = s.Store.Client.Channel.UpdateOneID(channelID).ClearDeletedAt().Save(ctx)
+	if err != nil {
+		return fmt.Errorf("error restoring channel: %v", err)
+	}
+	for _, v := range vods {
+		if err := vod.RestoreFromTrash(ctx, s.Store.Client, v); err != nil {
+			return err
+		}
+	}
+
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditChannelRestore, EntityType: "channel", EntityID: channelID.String(), Details: map[string]interface{}{"name": cha.Name, "vods": len(vods)}})
 	return nil
 }
 
-func (s *Service) UpdateChannel(cId uuid.UUID, channelDto Channel) (*ent.Channel, error) {
-	cha, err := s.Store.Client.Channel.UpdateOneID(cId).SetName(channelDto.Name).SetDisplayName(channelDto.DisplayName).SetImagePath(channelDto.ImagePath).SetRetention(channelDto.Retention).SetRetentionDays(channelDto.RetentionDays).Save(context.Background())
+// GetTrashedChannels returns the channels in the trash, most recently deleted first.
+func (s *Service) GetTrashedChannels(c echo.Context) ([]*ent.Channel, error) {
+	channels, err := s.Store.Client.Channel.Query().Where(channel.DeletedAtNotNil()).Order(ent.Desc(channel.FieldDeletedAt)).All(schema.SkipSoftDelete(c.Request().Context()))
+	if err != nil {
+		return nil, fmt.Errorf("error getting trashed channels: %v", err)
+	}
+	return channels, nil
+}
+
+func (s *Service) UpdateChannel(c echo.Context, cId uuid.UUID, channelDto Channel) (*ent.Channel, error) {
+	// the visibility of the channel is audited as it changes who can see it
+	before, err := s.Store.Client.Channel.Get(c.Request().Context(), cId)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("channel not found")
+		}
+		return nil, fmt.Errorf("error getting channel: %v", err)
+	}
+
+	chaUpdate := s.Store.Client.Channel.UpdateOneID(cId)
+	if channelDto.ArchiveProfileID != nil {
+		chaUpdate.SetArchiveProfileID(*channelDto.ArchiveProfileID)
+	} else {
+		chaUpdate.ClearArchiveProfile()
+	}
+	if channelDto.Restricted != nil {
+		chaUpdate.SetRestricted(*channelDto.Restricted)
+	}
+	if channelDto.RetentionTypeDays != nil {
+		chaUpdate.SetRetentionTypeDays(channelDto.RetentionTypeDays)
+	}
+	chaUpdate.SetNillableRetentionKeepLast(channelDto.RetentionKeepLast).SetNillableRetentionMaxSizeGB(channelDto.RetentionMaxSizeGB).SetNillableRetentionProtectPlaylists(channelDto.RetentionProtectPlaylists).SetNillableRetentionProtectWatched(channelDto.RetentionProtectWatched).SetNillableRetentionAction(channelDto.RetentionAction)
+	chaUpdate.SetNillableColdStorage(channelDto.ColdStorage).SetNillableColdStorageDays(channelDto.ColdStorageDays).SetNillableColdStorageUnwatchedDays(channelDto.ColdStorageUnwatchedDays)
+	cha, err := chaUpdate.SetName(channelDto.Name).SetDisplayName(channelDto.DisplayName).SetImagePath(channelDto.ImagePath).SetRetention(channelDto.Retention).SetRetentionDays(channelDto.RetentionDays).Save(c.Request().Context())
 	if err != nil {
 		// if channel not found
 		if _, ok := err.(*ent.NotFoundError); ok {
@@ -112,21 +256,26 @@ func (s *Service) UpdateChannel(cId uuid.UUID, channelDto Channel) (*ent.Channel
 		return nil, fmt.Errorf("error updating channel: %v", err)
 	}
 
+	if before.Restricted != cha.Restricted {
+		audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditChannelVisibility, EntityType: "channel", EntityID: cId.String(), Details: map[string]interface{}{"name": cha.Name}, Before: map[string]interface{}{"restricted": before.Restricted}, After: map[string]interface{}{"restricted": cha.Restricted}})
+	}
 	return cha, nil
 }
 
-func (s *Service) CheckChannelExists(cName string) bool {
-	_, err := s.Store.Client.Channel.Query().Where(channel.Name(cName)).Only(context.Background())
+// CheckChannelExists returns whether a channel with the name exists and whether it is in the trash.
+// Channels in the trash exist until the trash is purged so they can't be created again.
+func (s *Service) CheckChannelExists(cName string) (bool, bool) {
+	cha, err := s.Store.Client.Channel.Query().Where(channel.Name(cName)).Only(schema.SkipSoftDelete(context.Background()))
 	if err != nil {
 		// if channel not found
 		if _, ok := err.(*ent.NotFoundError); ok {
-			return false
+			return false, false
 		}
 		log.Error().Err(err).Msg("error checking channel exists")
-		return false
+		return false, false
 	}
 
-	return true
+	return true, cha.DeletedAt != nil
 }
 
 func (s *Service) CheckChannelExistsNoContext(cName string) bool {
@@ -153,12 +302,16 @@ func PopulateExternalChannelID() {
 		if c.ExtID != "" {
 			continue
 		}
-		twitchC, err := twitch.API.GetUserByLogin(c.Name)
+		p, err := platform.Get(c.Platform)
 		if err != nil {
-			log.Error().Msg("error getting twitch channel")
 			continue
 		}
-		_, err = database.DB().Client.Channel.UpdateOneID(c.ID).SetExtID(twitchC.ID).Save(context.Background())
+		platformC, err := p.GetUser(c.Name)
+		if err != nil {
+			log.Error().Msgf("error getting %s channel", c.Platform)
+			continue
+		}
+		_, err = database.DB().Client.Channel.UpdateOneID(c.ID).SetExtID(platformC.ID).Save(context.Background())
 		if err != nil {
 			log.Error().Err(err).Msg("error updating channel")
 			continue
@@ -173,10 +326,30 @@ func (s *Service) UpdateChannelImage(c echo.Context, channelID uuid.UUID) error
 		return fmt.Errorf("error getting channel: %v", err)
 	}
 
-	// Fetch channel from Twitch API
-	tChannel, err := twitch.API.GetUserByLogin(channel.Name)
+	if channel.Platform == utils.PlatformYoutube {
+		// Fetch channel from YouTube
+		yChannel, err := youtube.NewService().GetChannel(channel.ExtID)
+		if err != nil {
+			return fmt.Errorf("error fetching youtube channel: %v", err)
+		}
+
+		// Download channel profile image
+		err = utils.DownloadFile(yChannel.ProfileImageURL(), channel.Name, "profile.png")
+		if err != nil {
+			return fmt.Errorf("error downloading channel profile image: %v", err)
+		}
+
+		return nil
+	}
+
+	// Fetch channel from the platform API
+	p, err := platform.Get(channel.Platform)
+	if err != nil {
+		return err
+	}
+	tChannel, err := p.GetUser(channel.Name)
 	if err != nil {
-		return fmt.Errorf("error fetching twitch channel: %v", err)
+		return fmt.Errorf("error fetching %s channel: %v", channel.Platform, err)
 	}
 
 	// Download channel profile image
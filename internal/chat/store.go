@@ -0,0 +1,266 @@
+package chat
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChatMessage "github.com/zibbp/ganymede/ent/chatmessage"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+)
+
+// importBatchSize is the number of comments inserted per statement.
+const importBatchSize = 1000
+
+// importLocks prevents a chat from being imported by multiple tasks at once.
+var importLocks sync.Map
+
+// importing holds the videos whose chat is being imported in the background.
+var importing sync.Map
+
+// ImportChat imports the comments of the chat file of a video into the chat message table.
+// The chat file is decoded one comment at a time so it is never fully loaded in memory.
+// Previously imported comments of the video are replaced in the same transaction, readers never see a partially imported chat.
+func ImportChat(ctx context.Context, client *ent.Client, v *ent.Vod) error {
+	if v.ChatPath == "" {
+		return fmt.Errorf("video has no chat file")
+	}
+	file, err := os.Open(v.ChatPath)
+	if err != nil {
+		return fmt.Errorf("error opening chat file: %v", err)
+	}
+	defer file.Close()
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("error starting transaction: %v", err)
+	}
+	count, err := importChatComments(ctx, tx.Client(), v, file)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Error().Err(rollbackErr).Msgf("error rolling back chat import of vod %s", v.ID)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error committing chat messages: %v", err)
+	}
+	log.Debug().Msgf("imported %d chat messages for vod %s", count, v.ID)
+	return nil
+}
+
+func importChatComments(ctx context.Context, client *ent.Client, v *ent.Vod, file *os.File) (int, error) {
+	_, err := client.ChatMessage.Delete().Where(entChatMessage.HasVodWith(entVod.ID(v.ID))).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting chat messages: %v", err)
+	}
+
+	dec := json.NewDecoder(bufio.NewReader(file))
+	if err := expectDelim(dec, '{'); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return 0, fmt.Errorf("error decoding chat file: %v", err)
+		}
+		if key, ok := token.(string); !ok || key != "comments" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return 0, fmt.Errorf("error decoding chat file: %v", err)
+			}
+			continue
+		}
+
+		if err := expectDelim(dec, '['); err != nil {
+			return 0, err
+		}
+		batch := make([]*ent.ChatMessageCreate, 0, importBatchSize)
+		for dec.More() {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return 0, fmt.Errorf("error decoding chat comment: %v", err)
+			}
+			var comment Comment
+			if err := json.Unmarshal(raw, &comment); err != nil {
+				return 0, fmt.Errorf("error decoding chat comment: %v", err)
+			}
+			badges := make([]string, 0, len(comment.Message.UserBadges))
+			for _, badge := range comment.Message.UserBadges {
+				badges = append(badges, string(badge.ID))
+			}
+			batch = append(batch, client.ChatMessage.Create().
+				SetVodID(v.ID).
+				SetCommentID(comment.ID).
+				SetContentOffsetSeconds(comment.ContentOffsetSeconds).
+				SetCommenterID(comment.Commenter.ID).
+				SetCommenterName(comment.Commenter.Name).
+				SetCommenterDisplayName(comment.Commenter.DisplayName).
+				SetCommenterBadges(badges).
+				SetBody(comment.Message.Body).
+				SetData(string(raw)))
+			if len(batch) == importBatchSize {
+				if err := client.ChatMessage.CreateBulk(batch...).Exec(ctx); err != nil {
+					return 0, fmt.Errorf("error inserting chat messages: %v", err)
+				}
+				count += len(batch)
+				batch = batch[:0]
+			}
+		}
+		if len(batch) > 0 {
+			if err := client.ChatMessage.CreateBulk(batch...).Exec(ctx); err != nil {
+				return 0, fmt.Errorf("error inserting chat messages: %v", err)
+			}
+			count += len(batch)
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return 0, err
+		}
+	}
+
+	_, err = client.Vod.UpdateOneID(v.ID).SetChatImportedAt(time.Now()).Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error updating vod: %v", err)
+	}
+	return count, nil
+}
+
+// EnsureChatImported imports the chat of a video if it has not been imported yet.
+// It is used by background tasks, requests use RequireChatImported.
+func EnsureChatImported(ctx context.Context, client *ent.Client, v *ent.Vod) error {
+	if v.ChatImportedAt != nil {
+		return nil
+	}
+	lock, _ := importLocks.LoadOrStore(v.ID, &sync.Mutex{})
+	mu := lock.(*sync.Mutex)
+	mu.Lock()
+	defer mu.Unlock()
+
+	// another task may have imported the chat while waiting for the lock
+	v, err := client.Vod.Get(ctx, v.ID)
+	if err != nil {
+		return fmt.Errorf("error getting vod: %v", err)
+	}
+	if v.ChatImportedAt != nil {
+		return nil
+	}
+	return ImportChat(ctx, client, v)
+}
+
+// RequireChatImported returns an error if the chat of a video is not imported yet.
+// Videos archived before the chat message table existed are imported in the background the first time their chat is requested.
+func RequireChatImported(client *ent.Client, v *ent.Vod) error {
+	if v.ChatImportedAt != nil {
+		return nil
+	}
+	if v.ChatPath == "" {
+		return fmt.Errorf("video has no chat file")
+	}
+	if _, running := importing.LoadOrStore(v.ID, struct{}{}); !running {
+		go func() {
+			defer importing.Delete(v.ID)
+			if err := EnsureChatImported(context.Background(), client, v); err != nil {
+				log.Error().Err(err).Msgf("Error importing chat of video %s", v.ID)
+			}
+		}()
+	}
+	return fmt.Errorf("chat is being imported")
+}
+
+// ReadChatHeader returns the fields of a chat file other than the comments, such as the streamer and embedded emotes and badges, as a JSON object.
+// The comments are skipped one at a time so the chat file is never fully loaded in memory. If comments is set, the first comments are kept.
+func ReadChatHeader(path string, comments int) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading chat file: %v", err)
+	}
+	defer file.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(file))
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+	header := map[string]json.RawMessage{}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("error decoding chat file: %v", err)
+		}
+		key, _ := token.(string)
+		if key == "comments" {
+			kept, err := skipArray(dec, comments)
+			if err != nil {
+				return nil, err
+			}
+			if comments > 0 {
+				if header[key], err = json.Marshal(kept); err != nil {
+					return nil, fmt.Errorf("error encoding chat comments: %v", err)
+				}
+			}
+			continue
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("error decoding chat file: %v", err)
+		}
+		header[key] = raw
+	}
+	return json.Marshal(header)
+}
+
+// CommentsFromMessages converts stored chat messages back to chat comments.
+func CommentsFromMessages(messages []*ent.ChatMessage) ([]Comment, error) {
+	comments := make([]Comment, 0, len(messages))
+	for _, m := range messages {
+		var comment Comment
+		if err := json.Unmarshal([]byte(m.Data), &comment); err != nil {
+			return nil, fmt.Errorf("error decoding chat message: %v", err)
+		}
+		comments = append(comments, comment)
+	}
+	return comments, nil
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("error decoding chat file: %v", err)
+	}
+	if d, ok := token.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("error decoding chat file: expected %s", delim)
+	}
+	return nil
+}
+
+// skipArray skips an array, or null, one element at a time. The first keep elements are returned.
+func skipArray(dec *json.Decoder, keep int) ([]json.RawMessage, error) {
+	kept := []json.RawMessage{}
+	token, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("error decoding chat file: %v", err)
+	}
+	if token == nil {
+		return kept, nil
+	}
+	if d, ok := token.(json.Delim); !ok || d != '[' {
+		return nil, fmt.Errorf("error decoding chat file: expected [")
+	}
+	for dec.More() {
+		var element json.RawMessage
+		if err := dec.Decode(&element); err != nil {
+			return nil, fmt.Errorf("error decoding chat file: %v", err)
+		}
+		if len(kept) < keep {
+			kept = append(kept, element)
+		}
+	}
+	return kept, expectDelim(dec, ']')
+}
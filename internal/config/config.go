@@ -11,7 +11,10 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/notification"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type Service struct {
@@ -30,7 +33,9 @@ type Conf struct {
 	VideoCheckInterval  int  `json:"video_check_interval_minutes"`
 	OAuthEnabled        bool `json:"oauth_enabled"`
 	RegistrationEnabled bool `json:"registration_enabled"`
-	Parameters          struct {
+	// RequireLogin is kept when updating the config without it.
+	RequireLogin *bool `json:"require_login"`
+	Parameters   struct {
 		TwitchToken    string `json:"twitch_token"`
 		VideoConvert   string `json:"video_convert"`
 		ChatRender     string `json:"chat_render"`
@@ -42,10 +47,12 @@ type Conf struct {
 	Notifications    Notification    `json:"notifications"`
 	StorageTemplates StorageTemplate `json:"storage_templates"`
 	Livestream       struct {
-		Proxies         []ProxyListItem `json:"proxies"`
-		ProxyEnabled    bool            `json:"proxy_enabled"`
-		ProxyParameters string          `json:"proxy_parameters"`
-		ProxyWhitelist  []string        `json:"proxy_whitelist"`
+		Proxies               []ProxyListItem `json:"proxies"`
+		ProxyEnabled          bool            `json:"proxy_enabled"`
+		ProxyParameters       string          `json:"proxy_parameters"`
+		ProxyWhitelist        []string        `json:"proxy_whitelist"`
+		ReconnectAttempts     *int            `json:"reconnect_attempts"`
+		ReconnectDelaySeconds *int            `json:"reconnect_delay_seconds"`
 	} `json:"livestream"`
 }
 
@@ -62,6 +69,10 @@ type Notification struct {
 	IsLiveWebhookUrl       string `json:"is_live_webhook_url"`
 	IsLiveTemplate         string `json:"is_live_template"`
 	IsLiveEnabled          bool   `json:"is_live_enabled"`
+	// Targets receive notifications in addition to the webhook URLs of the events.
+	Targets             []notification.Target `json:"targets"`
+	RetryAttempts       int                   `json:"retry_attempts"`
+	RetryBackoffSeconds int                   `json:"retry_backoff_seconds"`
 }
 
 type StorageTemplate struct {
@@ -89,6 +100,7 @@ func NewConfig(refresh bool) {
 	viper.SetDefault("video_check_interval_minutes", 180)
 	viper.SetDefault("oauth_enabled", false)
 	viper.SetDefault("registration_enabled", true)
+	viper.SetDefault("require_login", false)
 	viper.SetDefault("parameters.video_convert", "-c:v copy -c:a copy")
 	viper.SetDefault("parameters.chat_render", "-h 1440 -w 340 --framerate 30 --font Inter --font-size 13")
 	viper.SetDefault("parameters.streamlink_live", "--twitch-low-latency,--twitch-disable-hosting")
@@ -107,10 +119,14 @@ func NewConfig(refresh bool) {
 	viper.SetDefault("notifications.is_live_webhook_url", "")
 	viper.SetDefault("notifications.is_live_template", "🔴 {{channel_display_name}} is live!")
 	viper.SetDefault("notifications.is_live_enabled", true)
+	viper.SetDefault("notifications.targets", []notification.Target{})
+	viper.SetDefault("notifications.retry_attempts", 5)
+	viper.SetDefault("notifications.retry_backoff_seconds", 10)
 
 	// Storage Templates
 	viper.SetDefault("storage_templates.folder_template", "{{date}}-{{id}}-{{type}}-{{uuid}}")
 	viper.SetDefault("storage_templates.file_template", "{{id}}")
+	viper.SetDefault("storage_templates.migration_journal_path", "/data/storage-migration.json")
 
 	// Livestream
 	viper.SetDefault("livestream.proxies", []ProxyListItem{
@@ -128,6 +144,30 @@ func NewConfig(refresh bool) {
 	viper.SetDefault("livestream.proxy_whitelist", []string{
 		"",
 	})
+	viper.SetDefault("livestream.reconnect_attempts", 10)
+	viper.SetDefault("livestream.reconnect_delay_seconds", 10)
+
+	// Storage
+	viper.SetDefault("storage.backend", "local")
+	viper.SetDefault("storage.cold_root", "/vods-cold")
+	// the trash is inside the vods volume so videos are moved, not copied, it is not served by nginx.conf or the static routes
+	viper.SetDefault("storage.trash_root", "/vods/.trash")
+	viper.SetDefault("storage.s3.endpoint", "")
+	viper.SetDefault("storage.s3.region", "us-east-1")
+	viper.SetDefault("storage.s3.bucket", "")
+	viper.SetDefault("storage.s3.prefix", "")
+	viper.SetDefault("storage.s3.use_path_style", true)
+	viper.SetDefault("storage.s3.part_size_mb", 64)
+	viper.SetDefault("storage.s3.playback", "presign")
+	viper.SetDefault("storage.s3.presign_expiry_minutes", 360)
+
+	// Integrity
+	viper.SetDefault("integrity.verify_interval_days", 7)
+	viper.SetDefault("integrity.duration_tolerance_seconds", 60)
+	viper.SetDefault("integrity.repair", "none")
+
+	// Trash
+	viper.SetDefault("trash.retention_days", 30)
 
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
 		log.Info().Msgf("config file not found at %s, creating new one", configPath)
@@ -181,9 +221,13 @@ func (s *Service) GetConfig(c echo.Context) (*Conf, error) {
 		}
 		proxyListItems = append(proxyListItems, proxyListItem)
 	}
+	requireLogin := viper.GetBool("require_login")
+	reconnectAttempts := viper.GetInt("livestream.reconnect_attempts")
+	reconnectDelaySeconds := viper.GetInt("livestream.reconnect_delay_seconds")
 
 	return &Conf{
 		RegistrationEnabled: viper.GetBool("registration_enabled"),
+		RequireLogin:        &requireLogin,
 		Archive: struct {
 			SaveAsHls bool `json:"save_as_hls"`
 		}(struct {
@@ -218,26 +262,40 @@ func (s *Service) GetConfig(c echo.Context) (*Conf, error) {
 			FileTemplate:   viper.GetString("storage_templates.file_template"),
 		}),
 		Livestream: struct {
-			Proxies         []ProxyListItem `json:"proxies"`
-			ProxyEnabled    bool            `json:"proxy_enabled"`
-			ProxyParameters string          `json:"proxy_parameters"`
-			ProxyWhitelist  []string        `json:"proxy_whitelist"`
+			Proxies               []ProxyListItem `json:"proxies"`
+			ProxyEnabled          bool            `json:"proxy_enabled"`
+			ProxyParameters       string          `json:"proxy_parameters"`
+			ProxyWhitelist        []string        `json:"proxy_whitelist"`
+			ReconnectAttempts     *int            `json:"reconnect_attempts"`
+			ReconnectDelaySeconds *int            `json:"reconnect_delay_seconds"`
 		}(struct {
-			Proxies         []ProxyListItem
-			ProxyEnabled    bool
-			ProxyParameters string
-			ProxyWhitelist  []string
+			Proxies               []ProxyListItem
+			ProxyEnabled          bool
+			ProxyParameters       string
+			ProxyWhitelist        []string
+			ReconnectAttempts     *int
+			ReconnectDelaySeconds *int
 		}{
-			Proxies:         proxyListItems,
-			ProxyEnabled:    viper.GetBool("livestream.proxy_enabled"),
-			ProxyParameters: viper.GetString("livestream.proxy_parameters"),
-			ProxyWhitelist:  viper.GetStringSlice("livestream.proxy_whitelist"),
+			Proxies:               proxyListItems,
+			ProxyEnabled:          viper.GetBool("livestream.proxy_enabled"),
+			ProxyParameters:       viper.GetString("livestream.proxy_parameters"),
+			ProxyWhitelist:        viper.GetStringSlice("livestream.proxy_whitelist"),
+			ReconnectAttempts:     &reconnectAttempts,
+			ReconnectDelaySeconds: &reconnectDelaySeconds,
 		}),
 	}, nil
 }
 
 func (s *Service) UpdateConfig(c echo.Context, cDto *Conf) error {
+	before, err := s.GetConfig(c)
+	if err != nil {
+		return err
+	}
 	viper.Set("registration_enabled", cDto.RegistrationEnabled)
+	// settings that are not sent are kept, turning off the login requirement must be explicit
+	if cDto.RequireLogin != nil {
+		viper.Set("require_login", *cDto.RequireLogin)
+	}
 	viper.Set("parameters.video_convert", cDto.Parameters.VideoConvert)
 	viper.Set("parameters.chat_render", cDto.Parameters.ChatRender)
 	viper.Set("parameters.streamlink_live", cDto.Parameters.StreamlinkLive)
@@ -255,11 +313,22 @@ func (s *Service) UpdateConfig(c echo.Context, cDto *Conf) error {
 	viper.Set("livestream.proxies", proxyListItems)
 	viper.Set("livestream.proxy_enabled", cDto.Livestream.ProxyEnabled)
 	viper.Set("livestream.proxy_whitelist", cDto.Livestream.ProxyWhitelist)
+	if cDto.Livestream.ReconnectAttempts != nil {
+		viper.Set("livestream.reconnect_attempts", *cDto.Livestream.ReconnectAttempts)
+	}
+	if cDto.Livestream.ReconnectDelaySeconds != nil {
+		viper.Set("livestream.reconnect_delay_seconds", *cDto.Livestream.ReconnectDelaySeconds)
+	}
 
-	err := viper.WriteConfig()
+	err = viper.WriteConfig()
 	if err != nil {
 		return fmt.Errorf("error writing config file: %w", err)
 	}
+	after, err := s.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditConfigUpdate, EntityType: "config", EntityID: "config", Before: before, After: after})
 	return nil
 }
 
@@ -277,6 +346,9 @@ func (s *Service) GetNotificationConfig(c echo.Context) (*Notification, error) {
 		IsLiveWebhookUrl:       viper.GetString("notifications.is_live_webhook_url"),
 		IsLiveTemplate:         viper.GetString("notifications.is_live_template"),
 		IsLiveEnabled:          viper.GetBool("notifications.is_live_enabled"),
+		Targets:                notification.GetTargets(),
+		RetryAttempts:          viper.GetInt("notifications.retry_attempts"),
+		RetryBackoffSeconds:    viper.GetInt("notifications.retry_backoff_seconds"),
 	}, nil
 }
 
@@ -288,6 +360,10 @@ func (s *Service) GetStorageTemplateConfig(c echo.Context) (*StorageTemplate, er
 }
 
 func (s *Service) UpdateNotificationConfig(c echo.Context, nDto *Notification) error {
+	before, err := s.GetNotificationConfig(c)
+	if err != nil {
+		return err
+	}
 	viper.Set("notifications.video_success_webhook_url", nDto.VideoSuccessWebhookUrl)
 	viper.Set("notifications.video_success_template", nDto.VideoSuccessTemplate)
 	viper.Set("notifications.video_success_enabled", nDto.VideoSuccessEnabled)
@@ -300,20 +376,40 @@ func (s *Service) UpdateNotificationConfig(c echo.Context, nDto *Notification) e
 	viper.Set("notifications.is_live_webhook_url", nDto.IsLiveWebhookUrl)
 	viper.Set("notifications.is_live_template", nDto.IsLiveTemplate)
 	viper.Set("notifications.is_live_enabled", nDto.IsLiveEnabled)
-	err := viper.WriteConfig()
+	// settings that are not sent are kept
+	if nDto.Targets != nil {
+		viper.Set("notifications.targets", nDto.Targets)
+	}
+	if nDto.RetryAttempts > 0 {
+		viper.Set("notifications.retry_attempts", nDto.RetryAttempts)
+	}
+	if nDto.RetryBackoffSeconds > 0 {
+		viper.Set("notifications.retry_backoff_seconds", nDto.RetryBackoffSeconds)
+	}
+	err = viper.WriteConfig()
 	if err != nil {
 		return fmt.Errorf("error writing config file: %w", err)
 	}
+	after, err := s.GetNotificationConfig(c)
+	if err != nil {
+		return err
+	}
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditConfigUpdate, EntityType: "config", EntityID: "notifications", Before: before, After: after})
 	return nil
 }
 
 func (s *Service) UpdateStorageTemplateConfig(c echo.Context, stDto *StorageTemplate) error {
+	before, err := s.GetStorageTemplateConfig(c)
+	if err != nil {
+		return err
+	}
 	viper.Set("storage_templates.folder_template", stDto.FolderTemplate)
 	viper.Set("storage_templates.file_template", stDto.FileTemplate)
-	err := viper.WriteConfig()
+	err = viper.WriteConfig()
 	if err != nil {
 		return fmt.Errorf("error writing config file: %w", err)
 	}
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditConfigUpdate, EntityType: "config", EntityID: "storage_templates", Before: before, After: stDto})
 	return nil
 }
 
@@ -327,6 +423,10 @@ func refreshConfig(configPath string) {
 	if !viper.IsSet("oauth_enabled") {
 		viper.Set("oauth_enabled", false)
 	}
+	// require login for read endpoints
+	if !viper.IsSet("require_login") {
+		viper.Set("require_login", false)
+	}
 	// streamlink params
 	if !viper.IsSet("parameters.streamlink_live") {
 		viper.Set("parameters.streamlink_live", "--twitch-low-latency,--twitch-disable-hosting")
@@ -366,6 +466,9 @@ func refreshConfig(configPath string) {
 	if !viper.IsSet("storage_templates.file_template") {
 		viper.Set("storage_templates.file_template", "{{id}}")
 	}
+	if !viper.IsSet("storage_templates.migration_journal_path") {
+		viper.Set("storage_templates.migration_journal_path", "/data/storage-migration.json")
+	}
 	// Twitch Token
 	if !viper.IsSet("parameters.twitch_token") {
 		viper.Set("parameters.twitch_token", "")
@@ -394,6 +497,67 @@ func refreshConfig(configPath string) {
 			"",
 		})
 	}
+	if !viper.IsSet("livestream.reconnect_attempts") {
+		viper.Set("livestream.reconnect_attempts", 10)
+	}
+	if !viper.IsSet("livestream.reconnect_delay_seconds") {
+		viper.Set("livestream.reconnect_delay_seconds", 10)
+	}
+	// Notifications
+	if !viper.IsSet("notifications.targets") {
+		viper.Set("notifications.targets", []notification.Target{})
+	}
+	if !viper.IsSet("notifications.retry_attempts") {
+		viper.Set("notifications.retry_attempts", 5)
+	}
+	if !viper.IsSet("notifications.retry_backoff_seconds") {
+		viper.Set("notifications.retry_backoff_seconds", 10)
+	}
+	// Storage
+	if !viper.IsSet("storage.backend") {
+		viper.Set("storage.backend", "local")
+	}
+	if !viper.IsSet("storage.trash_root") {
+		viper.Set("storage.trash_root", "/vods/.trash")
+	}
+	if !viper.IsSet("storage.s3.endpoint") {
+		viper.Set("storage.s3.endpoint", "")
+	}
+	if !viper.IsSet("storage.s3.region") {
+		viper.Set("storage.s3.region", "us-east-1")
+	}
+	if !viper.IsSet("storage.s3.bucket") {
+		viper.Set("storage.s3.bucket", "")
+	}
+	if !viper.IsSet("storage.s3.prefix") {
+		viper.Set("storage.s3.prefix", "")
+	}
+	if !viper.IsSet("storage.s3.use_path_style") {
+		viper.Set("storage.s3.use_path_style", true)
+	}
+	if !viper.IsSet("storage.s3.part_size_mb") {
+		viper.Set("storage.s3.part_size_mb", 64)
+	}
+	if !viper.IsSet("storage.s3.playback") {
+		viper.Set("storage.s3.playback", "presign")
+	}
+	if !viper.IsSet("storage.s3.presign_expiry_minutes") {
+		viper.Set("storage.s3.presign_expiry_minutes", 360)
+	}
+	// Integrity
+	if !viper.IsSet("integrity.verify_interval_days") {
+		viper.Set("integrity.verify_interval_days", 7)
+	}
+	if !viper.IsSet("integrity.duration_tolerance_seconds") {
+		viper.Set("integrity.duration_tolerance_seconds", 60)
+	}
+	if !viper.IsSet("integrity.repair") {
+		viper.Set("integrity.repair", "none")
+	}
+	// Trash
+	if !viper.IsSet("trash.retention_days") {
+		viper.Set("trash.retention_days", 30)
+	}
 	if !viper.IsSet("video_check_interval_minutes") {
 		viper.Set("video_check_interval_minutes", 180)
 	}
@@ -2,12 +2,14 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"os"
 
 	_ "github.com/lib/pq"
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
+	_ "github.com/zibbp/ganymede/ent/runtime"
 	"github.com/zibbp/ganymede/internal/utils"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -39,6 +41,9 @@ func InitializeDatabase(worker bool) {
 	}
 
 	if !worker {
+		if err := createExtensions(connectionString); err != nil {
+			log.Fatal().Err(err).Msg("error creating database extensions")
+		}
 		// Run auto migration
 		if err := client.Schema.Create(context.Background()); err != nil {
 			log.Fatal().Err(err).Msg("error running auto migration")
@@ -64,6 +69,13 @@ func DB() *Database {
 	return db
 }
 
+// SetDB replaces the database and returns the previous one, e.g. to use a test database in tests.
+func SetDB(d *Database) *Database {
+	previous := db
+	db = d
+	return previous
+}
+
 func NewDatabase() (*Database, error) {
 	log.Debug().Msg("setting up database connection")
 
@@ -84,6 +96,9 @@ func NewDatabase() (*Database, error) {
 		return nil, err
 	}
 
+	if err := createExtensions(connectionString); err != nil {
+		return nil, err
+	}
 	// Run auto migration
 	if err := client.Schema.Create(context.Background()); err != nil {
 		return nil, err
@@ -106,6 +121,20 @@ func NewDatabase() (*Database, error) {
 	return &Database{Client: client}, nil
 }
 
+// createExtensions creates the extensions used by the schema: pg_trgm for the chat search indexes.
+func createExtensions(connectionString string) error {
+	db, err := sql.Open("postgres", connectionString)
+	if err != nil {
+		return fmt.Errorf("error connecting to database: %v", err)
+	}
+	defer db.Close()
+	_, err = db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm")
+	if err != nil {
+		return fmt.Errorf("error creating pg_trgm extension: %v", err)
+	}
+	return nil
+}
+
 func seedDatabase(client *ent.Client) error {
 
 	// Create initial user
@@ -17,4 +17,8 @@ type ArchiveVideoInput struct {
 	LiveWatchChannel          *ent.Live
 	LiveChatWorkflowId        string
 	LiveChatArchiveWorkflowId string
+	// ReplaceVod is the live archive the vod replaces, both are linked as alternates once the vod is archived.
+	ReplaceVod *ent.Vod
+	// DeleteReplacedVod deletes the live archive once the vod is archived.
+	DeleteReplacedVod bool
 }
@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	osExec "os/exec"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -19,16 +20,39 @@ import (
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/config"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/profile"
 	"github.com/zibbp/ganymede/internal/temporal"
-	"github.com/zibbp/ganymede/internal/twitch"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
-func DownloadTwitchVodVideo(v *ent.Vod) error {
+// commandStopTimeout is how long a subprocess has to exit after it was interrupted before it is killed.
+const commandStopTimeout = 30 * time.Second
+
+// liveReconnectResetDuration is how long a live stream segment has to record before the reconnect attempts start over.
+const liveReconnectResetDuration = 5 * time.Minute
+
+// command returns a command that is interrupted when the context is cancelled, e.g. when the activity running it is cancelled.
+// The process is killed if it has not exited commandStopTimeout after the interrupt.
+func command(ctx context.Context, name string, args ...string) *osExec.Cmd {
+	cmd := osExec.CommandContext(ctx, name, args...)
+	cmd.Cancel = func() error {
+		return cmd.Process.Signal(os.Interrupt)
+	}
+	cmd.WaitDelay = commandStopTimeout
+	return cmd
+}
+
+func DownloadTwitchVodVideo(ctx context.Context, v *ent.Vod, progress *ProgressTracker) error {
+
+	videoURL := fmt.Sprintf("https://twitch.tv/videos/%s", v.ExtID)
+	if v.Type == utils.Clip {
+		videoURL = fmt.Sprintf("https://clips.twitch.tv/%s", v.ExtID)
+	}
 
 	var argArr []string
 	// Check if twitch token is set
-	argArr = append(argArr, fmt.Sprintf("https://twitch.tv/videos/%s", v.ExtID), fmt.Sprintf("%s,best", v.Resolution), "--force-progress", "--force")
+	argArr = append(argArr, videoURL, fmt.Sprintf("%s,best", v.Resolution), "--force-progress", "--force")
 
 	twitchToken := viper.GetString("parameters.twitch_token")
 	if twitchToken != "" {
@@ -40,7 +64,7 @@ func DownloadTwitchVodVideo(v *ent.Vod) error {
 
 	log.Debug().Msgf("running streamlink for vod video download: %s", strings.Join(argArr, " "))
 
-	cmd := osExec.Command("streamlink", argArr...)
+	cmd := command(ctx, "streamlink", argArr...)
 
 	videoLogfile, err := os.Create(fmt.Sprintf("/logs/%s-video.log", v.ID))
 	if err != nil {
@@ -48,8 +72,9 @@ func DownloadTwitchVodVideo(v *ent.Vod) error {
 	}
 
 	defer videoLogfile.Close()
-	cmd.Stdout = videoLogfile
-	cmd.Stderr = videoLogfile
+	output := io.MultiWriter(videoLogfile, progress.writer(parseStreamlinkProgress))
+	cmd.Stdout = output
+	cmd.Stderr = output
 
 	if err := cmd.Run(); err != nil {
 		if exitError, ok := err.(*osExec.ExitError); ok {
@@ -63,16 +88,65 @@ func DownloadTwitchVodVideo(v *ent.Vod) error {
 	return nil
 }
 
-func DownloadTwitchVodChat(v *ent.Vod) error {
-	cmd := osExec.Command("TwitchDownloaderCLI", "chatdownload", "--id", v.ExtID, "--embed-images", "-o", v.TmpChatDownloadPath)
+// qualityHeightRegex matches the height of a quality, e.g. 720 in 720p60.
+var qualityHeightRegex = regexp.MustCompile(`^\d+`)
+
+// DownloadYoutubeVideo downloads a YouTube video using yt-dlp. The resolution is used as a maximum height.
+func DownloadYoutubeVideo(ctx context.Context, v *ent.Vod, progress *ProgressTracker) error {
+
+	// qualities are Twitch style (e.g. 720p60), only the height is used
+	height := qualityHeightRegex.FindString(v.Resolution)
+
+	var format string
+	switch {
+	case v.Resolution == "audio":
+		// prefer AAC so the audio fits the m4a container without converting it
+		format = "ba[ext=m4a]/ba"
+	case height == "":
+		format = "bv*+ba/b"
+	default:
+		format = fmt.Sprintf("bv*[height<=%s]+ba/b[height<=%s]", height, height)
+	}
+
+	argArr := []string{fmt.Sprintf("https://www.youtube.com/watch?v=%s", v.ExtID), "-f", format, "--merge-output-format", "mp4", "--no-playlist", "--newline", "--force-overwrites", "-o", v.TmpVideoDownloadPath}
+
+	log.Debug().Msgf("running yt-dlp for youtube video download: %s", strings.Join(argArr, " "))
+
+	cmd := command(ctx, "yt-dlp", argArr...)
+
+	videoLogfile, err := os.Create(fmt.Sprintf("/logs/%s-video.log", v.ID))
+	if err != nil {
+		return fmt.Errorf("error creating video logfile: %w", err)
+	}
+
+	defer videoLogfile.Close()
+	output := io.MultiWriter(videoLogfile, progress.writer(parseYtDlpProgress))
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	if err := cmd.Run(); err != nil {
+		if exitError, ok := err.(*osExec.ExitError); ok {
+			log.Error().Err(err).Msg("error running yt-dlp for youtube video download")
+			return fmt.Errorf("error running yt-dlp for youtube video download with exit code %d: %w", exitError.ExitCode(), exitError)
+		}
+		return fmt.Errorf("error running yt-dlp for youtube video download: %w", err)
+	}
+
+	log.Debug().Msgf("finished downloading youtube video for %s", v.ExtID)
+	return nil
+}
+
+func DownloadTwitchVodChat(ctx context.Context, v *ent.Vod, progress *ProgressTracker) error {
+	cmd := command(ctx, "TwitchDownloaderCLI", "chatdownload", "--id", v.ExtID, "--embed-images", "-o", v.TmpChatDownloadPath)
 
 	chatLogfile, err := os.Create(fmt.Sprintf("/logs/%s-chat.log", v.ID))
 	if err != nil {
 		return fmt.Errorf("error creating chat logfile: %w", err)
 	}
 	defer chatLogfile.Close()
-	cmd.Stdout = chatLogfile
-	cmd.Stderr = chatLogfile
+	output := io.MultiWriter(chatLogfile, progress.writer(parseTwitchDownloaderProgress))
+	cmd.Stdout = output
+	cmd.Stderr = output
 
 	if err := cmd.Run(); err != nil {
 		if exitError, ok := err.(*osExec.ExitError); ok {
@@ -87,9 +161,13 @@ func DownloadTwitchVodChat(v *ent.Vod) error {
 	return nil
 }
 
-func RenderTwitchVodChat(v *ent.Vod) (error, bool) {
+func RenderTwitchVodChat(ctx context.Context, v *ent.Vod, progress *ProgressTracker) (error, bool) {
 	// Fetch config params
-	chatRenderParams := viper.GetString("parameters.chat_render")
+	settings, err := profile.GetVodSettings(ctx, v.ID)
+	if err != nil {
+		return err, false
+	}
+	chatRenderParams := settings.ChatRender
 	// Split supplied params into array
 	arr := strings.Fields(chatRenderParams)
 	// Generate args for exec
@@ -100,15 +178,16 @@ func RenderTwitchVodChat(v *ent.Vod) (error, bool) {
 	argArr = append(argArr, "-o", v.TmpChatRenderPath)
 	log.Debug().Msgf("chat render args: %v", argArr)
 	// Execute chat render
-	cmd := osExec.Command("TwitchDownloaderCLI", argArr...)
+	cmd := command(ctx, "TwitchDownloaderCLI", argArr...)
 
 	chatRenderLogfile, err := os.Create(fmt.Sprintf("/logs/%s-chat-render.log", v.ID))
 	if err != nil {
 		return fmt.Errorf("error creating chat render logfile: %w", err), true
 	}
 	defer chatRenderLogfile.Close()
-	cmd.Stdout = chatRenderLogfile
-	cmd.Stderr = chatRenderLogfile
+	output := io.MultiWriter(chatRenderLogfile, progress.writer(parseTwitchDownloaderProgress))
+	cmd.Stdout = output
+	cmd.Stderr = output
 
 	if err := cmd.Run(); err != nil {
 		if exitError, ok := err.(*osExec.ExitError); ok {
@@ -116,6 +195,9 @@ func RenderTwitchVodChat(v *ent.Vod) (error, bool) {
 			return fmt.Errorf("error running TwitchDownloaderCLI for vod chat render with exit code %d: %w", exitError.ExitCode(), exitError), true
 		}
 		log.Error().Err(err).Msg("error running TwitchDownloaderCLI for vod chat render")
+		if ctx.Err() != nil {
+			return ctx.Err(), true
+		}
 
 		// Check if error is because of no messages
 		checkCmd := fmt.Sprintf("cat /logs/%s-chat-render.log | grep 'Sequence contains no elements'", v.ID)
@@ -136,9 +218,13 @@ func RenderTwitchVodChat(v *ent.Vod) (error, bool) {
 	return nil, true
 }
 
-func ConvertTwitchVodVideo(v *ent.Vod) error {
+func ConvertTwitchVodVideo(ctx context.Context, v *ent.Vod, progress *ProgressTracker) error {
 	// Fetch config params
-	ffmpegParams := viper.GetString("parameters.video_convert")
+	settings, err := profile.GetVodSettings(ctx, v.ID)
+	if err != nil {
+		return err
+	}
+	ffmpegParams := settings.VideoConvert
 	// Split supplied params into array
 	arr := strings.Fields(ffmpegParams)
 	// Generate args for exec
@@ -149,7 +235,7 @@ func ConvertTwitchVodVideo(v *ent.Vod) error {
 	argArr = append(argArr, v.TmpVideoConvertPath)
 	log.Debug().Msgf("video convert args: %v", argArr)
 	// Execute ffmpeg
-	cmd := osExec.Command("ffmpeg", argArr...)
+	cmd := command(ctx, "ffmpeg", argArr...)
 
 	videoConvertLogfile, err := os.Create(fmt.Sprintf("/logs/%s-video-convert.log", v.ID))
 	if err != nil {
@@ -157,8 +243,9 @@ func ConvertTwitchVodVideo(v *ent.Vod) error {
 		return err
 	}
 	defer videoConvertLogfile.Close()
-	cmd.Stdout = videoConvertLogfile
-	cmd.Stderr = videoConvertLogfile
+	output := io.MultiWriter(videoConvertLogfile, progress.writer(newFfmpegProgressParser()))
+	cmd.Stdout = output
+	cmd.Stderr = output
 
 	if err := cmd.Run(); err != nil {
 		log.Error().Err(err).Msg("error running ffmpeg for vod video convert")
@@ -169,7 +256,7 @@ func ConvertTwitchVodVideo(v *ent.Vod) error {
 	return nil
 }
 
-func ConvertToHLS(v *ent.Vod) error {
+func ConvertToHLS(ctx context.Context, v *ent.Vod, progress *ProgressTracker) error {
 	// Delete original video file to save space
 	log.Debug().Msgf("deleting original video file for %s to save space", v.ExtID)
 	if err := os.Remove(v.TmpVideoDownloadPath); err != nil {
@@ -177,16 +264,18 @@ func ConvertToHLS(v *ent.Vod) error {
 		return err
 	}
 
-	cmd := osExec.Command("ffmpeg", "-y", "-hide_banner", "-i", v.TmpVideoConvertPath, "-c", "copy", "-start_number", "0", "-hls_time", "10", "-hls_list_size", "0", "-hls_segment_filename", fmt.Sprintf("/tmp/%s_%s-video_hls%s/%s_segment%s.ts", v.ExtID, v.ID, "%v", v.ExtID, "%d"), "-f", "hls", fmt.Sprintf("/tmp/%s_%s-video_hls%s/%s-video.m3u8", v.ExtID, v.ID, "%v", v.ExtID))
+	cmd := command(ctx, "ffmpeg", "-y", "-hide_banner", "-i", v.TmpVideoConvertPath, "-c", "copy", "-start_number", "0", "-hls_time", "10", "-hls_list_size", "0", "-hls_segment_filename", fmt.Sprintf("/tmp/%s_%s-video_hls%s/%s_segment%s.ts", v.ExtID, v.ID, "%v", v.ExtID, "%d"), "-f", "hls", fmt.Sprintf("/tmp/%s_%s-video_hls%s/%s-video.m3u8", v.ExtID, v.ID, "%v", v.ExtID))
 
-	videoConverLogFile, err := os.Open(fmt.Sprintf("/logs/%s-video-convert.log", v.ID))
+	// the output is appended to the log of the video convert
+	videoConverLogFile, err := os.OpenFile(fmt.Sprintf("/logs/%s-video-convert.log", v.ID), os.O_APPEND|os.O_WRONLY, 0644)
 	if err != nil {
 		log.Error().Err(err).Msg("error opening video convert logfile")
 		return err
 	}
 	defer videoConverLogFile.Close()
-	cmd.Stdout = videoConverLogFile
-	cmd.Stderr = videoConverLogFile
+	output := io.MultiWriter(videoConverLogFile, progress.writer(newFfmpegProgressParser()))
+	cmd.Stdout = output
+	cmd.Stderr = output
 
 	if err := cmd.Run(); err != nil {
 		log.Error().Err(err).Msg("error running ffmpeg for vod video convert - hls")
@@ -198,9 +287,17 @@ func ConvertToHLS(v *ent.Vod) error {
 
 }
 
-func DownloadTwitchLiveVideo(ctx context.Context, v *ent.Vod, ch *ent.Channel, liveChatWorkflowId string) error {
+func DownloadTwitchLiveVideo(ctx context.Context, v *ent.Vod, ch *ent.Channel, liveChatWorkflowId string, progress *ProgressTracker) error {
 	// Fetch config params
-	liveStreamlinkParams := viper.GetString("parameters.streamlink_live")
+	settings, err := profile.GetVodSettings(ctx, v.ID)
+	if err != nil {
+		return err
+	}
+	p, err := platform.Get(ch.Platform)
+	if err != nil {
+		return err
+	}
+	liveStreamlinkParams := settings.StreamlinkLive
 	// Split supplied params into array
 	splitStreamlinkParams := strings.Split(liveStreamlinkParams, ",")
 	// remove param if contains 'twith-api-header' (set by different config value)
@@ -218,6 +315,14 @@ func DownloadTwitchLiveVideo(ctx context.Context, v *ent.Vod, ch *ent.Channel, l
 	// check if user has proxies enabled
 	proxyEnabled := viper.GetBool("livestream.proxy_enabled")
 	whitelistedChannels := viper.GetStringSlice("livestream.proxy_whitelist")
+	// the archive profile can force proxies on or off
+	switch settings.ProxyPolicy {
+	case utils.ProxyPolicyEnabled:
+		proxyEnabled = true
+		whitelistedChannels = nil
+	case utils.ProxyPolicyDisabled:
+		proxyEnabled = false
+	}
 	if proxyEnabled {
 		// check if channel is whitelisted
 		if utils.Contains(whitelistedChannels, ch.Name) {
@@ -258,7 +363,7 @@ func DownloadTwitchLiveVideo(ctx context.Context, v *ent.Vod, ch *ent.Channel, l
 	configTwitchToken := viper.GetString("parameters.twitch_token")
 	if configTwitchToken != "" {
 		// check token is valid
-		err := twitch.CheckUserAccessToken(configTwitchToken)
+		err := p.CheckAccessToken(configTwitchToken)
 		if err != nil {
 			log.Error().Err(err).Msg("error checking twitch token")
 		} else {
@@ -302,10 +407,7 @@ func DownloadTwitchLiveVideo(ctx context.Context, v *ent.Vod, ch *ent.Channel, l
 		}
 	}
 
-	cmdArgs := append(filteredArgs, "-o", v.TmpVideoDownloadPath)
-
-	log.Debug().Msgf("streamlink live args: %v", cmdArgs)
-	log.Debug().Msgf("running: streamlink %s", strings.Join(cmdArgs, " "))
+	log.Debug().Msgf("streamlink live args: %v", filteredArgs)
 
 	// Start chat download workflow if liveChatWorkflowId is set (chat is being archived)
 	if liveChatWorkflowId != "" {
@@ -323,31 +425,98 @@ func DownloadTwitchLiveVideo(ctx context.Context, v *ent.Vod, ch *ent.Channel, l
 		}
 	}
 
-	// Execute streamlink
-	cmd := osExec.Command("streamlink", cmdArgs...)
-
 	videoLogfile, err := os.Create(fmt.Sprintf("/logs/%s-video.log", v.ID))
 	if err != nil {
 		log.Error().Err(err).Msg("error creating video logfile")
 		return err
 	}
 	defer videoLogfile.Close()
-	cmd.Stderr = videoLogfile
-	var stdout bytes.Buffer
 
-	multiWriterStdout := io.MultiWriter(videoLogfile, &stdout)
+	reconnectAttempts := viper.GetInt("livestream.reconnect_attempts")
+	reconnectDelay := time.Duration(viper.GetInt("livestream.reconnect_delay_seconds")) * time.Second
+
+	// streamlink is restarted if it exits while the channel is still live
+	// each run is recorded to a numbered segment which are concatenated during post processing
+	// the journal is written before recording so a stream without any segment is not mistaken for a single recording
+	segments := []LiveVideoSegment{}
+	if err := writeLiveVideoSegments(v, segments); err != nil {
+		return err
+	}
+	// attempt counts the consecutive reconnects, it is reset once a segment recorded long enough
+	for attempt := 0; ; attempt++ {
+		// the download was stopped while reconnecting
+		if ctx.Err() != nil {
+			break
+		}
 
-	cmd.Stdout = multiWriterStdout
+		segment := LiveVideoSegment{
+			Path:      liveVideoSegmentPath(v, len(segments)),
+			StartedAt: time.Now(),
+		}
+
+		cmdArgs := append(filteredArgs, "-o", segment.Path)
+		log.Debug().Msgf("running: streamlink %s", strings.Join(cmdArgs, " "))
+
+		// Execute streamlink
+		// streamlink is interrupted when the download is stopped and finishes writing the segment
+		cmd := command(ctx, "streamlink", cmdArgs...)
+		cmd.Stderr = io.MultiWriter(videoLogfile, progress.writer(parseStreamlinkProgress))
+		var stdout bytes.Buffer
+
+		multiWriterStdout := io.MultiWriter(videoLogfile, &stdout)
+
+		cmd.Stdout = multiWriterStdout
+
+		err := cmd.Run()
+		segment.EndedAt = time.Now()
+
+		if utils.FileExists(segment.Path) {
+			segments = append(segments, segment)
+			if err := writeLiveVideoSegments(v, segments); err != nil {
+				return err
+			}
+			if segment.EndedAt.Sub(segment.StartedAt) >= liveReconnectResetDuration {
+				attempt = 0
+			}
+		}
+
+		if err == nil {
+			break
+		}
 
-	if err := cmd.Run(); err != nil {
 		// Streamlink will error when the stream is offline - do not log this as an error
-		log.Debug().Msgf("finished downloading live video for %s - %s", v.ExtID, err.Error())
+		log.Debug().Msgf("streamlink exited while downloading live video for %s - %s", v.ExtID, err.Error())
 		log.Debug().Msgf("streamlink live stdout: %s", stdout.String())
-		if strings.Contains(stdout.String(), "No playable streams found on this URL") {
+		if len(segments) == 0 && strings.Contains(stdout.String(), "No playable streams found on this URL") {
 			log.Error().Msgf("no playable streams found on this URL for %s", v.ExtID)
 			return utils.NewLiveVideoDownloadNoStreamError("no playable streams found on this URL")
 		}
-		return nil
+
+		// archive was stopped
+		if streamlinkInterrupted(err) || ctx.Err() != nil {
+			break
+		}
+
+		if attempt >= reconnectAttempts {
+			log.Warn().Msgf("reached the maximum of %d reconnect attempts for %s", reconnectAttempts, v.ExtID)
+			break
+		}
+
+		// only reconnect if the channel is still live
+		streams, err := p.GetLiveStreams([]string{ch.Name})
+		if err != nil {
+			log.Error().Err(err).Msgf("error checking if %s is still live", ch.Name)
+			break
+		}
+		if len(streams) == 0 {
+			break
+		}
+
+		log.Warn().Msgf("streamlink exited while %s is still live, reconnecting in %s (attempt %d/%d)", ch.Name, reconnectDelay, attempt+1, reconnectAttempts)
+		select {
+		case <-ctx.Done():
+		case <-time.After(reconnectDelay):
+		}
 	}
 
 	log.Debug().Msgf("finished downloading live video for %s", v.ExtID)
@@ -364,7 +533,8 @@ func DownloadTwitchLiveChat(ctx context.Context, v *ent.Vod, ch *ent.Channel, q
 		return err
 	}
 
-	cmd := osExec.Command("chat_downloader", fmt.Sprintf("https://twitch.tv/%s", ch.Name), "--output", v.TmpLiveChatDownloadPath, "-q")
+	// chat_downloader runs until the download is stopped
+	cmd := command(ctx, "chat_downloader", fmt.Sprintf("https://twitch.tv/%s", ch.Name), "--output", v.TmpLiveChatDownloadPath, "-q")
 
 	chatLogfile, err := os.Create(fmt.Sprintf("/logs/%s-chat.log", v.ID))
 	if err != nil {
@@ -437,9 +607,9 @@ func GetFfprobeData(path string) (map[string]interface{}, error) {
 	return data, nil
 }
 
-func TwitchChatUpdate(v *ent.Vod) error {
+func TwitchChatUpdate(ctx context.Context, v *ent.Vod) error {
 
-	cmd := osExec.Command("TwitchDownloaderCLI", "chatupdate", "-i", v.TmpLiveChatConvertPath, "--embed-missing", "-o", v.TmpChatDownloadPath)
+	cmd := command(ctx, "TwitchDownloaderCLI", "chatupdate", "-i", v.TmpLiveChatConvertPath, "--embed-missing", "-o", v.TmpChatDownloadPath)
 
 	chatLogfile, err := os.Create(fmt.Sprintf("/logs/%s-chat-convert.log", v.ID))
 	if err != nil {
@@ -490,3 +660,56 @@ func testProxyServer(url string, header string) bool {
 	log.Debug().Msg("proxy server test successful")
 	return true
 }
+
+// ProbeVideoDuration returns the duration of a video in seconds. The error holds the output of ffprobe if the video can't be read.
+func ProbeVideoDuration(ctx context.Context, path string) (float64, error) {
+	cmd := command(ctx, "ffprobe", "-v", "error", "-show_entries", "format=duration", "-of", "default=noprint_wrappers=1:nokey=1", path)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return 0, fmt.Errorf("error running ffprobe: %s", firstLine(stderr.String(), err))
+	}
+	duration, err := strconv.ParseFloat(strings.TrimSpace(string(out)), 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing video duration %q", strings.TrimSpace(string(out)))
+	}
+	return duration, nil
+}
+
+// CheckVideoEnd decodes the last seconds of a video and returns an error if the decoder reports errors, e.g. because the file is truncated.
+func CheckVideoEnd(ctx context.Context, path string, seconds int) error {
+	cmd := command(ctx, "ffmpeg", "-hide_banner", "-v", "error", "-sseof", fmt.Sprintf("-%d", seconds), "-i", path, "-f", "null", "-")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil || strings.TrimSpace(stderr.String()) != "" {
+		return fmt.Errorf("error decoding end of video: %s", firstLine(stderr.String(), err))
+	}
+	return nil
+}
+
+// RemuxVideo copies the streams of a video into a new container at outputPath.
+// Remuxing rebuilds the index of a video and drops broken packets, so the output must be checked before it replaces the video.
+func RemuxVideo(ctx context.Context, path string, outputPath string) error {
+	cmd := command(ctx, "ffmpeg", "-y", "-hide_banner", "-v", "error", "-err_detect", "ignore_err", "-i", path, "-c", "copy", "-movflags", "+faststart", outputPath)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		os.Remove(outputPath)
+		return fmt.Errorf("error remuxing video: %s", firstLine(stderr.String(), err))
+	}
+	return nil
+}
+
+// firstLine returns the first line of the output of a command, or the error if there is no output.
+func firstLine(output string, err error) string {
+	output = strings.TrimSpace(output)
+	if output == "" {
+		if err != nil {
+			return err.Error()
+		}
+		return "unknown error"
+	}
+	return strings.SplitN(output, "\n", 2)[0]
+}
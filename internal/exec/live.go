@@ -0,0 +1,222 @@
+package exec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	osExec "os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/platform"
+)
+
+// LiveVideoSegment is a single streamlink recording of a live stream.
+// A live stream is recorded to multiple segments when streamlink is restarted after an unexpected exit.
+type LiveVideoSegment struct {
+	Path      string    `json:"path"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+}
+
+// liveVideoSegmentPath returns the temporary path of the n-th recorded segment of a live stream.
+func liveVideoSegmentPath(v *ent.Vod, n int) string {
+	ext := filepath.Ext(v.TmpVideoDownloadPath)
+	return fmt.Sprintf("%s-segment%03d%s", strings.TrimSuffix(v.TmpVideoDownloadPath, ext), n, ext)
+}
+
+// liveVideoSegmentsJournalPath returns the path of the file the recorded segments of a live stream are tracked in.
+func liveVideoSegmentsJournalPath(v *ent.Vod) string {
+	ext := filepath.Ext(v.TmpVideoDownloadPath)
+	return fmt.Sprintf("%s-segments.json", strings.TrimSuffix(v.TmpVideoDownloadPath, ext))
+}
+
+func writeLiveVideoSegments(v *ent.Vod, segments []LiveVideoSegment) error {
+	data, err := json.Marshal(segments)
+	if err != nil {
+		return fmt.Errorf("error marshalling live video segments: %v", err)
+	}
+	err = os.WriteFile(liveVideoSegmentsJournalPath(v), data, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing live video segments: %v", err)
+	}
+	return nil
+}
+
+// readLiveVideoSegments returns the recorded segments of a live stream. Nil is returned if the video was not recorded in segments.
+func readLiveVideoSegments(v *ent.Vod) ([]LiveVideoSegment, error) {
+	data, err := os.ReadFile(liveVideoSegmentsJournalPath(v))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading live video segments: %v", err)
+	}
+	segments := []LiveVideoSegment{}
+	err = json.Unmarshal(data, &segments)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling live video segments: %v", err)
+	}
+	// journals without segments were written as null
+	if segments == nil {
+		segments = []LiveVideoSegment{}
+	}
+	return segments, nil
+}
+
+// streamlinkInterrupted reports if streamlink exited because it was interrupted (i.e. the archive was stopped by the user).
+func streamlinkInterrupted(err error) bool {
+	var exitErr *osExec.ExitError
+	if !errors.As(err, &exitErr) {
+		return false
+	}
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return true
+	}
+	// streamlink exits with 130 when it receives SIGINT
+	return exitErr.ExitCode() == 130
+}
+
+// GetLiveVideoDuration returns the duration of a downloaded live stream.
+// If the stream was recorded in segments the duration of all segments is returned.
+func GetLiveVideoDuration(v *ent.Vod) (int, error) {
+	segments, err := readLiveVideoSegments(v)
+	if err != nil {
+		return 1, err
+	}
+	if segments == nil {
+		return GetVideoDuration(v.TmpVideoDownloadPath)
+	}
+	duration := 0
+	for _, segment := range segments {
+		segmentDuration, err := GetVideoDuration(segment.Path)
+		if err != nil {
+			return 1, err
+		}
+		duration += segmentDuration
+	}
+	return duration, nil
+}
+
+// ConcatLiveVideoSegments concatenates the recorded segments of a live stream into the download path.
+// The reconnects are returned as muted segments at the positions the segments were joined, lasting for the time lost while reconnecting.
+// Nothing is done if the live stream was not recorded in segments.
+func ConcatLiveVideoSegments(ctx context.Context, v *ent.Vod) ([]platform.MutedSegment, error) {
+	segments, err := readLiveVideoSegments(v)
+	if err != nil {
+		return nil, err
+	}
+	if segments == nil {
+		return nil, nil
+	}
+	// streamlink creates the output file before the stream is opened, a failed reconnect leaves an empty segment
+	segments = removeEmptyLiveVideoSegments(segments)
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("no live video segments were recorded")
+	}
+
+	durations := make([]int, len(segments))
+	for i, segment := range segments {
+		durations[i], err = GetVideoDuration(segment.Path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	gaps := liveVideoGaps(segments, durations)
+
+	if len(segments) == 1 {
+		if err := os.Rename(segments[0].Path, v.TmpVideoDownloadPath); err != nil {
+			return nil, fmt.Errorf("error renaming live video segment: %v", err)
+		}
+	} else {
+		log.Debug().Msgf("concatenating %d live video segments for %s", len(segments), v.ExtID)
+
+		listPath := fmt.Sprintf("%s.txt", strings.TrimSuffix(liveVideoSegmentsJournalPath(v), ".json"))
+		var list strings.Builder
+		for _, segment := range segments {
+			list.WriteString(fmt.Sprintf("file '%s'\n", segment.Path))
+		}
+		if err := os.WriteFile(listPath, []byte(list.String()), 0644); err != nil {
+			return nil, fmt.Errorf("error writing live video segment list: %v", err)
+		}
+
+		cmd := command(ctx, "ffmpeg", "-y", "-hide_banner", "-f", "concat", "-safe", "0", "-i", listPath, "-c", "copy", v.TmpVideoDownloadPath)
+
+		videoConcatLogfile, err := os.Create(fmt.Sprintf("/logs/%s-video-concat.log", v.ID))
+		if err != nil {
+			log.Error().Err(err).Msg("error creating video concat logfile")
+			return nil, err
+		}
+		defer videoConcatLogfile.Close()
+		cmd.Stdout = videoConcatLogfile
+		cmd.Stderr = videoConcatLogfile
+
+		if err := cmd.Run(); err != nil {
+			log.Error().Err(err).Msg("error running ffmpeg for live video concat")
+			return nil, err
+		}
+
+		for _, segment := range segments {
+			if err := os.Remove(segment.Path); err != nil {
+				log.Error().Err(err).Msgf("error deleting live video segment %s", segment.Path)
+			}
+		}
+		if err := os.Remove(listPath); err != nil {
+			log.Error().Err(err).Msg("error deleting live video segment list")
+		}
+	}
+
+	if err := os.Remove(liveVideoSegmentsJournalPath(v)); err != nil {
+		log.Error().Err(err).Msg("error deleting live video segments journal")
+	}
+
+	log.Debug().Msgf("finished concatenating live video segments for %s", v.ExtID)
+	return gaps, nil
+}
+
+// removeEmptyLiveVideoSegments deletes the segments without any data and returns the others.
+func removeEmptyLiveVideoSegments(segments []LiveVideoSegment) []LiveVideoSegment {
+	var kept []LiveVideoSegment
+	for _, segment := range segments {
+		info, err := os.Stat(segment.Path)
+		if err == nil && info.Size() > 0 {
+			kept = append(kept, segment)
+			continue
+		}
+		log.Warn().Msgf("skipping empty live video segment %s", segment.Path)
+		if err == nil {
+			if err := os.Remove(segment.Path); err != nil {
+				log.Error().Err(err).Msgf("error deleting live video segment %s", segment.Path)
+			}
+		}
+	}
+	return kept
+}
+
+// liveVideoGaps returns the reconnects between the given segments and their durations as muted segments in the concatenated video.
+// A gap starts at the cumulative duration of the segments before it and lasts for the time lost between the end of a segment and the start of the next one.
+// Gaps are at least a second long so every reconnect stays visible.
+func liveVideoGaps(segments []LiveVideoSegment, durations []int) []platform.MutedSegment {
+	gaps := []platform.MutedSegment{}
+	offset := 0
+	for i, duration := range durations {
+		offset += duration
+		if i+1 < len(durations) {
+			lost := int(segments[i+1].StartedAt.Sub(segments[i].EndedAt).Seconds())
+			if segments[i].EndedAt.IsZero() || segments[i+1].StartedAt.IsZero() || lost < 1 {
+				lost = 1
+			}
+			gaps = append(gaps, platform.MutedSegment{
+				Start: offset,
+				End:   offset + lost,
+			})
+		}
+	}
+	return gaps
+}
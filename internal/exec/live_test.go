@@ -0,0 +1,105 @@
+package exec
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/platform"
+)
+
+func TestLiveVideoGaps(t *testing.T) {
+	start := time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)
+	at := func(seconds int) time.Time {
+		return start.Add(time.Duration(seconds) * time.Second)
+	}
+	tests := []struct {
+		name      string
+		segments  []LiveVideoSegment
+		durations []int
+		want      []platform.MutedSegment
+	}{
+		{name: "single segment", segments: []LiveVideoSegment{{StartedAt: at(0), EndedAt: at(3600)}}, durations: []int{3600}, want: []platform.MutedSegment{}},
+		{name: "one reconnect", segments: []LiveVideoSegment{{StartedAt: at(0), EndedAt: at(600)}, {StartedAt: at(630), EndedAt: at(1830)}}, durations: []int{600, 1200}, want: []platform.MutedSegment{{Start: 600, End: 630}}},
+		{name: "reconnects are at the cumulative duration", segments: []LiveVideoSegment{{StartedAt: at(0), EndedAt: at(600)}, {StartedAt: at(610), EndedAt: at(1810)}, {StartedAt: at(1870), EndedAt: at(2170)}, {StartedAt: at(2175), EndedAt: at(2235)}}, durations: []int{600, 1200, 300, 60}, want: []platform.MutedSegment{{Start: 600, End: 610}, {Start: 1800, End: 1860}, {Start: 2100, End: 2105}}},
+		{name: "gaps are at least a second", segments: []LiveVideoSegment{{StartedAt: at(0), EndedAt: at(600)}, {StartedAt: at(600), EndedAt: at(1200)}}, durations: []int{600, 600}, want: []platform.MutedSegment{{Start: 600, End: 601}}},
+		{name: "segments without times", segments: []LiveVideoSegment{{}, {}}, durations: []int{600, 600}, want: []platform.MutedSegment{{Start: 600, End: 601}}},
+		{name: "no segments", segments: nil, durations: nil, want: []platform.MutedSegment{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gaps := liveVideoGaps(tt.segments, tt.durations)
+			assert.Equal(t, tt.want, gaps)
+			for _, gap := range gaps {
+				assert.Greater(t, gap.End, gap.Start)
+			}
+		})
+	}
+}
+
+func TestRemoveEmptyLiveVideoSegments(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, data string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(path, []byte(data), 0644))
+		return path
+	}
+	recorded := write("recorded.mp4", "video")
+	empty := write("empty.mp4", "")
+	missing := filepath.Join(dir, "missing.mp4")
+
+	tests := []struct {
+		name     string
+		segments []LiveVideoSegment
+		want     []LiveVideoSegment
+	}{
+		{name: "recorded", segments: []LiveVideoSegment{{Path: recorded}}, want: []LiveVideoSegment{{Path: recorded}}},
+		{name: "empty and missing are skipped", segments: []LiveVideoSegment{{Path: empty}, {Path: recorded}, {Path: missing}}, want: []LiveVideoSegment{{Path: recorded}}},
+		{name: "nothing recorded", segments: []LiveVideoSegment{{Path: missing}}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, removeEmptyLiveVideoSegments(tt.segments))
+		})
+	}
+
+	// empty segments are deleted
+	assert.NoFileExists(t, empty)
+	assert.FileExists(t, recorded)
+}
+
+func TestConcatLiveVideoSegmentsWithoutRecording(t *testing.T) {
+	dir := t.TempDir()
+	v := &ent.Vod{TmpVideoDownloadPath: filepath.Join(dir, "video.mp4")}
+
+	tests := []struct {
+		name    string
+		journal string
+		wantErr bool
+	}{
+		{name: "no journal", journal: "", wantErr: false},
+		{name: "no segments", journal: "[]", wantErr: true},
+		{name: "no segments written as null", journal: "null", wantErr: true},
+		{name: "only an empty segment", journal: `[{"path":"` + liveVideoSegmentPath(v, 0) + `"}]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Remove(liveVideoSegmentsJournalPath(v))
+			if tt.journal != "" {
+				assert.NoError(t, os.WriteFile(liveVideoSegmentsJournalPath(v), []byte(tt.journal), 0644))
+				assert.NoError(t, os.WriteFile(liveVideoSegmentPath(v, 0), nil, 0644))
+			}
+			gaps, err := ConcatLiveVideoSegments(context.Background(), v)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Nil(t, gaps)
+			}
+		})
+	}
+}
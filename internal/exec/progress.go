@@ -0,0 +1,228 @@
+package exec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ProgressTracker holds the latest progress parsed from the output of a command.
+// A nil tracker is valid and discards the progress.
+type ProgressTracker struct {
+	mu       sync.Mutex
+	progress *utils.Progress
+}
+
+func NewProgressTracker() *ProgressTracker {
+	return &ProgressTracker{}
+}
+
+// Progress returns the latest progress or nil if none was parsed yet.
+func (t *ProgressTracker) Progress() *utils.Progress {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.progress == nil {
+		return nil
+	}
+	p := *t.progress
+	return &p
+}
+
+func (t *ProgressTracker) set(p utils.Progress) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.progress = &p
+}
+
+// progressParser updates the progress from a line of output. It returns false if the line holds no progress.
+type progressParser func(line string, p *utils.Progress) bool
+
+// writer returns a writer parsing the progress from the output written to it.
+func (t *ProgressTracker) writer(parse progressParser) io.Writer {
+	if t == nil {
+		return io.Discard
+	}
+	return &progressWriter{tracker: t, parse: parse}
+}
+
+// progressWriter splits output into lines. Progress bars end their lines with a carriage return so both \r and \n end a line.
+type progressWriter struct {
+	tracker  *ProgressTracker
+	parse    progressParser
+	progress utils.Progress
+	buf      []byte
+}
+
+func (w *progressWriter) Write(b []byte) (int, error) {
+	w.buf = append(w.buf, b...)
+	for {
+		i := bytes.IndexAny(w.buf, "\r\n")
+		if i < 0 {
+			break
+		}
+		line := strings.TrimSpace(string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+		if line != "" && w.parse(line, &w.progress) {
+			w.tracker.set(w.progress)
+		}
+	}
+	// a program without newlines in its output should not grow the buffer forever
+	if len(w.buf) > 64*1024 {
+		w.buf = w.buf[:0]
+	}
+	return len(b), nil
+}
+
+var streamlinkWrittenRegex = regexp.MustCompile(`Written ([\d.]+ ?[KMGT]?i?B).*@ ([\d.]+ ?[KMGT]?i?B)/s`)
+
+// parseStreamlinkProgress parses the progress of streamlink run with --force-progress, e.g. "[download] Written 1.50 GiB to video.mp4 (10m5s @ 2.54 MiB/s)".
+// Streamlink only logs the segments of the playlist at the debug log level, which also logs the arguments with the twitch token, so the percent is unknown.
+func parseStreamlinkProgress(line string, p *utils.Progress) bool {
+	m := streamlinkWrittenRegex.FindStringSubmatch(line)
+	if m == nil {
+		return false
+	}
+	p.Bytes = parseByteSize(m[1])
+	p.Speed = formatByteSpeed(float64(parseByteSize(m[2])))
+	return true
+}
+
+var ytDlpProgressRegex = regexp.MustCompile(`^\[download\]\s+([\d.]+)% of\s+~?\s*([\d.]+[KMGT]?i?B)(?:\s+at\s+([\d.]+[KMGT]?i?B)/s)?(?:\s+ETA\s+([\d:]+))?`)
+
+// parseYtDlpProgress parses the progress of yt-dlp run with --newline.
+func parseYtDlpProgress(line string, p *utils.Progress) bool {
+	m := ytDlpProgressRegex.FindStringSubmatch(line)
+	if m == nil {
+		return false
+	}
+	p.Percent, _ = strconv.ParseFloat(m[1], 64)
+	p.Bytes = int64(float64(parseByteSize(m[2])) * p.Percent / 100)
+	p.Speed = ""
+	if m[3] != "" {
+		p.Speed = formatByteSpeed(float64(parseByteSize(m[3])))
+	}
+	p.ETA = 0
+	if m[4] != "" {
+		p.ETA = int64(parseClock(m[4]).Seconds())
+	}
+	return true
+}
+
+var (
+	twitchDownloaderPercentRegex   = regexp.MustCompile(`^\[STATUS\].*?([\d.]+)%`)
+	twitchDownloaderRemainingRegex = regexp.MustCompile(`(\w+) Remaining`)
+)
+
+// parseTwitchDownloaderProgress parses the status lines of TwitchDownloaderCLI, e.g. "[STATUS] - Rendering Video 45% (2m3s Elapsed | 2m30s Remaining)".
+func parseTwitchDownloaderProgress(line string, p *utils.Progress) bool {
+	m := twitchDownloaderPercentRegex.FindStringSubmatch(line)
+	if m == nil {
+		return false
+	}
+	p.Percent, _ = strconv.ParseFloat(m[1], 64)
+	p.ETA = 0
+	if m := twitchDownloaderRemainingRegex.FindStringSubmatch(line); m != nil {
+		if remaining, err := time.ParseDuration(m[1]); err == nil {
+			p.ETA = int64(remaining.Seconds())
+		}
+	}
+	return true
+}
+
+var (
+	ffmpegDurationRegex = regexp.MustCompile(`Duration: (\d+:\d+:[\d.]+)`)
+	ffmpegProgressRegex = regexp.MustCompile(`size=\s*(\d+)\s*(\w+)\s+time=(\d+:\d+:[\d.]+).*speed=\s*([\d.]+)x`)
+)
+
+// newFfmpegProgressParser parses the progress of ffmpeg. The percent is the encoded time of the duration of the first input.
+func newFfmpegProgressParser() progressParser {
+	var duration time.Duration
+	return func(line string, p *utils.Progress) bool {
+		if m := ffmpegDurationRegex.FindStringSubmatch(line); m != nil {
+			if duration == 0 {
+				duration = parseClock(m[1])
+			}
+			return false
+		}
+		m := ffmpegProgressRegex.FindStringSubmatch(line)
+		if m == nil {
+			return false
+		}
+		p.Bytes = parseByteSize(m[1] + m[2])
+		position := parseClock(m[3])
+		speed, _ := strconv.ParseFloat(m[4], 64)
+		p.Speed = fmt.Sprintf("%gx", speed)
+		if duration > 0 {
+			p.Percent = percent(position.Seconds(), duration.Seconds())
+			if speed > 0 {
+				p.ETA = int64(math.Max(0, (duration-position).Seconds()/speed))
+			}
+		}
+		return true
+	}
+}
+
+func percent(done float64, total float64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Min(100, math.Round(done/total*1000)/10)
+}
+
+var byteSizeRegex = regexp.MustCompile(`^([\d.]+)\s*([KMGT]?)(I?)B$`)
+
+// parseByteSize parses sizes such as "1.5 GiB", "20MB" or "512kB". Sizes that can't be parsed are 0.
+func parseByteSize(s string) int64 {
+	m := byteSizeRegex.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(s)))
+	if m == nil {
+		return 0
+	}
+	size, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0
+	}
+	base := 1000.0
+	if m[3] != "" || m[2] == "K" {
+		// ffmpeg reports kibibytes as kB
+		base = 1024
+	}
+	exponent := strings.Index("KMGT", m[2]) + 1
+	if m[2] == "" {
+		exponent = 0
+	}
+	return int64(size * math.Pow(base, float64(exponent)))
+}
+
+func formatByteSpeed(bytesPerSecond float64) string {
+	units := []string{"B/s", "KiB/s", "MiB/s", "GiB/s"}
+	i := 0
+	for bytesPerSecond >= 1024 && i < len(units)-1 {
+		bytesPerSecond /= 1024
+		i++
+	}
+	return fmt.Sprintf("%.2f %s", bytesPerSecond, units[i])
+}
+
+// parseClock parses durations such as "01:02:03.45" or "05:12".
+func parseClock(s string) time.Duration {
+	var seconds float64
+	for _, part := range strings.Split(s, ":") {
+		value, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return 0
+		}
+		seconds = seconds*60 + value
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,97 @@
+package exec
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+func TestParseStreamlinkProgress(t *testing.T) {
+	var p utils.Progress
+	assert.False(t, parseStreamlinkProgress("[cli][info] Opening stream: 1080p60 (hls)", &p))
+	assert.True(t, parseStreamlinkProgress("[download] Written 1.50 GiB to /tmp/video.mp4 (10m5s @ 2.54 MiB/s)", &p))
+	assert.Equal(t, utils.Progress{Bytes: 1610612736, Speed: "2.54 MiB/s"}, p)
+}
+
+func TestParseYtDlpProgress(t *testing.T) {
+	tests := []struct {
+		line string
+		ok   bool
+		want utils.Progress
+	}{
+		{line: "[download]  45.0% of  100.00MiB at  2.00MiB/s ETA 00:27", ok: true, want: utils.Progress{Percent: 45, Bytes: 47185920, Speed: "2.00 MiB/s", ETA: 27}},
+		{line: "[download]  10.0% of ~ 1.00GiB at Unknown B/s ETA Unknown", ok: true, want: utils.Progress{Percent: 10, Bytes: 107374182}},
+		{line: "[download] Destination: video.mp4", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			var p utils.Progress
+			assert.Equal(t, tt.ok, parseYtDlpProgress(tt.line, &p))
+			assert.Equal(t, tt.want, p)
+		})
+	}
+}
+
+func TestParseTwitchDownloaderProgress(t *testing.T) {
+	var p utils.Progress
+	assert.True(t, parseTwitchDownloaderProgress("[STATUS] - Rendering Video 45% (2m3s Elapsed | 2m30s Remaining)", &p))
+	assert.Equal(t, utils.Progress{Percent: 45, ETA: 150}, p)
+	assert.True(t, parseTwitchDownloaderProgress("[STATUS] - Downloading 12.5%", &p))
+	assert.Equal(t, utils.Progress{Percent: 12.5}, p)
+	assert.False(t, parseTwitchDownloaderProgress("[INFO] - Finished", &p))
+}
+
+func TestFfmpegProgressParser(t *testing.T) {
+	parse := newFfmpegProgressParser()
+	var p utils.Progress
+	// the percent is unknown before the duration
+	assert.True(t, parse("size=     512kB time=00:00:10.00 bitrate= 419.4kbits/s speed=2x", &p))
+	assert.Zero(t, p.Percent)
+	assert.False(t, parse("  Duration: 00:01:40.00, start: 0.000000, bitrate: 6000 kb/s", &p))
+	// the duration of later inputs and outputs is ignored
+	assert.False(t, parse("  Duration: 01:00:00.00, start: 0.000000, bitrate: 6000 kb/s", &p))
+	assert.True(t, parse("frame= 1500 fps=120 q=-1.0 size=   10240kB time=00:00:25.00 bitrate=3355.4kbits/s speed=2.5x", &p))
+	assert.Equal(t, utils.Progress{Percent: 25, Bytes: 10485760, Speed: "2.5x", ETA: 30}, p)
+}
+
+func TestProgressWriter(t *testing.T) {
+	tracker := NewProgressTracker()
+	w := tracker.writer(parseTwitchDownloaderProgress)
+	assert.Nil(t, tracker.Progress())
+
+	// progress bars end their lines with a carriage return and may be split across writes
+	fmt.Fprint(w, "[STATUS] - Downloading 1")
+	assert.Nil(t, tracker.Progress())
+	fmt.Fprint(w, "0%\r[STATUS] - Downloading 20%\r")
+	assert.Equal(t, &utils.Progress{Percent: 20}, tracker.Progress())
+	fmt.Fprint(w, "[INFO] - Done\n")
+	assert.Equal(t, &utils.Progress{Percent: 20}, tracker.Progress())
+
+	// a nil tracker discards the output
+	var nilTracker *ProgressTracker
+	_, err := nilTracker.writer(parseTwitchDownloaderProgress).Write([]byte("[STATUS] - 50%\n"))
+	assert.NoError(t, err)
+	assert.Nil(t, nilTracker.Progress())
+}
+
+func TestParseByteSize(t *testing.T) {
+	tests := map[string]int64{
+		"1.5 GiB": 1610612736,
+		"20MB":    20000000,
+		"512kB":   524288,
+		"100 B":   100,
+		"unknown": 0,
+	}
+	for s, want := range tests {
+		assert.Equal(t, want, parseByteSize(s), s)
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	assert.Equal(t, time.Hour+2*time.Minute+3450*time.Millisecond, parseClock("01:02:03.45"))
+	assert.Equal(t, 5*time.Minute+12*time.Second, parseClock("05:12"))
+	assert.Equal(t, time.Duration(0), parseClock("Unknown"))
+}
@@ -0,0 +1,64 @@
+package live
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/platform"
+	ganymedeVod "github.com/zibbp/ganymede/internal/vod"
+)
+
+// CheckClipWatchedChannels archives new clips of watched channels that have clip downloading enabled.
+func (s *Service) CheckClipWatchedChannels() {
+	channels, err := s.Store.Client.Live.Query().Where(live.DownloadClips(true)).WithChannel().WithArchiveProfile().All(context.Background())
+	if err != nil {
+		log.Debug().Err(err).Msg("error getting channels")
+		return
+	}
+	if len(channels) == 0 {
+		log.Debug().Msg("No channels to check for clips")
+		return
+	}
+	log.Info().Msgf("Checking %d channels for new clips", len(channels))
+	for _, watch := range channels {
+		startedAt := time.Now().Add(-time.Duration(watch.ClipsMaxAge) * 24 * time.Hour)
+
+		p, err := platform.Get(watch.Edges.Channel.Platform)
+		if err != nil {
+			log.Error().Err(err).Msgf("error getting clips for channel %s", watch.Edges.Channel.Name)
+			continue
+		}
+		clips, err := p.GetClips(watch.Edges.Channel.ExtID, startedAt, watch.ClipsMinViews)
+		if err != nil {
+			log.Error().Err(err).Msgf("error getting clips for channel %s", watch.Edges.Channel.Name)
+			continue
+		}
+
+		for _, clip := range clips {
+			// clips in the trash are not archived again
+			exists, trashed, err := ganymedeVod.Exists(context.Background(), s.Store.Client, vod.ExtID(clip.ID))
+			if err != nil {
+				log.Error().Err(err).Msgf("error checking if clip %s exists", clip.ID)
+				continue
+			}
+			if trashed {
+				log.Debug().Msgf("clip %s is in the trash, skipping", clip.ID)
+				continue
+			}
+			if exists {
+				continue
+			}
+
+			_, err = s.ArchiveService.ArchiveTwitchClip(clip.ID, watch.Resolution, watch.ArchiveChat, watch.RenderChat, archiveProfileID(watch))
+			if err != nil {
+				log.Error().Err(err).Msgf("Error archiving clip %s", clip.ID)
+				continue
+			}
+			log.Info().Msgf("[Channel Watch] starting archive for clip %s", clip.ID)
+		}
+	}
+	log.Info().Msg("Finished checking channels for new clips")
+}
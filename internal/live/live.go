@@ -19,6 +19,7 @@ import (
 	"github.com/zibbp/ganymede/internal/archive"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/notification"
+	"github.com/zibbp/ganymede/internal/platform"
 	"github.com/zibbp/ganymede/internal/twitch"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -30,21 +31,27 @@ type Service struct {
 }
 
 type Live struct {
-	ID                 uuid.UUID            `json:"id"`
-	WatchLive          bool                 `json:"watch_live"`
-	WatchVod           bool                 `json:"watch_vod"`
-	DownloadArchives   bool                 `json:"download_archives"`
-	DownloadHighlights bool                 `json:"download_highlights"`
-	DownloadUploads    bool                 `json:"download_uploads"`
-	IsLive             bool                 `json:"is_live"`
-	ArchiveChat        bool                 `json:"archive_chat"`
-	Resolution         string               `json:"resolution"`
-	LastLive           time.Time            `json:"last_live"`
-	RenderChat         bool                 `json:"render_chat"`
-	DownloadSubOnly    bool                 `json:"download_sub_only"`
-	Categories         []string             `json:"categories"`
-	MaxAge             int64                `json:"max_age"`
-	TitleRegex         []ent.LiveTitleRegex `json:"title_regex"`
+	ID                          uuid.UUID                `json:"id"`
+	WatchLive                   bool                     `json:"watch_live"`
+	WatchVod                    bool                     `json:"watch_vod"`
+	DownloadArchives            bool                     `json:"download_archives"`
+	DownloadHighlights          bool                     `json:"download_highlights"`
+	DownloadUploads             bool                     `json:"download_uploads"`
+	IsLive                      bool                     `json:"is_live"`
+	ArchiveChat                 bool                     `json:"archive_chat"`
+	Resolution                  string                   `json:"resolution"`
+	LastLive                    time.Time                `json:"last_live"`
+	RenderChat                  bool                     `json:"render_chat"`
+	DownloadSubOnly             bool                     `json:"download_sub_only"`
+	Categories                  []string                 `json:"categories"`
+	MaxAge                      int64                    `json:"max_age"`
+	TitleRegex                  []ent.LiveTitleRegex     `json:"title_regex"`
+	DownloadClips               bool                     `json:"download_clips"`
+	ClipsMinViews               int                      `json:"clips_min_views"`
+	ClipsMaxAge                 int64                    `json:"clips_max_age"`
+	VodReplacement              utils.LiveVodReplacement `json:"vod_replacement"`
+	VodReplacementMinDifference *int                     `json:"vod_replacement_min_difference"`
+	ArchiveProfileID            *uuid.UUID               `json:"archive_profile_id"`
 }
 
 type ConvertChat struct {
@@ -85,7 +92,7 @@ func (s *Service) AddLiveWatchedChannel(c echo.Context, liveDto Live) (*ent.Live
 		return nil, fmt.Errorf("channel already watched")
 	}
 
-	l, err := s.Store.Client.Live.Create().SetChannelID(liveDto.ID).SetWatchLive(liveDto.WatchLive).SetWatchVod(liveDto.WatchVod).SetDownloadArchives(liveDto.DownloadArchives).SetDownloadHighlights(liveDto.DownloadHighlights).SetDownloadUploads(liveDto.DownloadUploads).SetResolution(liveDto.Resolution).SetArchiveChat(liveDto.ArchiveChat).SetRenderChat(liveDto.RenderChat).SetDownloadSubOnly(liveDto.DownloadSubOnly).SetVideoAge(liveDto.MaxAge).Save(c.Request().Context())
+	l, err := s.Store.Client.Live.Create().SetChannelID(liveDto.ID).SetWatchLive(liveDto.WatchLive).SetWatchVod(liveDto.WatchVod).SetDownloadArchives(liveDto.DownloadArchives).SetDownloadHighlights(liveDto.DownloadHighlights).SetDownloadUploads(liveDto.DownloadUploads).SetResolution(liveDto.Resolution).SetArchiveChat(liveDto.ArchiveChat).SetRenderChat(liveDto.RenderChat).SetDownloadSubOnly(liveDto.DownloadSubOnly).SetVideoAge(liveDto.MaxAge).SetDownloadClips(liveDto.DownloadClips).SetClipsMinViews(liveDto.ClipsMinViews).SetClipsMaxAge(liveDto.ClipsMaxAge).SetVodReplacement(liveDto.VodReplacement).SetNillableVodReplacementMinDifference(liveDto.VodReplacementMinDifference).SetNillableArchiveProfileID(liveDto.ArchiveProfileID).Save(c.Request().Context())
 	if err != nil {
 		return nil, fmt.Errorf("error adding watched channel: %v", err)
 	}
@@ -111,7 +118,13 @@ func (s *Service) AddLiveWatchedChannel(c echo.Context, liveDto Live) (*ent.Live
 }
 
 func (s *Service) UpdateLiveWatchedChannel(c echo.Context, liveDto Live) (*ent.Live, error) {
-	l, err := s.Store.Client.Live.UpdateOneID(liveDto.ID).SetWatchLive(liveDto.WatchLive).SetWatchVod(liveDto.WatchVod).SetDownloadArchives(liveDto.DownloadArchives).SetDownloadHighlights(liveDto.DownloadHighlights).SetDownloadUploads(liveDto.DownloadUploads).SetResolution(liveDto.Resolution).SetArchiveChat(liveDto.ArchiveChat).SetRenderChat(liveDto.RenderChat).SetDownloadSubOnly(liveDto.DownloadSubOnly).SetVideoAge(liveDto.MaxAge).Save(c.Request().Context())
+	lUpdate := s.Store.Client.Live.UpdateOneID(liveDto.ID)
+	if liveDto.ArchiveProfileID != nil {
+		lUpdate.SetArchiveProfileID(*liveDto.ArchiveProfileID)
+	} else {
+		lUpdate.ClearArchiveProfile()
+	}
+	l, err := lUpdate.SetWatchLive(liveDto.WatchLive).SetWatchVod(liveDto.WatchVod).SetDownloadArchives(liveDto.DownloadArchives).SetDownloadHighlights(liveDto.DownloadHighlights).SetDownloadUploads(liveDto.DownloadUploads).SetResolution(liveDto.Resolution).SetArchiveChat(liveDto.ArchiveChat).SetRenderChat(liveDto.RenderChat).SetDownloadSubOnly(liveDto.DownloadSubOnly).SetVideoAge(liveDto.MaxAge).SetDownloadClips(liveDto.DownloadClips).SetClipsMinViews(liveDto.ClipsMinViews).SetClipsMaxAge(liveDto.ClipsMaxAge).SetVodReplacement(liveDto.VodReplacement).SetNillableVodReplacementMinDifference(liveDto.VodReplacementMinDifference).Save(c.Request().Context())
 	if err != nil {
 		return nil, fmt.Errorf("error updating watched channel: %v", err)
 	}
@@ -203,32 +216,24 @@ func (s *Service) Check() error {
 		return nil
 	}
 
-	// split into 99 channels per requests to avoid 100 channel limit
-	var liveWatchedChannelsSplit [][]*ent.Live
-	for i := 0; i < len(liveWatchedChannels); i += 99 {
-		end := i + 99
-		if end > len(liveWatchedChannels) {
-			end = len(liveWatchedChannels)
-		}
-		liveWatchedChannelsSplit = append(liveWatchedChannelsSplit, liveWatchedChannels[i:end])
+	// group the channels by platform to request their streams in bulk
+	logins := make(map[utils.VodPlatform][]string)
+	for _, lwc := range liveWatchedChannels {
+		logins[lwc.Edges.Channel.Platform] = append(logins[lwc.Edges.Channel.Platform], lwc.Edges.Channel.Name)
 	}
 
-	var streams []twitch.Live
-	// generate query string for twitch api
-	for _, lwc := range liveWatchedChannelsSplit {
-		var queryString string
-		for i, lwc := range lwc {
-			if i == 0 {
-				queryString += "?user_login=" + lwc.Edges.Channel.Name
-			} else {
-				queryString += "&user_login=" + lwc.Edges.Channel.Name
-			}
+	var streams []platform.LiveStream
+	for platformName, platformLogins := range logins {
+		p, err := platform.Get(platformName)
+		if err != nil {
+			log.Error().Err(err).Msg("error getting live streams")
+			continue
 		}
-		twitchStreams, err := s.TwitchService.GetStreams(queryString)
+		platformStreams, err := p.GetLiveStreams(platformLogins)
 		if err != nil {
-			log.Error().Err(err).Msg("error getting twitch streams")
+			log.Error().Err(err).Msgf("error getting %s streams", platformName)
 		}
-		streams = append(streams, twitchStreams.Data...)
+		streams = append(streams, platformStreams...)
 	}
 
 	// check if live stream is online
@@ -335,12 +340,15 @@ func (s *Service) ArchiveLiveChannel(c echo.Context, archiveLiveChannelDto Archi
 	}
 
 	// check if channel is live
-	queryString := "?user_login=" + channel.Name
-	twitchStream, err := s.TwitchService.GetStreams(queryString)
+	p, err := platform.Get(channel.Platform)
 	if err != nil {
-		return fmt.Errorf("error getting twitch streams: %v", err)
+		return err
 	}
-	if len(twitchStream.Data) == 0 {
+	streams, err := p.GetLiveStreams([]string{channel.Name})
+	if err != nil {
+		return fmt.Errorf("error getting %s streams: %v", channel.Platform, err)
+	}
+	if len(streams) == 0 {
 		return fmt.Errorf("channel is not live")
 	}
 	// create a temp live watched channel
@@ -349,7 +357,7 @@ func (s *Service) ArchiveLiveChannel(c echo.Context, archiveLiveChannelDto Archi
 		RenderChat:  archiveLiveChannelDto.RenderChat,
 		Resolution:  archiveLiveChannelDto.Resolution,
 	}
-	_, err = s.ArchiveService.ArchiveTwitchLive(lwc, twitchStream.Data[0])
+	_, err = s.ArchiveService.ArchiveTwitchLive(lwc, streams[0])
 	if err != nil {
 		log.Error().Err(err).Msg("error archiving twitch livestream")
 	}
@@ -357,11 +365,19 @@ func (s *Service) ArchiveLiveChannel(c echo.Context, archiveLiveChannelDto Archi
 	return nil
 }
 
-func stringInSlice(a string, list []twitch.Live) twitch.Live {
+// archiveProfileID returns the ID of the archive profile of the watched channel. The archive profile edge must be loaded.
+func archiveProfileID(watch *ent.Live) *uuid.UUID {
+	if watch.Edges.ArchiveProfile == nil {
+		return nil
+	}
+	return &watch.Edges.ArchiveProfile.ID
+}
+
+func stringInSlice(a string, list []platform.LiveStream) platform.LiveStream {
 	for _, b := range list {
 		if b.UserLogin == a {
 			return b
 		}
 	}
-	return twitch.Live{}
+	return platform.LiveStream{}
 }
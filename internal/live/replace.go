@@ -0,0 +1,98 @@
+package live
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/live"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/utils"
+	ganymedeVod "github.com/zibbp/ganymede/internal/vod"
+)
+
+// CheckLiveArchiveReplacements compares live archives of watched channels with their official VOD.
+// The VOD is archived if the live archive is meaningfully shorter or had to reconnect.
+func (s *Service) CheckLiveArchiveReplacements() {
+	channels, err := s.Store.Client.Live.Query().Where(live.VodReplacementNEQ(utils.LiveVodReplacementNone)).WithChannel().WithArchiveProfile().All(context.Background())
+	if err != nil {
+		log.Debug().Err(err).Msg("error getting channels")
+		return
+	}
+	if len(channels) == 0 {
+		log.Debug().Msg("No channels to check for live archive replacements")
+		return
+	}
+	log.Info().Msgf("Checking %d channels for live archive replacements", len(channels))
+	for _, watch := range channels {
+		// live archives that have not been compared with their vod yet
+		liveVods, err := s.Store.Client.Vod.Query().Where(vod.HasChannelWith(channel.ID(watch.Edges.Channel.ID)), vod.TypeEQ(utils.Live), vod.Processing(false), vod.Not(vod.HasAlternate())).All(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("error getting live archives from DB")
+			continue
+		}
+		if len(liveVods) == 0 {
+			continue
+		}
+
+		p, err := platform.Get(watch.Edges.Channel.Platform)
+		if err != nil {
+			log.Error().Err(err).Msg("error getting videos")
+			continue
+		}
+		videos, err := p.GetVideos(watch.Edges.Channel.ExtID, "archive")
+		if err != nil {
+			log.Error().Err(err).Msg("error getting videos")
+			continue
+		}
+
+		for _, liveVod := range liveVods {
+			video := replacementVideo(liveVod, videos, watch.VodReplacementMinDifference)
+			if video == nil {
+				continue
+			}
+
+			// the live archive is linked once the vod is archived, it is left alone while the vod is archiving or if it failed
+			exists, _, err := ganymedeVod.Exists(context.Background(), s.Store.Client, vod.ExtID(video.ID), vod.IDNEQ(liveVod.ID))
+			if err != nil {
+				log.Error().Err(err).Msg("error checking if vod exists")
+				continue
+			}
+			if exists {
+				continue
+			}
+
+			log.Info().Msgf("live archive %s is %d seconds shorter than vod %s and reconnected %d times", liveVod.ID, video.Duration-liveVod.Duration, video.ID, liveVod.Reconnects)
+
+			_, err = s.ArchiveService.ReplaceTwitchLiveArchive(liveVod, video.ID, watch.Resolution, watch.ArchiveChat, watch.RenderChat, archiveProfileID(watch), watch.VodReplacement == utils.LiveVodReplacementReplace)
+			if err != nil {
+				log.Error().Err(err).Msgf("Error archiving video %s", video.ID)
+				continue
+			}
+			log.Info().Msgf("[Channel Watch] starting archive for video %s replacing live archive %s", video.ID, liveVod.ID)
+		}
+	}
+	log.Info().Msg("Finished checking channels for live archive replacements")
+}
+
+// replacementVideo returns the video of a live archive if the live archive should be replaced by it, or nil.
+// Live archives are matched by the stream id or, once updated, the vod id and replaced if they are at least
+// minDifference seconds shorter than the video or had to reconnect. Videos that are still processing are not replaced.
+func replacementVideo(liveVod *ent.Vod, videos []platform.Video, minDifference int) *platform.Video {
+	for i := range videos {
+		video := &videos[i]
+		if video.ID != liveVod.ExtID && video.StreamID != liveVod.ExtID {
+			continue
+		}
+		if video.Processing {
+			return nil
+		}
+		if video.Duration-liveVod.Duration < minDifference && liveVod.Reconnects == 0 {
+			return nil
+		}
+		return video
+	}
+	return nil
+}
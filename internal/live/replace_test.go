@@ -0,0 +1,44 @@
+package live
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/platform"
+)
+
+func TestReplacementVideo(t *testing.T) {
+	videos := []platform.Video{
+		{ID: "vod1", StreamID: "stream1", Duration: 3600},
+		{ID: "vod2", StreamID: "stream2", Duration: 3600, Processing: true},
+		{ID: "vod3", StreamID: "stream3", Duration: 1800},
+	}
+	tests := []struct {
+		name          string
+		liveVod       *ent.Vod
+		minDifference int
+		want          string
+	}{
+		{name: "matched by stream id", liveVod: &ent.Vod{ExtID: "stream1", Duration: 3000}, minDifference: 300, want: "vod1"},
+		{name: "matched by vod id", liveVod: &ent.Vod{ExtID: "vod1", Duration: 3000}, minDifference: 300, want: "vod1"},
+		{name: "difference equal to the minimum", liveVod: &ent.Vod{ExtID: "stream1", Duration: 3300}, minDifference: 300, want: "vod1"},
+		{name: "difference below the minimum", liveVod: &ent.Vod{ExtID: "stream1", Duration: 3301}, minDifference: 300},
+		{name: "live archive longer than the vod", liveVod: &ent.Vod{ExtID: "stream3", Duration: 1900}, minDifference: 0},
+		{name: "reconnected without a difference", liveVod: &ent.Vod{ExtID: "stream1", Duration: 3600, Reconnects: 1}, minDifference: 300, want: "vod1"},
+		{name: "vod still processing", liveVod: &ent.Vod{ExtID: "stream2", Duration: 600}, minDifference: 300},
+		{name: "no matching vod", liveVod: &ent.Vod{ExtID: "stream4", Duration: 600}, minDifference: 300},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			video := replacementVideo(tt.liveVod, videos, tt.minDifference)
+			if tt.want == "" {
+				assert.Nil(t, video)
+				return
+			}
+			if assert.NotNil(t, video) {
+				assert.Equal(t, tt.want, video.ID)
+			}
+		})
+	}
+}
@@ -12,8 +12,9 @@ import (
 	"github.com/zibbp/ganymede/ent/channel"
 	"github.com/zibbp/ganymede/ent/live"
 	"github.com/zibbp/ganymede/ent/livetitleregex"
+	"github.com/zibbp/ganymede/ent/schema"
 	"github.com/zibbp/ganymede/ent/vod"
-	"github.com/zibbp/ganymede/internal/twitch"
+	"github.com/zibbp/ganymede/internal/platform"
 )
 
 type TwitchVideoResponse struct {
@@ -57,7 +58,7 @@ type Viewable string
 
 func (s *Service) CheckVodWatchedChannels() {
 	// Get channels from DB
-	channels, err := s.Store.Client.Live.Query().Where(live.WatchVod(true)).WithChannel().WithCategories().WithTitleRegex(func(ltrq *ent.LiveTitleRegexQuery) {
+	channels, err := s.Store.Client.Live.Query().Where(live.WatchVod(true)).WithChannel().WithArchiveProfile().WithCategories().WithTitleRegex(func(ltrq *ent.LiveTitleRegexQuery) {
 		ltrq.Where(livetitleregex.ApplyToVideosEQ(true))
 	}).All(context.Background())
 	if err != nil {
@@ -79,10 +80,16 @@ func (s *Service) CheckVodWatchedChannels() {
 			log.Debug().Msgf("Channel %s has category restrictions: %s", watch.Edges.Channel.Name, strings.Join(channelVideoCategories, ", "))
 		}
 
-		var videos []twitch.Video
+		p, err := platform.Get(watch.Edges.Channel.Platform)
+		if err != nil {
+			log.Error().Err(err).Msg("error getting videos")
+			continue
+		}
+
+		var videos []platform.Video
 		// If archives is enabled, fetch all videos
 		if watch.DownloadArchives {
-			tmpVideos, err := twitch.GetVideosByUser(watch.Edges.Channel.ExtID, "archive")
+			tmpVideos, err := p.GetVideos(watch.Edges.Channel.ExtID, "archive")
 			if err != nil {
 				log.Error().Err(err).Msg("error getting videos")
 				continue
@@ -91,7 +98,7 @@ func (s *Service) CheckVodWatchedChannels() {
 		}
 		// If highlights is enabled, fetch all videos
 		if watch.DownloadHighlights {
-			tmpVideos, err := twitch.GetVideosByUser(watch.Edges.Channel.ExtID, "highlight")
+			tmpVideos, err := p.GetVideos(watch.Edges.Channel.ExtID, "highlight")
 			if err != nil {
 				log.Error().Err(err).Msg("error getting videos")
 				continue
@@ -100,7 +107,7 @@ func (s *Service) CheckVodWatchedChannels() {
 		}
 		// If uploads is enabled, fetch all videos
 		if watch.DownloadUploads {
-			tmpVideos, err := twitch.GetVideosByUser(watch.Edges.Channel.ExtID, "upload")
+			tmpVideos, err := p.GetVideos(watch.Edges.Channel.ExtID, "upload")
 			if err != nil {
 				log.Error().Err(err).Msg("error getting videos")
 				continue
@@ -109,7 +116,8 @@ func (s *Service) CheckVodWatchedChannels() {
 		}
 
 		// Fetch all videos from DB
-		dbVideos, err := s.Store.Client.Vod.Query().Where(vod.HasChannelWith(channel.ID(watch.Edges.Channel.ID))).All(context.Background())
+		// videos in the trash, e.g. pruned by the retention policy, are included so they are not archived again
+		dbVideos, err := s.Store.Client.Vod.Query().Where(vod.HasChannelWith(channel.ID(watch.Edges.Channel.ID))).All(schema.SkipSoftDelete(context.Background()))
 		if err != nil {
 			log.Error().Err(err).Msg("error getting videos from DB")
 			continue
@@ -143,42 +151,36 @@ func (s *Service) CheckVodWatchedChannels() {
 					}
 				}
 
-				// Query the video using Twitch's GraphQL API to check for restrictions
-				gqlVideo, err := twitch.GQLGetVideo(video.ID)
+				// Fetch the full video metadata to check for restrictions
+				fullVideo, err := p.GetVideo(video.ID)
 				if err != nil {
-					log.Error().Err(err).Msgf("error getting video %s from GraphQL API", video.ID)
+					log.Error().Err(err).Msgf("error getting video %s metadata", video.ID)
 					continue
 				}
 
 				// check if video is too old
 				if watch.VideoAge > 0 {
-					parsedTime, err := time.Parse(time.RFC3339, video.CreatedAt)
-					if err != nil {
-						log.Error().Err(err).Msgf("error parsing video %s created_at", video.ID)
-						continue
-					}
-
 					currentTime := time.Now()
 					ageDuration := time.Duration(watch.VideoAge) * 24 * time.Hour
 					ageCutOff := currentTime.Add(-ageDuration)
 
-					if parsedTime.Before(ageCutOff) {
+					if video.CreatedAt.Before(ageCutOff) {
 						log.Debug().Msgf("skipping video %s. video is older than %d days.", video.ID, watch.VideoAge)
 						continue
 					}
 				}
 
 				// Get video chapters
-				gqlVideoChapters, err := twitch.GQLGetChapters(video.ID)
+				chapters, err := p.GetChapters(video.ID, video.Duration)
 				if err != nil {
-					log.Error().Err(err).Msgf("error getting video %s chapters from GraphQL API", video.ID)
+					log.Error().Err(err).Msgf("error getting video %s chapters", video.ID)
 					continue
 				}
 				var videoChapters []string
 
-				if len(gqlVideoChapters.Data.Video.Moments.Edges) > 0 {
-					for _, chapter := range gqlVideoChapters.Data.Video.Moments.Edges {
-						videoChapters = append(videoChapters, chapter.Node.Details.Game.DisplayName)
+				if len(chapters) > 0 {
+					for _, chapter := range chapters {
+						videoChapters = append(videoChapters, chapter.Title)
 					}
 					log.Debug().Msgf("Video %s has chapters: %s", video.ID, strings.Join(videoChapters, ", "))
 				}
@@ -186,10 +188,10 @@ func (s *Service) CheckVodWatchedChannels() {
 				// Append chapters and video category to video categories
 				var videoCategories []string
 				videoCategories = append(videoCategories, videoChapters...)
-				videoCategories = append(videoCategories, gqlVideo.Data.Video.Game.Name)
+				videoCategories = append(videoCategories, fullVideo.Category)
 
 				// Check if video is sub only restricted
-				if strings.Contains(gqlVideo.Data.Video.ResourceRestriction.Type, "SUB") {
+				if fullVideo.SubOnly {
 					// Skip if sub only is disabled
 					if !watch.DownloadSubOnly {
 						log.Info().Msgf("skipping sub only video %s.", video.ID)
@@ -220,7 +222,7 @@ func (s *Service) CheckVodWatchedChannels() {
 				}
 
 				// archive the video
-				_, err = s.ArchiveService.ArchiveTwitchVod(video.ID, watch.Resolution, watch.ArchiveChat, watch.RenderChat)
+				_, err = s.ArchiveService.ArchiveTwitchVod(video.ID, watch.Resolution, watch.ArchiveChat, watch.RenderChat, archiveProfileID(watch))
 				if err != nil {
 					log.Error().Err(err).Msgf("Error archiving video %s", video.ID)
 					continue
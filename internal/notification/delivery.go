@@ -0,0 +1,288 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/ent"
+	entNotificationDelivery "github.com/zibbp/ganymede/ent/notificationdelivery"
+	entNotificationSubscription "github.com/zibbp/ganymede/ent/notificationsubscription"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+const (
+	// maxRetryDelay caps the delay between delivery attempts.
+	maxRetryDelay = 10 * time.Minute
+	// deliveryTimeout is the timeout of a single delivery attempt.
+	deliveryTimeout = time.Minute
+)
+
+type Service struct {
+	Store *database.Database
+}
+
+func NewService(store *database.Database) *Service {
+	return &Service{Store: store}
+}
+
+type DeliveryPagination struct {
+	Offset     int                         `json:"offset"`
+	Limit      int                         `json:"limit"`
+	TotalCount int                         `json:"total_count"`
+	Pages      int                         `json:"pages"`
+	Data       []*ent.NotificationDelivery `json:"data"`
+}
+
+// GetDeliveries returns the notification deliveries, newest first. All statuses are returned if status is empty.
+func (s *Service) GetDeliveries(c echo.Context, status utils.TaskStatus, limit int, offset int) (DeliveryPagination, error) {
+	var pagination DeliveryPagination
+
+	query := s.Store.Client.NotificationDelivery.Query()
+	if status != "" {
+		query = query.Where(entNotificationDelivery.StatusEQ(status))
+	}
+
+	deliveries, err := query.Clone().Order(ent.Desc(entNotificationDelivery.FieldCreatedAt)).Limit(limit).Offset(offset).All(c.Request().Context())
+	if err != nil {
+		return pagination, fmt.Errorf("error getting notification deliveries: %v", err)
+	}
+
+	totalCount, err := query.Count(c.Request().Context())
+	if err != nil {
+		return pagination, fmt.Errorf("error getting total notification delivery count: %v", err)
+	}
+
+	pagination.TotalCount = totalCount
+	pagination.Limit = limit
+	pagination.Offset = offset
+	pagination.Pages = int(math.Ceil(float64(totalCount) / float64(limit)))
+	pagination.Data = deliveries
+
+	return pagination, nil
+}
+
+// GetTargets returns the configured notification targets.
+func GetTargets() []Target {
+	var targets []Target
+	err := viper.UnmarshalKey("notifications.targets", &targets)
+	if err != nil {
+		log.Error().Err(err).Msg("error reading notification targets")
+		return nil
+	}
+	return targets
+}
+
+// notify records a delivery for each target receiving the event and makes the first attempt in the background.
+// Failed attempts are retried by RetryDeliveries so pending deliveries survive restarts.
+func notify(msg Message, targets []Target) {
+	for _, target := range targets {
+		if !target.receives(msg.Event) {
+			continue
+		}
+		go deliver(target, nil, msg)
+	}
+}
+
+// deliver records the delivery of the message to a target and makes the first attempt.
+func deliver(target Target, subscriptionID *uuid.UUID, msg Message) {
+	create := database.DB().Client.NotificationDelivery.Create().
+		SetEvent(msg.Event).
+		SetProvider(target.Provider).
+		SetTarget(target.Name).
+		SetNillableSubscriptionID(subscriptionID).
+		SetTitle(msg.Title).
+		SetBody(msg.Body)
+	if len(msg.JSONBody) > 0 {
+		create.SetJSONBody(string(msg.JSONBody))
+	}
+	delivery, err := create.Save(context.Background())
+	if err != nil {
+		// the notification is still sent once if it cannot be recorded
+		log.Error().Err(err).Msg("error creating notification delivery")
+	}
+	attemptDelivery(target, delivery, msg)
+}
+
+// attemptDelivery sends the message to a target once and records the result.
+// Retryable failures are scheduled for the next attempt with exponential backoff.
+func attemptDelivery(target Target, delivery *ent.NotificationDelivery, msg Message) {
+	attempt := 1
+	if delivery != nil {
+		attempt = delivery.Attempts + 1
+	}
+	maxAttempts := viper.GetInt("notifications.retry_attempts")
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	provider, err := NewProvider(target)
+	if err != nil {
+		log.Error().Err(err).Str("target", target.Name).Msg("error creating notification provider")
+		updateDelivery(delivery, func(u *ent.NotificationDeliveryUpdateOne) {
+			u.SetStatus(utils.Failed).SetAttempts(attempt).SetError(err.Error()).ClearNextAttemptAt()
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), deliveryTimeout)
+	err = provider.Send(ctx, msg)
+	cancel()
+
+	if err == nil {
+		log.Debug().Str("target", target.Name).Str("event", string(msg.Event)).Msg("notification delivered")
+		updateDelivery(delivery, func(u *ent.NotificationDeliveryUpdateOne) {
+			u.SetStatus(utils.Success).SetAttempts(attempt).SetDeliveredAt(time.Now()).ClearNextAttemptAt().ClearError()
+		})
+		return
+	}
+
+	var sendErr *SendError
+	retryable := true
+	if errors.As(err, &sendErr) {
+		retryable = sendErr.Retryable()
+	}
+
+	if !retryable || attempt >= maxAttempts || delivery == nil {
+		log.Error().Err(err).Str("target", target.Name).Str("event", string(msg.Event)).Msgf("error sending notification after %d attempts", attempt)
+		updateDelivery(delivery, func(u *ent.NotificationDeliveryUpdateOne) {
+			u.SetStatus(utils.Failed).SetAttempts(attempt).SetError(err.Error()).ClearNextAttemptAt()
+			if sendErr != nil {
+				u.SetStatusCode(sendErr.StatusCode)
+			}
+		})
+		return
+	}
+
+	delay := retryDelay(attempt, sendErr)
+	log.Warn().Err(err).Str("target", target.Name).Str("event", string(msg.Event)).Msgf("error sending notification, retrying in %s", delay)
+	updateDelivery(delivery, func(u *ent.NotificationDeliveryUpdateOne) {
+		u.SetStatus(utils.Pending).SetAttempts(attempt).SetError(err.Error()).SetNextAttemptAt(time.Now().Add(delay))
+		if sendErr != nil {
+			u.SetStatusCode(sendErr.StatusCode)
+		}
+	})
+}
+
+// retryDelay returns the delay before the next attempt. The delay requested by the target is used if it is longer than the backoff, both are capped at maxRetryDelay.
+func retryDelay(attempt int, sendErr *SendError) time.Duration {
+	backoff := time.Duration(viper.GetInt("notifications.retry_backoff_seconds")) * time.Second
+	if backoff <= 0 {
+		backoff = 10 * time.Second
+	}
+	delay := maxRetryDelay
+	if attempt <= 16 {
+		delay = backoff * time.Duration(1<<(attempt-1))
+	}
+	if sendErr != nil && sendErr.RetryAfter > delay {
+		delay = sendErr.RetryAfter
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// RetryDeliveries sends the pending deliveries whose next attempt is due.
+// Each delivery is claimed before it is sent so concurrent sweeps do not send it twice.
+func RetryDeliveries() {
+	client := database.DB().Client
+	deliveries, err := client.NotificationDelivery.Query().
+		Where(entNotificationDelivery.StatusEQ(utils.Pending), entNotificationDelivery.NextAttemptAtLTE(time.Now())).
+		All(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("error getting pending notification deliveries")
+		return
+	}
+
+	for _, delivery := range deliveries {
+		// the claim expires if the attempt does not finish so the delivery is not stuck
+		n, err := client.NotificationDelivery.Update().
+			Where(entNotificationDelivery.ID(delivery.ID), entNotificationDelivery.NextAttemptAt(*delivery.NextAttemptAt)).
+			SetNextAttemptAt(time.Now().Add(2 * deliveryTimeout)).
+			Save(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msgf("error claiming notification delivery %s", delivery.ID)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+
+		target, err := deliveryTarget(client, delivery)
+		if err != nil {
+			log.Error().Err(err).Msgf("error retrying notification delivery %s", delivery.ID)
+			updateDelivery(delivery, func(u *ent.NotificationDeliveryUpdateOne) {
+				u.SetStatus(utils.Failed).SetError(err.Error()).ClearNextAttemptAt()
+			})
+			continue
+		}
+		msg := Message{Event: delivery.Event, Title: delivery.Title, Body: delivery.Body}
+		if delivery.JSONBody != "" {
+			msg.JSONBody = json.RawMessage(delivery.JSONBody)
+		}
+		attemptDelivery(target, delivery, msg)
+	}
+}
+
+// deliveryTarget returns the current settings of the target of a delivery.
+func deliveryTarget(client *ent.Client, delivery *ent.NotificationDelivery) (Target, error) {
+	if delivery.SubscriptionID != nil {
+		sub, err := client.NotificationSubscription.Query().Where(entNotificationSubscription.ID(*delivery.SubscriptionID)).WithUser().Only(context.Background())
+		if err != nil {
+			return Target{}, fmt.Errorf("error getting notification subscription: %v", err)
+		}
+		target := subscriptionTarget(sub, sub.Edges.User)
+		if !target.Enabled {
+			return Target{}, fmt.Errorf("notification subscription is disabled")
+		}
+		return target, nil
+	}
+	if delivery.Target == fmt.Sprintf("%s_webhook_url", delivery.Event) {
+		webhookUrl := viper.GetString(fmt.Sprintf("notifications.%s", delivery.Target))
+		if webhookUrl == "" {
+			return Target{}, fmt.Errorf("notification target %s no longer exists", delivery.Target)
+		}
+		return Target{Name: delivery.Target, Provider: utils.NotificationWebhook, Enabled: true, URL: webhookUrl}, nil
+	}
+	for _, target := range GetTargets() {
+		if target.Name == delivery.Target {
+			if !target.Enabled {
+				return Target{}, fmt.Errorf("notification target %s is disabled", target.Name)
+			}
+			return target, nil
+		}
+	}
+	return Target{}, fmt.Errorf("notification target %s no longer exists", delivery.Target)
+}
+
+func updateDelivery(delivery *ent.NotificationDelivery, update func(u *ent.NotificationDeliveryUpdateOne)) {
+	if delivery == nil {
+		return
+	}
+	u := database.DB().Client.NotificationDelivery.UpdateOneID(delivery.ID)
+	update(u)
+	if _, err := u.Save(context.Background()); err != nil {
+		log.Error().Err(err).Msg("error updating notification delivery")
+	}
+}
+
+// GetDelivery returns a notification delivery.
+func (s *Service) GetDelivery(c echo.Context, id uuid.UUID) (*ent.NotificationDelivery, error) {
+	delivery, err := s.Store.Client.NotificationDelivery.Get(c.Request().Context(), id)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("notification delivery not found")
+		}
+		return nil, fmt.Errorf("error getting notification delivery: %v", err)
+	}
+	return delivery, nil
+}
@@ -0,0 +1,118 @@
+package notification
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// TestTargetConfigRoundTrip writes a target to the config file and reads it back like GetTargets.
+func TestTargetConfigRoundTrip(t *testing.T) {
+	target := Target{
+		Name:         "email",
+		Provider:     utils.NotificationSMTP,
+		Enabled:      true,
+		Events:       []utils.NotificationEvent{utils.NotificationError},
+		SMTPHost:     "smtp.example.com",
+		SMTPPort:     587,
+		SMTPUsername: "user",
+		SMTPPassword: "password",
+		SMTPFrom:     "ganymede@example.com",
+		SMTPTo:       []string{"admin@example.com"},
+	}
+
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	w := viper.New()
+	w.Set("notifications.targets", []Target{target})
+	assert.NoError(t, w.WriteConfigAs(configPath))
+
+	r := viper.New()
+	r.SetConfigFile(configPath)
+	assert.NoError(t, r.ReadInConfig())
+	var targets []Target
+	assert.NoError(t, r.UnmarshalKey("notifications.targets", &targets))
+	assert.Equal(t, []Target{target}, targets)
+	assert.NoError(t, targets[0].Validate())
+}
+
+func TestRetryDelay(t *testing.T) {
+	viper.Set("notifications.retry_backoff_seconds", 10)
+	defer viper.Set("notifications.retry_backoff_seconds", nil)
+
+	tests := []struct {
+		name    string
+		attempt int
+		sendErr *SendError
+		want    time.Duration
+	}{
+		{name: "first attempt", attempt: 1, want: 10 * time.Second},
+		{name: "exponential backoff", attempt: 3, want: 40 * time.Second},
+		{name: "backoff is capped", attempt: 10, want: maxRetryDelay},
+		{name: "large attempts are capped", attempt: 100, want: maxRetryDelay},
+		{name: "retry after is used if longer", attempt: 1, sendErr: &SendError{StatusCode: 429, RetryAfter: time.Minute}, want: time.Minute},
+		{name: "retry after is capped", attempt: 1, sendErr: &SendError{StatusCode: 429, RetryAfter: 24 * time.Hour}, want: maxRetryDelay},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, retryDelay(tt.attempt, tt.sendErr))
+		})
+	}
+}
+
+// TestPublicOnlyTarget checks that subscriptions of users can not reach internal addresses.
+func TestPublicOnlyTarget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	msg := Message{Event: utils.NotificationIsLive, Body: "live"}
+	for _, publicOnly := range []bool{false, true} {
+		provider, err := NewProvider(Target{Name: "webhook", Provider: utils.NotificationWebhook, URL: server.URL, publicOnly: publicOnly})
+		if !assert.NoError(t, err) {
+			continue
+		}
+		err = provider.Send(context.Background(), msg)
+		if publicOnly {
+			assert.ErrorContains(t, err, "connections to 127.0.0.1 are not allowed")
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+
+	assert.Error(t, validatePublicURL("http://localhost:8080/webhook"))
+	assert.Error(t, validatePublicURL("http://[::1]/webhook"))
+	assert.Error(t, validatePublicURL("http://169.254.169.254/latest/meta-data"))
+	assert.Error(t, validatePublicURL("file:///etc/passwd"))
+	assert.NoError(t, validatePublicURL("https://discord.com/api/webhooks/1/abc"))
+}
+
+// TestNewMessageFallback checks that the built-in template is sent if a template fails to render.
+func TestNewMessageFallback(t *testing.T) {
+	data := TemplateData{
+		Event:   utils.NotificationError,
+		Channel: TemplateChannel{DisplayName: "Test Channel"},
+		Vod:     TemplateVod{Title: "Test Stream"},
+	}
+
+	msg, err := newMessage(`{{ .Vod.Title | truncate "four" }} {{ .Channel.Secret }}`, data)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "⚠️ Error: Queue ID  for Test Channel failed at task .", msg.Body)
+		assert.Nil(t, msg.JSONBody)
+	}
+
+	msg, err = newMessage(`{{ .Vod.Title }}`, data)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Test Stream", msg.Body)
+	}
+
+	// events without a built-in template are not sent
+	data.Event = "unknown"
+	_, err = newMessage(`{{ .Vod.Title `, data)
+	assert.Error(t, err)
+}
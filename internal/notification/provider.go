@@ -0,0 +1,241 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Target is a destination notifications are sent to.
+type Target struct {
+	Name     string                     `json:"name"`
+	Provider utils.NotificationProvider `json:"provider"`
+	Enabled  bool                       `json:"enabled"`
+	// Events the target receives, all events if empty.
+	Events []utils.NotificationEvent `json:"events"`
+	// URL is the webhook URL for webhook, discord and apprise, the topic URL for ntfy and the server URL for gotify and matrix.
+	URL string `json:"url"`
+	// Token is the access token for ntfy, gotify and matrix.
+	Token string `json:"token"`
+	// Room is the room ID for matrix.
+	Room string `json:"room"`
+	// SMTP settings, the mapstructure tags are needed to read the snake case keys from the config
+	SMTPHost     string   `json:"smtp_host" mapstructure:"smtp_host"`
+	SMTPPort     int      `json:"smtp_port" mapstructure:"smtp_port"`
+	SMTPUsername string   `json:"smtp_username" mapstructure:"smtp_username"`
+	SMTPPassword string   `json:"smtp_password" mapstructure:"smtp_password"`
+	SMTPFrom     string   `json:"smtp_from" mapstructure:"smtp_from"`
+	SMTPTo       []string `json:"smtp_to" mapstructure:"smtp_to"`
+	// publicOnly refuses connections to internal addresses, it is set for the subscriptions of users that are not admins.
+	publicOnly bool
+}
+
+// Message is a rendered notification.
+type Message struct {
+	Event utils.NotificationEvent
+	Title string
+	Body  string
+	// JSONBody is set if the template rendered a JSON object, it replaces the request body of providers that post JSON.
+	JSONBody json.RawMessage
+}
+
+// Provider sends messages to a target.
+type Provider interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SendError is returned by providers when the target rejected the message.
+type SendError struct {
+	StatusCode int
+	// RetryAfter is the delay requested by the target before retrying (e.g. HTTP 429).
+	RetryAfter time.Duration
+	Body       string
+}
+
+func (e *SendError) Error() string {
+	return fmt.Sprintf("unexpected status code %d: %s", e.StatusCode, e.Body)
+}
+
+// Retryable returns whether sending the message again may succeed.
+func (e *SendError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusRequestTimeout || e.StatusCode >= 500
+}
+
+// NewProvider returns the provider of a target.
+func NewProvider(target Target) (Provider, error) {
+	client := httpClient(target.publicOnly)
+	switch target.Provider {
+	case utils.NotificationWebhook, "":
+		return &webhookProvider{client: client, url: target.URL}, nil
+	case utils.NotificationDiscord:
+		return &discordProvider{client: client, url: target.URL}, nil
+	case utils.NotificationApprise:
+		return &appriseProvider{client: client, url: target.URL}, nil
+	case utils.NotificationNtfy:
+		return &ntfyProvider{client: client, url: target.URL, token: target.Token}, nil
+	case utils.NotificationGotify:
+		return &gotifyProvider{client: client, url: target.URL, token: target.Token}, nil
+	case utils.NotificationSMTP:
+		if target.publicOnly {
+			return nil, fmt.Errorf("%s is not supported for subscriptions", target.Provider)
+		}
+		return &smtpProvider{host: target.SMTPHost, port: target.SMTPPort, username: target.SMTPUsername, password: target.SMTPPassword, from: target.SMTPFrom, to: target.SMTPTo}, nil
+	case utils.NotificationMatrix:
+		return &matrixProvider{client: client, url: target.URL, token: target.Token, room: target.Room}, nil
+	default:
+		return nil, fmt.Errorf("unknown notification provider %s", target.Provider)
+	}
+}
+
+// Validate checks that the settings required by the provider of the target are set.
+func (t Target) Validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	switch t.Provider {
+	case utils.NotificationSMTP:
+		if t.SMTPHost == "" || t.SMTPFrom == "" || len(t.SMTPTo) == 0 {
+			return fmt.Errorf("smtp_host, smtp_from and smtp_to are required for %s", t.Provider)
+		}
+	case utils.NotificationMatrix:
+		if t.URL == "" || t.Token == "" || t.Room == "" {
+			return fmt.Errorf("url, token and room are required for %s", t.Provider)
+		}
+	case utils.NotificationGotify:
+		if t.URL == "" || t.Token == "" {
+			return fmt.Errorf("url and token are required for %s", t.Provider)
+		}
+	default:
+		if _, err := NewProvider(t); err != nil {
+			return err
+		}
+		if t.URL == "" {
+			return fmt.Errorf("url is required for %s", t.Provider)
+		}
+	}
+	return nil
+}
+
+// receives returns whether the target receives notifications for the event.
+func (t Target) receives(event utils.NotificationEvent) bool {
+	if !t.Enabled {
+		return false
+	}
+	if len(t.Events) == 0 {
+		return true
+	}
+	for _, e := range t.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// doRequest sends a request and returns a SendError if the response is not successful.
+func doRequest(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	sendErr := &SendError{StatusCode: resp.StatusCode, Body: string(body)}
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.ParseFloat(retryAfter, 64); err == nil {
+			sendErr.RetryAfter = time.Duration(seconds * float64(time.Second))
+		} else if date, err := http.ParseTime(retryAfter); err == nil {
+			sendErr.RetryAfter = time.Until(date)
+		}
+	}
+	return sendErr
+}
+
+func postJSON(ctx context.Context, client *http.Client, method string, url string, payload interface{}, headers map[string]string) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error marshalling request body: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	return doRequest(client, req)
+}
+
+// httpClient returns the client used to send notifications.
+// Clients of public only targets check the address of every connection after the hostname is resolved, redirects and DNS changes cannot reach internal addresses.
+func httpClient(publicOnly bool) *http.Client {
+	if !publicOnly {
+		return &http.Client{Timeout: 30 * time.Second}
+	}
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return fmt.Errorf("connections to %s are not allowed", host)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			// no proxy so the dialed address is the address of the target
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), it is not covered by net.IP.IsPrivate.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// isPublicIP returns whether an address is routable on the internet.
+func isPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || sharedAddressSpace.Contains(ip))
+}
+
+// validatePublicURL checks that a URL is an http(s) URL that does not point to an internal address.
+// Hostnames are checked when connecting as they can resolve to other addresses later.
+func validatePublicURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url must be an http or https url")
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		return fmt.Errorf("url must have a host")
+	}
+	if ip := net.ParseIP(host); (ip != nil && !isPublicIP(ip)) || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("url must not point to an internal address")
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package notification
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type WebhookRequestBody struct {
+	Content string                  `json:"content"`
+	Body    string                  `json:"body"`
+	Title   string                  `json:"title,omitempty"`
+	Event   utils.NotificationEvent `json:"event,omitempty"`
+}
+
+// webhookProvider posts a generic JSON body to a URL.
+type webhookProvider struct {
+	client *http.Client
+	url    string
+}
+
+func (p *webhookProvider) Send(ctx context.Context, msg Message) error {
+	if msg.JSONBody != nil {
+		return postJSON(ctx, p.client, http.MethodPost, p.url, msg.JSONBody, nil)
+	}
+	return postJSON(ctx, p.client, http.MethodPost, p.url, WebhookRequestBody{
+		Content: msg.Body,
+		Body:    msg.Body,
+		Title:   msg.Title,
+		Event:   msg.Event,
+	}, nil)
+}
+
+type discordEmbed struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description"`
+	Color       int    `json:"color"`
+	Timestamp   string `json:"timestamp"`
+}
+
+// discordProvider posts an embed to a Discord webhook.
+type discordProvider struct {
+	client *http.Client
+	url    string
+}
+
+func (p *discordProvider) Send(ctx context.Context, msg Message) error {
+	// templates can render a complete Discord webhook body (e.g. custom embeds)
+	if msg.JSONBody != nil {
+		return postJSON(ctx, p.client, http.MethodPost, p.url, msg.JSONBody, nil)
+	}
+	return postJSON(ctx, p.client, http.MethodPost, p.url, map[string]interface{}{
+		"embeds": []discordEmbed{{
+			Title:       msg.Title,
+			Description: msg.Body,
+			Color:       eventColor(msg.Event),
+			Timestamp:   time.Now().Format(time.RFC3339),
+		}},
+	}, nil)
+}
+
+// appriseProvider posts to the notify endpoint of an Apprise API server.
+type appriseProvider struct {
+	client *http.Client
+	url    string
+}
+
+func (p *appriseProvider) Send(ctx context.Context, msg Message) error {
+	if msg.JSONBody != nil {
+		return postJSON(ctx, p.client, http.MethodPost, p.url, msg.JSONBody, nil)
+	}
+	notifyType := "info"
+	switch msg.Event {
+	case utils.NotificationError:
+		notifyType = "failure"
+	case utils.NotificationVideoSuccess, utils.NotificationLiveSuccess:
+		notifyType = "success"
+	}
+	return postJSON(ctx, p.client, http.MethodPost, p.url, map[string]string{
+		"title": msg.Title,
+		"body":  msg.Body,
+		"type":  notifyType,
+	}, nil)
+}
+
+// ntfyProvider publishes to a ntfy topic URL.
+type ntfyProvider struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+func (p *ntfyProvider) Send(ctx context.Context, msg Message) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, strings.NewReader(msg.Body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	if msg.Title != "" {
+		req.Header.Set("Title", msg.Title)
+	}
+	if msg.Event == utils.NotificationError {
+		req.Header.Set("Priority", "high")
+		req.Header.Set("Tags", "warning")
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	}
+	return doRequest(p.client, req)
+}
+
+// gotifyProvider creates a message on a Gotify server.
+type gotifyProvider struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+func (p *gotifyProvider) Send(ctx context.Context, msg Message) error {
+	endpoint := strings.TrimSuffix(p.url, "/") + "/message"
+	if msg.JSONBody != nil {
+		return postJSON(ctx, p.client, http.MethodPost, endpoint, msg.JSONBody, map[string]string{"X-Gotify-Key": p.token})
+	}
+	priority := 5
+	if msg.Event == utils.NotificationError {
+		priority = 8
+	}
+	return postJSON(ctx, p.client, http.MethodPost, endpoint, map[string]interface{}{
+		"title":    msg.Title,
+		"message":  msg.Body,
+		"priority": priority,
+	}, map[string]string{"X-Gotify-Key": p.token})
+}
+
+// matrixProvider sends a text message to a Matrix room.
+type matrixProvider struct {
+	client *http.Client
+	url    string
+	token  string
+	room   string
+}
+
+func (p *matrixProvider) Send(ctx context.Context, msg Message) error {
+	body := msg.Body
+	if msg.Title != "" {
+		body = msg.Title + "\n" + msg.Body
+	}
+	endpoint := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s", strings.TrimSuffix(p.url, "/"), url.PathEscape(p.room), uuid.New().String())
+	return postJSON(ctx, p.client, http.MethodPut, endpoint, map[string]string{
+		"msgtype": "m.text",
+		"body":    body,
+	}, map[string]string{"Authorization": "Bearer " + p.token})
+}
+
+// smtpProvider sends an email. Port 465 uses implicit TLS, other ports use STARTTLS if the server supports it.
+type smtpProvider struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+	to       []string
+}
+
+func (p *smtpProvider) Send(ctx context.Context, msg Message) error {
+	port := p.port
+	if port == 0 {
+		port = 587
+	}
+	addr := net.JoinHostPort(p.host, strconv.Itoa(port))
+
+	var auth smtp.Auth
+	if p.username != "" {
+		auth = smtp.PlainAuth("", p.username, p.password, p.host)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", p.from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(p.to, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Title)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	if port != 465 {
+		err := smtp.SendMail(addr, auth, p.from, p.to, []byte(b.String()))
+		if err != nil {
+			return fmt.Errorf("error sending email: %w", err)
+		}
+		return nil
+	}
+
+	dialer := &tls.Dialer{NetDialer: &net.Dialer{Timeout: 30 * time.Second}, Config: &tls.Config{ServerName: p.host}}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("error connecting to smtp server: %w", err)
+	}
+	client, err := smtp.NewClient(conn, p.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("error connecting to smtp server: %w", err)
+	}
+	defer client.Close()
+	if auth != nil {
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("error authenticating with smtp server: %w", err)
+		}
+	}
+	if err := client.Mail(p.from); err != nil {
+		return fmt.Errorf("error sending email: %w", err)
+	}
+	for _, to := range p.to {
+		if err := client.Rcpt(to); err != nil {
+			return fmt.Errorf("error sending email: %w", err)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("error sending email: %w", err)
+	}
+	if _, err := w.Write([]byte(b.String())); err != nil {
+		return fmt.Errorf("error sending email: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("error sending email: %w", err)
+	}
+	return client.Quit()
+}
+
+func eventColor(event utils.NotificationEvent) int {
+	switch event {
+	case utils.NotificationError:
+		return 0xe03131
+	case utils.NotificationIsLive:
+		return 0x9146ff
+	default:
+		return 0x2f9e44
+	}
+}
@@ -0,0 +1,251 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entNotificationSubscription "github.com/zibbp/ganymede/ent/notificationsubscription"
+	entUser "github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/auth"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type Subscription struct {
+	Name       string                     `json:"name"`
+	Enabled    bool                       `json:"enabled"`
+	Events     []utils.NotificationEvent  `json:"events"`
+	ChannelIDs []uuid.UUID                `json:"channel_ids"`
+	Provider   utils.NotificationProvider `json:"provider"`
+	URL        string                     `json:"url"`
+	Token      string                     `json:"token"`
+	Room       string                     `json:"room"`
+	Template   string                     `json:"template"`
+}
+
+// subscriptionTarget returns the target of a subscription. Subscriptions without a URL use the webhook of their user.
+// Only admins can send notifications to internal addresses.
+func subscriptionTarget(sub *ent.NotificationSubscription, u *ent.User) Target {
+	target := Target{
+		Name:       fmt.Sprintf("%s/%s", u.Username, sub.ID),
+		Provider:   sub.Provider,
+		Enabled:    sub.Enabled,
+		Events:     sub.Events,
+		URL:        sub.URL,
+		Token:      sub.Token,
+		Room:       sub.Room,
+		publicOnly: u.Role != utils.AdminRole,
+	}
+	if sub.Name != "" {
+		target.Name = fmt.Sprintf("%s/%s", u.Username, sub.Name)
+	}
+	if target.URL == "" && target.Provider == utils.NotificationWebhook {
+		target.URL = u.Webhook
+	}
+	return target
+}
+
+// validateSubscription checks that a notification can be sent with the target of the subscription.
+func validateSubscription(sub *ent.NotificationSubscription, u *ent.User) error {
+	if sub.Provider == utils.NotificationSMTP {
+		return fmt.Errorf("%s is not supported for subscriptions", sub.Provider)
+	}
+	if len(sub.Events) == 0 {
+		return fmt.Errorf("at least one event is required")
+	}
+	for _, event := range sub.Events {
+		if _, ok := eventTitles[event]; !ok {
+			return fmt.Errorf("unknown event %s", event)
+		}
+	}
+	target := subscriptionTarget(sub, u)
+	if sub.Provider == utils.NotificationWebhook && target.URL == "" {
+		return fmt.Errorf("url is required if the user has no webhook")
+	}
+	if target.publicOnly && target.URL != "" {
+		if err := validatePublicURL(target.URL); err != nil {
+			return err
+		}
+	}
+	return target.Validate()
+}
+
+// sendToSubscribers sends the event to the enabled subscriptions of all users that receive events of the channel.
+func sendToSubscribers(client *ent.Client, data TemplateData, template string) {
+	event := data.Event
+	subscriptions, err := client.NotificationSubscription.Query().
+		Where(
+			entNotificationSubscription.Enabled(true),
+			entNotificationSubscription.Or(
+				entNotificationSubscription.Not(entNotificationSubscription.HasChannels()),
+				entNotificationSubscription.HasChannelsWith(entChannel.ID(data.Channel.ID)),
+			),
+		).
+		WithUser().
+		All(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("error getting notification subscriptions")
+		return
+	}
+
+	channel, err := client.Channel.Get(context.Background(), data.Channel.ID)
+	if err != nil {
+		log.Error().Err(err).Msg("error getting channel of notification")
+		return
+	}
+
+	for _, sub := range subscriptions {
+		target := subscriptionTarget(sub, sub.Edges.User)
+		if !target.receives(event) || target.URL == "" {
+			continue
+		}
+		// users are only notified about the channels they can view
+		canView, err := auth.CanAccessChannel(context.Background(), client, sub.Edges.User, channel, utils.ChannelAccessView)
+		if err != nil {
+			log.Error().Err(err).Str("target", target.Name).Msg("error checking channel access of notification subscription")
+			continue
+		}
+		if !canView {
+			continue
+		}
+		subTemplate := template
+		if sub.Template != "" {
+			subTemplate = sub.Template
+		}
+		if subTemplate == "" {
+			continue
+		}
+		msg, err := newMessage(subTemplate, data)
+		if err != nil {
+			log.Error().Err(err).Str("target", target.Name).Msg("error creating notification")
+			continue
+		}
+		go deliver(target, &sub.ID, msg)
+	}
+}
+
+// GetSubscriptions returns the notification subscriptions of a user.
+func (s *Service) GetSubscriptions(c echo.Context, userID uuid.UUID) ([]*ent.NotificationSubscription, error) {
+	subscriptions, err := s.Store.Client.NotificationSubscription.Query().Where(entNotificationSubscription.HasUserWith(entUser.ID(userID))).WithChannels().Order(ent.Asc(entNotificationSubscription.FieldCreatedAt)).All(c.Request().Context())
+	if err != nil {
+		return nil, fmt.Errorf("error getting notification subscriptions: %v", err)
+	}
+	return subscriptions, nil
+}
+
+func (s *Service) CreateSubscription(c echo.Context, userID uuid.UUID, subDto Subscription) (*ent.NotificationSubscription, error) {
+	u, err := s.Store.Client.User.Get(c.Request().Context(), userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user: %v", err)
+	}
+	create := subscriptionFromDto(subDto)
+	err = validateSubscription(create, u)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := s.Store.Client.NotificationSubscription.Create().
+		SetUser(u).
+		SetName(create.Name).
+		SetEnabled(create.Enabled).
+		SetEvents(create.Events).
+		SetProvider(create.Provider).
+		SetURL(create.URL).
+		SetToken(create.Token).
+		SetRoom(create.Room).
+		SetTemplate(create.Template).
+		AddChannelIDs(subDto.ChannelIDs...).
+		Save(c.Request().Context())
+	if err != nil {
+		return nil, fmt.Errorf("error creating notification subscription: %v", err)
+	}
+	return sub, nil
+}
+
+func (s *Service) UpdateSubscription(c echo.Context, userID uuid.UUID, id uuid.UUID, subDto Subscription) (*ent.NotificationSubscription, error) {
+	sub, err := s.Store.Client.NotificationSubscription.Query().Where(entNotificationSubscription.ID(id), entNotificationSubscription.HasUserWith(entUser.ID(userID))).WithUser().Only(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("notification subscription not found")
+		}
+		return nil, fmt.Errorf("error getting notification subscription: %v", err)
+	}
+
+	update := subscriptionFromDto(subDto)
+	// keep the token if it is not sent, it is not returned by the API
+	if subDto.Token == "" {
+		update.Token = sub.Token
+	}
+	err = validateSubscription(update, sub.Edges.User)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err = sub.Update().
+		SetName(update.Name).
+		SetEnabled(update.Enabled).
+		SetEvents(update.Events).
+		SetProvider(update.Provider).
+		SetURL(update.URL).
+		SetToken(update.Token).
+		SetRoom(update.Room).
+		SetTemplate(update.Template).
+		ClearChannels().
+		AddChannelIDs(subDto.ChannelIDs...).
+		Save(c.Request().Context())
+	if err != nil {
+		return nil, fmt.Errorf("error updating notification subscription: %v", err)
+	}
+	return sub, nil
+}
+
+func (s *Service) DeleteSubscription(c echo.Context, userID uuid.UUID, id uuid.UUID) error {
+	n, err := s.Store.Client.NotificationSubscription.Delete().Where(entNotificationSubscription.ID(id), entNotificationSubscription.HasUserWith(entUser.ID(userID))).Exec(c.Request().Context())
+	if err != nil {
+		return fmt.Errorf("error deleting notification subscription: %v", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("notification subscription not found")
+	}
+	return nil
+}
+
+// UpdateWebhook sets the webhook of a user, the default target of their subscriptions.
+// Only admins can use internal addresses.
+func (s *Service) UpdateWebhook(c echo.Context, userID uuid.UUID, webhook string) error {
+	u, err := s.Store.Client.User.Get(c.Request().Context(), userID)
+	if err != nil {
+		return fmt.Errorf("error getting user: %v", err)
+	}
+	if webhook != "" && u.Role != utils.AdminRole {
+		if err := validatePublicURL(webhook); err != nil {
+			return fmt.Errorf("invalid webhook: %v", err)
+		}
+	}
+	_, err = u.Update().SetWebhook(webhook).Save(c.Request().Context())
+	if err != nil {
+		return fmt.Errorf("error updating webhook: %v", err)
+	}
+	return nil
+}
+
+func subscriptionFromDto(subDto Subscription) *ent.NotificationSubscription {
+	provider := subDto.Provider
+	if provider == "" {
+		provider = utils.NotificationWebhook
+	}
+	return &ent.NotificationSubscription{
+		Name:     subDto.Name,
+		Enabled:  subDto.Enabled,
+		Events:   subDto.Events,
+		Provider: provider,
+		URL:      subDto.URL,
+		Token:    subDto.Token,
+		Room:     subDto.Room,
+		Template: subDto.Template,
+	}
+}
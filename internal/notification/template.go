@@ -0,0 +1,316 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChapter "github.com/zibbp/ganymede/ent/chapter"
+	entQueue "github.com/zibbp/ganymede/ent/queue"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// TemplateData is the data notification templates are executed with.
+type TemplateData struct {
+	Event      utils.NotificationEvent `json:"event"`
+	Channel    TemplateChannel         `json:"channel"`
+	Vod        TemplateVod             `json:"vod"`
+	Queue      *TemplateQueue          `json:"queue"`
+	Playlist   *TemplatePlaylist       `json:"playlist"`
+	FailedTask string                  `json:"failed_task"`
+}
+
+type TemplateChannel struct {
+	ID          uuid.UUID `json:"id"`
+	ExtID       string    `json:"ext_id"`
+	Name        string    `json:"name"`
+	DisplayName string    `json:"display_name"`
+	ImageURL    string    `json:"image_url"`
+}
+
+type TemplateVod struct {
+	ID           uuid.UUID         `json:"id"`
+	ExtID        string            `json:"ext_id"`
+	Platform     utils.VodPlatform `json:"platform"`
+	Type         utils.VodType     `json:"type"`
+	Title        string            `json:"title"`
+	Duration     int               `json:"duration"`
+	Views        int               `json:"views"`
+	Resolution   string            `json:"resolution"`
+	StreamedAt   time.Time         `json:"streamed_at"`
+	CreatedAt    time.Time         `json:"created_at"`
+	URL          string            `json:"url"`
+	ThumbnailURL string            `json:"thumbnail_url"`
+	Chapters     []TemplateChapter `json:"chapters"`
+}
+
+type TemplateChapter struct {
+	Title string `json:"title"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+type TemplateQueue struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type TemplatePlaylist struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+// newTemplateData returns the template data of an event. The chapters of the video are queried if they are not loaded.
+func newTemplateData(client *ent.Client, event utils.NotificationEvent, channelItem *ent.Channel, vodItem *ent.Vod, qItem *ent.Queue, failedTask string) TemplateData {
+	data := TemplateData{
+		Event: event,
+		Channel: TemplateChannel{
+			ID:          channelItem.ID,
+			ExtID:       channelItem.ExtID,
+			Name:        channelItem.Name,
+			DisplayName: channelItem.DisplayName,
+			ImageURL:    cdnURL(channelItem.ImagePath),
+		},
+		Vod: TemplateVod{
+			ID:           vodItem.ID,
+			ExtID:        vodItem.ExtID,
+			Platform:     vodItem.Platform,
+			Type:         vodItem.Type,
+			Title:        vodItem.Title,
+			Duration:     vodItem.Duration,
+			Views:        vodItem.Views,
+			Resolution:   vodItem.Resolution,
+			StreamedAt:   vodItem.StreamedAt,
+			CreatedAt:    vodItem.CreatedAt,
+			ThumbnailURL: cdnURL(vodItem.WebThumbnailPath),
+			Chapters:     []TemplateChapter{},
+		},
+		FailedTask: failedTask,
+	}
+	if frontend := os.Getenv("FRONTEND_HOST"); frontend != "" {
+		data.Vod.URL = fmt.Sprintf("%s/videos/%s", strings.TrimSuffix(frontend, "/"), vodItem.ID)
+	}
+	if qItem != nil {
+		data.Queue = &TemplateQueue{ID: qItem.ID, CreatedAt: qItem.CreatedAt}
+	}
+
+	chapters := vodItem.Edges.Chapters
+	if chapters == nil && client != nil {
+		var err error
+		chapters, err = client.Chapter.Query().Where(entChapter.HasVodWith(entVod.ID(vodItem.ID))).Order(ent.Asc(entChapter.FieldStart)).All(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msg("error getting chapters for notification")
+		}
+	}
+	for _, chapter := range chapters {
+		data.Vod.Chapters = append(data.Vod.Chapters, TemplateChapter{Title: chapter.Title, Start: chapter.Start, End: chapter.End})
+	}
+	return data
+}
+
+// variables returns the variables of the original {{variable}} template syntax.
+// They are available as functions so templates written before Go templates were supported still render.
+func (d TemplateData) variables() map[string]interface{} {
+	variables := map[string]interface{}{
+		// Channel variables
+		"channel_id":           d.Channel.ID,
+		"channel_ext_id":       d.Channel.ExtID,
+		"channel_name":         d.Channel.Name,
+		"channel_display_name": d.Channel.DisplayName,
+		// Vod variables
+		"vod_id":                 d.Vod.ID,
+		"vod_ext_id":             d.Vod.ExtID,
+		"vod_platform":           d.Vod.Platform,
+		"vod_type":               d.Vod.Type,
+		"vod_title":              d.Vod.Title,
+		"vod_duration":           d.Vod.Duration,
+		"vod_duration_formatted": humanDuration(d.Vod.Duration),
+		"vod_views":              d.Vod.Views,
+		"vod_resolution":         d.Vod.Resolution,
+		"vod_streamed_at":        d.Vod.StreamedAt,
+		"vod_created_at":         d.Vod.CreatedAt,
+		"vod_url":                d.Vod.URL,
+		"vod_thumbnail_url":      d.Vod.ThumbnailURL,
+		// Error
+		"failed_task": d.FailedTask,
+		// Queue and playlist variables are empty if the event has none, templates using them still render
+		"queue_id":         "",
+		"queue_created_at": "",
+		"playlist_id":      "",
+		"playlist_name":    "",
+	}
+	// Queue variables
+	if d.Queue != nil {
+		variables["queue_id"] = d.Queue.ID
+		variables["queue_created_at"] = d.Queue.CreatedAt
+	}
+	// Playlist variables
+	if d.Playlist != nil {
+		variables["playlist_id"] = d.Playlist.ID
+		variables["playlist_name"] = d.Playlist.Name
+	}
+	return variables
+}
+
+func templateFuncs(data TemplateData) template.FuncMap {
+	funcs := template.FuncMap{
+		"humanDuration": humanDuration,
+		"formatDate": func(layout string, t time.Time) string {
+			return t.Format(layout)
+		},
+		"truncate": func(length int, s string) string {
+			if utf8.RuneCountInString(s) <= length {
+				return s
+			}
+			return string([]rune(s)[:length]) + "…"
+		},
+		"thumbnailURL": func(v TemplateVod) string {
+			return v.ThumbnailURL
+		},
+		// toJSON encodes a value for use in JSON bodies, strings are quoted and escaped
+		"toJSON": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}
+	for name, value := range data.variables() {
+		value := value
+		funcs[name] = func() interface{} { return value }
+	}
+	return funcs
+}
+
+// RenderTemplate executes a notification template.
+func RenderTemplate(tmpl string, data TemplateData) (string, error) {
+	t, err := template.New("notification").Funcs(templateFuncs(data)).Option("missingkey=zero").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("error parsing template: %v", err)
+	}
+	var b bytes.Buffer
+	err = t.Execute(&b, data)
+	if err != nil {
+		return "", fmt.Errorf("error executing template: %v", err)
+	}
+	return b.String(), nil
+}
+
+// newMessage renders the template of a notification.
+// A rendered JSON object is sent as the request body by providers that post JSON.
+// If the template fails to render the built-in template of the event is used, the template source is never sent.
+func newMessage(tmpl string, data TemplateData) (Message, error) {
+	body, err := RenderTemplate(tmpl, data)
+	if err != nil {
+		log.Error().Err(err).Str("event", string(data.Event)).Msg("error rendering notification template, using the built-in template")
+		fallback, ok := fallbackTemplates[data.Event]
+		if !ok {
+			fallback, ok = defaultTemplates[data.Event]
+		}
+		if !ok {
+			return Message{}, fmt.Errorf("error rendering notification template: %v", err)
+		}
+		body, err = RenderTemplate(fallback, data)
+		if err != nil {
+			return Message{}, fmt.Errorf("error rendering built-in notification template: %v", err)
+		}
+	}
+	msg := Message{
+		Event: data.Event,
+		Title: eventTitles[data.Event],
+		Body:  body,
+	}
+	if isJSONObject(body) {
+		msg.JSONBody = json.RawMessage(strings.TrimSpace(body))
+	}
+	return msg, nil
+}
+
+// isJSONObject returns whether a rendered template is a JSON object.
+func isJSONObject(body string) bool {
+	trimmed := strings.TrimSpace(body)
+	return strings.HasPrefix(trimmed, "{") && json.Valid([]byte(trimmed))
+}
+
+// humanDuration formats seconds as e.g. 1h 2m 3s.
+func humanDuration(seconds int) string {
+	d := time.Duration(seconds) * time.Second
+	h := int(d.Hours())
+	m := int(d.Minutes()) % 60
+	s := int(d.Seconds()) % 60
+	switch {
+	case h > 0:
+		return fmt.Sprintf("%dh %dm %ds", h, m, s)
+	case m > 0:
+		return fmt.Sprintf("%dm %ds", m, s)
+	default:
+		return fmt.Sprintf("%ds", s)
+	}
+}
+
+// cdnURL returns the URL of a file in the /vods tree served by the CDN (CDN_URL).
+func cdnURL(path string) string {
+	cdn := os.Getenv("CDN_URL")
+	if cdn == "" || path == "" {
+		return ""
+	}
+	return strings.TrimSuffix(cdn, "/") + path
+}
+
+type Preview struct {
+	Event utils.NotificationEvent `json:"event"`
+	Title string                  `json:"title"`
+	Body  string                  `json:"body"`
+	// JSON is true if the template rendered a JSON object, it is sent as the request body by providers that post JSON.
+	JSON bool `json:"json"`
+}
+
+// Preview renders a template against a video and optionally a queue item without sending it.
+func (s *Service) Preview(c echo.Context, tmpl string, event utils.NotificationEvent, vodID uuid.UUID, queueID *uuid.UUID, failedTask string) (*Preview, error) {
+	ctx := c.Request().Context()
+	vodItem, err := s.Store.Client.Vod.Query().Where(entVod.ID(vodID)).WithChannel().WithChapters(func(q *ent.ChapterQuery) {
+		q.Order(ent.Asc(entChapter.FieldStart))
+	}).Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("vod not found")
+		}
+		return nil, fmt.Errorf("error getting vod: %v", err)
+	}
+
+	var qItem *ent.Queue
+	if queueID != nil {
+		// only the queue items of the vod can be previewed
+		qItem, err = s.Store.Client.Queue.Query().Where(entQueue.ID(*queueID), entQueue.HasVodWith(entVod.ID(vodID))).Only(ctx)
+		if err != nil {
+			if _, ok := err.(*ent.NotFoundError); ok {
+				return nil, fmt.Errorf("queue item not found")
+			}
+			return nil, fmt.Errorf("error getting queue item: %v", err)
+		}
+	}
+
+	data := newTemplateData(s.Store.Client, event, vodItem.Edges.Channel, vodItem, qItem, failedTask)
+	if event == utils.NotificationPlaylistVod {
+		data.Playlist = &TemplatePlaylist{ID: uuid.Nil, Name: "Preview Playlist"}
+	}
+	body, err := RenderTemplate(tmpl, data)
+	if err != nil {
+		return nil, err
+	}
+	return &Preview{
+		Event: event,
+		Title: eventTitles[event],
+		Body:  body,
+		JSON:  isJSONObject(body),
+	}, nil
+}
@@ -0,0 +1,142 @@
+package permission
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/channelpermission"
+	"github.com/zibbp/ganymede/ent/group"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type Service struct {
+	Store *database.Database
+}
+
+func NewService(store *database.Database) *Service {
+	return &Service{Store: store}
+}
+
+type Group struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	UserIDs     []uuid.UUID `json:"user_ids"`
+}
+
+// ChannelPermission grants a user or a group an access to a channel.
+type ChannelPermission struct {
+	Access  utils.ChannelAccess `json:"access"`
+	UserID  *uuid.UUID          `json:"user_id"`
+	GroupID *uuid.UUID          `json:"group_id"`
+}
+
+func (s *Service) GetGroups(c echo.Context) ([]*ent.Group, error) {
+	groups, err := s.Store.Client.Group.Query().WithUsers().Order(ent.Asc(group.FieldName)).All(c.Request().Context())
+	if err != nil {
+		return nil, fmt.Errorf("error getting groups: %v", err)
+	}
+	return groups, nil
+}
+
+func (s *Service) GetGroup(c echo.Context, id uuid.UUID) (*ent.Group, error) {
+	g, err := s.Store.Client.Group.Query().Where(group.ID(id)).WithUsers().Only(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("group not found")
+		}
+		return nil, fmt.Errorf("error getting group: %v", err)
+	}
+	return g, nil
+}
+
+func (s *Service) CreateGroup(c echo.Context, groupDto Group) (*ent.Group, error) {
+	g, err := s.Store.Client.Group.Create().SetName(groupDto.Name).SetDescription(groupDto.Description).AddUserIDs(groupDto.UserIDs...).Save(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.ConstraintError); ok {
+			return nil, fmt.Errorf("group already exists")
+		}
+		return nil, fmt.Errorf("error creating group: %v", err)
+	}
+	return s.GetGroup(c, g.ID)
+}
+
+// UpdateGroup updates a group and replaces its users.
+func (s *Service) UpdateGroup(c echo.Context, id uuid.UUID, groupDto Group) (*ent.Group, error) {
+	_, err := s.Store.Client.Group.UpdateOneID(id).SetName(groupDto.Name).SetDescription(groupDto.Description).ClearUsers().AddUserIDs(groupDto.UserIDs...).Save(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("group not found")
+		}
+		if _, ok := err.(*ent.ConstraintError); ok {
+			return nil, fmt.Errorf("group already exists")
+		}
+		return nil, fmt.Errorf("error updating group: %v", err)
+	}
+	return s.GetGroup(c, id)
+}
+
+// DeleteGroup deletes a group and the channel permissions granted to it.
+func (s *Service) DeleteGroup(c echo.Context, id uuid.UUID) error {
+	err := s.Store.Client.Group.DeleteOneID(id).Exec(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return fmt.Errorf("group not found")
+		}
+		return fmt.Errorf("error deleting group: %v", err)
+	}
+	return nil
+}
+
+func (s *Service) GetChannelPermissions(c echo.Context, channelID uuid.UUID) ([]*ent.ChannelPermission, error) {
+	permissions, err := s.Store.Client.ChannelPermission.Query().Where(channelpermission.HasChannelWith(channel.ID(channelID))).WithUser().WithGroup().Order(ent.Asc(channelpermission.FieldCreatedAt)).All(c.Request().Context())
+	if err != nil {
+		return nil, fmt.Errorf("error getting channel permissions: %v", err)
+	}
+	return permissions, nil
+}
+
+// CreateChannelPermission grants a user or a group, but not both, an access to a channel.
+func (s *Service) CreateChannelPermission(c echo.Context, channelID uuid.UUID, permissionDto ChannelPermission) (*ent.ChannelPermission, error) {
+	if (permissionDto.UserID == nil) == (permissionDto.GroupID == nil) {
+		return nil, fmt.Errorf("permission must be granted to either a user or a group")
+	}
+	p, err := s.Store.Client.ChannelPermission.Create().SetChannelID(channelID).SetAccess(permissionDto.Access).SetNillableUserID(permissionDto.UserID).SetNillableGroupID(permissionDto.GroupID).Save(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.ConstraintError); ok {
+			return nil, fmt.Errorf("channel, user or group not found")
+		}
+		return nil, fmt.Errorf("error creating channel permission: %v", err)
+	}
+
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditChannelPermissionGrant, EntityType: "channel", EntityID: channelID.String(), Details: map[string]interface{}{"permission_id": p.ID, "access": p.Access, "user_id": permissionDto.UserID, "group_id": permissionDto.GroupID}})
+	return p, nil
+}
+
+func (s *Service) DeleteChannelPermission(c echo.Context, channelID uuid.UUID, id uuid.UUID) error {
+	ctx := c.Request().Context()
+	p, err := s.Store.Client.ChannelPermission.Query().Where(channelpermission.ID(id), channelpermission.HasChannelWith(channel.ID(channelID))).WithUser().WithGroup().Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return fmt.Errorf("channel permission not found")
+		}
+		return fmt.Errorf("error getting channel permission: %v", err)
+	}
+	if err := s.Store.Client.ChannelPermission.DeleteOne(p).Exec(ctx); err != nil {
+		return fmt.Errorf("error deleting channel permission: %v", err)
+	}
+
+	details := map[string]interface{}{"permission_id": p.ID, "access": p.Access}
+	if p.Edges.User != nil {
+		details["user_id"] = p.Edges.User.ID
+	}
+	if p.Edges.Group != nil {
+		details["group_id"] = p.Edges.Group.ID
+	}
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditChannelPermissionRevoke, EntityType: "channel", EntityID: channelID.String(), Details: details})
+	return nil
+}
@@ -0,0 +1,121 @@
+package platform
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/chapter"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Platform is implemented by every streaming platform Ganymede can watch and archive from.
+type Platform interface {
+	// GetUser fetches a user (channel) by their login name.
+	GetUser(login string) (User, error)
+	// GetUserByID fetches a user (channel) by their ID.
+	GetUserByID(id string) (User, error)
+	// GetLiveStreams returns the streams that are currently live for the given logins. Offline users are omitted.
+	GetLiveStreams(logins []string) ([]LiveStream, error)
+	// GetVideos lists all videos of a user of the given type (archive, highlight, upload).
+	// Listed videos are not guaranteed to have Category and SubOnly populated.
+	GetVideos(userID string, videoType string) ([]Video, error)
+	// GetVideo fetches the full metadata of a video.
+	GetVideo(id string) (Video, error)
+	// GetChapters fetches the chapters of a video. The duration is used as the end of the last chapter.
+	GetChapters(videoID string, duration int) ([]chapter.Chapter, error)
+	// GetMutedSegments fetches the muted segments of a video.
+	GetMutedSegments(videoID string) ([]MutedSegment, error)
+	// GetClip fetches a clip by its ID (slug).
+	GetClip(id string) (Clip, error)
+	// GetClips lists the clips of a user created after startedAt with at least minViews views.
+	GetClips(userID string, startedAt time.Time, minViews int) ([]Clip, error)
+	// CheckAccessToken checks that a user access token is valid.
+	CheckAccessToken(token string) error
+}
+
+type User struct {
+	ID              string `json:"id"`
+	Login           string `json:"login"`
+	DisplayName     string `json:"display_name"`
+	Description     string `json:"description"`
+	ProfileImageURL string `json:"profile_image_url"`
+	OfflineImageURL string `json:"offline_image_url"`
+}
+
+type LiveStream struct {
+	ID           string    `json:"id"`
+	UserID       string    `json:"user_id"`
+	UserLogin    string    `json:"user_login"`
+	UserName     string    `json:"user_name"`
+	GameID       string    `json:"game_id"`
+	GameName     string    `json:"game_name"`
+	Type         string    `json:"type"`
+	Title        string    `json:"title"`
+	ViewerCount  int64     `json:"viewer_count"`
+	StartedAt    time.Time `json:"started_at"`
+	Language     string    `json:"language"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+}
+
+type Video struct {
+	ID            string            `json:"id"`
+	StreamID      string            `json:"stream_id"`
+	UserID        string            `json:"user_id"`
+	UserLogin     string            `json:"user_login"`
+	UserName      string            `json:"user_name"`
+	Title         string            `json:"title"`
+	Description   string            `json:"description"`
+	CreatedAt     time.Time         `json:"created_at"`
+	URL           string            `json:"url"`
+	ThumbnailURL  string            `json:"thumbnail_url"`
+	ViewCount     int64             `json:"view_count"`
+	Language      string            `json:"language"`
+	Type          string            `json:"type"`
+	Duration      int               `json:"duration"`
+	Category      string            `json:"category"`
+	SubOnly       bool              `json:"sub_only"`
+	Processing    bool              `json:"processing"`
+	MutedSegments []MutedSegment    `json:"muted_segments"`
+	Chapters      []chapter.Chapter `json:"chapters"`
+}
+
+type Clip struct {
+	ID           string    `json:"id"`
+	URL          string    `json:"url"`
+	UserID       string    `json:"user_id"`
+	UserName     string    `json:"user_name"`
+	CreatorName  string    `json:"creator_name"`
+	VideoID      string    `json:"video_id"`
+	VodOffset    int       `json:"vod_offset"`
+	Title        string    `json:"title"`
+	ViewCount    int64     `json:"view_count"`
+	CreatedAt    time.Time `json:"created_at"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	Duration     int       `json:"duration"`
+}
+
+type MutedSegment struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// platforms are the implementations of the supported platforms.
+var platforms = map[utils.VodPlatform]Platform{
+	utils.PlatformTwitch: &TwitchPlatform{},
+}
+
+// Get returns the implementation of a platform.
+func Get(p utils.VodPlatform) (Platform, error) {
+	impl, ok := platforms[p]
+	if !ok {
+		return nil, fmt.Errorf("platform %s is not supported", p)
+	}
+	return impl, nil
+}
+
+// Register sets the implementation of a platform and returns the previous one, e.g. to fake a platform in tests.
+func Register(p utils.VodPlatform, impl Platform) Platform {
+	previous := platforms[p]
+	platforms[p] = impl
+	return previous
+}
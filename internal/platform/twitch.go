@@ -0,0 +1,277 @@
+package platform
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/chapter"
+	"github.com/zibbp/ganymede/internal/twitch"
+)
+
+// TwitchPlatform implements Platform using the Twitch Helix and GraphQL APIs.
+type TwitchPlatform struct{}
+
+func (t *TwitchPlatform) GetUser(login string) (User, error) {
+	tChannel, err := twitch.API.GetUserByLogin(login)
+	if err != nil {
+		return User{}, err
+	}
+
+	return convertTwitchUser(tChannel), nil
+}
+
+func (t *TwitchPlatform) GetUserByID(id string) (User, error) {
+	tChannel, err := twitch.API.GetUserByID(id)
+	if err != nil {
+		return User{}, err
+	}
+
+	return convertTwitchUser(tChannel), nil
+}
+
+func convertTwitchUser(tChannel twitch.Channel) User {
+	return User{
+		ID:              tChannel.ID,
+		Login:           tChannel.Login,
+		DisplayName:     tChannel.DisplayName,
+		Description:     tChannel.Description,
+		ProfileImageURL: tChannel.ProfileImageURL,
+		OfflineImageURL: tChannel.OfflineImageURL,
+	}
+}
+
+func (t *TwitchPlatform) GetLiveStreams(logins []string) ([]LiveStream, error) {
+	var streams []LiveStream
+
+	// split into 99 channels per requests to avoid 100 channel limit
+	for i := 0; i < len(logins); i += 99 {
+		end := i + 99
+		if end > len(logins) {
+			end = len(logins)
+		}
+
+		// generate query string for twitch api
+		queryString := "?user_login=" + strings.Join(logins[i:end], "&user_login=")
+
+		twitchStreams, err := twitch.NewService().GetStreams(queryString)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range twitchStreams.Data {
+			stream, err := convertTwitchLive(s)
+			if err != nil {
+				return nil, err
+			}
+			streams = append(streams, stream)
+		}
+	}
+
+	return streams, nil
+}
+
+func (t *TwitchPlatform) GetVideos(userID string, videoType string) ([]Video, error) {
+	twitchVideos, err := twitch.GetVideosByUser(userID, videoType)
+	if err != nil {
+		return nil, err
+	}
+
+	videos := make([]Video, 0, len(twitchVideos))
+	for _, v := range twitchVideos {
+		video, err := convertTwitchVideo(twitch.Vod{
+			ID:           v.ID,
+			StreamID:     v.StreamID,
+			UserID:       v.UserID,
+			UserLogin:    string(v.UserLogin),
+			UserName:     string(v.UserName),
+			Title:        v.Title,
+			Description:  v.Description,
+			CreatedAt:    v.CreatedAt,
+			URL:          v.URL,
+			ThumbnailURL: v.ThumbnailURL,
+			ViewCount:    v.ViewCount,
+			Language:     string(v.Language),
+			Type:         string(v.Type),
+			Duration:     v.Duration,
+		})
+		if err != nil {
+			return nil, err
+		}
+		videos = append(videos, video)
+	}
+
+	return videos, nil
+}
+
+func (t *TwitchPlatform) GetVideo(id string) (Video, error) {
+	tVod, err := twitch.NewService().GetVodByID(id)
+	if err != nil {
+		return Video{}, err
+	}
+
+	video, err := convertTwitchVideo(tVod)
+	if err != nil {
+		return Video{}, err
+	}
+
+	// Query the video using Twitch's GraphQL API for the category and restrictions
+	gqlVideo, err := twitch.GQLGetVideo(id)
+	if err != nil {
+		return Video{}, fmt.Errorf("error getting video from GraphQL API: %v", err)
+	}
+	video.Category = gqlVideo.Data.Video.Game.Name
+	video.SubOnly = strings.Contains(gqlVideo.Data.Video.ResourceRestriction.Type, "SUB")
+
+	return video, nil
+}
+
+func (t *TwitchPlatform) GetChapters(videoID string, duration int) ([]chapter.Chapter, error) {
+	twitchChapters, err := twitch.GQLGetChapters(videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := twitchChapters.Data.Video.Moments.Edges
+	chapters := make([]chapter.Chapter, len(edges))
+	for i := 0; i < len(edges); i++ {
+		chapters[i].ID = edges[i].Node.ID
+		chapters[i].Title = edges[i].Node.Description
+		chapters[i].Type = string(edges[i].Node.Type)
+		chapters[i].Start = int(edges[i].Node.PositionMilliseconds / 1000)
+
+		if i+1 < len(edges) {
+			chapters[i].End = int(edges[i+1].Node.PositionMilliseconds / 1000)
+		} else {
+			chapters[i].End = duration
+		}
+	}
+
+	return chapters, nil
+}
+
+func (t *TwitchPlatform) GetMutedSegments(videoID string) ([]MutedSegment, error) {
+	mutedSegments, err := twitch.GQLGetMutedSegments(videoID)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := []MutedSegment{}
+	for _, s := range mutedSegments.Data.Video.MuteInfo.MutedSegmentConnection.Nodes {
+		segments = append(segments, MutedSegment{
+			Start: s.Offset,
+			End:   s.Offset + s.Duration,
+		})
+	}
+
+	return segments, nil
+}
+
+func (t *TwitchPlatform) GetClip(id string) (Clip, error) {
+	tClip, err := twitch.NewService().GetClipByID(id)
+	if err != nil {
+		return Clip{}, err
+	}
+
+	return convertTwitchClip(tClip)
+}
+
+func (t *TwitchPlatform) GetClips(userID string, startedAt time.Time, minViews int) ([]Clip, error) {
+	twitchClips, err := twitch.GetClipsByUser(userID, startedAt.UTC().Format(time.RFC3339), time.Now().UTC().Format(time.RFC3339), minViews)
+	if err != nil {
+		return nil, err
+	}
+
+	clips := make([]Clip, 0, len(twitchClips))
+	for _, c := range twitchClips {
+		clip, err := convertTwitchClip(c)
+		if err != nil {
+			return nil, err
+		}
+		clips = append(clips, clip)
+	}
+
+	return clips, nil
+}
+
+func convertTwitchClip(c twitch.Clip) (Clip, error) {
+	parsedDate, err := time.Parse(time.RFC3339, c.CreatedAt)
+	if err != nil {
+		return Clip{}, fmt.Errorf("error parsing date: %v", err)
+	}
+
+	return Clip{
+		ID:           c.ID,
+		URL:          c.URL,
+		UserID:       c.BroadcasterID,
+		UserName:     c.BroadcasterName,
+		CreatorName:  c.CreatorName,
+		VideoID:      c.VideoID,
+		VodOffset:    c.VodOffset,
+		Title:        c.Title,
+		ViewCount:    c.ViewCount,
+		CreatedAt:    parsedDate,
+		ThumbnailURL: c.ThumbnailURL,
+		Duration:     int(math.Ceil(c.Duration)),
+	}, nil
+}
+
+func convertTwitchVideo(v twitch.Vod) (Video, error) {
+	// Parse new Twitch API duration
+	parsedDuration, err := time.ParseDuration(v.Duration)
+	if err != nil {
+		return Video{}, fmt.Errorf("error parsing duration: %v", err)
+	}
+
+	// Parse Twitch date to time.Time
+	parsedDate, err := time.Parse(time.RFC3339, v.CreatedAt)
+	if err != nil {
+		return Video{}, fmt.Errorf("error parsing date: %v", err)
+	}
+
+	return Video{
+		ID:           v.ID,
+		StreamID:     v.StreamID,
+		UserID:       v.UserID,
+		UserLogin:    v.UserLogin,
+		UserName:     v.UserName,
+		Title:        v.Title,
+		Description:  v.Description,
+		CreatedAt:    parsedDate,
+		URL:          v.URL,
+		ThumbnailURL: v.ThumbnailURL,
+		ViewCount:    v.ViewCount,
+		Language:     v.Language,
+		Type:         v.Type,
+		Duration:     int(parsedDuration.Seconds()),
+		// the best way I know to check if a vod is processing / still being streamed
+		Processing: strings.Contains(v.ThumbnailURL, "processing"),
+	}, nil
+}
+
+func convertTwitchLive(l twitch.Live) (LiveStream, error) {
+	parsedDate, err := time.Parse(time.RFC3339, l.StartedAt)
+	if err != nil {
+		return LiveStream{}, fmt.Errorf("error parsing date: %v", err)
+	}
+
+	return LiveStream{
+		ID:           l.ID,
+		UserID:       l.UserID,
+		UserLogin:    l.UserLogin,
+		UserName:     l.UserName,
+		GameID:       l.GameID,
+		GameName:     l.GameName,
+		Type:         l.Type,
+		Title:        l.Title,
+		ViewerCount:  l.ViewerCount,
+		StartedAt:    parsedDate,
+		Language:     l.Language,
+		ThumbnailURL: l.ThumbnailURL,
+	}, nil
+}
+
+func (t *TwitchPlatform) CheckAccessToken(token string) error {
+	return twitch.CheckUserAccessToken(token)
+}
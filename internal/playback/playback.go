@@ -2,6 +2,7 @@ package playback
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -176,7 +177,7 @@ func (s *Service) StartPlayback(c echo.Context, videoId uuid.UUID) error {
 	}
 
 	// add a view to the video
-	err = s.Store.Client.Vod.UpdateOne(video).AddLocalViews(1).Exec(c.Request().Context())
+	err = s.Store.Client.Vod.UpdateOne(video).AddLocalViews(1).SetLastViewedAt(time.Now()).Exec(c.Request().Context())
 	if err != nil {
 		return fmt.Errorf("error adding view to video: %v", err)
 	}
@@ -6,9 +6,14 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/playlist"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/notification"
 )
 
 type Service struct {
@@ -41,7 +46,7 @@ func (s *Service) CreatePlaylist(c echo.Context, playlistDto Playlist) (*ent.Pla
 }
 
 func (s *Service) AddVodToPlaylist(c echo.Context, playlistID uuid.UUID, vodID uuid.UUID) error {
-	_, err := s.Store.Client.Playlist.Query().Where(playlist.ID(playlistID)).Only(c.Request().Context())
+	p, err := s.Store.Client.Playlist.Query().Where(playlist.ID(playlistID)).Only(c.Request().Context())
 	if err != nil {
 		return fmt.Errorf("playlist not found")
 	}
@@ -54,11 +59,28 @@ func (s *Service) AddVodToPlaylist(c echo.Context, playlistID uuid.UUID, vodID u
 		return fmt.Errorf("error adding vod to playlist: %v", err)
 	}
 
+	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID)).WithChannel().Only(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("error getting vod for playlist notification")
+		return nil
+	}
+	notification.NewService(s.Store).SendPlaylistVodNotification(p, v.Edges.Channel, v)
+
 	return nil
 }
 
+// visiblePlaylists returns the predicates hiding the playlists whose videos are all in channels the user of the request can't see.
+// Empty playlists are visible.
+func visiblePlaylists(c echo.Context) []predicate.Playlist {
+	vods := auth.VisibleVods(auth.Viewer(c))
+	if vods == nil {
+		return nil
+	}
+	return []predicate.Playlist{playlist.Or(playlist.Not(playlist.HasVods()), playlist.HasVodsWith(vods...))}
+}
+
 func (s *Service) GetPlaylists(c echo.Context) ([]*ent.Playlist, error) {
-	playlists, err := s.Store.Client.Playlist.Query().Order(ent.Desc(playlist.FieldCreatedAt)).All(c.Request().Context())
+	playlists, err := s.Store.Client.Playlist.Query().Where(visiblePlaylists(c)...).Order(ent.Desc(playlist.FieldCreatedAt)).All(c.Request().Context())
 	if err != nil {
 		return nil, fmt.Errorf("error getting playlists: %v", err)
 	}
@@ -67,16 +89,19 @@ func (s *Service) GetPlaylists(c echo.Context) ([]*ent.Playlist, error) {
 }
 
 func (s *Service) GetPlaylist(c echo.Context, playlistID uuid.UUID) (*ent.Playlist, error) {
-	rPlaylist, err := s.Store.Client.Playlist.Query().Where(playlist.ID(playlistID)).WithVods().Order(ent.Desc(playlist.FieldCreatedAt)).Only(c.Request().Context())
+	rPlaylist, err := s.Store.Client.Playlist.Query().Where(playlist.ID(playlistID)).Where(visiblePlaylists(c)...).WithVods(func(q *ent.VodQuery) {
+		// only the vods of the channels the user can see
+		q.Where(auth.VisibleVods(auth.Viewer(c))...)
+	}).Order(ent.Desc(playlist.FieldCreatedAt)).Only(c.Request().Context())
+	if err != nil {
+		return nil, fmt.Errorf("error getting playlist: %v", err)
+	}
 	// Order VODs by date streamed
 	tmpVods := rPlaylist.Edges.Vods
 	sort.Slice(tmpVods, func(i, j int) bool {
 		return tmpVods[i].StreamedAt.After(tmpVods[j].StreamedAt)
 	})
 	rPlaylist.Edges.Vods = tmpVods
-	if err != nil {
-		return nil, fmt.Errorf("error getting playlist: %v", err)
-	}
 
 	return rPlaylist, nil
 }
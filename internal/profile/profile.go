@@ -0,0 +1,154 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/archiveprofile"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type Service struct {
+	Store *database.Database
+}
+
+func NewService(store *database.Database) *Service {
+	return &Service{Store: store}
+}
+
+type Profile struct {
+	ID             uuid.UUID         `json:"id"`
+	Name           string            `json:"name"`
+	Quality        string            `json:"quality"`
+	VideoConvert   string            `json:"video_convert"`
+	SaveAsHls      *bool             `json:"save_as_hls"`
+	RenderChat     bool              `json:"render_chat"`
+	ChatRender     string            `json:"chat_render"`
+	StreamlinkLive string            `json:"streamlink_live"`
+	FolderTemplate string            `json:"folder_template"`
+	FileTemplate   string            `json:"file_template"`
+	ProxyPolicy    utils.ProxyPolicy `json:"proxy_policy"`
+}
+
+// Settings are the settings used while archiving a video.
+// They are the global config overridden by the archive profile of the video.
+type Settings struct {
+	VideoConvert   string
+	SaveAsHls      bool
+	ChatRender     string
+	StreamlinkLive string
+	ProxyPolicy    utils.ProxyPolicy
+}
+
+func (s *Service) GetProfiles(c echo.Context) ([]*ent.ArchiveProfile, error) {
+	profiles, err := s.Store.Client.ArchiveProfile.Query().Order(ent.Asc(archiveprofile.FieldName)).All(c.Request().Context())
+	if err != nil {
+		return nil, fmt.Errorf("error getting archive profiles: %v", err)
+	}
+	return profiles, nil
+}
+
+func (s *Service) GetProfile(c echo.Context, id uuid.UUID) (*ent.ArchiveProfile, error) {
+	profile, err := s.Store.Client.ArchiveProfile.Query().Where(archiveprofile.ID(id)).Only(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("archive profile not found")
+		}
+		return nil, fmt.Errorf("error getting archive profile: %v", err)
+	}
+	return profile, nil
+}
+
+func (s *Service) CreateProfile(c echo.Context, profileDto Profile) (*ent.ArchiveProfile, error) {
+	profile, err := s.Store.Client.ArchiveProfile.Create().SetName(profileDto.Name).SetQuality(profileDto.Quality).SetVideoConvert(profileDto.VideoConvert).SetNillableSaveAsHls(profileDto.SaveAsHls).SetRenderChat(profileDto.RenderChat).SetChatRender(profileDto.ChatRender).SetStreamlinkLive(profileDto.StreamlinkLive).SetFolderTemplate(profileDto.FolderTemplate).SetFileTemplate(profileDto.FileTemplate).SetProxyPolicy(profileDto.ProxyPolicy).Save(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.ConstraintError); ok {
+			return nil, fmt.Errorf("archive profile already exists")
+		}
+		return nil, fmt.Errorf("error creating archive profile: %v", err)
+	}
+	return profile, nil
+}
+
+func (s *Service) UpdateProfile(c echo.Context, id uuid.UUID, profileDto Profile) (*ent.ArchiveProfile, error) {
+	update := s.Store.Client.ArchiveProfile.UpdateOneID(id)
+	// settings that are not set fall back to the global config
+	if profileDto.SaveAsHls == nil {
+		update.ClearSaveAsHls()
+	}
+	profile, err := update.SetName(profileDto.Name).SetQuality(profileDto.Quality).SetVideoConvert(profileDto.VideoConvert).SetNillableSaveAsHls(profileDto.SaveAsHls).SetRenderChat(profileDto.RenderChat).SetChatRender(profileDto.ChatRender).SetStreamlinkLive(profileDto.StreamlinkLive).SetFolderTemplate(profileDto.FolderTemplate).SetFileTemplate(profileDto.FileTemplate).SetProxyPolicy(profileDto.ProxyPolicy).Save(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("archive profile not found")
+		}
+		if _, ok := err.(*ent.ConstraintError); ok {
+			return nil, fmt.Errorf("archive profile already exists")
+		}
+		return nil, fmt.Errorf("error updating archive profile: %v", err)
+	}
+	return profile, nil
+}
+
+func (s *Service) DeleteProfile(c echo.Context, id uuid.UUID) error {
+	err := s.Store.Client.ArchiveProfile.DeleteOneID(id).Exec(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return fmt.Errorf("archive profile not found")
+		}
+		return fmt.Errorf("error deleting archive profile: %v", err)
+	}
+	return nil
+}
+
+// DefaultSettings returns the settings of the global config.
+func DefaultSettings() Settings {
+	return Settings{
+		VideoConvert:   viper.GetString("parameters.video_convert"),
+		SaveAsHls:      viper.GetBool("archive.save_as_hls"),
+		ChatRender:     viper.GetString("parameters.chat_render"),
+		StreamlinkLive: viper.GetString("parameters.streamlink_live"),
+		ProxyPolicy:    utils.ProxyPolicyDefault,
+	}
+}
+
+// GetSettings returns the global config overridden by the profile. A nil profile returns the global config.
+func GetSettings(p *ent.ArchiveProfile) Settings {
+	settings := DefaultSettings()
+	if p == nil {
+		return settings
+	}
+	if p.VideoConvert != "" {
+		settings.VideoConvert = p.VideoConvert
+	}
+	if p.SaveAsHls != nil {
+		settings.SaveAsHls = *p.SaveAsHls
+	}
+	if p.ChatRender != "" {
+		settings.ChatRender = p.ChatRender
+	}
+	if p.StreamlinkLive != "" {
+		settings.StreamlinkLive = p.StreamlinkLive
+	}
+	if p.ProxyPolicy != utils.ProxyPolicyDefault {
+		settings.ProxyPolicy = p.ProxyPolicy
+	}
+	return settings
+}
+
+// GetVodSettings returns the settings for archiving a video using the archive profile the video was created with.
+func GetVodSettings(ctx context.Context, vodID uuid.UUID) (Settings, error) {
+	p, err := database.DB().Client.ArchiveProfile.Query().Where(archiveprofile.HasVodsWith(vod.ID(vodID))).Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return DefaultSettings(), nil
+		}
+		return Settings{}, fmt.Errorf("error getting archive profile: %v", err)
+	}
+	return GetSettings(p), nil
+}
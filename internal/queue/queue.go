@@ -3,17 +3,19 @@ package queue
 import (
 	"context"
 	"fmt"
-	"os/exec"
-	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/predicate"
 	"github.com/zibbp/ganymede/ent/queue"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/channel"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/temporal"
 	"github.com/zibbp/ganymede/internal/utils"
 	"github.com/zibbp/ganymede/internal/vod"
 )
@@ -51,7 +53,7 @@ type Queue struct {
 
 func (s *Service) CreateQueueItem(queueDto Queue, vID uuid.UUID) (*ent.Queue, error) {
 	if queueDto.LiveArchive {
-		q, err := s.Store.Client.Queue.Create().SetVodID(vID).SetLiveArchive(true).Save(context.Background())
+		q, err := s.Store.Client.Queue.Create().SetVodID(vID).SetLiveArchive(true).SetPriority(utils.QueuePriorityHigh).Save(context.Background())
 		if err != nil {
 			if _, ok := err.(*ent.ConstraintError); ok {
 				return nil, fmt.Errorf("queue item exists for vod or vod does not exist")
@@ -82,21 +84,72 @@ func (s *Service) UpdateQueueItem(queueDto Queue, qID uuid.UUID) (*ent.Queue, er
 	return q, nil
 }
 
+// GetQueueItems returns the queue items of the videos of the channels the user of the request can see.
 func (s *Service) GetQueueItems(c echo.Context) ([]*ent.Queue, error) {
-	q, err := s.Store.Client.Queue.Query().WithVod().Order(ent.Desc(queue.FieldCreatedAt)).All(c.Request().Context())
+	q, err := s.Store.Client.Queue.Query().Where(visibleQueueItems(c)...).WithVod().Order(ent.Desc(queue.FieldCreatedAt)).All(c.Request().Context())
 	if err != nil {
 		return nil, fmt.Errorf("error getting queue task: %v", err)
 	}
 	return q, nil
 }
 func (s *Service) GetQueueItemsFilter(c echo.Context, processing bool) ([]*ent.Queue, error) {
-	q, err := s.Store.Client.Queue.Query().Where(queue.Processing(processing)).WithVod().Order(ent.Asc(queue.FieldCreatedAt)).All(c.Request().Context())
+	// items are returned in the order their tasks are dispatched
+	q, err := s.Store.Client.Queue.Query().Where(queue.Processing(processing)).Where(visibleQueueItems(c)...).WithVod().Order(ent.Desc(queue.FieldPriority), ent.Asc(queue.FieldCreatedAt)).All(c.Request().Context())
 	if err != nil {
 		return nil, fmt.Errorf("error getting queue task: %v", err)
 	}
 	return q, nil
 }
 
+// visibleQueueItems returns the predicates limiting a queue query to the items of the videos the user of the request can see.
+func visibleQueueItems(c echo.Context) []predicate.Queue {
+	vods := auth.VisibleVods(auth.Viewer(c))
+	if vods == nil {
+		return nil
+	}
+	return []predicate.Queue{queue.HasVodWith(vods...)}
+}
+
+// SetQueueItemPriority sets the priority of a queue item. Tasks of items with a higher priority are dispatched first.
+func (s *Service) SetQueueItemPriority(c echo.Context, qID uuid.UUID, priority int) (*ent.Queue, error) {
+	q, err := s.Store.Client.Queue.UpdateOneID(qID).SetPriority(priority).Save(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("queue item not found")
+		}
+		return nil, fmt.Errorf("error updating queue priority: %v", err)
+	}
+	return q, nil
+}
+
+// BumpQueueItem moves a queue item to the front of the queue by giving it a higher priority than all other processing items.
+func (s *Service) BumpQueueItem(c echo.Context, qID uuid.UUID) (*ent.Queue, error) {
+	highest, err := s.Store.Client.Queue.Query().Where(queue.Processing(true), queue.IDNEQ(qID)).Order(ent.Desc(queue.FieldPriority)).First(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); !ok {
+			return nil, fmt.Errorf("error getting queue items: %v", err)
+		}
+	}
+	priority := utils.QueuePriorityHigh
+	if highest != nil && highest.Priority >= priority {
+		priority = highest.Priority + 1
+	}
+	return s.SetQueueItemPriority(c, qID, priority)
+}
+
+// SetQueueItemPaused pauses or resumes a queue item.
+// The tasks of a paused item are not dispatched until it is resumed, tasks that are already running are not interrupted.
+func (s *Service) SetQueueItemPaused(c echo.Context, qID uuid.UUID, paused bool) (*ent.Queue, error) {
+	q, err := s.Store.Client.Queue.UpdateOneID(qID).SetPaused(paused).Save(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("queue item not found")
+		}
+		return nil, fmt.Errorf("error updating queue item: %v", err)
+	}
+	return q, nil
+}
+
 func (s *Service) DeleteQueueItem(c echo.Context, qID uuid.UUID) error {
 	err := s.Store.Client.Queue.DeleteOneID(qID).Exec(c.Request().Context())
 	if err != nil {
@@ -108,11 +161,29 @@ func (s *Service) DeleteQueueItem(c echo.Context, qID uuid.UUID) error {
 func (s *Service) GetQueueItem(qID uuid.UUID) (*ent.Queue, error) {
 	q, err := s.Store.Client.Queue.Query().Where(queue.ID(qID)).WithVod().Only(context.Background())
 	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("queue item not found")
+		}
 		return nil, fmt.Errorf("error getting queue task: %v", err)
 	}
 	return q, nil
 }
 
+// GetQueueItemProgress returns the progress of the running tasks of a queue item, reported by the heartbeats of their activities.
+func (s *Service) GetQueueItemProgress(ctx context.Context, q *ent.Queue) ([]temporal.ActivityProgress, error) {
+	if !q.Processing || temporal.GetTemporalClient() == nil {
+		return []temporal.ActivityProgress{}, nil
+	}
+	workflowID := q.WorkflowID
+	if workflowID == "" && q.Edges.Vod != nil {
+		workflowID = q.Edges.Vod.ID.String()
+	}
+	if workflowID == "" {
+		return []temporal.ActivityProgress{}, nil
+	}
+	return temporal.GetWorkflowProgress(ctx, workflowID, q.WorkflowRunID)
+}
+
 func (s *Service) ReadLogFile(c echo.Context, qID uuid.UUID, logType string) ([]byte, error) {
 	q, err := s.GetQueueItem(qID)
 	if err != nil {
@@ -134,33 +205,55 @@ func (s *Service) ArchiveGetQueueItem(qID uuid.UUID) (*ent.Queue, error) {
 	return q, nil
 }
 
-// StopQueueItem
-// kills the streamlink process for a queue item
-// which in turn will stop the chat download and proceed to post processing
-func (s *Service) StopQueueItem(c echo.Context, id uuid.UUID) error {
-	// get vod
-	v, err := database.DB().Client.Queue.Query().Where(queue.ID(id)).WithVod().First(c.Request().Context())
+// queueItemWorkflow returns the workflow archiving a queue item.
+// Archives are started with the ID of their video as workflow ID, it is used for items started before the IDs were stored.
+func (s *Service) queueItemWorkflow(c echo.Context, id uuid.UUID) (*ent.Queue, string, string, error) {
+	q, err := s.Store.Client.Queue.Query().Where(queue.ID(id)).WithVod().Only(c.Request().Context())
 	if err != nil {
-		return fmt.Errorf("error getting queue item: %v", err)
-	}
-	log.Debug().Msgf("running: pgrep -f 'streamlink.*%s' | xargs kill\n", v.Edges.Vod.ExtID)
-	// get pid using the vod id
-	getPid := exec.Command("pgrep", "-f", fmt.Sprintf("streamlink.*%s", v.Edges.Vod.ExtID))
-	// kill pid
-	killPid := exec.Command("xargs", "kill", "-INT")
-	getPidOutput, err := getPid.Output()
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, "", "", fmt.Errorf("queue item not found")
+		}
+		return nil, "", "", fmt.Errorf("error getting queue item: %v", err)
+	}
+	if !q.Processing {
+		return nil, "", "", fmt.Errorf("queue item is not processing")
+	}
+	if q.WorkflowID != "" {
+		return q, q.WorkflowID, q.WorkflowRunID, nil
+	}
+	return q, q.Edges.Vod.ID.String(), "", nil
+}
+
+// StopQueueItem stops a queue item.
+// Live archives stop recording and continue processing the recorded video, other archives are cancelled.
+func (s *Service) StopQueueItem(c echo.Context, id uuid.UUID) error {
+	q, workflowID, runID, err := s.queueItemWorkflow(c, id)
 	if err != nil {
-		log.Error().Err(err).Msgf("error getting pid for queue item: %v", err)
-		return fmt.Errorf("error getting pid queue item: %v", err)
+		return err
+	}
+	if !q.LiveArchive || q.TaskVideoDownload != utils.Running {
+		return s.CancelQueueItem(c, id)
 	}
 
-	killPid.Stdin = strings.NewReader(string(getPidOutput))
+	log.Debug().Msgf("stopping live video download of queue item %s", id)
+	if err := temporal.SignalWorkflow(c.Request().Context(), workflowID, runID, utils.StopLiveVideoDownloadSignal, nil); err != nil {
+		return err
+	}
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditQueueStop, EntityType: "queue", EntityID: id.String(), Details: map[string]interface{}{"workflow_id": workflowID}})
+	return nil
+}
 
-	err = killPid.Run()
+// CancelQueueItem cancels the workflow of a queue item. Running tasks stop their subprocesses and are set to cancelled.
+func (s *Service) CancelQueueItem(c echo.Context, id uuid.UUID) error {
+	_, workflowID, runID, err := s.queueItemWorkflow(c, id)
 	if err != nil {
-		log.Error().Err(err).Msgf("error killing pid for queue item: %v", err)
-		return fmt.Errorf("error killing pid queue item: %v", err)
+		return err
 	}
 
+	log.Debug().Msgf("cancelling workflow %s of queue item %s", workflowID, id)
+	if err := temporal.CancelWorkflow(c.Request().Context(), workflowID, runID); err != nil {
+		return err
+	}
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditQueueCancel, EntityType: "queue", EntityID: id.String(), Details: map[string]interface{}{"workflow_id": workflowID}})
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/zibbp/ganymede/internal/archive"
 	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/live"
+	"github.com/zibbp/ganymede/internal/notification"
 	"github.com/zibbp/ganymede/internal/task"
 	"github.com/zibbp/ganymede/internal/twitch"
 )
@@ -27,6 +28,7 @@ func (s *Service) StartAppScheduler() {
 	scheduler := gocron.NewScheduler(time.UTC)
 
 	s.twitchAuthSchedule(scheduler)
+	s.retryNotificationSchedule(scheduler)
 
 	scheduler.StartAsync()
 }
@@ -94,6 +96,10 @@ func (s *Service) StartPruneVideoScheduler() {
 	scheduler := gocron.NewScheduler(loc)
 
 	s.pruneVideoSchedule(scheduler)
+	s.tierVideoSchedule(scheduler)
+	s.verifyVideoSchedule(scheduler)
+	s.purgeTrashSchedule(scheduler)
+	s.importChatSchedule(scheduler)
 
 	scheduler.StartAsync()
 }
@@ -112,6 +118,16 @@ func (s *Service) twitchAuthSchedule(scheduler *gocron.Scheduler) {
 	}
 }
 
+func (s *Service) retryNotificationSchedule(scheduler *gocron.Scheduler) {
+	log.Debug().Msg("setting up retry notification schedule")
+	_, err := scheduler.Every(1).Minute().SingletonMode().Do(func() {
+		notification.RetryDeliveries()
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to set up retry notification schedule")
+	}
+}
+
 func (s *Service) checkLiveStreamSchedule(scheduler *gocron.Scheduler) {
 	log.Debug().Msg("setting up check live stream schedule")
 	configLiveCheckInterval := viper.GetInt("live_check_interval_seconds")
@@ -136,6 +152,8 @@ func (s *Service) checkWatchedChannelVideos(schedule *gocron.Scheduler) {
 	_, err := schedule.Every(configCheckVideoInterval).Minutes().Do(func() {
 		log.Info().Msg("running check watched channel videos schedule")
 		s.LiveService.CheckVodWatchedChannels()
+		s.LiveService.CheckClipWatchedChannels()
+		s.LiveService.CheckLiveArchiveReplacements()
 	})
 	if err != nil {
 		log.Error().Err(err).Msg("failed to set up check watched channel videos schedule")
@@ -180,3 +198,48 @@ func (s *Service) pruneVideoSchedule(scheduler *gocron.Scheduler) {
 		log.Error().Err(err).Msg("failed to set up prune videos schedule")
 	}
 }
+
+func (s *Service) tierVideoSchedule(scheduler *gocron.Scheduler) {
+	log.Debug().Msg("setting up tier video schedule")
+	_, err := scheduler.Every(1).Day().At("02:00").Do(func() {
+		log.Info().Msg("running tier videos task")
+		task.TierVideos()
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to set up tier videos schedule")
+	}
+}
+
+func (s *Service) verifyVideoSchedule(scheduler *gocron.Scheduler) {
+	log.Debug().Msg("setting up verify video schedule")
+	_, err := scheduler.Every(1).Day().At("03:00").Do(func() {
+		log.Info().Msg("running verify videos task")
+		task.VerifyVideos(false)
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to set up verify videos schedule")
+	}
+}
+
+func (s *Service) purgeTrashSchedule(scheduler *gocron.Scheduler) {
+	log.Debug().Msg("setting up purge trash schedule")
+	_, err := scheduler.Every(1).Day().At("04:00").Do(func() {
+		log.Info().Msg("running purge trash task")
+		task.PurgeTrash()
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to set up purge trash schedule")
+	}
+}
+
+func (s *Service) importChatSchedule(scheduler *gocron.Scheduler) {
+	log.Debug().Msg("setting up import chat schedule")
+	// chats that are not imported are not searchable, import them soon after they are archived
+	_, err := scheduler.Every(1).Hour().SingletonMode().Do(func() {
+		log.Info().Msg("running import chat task")
+		task.ImportChats()
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to set up import chat schedule")
+	}
+}
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Local stores files on the local disk.
+type Local struct{}
+
+func (l *Local) MoveFile(ctx context.Context, src string, path string) error {
+	return utils.MoveFile(src, path)
+}
+
+func (l *Local) MoveFolder(ctx context.Context, src string, path string) error {
+	return utils.MoveFolder(src, path)
+}
+
+func (l *Local) Rename(ctx context.Context, src string, dst string) error {
+	return os.Rename(src, dst)
+}
+
+func (l *Local) DeleteFile(ctx context.Context, path string) error {
+	return utils.DeleteFile(path)
+}
+
+func (l *Local) DeleteFolder(ctx context.Context, path string) error {
+	return utils.DeleteFolder(path)
+}
+
+func (l *Local) Exists(ctx context.Context, path string) (bool, error) {
+	_, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error checking if file exists: %v", err)
+	}
+	return true, nil
+}
+
+func (l *Local) Serve(c echo.Context, path string) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return echo.NewHTTPError(http.StatusNotFound, "file not found")
+	}
+	return c.File(path)
+}
@@ -0,0 +1,281 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+)
+
+const (
+	// S3PlaybackPresign redirects playback requests to presigned URLs.
+	S3PlaybackPresign = "presign"
+	// S3PlaybackProxy streams playback requests through the API.
+	S3PlaybackProxy = "proxy"
+
+	s3MinimumPartSize = 5 * 1024 * 1024
+	s3MaximumCopySize = 5 * 1024 * 1024 * 1024
+)
+
+// S3 stores files in an S3 compatible object storage (AWS S3, MinIO, etc.).
+// Objects are keyed by the path of the file after /vods/, optionally under a prefix.
+type S3 struct {
+	Client        *minio.Client
+	Bucket        string
+	Prefix        string
+	PartSize      uint64
+	Playback      string
+	PresignExpiry time.Duration
+}
+
+// NewS3FromConfig returns the S3 backend using the storage config. Credentials are read from the S3_ACCESS_KEY and S3_SECRET_KEY environment variables.
+func NewS3FromConfig() (*S3, error) {
+	endpoint := viper.GetString("storage.s3.endpoint")
+	bucket := viper.GetString("storage.s3.bucket")
+	if endpoint == "" || bucket == "" {
+		return nil, fmt.Errorf("s3 storage is not configured")
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing s3 endpoint: %v", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("s3 endpoint must be an absolute url")
+	}
+
+	bucketLookup := minio.BucketLookupDNS
+	if viper.GetBool("storage.s3.use_path_style") {
+		bucketLookup = minio.BucketLookupPath
+	}
+	client, err := minio.New(u.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(os.Getenv("S3_ACCESS_KEY"), os.Getenv("S3_SECRET_KEY"), ""),
+		Secure:       u.Scheme == "https",
+		Region:       viper.GetString("storage.s3.region"),
+		BucketLookup: bucketLookup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating s3 client: %v", err)
+	}
+
+	partSize := viper.GetInt64("storage.s3.part_size_mb") * 1024 * 1024
+	if partSize < s3MinimumPartSize {
+		partSize = s3MinimumPartSize
+	}
+	playback := viper.GetString("storage.s3.playback")
+	if playback != S3PlaybackProxy {
+		playback = S3PlaybackPresign
+	}
+
+	return &S3{
+		Client:        client,
+		Bucket:        bucket,
+		Prefix:        strings.Trim(viper.GetString("storage.s3.prefix"), "/"),
+		PartSize:      uint64(partSize),
+		Playback:      playback,
+		PresignExpiry: time.Duration(viper.GetInt("storage.s3.presign_expiry_minutes")) * time.Minute,
+	}, nil
+}
+
+func (s *S3) MoveFile(ctx context.Context, src string, p string) error {
+	log.Debug().Msgf("uploading file: %s to s3 %s", src, s.key(p))
+	err := s.upload(ctx, src, s.key(p))
+	if err != nil {
+		return err
+	}
+	// Upload was successful - delete source file
+	err = os.Remove(src)
+	if err != nil {
+		log.Info().Msgf("error deleting source file: %v", err)
+	}
+	return nil
+}
+
+func (s *S3) MoveFolder(ctx context.Context, src string, p string) error {
+	log.Debug().Msgf("uploading folder: %s to s3 %s", src, s.key(p))
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return err
+	}
+	err := filepath.Walk(src, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(src, filePath)
+		if err != nil {
+			return err
+		}
+		return s.upload(ctx, filePath, s.key(path.Join(p, filepath.ToSlash(relPath))))
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading folder: %v", err)
+	}
+	return os.RemoveAll(src)
+}
+
+func (s *S3) Rename(ctx context.Context, src string, dst string) error {
+	srcKey := s.key(src)
+	dstKey := s.key(dst)
+
+	keys, err := s.list(ctx, srcKey+"/")
+	if err != nil {
+		return err
+	}
+	// a single file
+	if len(keys) == 0 {
+		return s.move(ctx, srcKey, dstKey)
+	}
+	for _, key := range keys {
+		err := s.move(ctx, key, dstKey+strings.TrimPrefix(key, srcKey))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *S3) DeleteFile(ctx context.Context, p string) error {
+	log.Debug().Msgf("deleting s3 object: %s", s.key(p))
+	err := s.Client.RemoveObject(ctx, s.Bucket, s.key(p), minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("error deleting s3 object: %v", err)
+	}
+	return nil
+}
+
+func (s *S3) DeleteFolder(ctx context.Context, p string) error {
+	keys, err := s.list(ctx, s.key(p)+"/")
+	if err != nil {
+		return fmt.Errorf("error deleting folder: %v", err)
+	}
+	for _, key := range keys {
+		err := s.Client.RemoveObject(ctx, s.Bucket, key, minio.RemoveObjectOptions{})
+		if err != nil {
+			return fmt.Errorf("error deleting folder: %v", err)
+		}
+	}
+	return nil
+}
+
+func (s *S3) Exists(ctx context.Context, p string) (bool, error) {
+	_, err := s.Client.StatObject(ctx, s.Bucket, s.key(p), minio.StatObjectOptions{})
+	if err != nil {
+		if isS3NotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error checking if s3 object exists: %v", err)
+	}
+	return true, nil
+}
+
+// Serve redirects to a presigned URL or proxies the object depending on the playback setting.
+// HLS playlists are always proxied as the segments are requested relative to the playlist.
+func (s *S3) Serve(c echo.Context, p string) error {
+	key := s.key(p)
+	if s.Playback == S3PlaybackPresign && path.Ext(p) != ".m3u8" {
+		u, err := s.Presign(c.Request().Context(), p)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.Redirect(http.StatusTemporaryRedirect, u)
+	}
+
+	object, err := s.Client.GetObject(c.Request().Context(), s.Bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer object.Close()
+	info, err := object.Stat()
+	if err != nil {
+		if isS3NotFound(err) {
+			return echo.NewHTTPError(http.StatusNotFound, "file not found")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if info.ContentType != "" {
+		c.Response().Header().Set(echo.HeaderContentType, info.ContentType)
+	}
+	// ranges are read from the object so the player can seek
+	http.ServeContent(c.Response(), c.Request(), path.Base(p), info.LastModified, object)
+	return nil
+}
+
+// Presign returns a presigned URL to download the file at the path.
+func (s *S3) Presign(ctx context.Context, p string) (string, error) {
+	u, err := s.Client.PresignedGetObject(ctx, s.Bucket, s.key(p), s.PresignExpiry, nil)
+	if err != nil {
+		return "", fmt.Errorf("error presigning s3 object: %v", err)
+	}
+	return u.String(), nil
+}
+
+// key returns the object key of a path in the /vods tree.
+func (s *S3) key(p string) string {
+	key := strings.TrimPrefix(path.Clean(p), "/vods/")
+	key = strings.TrimPrefix(key, "/")
+	if s.Prefix != "" {
+		return s.Prefix + "/" + key
+	}
+	return key
+}
+
+// upload uploads a local file. Files larger than the part size are uploaded with a multipart upload.
+func (s *S3) upload(ctx context.Context, src string, key string) error {
+	_, err := s.Client.FPutObject(ctx, s.Bucket, key, src, minio.PutObjectOptions{PartSize: s.PartSize})
+	if err != nil {
+		return fmt.Errorf("error uploading %s to s3: %v", src, err)
+	}
+	return nil
+}
+
+// move copies an object to a new key and deletes the original.
+// Objects larger than the maximum size of a single copy are copied in parts.
+func (s *S3) move(ctx context.Context, srcKey string, dstKey string) error {
+	info, err := s.Client.StatObject(ctx, s.Bucket, srcKey, minio.StatObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("error copying s3 object %s: %v", srcKey, err)
+	}
+	dst := minio.CopyDestOptions{Bucket: s.Bucket, Object: dstKey}
+	src := minio.CopySrcOptions{Bucket: s.Bucket, Object: srcKey}
+	if info.Size <= s3MaximumCopySize {
+		_, err = s.Client.CopyObject(ctx, dst, src)
+	} else {
+		_, err = s.Client.ComposeObject(ctx, dst, src)
+	}
+	if err != nil {
+		return fmt.Errorf("error copying s3 object %s: %v", srcKey, err)
+	}
+	err = s.Client.RemoveObject(ctx, s.Bucket, srcKey, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("error deleting s3 object %s: %v", srcKey, err)
+	}
+	return nil
+}
+
+// list returns the keys of all objects under a prefix.
+func (s *S3) list(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	for object := range s.Client.ListObjects(ctx, s.Bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, fmt.Errorf("error listing s3 objects: %v", object.Err)
+		}
+		keys = append(keys, object.Key)
+	}
+	return keys, nil
+}
+
+func isS3NotFound(err error) bool {
+	return minio.ToErrorResponse(err).StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Backend stores the media files of archived videos.
+//
+// Paths are always the paths of the files in the local /vods tree. They are what is stored in the database
+// regardless of the backend, backends that do not use the local disk map them to their own locations.
+type Backend interface {
+	// MoveFile moves a local file (e.g. a temporary download) to path.
+	MoveFile(ctx context.Context, src string, path string) error
+	// MoveFolder moves a local folder (e.g. temporary HLS files) to path.
+	MoveFolder(ctx context.Context, src string, path string) error
+	// Rename renames a file or folder.
+	Rename(ctx context.Context, src string, dst string) error
+	DeleteFile(ctx context.Context, path string) error
+	DeleteFolder(ctx context.Context, path string) error
+	Exists(ctx context.Context, path string) (bool, error)
+	// Serve writes the file to the response for playback.
+	Serve(c echo.Context, path string) error
+}
+
+// Default returns the storage backend new videos are stored in.
+func Default() utils.StorageBackend {
+	backend := utils.StorageBackend(viper.GetString("storage.backend"))
+	if backend == "" {
+		return utils.StorageLocal
+	}
+	return backend
+}
+
+// Get returns the storage backend.
+func Get(backend utils.StorageBackend) (Backend, error) {
+	switch backend {
+	case utils.StorageLocal, "":
+		return &Local{}, nil
+	case utils.StorageS3:
+		return NewS3FromConfig()
+	default:
+		return nil, fmt.Errorf("unknown storage backend %s", backend)
+	}
+}
+
+// ForVod returns the storage backend the files of the video are stored in.
+func ForVod(v *ent.Vod) (Backend, error) {
+	return Get(v.StorageBackend)
+}
+
+// VodFolder returns the folder the files of a video are stored in, derived from the path of the video.
+func VodFolder(v *ent.Vod) string {
+	if v.VideoHlsPath != "" {
+		return path.Dir(v.VideoHlsPath)
+	}
+	return path.Dir(v.VideoPath)
+}
+
+// PlaybackVod returns the video with the paths of the media files in a remote backend replaced by the storage route of the API, GET /vod/:id/storage/*.
+// Only the responses are changed, the database keeps the paths in the /vods tree.
+func PlaybackVod(v *ent.Vod) *ent.Vod {
+	if v == nil || v.StorageBackend == utils.StorageLocal || v.StorageBackend == "" {
+		return v
+	}
+	folder := VodFolder(v)
+	route := func(p string) string {
+		if !strings.HasPrefix(p, folder+"/") {
+			return p
+		}
+		return fmt.Sprintf("/api/v1/vod/%s/storage/%s", v.ID, strings.TrimPrefix(p, folder+"/"))
+	}
+	playback := *v
+	playback.VideoPath = route(v.VideoPath)
+	playback.ChatVideoPath = route(v.ChatVideoPath)
+	return &playback
+}
+
+// PlaybackVods returns the videos with their playback paths, see PlaybackVod.
+func PlaybackVods(vods []*ent.Vod) []*ent.Vod {
+	playback := make([]*ent.Vod, len(vods))
+	for i, v := range vods {
+		playback[i] = PlaybackVod(v)
+	}
+	return playback
+}
+
+// DeleteVodFolder deletes the folder of a video.
+// Only the media files are moved to remote backends, the remaining files of the video on the local disk are deleted too.
+func DeleteVodFolder(ctx context.Context, v *ent.Vod, path string) error {
+	backend, err := ForVod(v)
+	if err != nil {
+		return err
+	}
+	err = backend.DeleteFolder(ctx, path)
+	if err != nil {
+		return err
+	}
+	if v.StorageBackend != utils.StorageLocal {
+		return utils.DeleteFolder(path)
+	}
+	return nil
+}
+
+// MoveVodFolder moves the folder of a video, e.g. to and from the trash.
+// Like DeleteVodFolder, the remaining files of videos in remote backends are moved on the local disk too.
+func MoveVodFolder(ctx context.Context, v *ent.Vod, src string, dst string) error {
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("%s already exists", dst)
+	}
+	if err := os.MkdirAll(path.Dir(dst), os.ModePerm); err != nil {
+		return fmt.Errorf("error creating folder: %v", err)
+	}
+	if v.StorageBackend != utils.StorageLocal {
+		backend, err := ForVod(v)
+		if err != nil {
+			return err
+		}
+		if err := backend.Rename(ctx, src, dst); err != nil {
+			return err
+		}
+		if _, err := os.Stat(src); os.IsNotExist(err) {
+			return nil
+		}
+	}
+	// renaming fails across file systems, e.g. for videos in cold storage
+	if err := os.Rename(src, dst); err != nil {
+		return utils.MoveFolder(src, dst)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package task
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// ImportChats imports the chat files of videos whose chat is not in the chat message table yet.
+func ImportChats() {
+	videos, err := database.DB().Client.Vod.Query().Where(entVod.ChatImportedAtIsNil(), entVod.ChatPathNEQ(""), entVod.Processing(false)).All(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Error fetching videos")
+		return
+	}
+	log.Debug().Msgf("Found %d videos with chat to import", len(videos))
+
+	for _, video := range videos {
+		if !utils.FileExists(video.ChatPath) {
+			continue
+		}
+		err := chat.EnsureChatImported(context.Background(), database.DB().Client, video)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error importing chat of video %s", video.ID)
+			continue
+		}
+		log.Info().Msgf("Imported chat of video %s", video.ID)
+	}
+}
@@ -0,0 +1,727 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/archive"
+	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/platform"
+	"github.com/zibbp/ganymede/internal/twitch"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+)
+
+// Import modes. Hardlinked files stay in the import directory, which requires both to be on the same filesystem.
+const (
+	ImportModeMove     = "move"
+	ImportModeHardlink = "hardlink"
+)
+
+// ImportOptions configures an import of archives that were downloaded outside of Ganymede.
+type ImportOptions struct {
+	Path   string `json:"path"`
+	Mode   string `json:"mode"`
+	DryRun bool   `json:"dry_run"`
+}
+
+// ImportedVideo is a video created by an import, or that would be created by a dry run.
+type ImportedVideo struct {
+	ExtID     string     `json:"ext_id"`
+	VodID     *uuid.UUID `json:"vod_id,omitempty"`
+	Channel   string     `json:"channel"`
+	Title     string     `json:"title"`
+	Metadata  string     `json:"metadata"` // "twitch" if the video is still available on Twitch, "files" otherwise
+	Files     []string   `json:"files"`
+	VideoPath string     `json:"video_path"`
+}
+
+// ImportIssue is a file or video that was not imported.
+type ImportIssue struct {
+	Path   string `json:"path"`
+	ExtID  string `json:"ext_id,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// ImportReport is the result of an import.
+type ImportReport struct {
+	ImportOptions
+	Running    bool            `json:"running"`
+	StartedAt  time.Time       `json:"started_at"`
+	FinishedAt *time.Time      `json:"finished_at,omitempty"`
+	Imported   []ImportedVideo `json:"imported"`
+	Skipped    []ImportIssue   `json:"skipped"`   // videos that are already archived
+	Unmatched  []ImportIssue   `json:"unmatched"` // files that could not be matched to a video or imported
+	Error      string          `json:"error,omitempty"`
+}
+
+var (
+	importMu     sync.Mutex
+	importReport *ImportReport
+)
+
+var (
+	importVideoExtensions = map[string]bool{".mp4": true, ".mkv": true, ".ts": true, ".flv": true, ".mov": true, ".webm": true}
+	importImageExtensions = map[string]bool{".jpg": true, ".jpeg": true, ".png": true, ".webp": true}
+
+	twitchVideoURLRegex = regexp.MustCompile(`twitch\.tv/videos/(\d+)`)
+	// a "v" prefixed ID as used by yt-dlp, or a number as long as current Twitch video IDs
+	fileNameVideoIDRegex = regexp.MustCompile(`(?:^|[^0-9a-zA-Z])v(\d{6,12})(?:[^0-9]|$)`)
+	fileNameNumberRegex  = regexp.MustCompile(`(?:^|[^0-9])(\d{9,11})(?:[^0-9]|$)`)
+	// display names of channels only differ from the login in case, unless they are localized
+	twitchLoginRegex = regexp.MustCompile(`^[a-zA-Z0-9_]{1,25}$`)
+)
+
+// importGroup holds the files of a video.
+type importGroup struct {
+	extID     string
+	videos    []string
+	chat      string
+	info      string
+	thumbnail string
+	meta      twitch.Vod // metadata read from the chat and info files
+	// confirmed is set if the video ID was read from metadata, a Twitch URL or a "v" prefixed ID.
+	// IDs that are only a number in the file names could be a date or timestamp, they must be confirmed by Twitch.
+	confirmed bool
+}
+
+// StartImport imports the archives in a directory in the background. Dry runs only report what would be imported.
+func (s *Service) StartImport(opts ImportOptions) (*ImportReport, error) {
+	if opts.Mode == "" {
+		opts.Mode = ImportModeHardlink
+	}
+	if opts.Mode != ImportModeMove && opts.Mode != ImportModeHardlink {
+		return nil, fmt.Errorf("invalid import mode %s", opts.Mode)
+	}
+	info, err := os.Stat(opts.Path)
+	if err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("import path %s is not a directory", opts.Path)
+	}
+
+	report := &ImportReport{ImportOptions: opts, Running: true, StartedAt: time.Now(), Imported: []ImportedVideo{}, Skipped: []ImportIssue{}, Unmatched: []ImportIssue{}}
+
+	importMu.Lock()
+	defer importMu.Unlock()
+	if importReport != nil && importReport.Running {
+		return nil, fmt.Errorf("an import is already running")
+	}
+	importReport = report
+	go func() {
+		s.importLibrary(context.Background(), report)
+		log.Info().Msgf("Imported %d videos from %s, skipped %d, %d files unmatched", len(report.Imported), opts.Path, len(report.Skipped), len(report.Unmatched))
+	}()
+	return copyImportReport(report), nil
+}
+
+// GetImportReport returns the report of the last import or dry run.
+func (s *Service) GetImportReport() (*ImportReport, error) {
+	importMu.Lock()
+	defer importMu.Unlock()
+	if importReport == nil {
+		return nil, fmt.Errorf("no import has run")
+	}
+	return copyImportReport(importReport), nil
+}
+
+func copyImportReport(report *ImportReport) *ImportReport {
+	r := *report
+	r.Imported = append([]ImportedVideo{}, report.Imported...)
+	r.Skipped = append([]ImportIssue{}, report.Skipped...)
+	r.Unmatched = append([]ImportIssue{}, report.Unmatched...)
+	return &r
+}
+
+// importLibrary matches the files of the import directory to Twitch videos and imports them. The report is updated as videos are imported.
+func (s *Service) importLibrary(ctx context.Context, report *ImportReport) {
+	defer func() {
+		importMu.Lock()
+		defer importMu.Unlock()
+		now := time.Now()
+		report.Running = false
+		report.FinishedAt = &now
+	}()
+
+	groups, unmatched, err := scanImportDirectory(report.Path)
+	if err != nil {
+		importMu.Lock()
+		report.Error = err.Error()
+		importMu.Unlock()
+		return
+	}
+	importMu.Lock()
+	report.Unmatched = append(report.Unmatched, unmatched...)
+	importMu.Unlock()
+
+	for _, group := range groups {
+		imported, issues, skipped := s.importGroup(ctx, group, report.ImportOptions)
+		importMu.Lock()
+		if imported != nil {
+			report.Imported = append(report.Imported, *imported)
+		}
+		if skipped {
+			report.Skipped = append(report.Skipped, issues...)
+		} else {
+			report.Unmatched = append(report.Unmatched, issues...)
+		}
+		importMu.Unlock()
+	}
+}
+
+// scanImportDirectory groups the files of a directory by the Twitch video they belong to.
+// Files without a video ID belong to the video of the other files in their folder if there is exactly one.
+func scanImportDirectory(root string) ([]*importGroup, []ImportIssue, error) {
+	groups := map[string]*importGroup{}
+	group := func(extID string) *importGroup {
+		if groups[extID] == nil {
+			groups[extID] = &importGroup{extID: extID}
+		}
+		return groups[extID]
+	}
+	folderIDs := map[string]map[string]bool{}
+	var unidentified []string
+	unmatched := []ImportIssue{}
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			unmatched = append(unmatched, ImportIssue{Path: path, Reason: err.Error()})
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+
+		var extID string
+		switch {
+		case ext == ".json":
+			meta, isChat, err := readImportJson(path)
+			if err != nil {
+				unmatched = append(unmatched, ImportIssue{Path: path, Reason: fmt.Sprintf("error reading json: %v", err)})
+				return nil
+			}
+			confirmed := meta.ID != ""
+			if meta.ID == "" {
+				meta.ID, confirmed = fileNameVideoID(path)
+			}
+			if meta.ID == "" {
+				unidentified = append(unidentified, path)
+				return nil
+			}
+			extID = meta.ID
+			g := group(extID)
+			g.confirmed = g.confirmed || confirmed
+			if isChat {
+				g.chat = path
+			} else {
+				g.info = path
+			}
+			mergeImportMetadata(&g.meta, meta)
+		case importVideoExtensions[ext]:
+			extID = embeddedVideoID(path)
+			confirmed := extID != ""
+			if extID == "" {
+				extID, confirmed = fileNameVideoID(path)
+			}
+			if extID == "" {
+				unidentified = append(unidentified, path)
+				return nil
+			}
+			g := group(extID)
+			g.videos = append(g.videos, path)
+			g.confirmed = g.confirmed || confirmed
+		case importImageExtensions[ext]:
+			var confirmed bool
+			extID, confirmed = fileNameVideoID(path)
+			if extID == "" {
+				unidentified = append(unidentified, path)
+				return nil
+			}
+			g := group(extID)
+			g.thumbnail = path
+			g.confirmed = g.confirmed || confirmed
+		default:
+			return nil
+		}
+
+		folder := filepath.Dir(path)
+		if folderIDs[folder] == nil {
+			folderIDs[folder] = map[string]bool{}
+		}
+		folderIDs[folder][extID] = true
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error scanning import directory: %v", err)
+	}
+
+	for _, path := range unidentified {
+		ids := folderIDs[filepath.Dir(path)]
+		if len(ids) != 1 {
+			unmatched = append(unmatched, ImportIssue{Path: path, Reason: "no twitch video id found"})
+			continue
+		}
+		var extID string
+		for id := range ids {
+			extID = id
+		}
+		g := group(extID)
+		ext := strings.ToLower(filepath.Ext(path))
+		switch {
+		case importVideoExtensions[ext]:
+			g.videos = append(g.videos, path)
+		case importImageExtensions[ext] && g.thumbnail == "":
+			g.thumbnail = path
+		case ext == ".json" && strings.HasSuffix(strings.ToLower(path), "info.json") && g.info == "":
+			g.info = path
+		default:
+			unmatched = append(unmatched, ImportIssue{Path: path, ExtID: extID, Reason: "file is not part of the video"})
+		}
+	}
+
+	sorted := make([]*importGroup, 0, len(groups))
+	for _, g := range groups {
+		sorted = append(sorted, g)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].extID < sorted[j].extID })
+	return sorted, unmatched, nil
+}
+
+// readImportJson reads the video metadata of a TwitchDownloader chat file or an info file of Ganymede or yt-dlp.
+// Only the first comment of a chat is read so large chat files are not loaded in memory.
+func readImportJson(path string) (twitch.Vod, bool, error) {
+	data, err := chat.ReadChatHeader(path, 1)
+	if err != nil {
+		return twitch.Vod{}, false, err
+	}
+	var file struct {
+		// TwitchDownloader chat, the login of the streamer is only written by recent versions
+		Streamer *struct {
+			Name  string          `json:"name"`
+			Login string          `json:"login"`
+			ID    json.RawMessage `json:"id"`
+		} `json:"streamer"`
+		Video *struct {
+			ID        json.RawMessage `json:"id"`
+			Title     string          `json:"title"`
+			CreatedAt string          `json:"created_at"`
+			Length    float64         `json:"length"`
+		} `json:"video"`
+		Comments []struct {
+			ContentID string `json:"content_id"`
+		} `json:"comments"`
+		// Ganymede and yt-dlp info
+		ID         json.RawMessage `json:"id"`
+		Title      string          `json:"title"`
+		UserLogin  string          `json:"user_login"`
+		UserName   string          `json:"user_name"`
+		UploaderID string          `json:"uploader_id"`
+		Uploader   string          `json:"uploader"`
+		Type       string          `json:"type"`
+		CreatedAt  string          `json:"created_at"`
+		Timestamp  int64           `json:"timestamp"`
+		Duration   json.RawMessage `json:"duration"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return twitch.Vod{}, false, err
+	}
+
+	if file.Comments != nil && file.Video != nil {
+		meta := twitch.Vod{ID: rawJsonString(file.Video.ID), Title: file.Video.Title, CreatedAt: file.Video.CreatedAt}
+		if meta.ID == "" && len(file.Comments) > 0 {
+			meta.ID = file.Comments[0].ContentID
+		}
+		if file.Streamer != nil {
+			meta.UserID = rawJsonString(file.Streamer.ID)
+			meta.UserLogin = strings.ToLower(file.Streamer.Login)
+			meta.UserName = file.Streamer.Name
+			if meta.UserLogin == "" && twitchLoginRegex.MatchString(file.Streamer.Name) {
+				meta.UserLogin = strings.ToLower(file.Streamer.Name)
+			}
+		}
+		if file.Video.Length > 0 {
+			meta.Duration = (time.Duration(file.Video.Length) * time.Second).String()
+		}
+		return meta, true, nil
+	}
+
+	meta := twitch.Vod{
+		ID:        strings.TrimPrefix(rawJsonString(file.ID), "v"),
+		Title:     file.Title,
+		UserLogin: file.UserLogin,
+		UserName:  file.UserName,
+		Type:      file.Type,
+		CreatedAt: file.CreatedAt,
+	}
+	if _, err := strconv.ParseInt(meta.ID, 10, 64); err != nil {
+		meta.ID = ""
+	}
+	if meta.UserLogin == "" {
+		meta.UserLogin = strings.ToLower(file.UploaderID)
+		meta.UserName = file.Uploader
+	}
+	if meta.CreatedAt == "" && file.Timestamp > 0 {
+		meta.CreatedAt = time.Unix(file.Timestamp, 0).UTC().Format(time.RFC3339)
+	}
+	// the duration is a Twitch duration string or a number of seconds
+	if duration := rawJsonString(file.Duration); duration != "" {
+		if seconds, err := strconv.ParseFloat(duration, 64); err == nil {
+			meta.Duration = (time.Duration(seconds) * time.Second).String()
+		} else {
+			meta.Duration = duration
+		}
+	}
+	return meta, false, nil
+}
+
+func rawJsonString(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+// mergeImportMetadata fills the empty fields of dst from src.
+func mergeImportMetadata(dst *twitch.Vod, src twitch.Vod) {
+	if dst.ID == "" {
+		dst.ID = src.ID
+	}
+	if dst.Title == "" {
+		dst.Title = src.Title
+	}
+	if dst.UserID == "" {
+		dst.UserID = src.UserID
+	}
+	if dst.UserLogin == "" {
+		dst.UserLogin = src.UserLogin
+	}
+	if dst.UserName == "" {
+		dst.UserName = src.UserName
+	}
+	if dst.Type == "" {
+		dst.Type = src.Type
+	}
+	if dst.CreatedAt == "" {
+		dst.CreatedAt = src.CreatedAt
+	}
+	if dst.Duration == "" {
+		dst.Duration = src.Duration
+	}
+}
+
+// embeddedVideoID returns the Twitch video ID from the metadata tags of a video, e.g. the URL yt-dlp embeds.
+func embeddedVideoID(path string) string {
+	data, err := exec.GetFfprobeData(path)
+	if err != nil {
+		return ""
+	}
+	format, _ := data["format"].(map[string]interface{})
+	tags, _ := format["tags"].(map[string]interface{})
+	for _, value := range tags {
+		if s, ok := value.(string); ok {
+			if m := twitchVideoURLRegex.FindStringSubmatch(s); m != nil {
+				return m[1]
+			}
+		}
+	}
+	return ""
+}
+
+// fileNameVideoID returns the Twitch video ID from a file name. IDs that are only a number are not confirmed.
+func fileNameVideoID(path string) (string, bool) {
+	name := filepath.Base(path)
+	if m := twitchVideoURLRegex.FindStringSubmatch(name); m != nil {
+		return m[1], true
+	}
+	if m := fileNameVideoIDRegex.FindStringSubmatch(name); m != nil {
+		return m[1], true
+	}
+	if m := fileNameNumberRegex.FindStringSubmatch(name); m != nil {
+		return m[1], false
+	}
+	return "", false
+}
+
+// importGroup imports the files of a video. The issues are skipped videos if skipped is set.
+func (s *Service) importGroup(ctx context.Context, g *importGroup, opts ImportOptions) (*ImportedVideo, []ImportIssue, bool) {
+	issues := func(reason string) []ImportIssue {
+		var files []ImportIssue
+		for _, path := range append(append([]string{}, g.videos...), g.chat, g.info, g.thumbnail) {
+			if path != "" {
+				files = append(files, ImportIssue{Path: path, ExtID: g.extID, Reason: reason})
+			}
+		}
+		return files
+	}
+
+	if len(g.videos) == 0 {
+		return nil, issues("no video file found for the video"), false
+	}
+	exists, trashed, err := vod.Exists(ctx, s.Store.Client, entVod.ExtID(g.extID))
+	if err != nil {
+		return nil, issues(fmt.Sprintf("error checking if video exists: %v", err)), false
+	}
+	if trashed {
+		return nil, issues("video is in the trash, restore or delete it to import it again"), true
+	}
+	if exists {
+		return nil, issues("video is already archived"), true
+	}
+
+	// the largest file is the video, other files are reported
+	sort.Slice(g.videos, func(i, j int) bool { return fileSize(g.videos[i]) > fileSize(g.videos[j]) })
+	videoFile := g.videos[0]
+	var extra []ImportIssue
+	for _, path := range g.videos[1:] {
+		extra = append(extra, ImportIssue{Path: path, ExtID: g.extID, Reason: "a larger video file was imported for the video"})
+	}
+
+	meta := g.meta
+	metadataSource := "files"
+	if s.ArchiveService != nil && s.ArchiveService.TwitchService != nil {
+		if tVod, err := s.ArchiveService.TwitchService.GetVodByID(g.extID); err == nil {
+			meta = tVod
+			mergeImportMetadata(&meta, g.meta)
+			metadataSource = "twitch"
+		} else {
+			log.Debug().Err(err).Msgf("video %s is not available on twitch", g.extID)
+		}
+	}
+	if !g.confirmed {
+		if metadataSource != "twitch" {
+			return nil, append(issues("the number in the file names is not a known twitch video id, add a chat or info file"), extra...), false
+		}
+		if g.meta.UserLogin != "" && g.meta.UserLogin != meta.UserLogin {
+			return nil, append(issues(fmt.Sprintf("the number in the file names is the id of a twitch video of %s", meta.UserLogin)), extra...), false
+		}
+	}
+	if metadataSource != "twitch" && meta.UserID != "" {
+		login, err := s.importChannelLogin(ctx, meta.UserID)
+		if err != nil {
+			log.Debug().Err(err).Msgf("error getting the channel of video %s", g.extID)
+		} else if login != "" {
+			meta.UserLogin = login
+		}
+	}
+	if meta.UserLogin == "" {
+		return nil, append(issues("the channel of the video is unknown, add a chat or info file"), extra...), false
+	}
+	meta.ID = g.extID
+	if meta.Title == "" {
+		meta.Title = strings.TrimSuffix(filepath.Base(videoFile), filepath.Ext(videoFile))
+	}
+	if meta.Type == "" {
+		meta.Type = string(utils.Archive)
+	}
+	streamedAt, err := time.Parse(time.RFC3339, meta.CreatedAt)
+	if err != nil {
+		// the modification time is the closest to when the video was downloaded
+		if info, err := os.Stat(videoFile); err == nil {
+			streamedAt = info.ModTime()
+		} else {
+			streamedAt = time.Now()
+		}
+		meta.CreatedAt = streamedAt.UTC().Format(time.RFC3339)
+	}
+	duration := 0
+	if d, err := time.ParseDuration(meta.Duration); err == nil {
+		duration = int(d.Seconds())
+	} else if d, err := exec.ProbeVideoDuration(ctx, videoFile); err == nil {
+		duration = int(d)
+	}
+
+	vodID := uuid.New()
+	folderName, err := archive.GetFolderName(vodID, meta)
+	if err != nil {
+		folderName = fmt.Sprintf("%s-%s", meta.ID, vodID)
+	}
+	fileName, err := archive.GetFileName(vodID, meta)
+	if err != nil {
+		fileName = meta.ID
+	}
+	rootVodPath := fmt.Sprintf("/vods/%s/%s", meta.UserLogin, folderName)
+
+	// source and destination of each file
+	transfers := [][2]string{{videoFile, fmt.Sprintf("%s/%s-video%s", rootVodPath, fileName, strings.ToLower(filepath.Ext(videoFile)))}}
+	var chatPath, infoPath, thumbnailPath string
+	if g.chat != "" {
+		chatPath = fmt.Sprintf("%s/%s-chat.json", rootVodPath, fileName)
+		transfers = append(transfers, [2]string{g.chat, chatPath})
+	}
+	if g.info != "" {
+		infoPath = fmt.Sprintf("%s/%s-info.json", rootVodPath, fileName)
+		transfers = append(transfers, [2]string{g.info, infoPath})
+	}
+	if g.thumbnail != "" {
+		thumbnailPath = fmt.Sprintf("%s/%s-thumbnail%s", rootVodPath, fileName, strings.ToLower(filepath.Ext(g.thumbnail)))
+		transfers = append(transfers, [2]string{g.thumbnail, thumbnailPath})
+	}
+
+	imported := &ImportedVideo{ExtID: g.extID, Channel: meta.UserLogin, Title: meta.Title, Metadata: metadataSource, VideoPath: transfers[0][1]}
+	for _, transfer := range transfers {
+		imported.Files = append(imported.Files, transfer[0])
+	}
+	if opts.DryRun {
+		return imported, extra, false
+	}
+
+	dbChannel, err := s.importChannel(ctx, meta)
+	if err != nil {
+		return nil, append(issues(err.Error()), extra...), false
+	}
+
+	if err := utils.CreateFolder(fmt.Sprintf("%s/%s", meta.UserLogin, folderName)); err != nil {
+		return nil, append(issues(fmt.Sprintf("error creating folder: %v", err)), extra...), false
+	}
+	if err := transferImportFiles(transfers, opts.Mode); err != nil {
+		return nil, append(issues(err.Error()), extra...), false
+	}
+
+	v, err := s.Store.Client.Vod.Create().
+		SetID(vodID).
+		SetExtID(meta.ID).
+		SetPlatform(utils.PlatformTwitch).
+		SetType(utils.VodType(meta.Type)).
+		SetTitle(meta.Title).
+		SetDuration(duration).
+		SetViews(int(meta.ViewCount)).
+		SetResolution("source").
+		SetThumbnailPath(thumbnailPath).
+		SetWebThumbnailPath(thumbnailPath).
+		SetVideoPath(transfers[0][1]).
+		SetChatPath(chatPath).
+		SetInfoPath(infoPath).
+		SetFolderName(folderName).
+		SetFileName(fileName).
+		SetStreamedAt(streamedAt).
+		SetChannel(dbChannel).
+		Save(ctx)
+	if err != nil {
+		rollbackImportFiles(transfers, opts.Mode)
+		return nil, append(issues(fmt.Sprintf("error creating vod: %v", err)), extra...), false
+	}
+	imported.VodID = &v.ID
+
+	if chatPath != "" {
+		if err := chat.EnsureChatImported(ctx, s.Store.Client, v); err != nil {
+			log.Error().Err(err).Msgf("Error importing chat of video %s", v.ID)
+		}
+	}
+	log.Info().Msgf("Imported video %s from %s", v.ID, videoFile)
+	return imported, extra, false
+}
+
+// importChannelLogin returns the login of a channel by its Twitch ID, from the archived channels or Twitch. The login is empty if the channel is unknown.
+func (s *Service) importChannelLogin(ctx context.Context, userID string) (string, error) {
+	dbChannel, err := s.Store.Client.Channel.Query().Where(entChannel.ExtID(userID)).First(ctx)
+	if err == nil {
+		return dbChannel.Name, nil
+	}
+	if _, ok := err.(*ent.NotFoundError); !ok {
+		return "", fmt.Errorf("error getting channel: %v", err)
+	}
+	if s.ArchiveService == nil {
+		return "", nil
+	}
+	twitchPlatform, err := platform.Get(utils.PlatformTwitch)
+	if err != nil {
+		return "", err
+	}
+	user, err := twitchPlatform.GetUserByID(userID)
+	if err != nil {
+		return "", fmt.Errorf("error fetching twitch channel: %v", err)
+	}
+	return user.Login, nil
+}
+
+// importChannel returns the channel of an imported video. Missing channels are created from Twitch or, if the channel is gone, from the metadata.
+func (s *Service) importChannel(ctx context.Context, meta twitch.Vod) (*ent.Channel, error) {
+	dbChannel, err := s.Store.Client.Channel.Query().Where(entChannel.Name(meta.UserLogin)).Only(ctx)
+	if err == nil {
+		return dbChannel, nil
+	}
+	if _, ok := err.(*ent.NotFoundError); !ok {
+		return nil, fmt.Errorf("error getting channel: %v", err)
+	}
+
+	if s.ArchiveService != nil {
+		dbChannel, err := s.ArchiveService.ArchiveTwitchChannel(meta.UserLogin)
+		if err == nil {
+			return dbChannel, nil
+		}
+		log.Debug().Err(err).Msgf("error archiving channel %s, creating it from the video metadata", meta.UserLogin)
+	}
+
+	if err := utils.CreateFolder(meta.UserLogin); err != nil {
+		return nil, fmt.Errorf("error creating channel folder: %v", err)
+	}
+	displayName := meta.UserName
+	if displayName == "" {
+		displayName = meta.UserLogin
+	}
+	dbChannel, err = s.Store.Client.Channel.Create().
+		SetExtID(meta.UserID).
+		SetName(meta.UserLogin).
+		SetDisplayName(displayName).
+		SetImagePath(fmt.Sprintf("/vods/%s/profile.png", meta.UserLogin)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error creating channel: %v", err)
+	}
+	return dbChannel, nil
+}
+
+// transferImportFiles moves or hardlinks the files of a video. Transferred files are restored if a file fails.
+func transferImportFiles(transfers [][2]string, mode string) error {
+	for i, transfer := range transfers {
+		var err error
+		if mode == ImportModeMove {
+			err = utils.MoveFile(transfer[0], transfer[1])
+		} else {
+			err = os.Link(transfer[0], transfer[1])
+		}
+		if err != nil {
+			rollbackImportFiles(transfers[:i], mode)
+			return fmt.Errorf("error transferring %s to %s: %v", transfer[0], transfer[1], err)
+		}
+	}
+	return nil
+}
+
+func rollbackImportFiles(transfers [][2]string, mode string) {
+	for _, transfer := range transfers {
+		var err error
+		if mode == ImportModeMove {
+			err = utils.MoveFile(transfer[1], transfer[0])
+		} else {
+			err = os.Remove(transfer[1])
+		}
+		if err != nil {
+			log.Error().Err(err).Msgf("Error restoring %s", transfer[0])
+		}
+	}
+}
+
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
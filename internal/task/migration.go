@@ -0,0 +1,620 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/archive"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/twitch"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// Storage migration statuses
+const (
+	MigrationRunning     = "running"
+	MigrationCompleted   = "completed"
+	MigrationRollingBack = "rolling_back"
+	MigrationRolledBack  = "rolled_back"
+)
+
+// Statuses of a video in a storage migration
+const (
+	MigrationVideoPending    = "pending"
+	MigrationVideoMigrated   = "migrated"
+	MigrationVideoFailed     = "failed"
+	MigrationVideoCollision  = "collision"
+	MigrationVideoUnchanged  = "unchanged"
+	MigrationVideoRolledBack = "rolled_back"
+)
+
+// MigrationJournal is the plan and the state of a storage migration. It is saved to
+// storage_templates.migration_journal_path so a migration can be resumed after a crash or rolled back.
+type MigrationJournal struct {
+	ID         uuid.UUID         `json:"id"`
+	Status     string            `json:"status"`
+	DryRun     bool              `json:"dry_run"`
+	StartedAt  time.Time         `json:"started_at"`
+	FinishedAt *time.Time        `json:"finished_at,omitempty"`
+	Total      int               `json:"total"`
+	Processed  int               `json:"processed"`
+	Migrated   int               `json:"migrated"`
+	Failed     int               `json:"failed"`
+	Collisions int               `json:"collisions"`
+	Videos     []*MigrationVideo `json:"videos"`
+}
+
+// MigrationVideo is the migration of the files of a video. Moves are done in order and undone in reverse order.
+// The journal is saved after every move so an interrupted migration knows which files were moved.
+type MigrationVideo struct {
+	VodID    uuid.UUID       `json:"vod_id"`
+	Status   string          `json:"status"`
+	Moves    []MigrationMove `json:"moves"`
+	Done     int             `json:"done"` // number of moves done
+	OldPaths MigrationPaths  `json:"old_paths"`
+	NewPaths MigrationPaths  `json:"new_paths"`
+	Issues   []string        `json:"issues,omitempty"`
+}
+
+// MigrationMove is a file or folder rename. Moves without a backend are done on the local disk.
+type MigrationMove struct {
+	From    string               `json:"from"`
+	To      string               `json:"to"`
+	Backend utils.StorageBackend `json:"backend,omitempty"`
+}
+
+// MigrationPaths are the paths of a video saved in the database.
+type MigrationPaths struct {
+	FolderName          string `json:"folder_name"`
+	FileName            string `json:"file_name"`
+	VideoPath           string `json:"video_path"`
+	ThumbnailPath       string `json:"thumbnail_path"`
+	WebThumbnailPath    string `json:"web_thumbnail_path"`
+	ChatPath            string `json:"chat_path"`
+	ChatVideoPath       string `json:"chat_video_path"`
+	InfoPath            string `json:"info_path"`
+	VideoHlsPath        string `json:"video_hls_path"`
+	CaptionPath         string `json:"caption_path"`
+	LiveChatPath        string `json:"live_chat_path"`
+	LiveChatConvertPath string `json:"live_chat_convert_path"`
+}
+
+var (
+	migrationMu      sync.Mutex
+	migrationJournal *MigrationJournal
+	migrationActive  bool
+)
+
+// StorageMigration renames the files and folders of all videos to the current storage templates.
+// An interrupted migration is resumed instead of starting a new one.
+func (s *Service) StorageMigration() error {
+	journal, err := s.beginStorageMigration(context.Background())
+	if err != nil {
+		return err
+	}
+	s.runStorageMigration(context.Background(), journal)
+	return nil
+}
+
+// StartStorageMigration starts or resumes a storage migration in the background. Dry runs return the plan of a new migration without changing anything.
+func (s *Service) StartStorageMigration(ctx context.Context, dryRun bool) (*MigrationJournal, error) {
+	if dryRun {
+		journal, err := s.planStorageMigration(ctx)
+		if err != nil {
+			return nil, err
+		}
+		journal.DryRun = true
+		now := time.Now()
+		journal.Status = MigrationCompleted
+		journal.FinishedAt = &now
+		return journal, nil
+	}
+	journal, err := s.beginStorageMigration(ctx)
+	if err != nil {
+		return nil, err
+	}
+	migrationMu.Lock()
+	defer migrationMu.Unlock()
+	go s.runStorageMigration(context.Background(), journal)
+	return copyMigrationJournal(journal), nil
+}
+
+// RollbackStorageMigration restores the files and paths of the videos migrated by the last storage migration in the background.
+func (s *Service) RollbackStorageMigration(ctx context.Context) (*MigrationJournal, error) {
+	migrationMu.Lock()
+	defer migrationMu.Unlock()
+	if migrationActive {
+		return nil, fmt.Errorf("a storage migration is running")
+	}
+	journal, err := loadMigrationJournal()
+	if err != nil {
+		return nil, err
+	}
+	if journal.Status == MigrationRolledBack {
+		return nil, fmt.Errorf("storage migration is already rolled back")
+	}
+	journal.Status = MigrationRollingBack
+	journal.FinishedAt = nil
+	migrationJournal = journal
+	migrationActive = true
+	if err := writeMigrationJournal(journal); err != nil {
+		migrationActive = false
+		return nil, err
+	}
+	go s.rollbackStorageMigration(context.Background(), journal)
+	return copyMigrationJournal(journal), nil
+}
+
+// GetStorageMigration returns the journal of the running or last storage migration.
+func (s *Service) GetStorageMigration() (*MigrationJournal, error) {
+	migrationMu.Lock()
+	defer migrationMu.Unlock()
+	if migrationJournal != nil {
+		return copyMigrationJournal(migrationJournal), nil
+	}
+	return loadMigrationJournal()
+}
+
+// beginStorageMigration returns the journal of an interrupted migration or plans a new one.
+func (s *Service) beginStorageMigration(ctx context.Context) (*MigrationJournal, error) {
+	migrationMu.Lock()
+	defer migrationMu.Unlock()
+	if migrationActive {
+		return nil, fmt.Errorf("a storage migration is running")
+	}
+
+	journal, err := loadMigrationJournal()
+	if err == nil && (journal.Status == MigrationRunning || journal.Status == MigrationRollingBack) {
+		if journal.Status == MigrationRollingBack {
+			return nil, fmt.Errorf("the last storage migration was interrupted while rolling back, roll it back again")
+		}
+		log.Info().Msgf("Resuming storage migration %s", journal.ID)
+	} else {
+		journal, err = s.planStorageMigration(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writeMigrationJournal(journal); err != nil {
+		return nil, err
+	}
+	migrationJournal = journal
+	migrationActive = true
+	return journal, nil
+}
+
+// planStorageMigration returns the renames needed to move every video to the current storage templates.
+// Videos whose new folder or files already exist or are used by another video are collisions and are not migrated.
+func (s *Service) planStorageMigration(ctx context.Context) (*MigrationJournal, error) {
+	videos, err := s.Store.Client.Vod.Query().WithChannel().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting videos: %v", err)
+	}
+
+	journal := &MigrationJournal{ID: uuid.New(), Status: MigrationRunning, StartedAt: time.Now(), Videos: []*MigrationVideo{}}
+	folders := map[string]*MigrationVideo{}
+	for _, video := range videos {
+		if video.ColdStorageAt != nil {
+			log.Info().Msgf("Skipping video %s in cold storage", video.ID)
+			continue
+		}
+		mv := planVideoMigration(ctx, video)
+		if mv.Status == MigrationVideoPending {
+			if other, ok := folders[mv.NewPaths.FolderName+"/"+video.Edges.Channel.Name]; ok {
+				mv.Status = MigrationVideoCollision
+				mv.Issues = append(mv.Issues, fmt.Sprintf("video %s is migrated to the same folder", other.VodID))
+				if other.Status == MigrationVideoPending {
+					other.Status = MigrationVideoCollision
+					other.Issues = append(other.Issues, fmt.Sprintf("video %s is migrated to the same folder", video.ID))
+				}
+			} else {
+				folders[mv.NewPaths.FolderName+"/"+video.Edges.Channel.Name] = mv
+			}
+		}
+		journal.Videos = append(journal.Videos, mv)
+	}
+
+	for _, mv := range journal.Videos {
+		switch mv.Status {
+		case MigrationVideoPending:
+			journal.Total++
+		case MigrationVideoCollision:
+			journal.Collisions++
+		}
+	}
+	return journal, nil
+}
+
+// planVideoMigration returns the renames of the files of a video. Files are renamed in the old folder before the folder is renamed.
+func planVideoMigration(ctx context.Context, video *ent.Vod) *MigrationVideo {
+	mv := &MigrationVideo{VodID: video.ID, Status: MigrationVideoPending, Moves: []MigrationMove{}}
+	mv.OldPaths = MigrationPaths{
+		FolderName:          video.FolderName,
+		FileName:            video.FileName,
+		VideoPath:           video.VideoPath,
+		ThumbnailPath:       video.ThumbnailPath,
+		WebThumbnailPath:    video.WebThumbnailPath,
+		ChatPath:            video.ChatPath,
+		ChatVideoPath:       video.ChatVideoPath,
+		InfoPath:            video.InfoPath,
+		VideoHlsPath:        video.VideoHlsPath,
+		CaptionPath:         video.CaptionPath,
+		LiveChatPath:        video.LiveChatPath,
+		LiveChatConvertPath: video.LiveChatConvertPath,
+	}
+	fail := func(issue string) *MigrationVideo {
+		mv.Status = MigrationVideoFailed
+		mv.Issues = append(mv.Issues, issue)
+		return mv
+	}
+
+	if video.Edges.Channel == nil {
+		return fail("video has no channel")
+	}
+	oldRootFolderPath := storage.VodFolder(video)
+	if path.Dir(oldRootFolderPath) != fmt.Sprintf("/vods/%s", video.Edges.Channel.Name) {
+		return fail(fmt.Sprintf("video path %s is not in a video folder", video.VideoPath))
+	}
+
+	vDto := twitch.Vod{
+		ID:        video.ExtID,
+		UserLogin: video.Edges.Channel.Name,
+		Title:     video.Title,
+		Type:      string(video.Type),
+		CreatedAt: video.StreamedAt.Format(time.RFC3339),
+	}
+	folderName, err := archive.GetFolderName(video.ID, vDto)
+	if err != nil {
+		return fail(fmt.Sprintf("error getting folder name: %v", err))
+	}
+	fileName, err := archive.GetFileName(video.ID, vDto)
+	if err != nil {
+		return fail(fmt.Sprintf("error getting file name: %v", err))
+	}
+	newRootFolderPath := fmt.Sprintf("/vods/%s/%s", video.Edges.Channel.Name, folderName)
+
+	backend, err := storage.ForVod(video)
+	if err != nil {
+		return fail(fmt.Sprintf("storage backend %s is not available: %v", video.StorageBackend, err))
+	}
+	// media files are renamed in the storage backend of the video, the other files on the local disk
+	mediaBackend := utils.StorageBackend("")
+	if video.StorageBackend != utils.StorageLocal {
+		mediaBackend = video.StorageBackend
+	}
+
+	mv.NewPaths = MigrationPaths{FolderName: folderName, FileName: fileName}
+	// files outside the folder of the video keep their path
+	addMove := func(oldPath string, newName string, media bool) string {
+		if oldPath == "" {
+			return ""
+		}
+		if !strings.HasPrefix(oldPath, oldRootFolderPath+"/") {
+			return oldPath
+		}
+		move := MigrationMove{From: oldPath, To: fmt.Sprintf("%s/%s", oldRootFolderPath, newName)}
+		if media {
+			move.Backend = mediaBackend
+		}
+		if move.From == move.To {
+			return fmt.Sprintf("%s/%s", newRootFolderPath, newName)
+		}
+		exists, err := migrationPathExists(ctx, backend, move, move.From)
+		if err != nil || !exists {
+			mv.Issues = append(mv.Issues, fmt.Sprintf("%s is missing", move.From))
+		} else if exists, _ := migrationPathExists(ctx, backend, move, move.To); exists {
+			mv.Status = MigrationVideoCollision
+			mv.Issues = append(mv.Issues, fmt.Sprintf("%s already exists", move.To))
+		} else {
+			mv.Moves = append(mv.Moves, move)
+		}
+		return fmt.Sprintf("%s/%s", newRootFolderPath, newName)
+	}
+
+	if path.Ext(video.VideoPath) == ".m3u8" {
+		hlsFolder := video.VideoHlsPath
+		if hlsFolder == "" {
+			hlsFolder = path.Dir(video.VideoPath)
+		}
+		newHlsFolder := addMove(hlsFolder, fmt.Sprintf("%s-video_hls", fileName), true)
+		if video.VideoHlsPath != "" {
+			mv.NewPaths.VideoHlsPath = newHlsFolder
+		}
+		mv.NewPaths.VideoPath = fmt.Sprintf("%s/%s", newHlsFolder, path.Base(video.VideoPath))
+	} else {
+		mv.NewPaths.VideoPath = addMove(video.VideoPath, fmt.Sprintf("%s-video%s", fileName, path.Ext(video.VideoPath)), true)
+	}
+	mv.NewPaths.ThumbnailPath = addMove(video.ThumbnailPath, fmt.Sprintf("%s-thumbnail%s", fileName, path.Ext(video.ThumbnailPath)), false)
+	mv.NewPaths.WebThumbnailPath = addMove(video.WebThumbnailPath, fmt.Sprintf("%s-web_thumbnail%s", fileName, path.Ext(video.WebThumbnailPath)), false)
+	mv.NewPaths.ChatPath = addMove(video.ChatPath, fmt.Sprintf("%s-chat%s", fileName, path.Ext(video.ChatPath)), false)
+	mv.NewPaths.ChatVideoPath = addMove(video.ChatVideoPath, fmt.Sprintf("%s-chat%s", fileName, path.Ext(video.ChatVideoPath)), true)
+	mv.NewPaths.InfoPath = addMove(video.InfoPath, fmt.Sprintf("%s-info%s", fileName, path.Ext(video.InfoPath)), false)
+	mv.NewPaths.CaptionPath = addMove(video.CaptionPath, fmt.Sprintf("%s-caption%s", fileName, path.Ext(video.CaptionPath)), false)
+	mv.NewPaths.LiveChatPath = addMove(video.LiveChatPath, fmt.Sprintf("%s-live-chat%s", fileName, path.Ext(video.LiveChatPath)), false)
+	mv.NewPaths.LiveChatConvertPath = addMove(video.LiveChatConvertPath, fmt.Sprintf("%s-chat-convert%s", fileName, path.Ext(video.LiveChatConvertPath)), false)
+
+	if oldRootFolderPath != newRootFolderPath {
+		if utils.FileExists(newRootFolderPath) {
+			mv.Status = MigrationVideoCollision
+			mv.Issues = append(mv.Issues, fmt.Sprintf("%s already exists", newRootFolderPath))
+		}
+		if utils.FileExists(oldRootFolderPath) {
+			mv.Moves = append(mv.Moves, MigrationMove{From: oldRootFolderPath, To: newRootFolderPath})
+		}
+		if mediaBackend != "" {
+			mv.Moves = append(mv.Moves, MigrationMove{From: oldRootFolderPath, To: newRootFolderPath, Backend: mediaBackend})
+		}
+	}
+
+	if mv.Status == MigrationVideoPending && len(mv.Moves) == 0 && mv.NewPaths == mv.OldPaths {
+		mv.Status = MigrationVideoUnchanged
+	}
+	return mv
+}
+
+func migrationPathExists(ctx context.Context, backend storage.Backend, move MigrationMove, p string) (bool, error) {
+	if move.Backend == "" {
+		return utils.FileExists(p), nil
+	}
+	return backend.Exists(ctx, p)
+}
+
+// runStorageMigration migrates the pending videos of a journal. The moves of a video that fails are undone
+// so no video is left half-migrated, and the journal is saved after every video.
+func (s *Service) runStorageMigration(ctx context.Context, journal *MigrationJournal) {
+	defer func() {
+		migrationMu.Lock()
+		now := time.Now()
+		journal.Status = MigrationCompleted
+		journal.FinishedAt = &now
+		migrationActive = false
+		migrationMu.Unlock()
+		if err := saveMigrationJournal(journal); err != nil {
+			log.Error().Err(err).Msg("Error saving storage migration journal")
+		}
+		log.Info().Msgf("Storage migration finished, %d videos migrated, %d failed, %d collisions", journal.Migrated, journal.Failed, journal.Collisions)
+	}()
+
+	for _, mv := range journal.Videos {
+		if mv.Status != MigrationVideoPending {
+			continue
+		}
+		status, issue := s.migrateVideo(ctx, journal, mv)
+
+		migrationMu.Lock()
+		mv.Status = status
+		if issue != "" {
+			mv.Issues = append(mv.Issues, issue)
+		}
+		journal.Processed++
+		if status == MigrationVideoMigrated {
+			journal.Migrated++
+		} else {
+			journal.Failed++
+		}
+		migrationMu.Unlock()
+
+		if status == MigrationVideoMigrated {
+			log.Info().Msgf("Migrated video %s to new storage template", mv.VodID)
+		} else {
+			log.Error().Msgf("Error migrating video %s: %s", mv.VodID, issue)
+		}
+		if err := saveMigrationJournal(journal); err != nil {
+			log.Error().Err(err).Msg("Error saving storage migration journal")
+		}
+	}
+}
+
+// migrateVideo does the moves of a video and saves its new paths. Moves done before a crash are detected by their destination existing.
+func (s *Service) migrateVideo(ctx context.Context, journal *MigrationJournal, mv *MigrationVideo) (string, string) {
+	for i := mv.Done; i < len(mv.Moves); i++ {
+		move := mv.Moves[i]
+		err := renameMigrationPath(ctx, move.Backend, move.From, move.To)
+		if err != nil {
+			if movedBefore(ctx, move) {
+				setMigrationDone(journal, mv, i+1)
+				continue
+			}
+			issue := fmt.Sprintf("error renaming %s to %s: %v", move.From, move.To, err)
+			if err := undoMigrationMoves(ctx, journal, mv); err != nil {
+				return MigrationVideoFailed, fmt.Sprintf("%s; %v", issue, err)
+			}
+			return MigrationVideoFailed, issue
+		}
+		setMigrationDone(journal, mv, i+1)
+	}
+
+	if err := setMigrationPaths(ctx, s.Store.Client, mv.VodID, mv.NewPaths); err != nil {
+		issue := err.Error()
+		if err := undoMigrationMoves(ctx, journal, mv); err != nil {
+			return MigrationVideoFailed, fmt.Sprintf("%s; %v", issue, err)
+		}
+		return MigrationVideoFailed, issue
+	}
+	return MigrationVideoMigrated, ""
+}
+
+// movedBefore returns true if a move was done before the migration was interrupted.
+func movedBefore(ctx context.Context, move MigrationMove) bool {
+	backend, err := migrationBackend(move.Backend)
+	if err != nil {
+		return false
+	}
+	fromExists, err := backend.Exists(ctx, move.From)
+	if err != nil || fromExists {
+		return false
+	}
+	toExists, err := backend.Exists(ctx, move.To)
+	return err == nil && toExists
+}
+
+// undoMigrationMoves undoes the moves done for a video in reverse order.
+func undoMigrationMoves(ctx context.Context, journal *MigrationJournal, mv *MigrationVideo) error {
+	for mv.Done > 0 {
+		move := mv.Moves[mv.Done-1]
+		if err := renameMigrationPath(ctx, move.Backend, move.To, move.From); err != nil {
+			return fmt.Errorf("error restoring %s: %v", move.From, err)
+		}
+		setMigrationDone(journal, mv, mv.Done-1)
+	}
+	return nil
+}
+
+// setMigrationDone sets the number of moves done for a video and saves the journal.
+func setMigrationDone(journal *MigrationJournal, mv *MigrationVideo, done int) {
+	migrationMu.Lock()
+	defer migrationMu.Unlock()
+	mv.Done = done
+	if err := writeMigrationJournal(journal); err != nil {
+		log.Error().Err(err).Msg("Error saving storage migration journal")
+	}
+}
+
+// rollbackStorageMigration undoes the moves of the videos of a journal and restores their paths.
+func (s *Service) rollbackStorageMigration(ctx context.Context, journal *MigrationJournal) {
+	defer func() {
+		migrationMu.Lock()
+		now := time.Now()
+		journal.FinishedAt = &now
+		migrationActive = false
+		migrationMu.Unlock()
+		if err := saveMigrationJournal(journal); err != nil {
+			log.Error().Err(err).Msg("Error saving storage migration journal")
+		}
+	}()
+
+	failed := 0
+	for i := len(journal.Videos) - 1; i >= 0; i-- {
+		mv := journal.Videos[i]
+		if mv.Done == 0 && mv.Status != MigrationVideoMigrated {
+			continue
+		}
+		var issue string
+		if err := undoMigrationMoves(ctx, journal, mv); err != nil {
+			issue = err.Error()
+		} else if err := setMigrationPaths(ctx, s.Store.Client, mv.VodID, mv.OldPaths); err != nil {
+			issue = err.Error()
+		}
+
+		migrationMu.Lock()
+		if issue != "" {
+			failed++
+			mv.Issues = append(mv.Issues, issue)
+		} else {
+			if mv.Status == MigrationVideoMigrated {
+				journal.Migrated--
+			}
+			mv.Status = MigrationVideoRolledBack
+		}
+		migrationMu.Unlock()
+
+		if issue != "" {
+			log.Error().Msgf("Error rolling back video %s: %s", mv.VodID, issue)
+		}
+		if err := saveMigrationJournal(journal); err != nil {
+			log.Error().Err(err).Msg("Error saving storage migration journal")
+		}
+	}
+
+	migrationMu.Lock()
+	if failed == 0 {
+		journal.Status = MigrationRolledBack
+	}
+	migrationMu.Unlock()
+	log.Info().Msgf("Storage migration %s rolled back, %d videos failed", journal.ID, failed)
+}
+
+func setMigrationPaths(ctx context.Context, client *ent.Client, id uuid.UUID, paths MigrationPaths) error {
+	_, err := client.Vod.UpdateOneID(id).
+		SetFolderName(paths.FolderName).
+		SetFileName(paths.FileName).
+		SetVideoPath(paths.VideoPath).
+		SetThumbnailPath(paths.ThumbnailPath).
+		SetWebThumbnailPath(paths.WebThumbnailPath).
+		SetChatPath(paths.ChatPath).
+		SetChatVideoPath(paths.ChatVideoPath).
+		SetInfoPath(paths.InfoPath).
+		SetVideoHlsPath(paths.VideoHlsPath).
+		SetCaptionPath(paths.CaptionPath).
+		SetLiveChatPath(paths.LiveChatPath).
+		SetLiveChatConvertPath(paths.LiveChatConvertPath).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("error updating video paths: %v", err)
+	}
+	return nil
+}
+
+func migrationBackend(backend utils.StorageBackend) (storage.Backend, error) {
+	if backend == "" {
+		return storage.Get(utils.StorageLocal)
+	}
+	return storage.Get(backend)
+}
+
+func renameMigrationPath(ctx context.Context, backend utils.StorageBackend, from string, to string) error {
+	if backend == "" {
+		return os.Rename(from, to)
+	}
+	b, err := migrationBackend(backend)
+	if err != nil {
+		return err
+	}
+	return b.Rename(ctx, from, to)
+}
+
+func loadMigrationJournal() (*MigrationJournal, error) {
+	data, err := os.ReadFile(viper.GetString("storage_templates.migration_journal_path"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no storage migration has run")
+		}
+		return nil, fmt.Errorf("error reading storage migration journal: %v", err)
+	}
+	var journal MigrationJournal
+	if err := json.Unmarshal(data, &journal); err != nil {
+		return nil, fmt.Errorf("error parsing storage migration journal: %v", err)
+	}
+	return &journal, nil
+}
+
+func saveMigrationJournal(journal *MigrationJournal) error {
+	migrationMu.Lock()
+	defer migrationMu.Unlock()
+	return writeMigrationJournal(journal)
+}
+
+// writeMigrationJournal writes the journal to a temporary file first so a crash never leaves a partial journal. The caller holds migrationMu.
+func writeMigrationJournal(journal *MigrationJournal) error {
+	data, err := json.MarshalIndent(journal, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding storage migration journal: %v", err)
+	}
+	journalPath := viper.GetString("storage_templates.migration_journal_path")
+	tmpPath := journalPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("error writing storage migration journal: %v", err)
+	}
+	if err := os.Rename(tmpPath, journalPath); err != nil {
+		return fmt.Errorf("error writing storage migration journal: %v", err)
+	}
+	return nil
+}
+
+func copyMigrationJournal(journal *MigrationJournal) *MigrationJournal {
+	data, _ := json.Marshal(journal)
+	var c MigrationJournal
+	_ = json.Unmarshal(data, &c)
+	return &c
+}
@@ -0,0 +1,341 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entPlayback "github.com/zibbp/ganymede/ent/playback"
+	"github.com/zibbp/ganymede/ent/schema"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+)
+
+// PruneReport lists the videos pruned by the retention policies of the channels and the space they free.
+// Videos pruned with the delete action are moved to the trash, their space is only freed when the trash is purged.
+type PruneReport struct {
+	Videos     int                  `json:"videos"`
+	FreedBytes int64                `json:"freed_bytes"`
+	Channels   []ChannelPruneReport `json:"channels"`
+}
+
+// ChannelPruneReport lists the videos of a channel pruned by its retention policy.
+// Sizes are only known for files on the local disk. The used space includes the videos of the channel in the trash.
+// FreedAt is when the trash purge frees the space of videos moved to the trash, it is not set if the space is freed right away or the purge is disabled.
+type ChannelPruneReport struct {
+	ChannelID    uuid.UUID             `json:"channel_id"`
+	Channel      string                `json:"channel"`
+	Action       utils.RetentionAction `json:"action"`
+	UsedBytes    int64                 `json:"used_bytes"`
+	TrashedBytes int64                 `json:"trashed_bytes"`
+	FreedBytes   int64                 `json:"freed_bytes"`
+	FreedAt      *time.Time            `json:"freed_at"`
+	Videos       []PrunedVideo         `json:"videos"`
+}
+
+// PrunedVideo is a video pruned by the retention policy of its channel.
+type PrunedVideo struct {
+	VodID      uuid.UUID     `json:"vod_id"`
+	Title      string        `json:"title"`
+	Type       utils.VodType `json:"type"`
+	CreatedAt  time.Time     `json:"created_at"`
+	Reason     string        `json:"reason"`
+	FreedBytes int64         `json:"freed_bytes"`
+	video      *ent.Vod
+}
+
+// PruneVideos prunes the videos matching the retention policies of their channels.
+func PruneVideos() {
+	ctx := context.Background()
+	client := database.DB().Client
+
+	report, err := planPrune(ctx, client)
+	if err != nil {
+		log.Error().Err(err).Msg("Error planning prune")
+		return
+	}
+
+	// videos are moved to the trash through the vod service, purging the trash deletes their queue items, chapters and muted segments too
+	vodService := &vod.Service{Store: database.DB()}
+	echoCtx := audit.NewSystemContext(ctx, "prune_videos")
+
+	var pruned int
+	var freed, trashed int64
+	for _, channel := range report.Channels {
+		for _, video := range channel.Videos {
+			if err := pruneVideo(echoCtx, vodService, video.video, channel.Action); err != nil {
+				log.Error().Err(err).Msgf("Error pruning video %s", video.VodID)
+				continue
+			}
+			log.Info().Msgf("Pruned video %s of channel %s: %s", video.VodID, channel.Channel, video.Reason)
+			pruned++
+			if channel.Action == utils.RetentionDelete {
+				trashed += video.FreedBytes
+			} else {
+				freed += video.FreedBytes
+			}
+		}
+	}
+	log.Info().Msgf("Pruned %d videos, freed %d bytes, %d bytes are freed when the trash is purged", pruned, freed, trashed)
+}
+
+// PreviewPrune returns the videos the next prune would prune and the space it would free.
+func (s *Service) PreviewPrune(ctx context.Context) (*PruneReport, error) {
+	return planPrune(ctx, s.Store.Client)
+}
+
+// planPrune applies the retention policies of the channels with retention enabled. Videos are pruned if
+//   - they were archived more than the retention days of their type ago,
+//   - they are not in the newest retention_keep_last videos,
+//   - or, oldest first, while the channel uses more than retention_max_size_gb.
+//
+// Locked videos, videos that are processing and, if protected by the channel, videos in a playlist or watched by a user are never pruned.
+func planPrune(ctx context.Context, client *ent.Client) (*PruneReport, error) {
+	channels, err := client.Channel.Query().Where(entChannel.Retention(true)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching channels: %v", err)
+	}
+	log.Debug().Msgf("Found %d channels with retention enabled", len(channels))
+
+	report := &PruneReport{Channels: []ChannelPruneReport{}}
+	for _, channel := range channels {
+		channelReport, err := planChannelPrune(ctx, client, channel)
+		if err != nil {
+			return nil, err
+		}
+		if len(channelReport.Videos) == 0 {
+			continue
+		}
+		report.Videos += len(channelReport.Videos)
+		report.FreedBytes += channelReport.FreedBytes
+		report.Channels = append(report.Channels, *channelReport)
+	}
+	return report, nil
+}
+
+func planChannelPrune(ctx context.Context, client *ent.Client, channel *ent.Channel) (*ChannelPruneReport, error) {
+	report := &ChannelPruneReport{ChannelID: channel.ID, Channel: channel.Name, Action: channel.RetentionAction, Videos: []PrunedVideo{}}
+
+	videos, err := client.Vod.Query().Where(entVod.HasChannelWith(entChannel.ID(channel.ID)), entVod.Processing(false)).WithPlaylists().Order(ent.Desc(entVod.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching videos for channel %s: %v", channel.ID, err)
+	}
+
+	watched := map[uuid.UUID]bool{}
+	if channel.RetentionProtectWatched && len(videos) > 0 {
+		ids := make([]uuid.UUID, len(videos))
+		for i, video := range videos {
+			ids[i] = video.ID
+		}
+		playbacks, err := client.Playback.Query().Where(entPlayback.VodIDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching playback for channel %s: %v", channel.ID, err)
+		}
+		for _, playback := range playbacks {
+			watched[playback.VodID] = true
+		}
+	}
+
+	protected := func(video *ent.Vod) bool {
+		return video.Locked ||
+			(channel.RetentionProtectPlaylists && len(video.Edges.Playlists) > 0) ||
+			(channel.RetentionProtectWatched && watched[video.ID])
+	}
+	pruned := map[uuid.UUID]bool{}
+	prune := func(video *ent.Vod, freed int64, reason string) {
+		pruned[video.ID] = true
+		report.FreedBytes += freed
+		report.Videos = append(report.Videos, PrunedVideo{VodID: video.ID, Title: video.Title, Type: video.Type, CreatedAt: video.CreatedAt, Reason: reason, FreedBytes: freed, video: video})
+	}
+
+	sizes := make([]vodSize, len(videos))
+	for i, video := range videos {
+		sizes[i] = vodFileSizes(video)
+		report.UsedBytes += sizes[i].total()
+	}
+
+	// videos in the trash use space until the trash is purged
+	trashed, err := client.Vod.Query().Where(entVod.HasChannelWith(entChannel.ID(channel.ID)), entVod.DeletedAtNotNil()).All(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching trashed videos for channel %s: %v", channel.ID, err)
+	}
+	for _, video := range trashed {
+		if video.StorageBackend == utils.StorageLocal && video.TrashPath != "" {
+			report.TrashedBytes += folderSize(video.TrashPath)
+		}
+	}
+	report.UsedBytes += report.TrashedBytes
+	if retentionDays := viper.GetInt("trash.retention_days"); channel.RetentionAction == utils.RetentionDelete && retentionDays > 0 {
+		freedAt := time.Now().AddDate(0, 0, retentionDays)
+		report.FreedAt = &freedAt
+	}
+
+	// videos are ordered newest first
+	for i, video := range videos {
+		if protected(video) || !prunable(video, channel.RetentionAction) {
+			continue
+		}
+		days := channel.RetentionDays
+		if typeDays, ok := channel.RetentionTypeDays[video.Type]; ok {
+			days = typeDays
+		}
+		switch {
+		case days > 0 && video.CreatedAt.Add(time.Duration(days)*24*time.Hour).Before(time.Now()):
+			prune(video, sizes[i].freed(channel.RetentionAction), fmt.Sprintf("archived more than %d days ago", days))
+		case channel.RetentionKeepLast > 0 && int64(i) >= channel.RetentionKeepLast:
+			prune(video, sizes[i].freed(channel.RetentionAction), fmt.Sprintf("not in the %d newest videos", channel.RetentionKeepLast))
+		}
+	}
+
+	if channel.RetentionMaxSizeGB > 0 {
+		maxBytes := channel.RetentionMaxSizeGB * 1024 * 1024 * 1024
+		used := report.UsedBytes - report.FreedBytes
+		for i := len(videos) - 1; i >= 0 && used > maxBytes; i-- {
+			video := videos[i]
+			if pruned[video.ID] || protected(video) || !prunable(video, channel.RetentionAction) {
+				continue
+			}
+			freed := sizes[i].freed(channel.RetentionAction)
+			if freed == 0 {
+				continue
+			}
+			prune(video, freed, fmt.Sprintf("channel uses more than %d GB", channel.RetentionMaxSizeGB))
+			used -= freed
+		}
+	}
+
+	return report, nil
+}
+
+// prunable returns false if the action has nothing left to delete from a video.
+func prunable(video *ent.Vod, action utils.RetentionAction) bool {
+	switch action {
+	case utils.RetentionDeleteVideo:
+		return video.VideoPrunedAt == nil && video.VideoPath != ""
+	case utils.RetentionDeleteChatVideo:
+		return video.ChatVideoPath != ""
+	}
+	return true
+}
+
+// pruneVideo deletes a video or its video file or chat video as the retention action of its channel says.
+func pruneVideo(c echo.Context, vodService *vod.Service, video *ent.Vod, action utils.RetentionAction) error {
+	ctx := c.Request().Context()
+	switch action {
+	case utils.RetentionDeleteVideo:
+		backend, err := storage.ForVod(video)
+		if err != nil {
+			return err
+		}
+		if path.Ext(video.VideoPath) == ".m3u8" {
+			err = backend.DeleteFolder(ctx, path.Dir(video.VideoPath))
+		} else {
+			err = backend.DeleteFile(ctx, video.VideoPath)
+		}
+		if err != nil {
+			return fmt.Errorf("error deleting video file: %v", err)
+		}
+		if _, err := vodService.Store.Client.Vod.UpdateOneID(video.ID).SetVideoPrunedAt(time.Now()).Save(ctx); err != nil {
+			return fmt.Errorf("error updating video: %v", err)
+		}
+		audit.Record(c, vodService.Store.Client, audit.Event{Action: utils.AuditVodPrune, EntityType: "vod", EntityID: video.ID.String(), Details: map[string]interface{}{"title": video.Title, "ext_id": video.ExtID, "action": action, "path": video.VideoPath}})
+
+	case utils.RetentionDeleteChatVideo:
+		backend, err := storage.ForVod(video)
+		if err != nil {
+			return err
+		}
+		if err := backend.DeleteFile(ctx, video.ChatVideoPath); err != nil {
+			return fmt.Errorf("error deleting chat video file: %v", err)
+		}
+		if _, err := vodService.Store.Client.Vod.UpdateOneID(video.ID).SetChatVideoPath("").Save(ctx); err != nil {
+			return fmt.Errorf("error updating video: %v", err)
+		}
+		audit.Record(c, vodService.Store.Client, audit.Event{Action: utils.AuditVodPrune, EntityType: "vod", EntityID: video.ID.String(), Details: map[string]interface{}{"title": video.Title, "ext_id": video.ExtID, "action": action, "path": video.ChatVideoPath}})
+
+	default:
+		// pruned videos are moved to the trash and permanently deleted when the trash is purged
+		return vodService.TrashVod(c, video.ID, true, true)
+	}
+	return nil
+}
+
+// vodSize is the size of the files of a video on the local disk.
+type vodSize struct {
+	video     int64
+	chatVideo int64
+	other     int64
+}
+
+func (s vodSize) total() int64 {
+	return s.video + s.chatVideo + s.other
+}
+
+// freed returns the bytes a retention action frees.
+func (s vodSize) freed(action utils.RetentionAction) int64 {
+	switch action {
+	case utils.RetentionDeleteVideo:
+		return s.video
+	case utils.RetentionDeleteChatVideo:
+		return s.chatVideo
+	}
+	return s.total()
+}
+
+// vodFileSizes returns the sizes of the files of a video. Media files in remote storage backends are not counted.
+func vodFileSizes(video *ent.Vod) vodSize {
+	var size vodSize
+	if video.StorageBackend == utils.StorageLocal {
+		if video.VideoPrunedAt == nil {
+			if path.Ext(video.VideoPath) == ".m3u8" {
+				size.video = folderSize(path.Dir(video.VideoPath))
+			} else {
+				size.video = localFileSize(video.VideoPath)
+			}
+		}
+		size.chatVideo = localFileSize(video.ChatVideoPath)
+	}
+	for _, p := range []string{video.ChatPath, video.InfoPath, video.ThumbnailPath, video.WebThumbnailPath, video.CaptionPath} {
+		size.other += localFileSize(p)
+	}
+	return size
+}
+
+func localFileSize(p string) int64 {
+	if p == "" {
+		return 0
+	}
+	info, err := os.Stat(p)
+	if err != nil || info.IsDir() {
+		return 0
+	}
+	return info.Size()
+}
+
+func folderSize(p string) int64 {
+	var size int64
+	_ = filepath.WalkDir(p, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if info, err := d.Info(); err == nil {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
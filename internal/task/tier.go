@@ -0,0 +1,136 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/ent"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	entPlayback "github.com/zibbp/ganymede/ent/playback"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// TierVideos moves videos matching the cold storage rules of their channel to the cold storage root.
+// A video is moved if it was archived more than cold_storage_days ago or not watched in cold_storage_unwatched_days.
+// Views of the video player and the playback progress of users both count as watched.
+func TierVideos() {
+	coldRoot := path.Clean(viper.GetString("storage.cold_root"))
+	if coldRoot == "" || coldRoot == "." || coldRoot == "/vods" {
+		log.Error().Msg("cold storage root is not configured")
+		return
+	}
+
+	channels, err := database.DB().Client.Channel.Query().Where(entChannel.ColdStorage(true)).All(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Error fetching channels")
+		return
+	}
+	log.Debug().Msgf("Found %d channels with cold storage enabled", len(channels))
+
+	for _, channel := range channels {
+		if channel.ColdStorageDays <= 0 && channel.ColdStorageUnwatchedDays <= 0 {
+			continue
+		}
+		videos, err := database.DB().Client.Vod.Query().Where(entVod.HasChannelWith(entChannel.ID(channel.ID)), entVod.ColdStorageAtIsNil(), entVod.Processing(false), entVod.StorageBackendEQ(utils.StorageLocal)).All(context.Background())
+		if err != nil {
+			log.Error().Err(err).Msgf("Error fetching videos for channel %s", channel.ID)
+			continue
+		}
+
+		for _, video := range videos {
+			cold, err := isColdVideo(channel, video)
+			if err != nil {
+				log.Error().Err(err).Msgf("Error checking video %s", video.ID)
+				continue
+			}
+			if !cold {
+				continue
+			}
+			err = moveVideoToRoot(video, channel.Name, coldRoot)
+			if err != nil {
+				log.Error().Err(err).Msgf("Error moving video %s to cold storage", video.ID)
+				continue
+			}
+			log.Info().Msgf("Moved video %s to cold storage", video.ID)
+		}
+	}
+}
+
+func isColdVideo(channel *ent.Channel, video *ent.Vod) (bool, error) {
+	if channel.ColdStorageDays > 0 && video.CreatedAt.Add(time.Duration(channel.ColdStorageDays)*24*time.Hour).Before(time.Now()) {
+		return true, nil
+	}
+	if channel.ColdStorageUnwatchedDays > 0 {
+		// videos that were never watched count from when they were archived
+		lastWatched := video.CreatedAt
+		if video.LastViewedAt != nil && video.LastViewedAt.After(lastWatched) {
+			lastWatched = *video.LastViewedAt
+		} else if video.LastViewedAt == nil && video.LocalViews > 0 && video.UpdatedAt.After(lastWatched) {
+			// views recorded before the view time was tracked
+			lastWatched = video.UpdatedAt
+		}
+		lastPlayback, err := database.DB().Client.Playback.Query().Where(entPlayback.VodID(video.ID)).Order(ent.Desc(entPlayback.FieldUpdatedAt)).First(context.Background())
+		if err != nil {
+			if _, ok := err.(*ent.NotFoundError); !ok {
+				return false, fmt.Errorf("error fetching playback: %v", err)
+			}
+		} else if lastPlayback.UpdatedAt.After(lastWatched) {
+			lastWatched = lastPlayback.UpdatedAt
+		}
+		if lastWatched.Add(time.Duration(channel.ColdStorageUnwatchedDays) * 24 * time.Hour).Before(time.Now()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// moveVideoToRoot moves the folder of a video to another storage root and rewrites the paths of the video.
+// Only videos in the folder created by the archiver are moved.
+func moveVideoToRoot(video *ent.Vod, channelName string, root string) error {
+	oldFolderPath := storage.VodFolder(video)
+	if video.FolderName == "" || oldFolderPath != fmt.Sprintf("/vods/%s/%s", channelName, video.FolderName) {
+		return fmt.Errorf("video is not in its archive folder")
+	}
+	newFolderPath := fmt.Sprintf("%s/%s/%s", root, channelName, video.FolderName)
+
+	err := utils.MoveFolder(oldFolderPath, newFolderPath)
+	if err != nil {
+		return fmt.Errorf("error moving %s to %s: %v", oldFolderPath, newFolderPath, err)
+	}
+
+	rewrite := func(p string) string {
+		if strings.HasPrefix(p, oldFolderPath+"/") {
+			return newFolderPath + strings.TrimPrefix(p, oldFolderPath)
+		}
+		return p
+	}
+	_, err = video.Update().
+		SetThumbnailPath(rewrite(video.ThumbnailPath)).
+		SetWebThumbnailPath(rewrite(video.WebThumbnailPath)).
+		SetVideoPath(rewrite(video.VideoPath)).
+		SetVideoHlsPath(rewrite(video.VideoHlsPath)).
+		SetChatPath(rewrite(video.ChatPath)).
+		SetLiveChatPath(rewrite(video.LiveChatPath)).
+		SetLiveChatConvertPath(rewrite(video.LiveChatConvertPath)).
+		SetChatVideoPath(rewrite(video.ChatVideoPath)).
+		SetInfoPath(rewrite(video.InfoPath)).
+		SetCaptionPath(rewrite(video.CaptionPath)).
+		SetColdStorageAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		// move the files back so the paths stay valid
+		if moveErr := utils.MoveFolder(newFolderPath, oldFolderPath); moveErr != nil {
+			log.Error().Err(moveErr).Msgf("Error moving %s back to %s", newFolderPath, oldFolderPath)
+		}
+		return fmt.Errorf("error updating video paths: %v", err)
+	}
+	return nil
+}
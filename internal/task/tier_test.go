@@ -0,0 +1,175 @@
+package task
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/enttest"
+	"github.com/zibbp/ganymede/internal/database"
+)
+
+const tierChannel = "tier_channel"
+
+func openTierDatabase(t *testing.T) *ent.Client {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", enttest.WithOptions(ent.Log(t.Log)))
+	t.Cleanup(func() {
+		client.Close()
+	})
+	previous := database.SetDB(&database.Database{Client: client})
+	t.Cleanup(func() {
+		database.SetDB(previous)
+	})
+	return client
+}
+
+// createTierVod creates a video archived age days ago with its files in its archive folder.
+func createTierVod(t *testing.T, client *ent.Client, channel *ent.Channel, folder string, age int) *ent.Vod {
+	folderPath := filepath.Join("/vods", tierChannel, folder)
+	if err := os.MkdirAll(folderPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(filepath.Join("/vods", tierChannel))
+	})
+	if err := os.WriteFile(filepath.Join(folderPath, "video.mp4"), []byte("video"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	createdAt := time.Now().Add(-time.Duration(age) * 24 * time.Hour)
+	v, err := client.Vod.Create().SetTitle(folder).SetExtID(folder).SetWebThumbnailPath("").SetFolderName(folder).
+		SetVideoPath(filepath.Join(folderPath, "video.mp4")).SetThumbnailPath(filepath.Join(folderPath, "thumbnail.jpg")).SetInfoPath("/data/info.json").
+		SetCreatedAt(createdAt).SetUpdatedAt(createdAt).SetChannel(channel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestIsColdVideo(t *testing.T) {
+	client := openTierDatabase(t)
+
+	channel, err := client.Channel.Create().SetName(tierChannel).SetDisplayName(tierChannel).SetImagePath("").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	daysAgo := func(days int) time.Time {
+		return time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+	}
+	create := func(title string, age int) *ent.VodCreate {
+		return client.Vod.Create().SetTitle(title).SetExtID(title).SetWebThumbnailPath("").SetVideoPath("").
+			SetCreatedAt(daysAgo(age)).SetUpdatedAt(daysAgo(age)).SetChannel(channel)
+	}
+
+	recent := create("recent", 1).SaveX(context.Background())
+	old := create("old", 40).SaveX(context.Background())
+	unwatched := create("unwatched", 60).SaveX(context.Background())
+	viewed := create("viewed", 60).SetLastViewedAt(daysAgo(2)).SaveX(context.Background())
+	viewedLongAgo := create("viewed long ago", 60).SetLastViewedAt(daysAgo(45)).SaveX(context.Background())
+	// views recorded before the view time was tracked count from the last update
+	localViews := create("local views", 60).SetLocalViews(3).SetUpdatedAt(daysAgo(5)).SaveX(context.Background())
+	played := create("played", 60).SaveX(context.Background())
+	client.Playback.Create().SetVodID(played.ID).SetUserID(uuid.New()).SetTime(30).SetUpdatedAt(daysAgo(3)).SaveX(context.Background())
+	playedLongAgo := create("played long ago", 60).SaveX(context.Background())
+	client.Playback.Create().SetVodID(playedLongAgo.ID).SetUserID(uuid.New()).SetTime(30).SetUpdatedAt(daysAgo(50)).SaveX(context.Background())
+
+	ageRule := &ent.Channel{ColdStorageDays: 30}
+	unwatchedRule := &ent.Channel{ColdStorageUnwatchedDays: 30}
+	tests := []struct {
+		name    string
+		channel *ent.Channel
+		video   *ent.Vod
+		want    bool
+	}{
+		{name: "archived recently", channel: ageRule, video: recent, want: false},
+		{name: "archived before the age", channel: ageRule, video: old, want: true},
+		{name: "age rule ignores views", channel: ageRule, video: viewed, want: true},
+		{name: "never watched", channel: unwatchedRule, video: unwatched, want: true},
+		{name: "never watched but archived recently", channel: unwatchedRule, video: recent, want: false},
+		{name: "viewed recently", channel: unwatchedRule, video: viewed, want: false},
+		{name: "viewed before the days", channel: unwatchedRule, video: viewedLongAgo, want: true},
+		{name: "local views without a view time", channel: unwatchedRule, video: localViews, want: false},
+		{name: "played recently", channel: unwatchedRule, video: played, want: false},
+		{name: "played before the days", channel: unwatchedRule, video: playedLongAgo, want: true},
+		{name: "no rules", channel: &ent.Channel{}, video: old, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cold, err := isColdVideo(tt.channel, tt.video)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, cold)
+		})
+	}
+}
+
+func TestTierVideos(t *testing.T) {
+	client := openTierDatabase(t)
+
+	coldRoot := t.TempDir()
+	viper.Set("storage.cold_root", coldRoot)
+	t.Cleanup(func() {
+		viper.Set("storage.cold_root", "")
+	})
+
+	channel, err := client.Channel.Create().SetName(tierChannel).SetDisplayName(tierChannel).SetImagePath("").
+		SetColdStorage(true).SetColdStorageDays(30).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := createTierVod(t, client, channel, "old", 40)
+	recent := createTierVod(t, client, channel, "recent", 1)
+
+	TierVideos()
+
+	// old videos are moved with their paths in the folder rewritten
+	old, err = client.Vod.Get(context.Background(), old.ID)
+	if assert.NoError(t, err) {
+		newFolderPath := filepath.Join(coldRoot, tierChannel, "old")
+		assert.NotNil(t, old.ColdStorageAt)
+		assert.Equal(t, filepath.Join(newFolderPath, "video.mp4"), old.VideoPath)
+		assert.Equal(t, filepath.Join(newFolderPath, "thumbnail.jpg"), old.ThumbnailPath)
+		assert.Equal(t, "/data/info.json", old.InfoPath)
+		assert.FileExists(t, filepath.Join(newFolderPath, "video.mp4"))
+		assert.NoDirExists(t, filepath.Join("/vods", tierChannel, "old"))
+	}
+
+	recent, err = client.Vod.Get(context.Background(), recent.ID)
+	if assert.NoError(t, err) {
+		assert.Nil(t, recent.ColdStorageAt)
+		assert.Equal(t, filepath.Join("/vods", tierChannel, "recent", "video.mp4"), recent.VideoPath)
+		assert.FileExists(t, recent.VideoPath)
+	}
+}
+
+func TestMoveVideoToRoot(t *testing.T) {
+	client := openTierDatabase(t)
+
+	channel, err := client.Channel.Create().SetName(tierChannel).SetDisplayName(tierChannel).SetImagePath("").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// videos outside their archive folder are not moved
+	moved, err := client.Vod.Create().SetTitle("moved").SetExtID("moved").SetWebThumbnailPath("").SetFolderName("moved").
+		SetVideoPath("/other/moved/video.mp4").SetChannel(channel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Error(t, moveVideoToRoot(moved, tierChannel, t.TempDir()))
+
+	// the files are moved back if the paths can't be updated
+	root := t.TempDir()
+	video := createTierVod(t, client, channel, "deleted", 40)
+	if err := client.Vod.DeleteOneID(video.ID).Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	assert.Error(t, moveVideoToRoot(video, tierChannel, root))
+	assert.FileExists(t, video.VideoPath)
+	assert.NoFileExists(t, filepath.Join(root, tierChannel, "deleted", "video.mp4"))
+}
@@ -0,0 +1,63 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+	entChannel "github.com/zibbp/ganymede/ent/channel"
+	"github.com/zibbp/ganymede/ent/schema"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/channel"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/vod"
+)
+
+// PurgeTrash permanently deletes the videos and channels that were moved to the trash more than trash.retention_days ago.
+func PurgeTrash() {
+	retentionDays := viper.GetInt("trash.retention_days")
+	if retentionDays <= 0 {
+		log.Info().Msg("Trash purge is disabled")
+		return
+	}
+	before := time.Now().AddDate(0, 0, -retentionDays)
+
+	ctx := schema.SkipSoftDelete(context.Background())
+	client := database.DB().Client
+
+	vodService := &vod.Service{Store: database.DB()}
+	channelService := &channel.Service{Store: database.DB()}
+	echoCtx := audit.NewSystemContext(ctx, "purge_trash")
+
+	videos, err := client.Vod.Query().Where(entVod.DeletedAtLT(before)).All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Error fetching trashed videos")
+		return
+	}
+	var purged int
+	for _, video := range videos {
+		// the files of videos moved to the trash without their files are kept
+		if err := vodService.DeleteVod(echoCtx, video.ID, video.TrashPath != ""); err != nil {
+			log.Error().Err(err).Msgf("Error purging video %s", video.ID)
+			continue
+		}
+		purged++
+	}
+
+	// channels are purged after their videos, which were moved to the trash with them
+	channels, err := client.Channel.Query().Where(entChannel.DeletedAtLT(before)).All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Error fetching trashed channels")
+		return
+	}
+	for _, c := range channels {
+		if err := channelService.DeleteChannel(echoCtx, c.ID); err != nil {
+			log.Error().Err(err).Msgf("Error purging channel %s", c.ID)
+			continue
+		}
+		purged++
+	}
+	log.Info().Msgf("Purged %d videos and channels from the trash", purged)
+}
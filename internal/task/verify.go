@@ -0,0 +1,399 @@
+package task
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+	"github.com/zibbp/ganymede/ent"
+	entQueue "github.com/zibbp/ganymede/ent/queue"
+	entVod "github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/exec"
+	"github.com/zibbp/ganymede/internal/storage"
+	"github.com/zibbp/ganymede/internal/temporal"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// verifyEndSeconds is how many seconds at the end of a video are decoded to detect truncated files.
+const verifyEndSeconds = 30
+
+// HealthReport summarizes the integrity of the archived videos.
+type HealthReport struct {
+	Total      int        `json:"total"`
+	Healthy    int        `json:"healthy"`
+	Unhealthy  int        `json:"unhealthy"`
+	Unverified int        `json:"unverified"`
+	Videos     []*ent.Vod `json:"videos"` // unhealthy videos
+}
+
+// VerifyVideos verifies the integrity of the archived videos and repairs unhealthy videos as configured by integrity.repair.
+// Videos verified in the last integrity.verify_interval_days are skipped unless all is set.
+func VerifyVideos(all bool) {
+	ctx := context.Background()
+	client := database.DB().Client
+
+	query := client.Vod.Query().Where(entVod.Processing(false))
+	if !all {
+		interval := time.Duration(viper.GetInt("integrity.verify_interval_days")) * 24 * time.Hour
+		query = query.Where(entVod.Or(entVod.VerifiedAtIsNil(), entVod.VerifiedAtLT(time.Now().Add(-interval))))
+	}
+	videos, err := query.All(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Error fetching videos")
+		return
+	}
+	log.Debug().Msgf("Found %d videos to verify", len(videos))
+
+	for _, video := range videos {
+		verified, err := VerifyVideo(ctx, client, video)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error verifying video %s", video.ID)
+			continue
+		}
+		video = verified
+		if video.Health != utils.HealthUnhealthy {
+			continue
+		}
+		log.Warn().Msgf("Video %s is unhealthy: %s", video.ID, strings.Join(video.HealthIssues, "; "))
+		if err := RepairVideo(ctx, client, video); err != nil {
+			log.Error().Err(err).Msgf("Error repairing video %s", video.ID)
+		}
+	}
+}
+
+// VerifyVideo checks that the files of a video exist and that the video is playable and as long as archived.
+// The result is saved to the health of the video.
+func VerifyVideo(ctx context.Context, client *ent.Client, video *ent.Vod) (*ent.Vod, error) {
+	issues := videoIssues(ctx, video)
+	health := utils.HealthHealthy
+	if len(issues) > 0 {
+		health = utils.HealthUnhealthy
+	}
+	video, err := client.Vod.UpdateOneID(video.ID).SetHealth(health).SetHealthIssues(issues).SetVerifiedAt(time.Now()).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error saving video health: %v", err)
+	}
+	return video, nil
+}
+
+// RepairVideo repairs an unhealthy video as configured by integrity.repair.
+// Remuxed videos are verified again, re-archived videos are verified after the archive finished.
+func RepairVideo(ctx context.Context, client *ent.Client, video *ent.Vod) error {
+	switch viper.GetString("integrity.repair") {
+	case "remux":
+		if video.StorageBackend != utils.StorageLocal || path.Ext(video.VideoPath) == ".m3u8" || !utils.FileExists(video.VideoPath) {
+			return fmt.Errorf("video can't be remuxed")
+		}
+		if err := remuxVideo(ctx, video); err != nil {
+			return err
+		}
+		_, err := VerifyVideo(ctx, client, video)
+		return err
+
+	case "rearchive":
+		var workflowName string
+		switch {
+		case video.Type == utils.Live:
+			return fmt.Errorf("live archives can't be re-archived")
+		case video.Platform == utils.PlatformYoutube:
+			workflowName = "ArchiveYoutubeVideoWorkflow"
+		case video.Type == utils.Clip:
+			workflowName = "ArchiveClipWorkflow"
+		default:
+			workflowName = "ArchiveVideoWorkflow"
+		}
+		if video.ExtID == "" {
+			return fmt.Errorf("video has no platform id and can't be re-archived")
+		}
+		// only the video is archived again
+		q, err := client.Queue.Query().Where(entQueue.HasVodWith(entVod.ID(video.ID))).Only(ctx)
+		if err != nil {
+			if _, ok := err.(*ent.NotFoundError); !ok {
+				return fmt.Errorf("error getting queue item: %v", err)
+			}
+			// imported videos have no queue item
+			_, err = client.Queue.Create().SetVodID(video.ID).SetProcessing(true).SetVideoProcessing(true).SetChatProcessing(false).
+				SetTaskVodCreateFolder(utils.Success).SetTaskVodDownloadThumbnail(utils.Success).SetTaskVodSaveInfo(utils.Success).
+				SetTaskVideoDownload(utils.Pending).SetTaskVideoConvert(utils.Pending).SetTaskVideoMove(utils.Pending).
+				SetTaskChatDownload(utils.Success).SetTaskChatConvert(utils.Success).SetTaskChatRender(utils.Success).SetTaskChatMove(utils.Success).
+				SetRenderChat(false).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("error creating queue item: %v", err)
+			}
+		} else {
+			_, err = q.Update().SetProcessing(true).SetVideoProcessing(true).SetChatProcessing(false).
+				SetTaskVideoDownload(utils.Pending).SetTaskVideoConvert(utils.Pending).SetTaskVideoMove(utils.Pending).
+				Save(ctx)
+			if err != nil {
+				return fmt.Errorf("error updating queue item: %v", err)
+			}
+		}
+		update := client.Vod.UpdateOneID(video.ID).SetProcessing(true).ClearVerifiedAt()
+		if video.TmpVideoDownloadPath == "" || video.TmpVideoConvertPath == "" {
+			update = update.SetTmpVideoDownloadPath(fmt.Sprintf("/tmp/%s_%s-video.mp4", video.ExtID, video.ID)).
+				SetTmpVideoConvertPath(fmt.Sprintf("/tmp/%s_%s-video-convert.mp4", video.ExtID, video.ID))
+		}
+		if _, err := update.Save(ctx); err != nil {
+			rollbackRearchive(ctx, client, video, q)
+			return fmt.Errorf("error updating video: %v", err)
+		}
+		if _, err := temporal.RestartArchiveWorkflow(ctx, video.ID, workflowName); err != nil {
+			rollbackRearchive(ctx, client, video, q)
+			return err
+		}
+		log.Info().Msgf("Re-archiving unhealthy video %s", video.ID)
+	}
+	return nil
+}
+
+// rollbackRearchive restores the video and its queue item after the archive workflow could not be restarted.
+// The queue item is deleted if it was created for the re-archive, which is the case when q is nil.
+func rollbackRearchive(ctx context.Context, client *ent.Client, video *ent.Vod, q *ent.Queue) {
+	ctx = context.WithoutCancel(ctx)
+	update := client.Vod.UpdateOneID(video.ID).SetProcessing(video.Processing).
+		SetTmpVideoDownloadPath(video.TmpVideoDownloadPath).SetTmpVideoConvertPath(video.TmpVideoConvertPath)
+	if video.VerifiedAt == nil {
+		update = update.ClearVerifiedAt()
+	} else {
+		update = update.SetVerifiedAt(*video.VerifiedAt)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		log.Error().Err(err).Msgf("error restoring video %s after a failed re-archive", video.ID)
+	}
+	if q == nil {
+		if _, err := client.Queue.Delete().Where(entQueue.HasVodWith(entVod.ID(video.ID))).Exec(ctx); err != nil {
+			log.Error().Err(err).Msgf("error deleting queue item of video %s after a failed re-archive", video.ID)
+		}
+		return
+	}
+	_, err := q.Update().SetProcessing(q.Processing).SetVideoProcessing(q.VideoProcessing).SetChatProcessing(q.ChatProcessing).
+		SetTaskVideoDownload(q.TaskVideoDownload).SetTaskVideoConvert(q.TaskVideoConvert).SetTaskVideoMove(q.TaskVideoMove).
+		Save(ctx)
+	if err != nil {
+		log.Error().Err(err).Msgf("error restoring queue item of video %s after a failed re-archive", video.ID)
+	}
+}
+
+// remuxVideo remuxes a video to a temporary file and replaces the video with it only if the remuxed video is playable,
+// complete and not shorter than the video, as remuxing drops the packets it can't read.
+func remuxVideo(ctx context.Context, video *ent.Vod) error {
+	ext := path.Ext(video.VideoPath)
+	tmpPath := fmt.Sprintf("%s.remux%s", strings.TrimSuffix(video.VideoPath, ext), ext)
+	if err := exec.RemuxVideo(ctx, video.VideoPath, tmpPath); err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	duration, err := exec.ProbeVideoDuration(ctx, tmpPath)
+	if err != nil {
+		return fmt.Errorf("remuxed video is not playable: %v", err)
+	}
+	if err := exec.CheckVideoEnd(ctx, tmpPath, verifyEndSeconds); err != nil {
+		return fmt.Errorf("remuxed video is truncated or corrupted: %v", err)
+	}
+	// the original may not be playable so its duration is only compared if it can be read
+	tolerance := viper.GetFloat64("integrity.duration_tolerance_seconds")
+	if originalDuration, err := exec.ProbeVideoDuration(ctx, video.VideoPath); err == nil && duration < originalDuration-tolerance {
+		return fmt.Errorf("remuxed video is %ds long but the video is %ds long", int(duration), int(originalDuration))
+	}
+	if video.Duration > 1 && math.Abs(duration-float64(video.Duration)) > tolerance {
+		return fmt.Errorf("remuxed video is %ds long but %ds were archived", int(duration), video.Duration)
+	}
+
+	if err := os.Rename(tmpPath, video.VideoPath); err != nil {
+		return fmt.Errorf("error replacing video with remuxed video: %v", err)
+	}
+	log.Info().Msgf("Remuxed video %s", video.ID)
+	return nil
+}
+
+// GetHealthReport returns the number of videos by health and the unhealthy videos.
+func (s *Service) GetHealthReport(ctx context.Context) (*HealthReport, error) {
+	var counts []struct {
+		Health utils.VodHealth `json:"health"`
+		Count  int             `json:"count"`
+	}
+	err := s.Store.Client.Vod.Query().GroupBy(entVod.FieldHealth).Aggregate(ent.Count()).Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("error counting videos: %v", err)
+	}
+	report := &HealthReport{}
+	for _, c := range counts {
+		report.Total += c.Count
+		switch c.Health {
+		case utils.HealthHealthy:
+			report.Healthy = c.Count
+		case utils.HealthUnhealthy:
+			report.Unhealthy = c.Count
+		case utils.HealthUnverified:
+			report.Unverified = c.Count
+		}
+	}
+
+	report.Videos, err = s.Store.Client.Vod.Query().Where(entVod.HealthEQ(utils.HealthUnhealthy)).WithChannel().Order(ent.Desc(entVod.FieldVerifiedAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unhealthy videos: %v", err)
+	}
+	return report, nil
+}
+
+// VerifyVod verifies the integrity of a video.
+func (s *Service) VerifyVod(ctx context.Context, id uuid.UUID) (*ent.Vod, error) {
+	video, err := s.Store.Client.Vod.Get(ctx, id)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return nil, fmt.Errorf("vod not found")
+		}
+		return nil, fmt.Errorf("error getting vod: %v", err)
+	}
+	if video.Processing {
+		return nil, fmt.Errorf("vod is processing")
+	}
+	return VerifyVideo(ctx, s.Store.Client, video)
+}
+
+// videoIssues returns the problems of the files of a video.
+func videoIssues(ctx context.Context, video *ent.Vod) []string {
+	issues := []string{}
+
+	backend, err := storage.ForVod(video)
+	if err != nil {
+		return append(issues, fmt.Sprintf("storage backend %s is not available: %v", video.StorageBackend, err))
+	}
+
+	// media files are stored in the storage backend of the video, the other files on the local disk
+	if video.VideoPrunedAt != nil {
+		// the retention policy deleted the video file
+	} else if video.VideoPath == "" {
+		issues = append(issues, "video path is not set")
+	} else if exists, err := backend.Exists(ctx, video.VideoPath); err != nil {
+		issues = append(issues, fmt.Sprintf("error checking video file: %v", err))
+	} else if !exists {
+		issues = append(issues, fmt.Sprintf("video file %s is missing", video.VideoPath))
+	} else if video.StorageBackend == utils.StorageLocal {
+		issues = append(issues, localVideoIssues(ctx, video)...)
+	}
+
+	if video.ChatVideoPath != "" {
+		if exists, err := backend.Exists(ctx, video.ChatVideoPath); err != nil {
+			issues = append(issues, fmt.Sprintf("error checking chat video file: %v", err))
+		} else if !exists {
+			issues = append(issues, fmt.Sprintf("chat video file %s is missing", video.ChatVideoPath))
+		}
+	}
+
+	files := []struct {
+		name string
+		path string
+	}{
+		{"thumbnail", video.ThumbnailPath},
+		{"web thumbnail", video.WebThumbnailPath},
+		{"info", video.InfoPath},
+		{"chat", video.ChatPath},
+		{"caption", video.CaptionPath},
+	}
+	for _, file := range files {
+		if file.path != "" && !utils.FileExists(file.path) {
+			issues = append(issues, fmt.Sprintf("%s file %s is missing", file.name, file.path))
+		}
+	}
+
+	return issues
+}
+
+// localVideoIssues checks that a video on the local disk is playable and as long as the archived duration.
+func localVideoIssues(ctx context.Context, video *ent.Vod) []string {
+	issues := []string{}
+
+	var duration float64
+	if path.Ext(video.VideoPath) == ".m3u8" {
+		var playlistIssues []string
+		duration, playlistIssues = hlsPlaylistIssues(video.VideoPath)
+		issues = append(issues, playlistIssues...)
+	} else {
+		var err error
+		duration, err = exec.ProbeVideoDuration(ctx, video.VideoPath)
+		if err != nil {
+			return append(issues, fmt.Sprintf("video is not playable: %v", err))
+		}
+		if err := exec.CheckVideoEnd(ctx, video.VideoPath, verifyEndSeconds); err != nil {
+			issues = append(issues, fmt.Sprintf("video is truncated or corrupted: %v", err))
+		}
+	}
+
+	// videos without a known duration have a duration of 1
+	tolerance := viper.GetFloat64("integrity.duration_tolerance_seconds")
+	if video.Duration > 1 && math.Abs(duration-float64(video.Duration)) > tolerance {
+		issues = append(issues, fmt.Sprintf("video is %ds long but %ds were archived", int(duration), video.Duration))
+	}
+
+	return issues
+}
+
+// hlsPlaylistIssues returns the duration of an HLS playlist and checks that its segments exist and that the playlist is complete.
+func hlsPlaylistIssues(playlistPath string) (float64, []string) {
+	issues := []string{}
+
+	file, err := os.Open(playlistPath)
+	if err != nil {
+		return 0, append(issues, fmt.Sprintf("error opening hls playlist: %v", err))
+	}
+	defer file.Close()
+
+	var duration float64
+	var segments, missing int
+	var firstMissing string
+	ended := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#EXTINF:"):
+			value := strings.SplitN(strings.TrimPrefix(line, "#EXTINF:"), ",", 2)[0]
+			if d, err := strconv.ParseFloat(value, 64); err == nil {
+				duration += d
+			}
+		case line == "#EXT-X-ENDLIST":
+			ended = true
+		case strings.HasPrefix(line, "#"):
+		default:
+			segments++
+			segmentPath := line
+			if !path.IsAbs(segmentPath) {
+				segmentPath = path.Join(path.Dir(playlistPath), segmentPath)
+			}
+			if info, err := os.Stat(segmentPath); err != nil || info.Size() == 0 {
+				missing++
+				if firstMissing == "" {
+					firstMissing = segmentPath
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return duration, append(issues, fmt.Sprintf("error reading hls playlist: %v", err))
+	}
+
+	if segments == 0 {
+		issues = append(issues, "hls playlist has no segments")
+	}
+	if missing > 0 {
+		issues = append(issues, fmt.Sprintf("%d of %d hls segments are missing or empty, first %s", missing, segments, firstMissing))
+	}
+	if !ended {
+		issues = append(issues, "hls playlist is incomplete")
+	}
+	return duration, issues
+}
@@ -62,3 +62,10 @@ func InitializeTemporalClient() {
 func GetTemporalClient() *Temporal {
 	return temporalClient
 }
+
+// SetTemporalClient replaces the Temporal client and returns the previous one, e.g. to mock Temporal in tests.
+func SetTemporalClient(t *Temporal) *Temporal {
+	previous := temporalClient
+	temporalClient = t
+	return previous
+}
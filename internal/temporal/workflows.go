@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -12,11 +13,13 @@ import (
 	entVod "github.com/zibbp/ganymede/ent/vod"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/dto"
+	"github.com/zibbp/ganymede/internal/utils"
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/history/v1"
 	"go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
 )
 
 type WorkflowHistory struct {
@@ -123,7 +126,7 @@ func RestartArchiveWorkflow(ctx context.Context, videoId uuid.UUID, workflowName
 	vod, err := database.DB().Client.Vod.Query().Where(entVod.ID(videoId)).WithChannel().WithQueue().Only(context.Background())
 	if err != nil {
 		log.Error().Err(err).Msg("failed to fetch vod")
-		return "", nil
+		return "", fmt.Errorf("error fetching vod: %v", err)
 	}
 
 	// check if a live watch exists
@@ -133,7 +136,7 @@ func RestartArchiveWorkflow(ctx context.Context, videoId uuid.UUID, workflowName
 			log.Debug().Msg("no live watch found")
 		} else {
 			log.Error().Err(err).Msg("failed to fetch live watch")
-			return "", nil
+			return "", fmt.Errorf("error fetching live watch: %v", err)
 		}
 	}
 
@@ -155,14 +158,41 @@ func RestartArchiveWorkflow(ctx context.Context, videoId uuid.UUID, workflowName
 	workflowRun, err := temporalClient.Client.ExecuteWorkflow(ctx, workflowOptions, workflowName, input)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to start workflow")
-		return "", nil
+		return "", fmt.Errorf("error starting workflow: %v", err)
 	}
 
 	log.Info().Msgf("Started workflow %s", workflowRun.GetID())
 
+	// the queue item is cancelled through the restarted workflow
+	if input.Queue != nil {
+		_, err = database.DB().Client.Queue.UpdateOneID(input.Queue.ID).SetWorkflowID(workflowRun.GetID()).SetWorkflowRunID(workflowRun.GetRunID()).Save(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to update queue item workflow")
+		}
+	}
+
 	return workflowRun.GetID(), nil
 }
 
+// CancelWorkflow requests cancellation of a workflow. Cancellation is propagated to its child workflows and activities.
+// The current run is cancelled if runId is empty.
+func CancelWorkflow(ctx context.Context, workflowId string, runId string) error {
+	err := temporalClient.Client.CancelWorkflow(ctx, workflowId, runId)
+	if err != nil {
+		return fmt.Errorf("error cancelling workflow: %v", err)
+	}
+	return nil
+}
+
+// SignalWorkflow sends a signal to a workflow. The current run is signalled if runId is empty.
+func SignalWorkflow(ctx context.Context, workflowId string, runId string, signalName string, arg interface{}) error {
+	err := temporalClient.Client.SignalWorkflow(ctx, workflowId, runId, signalName, arg)
+	if err != nil {
+		return fmt.Errorf("error signalling workflow: %v", err)
+	}
+	return nil
+}
+
 func GetVideoIdFromWorkflow(ctx context.Context, workflowId string, runId string) (WorkflowVideoIdResult, error) {
 	var result WorkflowVideoIdResult
 	history, err := GetWorkflowHistory(ctx, workflowId, runId)
@@ -191,3 +221,57 @@ func GetVideoIdFromWorkflow(ctx context.Context, workflowId string, runId string
 
 	return result, nil
 }
+
+// ActivityProgress is the progress of a running activity, sent with its heartbeats.
+type ActivityProgress struct {
+	Activity      string          `json:"activity"`
+	WorkflowId    string          `json:"workflow_id"`
+	State         string          `json:"state"`
+	Attempt       int32           `json:"attempt"`
+	LastHeartbeat *time.Time      `json:"last_heartbeat,omitempty"`
+	Progress      *utils.Progress `json:"progress,omitempty"`
+}
+
+// GetWorkflowProgress returns the progress of the running activities of a workflow and its child workflows.
+func GetWorkflowProgress(ctx context.Context, workflowId string, runId string) ([]ActivityProgress, error) {
+	description, err := temporalClient.Client.DescribeWorkflowExecution(ctx, workflowId, runId)
+	if err != nil {
+		return nil, fmt.Errorf("error describing workflow: %v", err)
+	}
+
+	progress := []ActivityProgress{}
+	for _, pendingActivity := range description.PendingActivities {
+		activityProgress := ActivityProgress{
+			Activity:   pendingActivity.GetActivityType().GetName(),
+			WorkflowId: workflowId,
+			State:      pendingActivity.GetState().String(),
+			Attempt:    pendingActivity.GetAttempt(),
+		}
+		if pendingActivity.LastHeartbeatTime != nil {
+			lastHeartbeat := pendingActivity.LastHeartbeatTime.AsTime()
+			activityProgress.LastHeartbeat = &lastHeartbeat
+		}
+		// heartbeats hold a message and optionally the progress
+		var msg string
+		var p utils.Progress
+		details := pendingActivity.GetHeartbeatDetails()
+		if len(details.GetPayloads()) > 1 {
+			if err := converter.GetDefaultDataConverter().FromPayloads(details, &msg, &p); err != nil {
+				log.Debug().Err(err).Msgf("error decoding heartbeat details of activity %s", activityProgress.Activity)
+			} else {
+				activityProgress.Progress = &p
+			}
+		}
+		progress = append(progress, activityProgress)
+	}
+
+	for _, child := range description.PendingChildren {
+		childProgress, err := GetWorkflowProgress(ctx, child.GetWorkflowId(), child.GetRunId())
+		if err != nil {
+			return nil, err
+		}
+		progress = append(progress, childProgress...)
+	}
+
+	return progress, nil
+}
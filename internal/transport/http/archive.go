@@ -5,25 +5,45 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/archive"
+	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type ArchiveService interface {
 	ArchiveTwitchChannel(cName string) (*ent.Channel, error)
-	ArchiveTwitchVod(vID string, quality string, chat bool, renderChat bool) (*archive.TwitchVodResponse, error)
+	ArchiveTwitchVod(vID string, quality string, chat bool, renderChat bool, profileID *uuid.UUID) (*archive.TwitchVodResponse, error)
+	ArchiveTwitchClip(clipID string, quality string, chat bool, renderChat bool, profileID *uuid.UUID) (*archive.TwitchVodResponse, error)
+	ArchiveYoutubeChannel(cName string) (*ent.Channel, error)
+	ArchiveYoutubeVideo(vID string, quality string) (*archive.TwitchVodResponse, error)
+	TwitchVodChannelID(vID string) (string, error)
+	TwitchClipChannelID(clipID string) (string, error)
+	YoutubeVideoChannelID(vID string) (string, error)
 }
 
 type ArchiveChannelRequest struct {
 	ChannelName string `json:"channel_name" validate:"required"`
 }
 type ArchiveVodRequest struct {
-	VodID      string           `json:"vod_id" validate:"required"`
-	Quality    utils.VodQuality `json:"quality" validate:"required,oneof=best source 720p60 480p30 360p30 160p30 480p 360p 160p audio"`
-	Chat       bool             `json:"chat"`
-	RenderChat bool             `json:"render_chat"`
+	VodID            string           `json:"vod_id" validate:"required"`
+	Quality          utils.VodQuality `json:"quality" validate:"required,oneof=best source 720p60 480p30 360p30 160p30 480p 360p 160p audio"`
+	Chat             bool             `json:"chat"`
+	RenderChat       bool             `json:"render_chat"`
+	ArchiveProfileID *uuid.UUID       `json:"archive_profile_id"`
+}
+type ArchiveClipRequest struct {
+	Slug             string           `json:"slug" validate:"required"`
+	Quality          utils.VodQuality `json:"quality" validate:"required,oneof=best source 720p60 480p30 360p30 160p30 480p 360p 160p audio"`
+	Chat             bool             `json:"chat"`
+	RenderChat       bool             `json:"render_chat"`
+	ArchiveProfileID *uuid.UUID       `json:"archive_profile_id"`
+}
+type ArchiveYoutubeVideoRequest struct {
+	VideoID string           `json:"video_id" validate:"required"`
+	Quality utils.VodQuality `json:"quality" validate:"required,oneof=best source 2160p 1440p 1080p 720p 480p 360p audio"`
 }
 
 // ArchiveTwitchChannel godoc
@@ -64,6 +84,7 @@ func (h *Handler) ArchiveTwitchChannel(c echo.Context) error {
 //	@Param			vod	body		ArchiveVodRequest	true	"Vod"
 //	@Success		200	{object}	archive.TwitchVodResponse
 //	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		403	{object}	utils.ErrorResponse
 //	@Failure		500	{object}	utils.ErrorResponse
 //	@Router			/archive/vod [post]
 //	@Security		ApiKeyCookieAuth
@@ -75,7 +96,115 @@ func (h *Handler) ArchiveTwitchVod(c echo.Context) error {
 	if err := c.Validate(avr); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
-	vod, err := h.Service.ArchiveService.ArchiveTwitchVod(avr.VodID, string(avr.Quality), avr.Chat, avr.RenderChat)
+	// archivers and users granted the archive access to the channel can archive its videos
+	channelID, err := h.Service.ArchiveService.TwitchVodChannelID(avr.VodID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if err := auth.CheckChannelExtIDAccess(c, channelID, utils.ChannelAccessArchive); err != nil {
+		return err
+	}
+	vod, err := h.Service.ArchiveService.ArchiveTwitchVod(avr.VodID, string(avr.Quality), avr.Chat, avr.RenderChat, avr.ArchiveProfileID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, vod)
+}
+
+// ArchiveTwitchClip godoc
+//
+//	@Summary		Archive a twitch clip
+//	@Description	Archive a twitch clip by its slug. The clip is linked to its source vod if it is archived.
+//	@Tags			archive
+//	@Accept			json
+//	@Produce		json
+//	@Param			clip	body		ArchiveClipRequest	true	"Clip"
+//	@Success		200		{object}	archive.TwitchVodResponse
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		403		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/archive/clip [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) ArchiveTwitchClip(c echo.Context) error {
+	acr := new(ArchiveClipRequest)
+	if err := c.Bind(acr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(acr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	channelID, err := h.Service.ArchiveService.TwitchClipChannelID(acr.Slug)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if err := auth.CheckChannelExtIDAccess(c, channelID, utils.ChannelAccessArchive); err != nil {
+		return err
+	}
+	vod, err := h.Service.ArchiveService.ArchiveTwitchClip(acr.Slug, string(acr.Quality), acr.Chat, acr.RenderChat, acr.ArchiveProfileID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, vod)
+}
+
+// ArchiveYoutubeChannel godoc
+//
+//	@Summary		Archive a youtube channel
+//	@Description	Archive a youtube channel by handle or channel id (creates channel in database and download profile image)
+//	@Tags			archive
+//	@Accept			json
+//	@Produce		json
+//	@Param			channel	body		ArchiveChannelRequest	true	"Channel"
+//	@Success		200		{object}	ent.Channel
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/archive/youtube/channel [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) ArchiveYoutubeChannel(c echo.Context) error {
+	acr := new(ArchiveChannelRequest)
+	if err := c.Bind(acr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(acr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	channel, err := h.Service.ArchiveService.ArchiveYoutubeChannel(acr.ChannelName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, channel)
+}
+
+// ArchiveYoutubeVideo godoc
+//
+//	@Summary		Archive a youtube video
+//	@Description	Archive a youtube video or past livestream
+//	@Tags			archive
+//	@Accept			json
+//	@Produce		json
+//	@Param			video	body		ArchiveYoutubeVideoRequest	true	"Video"
+//	@Success		200		{object}	archive.TwitchVodResponse
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		403		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/archive/youtube/vod [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) ArchiveYoutubeVideo(c echo.Context) error {
+	ayr := new(ArchiveYoutubeVideoRequest)
+	if err := c.Bind(ayr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(ayr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	channelID, err := h.Service.ArchiveService.YoutubeVideoChannelID(ayr.VideoID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if err := auth.CheckChannelExtIDAccess(c, channelID, utils.ChannelAccessArchive); err != nil {
+		return err
+	}
+	vod, err := h.Service.ArchiveService.ArchiveYoutubeVideo(ayr.VideoID, string(ayr.Quality))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -0,0 +1,198 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/profile"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type ArchiveProfileService interface {
+	GetProfiles(c echo.Context) ([]*ent.ArchiveProfile, error)
+	GetProfile(c echo.Context, id uuid.UUID) (*ent.ArchiveProfile, error)
+	CreateProfile(c echo.Context, profileDto profile.Profile) (*ent.ArchiveProfile, error)
+	UpdateProfile(c echo.Context, id uuid.UUID, profileDto profile.Profile) (*ent.ArchiveProfile, error)
+	DeleteProfile(c echo.Context, id uuid.UUID) error
+}
+
+// ArchiveProfileRequest is used to create and update archive profiles.
+// Empty settings fall back to the global config.
+type ArchiveProfileRequest struct {
+	Name           string `json:"name" validate:"required,min=1,max=50"`
+	Quality        string `json:"quality" validate:"omitempty,oneof=best source 720p60 480p30 360p30 160p30 480p 360p 160p audio"`
+	VideoConvert   string `json:"video_convert"`
+	SaveAsHls      *bool  `json:"save_as_hls"`
+	RenderChat     bool   `json:"render_chat"`
+	ChatRender     string `json:"chat_render"`
+	StreamlinkLive string `json:"streamlink_live"`
+	FolderTemplate string `json:"folder_template"`
+	FileTemplate   string `json:"file_template"`
+	// ProxyPolicy defaults to default when not set
+	ProxyPolicy utils.ProxyPolicy `json:"proxy_policy" validate:"omitempty,oneof=default enabled disabled"`
+}
+
+func (r *ArchiveProfileRequest) toProfile() profile.Profile {
+	proxyPolicy := r.ProxyPolicy
+	if proxyPolicy == "" {
+		proxyPolicy = utils.ProxyPolicyDefault
+	}
+	return profile.Profile{
+		Name:           r.Name,
+		Quality:        r.Quality,
+		VideoConvert:   r.VideoConvert,
+		SaveAsHls:      r.SaveAsHls,
+		RenderChat:     r.RenderChat,
+		ChatRender:     r.ChatRender,
+		StreamlinkLive: r.StreamlinkLive,
+		FolderTemplate: r.FolderTemplate,
+		FileTemplate:   r.FileTemplate,
+		ProxyPolicy:    proxyPolicy,
+	}
+}
+
+// GetArchiveProfiles godoc
+//
+//	@Summary		Get archive profiles
+//	@Description	Get archive profiles
+//	@Tags			archive
+//	@Produce		json
+//	@Success		200	{object}	[]ent.ArchiveProfile
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/archive/profile [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetArchiveProfiles(c echo.Context) error {
+	profiles, err := h.Service.ArchiveProfileService.GetProfiles(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, profiles)
+}
+
+// GetArchiveProfile godoc
+//
+//	@Summary		Get archive profile
+//	@Description	Get archive profile
+//	@Tags			archive
+//	@Produce		json
+//	@Param			id	path		string	true	"archive profile id"
+//	@Success		200	{object}	ent.ArchiveProfile
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/archive/profile/{id} [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetArchiveProfile(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid archive profile id")
+	}
+	p, err := h.Service.ArchiveProfileService.GetProfile(c, pID)
+	if err != nil {
+		if err.Error() == "archive profile not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, p)
+}
+
+// CreateArchiveProfile godoc
+//
+//	@Summary		Create archive profile
+//	@Description	Create archive profile
+//	@Tags			archive
+//	@Accept			json
+//	@Produce		json
+//	@Param			profile	body		ArchiveProfileRequest	true	"archive profile"
+//	@Success		200		{object}	ent.ArchiveProfile
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/archive/profile [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateArchiveProfile(c echo.Context) error {
+	apr := new(ArchiveProfileRequest)
+	if err := c.Bind(apr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(apr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	p, err := h.Service.ArchiveProfileService.CreateProfile(c, apr.toProfile())
+	if err != nil {
+		if err.Error() == "archive profile already exists" {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, p)
+}
+
+// UpdateArchiveProfile godoc
+//
+//	@Summary		Update archive profile
+//	@Description	Update archive profile
+//	@Tags			archive
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"archive profile id"
+//	@Param			profile	body		ArchiveProfileRequest	true	"archive profile"
+//	@Success		200		{object}	ent.ArchiveProfile
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/archive/profile/{id} [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) UpdateArchiveProfile(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid archive profile id")
+	}
+	apr := new(ArchiveProfileRequest)
+	if err := c.Bind(apr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(apr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	p, err := h.Service.ArchiveProfileService.UpdateProfile(c, pID, apr.toProfile())
+	if err != nil {
+		if err.Error() == "archive profile not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		if err.Error() == "archive profile already exists" {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, p)
+}
+
+// DeleteArchiveProfile godoc
+//
+//	@Summary		Delete archive profile
+//	@Description	Delete archive profile. Channels, watched channels and videos using the profile fall back to the global config.
+//	@Tags			archive
+//	@Param			id	path	string	true	"archive profile id"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/archive/profile/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteArchiveProfile(c echo.Context) error {
+	pID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid archive profile id")
+	}
+	err = h.Service.ArchiveProfileService.DeleteProfile(c, pID)
+	if err != nil {
+		if err.Error() == "archive profile not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
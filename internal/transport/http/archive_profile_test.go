@@ -0,0 +1,64 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/enttest"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/profile"
+	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+var (
+	createArchiveProfileTestJson = `{
+		"name": "low quality",
+		"quality": "480p30",
+		"render_chat": false,
+		"folder_template": "{{id}}"
+	}`
+)
+
+// * TestCreateArchiveProfile tests the CreateArchiveProfile function
+// Creates a new archive profile
+func TestCreateArchiveProfile(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			ArchiveProfileService: profile.NewService(&database.Database{Client: client}),
+		},
+	}
+
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/archive/profile", strings.NewReader(createArchiveProfileTestJson))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.CreateArchiveProfile(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "low quality", response["name"])
+		assert.Equal(t, "480p30", response["quality"])
+		assert.Equal(t, string(utils.ProxyPolicyDefault), response["proxy_policy"])
+	}
+}
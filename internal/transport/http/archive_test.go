@@ -1,27 +1,36 @@
 package http_test
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/enttest"
 	"github.com/zibbp/ganymede/internal/archive"
+	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/channel"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/platform"
 	"github.com/zibbp/ganymede/internal/queue"
+	"github.com/zibbp/ganymede/internal/temporal"
 	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
 	"github.com/zibbp/ganymede/internal/twitch"
 	"github.com/zibbp/ganymede/internal/utils"
 	"github.com/zibbp/ganymede/internal/vod"
+	"go.temporal.io/sdk/mocks"
 )
 
 var (
@@ -42,6 +51,10 @@ func (m ServiceFuncMock) GetUserByLogin(login string) (twitch.Channel, error) {
 	}, nil
 }
 
+func (m ServiceFuncMock) GetUserByID(id string) (twitch.Channel, error) {
+	return m.GetUserByLogin("test")
+}
+
 // * TestArchiveChannel tests the archiving of a twitch channel functionality.
 // Test fetches a mock channel, creates a db entry, and downloads the channel image.
 func TestArchiveTwitchChannel(t *testing.T) {
@@ -93,3 +106,237 @@ func TestArchiveTwitchChannel(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+// TestArchiveTwitchClip archives a clip of a channel that is not archived yet, the channel is created from the broadcaster ID.
+func TestArchiveTwitchClip(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	image := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("png"))
+	}))
+	defer image.Close()
+
+	// the display name of the broadcaster does not match the login
+	twitchPlatform := platform.Register(utils.PlatformTwitch, &FakePlatform{
+		Users: []platform.User{
+			{ID: "456", Login: "clip_channel", DisplayName: "クリップ", ProfileImageURL: image.URL},
+		},
+		Clips: []platform.Clip{
+			{ID: "clip1", UserID: "456", UserName: "クリップ", Title: "clip", Duration: 30, CreatedAt: time.Now()},
+		},
+	})
+	defer platform.Register(utils.PlatformTwitch, twitchPlatform)
+
+	run := &mocks.WorkflowRun{}
+	run.On("GetID").Return("workflow")
+	run.On("GetRunID").Return("run")
+	temporalClient := &mocks.Client{}
+	temporalClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(run, nil)
+	previousClient := temporal.SetTemporalClient(&temporal.Temporal{Client: temporalClient})
+	defer temporal.SetTemporalClient(previousClient)
+
+	vodService := vod.NewService(&database.Database{Client: client})
+	channelService := channel.NewService(&database.Database{Client: client})
+	queueService := queue.NewService(&database.Database{Client: client}, vodService, channelService)
+	archiveService := archive.NewService(&database.Database{Client: client}, twitch.NewService(), channelService, vodService, queueService)
+
+	response, err := archiveService.ArchiveTwitchClip("clip1", "best", false, false, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "clip1", response.VOD.ExtID)
+		assert.Equal(t, utils.Clip, response.VOD.Type)
+		temporalClient.AssertExpectations(t)
+
+		dbChannel, err := response.VOD.QueryChannel().Only(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "clip_channel", dbChannel.Name)
+		assert.Equal(t, "456", dbChannel.ExtID)
+	}
+
+	// the clip is not archived twice
+	_, err = archiveService.ArchiveTwitchClip("clip1", "best", false, false, nil)
+	assert.Error(t, err)
+}
+
+// fakeYtdlp puts a yt-dlp on the PATH printing the given video for video URLs and the given channel otherwise.
+func fakeYtdlp(t *testing.T, video string, channel string) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "video.json"), []byte(video), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "channel.json"), []byte(channel), 0644); err != nil {
+		t.Fatal(err)
+	}
+	script := fmt.Sprintf(`#!/bin/sh
+for arg in "$@"; do url="$arg"; done
+case "$url" in
+	*watch*) cat %s/video.json ;;
+	*) cat %s/channel.json ;;
+esac
+`, dir, dir)
+	if err := os.WriteFile(filepath.Join(dir, "yt-dlp"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+// TestArchiveYoutubeChannel archives a YouTube channel and refuses to archive it again while it is in the trash.
+func TestArchiveYoutubeChannel(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	image := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("png"))
+	}))
+	defer image.Close()
+
+	fakeYtdlp(t, `{}`, `{"channel_id": "UCyoutubechannel00000001", "channel": "YouTube Channel", "uploader_id": "@YT_Channel", "thumbnails": [{"id": "avatar_uncropped", "url": "`+image.URL+`"}]}`)
+	t.Cleanup(func() {
+		os.RemoveAll("/vods/yt-yt_channel")
+	})
+
+	vodService := vod.NewService(&database.Database{Client: client})
+	channelService := channel.NewService(&database.Database{Client: client})
+	queueService := queue.NewService(&database.Database{Client: client}, vodService, channelService)
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			ArchiveService: archive.NewService(&database.Database{Client: client}, twitch.NewService(), channelService, vodService, queueService),
+		},
+	}
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/archive/youtube/channel", strings.NewReader(`{"channel_name": "YT_Channel"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.ArchiveYoutubeChannel(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "yt-yt_channel", response["name"])
+		assert.Equal(t, "UCyoutubechannel00000001", response["ext_id"])
+		assert.FileExists(t, "/vods/yt-yt_channel/profile.png")
+	}
+
+	// a channel in the trash is not archived again
+	if _, err := client.Channel.Update().SetDeletedAt(time.Now()).Save(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/archive/youtube/channel", strings.NewReader(`{"channel_name": "YT_Channel"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+
+	err := h.ArchiveYoutubeChannel(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusInternalServerError, err.(*echo.HTTPError).Code)
+		assert.Equal(t, "channel is in the trash, restore it to archive it again", err.(*echo.HTTPError).Message)
+	}
+}
+
+// TestArchiveYoutubeVideo archives a YouTube video of a channel that is not archived yet.
+// Only users allowed to archive can create the channel and a video is not archived twice.
+func TestArchiveYoutubeVideo(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+	previousDB := database.SetDB(&database.Database{Client: client})
+	defer database.SetDB(previousDB)
+
+	image := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("png"))
+	}))
+	defer image.Close()
+
+	fakeYtdlp(t, `{"id": "video1", "title": "video", "channel_id": "UCyoutubechannel00000002", "channel": "YouTube Video Channel", "uploader_id": "@yt_video_channel", "duration": 60, "timestamp": 1705312345, "live_status": "not_live"}`,
+		`{"channel_id": "UCyoutubechannel00000002", "channel": "YouTube Video Channel", "uploader_id": "@yt_video_channel", "thumbnails": [{"id": "avatar_uncropped", "url": "`+image.URL+`"}]}`)
+	t.Cleanup(func() {
+		os.RemoveAll("/vods/yt-yt_video_channel")
+	})
+
+	run := &mocks.WorkflowRun{}
+	run.On("GetID").Return("workflow")
+	run.On("GetRunID").Return("run")
+	temporalClient := &mocks.Client{}
+	temporalClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(run, nil)
+	previousClient := temporal.SetTemporalClient(&temporal.Temporal{Client: temporalClient})
+	defer temporal.SetTemporalClient(previousClient)
+
+	vodService := vod.NewService(&database.Database{Client: client})
+	channelService := channel.NewService(&database.Database{Client: client})
+	queueService := queue.NewService(&database.Database{Client: client}, vodService, channelService)
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			ArchiveService: archive.NewService(&database.Database{Client: client}, twitch.NewService(), channelService, vodService, queueService),
+		},
+	}
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	user, err := client.User.Create().SetUsername("user").SetPassword("password").SetRole(utils.UserRole).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	archiver, err := client.User.Create().SetUsername("archiver").SetPassword("password").SetRole(utils.ArchiverRole).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archiveVideo := func(u *ent.User) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/archive/youtube/vod", strings.NewReader(`{"video_id": "video1", "quality": "best"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: u}
+		return rec, h.ArchiveYoutubeVideo(c)
+	}
+
+	// users can't archive a channel that is not archived yet
+	_, err = archiveVideo(user)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	}
+	count, err := client.Channel.Query().Count(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	rec, err := archiveVideo(archiver)
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		temporalClient.AssertExpectations(t)
+
+		var response archive.TwitchVodResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "video1", response.VOD.ExtID)
+		assert.Equal(t, utils.PlatformYoutube, response.VOD.Platform)
+
+		dbChannel, err := client.Channel.Query().Only(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "yt-yt_video_channel", dbChannel.Name)
+		assert.Equal(t, "UCyoutubechannel00000002", dbChannel.ExtID)
+	}
+
+	// the video is not archived twice
+	_, err = archiveVideo(archiver)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusInternalServerError, err.(*echo.HTTPError).Code)
+		assert.Equal(t, "vod already exists", err.(*echo.HTTPError).Message)
+	}
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type AuditService interface {
+	GetEvents(c echo.Context, filter audit.Filter) (audit.Pagination, error)
+}
+
+// GetAuditEvents godoc
+//
+//	@Summary		Get audit events
+//	@Description	Get the audit log of administrative and destructive actions, newest first
+//	@Tags			admin
+//	@Produce		json
+//	@Param			action		query		string	false	"Action"	Enums(vod_delete, channel_delete, vod_trash, vod_restore, channel_trash, channel_restore, config_update, user_update, user_delete, queue_stop, queue_cancel, task_start)
+//	@Param			entity_type	query		string	false	"Entity type, e.g. vod"
+//	@Param			entity_id	query		string	false	"Entity ID"
+//	@Param			user_id		query		string	false	"ID of the user who did the action"
+//	@Param			from		query		string	false	"Events at or after this time, RFC 3339"
+//	@Param			to			query		string	false	"Events before this time, RFC 3339"
+//	@Param			limit		query		integer	false	"Limit"		default(20)
+//	@Param			offset		query		integer	false	"Offset"	default(0)
+//	@Success		200			{object}	audit.Pagination
+//	@Failure		400			{object}	utils.ErrorResponse
+//	@Failure		500			{object}	utils.ErrorResponse
+//	@Router			/admin/audit [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetAuditEvents(c echo.Context) error {
+	filter := audit.Filter{
+		Action:     utils.AuditAction(c.QueryParam("action")),
+		EntityType: c.QueryParam("entity_type"),
+		EntityID:   c.QueryParam("entity_id"),
+		Limit:      20,
+	}
+	if filter.Action != "" {
+		valid := false
+		for _, action := range utils.AuditAction("").Values() {
+			valid = valid || string(filter.Action) == action
+		}
+		if !valid {
+			return echo.NewHTTPError(http.StatusBadRequest, "action is invalid")
+		}
+	}
+	if c.QueryParam("user_id") != "" {
+		userID, err := uuid.Parse(c.QueryParam("user_id"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid user id")
+		}
+		filter.UserID = &userID
+	}
+	if c.QueryParam("from") != "" {
+		from, err := time.Parse(time.RFC3339, c.QueryParam("from"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid from time")
+		}
+		filter.From = &from
+	}
+	if c.QueryParam("to") != "" {
+		to, err := time.Parse(time.RFC3339, c.QueryParam("to"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid to time")
+		}
+		filter.To = &to
+	}
+	if c.QueryParam("limit") != "" {
+		l, err := strconv.Atoi(c.QueryParam("limit"))
+		if err != nil || l < 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+		}
+		filter.Limit = l
+	}
+	if c.QueryParam("offset") != "" {
+		o, err := strconv.Atoi(c.QueryParam("offset"))
+		if err != nil || o < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid offset")
+		}
+		filter.Offset = o
+	}
+	events, err := h.Service.AuditService.GetEvents(c, filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, events)
+}
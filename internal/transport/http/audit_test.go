@@ -0,0 +1,98 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/enttest"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/auth"
+	"github.com/zibbp/ganymede/internal/database"
+	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
+	"github.com/zibbp/ganymede/internal/user"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// * TestGetAuditEvents tests the GetAuditEvents function
+// Changes the role of a user and returns the change with the admin who made it in the audit log
+func TestGetAuditEvents(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	store := &database.Database{Client: client}
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			UserService:  user.NewService(store),
+			AuditService: audit.NewService(store),
+		},
+	}
+
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	// Create an admin and a user
+	admin, err := client.User.Create().SetUsername("admin").SetRole(utils.AdminRole).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbUser, err := client.User.Create().SetUsername("test").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Make the user an editor
+	req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/api/v1/user/%s", dbUser.ID), strings.NewReader(`{"username":"test","role":"editor"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderXRealIP, "192.0.2.1")
+	rec := httptest.NewRecorder()
+	c := &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: admin}
+	c.SetParamNames("id")
+	c.SetParamValues(dbUser.ID.String())
+
+	if !assert.NoError(t, h.UpdateUser(c)) {
+		return
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit?action=user_update&entity_id="+dbUser.ID.String(), nil)
+	rec = httptest.NewRecorder()
+
+	if assert.NoError(t, h.GetAuditEvents(h.Server.NewContext(req, rec))) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response audit.Pagination
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		if assert.Equal(t, 1, response.TotalCount) {
+			event := response.Data[0]
+			assert.Equal(t, utils.AuditUserUpdate, event.Action)
+			assert.Equal(t, "user", event.EntityType)
+			assert.Equal(t, admin.ID, *event.UserID)
+			assert.Equal(t, "admin", event.Username)
+			assert.Equal(t, "192.0.2.1", event.IP)
+			// only the changed fields are recorded
+			assert.Equal(t, map[string]utils.AuditChange{"role": {Before: "user", After: "editor"}}, event.Changes)
+		}
+	}
+
+	// Invalid filters are rejected
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/admin/audit?action=unknown", nil)
+	rec = httptest.NewRecorder()
+
+	err = h.GetAuditEvents(h.Server.NewContext(req, rec))
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
@@ -1,14 +1,20 @@
 package http
 
 import (
+	"context"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/spf13/viper"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/user"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type AuthService interface {
@@ -21,6 +27,19 @@ type AuthService interface {
 	OAuthCallback(c echo.Context) error
 	OAuthTokenRefresh(c echo.Context, refreshToken string) error
 	OAuthLogout(c echo.Context) error
+	CreateApiToken(c echo.Context, user *ent.User, tokenDto auth.CreateApiToken) (string, *ent.ApiToken, error)
+	GetApiTokens(ctx context.Context, userID uuid.UUID) ([]*ent.ApiToken, error)
+	DeleteApiToken(c echo.Context, user *ent.User, id uuid.UUID) error
+	GetSessions(ctx context.Context, userID uuid.UUID) ([]*ent.Session, error)
+	DeleteSession(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
+	DeleteOtherSessions(ctx context.Context, userID uuid.UUID, current *uuid.UUID) error
+}
+
+// SessionResponse is a login session of the current user.
+type SessionResponse struct {
+	*ent.Session
+	// Current is whether the request was made with the session.
+	Current bool `json:"current"`
 }
 
 type RegisterRequest struct {
@@ -294,3 +313,214 @@ func (h *Handler) OAuthLogout(c echo.Context) error {
 	}
 	return c.Redirect(http.StatusFound, os.Getenv("FRONTEND_HOST"))
 }
+
+type CreateApiTokenRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+	// Role caps the role the token grants, defaults to the role of the user
+	Role utils.Role `json:"role" validate:"omitempty,oneof=admin editor archiver user"`
+	// ExpiresInDays is the number of days until the token expires, 0 never expires
+	ExpiresInDays int `json:"expires_in_days" validate:"min=0"`
+}
+
+type CreateApiTokenResponse struct {
+	*ent.ApiToken
+	// Token is only returned when the token is created
+	Token string `json:"token"`
+}
+
+// CreateApiToken godoc
+//
+//	@Summary		Create API token
+//	@Description	Create an API token for the current user. Tokens are sent in the Authorization header as "Bearer <token>" and are only shown once.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		CreateApiTokenRequest	true	"CreateApiTokenRequest"
+//	@Success		200		{object}	CreateApiTokenResponse
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		403		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/auth/tokens [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateApiToken(c echo.Context) error {
+	// a leaked token should not be able to create more tokens
+	if c.Get("auth_method") == "api_token" {
+		return echo.NewHTTPError(http.StatusForbidden, "API tokens can't create API tokens")
+	}
+	cc := c.(*auth.CustomContext)
+	ctr := new(CreateApiTokenRequest)
+	if err := c.Bind(ctr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(ctr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	tokenDto := auth.CreateApiToken{
+		Name: ctr.Name,
+		Role: ctr.Role,
+	}
+	if ctr.ExpiresInDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, ctr.ExpiresInDays)
+		tokenDto.ExpiresAt = &expiresAt
+	}
+
+	token, apiToken, err := h.Service.AuthService.CreateApiToken(c, cc.User, tokenDto)
+	if err != nil {
+		if err.Error() == "token role can't be higher than the user role" {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, CreateApiTokenResponse{ApiToken: apiToken, Token: token})
+}
+
+// GetApiTokens godoc
+//
+//	@Summary		Get API tokens
+//	@Description	Get the API tokens of the current user
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{object}	[]ent.ApiToken
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/tokens [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetApiTokens(c echo.Context) error {
+	cc := c.(*auth.CustomContext)
+	tokens, err := h.Service.AuthService.GetApiTokens(c.Request().Context(), cc.User.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, tokens)
+}
+
+// DeleteApiToken godoc
+//
+//	@Summary		Revoke API token
+//	@Description	Revoke an API token of the current user. Admins can revoke the tokens of any user.
+//	@Tags			auth
+//	@Param			id	path	string	true	"Token id"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/tokens/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteApiToken(c echo.Context) error {
+	cc := c.(*auth.CustomContext)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+	if err := h.Service.AuthService.DeleteApiToken(c, cc.User, id); err != nil {
+		if err.Error() == "token not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// GetSessions godoc
+//
+//	@Summary		Get sessions
+//	@Description	Get the login sessions of the current user, most recently seen first
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{object}	[]SessionResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/sessions [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetSessions(c echo.Context) error {
+	cc := c.(*auth.CustomContext)
+	sessions, err := h.Service.AuthService.GetSessions(c.Request().Context(), cc.User.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	current := auth.SessionID(c)
+	response := make([]SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		response = append(response, SessionResponse{Session: session, Current: current != nil && *current == session.ID})
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteSession godoc
+//
+//	@Summary		Revoke session
+//	@Description	Revoke a login session of the current user, the device is logged out
+//	@Tags			auth
+//	@Param			id	path	string	true	"Session id"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/sessions/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteSession(c echo.Context) error {
+	cc := c.(*auth.CustomContext)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+	if err := h.Service.AuthService.DeleteSession(c.Request().Context(), cc.User.ID, id); err != nil {
+		if err.Error() == "session not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// DeleteOtherSessions godoc
+//
+//	@Summary		Revoke other sessions
+//	@Description	Revoke all login sessions of the current user except the session of the request. Requires a login session, not an API token.
+//	@Tags			auth
+//	@Success		200
+//	@Failure		403	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/auth/sessions [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteOtherSessions(c echo.Context) error {
+	cc := c.(*auth.CustomContext)
+	// API tokens have no session, they would revoke all sessions of the user
+	sessionID := auth.SessionID(c)
+	if sessionID == nil {
+		return echo.NewHTTPError(http.StatusForbidden, "sessions can only be revoked from a login session")
+	}
+	if err := h.Service.AuthService.DeleteOtherSessions(c.Request().Context(), cc.User.ID, sessionID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// CheckFileAccess godoc
+//
+//	@Summary		Check file access
+//	@Description	Check that the user can view the channel of a file in /vods or /vods-cold. The file is the X-Original-URI header set by the nginx auth_request of the file server.
+//	@Tags			auth
+//	@Param			X-Original-URI	header	string	true	"Requested file"
+//	@Success		204
+//	@Failure		401	{object}	utils.ErrorResponse
+//	@Failure		403	{object}	utils.ErrorResponse
+//	@Router			/auth/file-access [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CheckFileAccess(c echo.Context) error {
+	uri, err := url.ParseRequestURI(c.Request().Header.Get("X-Original-URI"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusForbidden, "invalid file")
+	}
+	prefix := "/vods"
+	if uri.Path == "/vods-cold" || strings.HasPrefix(uri.Path, "/vods-cold/") {
+		prefix = "/vods-cold"
+	}
+	// nginx only accepts 401 and 403 from an auth request so hidden files are forbidden
+	if err := auth.CheckStaticFileAccess(c, prefix, uri.Path); err != nil {
+		if httpErr, ok := err.(*echo.HTTPError); ok && httpErr.Code == http.StatusNotFound {
+			return echo.NewHTTPError(http.StatusForbidden, httpErr.Message)
+		}
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -1,6 +1,7 @@
 package http_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -14,11 +15,14 @@ import (
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/zibbp/ganymede/ent"
+	entAuditEvent "github.com/zibbp/ganymede/ent/auditevent"
 	"github.com/zibbp/ganymede/ent/enttest"
+	entSession "github.com/zibbp/ganymede/ent/session"
 	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/database"
 	httpTransport "github.com/zibbp/ganymede/internal/transport/http"
 	"github.com/zibbp/ganymede/internal/utils"
+	"golang.org/x/crypto/bcrypt"
 )
 
 var (
@@ -178,3 +182,211 @@ func TestRefresh(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rec.Code)
 	}
 }
+
+// * TestSessions tests the Login, GetSessions, ChangePassword and Refresh functions.
+// Test logs a user in on two devices, changes the password on one of them and checks the other device is logged out.
+func TestSessions(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	t.Setenv("JWT_SECRET", "test")
+	t.Setenv("JWT_REFRESH_SECRET", "test")
+
+	h := &httpTransport.Handler{
+		Server: echo.New(),
+		Service: httpTransport.Services{
+			AuthService: auth.NewService(&database.Database{Client: client}),
+		},
+	}
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	password, err := bcrypt.GenerateFromPassword([]byte("test1234"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbUser, err := client.User.Create().SetUsername("test").SetPassword(string(password)).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Login on a TV and a laptop
+	refreshTokens := map[string]string{}
+	for _, device := range []string{"TV", "Laptop"} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", strings.NewReader(testUserJson))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("User-Agent", device)
+		rec := httptest.NewRecorder()
+		if !assert.NoError(t, h.Login(h.Server.NewContext(req, rec))) {
+			return
+		}
+		for _, cookie := range rec.Result().Cookies() {
+			if cookie.Name == "refresh-token" {
+				refreshTokens[device] = cookie.Value
+			}
+		}
+	}
+	laptop, err := client.Session.Query().Where(entSession.UserAgent("Laptop")).Only(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// List the sessions from the laptop
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/auth/sessions", nil)
+	rec := httptest.NewRecorder()
+	c := &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: dbUser}
+	c.Set("session.id", laptop.ID)
+
+	if assert.NoError(t, h.GetSessions(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response []map[string]interface{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		if assert.Len(t, response, 2) {
+			for _, session := range response {
+				assert.Equal(t, session["user_agent"] == "Laptop", session["current"])
+			}
+		}
+	}
+
+	// Change the password on the laptop
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/auth/change-password", strings.NewReader(`{"old_password":"test1234","new_password":"test12345","confirm_new_password":"test12345"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: dbUser}
+	c.Set("session.id", laptop.ID)
+
+	if assert.NoError(t, h.ChangePassword(c)) {
+		sessions, err := client.Session.Query().All(context.Background())
+		assert.NoError(t, err)
+		if assert.Len(t, sessions, 1) {
+			assert.Equal(t, laptop.ID, sessions[0].ID)
+		}
+	}
+
+	// The TV is logged out, the laptop is not
+	for device, code := range map[string]int{"TV": http.StatusUnauthorized, "Laptop": http.StatusOK} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/refresh", nil)
+		req.AddCookie(&http.Cookie{Name: "refresh-token", Value: refreshTokens[device]})
+		rec := httptest.NewRecorder()
+
+		err := h.Refresh(h.Server.NewContext(req, rec))
+		if code == http.StatusOK {
+			assert.NoError(t, err)
+			assert.Equal(t, code, rec.Code)
+		} else if assert.Error(t, err) {
+			assert.Equal(t, code, err.(*echo.HTTPError).Code)
+		}
+	}
+
+	// Sessions can't be revoked with an API token, which has no session
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/auth/sessions", nil)
+	rec = httptest.NewRecorder()
+	c = &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: dbUser}
+	err = h.DeleteOtherSessions(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	}
+	exists, err := client.Session.Query().Where(entSession.ID(laptop.ID)).Exist(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	// The refresh token was rotated, using it again revokes the session
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/auth/refresh", nil)
+	req.AddCookie(&http.Cookie{Name: "refresh-token", Value: refreshTokens["Laptop"]})
+	rec = httptest.NewRecorder()
+	err = h.Refresh(h.Server.NewContext(req, rec))
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusUnauthorized, err.(*echo.HTTPError).Code)
+	}
+	exists, err = client.Session.Query().Where(entSession.ID(laptop.ID)).Exist(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+// * TestApiTokens tests the CreateApiToken, GetApiTokens and DeleteApiToken functions.
+// Test creates an API token capped to the archiver role, authenticates with it and revokes it.
+func TestApiTokens(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpTransport.Handler{
+		Server: echo.New(),
+		Service: httpTransport.Services{
+			AuthService: auth.NewService(&database.Database{Client: client}),
+		},
+	}
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	dbUser, err := client.User.Create().SetUsername("test").SetRole(utils.EditorRole).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A token can't grant a higher role than the user has
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/tokens", strings.NewReader(`{"name": "bot", "role": "admin"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: dbUser}
+
+	err = h.CreateApiToken(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/auth/tokens", strings.NewReader(`{"name": "bot", "role": "archiver", "expires_in_days": 30}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: dbUser}
+
+	var token httpTransport.CreateApiTokenResponse
+	if assert.NoError(t, h.CreateApiToken(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		err := json.Unmarshal(rec.Body.Bytes(), &token)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(token.Token, token.Prefix))
+		assert.NotNil(t, token.ExpiresAt)
+	}
+
+	// The token authenticates the user and its role caps the role of the user
+	apiToken, err := auth.AuthenticateApiToken(context.Background(), client, token.Token)
+	if assert.NoError(t, err) {
+		assert.Equal(t, dbUser.ID, apiToken.Edges.User.ID)
+		assert.Equal(t, utils.ArchiverRole, auth.CapRole(dbUser.Role, apiToken.Role))
+	}
+	dbToken, err := client.ApiToken.Get(context.Background(), token.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, dbToken.LastUsedAt)
+	assert.NotEqual(t, token.Token, dbToken.TokenHash)
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/auth/tokens/"+token.ID.String(), nil)
+	rec = httptest.NewRecorder()
+	c = &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: dbUser}
+	c.SetPath("/api/v1/auth/tokens/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(token.ID.String())
+
+	if assert.NoError(t, h.DeleteApiToken(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	// Revoked tokens are rejected
+	_, err = auth.AuthenticateApiToken(context.Background(), client, token.Token)
+	assert.Error(t, err)
+
+	// Creating and revoking the token is audited with the user
+	for _, action := range []utils.AuditAction{utils.AuditApiTokenCreate, utils.AuditApiTokenRevoke} {
+		event, err := client.AuditEvent.Query().Where(entAuditEvent.ActionEQ(action), entAuditEvent.EntityID(token.ID.String())).Only(context.Background())
+		if assert.NoError(t, err, action) {
+			assert.Equal(t, dbUser.Username, event.Username)
+			assert.Equal(t, "bot", event.Details["name"])
+		}
+	}
+}
@@ -8,16 +8,22 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/channel"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type ChannelService interface {
 	CreateChannel(channelDto channel.Channel) (*ent.Channel, error)
-	GetChannels() ([]*ent.Channel, error)
+	GetChannels(predicates ...predicate.Channel) ([]*ent.Channel, error)
 	GetChannel(channelID uuid.UUID) (*ent.Channel, error)
 	GetChannelByName(channelName string) (*ent.Channel, error)
-	DeleteChannel(channelID uuid.UUID) error
-	UpdateChannel(channelID uuid.UUID, channelDto channel.Channel) (*ent.Channel, error)
+	DeleteChannel(c echo.Context, channelID uuid.UUID) error
+	TrashChannel(c echo.Context, channelID uuid.UUID, deleteFiles bool, force bool) error
+	RestoreChannel(c echo.Context, channelID uuid.UUID) error
+	GetTrashedChannels(c echo.Context) ([]*ent.Channel, error)
+	UpdateChannel(c echo.Context, channelID uuid.UUID, channelDto channel.Channel) (*ent.Channel, error)
 	UpdateChannelImage(c echo.Context, channelID uuid.UUID) error
 }
 
@@ -27,7 +33,23 @@ type CreateChannelRequest struct {
 	DisplayName   string `json:"display_name" validate:"required,min=2,max=50"`
 	ImagePath     string `json:"image_path" validate:"required,min=3"`
 	Retention     bool   `json:"retention"`
-	RetentionDays int64  `json:"retention_days"`
+	RetentionDays int64  `json:"retention_days" validate:"min=0"`
+	// RetentionTypeDays overrides RetentionDays for video types, 0 never prunes a type by age.
+	// The retention rules and cold storage rules are only used when updating a channel, omitted rules are unchanged.
+	RetentionTypeDays         map[utils.VodType]int64 `json:"retention_type_days"`
+	RetentionKeepLast         *int64                  `json:"retention_keep_last" validate:"omitempty,min=0"`
+	RetentionMaxSizeGB        *int64                  `json:"retention_max_size_gb" validate:"omitempty,min=0"`
+	RetentionProtectPlaylists *bool                   `json:"retention_protect_playlists"`
+	RetentionProtectWatched   *bool                   `json:"retention_protect_watched"`
+	RetentionAction           *utils.RetentionAction  `json:"retention_action" validate:"omitempty,oneof=delete delete_video delete_chat_video"`
+	// ColdStorage moves videos to the cold storage root once they are older than ColdStorageDays or not watched in ColdStorageUnwatchedDays
+	ColdStorage              *bool  `json:"cold_storage"`
+	ColdStorageDays          *int64 `json:"cold_storage_days" validate:"omitempty,min=0"`
+	ColdStorageUnwatchedDays *int64 `json:"cold_storage_unwatched_days" validate:"omitempty,min=0"`
+	// Restricted hides the channel from users without a channel permission, only admins can change it
+	Restricted *bool `json:"restricted"`
+	// ArchiveProfileID is only used when updating a channel
+	ArchiveProfileID *uuid.UUID `json:"archive_profile_id"`
 }
 
 // CreateChannel godoc
@@ -62,6 +84,7 @@ func (h *Handler) CreateChannel(c echo.Context) error {
 		Name:        ccr.Name,
 		DisplayName: ccr.DisplayName,
 		ImagePath:   ccr.ImagePath,
+		Restricted:  ccr.Restricted,
 	}
 
 	cha, err := h.Service.ChannelService.CreateChannel(ccDto)
@@ -84,7 +107,7 @@ func (h *Handler) CreateChannel(c echo.Context) error {
 //	@Failure		500	{object}	utils.ErrorResponse
 //	@Router			/channel [get]
 func (h *Handler) GetChannels(c echo.Context) error {
-	channels, err := h.Service.ChannelService.GetChannels()
+	channels, err := h.Service.ChannelService.GetChannels(auth.VisibleChannels(auth.Viewer(c))...)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -124,14 +147,18 @@ func (h *Handler) GetChannel(c echo.Context) error {
 // DeleteChannel godoc
 //
 //	@Summary		Delete a channel
-//	@Description	Delete a channel
+//	@Description	Move a channel and its vods to the trash, or delete it permanently
 //	@Tags			channel
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path	string	true	"Channel ID"
+//	@Param			id				path	string	true	"Channel ID"
+//	@Param			delete_files	query	string	false	"Move the files of the vods to the trash"
+//	@Param			force			query	string	false	"Move a channel with locked vods or vods in a playlist to the trash"
+//	@Param			permanent		query	string	false	"Delete the channel permanently"
 //	@Success		200
 //	@Failure		400	{object}	utils.ErrorResponse
 //	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
 //	@Failure		500	{object}	utils.ErrorResponse
 //	@Router			/channel/{id} [delete]
 //	@Security		ApiKeyCookieAuth
@@ -141,8 +168,59 @@ func (h *Handler) DeleteChannel(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
-	err = h.Service.ChannelService.DeleteChannel(cUUID)
+	if c.QueryParam("permanent") == "true" {
+		err = h.Service.ChannelService.DeleteChannel(c, cUUID)
+	} else {
+		err = h.Service.ChannelService.TrashChannel(c, cUUID, c.QueryParam("delete_files") == "true", c.QueryParam("force") == "true")
+	}
+	if err != nil {
+		switch err.Error() {
+		case "channel not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "channel has locked vods", "channel has vods in a playlist":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// GetTrashedChannels godoc
+//
+//	@Summary		Get trashed channels
+//	@Description	Get the channels in the trash, most recently deleted first
+//	@Tags			channel
+//	@Produce		json
+//	@Success		200	{object}	[]ent.Channel
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/channel/trash [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetTrashedChannels(c echo.Context) error {
+	channels, err := h.Service.ChannelService.GetTrashedChannels(c)
 	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, channels)
+}
+
+// RestoreChannel godoc
+//
+//	@Summary		Restore a channel
+//	@Description	Restore a channel from the trash with the vods that were moved to the trash with it
+//	@Tags			channel
+//	@Param			id	path	string	true	"Channel ID"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/channel/{id}/restore [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) RestoreChannel(c echo.Context) error {
+	cUUID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := h.Service.ChannelService.RestoreChannel(c, cUUID); err != nil {
 		if err.Error() == "channel not found" {
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
 		}
@@ -181,14 +259,32 @@ func (h *Handler) UpdateChannel(c echo.Context) error {
 	}
 
 	ccDto := channel.Channel{
-		Name:          ccr.Name,
-		DisplayName:   ccr.DisplayName,
-		ImagePath:     ccr.ImagePath,
-		Retention:     ccr.Retention,
-		RetentionDays: ccr.RetentionDays,
+		Name:                      ccr.Name,
+		DisplayName:               ccr.DisplayName,
+		ImagePath:                 ccr.ImagePath,
+		Retention:                 ccr.Retention,
+		RetentionDays:             ccr.RetentionDays,
+		RetentionTypeDays:         ccr.RetentionTypeDays,
+		RetentionKeepLast:         ccr.RetentionKeepLast,
+		RetentionMaxSizeGB:        ccr.RetentionMaxSizeGB,
+		RetentionProtectPlaylists: ccr.RetentionProtectPlaylists,
+		RetentionProtectWatched:   ccr.RetentionProtectWatched,
+		RetentionAction:           ccr.RetentionAction,
+		ArchiveProfileID:          ccr.ArchiveProfileID,
+		ColdStorage:               ccr.ColdStorage,
+		ColdStorageDays:           ccr.ColdStorageDays,
+		ColdStorageUnwatchedDays:  ccr.ColdStorageUnwatchedDays,
+		Restricted:                ccr.Restricted,
+	}
+
+	// users granted the edit access to the channel can update it but not change who can see it
+	if ccr.Restricted != nil {
+		if user := auth.Viewer(c); user == nil || user.Role != utils.AdminRole {
+			return echo.NewHTTPError(http.StatusForbidden, "only admins can restrict a channel")
+		}
 	}
 
-	cha, err := h.Service.ChannelService.UpdateChannel(cUUID, ccDto)
+	cha, err := h.Service.ChannelService.UpdateChannel(c, cUUID, ccDto)
 	if err != nil {
 		if err.Error() == "channel not found" {
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -68,6 +69,23 @@ func TestCreateChannel(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "test_channel", response["name"])
 	}
+
+	// A channel in the trash can't be created again
+	_, err := client.Channel.Update().Where(entChannel.Name("test_channel")).SetDeletedAt(time.Now()).Save(context.Background())
+	assert.NoError(t, err)
+	exists, trashed := h.Service.ChannelService.(*channel.Service).CheckChannelExists("test_channel")
+	assert.True(t, exists)
+	assert.True(t, trashed)
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/channels", strings.NewReader(channelJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+
+	err = h.CreateChannel(c)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.(*echo.HTTPError).Message, "channel is in the trash")
+	}
 }
 
 // * TestCreateChannelInvalid tests the CreateChannel function
@@ -239,9 +257,9 @@ func TestUpdateChannel(t *testing.T) {
 	}
 
 	// Create a channel
-	testChannel := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").SaveX(context.Background())
+	testChannel := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").SetRetentionKeepLast(5).SetRetentionProtectWatched(false).SetRetentionAction(utils.RetentionDeleteVideo).SetColdStorage(true).SaveX(context.Background())
 
-	// Updated channel
+	// Updated channel, the omitted retention and cold storage rules are unchanged
 	updatedJson := `{
 		"name": "updated",
 		"display_name": "updated",
@@ -270,6 +288,11 @@ func TestUpdateChannel(t *testing.T) {
 		assert.Equal(t, "updated", response["display_name"])
 		assert.Equal(t, "/vods/updated/updated.jpg", response["image_path"])
 	}
+	updated := client.Channel.GetX(context.Background(), testChannel.ID)
+	assert.Equal(t, int64(5), updated.RetentionKeepLast)
+	assert.False(t, updated.RetentionProtectWatched)
+	assert.Equal(t, utils.RetentionDeleteVideo, updated.RetentionAction)
+	assert.True(t, updated.ColdStorage)
 }
 
 // * TestGetChannelByName tests the GetChannelByName function
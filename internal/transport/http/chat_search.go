@@ -0,0 +1,138 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/internal/vod"
+)
+
+// parseChatSearchParams parses the query parameters of the chat search endpoints.
+func parseChatSearchParams(c echo.Context) (vod.ChatSearchParams, error) {
+	params := vod.ChatSearchParams{
+		Query:     c.QueryParam("q"),
+		Commenter: c.QueryParam("commenter"),
+		Badge:     c.QueryParam("badge"),
+		Limit:     20,
+	}
+	if params.Query == "" && params.Commenter == "" {
+		return params, fmt.Errorf("q or commenter is required")
+	}
+	if c.QueryParam("channel_id") != "" {
+		channelID, err := uuid.Parse(c.QueryParam("channel_id"))
+		if err != nil {
+			return params, fmt.Errorf("invalid channel_id: %w", err)
+		}
+		params.ChannelID = channelID
+	}
+	if c.QueryParam("from") != "" {
+		from, err := time.Parse(time.RFC3339, c.QueryParam("from"))
+		if err != nil {
+			return params, fmt.Errorf("invalid from: %w", err)
+		}
+		params.From = &from
+	}
+	if c.QueryParam("to") != "" {
+		to, err := time.Parse(time.RFC3339, c.QueryParam("to"))
+		if err != nil {
+			return params, fmt.Errorf("invalid to: %w", err)
+		}
+		params.To = &to
+	}
+	if c.QueryParam("limit") != "" {
+		limit, err := strconv.Atoi(c.QueryParam("limit"))
+		if err != nil || limit < 1 || limit > 100 {
+			return params, fmt.Errorf("invalid limit: must be between 1 and 100")
+		}
+		params.Limit = limit
+	}
+	if c.QueryParam("offset") != "" {
+		offset, err := strconv.Atoi(c.QueryParam("offset"))
+		if err != nil || offset < 0 {
+			return params, fmt.Errorf("invalid offset")
+		}
+		params.Offset = offset
+	}
+	return params, nil
+}
+
+// SearchVodChat godoc
+//
+//	@Summary		Search vod chat
+//	@Description	Search the chat messages of a vod by message and commenter
+//	@Tags			vods
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string	true	"Vod ID"
+//	@Param			q			query		string	false	"Search query"
+//	@Param			commenter	query		string	false	"Commenter name"
+//	@Param			badge		query		string	false	"Badge ID (e.g. moderator)"
+//	@Param			limit		query		integer	false	"Limit"		default(20)
+//	@Param			offset		query		integer	false	"Offset"	default(0)
+//	@Success		200			{object}	vod.ChatSearchPagination
+//	@Failure		400			{object}	utils.ErrorResponse
+//	@Failure		503			{object}	utils.ErrorResponse
+//	@Failure		500			{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/chat/search [get]
+func (h *Handler) SearchVodChat(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	params, err := parseChatSearchParams(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	v, err := h.Service.VodService.SearchVodChat(c, vID, params)
+	if err != nil {
+		if err.Error() == "vod not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		if err.Error() == "chat is being imported" {
+			return chatImportingError(c, err)
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, v)
+}
+
+// SearchChat godoc
+//
+//	@Summary		Search chat
+//	@Description	Search the chat messages of all vods by message and commenter
+//	@Tags			chat
+//	@Accept			json
+//	@Produce		json
+//	@Param			q			query		string	false	"Search query"
+//	@Param			commenter	query		string	false	"Commenter name"
+//	@Param			badge		query		string	false	"Badge ID (e.g. moderator)"
+//	@Param			channel_id	query		string	false	"Channel ID"
+//	@Param			from		query		string	false	"Streamed after (RFC3339)"
+//	@Param			to			query		string	false	"Streamed before (RFC3339)"
+//	@Param			limit		query		integer	false	"Limit"		default(20)
+//	@Param			offset		query		integer	false	"Offset"	default(0)
+//	@Success		200			{object}	vod.ChatSearchPagination
+//	@Failure		400			{object}	utils.ErrorResponse
+//	@Failure		500			{object}	utils.ErrorResponse
+//	@Router			/chat/search [get]
+func (h *Handler) SearchChat(c echo.Context) error {
+	params, err := parseChatSearchParams(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	v, err := h.Service.VodService.SearchChat(c, params)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, v)
+}
+
+// chatImportingError asks the client to retry while the chat of a video is imported in the background.
+func chatImportingError(c echo.Context, err error) error {
+	c.Response().Header().Set("Retry-After", "10")
+	return echo.NewHTTPError(http.StatusServiceUnavailable, err.Error())
+}
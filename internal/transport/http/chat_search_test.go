@@ -0,0 +1,83 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/enttest"
+	"github.com/zibbp/ganymede/internal/database"
+	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
+	"github.com/zibbp/ganymede/internal/vod"
+)
+
+// * TestSearchChat tests the SearchChat function
+// Searches the chat messages of all vods
+func TestSearchChat(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			VodService: vod.NewService(&database.Database{Client: client}),
+		},
+	}
+
+	// Create a channel
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a vod
+	dbVod, err := client.Vod.Create().SetChannel(dbChannel).SetExtID("123456789").SetPlatform("twitch").SetType("archive").SetTitle("Test Vod").SetWebThumbnailPath("/vods/test_channel/123456789/123456789-web_thumbnail.jpg").SetVideoPath("/vods/test_channel/123456789/123456789-video.mp4").SetStreamedAt(time.Now()).SetChatImportedAt(time.Now()).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a vod whose chat is not imported yet
+	_, err = client.Vod.Create().SetChannel(dbChannel).SetExtID("987654321").SetPlatform("twitch").SetType("archive").SetTitle("Test Vod").SetWebThumbnailPath("/vods/test_channel/987654321/987654321-web_thumbnail.jpg").SetVideoPath("/vods/test_channel/987654321/987654321-video.mp4").SetChatPath("/vods/test_channel/987654321/987654321-chat.json").SetStreamedAt(time.Now()).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create chat messages
+	_, err = client.ChatMessage.Create().SetVod(dbVod).SetContentOffsetSeconds(42).SetCommenterName("mod_user").SetCommenterBadges([]string{"moderator"}).SetBody("hello world").SetData(`{"_id": "a", "message": {"body": "hello world"}}`).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.ChatMessage.Create().SetVod(dbVod).SetContentOffsetSeconds(60).SetCommenterName("user").SetCommenterBadges([]string{"subscriber"}).SetBody("Hello there").SetData(`{"_id": "b", "message": {"body": "Hello there"}}`).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/chat/search?q=hello&badge=moderator", nil)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.SearchChat(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response vod.ChatSearchPagination
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, response.TotalCount)
+		assert.Equal(t, 1, response.NotImported)
+		if assert.Len(t, response.Data, 1) {
+			assert.Equal(t, dbVod.ID, response.Data[0].VodID)
+			assert.Equal(t, float64(42), response.Data[0].ContentOffsetSeconds)
+			assert.Equal(t, "hello world", response.Data[0].Comment.Message.Body)
+		}
+	}
+}
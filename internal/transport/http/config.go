@@ -1,11 +1,13 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/notification"
 )
 
 type ConfigService interface {
@@ -18,7 +20,8 @@ type ConfigService interface {
 }
 
 type UpdateConfigRequest struct {
-	RegistrationEnabled bool `json:"registration_enabled"`
+	RegistrationEnabled bool  `json:"registration_enabled"`
+	RequireLogin        *bool `json:"require_login"`
 	Parameters          struct {
 		TwitchToken    string `json:"twitch_token"`
 		VideoConvert   string `json:"video_convert" validate:"required"`
@@ -29,26 +32,31 @@ type UpdateConfigRequest struct {
 		SaveAsHls bool `json:"save_as_hls"`
 	} `json:"archive"`
 	Livestream struct {
-		Proxies         []config.ProxyListItem `json:"proxies"`
-		ProxyEnabled    bool                   `json:"proxy_enabled"`
-		ProxyParameters string                 `json:"proxy_parameters"`
-		ProxyWhitelist  []string               `json:"proxy_whitelist"`
+		Proxies               []config.ProxyListItem `json:"proxies"`
+		ProxyEnabled          bool                   `json:"proxy_enabled"`
+		ProxyParameters       string                 `json:"proxy_parameters"`
+		ProxyWhitelist        []string               `json:"proxy_whitelist"`
+		ReconnectAttempts     *int                   `json:"reconnect_attempts" validate:"omitempty,min=0"`
+		ReconnectDelaySeconds *int                   `json:"reconnect_delay_seconds" validate:"omitempty,min=0"`
 	} `json:"livestream"`
 }
 
 type UpdateNotificationRequest struct {
-	VideoSuccessWebhookUrl string `json:"video_success_webhook_url"`
-	VideoSuccessTemplate   string `json:"video_success_template"`
-	VideoSuccessEnabled    bool   `json:"video_success_enabled"`
-	LiveSuccessWebhookUrl  string `json:"live_success_webhook_url"`
-	LiveSuccessTemplate    string `json:"live_success_template"`
-	LiveSuccessEnabled     bool   `json:"live_success_enabled"`
-	ErrorWebhookUrl        string `json:"error_webhook_url"`
-	ErrorTemplate          string `json:"error_template"`
-	ErrorEnabled           bool   `json:"error_enabled"`
-	IsLiveWebhookUrl       string `json:"is_live_webhook_url"`
-	IsLiveTemplate         string `json:"is_live_template"`
-	IsLiveEnabled          bool   `json:"is_live_enabled"`
+	VideoSuccessWebhookUrl string                `json:"video_success_webhook_url"`
+	VideoSuccessTemplate   string                `json:"video_success_template"`
+	VideoSuccessEnabled    bool                  `json:"video_success_enabled"`
+	LiveSuccessWebhookUrl  string                `json:"live_success_webhook_url"`
+	LiveSuccessTemplate    string                `json:"live_success_template"`
+	LiveSuccessEnabled     bool                  `json:"live_success_enabled"`
+	ErrorWebhookUrl        string                `json:"error_webhook_url"`
+	ErrorTemplate          string                `json:"error_template"`
+	ErrorEnabled           bool                  `json:"error_enabled"`
+	IsLiveWebhookUrl       string                `json:"is_live_webhook_url"`
+	IsLiveTemplate         string                `json:"is_live_template"`
+	IsLiveEnabled          bool                  `json:"is_live_enabled"`
+	Targets                []notification.Target `json:"targets"`
+	RetryAttempts          int                   `json:"retry_attempts" validate:"min=0,max=20"`
+	RetryBackoffSeconds    int                   `json:"retry_backoff_seconds" validate:"min=0"`
 }
 
 type UpdateStorageTemplateRequest struct {
@@ -98,6 +106,7 @@ func (h *Handler) UpdateConfig(c echo.Context) error {
 	}
 	cDto := config.Conf{
 		RegistrationEnabled: conf.RegistrationEnabled,
+		RequireLogin:        conf.RequireLogin,
 		Archive: struct {
 			SaveAsHls bool `json:"save_as_hls"`
 		}(conf.Archive),
@@ -108,10 +117,12 @@ func (h *Handler) UpdateConfig(c echo.Context) error {
 			StreamlinkLive string `json:"streamlink_live"`
 		}(conf.Parameters),
 		Livestream: struct {
-			Proxies         []config.ProxyListItem `json:"proxies"`
-			ProxyEnabled    bool                   `json:"proxy_enabled"`
-			ProxyParameters string                 `json:"proxy_parameters"`
-			ProxyWhitelist  []string               `json:"proxy_whitelist"`
+			Proxies               []config.ProxyListItem `json:"proxies"`
+			ProxyEnabled          bool                   `json:"proxy_enabled"`
+			ProxyParameters       string                 `json:"proxy_parameters"`
+			ProxyWhitelist        []string               `json:"proxy_whitelist"`
+			ReconnectAttempts     *int                   `json:"reconnect_attempts"`
+			ReconnectDelaySeconds *int                   `json:"reconnect_delay_seconds"`
 		}(conf.Livestream),
 	}
 	if err := h.Service.ConfigService.UpdateConfig(c, &cDto); err != nil {
@@ -157,6 +168,14 @@ func (h *Handler) UpdateNotificationConfig(c echo.Context) error {
 	if err := c.Bind(conf); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
+	if err := c.Validate(conf); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	for _, target := range conf.Targets {
+		if err := target.Validate(); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid target %s: %v", target.Name, err))
+		}
+	}
 	cDto := config.Notification{
 		VideoSuccessWebhookUrl: conf.VideoSuccessWebhookUrl,
 		VideoSuccessTemplate:   conf.VideoSuccessTemplate,
@@ -170,6 +189,9 @@ func (h *Handler) UpdateNotificationConfig(c echo.Context) error {
 		IsLiveWebhookUrl:       conf.IsLiveWebhookUrl,
 		IsLiveTemplate:         conf.IsLiveTemplate,
 		IsLiveEnabled:          conf.IsLiveEnabled,
+		Targets:                conf.Targets,
+		RetryAttempts:          conf.RetryAttempts,
+		RetryBackoffSeconds:    conf.RetryBackoffSeconds,
 	}
 
 	if err := h.Service.ConfigService.UpdateNotificationConfig(c, &cDto); err != nil {
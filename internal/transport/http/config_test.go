@@ -0,0 +1,71 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/enttest"
+	"github.com/zibbp/ganymede/internal/config"
+	"github.com/zibbp/ganymede/internal/database"
+	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// * TestUpdateConfig tests the UpdateConfig function
+// Saves the config without the settings the client did not send and checks they are kept
+func TestUpdateConfig(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			ConfigService: config.NewService(&database.Database{Client: client}),
+		},
+	}
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	viper.SetConfigFile(filepath.Join(t.TempDir(), "config.json"))
+	viper.Set("livestream.proxies", []interface{}{})
+	viper.Set("require_login", true)
+	viper.Set("livestream.reconnect_attempts", 3)
+	viper.Set("livestream.reconnect_delay_seconds", 30)
+	t.Cleanup(func() {
+		viper.Set("require_login", false)
+		viper.Set("livestream.reconnect_attempts", 10)
+		viper.Set("livestream.reconnect_delay_seconds", 10)
+	})
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/config", strings.NewReader(`{"registration_enabled": true, "parameters": {"video_convert": "-c:v copy", "chat_render": "-h 1440"}}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.UpdateConfig(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.True(t, viper.GetBool("registration_enabled"))
+		assert.True(t, viper.GetBool("require_login"))
+	}
+
+	// The login requirement is only turned off when it is sent, a reconnect setting can be set to zero
+	req = httptest.NewRequest(http.MethodPut, "/api/v1/config", strings.NewReader(`{"require_login": false, "parameters": {"video_convert": "-c:v copy", "chat_render": "-h 1440"}, "livestream": {"reconnect_attempts": 0}}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.UpdateConfig(c)) {
+		assert.False(t, viper.GetBool("require_login"))
+	}
+}
@@ -21,22 +21,26 @@ import (
 )
 
 type Services struct {
-	AuthService      AuthService
-	ChannelService   ChannelService
-	VodService       VodService
-	QueueService     QueueService
-	TwitchService    TwitchService
-	ArchiveService   ArchiveService
-	AdminService     AdminService
-	UserService      UserService
-	ConfigService    ConfigService
-	LiveService      LiveService
-	SchedulerService SchedulerService
-	PlaybackService  PlaybackService
-	MetricsService   MetricsService
-	PlaylistService  PlaylistService
-	TaskService      TaskService
-	ChapterService   ChapterService
+	AuthService           AuthService
+	ChannelService        ChannelService
+	VodService            VodService
+	QueueService          QueueService
+	TwitchService         TwitchService
+	ArchiveService        ArchiveService
+	AdminService          AdminService
+	UserService           UserService
+	ConfigService         ConfigService
+	LiveService           LiveService
+	SchedulerService      SchedulerService
+	PlaybackService       PlaybackService
+	MetricsService        MetricsService
+	PlaylistService       PlaylistService
+	TaskService           TaskService
+	ChapterService        ChapterService
+	ArchiveProfileService ArchiveProfileService
+	NotificationService   NotificationService
+	PermissionService     PermissionService
+	AuditService          AuditService
 }
 
 type Handler struct {
@@ -44,28 +48,32 @@ type Handler struct {
 	Service Services
 }
 
-func NewHandler(authService AuthService, channelService ChannelService, vodService VodService, queueService QueueService, twitchService TwitchService, archiveService ArchiveService, adminService AdminService, userService UserService, configService ConfigService, liveService LiveService, schedulerService SchedulerService, playbackService PlaybackService, metricsService MetricsService, playlistService PlaylistService, taskService TaskService, chapterService ChapterService) *Handler {
+func NewHandler(authService AuthService, channelService ChannelService, vodService VodService, queueService QueueService, twitchService TwitchService, archiveService ArchiveService, adminService AdminService, userService UserService, configService ConfigService, liveService LiveService, schedulerService SchedulerService, playbackService PlaybackService, metricsService MetricsService, playlistService PlaylistService, taskService TaskService, chapterService ChapterService, archiveProfileService ArchiveProfileService, notificationService NotificationService, permissionService PermissionService, auditService AuditService) *Handler {
 	log.Debug().Msg("creating new handler")
 
 	h := &Handler{
 		Server: echo.New(),
 		Service: Services{
-			AuthService:      authService,
-			ChannelService:   channelService,
-			VodService:       vodService,
-			QueueService:     queueService,
-			TwitchService:    twitchService,
-			ArchiveService:   archiveService,
-			AdminService:     adminService,
-			UserService:      userService,
-			ConfigService:    configService,
-			LiveService:      liveService,
-			SchedulerService: schedulerService,
-			PlaybackService:  playbackService,
-			MetricsService:   metricsService,
-			PlaylistService:  playlistService,
-			TaskService:      taskService,
-			ChapterService:   chapterService,
+			AuthService:           authService,
+			ChannelService:        channelService,
+			VodService:            vodService,
+			QueueService:          queueService,
+			TwitchService:         twitchService,
+			ArchiveService:        archiveService,
+			AdminService:          adminService,
+			UserService:           userService,
+			ConfigService:         configService,
+			LiveService:           liveService,
+			SchedulerService:      schedulerService,
+			PlaybackService:       playbackService,
+			MetricsService:        metricsService,
+			PlaylistService:       playlistService,
+			TaskService:           taskService,
+			ChapterService:        chapterService,
+			ArchiveProfileService: archiveProfileService,
+			NotificationService:   notificationService,
+			PermissionService:     permissionService,
+			AuditService:          auditService,
 		},
 	}
 
@@ -121,8 +129,9 @@ func (h *Handler) mapRoutes() {
 		return nil
 	})
 
-	// Static files
-	h.Server.Static("/static/vods", "/vods")
+	// Static files, limited to the channels the user can see
+	h.Server.Group("/static/vods", auth.OptionalUserMiddleware, auth.StaticFileAccessMiddleware("/static/vods")).Static("", "/vods")
+	h.Server.Group("/static/vods-cold", auth.OptionalUserMiddleware, auth.StaticFileAccessMiddleware("/static/vods-cold")).Static("", viper.GetString("storage.cold_root"))
 
 	// Swagger
 	h.Server.GET("/swagger/*", echoSwagger.WrapHandler)
@@ -151,7 +160,14 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	authGroup.POST("/login", h.Login)
 	authGroup.POST("/refresh", h.Refresh)
 	authGroup.GET("/me", h.Me, auth.GuardMiddleware, auth.GetUserMiddleware)
+	authGroup.GET("/file-access", h.CheckFileAccess, auth.OptionalUserMiddleware)
 	authGroup.POST("/change-password", h.ChangePassword, auth.GuardMiddleware, auth.GetUserMiddleware)
+	authGroup.GET("/tokens", h.GetApiTokens, auth.GuardMiddleware, auth.GetUserMiddleware)
+	authGroup.POST("/tokens", h.CreateApiToken, auth.GuardMiddleware, auth.GetUserMiddleware)
+	authGroup.DELETE("/tokens/:id", h.DeleteApiToken, auth.GuardMiddleware, auth.GetUserMiddleware)
+	authGroup.GET("/sessions", h.GetSessions, auth.GuardMiddleware, auth.GetUserMiddleware)
+	authGroup.DELETE("/sessions", h.DeleteOtherSessions, auth.GuardMiddleware, auth.GetUserMiddleware)
+	authGroup.DELETE("/sessions/:id", h.DeleteSession, auth.GuardMiddleware, auth.GetUserMiddleware)
 	authGroup.GET("/oauth/login", h.OAuthLogin)
 	authGroup.GET("/oauth/callback", h.OAuthCallback)
 	authGroup.GET("/oauth/refresh", h.OAuthTokenRefresh)
@@ -160,57 +176,95 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	// Channel
 	channelGroup := e.Group("/channel")
 	channelGroup.POST("", h.CreateChannel, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
-	channelGroup.GET("", h.GetChannels)
-	channelGroup.GET("/:id", h.GetChannel)
-	channelGroup.GET("/name/:name", h.GetChannelByName)
-	channelGroup.PUT("/:id", h.UpdateChannel, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
+	channelGroup.GET("", h.GetChannels, auth.OptionalUserMiddleware)
+	channelGroup.GET("/:id", h.GetChannel, auth.OptionalUserMiddleware, auth.ChannelAccessMiddleware("id", utils.ChannelAccessView))
+	channelGroup.GET("/name/:name", h.GetChannelByName, auth.OptionalUserMiddleware, auth.ChannelNameAccessMiddleware("name", utils.ChannelAccessView))
+	channelGroup.PUT("/:id", h.UpdateChannel, auth.GuardMiddleware, auth.GetUserMiddleware, auth.ChannelAccessMiddleware("id", utils.ChannelAccessEdit))
 	channelGroup.DELETE("/:id", h.DeleteChannel, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	channelGroup.GET("/trash", h.GetTrashedChannels, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	channelGroup.POST("/:id/restore", h.RestoreChannel, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
 	channelGroup.POST("/update-image", h.UpdateChannelImage, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
+	channelGroup.GET("/:id/permission", h.GetChannelPermissions, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	channelGroup.POST("/:id/permission", h.CreateChannelPermission, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	channelGroup.DELETE("/:id/permission/:permissionId", h.DeleteChannelPermission, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+
+	// Group
+	groupGroup := e.Group("/group")
+	groupGroup.GET("", h.GetGroups, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	groupGroup.GET("/:id", h.GetGroup, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	groupGroup.POST("", h.CreateGroup, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	groupGroup.PUT("/:id", h.UpdateGroup, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	groupGroup.DELETE("/:id", h.DeleteGroup, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
 
 	// VOD
 	vodGroup := e.Group("/vod")
 	vodGroup.POST("", h.CreateVod, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
-	vodGroup.GET("", h.GetVods)
-	vodGroup.GET("/:id", h.GetVod)
-	vodGroup.GET("/search", h.SearchVods)
-	vodGroup.PUT("/:id", h.UpdateVod, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
+	vodGroup.GET("", h.GetVods, auth.OptionalUserMiddleware)
+	vodGroup.GET("/:id", h.GetVod, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessView))
+	vodGroup.GET("/search", h.SearchVods, auth.OptionalUserMiddleware)
+	vodGroup.GET("/health", h.GetHealthReport, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	vodGroup.PUT("/:id", h.UpdateVod, auth.GuardMiddleware, auth.GetUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessEdit))
 	vodGroup.DELETE("/:id", h.DeleteVod, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
-	vodGroup.GET("/:id/playlist", h.GetVodPlaylists)
-	vodGroup.GET("/paginate", h.GetVodsPagination)
-	vodGroup.GET("/:id/chat", h.GetVodChatComments)
-	vodGroup.GET("/:id/chat/seek", h.GetNumberOfVodChatCommentsFromTime)
-	vodGroup.GET("/:id/chat/userid", h.GetUserIdFromChat)
-	vodGroup.GET("/:id/chat/emotes", h.GetVodChatEmotes)
-	vodGroup.GET("/:id/chat/badges", h.GetVodChatBadges)
-	vodGroup.POST("/:id/lock", h.LockVod, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
+	vodGroup.GET("/trash", h.GetTrashedVods, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	vodGroup.POST("/:id/restore", h.RestoreVod, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	vodGroup.GET("/:id/playlist", h.GetVodPlaylists, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessView))
+	vodGroup.GET("/paginate", h.GetVodsPagination, auth.OptionalUserMiddleware)
+	vodGroup.GET("/:id/chat", h.GetVodChatComments, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessView))
+	vodGroup.GET("/:id/chat/seek", h.GetNumberOfVodChatCommentsFromTime, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessView))
+	vodGroup.GET("/:id/chat/userid", h.GetUserIdFromChat, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessView))
+	vodGroup.GET("/:id/chat/emotes", h.GetVodChatEmotes, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessView))
+	vodGroup.GET("/:id/chat/badges", h.GetVodChatBadges, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessView))
+	vodGroup.GET("/:id/chat/search", h.SearchVodChat, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessView))
+	vodGroup.POST("/:id/lock", h.LockVod, auth.GuardMiddleware, auth.GetUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessEdit))
+	vodGroup.POST("/:id/verify", h.VerifyVod, auth.GuardMiddleware, auth.GetUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessEdit))
+	vodGroup.GET("/:id/storage/*", h.GetVodFile, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("id", utils.ChannelAccessView))
+
+	// Chat
+	chatGroup := e.Group("/chat")
+	chatGroup.GET("/search", h.SearchChat, auth.OptionalUserMiddleware)
 
 	// Queue
 	queueGroup := e.Group("/queue")
 	queueGroup.POST("", h.CreateQueueItem, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
 	queueGroup.GET("", h.GetQueueItems, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole))
-	queueGroup.GET("/:id", h.GetQueueItem, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole))
+	queueGroup.GET("/:id", h.GetQueueItem, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole), auth.QueueAccessMiddleware("id", utils.ChannelAccessArchive))
 	queueGroup.PUT("/:id", h.UpdateQueueItem, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
 	queueGroup.DELETE("/:id", h.DeleteQueueItem, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
-	queueGroup.GET("/:id/tail", h.ReadQueueLogFile, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole))
+	queueGroup.GET("/:id/tail", h.ReadQueueLogFile, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole), auth.QueueAccessMiddleware("id", utils.ChannelAccessArchive))
+	queueGroup.GET("/:id/progress", h.StreamQueueItemProgress, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole), auth.QueueAccessMiddleware("id", utils.ChannelAccessArchive))
 	queueGroup.POST("/:id/stop", h.StopQueueItem, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	queueGroup.POST("/:id/cancel", h.CancelQueueItem, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	queueGroup.PUT("/:id/priority", h.UpdateQueueItemPriority, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
+	queueGroup.POST("/:id/bump", h.BumpQueueItem, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
+	queueGroup.POST("/:id/pause", h.PauseQueueItem, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
+	queueGroup.POST("/:id/resume", h.ResumeQueueItem, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
 
 	// Twitch
 	twitchGroup := e.Group("/twitch")
-	twitchGroup.GET("/channel", h.GetTwitchUser)
-	twitchGroup.GET("/vod", h.GetTwitchVod)
-	twitchGroup.GET("/gql/video", h.GQLGetTwitchVideo)
-	twitchGroup.GET("/categories", h.GetTwitchCategories)
+	twitchGroup.GET("/channel", h.GetTwitchUser, auth.OptionalUserMiddleware)
+	twitchGroup.GET("/vod", h.GetTwitchVod, auth.OptionalUserMiddleware)
+	twitchGroup.GET("/gql/video", h.GQLGetTwitchVideo, auth.OptionalUserMiddleware)
+	twitchGroup.GET("/categories", h.GetTwitchCategories, auth.OptionalUserMiddleware)
 
 	// Archive
 	archiveGroup := e.Group("/archive")
 	archiveGroup.POST("/channel", h.ArchiveTwitchChannel, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole))
-	archiveGroup.POST("/vod", h.ArchiveTwitchVod, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole))
+	archiveGroup.POST("/vod", h.ArchiveTwitchVod, auth.GuardMiddleware, auth.GetUserMiddleware)
+	archiveGroup.POST("/clip", h.ArchiveTwitchClip, auth.GuardMiddleware, auth.GetUserMiddleware)
+	archiveGroup.POST("/youtube/channel", h.ArchiveYoutubeChannel, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole))
+	archiveGroup.POST("/youtube/vod", h.ArchiveYoutubeVideo, auth.GuardMiddleware, auth.GetUserMiddleware)
 	archiveGroup.POST("/convert-twitch-live-chat", h.ConvertTwitchChat, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	archiveGroup.GET("/profile", h.GetArchiveProfiles, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole))
+	archiveGroup.GET("/profile/:id", h.GetArchiveProfile, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole))
+	archiveGroup.POST("/profile", h.CreateArchiveProfile, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
+	archiveGroup.PUT("/profile/:id", h.UpdateArchiveProfile, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
+	archiveGroup.DELETE("/profile/:id", h.DeleteArchiveProfile, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
 
 	// Admin
 	adminGroup := e.Group("/admin")
 	adminGroup.GET("/stats", h.GetStats, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
 	adminGroup.GET("/info", h.GetInfo, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	adminGroup.GET("/audit", h.GetAuditEvents, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
 
 	// User
 	userGroup := e.Group("/user")
@@ -237,7 +291,7 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	liveGroup.DELETE("/:id", h.DeleteLiveWatchedChannel, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
 	liveGroup.GET("/check", h.Check, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
 	liveGroup.GET("/vod", h.CheckVodWatchedChannels, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
-	liveGroup.POST("/archive", h.ArchiveLiveChannel, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.ArchiverRole))
+	liveGroup.POST("/archive", h.ArchiveLiveChannel, auth.GuardMiddleware, auth.GetUserMiddleware)
 
 	// Playback
 	playbackGroup := e.Group("/playback")
@@ -251,8 +305,8 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 
 	// Playlist
 	playlistGroup := e.Group("/playlist")
-	playlistGroup.GET("/:id", h.GetPlaylist)
-	playlistGroup.GET("", h.GetPlaylists)
+	playlistGroup.GET("/:id", h.GetPlaylist, auth.OptionalUserMiddleware)
+	playlistGroup.GET("", h.GetPlaylists, auth.OptionalUserMiddleware)
 	playlistGroup.POST("", h.CreatePlaylist, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
 	playlistGroup.POST("/:id", h.AddVodToPlaylist, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
 	playlistGroup.DELETE("/:id/vod", h.DeleteVodFromPlaylist, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.EditorRole))
@@ -266,10 +320,24 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 	// Task
 	taskGroup := e.Group("/task")
 	taskGroup.POST("/start", h.StartTask, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	taskGroup.POST("/import", h.StartImport, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	taskGroup.GET("/import", h.GetImportReport, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	taskGroup.POST("/storage-migration", h.StartStorageMigration, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	taskGroup.POST("/storage-migration/rollback", h.RollbackStorageMigration, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	taskGroup.GET("/storage-migration", h.GetStorageMigration, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	taskGroup.GET("/prune-videos/preview", h.PreviewPrune, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
 
 	// Notification
 	notificationGroup := e.Group("/notification")
 	notificationGroup.POST("/test", h.TestNotification, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	notificationGroup.GET("/delivery", h.GetNotificationDeliveries, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	notificationGroup.GET("/delivery/:id", h.GetNotificationDelivery, auth.GuardMiddleware, auth.GetUserMiddleware, auth.UserRoleMiddleware(utils.AdminRole))
+	notificationGroup.GET("/subscription", h.GetNotificationSubscriptions, auth.GuardMiddleware, auth.GetUserMiddleware)
+	notificationGroup.POST("/subscription", h.CreateNotificationSubscription, auth.GuardMiddleware, auth.GetUserMiddleware)
+	notificationGroup.PUT("/subscription/:id", h.UpdateNotificationSubscription, auth.GuardMiddleware, auth.GetUserMiddleware)
+	notificationGroup.DELETE("/subscription/:id", h.DeleteNotificationSubscription, auth.GuardMiddleware, auth.GetUserMiddleware)
+	notificationGroup.PUT("/webhook", h.UpdateNotificationWebhook, auth.GuardMiddleware, auth.GetUserMiddleware)
+	notificationGroup.POST("/preview", h.PreviewNotification, auth.GuardMiddleware, auth.GetUserMiddleware, auth.VodBodyAccessMiddleware("vod_id", utils.ChannelAccessView))
 
 	// Workflows
 	workflowGroup := e.Group("/workflows")
@@ -283,8 +351,8 @@ func groupV1Routes(e *echo.Group, h *Handler) {
 
 	// Chapter
 	chapterGroup := e.Group("/chapter")
-	chapterGroup.GET("/video/:videoId", h.GetVideoChapters)
-	chapterGroup.GET("/video/:videoId/webvtt", h.GetWebVTTChapters)
+	chapterGroup.GET("/video/:videoId", h.GetVideoChapters, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("videoId", utils.ChannelAccessView))
+	chapterGroup.GET("/video/:videoId/webvtt", h.GetWebVTTChapters, auth.OptionalUserMiddleware, auth.VodAccessMiddleware("videoId", utils.ChannelAccessView))
 }
 
 func (h *Handler) Serve() error {
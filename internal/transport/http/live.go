@@ -6,7 +6,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/live"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
 type LiveService interface {
@@ -33,6 +35,14 @@ type AddWatchedChannelRequest struct {
 	Categories         []string            `json:"categories"`
 	MaxAge             int64               `json:"max_age"`
 	Regex              []AddLiveTitleRegex `json:"regex"`
+	DownloadClips      bool                `json:"download_clips" validate:"boolean"`
+	ClipsMinViews      int                 `json:"clips_min_views" validate:"min=0"`
+	ClipsMaxAge        int64               `json:"clips_max_age" validate:"min=0"`
+	// VodReplacement defaults to none when not set
+	VodReplacement utils.LiveVodReplacement `json:"vod_replacement" validate:"omitempty,oneof=none replace keep_both"`
+	// VodReplacementMinDifference defaults to 60 seconds when not set
+	VodReplacementMinDifference *int       `json:"vod_replacement_min_difference" validate:"omitempty,min=0"`
+	ArchiveProfileID            *uuid.UUID `json:"archive_profile_id"`
 }
 
 type AddLiveTitleRegex struct {
@@ -54,6 +64,14 @@ type AddMultipleWatchedChannelRequest struct {
 	DownloadSubOnly    bool     `json:"download_sub_only"`
 	Categories         []string `json:"categories"`
 	MaxAge             int64    `json:"max_age"`
+	DownloadClips      bool     `json:"download_clips"`
+	ClipsMinViews      int      `json:"clips_min_views" validate:"min=0"`
+	ClipsMaxAge        int64    `json:"clips_max_age" validate:"min=0"`
+	// VodReplacement defaults to none when not set
+	VodReplacement utils.LiveVodReplacement `json:"vod_replacement" validate:"omitempty,oneof=none replace keep_both"`
+	// VodReplacementMinDifference defaults to 60 seconds when not set
+	VodReplacementMinDifference *int       `json:"vod_replacement_min_difference" validate:"omitempty,min=0"`
+	ArchiveProfileID            *uuid.UUID `json:"archive_profile_id"`
 }
 
 type UpdateWatchedChannelRequest struct {
@@ -69,6 +87,14 @@ type UpdateWatchedChannelRequest struct {
 	Categories         []string            `json:"categories"`
 	MaxAge             int64               `json:"max_age"`
 	Regex              []AddLiveTitleRegex `json:"regex"`
+	DownloadClips      bool                `json:"download_clips" validate:"boolean"`
+	ClipsMinViews      int                 `json:"clips_min_views" validate:"min=0"`
+	ClipsMaxAge        int64               `json:"clips_max_age" validate:"min=0"`
+	// VodReplacement defaults to none when not set
+	VodReplacement utils.LiveVodReplacement `json:"vod_replacement" validate:"omitempty,oneof=none replace keep_both"`
+	// VodReplacementMinDifference defaults to 60 seconds when not set
+	VodReplacementMinDifference *int       `json:"vod_replacement_min_difference" validate:"omitempty,min=0"`
+	ArchiveProfileID            *uuid.UUID `json:"archive_profile_id"`
 }
 
 type ConvertChatRequest struct {
@@ -133,19 +159,25 @@ func (h *Handler) AddLiveWatchedChannel(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 	liveDto := live.Live{
-		ID:                 cUUID,
-		WatchLive:          ccr.WatchLive,
-		WatchVod:           ccr.WatchVod,
-		DownloadArchives:   ccr.DownloadArchives,
-		DownloadHighlights: ccr.DownloadHighlights,
-		DownloadUploads:    ccr.DownloadUploads,
-		IsLive:             false,
-		ArchiveChat:        ccr.ArchiveChat,
-		Resolution:         ccr.Resolution,
-		RenderChat:         ccr.RenderChat,
-		DownloadSubOnly:    ccr.DownloadSubOnly,
-		Categories:         ccr.Categories,
-		MaxAge:             ccr.MaxAge,
+		ID:                          cUUID,
+		WatchLive:                   ccr.WatchLive,
+		WatchVod:                    ccr.WatchVod,
+		DownloadArchives:            ccr.DownloadArchives,
+		DownloadHighlights:          ccr.DownloadHighlights,
+		DownloadUploads:             ccr.DownloadUploads,
+		IsLive:                      false,
+		ArchiveChat:                 ccr.ArchiveChat,
+		Resolution:                  ccr.Resolution,
+		RenderChat:                  ccr.RenderChat,
+		DownloadSubOnly:             ccr.DownloadSubOnly,
+		Categories:                  ccr.Categories,
+		MaxAge:                      ccr.MaxAge,
+		DownloadClips:               ccr.DownloadClips,
+		ClipsMinViews:               ccr.ClipsMinViews,
+		ClipsMaxAge:                 ccr.ClipsMaxAge,
+		VodReplacement:              vodReplacementOrDefault(ccr.VodReplacement),
+		VodReplacementMinDifference: ccr.VodReplacementMinDifference,
+		ArchiveProfileID:            ccr.ArchiveProfileID,
 	}
 
 	for _, regex := range ccr.Regex {
@@ -196,19 +228,25 @@ func (h *Handler) AddMultipleLiveWatchedChannel(c echo.Context) error {
 			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 		liveDto := live.Live{
-			ID:                 cUUID,
-			WatchLive:          ccr.WatchLive,
-			WatchVod:           ccr.WatchVod,
-			DownloadArchives:   ccr.DownloadArchives,
-			DownloadHighlights: ccr.DownloadHighlights,
-			DownloadUploads:    ccr.DownloadUploads,
-			IsLive:             false,
-			ArchiveChat:        ccr.ArchiveChat,
-			Resolution:         ccr.Resolution,
-			RenderChat:         ccr.RenderChat,
-			DownloadSubOnly:    ccr.DownloadSubOnly,
-			Categories:         ccr.Categories,
-			MaxAge:             ccr.MaxAge,
+			ID:                          cUUID,
+			WatchLive:                   ccr.WatchLive,
+			WatchVod:                    ccr.WatchVod,
+			DownloadArchives:            ccr.DownloadArchives,
+			DownloadHighlights:          ccr.DownloadHighlights,
+			DownloadUploads:             ccr.DownloadUploads,
+			IsLive:                      false,
+			ArchiveChat:                 ccr.ArchiveChat,
+			Resolution:                  ccr.Resolution,
+			RenderChat:                  ccr.RenderChat,
+			DownloadSubOnly:             ccr.DownloadSubOnly,
+			Categories:                  ccr.Categories,
+			MaxAge:                      ccr.MaxAge,
+			DownloadClips:               ccr.DownloadClips,
+			ClipsMinViews:               ccr.ClipsMinViews,
+			ClipsMaxAge:                 ccr.ClipsMaxAge,
+			VodReplacement:              vodReplacementOrDefault(ccr.VodReplacement),
+			VodReplacementMinDifference: ccr.VodReplacementMinDifference,
+			ArchiveProfileID:            ccr.ArchiveProfileID,
 		}
 		l, err := h.Service.LiveService.AddLiveWatchedChannel(c, liveDto)
 		if err != nil {
@@ -248,18 +286,24 @@ func (h *Handler) UpdateLiveWatchedChannel(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 	liveDto := live.Live{
-		ID:                 lID,
-		WatchLive:          ccr.WatchLive,
-		WatchVod:           ccr.WatchVod,
-		DownloadArchives:   ccr.DownloadArchives,
-		DownloadHighlights: ccr.DownloadHighlights,
-		DownloadUploads:    ccr.DownloadUploads,
-		ArchiveChat:        ccr.ArchiveChat,
-		Resolution:         ccr.Resolution,
-		RenderChat:         ccr.RenderChat,
-		DownloadSubOnly:    ccr.DownloadSubOnly,
-		Categories:         ccr.Categories,
-		MaxAge:             ccr.MaxAge,
+		ID:                          lID,
+		WatchLive:                   ccr.WatchLive,
+		WatchVod:                    ccr.WatchVod,
+		DownloadArchives:            ccr.DownloadArchives,
+		DownloadHighlights:          ccr.DownloadHighlights,
+		DownloadUploads:             ccr.DownloadUploads,
+		ArchiveChat:                 ccr.ArchiveChat,
+		Resolution:                  ccr.Resolution,
+		RenderChat:                  ccr.RenderChat,
+		DownloadSubOnly:             ccr.DownloadSubOnly,
+		Categories:                  ccr.Categories,
+		MaxAge:                      ccr.MaxAge,
+		DownloadClips:               ccr.DownloadClips,
+		ClipsMinViews:               ccr.ClipsMinViews,
+		ClipsMaxAge:                 ccr.ClipsMaxAge,
+		VodReplacement:              vodReplacementOrDefault(ccr.VodReplacement),
+		VodReplacementMinDifference: ccr.VodReplacementMinDifference,
+		ArchiveProfileID:            ccr.ArchiveProfileID,
 	}
 
 	for _, regex := range ccr.Regex {
@@ -360,6 +404,10 @@ func (h *Handler) ArchiveLiveChannel(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
+	// archivers and users granted the archive access to the channel can archive it
+	if err := auth.CheckChannelAccess(c, cID, utils.ChannelAccessArchive); err != nil {
+		return err
+	}
 
 	archiveLiveDto := live.ArchiveLive{
 		ChannelID:   cID,
@@ -375,3 +423,11 @@ func (h *Handler) ArchiveLiveChannel(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, "ok")
 }
+
+// vodReplacementOrDefault returns the vod replacement policy, defaulting to none for clients that do not send it
+func vodReplacementOrDefault(r utils.LiveVodReplacement) utils.LiveVodReplacement {
+	if r == "" {
+		return utils.LiveVodReplacementNone
+	}
+	return r
+}
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -20,6 +21,7 @@ import (
 	"github.com/zibbp/ganymede/internal/channel"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/live"
+	"github.com/zibbp/ganymede/internal/platform"
 	"github.com/zibbp/ganymede/internal/queue"
 	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
 	"github.com/zibbp/ganymede/internal/twitch"
@@ -79,6 +81,53 @@ func TestAddLiveWatchedChannel(t *testing.T) {
 	}
 }
 
+// * TestAddLiveWatchedChannelWithClips tests the create watched channel with clip downloading
+// Test creates a live watched channel that downloads clips
+func TestAddLiveWatchedChannelWithClips(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	twitchService := twitch.NewService()
+	vodService := vod.NewService(&database.Database{Client: client})
+	channelService := channel.NewService(&database.Database{Client: client})
+	queueService := queue.NewService(&database.Database{Client: client}, vodService, channelService)
+	archiveService := archive.NewService(&database.Database{Client: client}, twitchService, channelService, vodService, queueService)
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			LiveService: live.NewService(&database.Database{Client: client}, twitchService, archiveService),
+		},
+	}
+
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	// Create a test channel
+	testChannel := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").SaveX(context.Background())
+
+	// Watched channel json
+	liveWatchedChannelJson := `{"channel_id": "` + testChannel.ID.String() + `", "watch_live": false, "watch_vod": false, "resolution": "best", "download_clips": true, "clips_min_views": 100, "clips_max_age": 3}`
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/live", strings.NewReader(liveWatchedChannelJson))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.AddLiveWatchedChannel(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		// Check database to ensure the clip settings were saved
+		liveWatchedChannel := client.Live.Query().Where(entLive.HasChannelWith(entChannel.IDEQ(testChannel.ID))).OnlyX(context.Background())
+		assert.True(t, liveWatchedChannel.DownloadClips)
+		assert.Equal(t, 100, liveWatchedChannel.ClipsMinViews)
+		assert.Equal(t, int64(3), liveWatchedChannel.ClipsMaxAge)
+	}
+}
+
 // * TestGetLiveWatchedChannels tests the get watched channels
 // Test gets watched channels
 func TestGetLiveWatchedChannels(t *testing.T) {
@@ -231,3 +280,51 @@ func TestDeleteLiveWatchedChannel(t *testing.T) {
 
 	}
 }
+
+// * TestCheckLiveWatchedChannels tests the live check against a fake platform
+// Test marks a watched channel that is no longer live as offline
+func TestCheckLiveWatchedChannels(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	twitchPlatform := platform.Register(utils.PlatformTwitch, &FakePlatform{
+		Streams: []platform.LiveStream{
+			{ID: "1", UserLogin: "other_channel", Title: "live", StartedAt: time.Now()},
+		},
+	})
+	defer platform.Register(utils.PlatformTwitch, twitchPlatform)
+
+	twitchService := twitch.NewService()
+	vodService := vod.NewService(&database.Database{Client: client})
+	channelService := channel.NewService(&database.Database{Client: client})
+	queueService := queue.NewService(&database.Database{Client: client}, vodService, channelService)
+	archiveService := archive.NewService(&database.Database{Client: client}, twitchService, channelService, vodService, queueService)
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			LiveService: live.NewService(&database.Database{Client: client}, twitchService, archiveService),
+		},
+	}
+
+	// Create a test channel that was live during the last check
+	testChannel := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").SaveX(context.Background())
+	liveWatchedChannel := client.Live.Create().SetChannel(testChannel).SetWatchLive(true).SetIsLive(true).SetResolution("best").SaveX(context.Background())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/live/check", nil)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.Check(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		// Check the watched channel is now offline
+		updated := client.Live.GetX(context.Background(), liveWatchedChannel.ID)
+		assert.False(t, updated.IsLive)
+		assert.True(t, updated.LastLive.After(liveWatchedChannel.LastLive))
+	}
+}
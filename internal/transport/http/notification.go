@@ -2,14 +2,67 @@ package http
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/notification"
+	"github.com/zibbp/ganymede/internal/utils"
 )
 
+type NotificationService interface {
+	GetDeliveries(c echo.Context, status utils.TaskStatus, limit int, offset int) (notification.DeliveryPagination, error)
+	GetDelivery(c echo.Context, id uuid.UUID) (*ent.NotificationDelivery, error)
+	GetSubscriptions(c echo.Context, userID uuid.UUID) ([]*ent.NotificationSubscription, error)
+	CreateSubscription(c echo.Context, userID uuid.UUID, subDto notification.Subscription) (*ent.NotificationSubscription, error)
+	UpdateSubscription(c echo.Context, userID uuid.UUID, id uuid.UUID, subDto notification.Subscription) (*ent.NotificationSubscription, error)
+	DeleteSubscription(c echo.Context, userID uuid.UUID, id uuid.UUID) error
+	UpdateWebhook(c echo.Context, userID uuid.UUID, webhook string) error
+	Preview(c echo.Context, tmpl string, event utils.NotificationEvent, vodID uuid.UUID, queueID *uuid.UUID, failedTask string) (*notification.Preview, error)
+}
+
+type NotificationSubscriptionRequest struct {
+	Name       string                     `json:"name"`
+	Enabled    bool                       `json:"enabled"`
+	Events     []utils.NotificationEvent  `json:"events" validate:"required,min=1,dive,oneof=video_success live_success error is_live playlist_vod"`
+	ChannelIDs []uuid.UUID                `json:"channel_ids"`
+	Provider   utils.NotificationProvider `json:"provider" validate:"omitempty,oneof=webhook discord apprise ntfy gotify matrix"`
+	URL        string                     `json:"url" validate:"omitempty,url"`
+	Token      string                     `json:"token"`
+	Room       string                     `json:"room"`
+	Template   string                     `json:"template"`
+}
+
+func (r *NotificationSubscriptionRequest) toSubscription() notification.Subscription {
+	return notification.Subscription{
+		Name:       r.Name,
+		Enabled:    r.Enabled,
+		Events:     r.Events,
+		ChannelIDs: r.ChannelIDs,
+		Provider:   r.Provider,
+		URL:        r.URL,
+		Token:      r.Token,
+		Room:       r.Room,
+		Template:   r.Template,
+	}
+}
+
+type PreviewNotificationRequest struct {
+	Template   string                  `json:"template" validate:"required"`
+	Event      utils.NotificationEvent `json:"event" validate:"required,oneof=video_success live_success error is_live playlist_vod"`
+	VodID      uuid.UUID               `json:"vod_id" validate:"required"`
+	QueueID    *uuid.UUID              `json:"queue_id"`
+	FailedTask string                  `json:"failed_task"`
+}
+
+type UpdateWebhookRequest struct {
+	Webhook string `json:"webhook" validate:"omitempty,url"`
+}
+
 // TestNotification godoc
 //
 //	@Summary		Test notification
@@ -67,3 +120,260 @@ func (h *Handler) TestNotification(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, "ok")
 }
+
+// GetNotificationDeliveries godoc
+//
+//	@Summary		Get notification deliveries
+//	@Description	Get notification deliveries, newest first. Use status=failed to get failed deliveries.
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			status	query		string	false	"Status"	Enums(pending, running, success, failed)
+//	@Param			limit	query		integer	false	"Limit"		default(20)
+//	@Param			offset	query		integer	false	"Offset"	default(0)
+//	@Success		200		{object}	notification.DeliveryPagination
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/notification/delivery [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetNotificationDeliveries(c echo.Context) error {
+	status := utils.TaskStatus(c.QueryParam("status"))
+	switch status {
+	case "", utils.Pending, utils.Running, utils.Success, utils.Failed:
+	default:
+		return echo.NewHTTPError(http.StatusBadRequest, "status is invalid")
+	}
+	limit := 20
+	if c.QueryParam("limit") != "" {
+		l, err := strconv.Atoi(c.QueryParam("limit"))
+		if err != nil || l < 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid limit")
+		}
+		limit = l
+	}
+	offset := 0
+	if c.QueryParam("offset") != "" {
+		o, err := strconv.Atoi(c.QueryParam("offset"))
+		if err != nil || o < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid offset")
+		}
+		offset = o
+	}
+	deliveries, err := h.Service.NotificationService.GetDeliveries(c, status, limit, offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, deliveries)
+}
+
+// GetNotificationDelivery godoc
+//
+//	@Summary		Get notification delivery
+//	@Description	Get notification delivery
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"Delivery ID"
+//	@Success		200	{object}	ent.NotificationDelivery
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/notification/delivery/{id} [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetNotificationDelivery(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	delivery, err := h.Service.NotificationService.GetDelivery(c, id)
+	if err != nil {
+		if err.Error() == "notification delivery not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, delivery)
+}
+
+// GetNotificationSubscriptions godoc
+//
+//	@Summary		Get notification subscriptions
+//	@Description	Get the notification subscriptions of the current user
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	[]ent.NotificationSubscription
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/notification/subscription [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetNotificationSubscriptions(c echo.Context) error {
+	cc := c.(*auth.CustomContext)
+	subscriptions, err := h.Service.NotificationService.GetSubscriptions(c, cc.User.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, subscriptions)
+}
+
+// CreateNotificationSubscription godoc
+//
+//	@Summary		Create notification subscription
+//	@Description	Subscribe the current user to notification events of channels. The subscription receives events of all channels if no channels are set.
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		NotificationSubscriptionRequest	true	"Subscription"
+//	@Success		200		{object}	ent.NotificationSubscription
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/notification/subscription [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateNotificationSubscription(c echo.Context) error {
+	cc := c.(*auth.CustomContext)
+	req := new(NotificationSubscriptionRequest)
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	subscription, err := h.Service.NotificationService.CreateSubscription(c, cc.User.ID, req.toSubscription())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusOK, subscription)
+}
+
+// UpdateNotificationSubscription godoc
+//
+//	@Summary		Update notification subscription
+//	@Description	Update a notification subscription of the current user. The token is kept if it is empty.
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Subscription ID"
+//	@Param			body	body		NotificationSubscriptionRequest	true	"Subscription"
+//	@Success		200		{object}	ent.NotificationSubscription
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Router			/notification/subscription/{id} [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) UpdateNotificationSubscription(c echo.Context) error {
+	cc := c.(*auth.CustomContext)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	req := new(NotificationSubscriptionRequest)
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	subscription, err := h.Service.NotificationService.UpdateSubscription(c, cc.User.ID, id, req.toSubscription())
+	if err != nil {
+		if err.Error() == "notification subscription not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusOK, subscription)
+}
+
+// DeleteNotificationSubscription godoc
+//
+//	@Summary		Delete notification subscription
+//	@Description	Delete a notification subscription of the current user
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Subscription ID"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/notification/subscription/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteNotificationSubscription(c echo.Context) error {
+	cc := c.(*auth.CustomContext)
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	err = h.Service.NotificationService.DeleteSubscription(c, cc.User.ID, id)
+	if err != nil {
+		if err.Error() == "notification subscription not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// UpdateNotificationWebhook godoc
+//
+//	@Summary		Update notification webhook
+//	@Description	Update the webhook of the current user, used by subscriptions without a URL
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body	UpdateWebhookRequest	true	"Webhook"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/notification/webhook [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) UpdateNotificationWebhook(c echo.Context) error {
+	cc := c.(*auth.CustomContext)
+	req := new(UpdateWebhookRequest)
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	err := h.Service.NotificationService.UpdateWebhook(c, cc.User.ID, req.Webhook)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid webhook") {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// PreviewNotification godoc
+//
+//	@Summary		Preview notification
+//	@Description	Render a notification template against a video and optionally a queue item without sending it
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		PreviewNotificationRequest	true	"Preview"
+//	@Success		200		{object}	notification.Preview
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/notification/preview [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) PreviewNotification(c echo.Context) error {
+	req := new(PreviewNotificationRequest)
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	preview, err := h.Service.NotificationService.Preview(c, req.Template, req.Event, req.VodID, req.QueueID, req.FailedTask)
+	if err != nil {
+		switch {
+		case err.Error() == "vod not found" || err.Error() == "queue item not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case strings.HasPrefix(err.Error(), "error parsing template") || strings.HasPrefix(err.Error(), "error executing template"):
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, preview)
+}
@@ -0,0 +1,225 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/enttest"
+	"github.com/zibbp/ganymede/internal/auth"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/notification"
+	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// * TestGetNotificationDeliveries tests the GetNotificationDeliveries function
+// Gets the failed notification deliveries
+func TestGetNotificationDeliveries(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			NotificationService: notification.NewService(&database.Database{Client: client}),
+		},
+	}
+
+	// Create deliveries
+	_, err := client.NotificationDelivery.Create().SetEvent(utils.NotificationError).SetProvider(utils.NotificationDiscord).SetTarget("discord").SetStatus(utils.Failed).SetAttempts(5).SetStatusCode(429).SetError("unexpected status code 429").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.NotificationDelivery.Create().SetEvent(utils.NotificationVideoSuccess).SetProvider(utils.NotificationNtfy).SetTarget("ntfy").SetStatus(utils.Success).SetAttempts(1).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/notification/delivery?status=failed", nil)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.GetNotificationDeliveries(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response notification.DeliveryPagination
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, response.TotalCount)
+		if assert.Len(t, response.Data, 1) {
+			assert.Equal(t, "discord", response.Data[0].Target)
+			assert.Equal(t, 429, response.Data[0].StatusCode)
+		}
+	}
+}
+
+// * TestCreateNotificationSubscription tests the CreateNotificationSubscription function
+// Subscribes a user to a channel using the webhook of the user
+func TestCreateNotificationSubscription(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			NotificationService: notification.NewService(&database.Database{Client: client}),
+		},
+	}
+
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	// Create a user with a webhook
+	dbUser, err := client.User.Create().SetUsername("test_user").SetWebhook("https://example.com/webhook").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a channel
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := `{"name": "test", "enabled": true, "events": ["is_live", "error"], "channel_ids": ["` + dbChannel.ID.String() + `"]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/notification/subscription", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: dbUser}
+
+	if assert.NoError(t, h.CreateNotificationSubscription(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", response["name"])
+		assert.Equal(t, string(utils.NotificationWebhook), response["provider"])
+	}
+
+	subscriptions, err := client.User.QueryNotificationSubscriptions(dbUser).WithChannels().All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assert.Len(t, subscriptions, 1) && assert.Len(t, subscriptions[0].Edges.Channels, 1) {
+		assert.Equal(t, dbChannel.ID, subscriptions[0].Edges.Channels[0].ID)
+	}
+
+	// A subscription without a webhook or URL is rejected
+	_, err = client.User.UpdateOne(dbUser).SetWebhook("").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbUser.Webhook = ""
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/notification/subscription", strings.NewReader(`{"enabled": true, "events": ["is_live"]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: dbUser}
+
+	err = h.CreateNotificationSubscription(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+
+	// Only admins can send notifications to internal addresses
+	for _, role := range []utils.Role{utils.UserRole, utils.AdminRole} {
+		dbUser, err = client.User.UpdateOne(dbUser).SetRole(role).Save(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		req = httptest.NewRequest(http.MethodPost, "/api/v1/notification/subscription", strings.NewReader(`{"enabled": true, "events": ["is_live"], "url": "http://127.0.0.1:8080/webhook"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec = httptest.NewRecorder()
+		c = &auth.CustomContext{Context: h.Server.NewContext(req, rec), User: dbUser}
+
+		err = h.CreateNotificationSubscription(c)
+		if role == utils.AdminRole {
+			assert.NoError(t, err)
+		} else if assert.Error(t, err) {
+			assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+		}
+	}
+}
+
+// * TestPreviewNotification tests the PreviewNotification function
+// Renders a Go template and a template using the original variable syntax against a video
+func TestPreviewNotification(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			NotificationService: notification.NewService(&database.Database{Client: client}),
+		},
+	}
+
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	// Create a channel and vod
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbVod, err := client.Vod.Create().SetChannel(dbChannel).SetExtID("123").SetPlatform(utils.PlatformTwitch).SetType(utils.Archive).SetTitle("Test Stream").SetDuration(3723).SetWebThumbnailPath("/vods/test_channel/123/thumbnail.jpg").SetVideoPath("/vods/test_channel/123/123-video.mp4").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		template string
+		body     string
+		json     bool
+	}{
+		{`{{ .Channel.DisplayName }}: {{ .Vod.Title | truncate 4 }} ({{ humanDuration .Vod.Duration }})`, "Test Channel: Test… (1h 2m 3s)", false},
+		{`{{channel_display_name}} - {{vod_title}}`, "Test Channel - Test Stream", false},
+		{`{"embeds": [{"title": {{ toJSON .Vod.Title }}}]}`, `{"embeds": [{"title": "Test Stream"}]}`, true},
+	}
+	for _, test := range tests {
+		reqBody, _ := json.Marshal(map[string]string{"template": test.template, "event": "video_success", "vod_id": dbVod.ID.String()})
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/notification/preview", strings.NewReader(string(reqBody)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := h.Server.NewContext(req, rec)
+
+		if assert.NoError(t, h.PreviewNotification(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response notification.Preview
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, test.body, response.Body)
+			assert.Equal(t, test.json, response.JSON)
+		}
+	}
+
+	// Invalid templates are rejected
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/notification/preview", strings.NewReader(`{"template": "{{ .Vod.Title ", "event": "video_success", "vod_id": "`+dbVod.ID.String()+`"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	err = h.PreviewNotification(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
@@ -0,0 +1,271 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/permission"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+type PermissionService interface {
+	GetGroups(c echo.Context) ([]*ent.Group, error)
+	GetGroup(c echo.Context, id uuid.UUID) (*ent.Group, error)
+	CreateGroup(c echo.Context, groupDto permission.Group) (*ent.Group, error)
+	UpdateGroup(c echo.Context, id uuid.UUID, groupDto permission.Group) (*ent.Group, error)
+	DeleteGroup(c echo.Context, id uuid.UUID) error
+	GetChannelPermissions(c echo.Context, channelID uuid.UUID) ([]*ent.ChannelPermission, error)
+	CreateChannelPermission(c echo.Context, channelID uuid.UUID, permissionDto permission.ChannelPermission) (*ent.ChannelPermission, error)
+	DeleteChannelPermission(c echo.Context, channelID uuid.UUID, id uuid.UUID) error
+}
+
+// GroupRequest is used to create and update groups. The users replace the users of the group.
+type GroupRequest struct {
+	Name        string      `json:"name" validate:"required,min=1,max=50"`
+	Description string      `json:"description"`
+	UserIDs     []uuid.UUID `json:"user_ids"`
+}
+
+// ChannelPermissionRequest grants either a user or a group an access to a channel.
+type ChannelPermissionRequest struct {
+	Access  utils.ChannelAccess `json:"access" validate:"required,oneof=view archive edit"`
+	UserID  *uuid.UUID          `json:"user_id"`
+	GroupID *uuid.UUID          `json:"group_id"`
+}
+
+// GetGroups godoc
+//
+//	@Summary		Get groups
+//	@Description	Get groups and their users
+//	@Tags			permission
+//	@Produce		json
+//	@Success		200	{object}	[]ent.Group
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/group [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetGroups(c echo.Context) error {
+	groups, err := h.Service.PermissionService.GetGroups(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, groups)
+}
+
+// GetGroup godoc
+//
+//	@Summary		Get group
+//	@Description	Get a group and its users
+//	@Tags			permission
+//	@Produce		json
+//	@Param			id	path		string	true	"group id"
+//	@Success		200	{object}	ent.Group
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/group/{id} [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetGroup(c echo.Context) error {
+	gID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid group id")
+	}
+	g, err := h.Service.PermissionService.GetGroup(c, gID)
+	if err != nil {
+		if err.Error() == "group not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, g)
+}
+
+// CreateGroup godoc
+//
+//	@Summary		Create group
+//	@Description	Create a group of users
+//	@Tags			permission
+//	@Accept			json
+//	@Produce		json
+//	@Param			group	body		GroupRequest	true	"group"
+//	@Success		200		{object}	ent.Group
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/group [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateGroup(c echo.Context) error {
+	gr := new(GroupRequest)
+	if err := c.Bind(gr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(gr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	g, err := h.Service.PermissionService.CreateGroup(c, permission.Group{Name: gr.Name, Description: gr.Description, UserIDs: gr.UserIDs})
+	if err != nil {
+		if err.Error() == "group already exists" {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, g)
+}
+
+// UpdateGroup godoc
+//
+//	@Summary		Update group
+//	@Description	Update a group and replace its users
+//	@Tags			permission
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string			true	"group id"
+//	@Param			group	body		GroupRequest	true	"group"
+//	@Success		200		{object}	ent.Group
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/group/{id} [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) UpdateGroup(c echo.Context) error {
+	gID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid group id")
+	}
+	gr := new(GroupRequest)
+	if err := c.Bind(gr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(gr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	g, err := h.Service.PermissionService.UpdateGroup(c, gID, permission.Group{Name: gr.Name, Description: gr.Description, UserIDs: gr.UserIDs})
+	if err != nil {
+		switch err.Error() {
+		case "group not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "group already exists":
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, g)
+}
+
+// DeleteGroup godoc
+//
+//	@Summary		Delete group
+//	@Description	Delete a group and the channel permissions granted to it
+//	@Tags			permission
+//	@Param			id	path	string	true	"group id"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/group/{id} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteGroup(c echo.Context) error {
+	gID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid group id")
+	}
+	if err := h.Service.PermissionService.DeleteGroup(c, gID); err != nil {
+		if err.Error() == "group not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// GetChannelPermissions godoc
+//
+//	@Summary		Get channel permissions
+//	@Description	Get the users and groups granted an access to a channel
+//	@Tags			permission
+//	@Produce		json
+//	@Param			id	path		string	true	"channel id"
+//	@Success		200	{object}	[]ent.ChannelPermission
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/channel/{id}/permission [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetChannelPermissions(c echo.Context) error {
+	cID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid channel id")
+	}
+	permissions, err := h.Service.PermissionService.GetChannelPermissions(c, cID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, permissions)
+}
+
+// CreateChannelPermission godoc
+//
+//	@Summary		Create channel permission
+//	@Description	Grant a user or a group an access to a channel. Restricted channels are only visible to admins and users with a permission.
+//	@Tags			permission
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"channel id"
+//	@Param			permission	body		ChannelPermissionRequest	true	"permission"
+//	@Success		200			{object}	ent.ChannelPermission
+//	@Failure		400			{object}	utils.ErrorResponse
+//	@Failure		500			{object}	utils.ErrorResponse
+//	@Router			/channel/{id}/permission [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CreateChannelPermission(c echo.Context) error {
+	cID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid channel id")
+	}
+	cpr := new(ChannelPermissionRequest)
+	if err := c.Bind(cpr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(cpr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	p, err := h.Service.PermissionService.CreateChannelPermission(c, cID, permission.ChannelPermission{Access: cpr.Access, UserID: cpr.UserID, GroupID: cpr.GroupID})
+	if err != nil {
+		switch err.Error() {
+		case "permission must be granted to either a user or a group", "channel, user or group not found":
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, p)
+}
+
+// DeleteChannelPermission godoc
+//
+//	@Summary		Delete channel permission
+//	@Description	Revoke a channel permission
+//	@Tags			permission
+//	@Param			id				path	string	true	"channel id"
+//	@Param			permissionId	path	string	true	"permission id"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/channel/{id}/permission/{permissionId} [delete]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) DeleteChannelPermission(c echo.Context) error {
+	cID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid channel id")
+	}
+	pID, err := uuid.Parse(c.Param("permissionId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid permission id")
+	}
+	if err := h.Service.PermissionService.DeleteChannelPermission(c, cID, pID); err != nil {
+		if err.Error() == "channel permission not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
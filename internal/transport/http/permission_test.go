@@ -0,0 +1,130 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/enttest"
+	"github.com/zibbp/ganymede/internal/auth"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/permission"
+	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+)
+
+// * TestRestrictedChannel tests the CreateGroup, CreateChannelPermission and GetVodsPagination functions
+// Grants a group the view access to a restricted channel and only returns its vods to admins and members of the group
+func TestRestrictedChannel(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	store := &database.Database{Client: client}
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			VodService:        vod.NewService(store),
+			PermissionService: permission.NewService(store),
+		},
+	}
+
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	// Create a public and a restricted channel with a vod each
+	publicChannel, err := client.Channel.Create().SetName("public_channel").SetDisplayName("Public Channel").SetImagePath("/vods/public_channel/public_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	restrictedChannel, err := client.Channel.Create().SetName("restricted_channel").SetDisplayName("Restricted Channel").SetImagePath("/vods/restricted_channel/restricted_channel.jpg").SetRestricted(true).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dbChannel := range []*ent.Channel{publicChannel, restrictedChannel} {
+		_, err := client.Vod.Create().SetChannel(dbChannel).SetExtID(dbChannel.Name).SetTitle(dbChannel.Name + " vod").SetWebThumbnailPath("").SetVideoPath("").SetStreamedAt(time.Now()).Save(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Create users
+	admin, err := client.User.Create().SetUsername("admin").SetRole(utils.AdminRole).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	member, err := client.User.Create().SetUsername("member").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	outsider, err := client.User.Create().SetUsername("outsider").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a group with the member
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/group", strings.NewReader(fmt.Sprintf(`{"name":"community","user_ids":["%s"]}`, member.ID)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	var group ent.Group
+	if assert.NoError(t, h.CreateGroup(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &group))
+		assert.Len(t, group.Edges.Users, 1)
+	}
+
+	// Grant the group the view access to the restricted channel
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/channel/:id/permission", strings.NewReader(fmt.Sprintf(`{"access":"view","group_id":"%s"}`, group.ID)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+	c.SetPath("/api/v1/channel/:id/permission")
+	c.SetParamNames("id")
+	c.SetParamValues(restrictedChannel.ID.String())
+
+	if assert.NoError(t, h.CreateChannelPermission(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	// Anonymous visitors and users without a permission only see the public channel
+	for _, tc := range []struct {
+		user  *ent.User
+		count int
+	}{
+		{nil, 1},
+		{outsider, 1},
+		{member, 2},
+		{admin, 2},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/vod/paginate?limit=20&offset=0", nil)
+		rec := httptest.NewRecorder()
+		var c echo.Context = h.Server.NewContext(req, rec)
+		if tc.user != nil {
+			c = &auth.CustomContext{Context: c, User: tc.user}
+		}
+
+		if assert.NoError(t, h.GetVodsPagination(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var response map[string]interface{}
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, float64(tc.count), response["total_count"])
+			assert.Len(t, response["data"], tc.count)
+		}
+	}
+}
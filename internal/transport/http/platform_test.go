@@ -0,0 +1,105 @@
+package http_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/zibbp/ganymede/internal/chapter"
+	"github.com/zibbp/ganymede/internal/platform"
+)
+
+// FakePlatform is an in-memory platform used to drive services without calling external APIs.
+type FakePlatform struct {
+	Users   []platform.User
+	Streams []platform.LiveStream
+	Videos  []platform.Video
+	Clips   []platform.Clip
+}
+
+func (f *FakePlatform) GetUser(login string) (platform.User, error) {
+	for _, u := range f.Users {
+		if u.Login == login {
+			return u, nil
+		}
+	}
+	return platform.User{}, fmt.Errorf("channel not found")
+}
+
+func (f *FakePlatform) GetUserByID(id string) (platform.User, error) {
+	for _, u := range f.Users {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return platform.User{}, fmt.Errorf("channel not found")
+}
+
+func (f *FakePlatform) GetLiveStreams(logins []string) ([]platform.LiveStream, error) {
+	var streams []platform.LiveStream
+	for _, s := range f.Streams {
+		for _, login := range logins {
+			if s.UserLogin == login {
+				streams = append(streams, s)
+			}
+		}
+	}
+	return streams, nil
+}
+
+func (f *FakePlatform) GetVideos(userID string, videoType string) ([]platform.Video, error) {
+	var videos []platform.Video
+	for _, v := range f.Videos {
+		if v.UserID == userID && v.Type == videoType {
+			videos = append(videos, v)
+		}
+	}
+	return videos, nil
+}
+
+func (f *FakePlatform) GetVideo(id string) (platform.Video, error) {
+	for _, v := range f.Videos {
+		if v.ID == id {
+			return v, nil
+		}
+	}
+	return platform.Video{}, fmt.Errorf("vod not found")
+}
+
+func (f *FakePlatform) GetChapters(videoID string, duration int) ([]chapter.Chapter, error) {
+	v, err := f.GetVideo(videoID)
+	if err != nil {
+		return nil, err
+	}
+	return v.Chapters, nil
+}
+
+func (f *FakePlatform) GetMutedSegments(videoID string) ([]platform.MutedSegment, error) {
+	v, err := f.GetVideo(videoID)
+	if err != nil {
+		return nil, err
+	}
+	return v.MutedSegments, nil
+}
+
+func (f *FakePlatform) GetClip(id string) (platform.Clip, error) {
+	for _, c := range f.Clips {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return platform.Clip{}, fmt.Errorf("clip not found")
+}
+
+func (f *FakePlatform) GetClips(userID string, startedAt time.Time, minViews int) ([]platform.Clip, error) {
+	var clips []platform.Clip
+	for _, c := range f.Clips {
+		if c.UserID == userID && c.CreatedAt.After(startedAt) && c.ViewCount >= int64(minViews) {
+			clips = append(clips, c)
+		}
+	}
+	return clips, nil
+}
+
+func (f *FakePlatform) CheckAccessToken(token string) error {
+	return nil
+}
@@ -148,6 +148,19 @@ func TestGetPlaylists(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// Playlists with only videos of restricted channels are hidden from users without a permission
+	restrictedChannel, err := client.Channel.Create().SetName("restricted_channel").SetDisplayName("Restricted Channel").SetImagePath("").SetRestricted(true).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	restrictedVod, err := client.Vod.Create().SetTitle("restricted vod").SetExtID("456").SetWebThumbnailPath("").SetVideoPath("").SetChannel(restrictedChannel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Playlist.Create().SetName("restricted_playlist").AddVods(restrictedVod).Save(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/playlist", nil)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
@@ -160,7 +173,9 @@ func TestGetPlaylists(t *testing.T) {
 		var response []map[string]interface{}
 		err := json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, "test_playlist", response[0]["name"])
+		if assert.Len(t, response, 1) {
+			assert.Equal(t, "test_playlist", response[0]["name"])
+		}
 	}
 }
 
@@ -1,12 +1,18 @@
 package http
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/queue"
+	"github.com/zibbp/ganymede/internal/temporal"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -15,16 +21,31 @@ type QueueService interface {
 	GetQueueItems(c echo.Context) ([]*ent.Queue, error)
 	GetQueueItemsFilter(c echo.Context, pro bool) ([]*ent.Queue, error)
 	GetQueueItem(id uuid.UUID) (*ent.Queue, error)
+	GetQueueItemProgress(ctx context.Context, q *ent.Queue) ([]temporal.ActivityProgress, error)
 	UpdateQueueItem(queueDto queue.Queue, id uuid.UUID) (*ent.Queue, error)
 	DeleteQueueItem(c echo.Context, id uuid.UUID) error
 	ReadLogFile(c echo.Context, id uuid.UUID, logType string) ([]byte, error)
 	StopQueueItem(c echo.Context, id uuid.UUID) error
+	CancelQueueItem(c echo.Context, id uuid.UUID) error
+	SetQueueItemPriority(c echo.Context, id uuid.UUID, priority int) (*ent.Queue, error)
+	BumpQueueItem(c echo.Context, id uuid.UUID) (*ent.Queue, error)
+	SetQueueItemPaused(c echo.Context, id uuid.UUID, paused bool) (*ent.Queue, error)
 }
 
 type CreateQueueRequest struct {
 	VodID string `json:"vod_id" validate:"required"`
 }
 
+// QueueItemResponse is a queue item with the progress of its running tasks.
+type QueueItemResponse struct {
+	*ent.Queue
+	Progress []temporal.ActivityProgress `json:"progress"`
+}
+
+type UpdateQueuePriorityRequest struct {
+	Priority *int `json:"priority" validate:"required,min=0,max=1000"`
+}
+
 type UpdateQueueRequest struct {
 	ID                       uuid.UUID        `json:"id"`
 	LiveArchive              bool             `json:"live_archive"`
@@ -32,16 +53,16 @@ type UpdateQueueRequest struct {
 	VideoProcessing          bool             `json:"video_processing"`
 	ChatProcessing           bool             `json:"chat_processing"`
 	Processing               bool             `json:"processing"`
-	TaskVodCreateFolder      utils.TaskStatus `json:"task_vod_create_folder" validate:"required,oneof=pending running success failed"`
-	TaskVodDownloadThumbnail utils.TaskStatus `json:"task_vod_download_thumbnail" validate:"required,oneof=pending running success failed"`
-	TaskVodSaveInfo          utils.TaskStatus `json:"task_vod_save_info" validate:"required,oneof=pending running success failed"`
-	TaskVideoDownload        utils.TaskStatus `json:"task_video_download" validate:"required,oneof=pending running success failed"`
-	TaskVideoConvert         utils.TaskStatus `json:"task_video_convert" validate:"required,oneof=pending running success failed"`
-	TaskVideoMove            utils.TaskStatus `json:"task_video_move" validate:"required,oneof=pending running success failed"`
-	TaskChatDownload         utils.TaskStatus `json:"task_chat_download" validate:"required,oneof=pending running success failed"`
-	TaskChatConvert          utils.TaskStatus `json:"task_chat_convert" validate:"required,oneof=pending running success failed"`
-	TaskChatRender           utils.TaskStatus `json:"task_chat_render" validate:"required,oneof=pending running success failed"`
-	TaskChatMove             utils.TaskStatus `json:"task_chat_move" validate:"required,oneof=pending running success failed"`
+	TaskVodCreateFolder      utils.TaskStatus `json:"task_vod_create_folder" validate:"required,oneof=pending running success failed cancelled"`
+	TaskVodDownloadThumbnail utils.TaskStatus `json:"task_vod_download_thumbnail" validate:"required,oneof=pending running success failed cancelled"`
+	TaskVodSaveInfo          utils.TaskStatus `json:"task_vod_save_info" validate:"required,oneof=pending running success failed cancelled"`
+	TaskVideoDownload        utils.TaskStatus `json:"task_video_download" validate:"required,oneof=pending running success failed cancelled"`
+	TaskVideoConvert         utils.TaskStatus `json:"task_video_convert" validate:"required,oneof=pending running success failed cancelled"`
+	TaskVideoMove            utils.TaskStatus `json:"task_video_move" validate:"required,oneof=pending running success failed cancelled"`
+	TaskChatDownload         utils.TaskStatus `json:"task_chat_download" validate:"required,oneof=pending running success failed cancelled"`
+	TaskChatConvert          utils.TaskStatus `json:"task_chat_convert" validate:"required,oneof=pending running success failed cancelled"`
+	TaskChatRender           utils.TaskStatus `json:"task_chat_render" validate:"required,oneof=pending running success failed cancelled"`
+	TaskChatMove             utils.TaskStatus `json:"task_chat_move" validate:"required,oneof=pending running success failed cancelled"`
 }
 
 // CreateQueueItem godoc
@@ -122,8 +143,9 @@ func (h *Handler) GetQueueItems(c echo.Context) error {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id	path		string	true	"Queue item id"
-//	@Success		200	{object}	ent.Queue
+//	@Success		200	{object}	QueueItemResponse
 //	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
 //	@Failure		500	{object}	utils.ErrorResponse
 //	@Router			/queue/{id} [get]
 //	@Security		ApiKeyCookieAuth
@@ -134,9 +156,81 @@ func (h *Handler) GetQueueItem(c echo.Context) error {
 	}
 	q, err := h.Service.QueueService.GetQueueItem(id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return queueItemError(err)
+	}
+	return c.JSON(http.StatusOK, h.queueItemResponse(c.Request().Context(), q))
+}
+
+// queueProgressInterval is how often the progress stream of a queue item sends an event.
+const queueProgressInterval = 5 * time.Second
+
+// StreamQueueItemProgress godoc
+//
+//	@Summary		Stream queue item progress
+//	@Description	Stream the queue item with the progress of its running tasks as server-sent events. The stream ends when the queue item is no longer processing.
+//	@Tags			queue
+//	@Produce		text/event-stream
+//	@Param			id	path		string	true	"Queue item id"
+//	@Success		200	{object}	QueueItemResponse
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/queue/{id}/progress [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) StreamQueueItemProgress(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	q, err := h.Service.QueueService.GetQueueItem(id)
+	if err != nil {
+		return queueItemError(err)
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.WriteHeader(http.StatusOK)
+
+	ctx := c.Request().Context()
+	ticker := time.NewTicker(queueProgressInterval)
+	defer ticker.Stop()
+	for {
+		data, err := json.Marshal(h.queueItemResponse(ctx, q))
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(res, "event: queue\ndata: %s\n\n", data); err != nil {
+			return nil
+		}
+		res.Flush()
+		if !q.Processing {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		q, err = h.Service.QueueService.GetQueueItem(id)
+		if err != nil {
+			fmt.Fprintf(res, "event: error\ndata: %s\n\n", err.Error())
+			res.Flush()
+			return nil
+		}
 	}
-	return c.JSON(http.StatusOK, q)
+}
+
+// queueItemResponse adds the progress of the running tasks to a queue item. The queue item is returned without progress if it can't be read.
+func (h *Handler) queueItemResponse(ctx context.Context, q *ent.Queue) QueueItemResponse {
+	progress, err := h.Service.QueueService.GetQueueItemProgress(ctx, q)
+	if err != nil {
+		log.Error().Err(err).Msgf("error getting progress of queue item %s", q.ID)
+	}
+	return QueueItemResponse{Queue: q, Progress: progress}
 }
 
 // UpdateQueueItem godoc
@@ -255,6 +349,18 @@ func (h *Handler) ReadQueueLogFile(c echo.Context) error {
 	return c.JSON(http.StatusOK, string(log))
 }
 
+// StopQueueItem godoc
+//
+//	@Summary		Stop queue item
+//	@Description	Stop a queue item. Live archives stop recording and process the recorded video, other archives are cancelled.
+//	@Tags			queue
+//	@Param			id	path	string	true	"Queue item id"
+//	@Success		204
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/queue/{id}/stop [post]
+//	@Security		ApiKeyCookieAuth
 func (h *Handler) StopQueueItem(c echo.Context) error {
 	id := c.Param("id")
 
@@ -265,7 +371,154 @@ func (h *Handler) StopQueueItem(c echo.Context) error {
 
 	err = h.Service.QueueService.StopQueueItem(c, uuid)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return queueWorkflowError(err)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
+
+// CancelQueueItem godoc
+//
+//	@Summary		Cancel queue item
+//	@Description	Cancel the archive of a queue item. Running tasks are stopped and set to cancelled.
+//	@Tags			queue
+//	@Param			id	path	string	true	"Queue item id"
+//	@Success		204
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/queue/{id}/cancel [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) CancelQueueItem(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+
+	err = h.Service.QueueService.CancelQueueItem(c, id)
+	if err != nil {
+		return queueWorkflowError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func queueWorkflowError(err error) error {
+	if err.Error() == "queue item is not processing" {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return queueItemError(err)
+}
+
+// UpdateQueueItemPriority godoc
+//
+//	@Summary		Update queue item priority
+//	@Description	Set the priority of a queue item. Tasks of items with a higher priority are dispatched first. Live archives default to 100, other items to 50.
+//	@Tags			queue
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Queue item id"
+//	@Param			body	body		UpdateQueuePriorityRequest	true	"Priority"
+//	@Success		200		{object}	ent.Queue
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		500		{object}	utils.ErrorResponse
+//	@Router			/queue/{id}/priority [put]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) UpdateQueueItemPriority(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+	req := new(UpdateQueuePriorityRequest)
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	q, err := h.Service.QueueService.SetQueueItemPriority(c, id, *req.Priority)
+	if err != nil {
+		return queueItemError(err)
+	}
+	return c.JSON(http.StatusOK, q)
+}
+
+// BumpQueueItem godoc
+//
+//	@Summary		Bump queue item
+//	@Description	Move a queue item to the front of the queue by giving it the highest priority
+//	@Tags			queue
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"Queue item id"
+//	@Success		200	{object}	ent.Queue
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/queue/{id}/bump [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) BumpQueueItem(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+	q, err := h.Service.QueueService.BumpQueueItem(c, id)
+	if err != nil {
+		return queueItemError(err)
+	}
+	return c.JSON(http.StatusOK, q)
+}
+
+// PauseQueueItem godoc
+//
+//	@Summary		Pause queue item
+//	@Description	Pause a queue item. Its pending video download, video convert and chat render tasks are not started until it is resumed.
+//	@Tags			queue
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"Queue item id"
+//	@Success		200	{object}	ent.Queue
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/queue/{id}/pause [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) PauseQueueItem(c echo.Context) error {
+	return h.setQueueItemPaused(c, true)
+}
+
+// ResumeQueueItem godoc
+//
+//	@Summary		Resume queue item
+//	@Description	Resume a paused queue item
+//	@Tags			queue
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"Queue item id"
+//	@Success		200	{object}	ent.Queue
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/queue/{id}/resume [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) ResumeQueueItem(c echo.Context) error {
+	return h.setQueueItemPaused(c, false)
+}
+
+func (h *Handler) setQueueItemPaused(c echo.Context, paused bool) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+	q, err := h.Service.QueueService.SetQueueItemPaused(c, id, paused)
+	if err != nil {
+		return queueItemError(err)
+	}
+	return c.JSON(http.StatusOK, q)
+}
+
+func queueItemError(err error) error {
+	if err.Error() == "queue item not found" {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+}
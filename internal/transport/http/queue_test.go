@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/zibbp/ganymede/ent"
@@ -124,6 +125,19 @@ func TestGetQueueItems(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// The queue items of restricted channels are hidden from users without a permission
+	restrictedChannel, err := client.Channel.Create().SetName("restricted_channel").SetDisplayName("Restricted Channel").SetImagePath("").SetRestricted(true).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	restrictedVod, err := client.Vod.Create().SetTitle("restricted vod").SetExtID("456").SetWebThumbnailPath("").SetVideoPath("").SetChannel(restrictedChannel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Queue.Create().SetVod(restrictedVod).Save(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/queue", nil)
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 	rec := httptest.NewRecorder()
@@ -408,3 +422,234 @@ func TestReadQueueLogFile(t *testing.T) {
 
 	}
 }
+
+// * TestBumpQueueItem tests the BumpQueueItem and PauseQueueItem functions
+// Bumps a queue item above a live archive and pauses it
+func TestBumpQueueItem(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	vodService := vod.NewService(&database.Database{Client: client})
+	channelService := channel.NewService(&database.Database{Client: client})
+	queueService := queue.NewService(&database.Database{Client: client}, vodService, channelService)
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			QueueService: queueService,
+		},
+	}
+
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	// Create a channel
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a live archive and a video archive
+	liveVod, err := client.Vod.Create().SetTitle("live vod").SetExtID("123").SetWebThumbnailPath("").SetVideoPath("").SetChannel(dbChannel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	liveQueueItem, err := queueService.CreateQueueItem(queue.Queue{LiveArchive: true}, liveVod.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, utils.QueuePriorityHigh, liveQueueItem.Priority)
+
+	dbVod, err := client.Vod.Create().SetTitle("test vod").SetExtID("456").SetWebThumbnailPath("").SetVideoPath("").SetChannel(dbChannel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbQueueItem, err := queueService.CreateQueueItem(queue.Queue{LiveArchive: false}, dbVod.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, utils.QueuePriorityNormal, dbQueueItem.Priority)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/queue/"+dbQueueItem.ID.String()+"/bump", nil)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+	c.SetPath("/api/v1/queue/:id/bump")
+	c.SetParamNames("id")
+	c.SetParamValues(dbQueueItem.ID.String())
+
+	if assert.NoError(t, h.BumpQueueItem(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		queueItem, err := client.Queue.Get(context.Background(), dbQueueItem.ID)
+		assert.NoError(t, err)
+		assert.Greater(t, queueItem.Priority, liveQueueItem.Priority)
+	}
+
+	// The bumped item is dispatched first
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/queue?processing=true", nil)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.GetQueueItems(c)) {
+		var response []ent.Queue
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		if assert.Len(t, response, 2) {
+			assert.Equal(t, dbQueueItem.ID, response[0].ID)
+		}
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/queue/"+dbQueueItem.ID.String()+"/pause", nil)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+	c.SetPath("/api/v1/queue/:id/pause")
+	c.SetParamNames("id")
+	c.SetParamValues(dbQueueItem.ID.String())
+
+	if assert.NoError(t, h.PauseQueueItem(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		queueItem, err := client.Queue.Get(context.Background(), dbQueueItem.ID)
+		assert.NoError(t, err)
+		assert.True(t, queueItem.Paused)
+	}
+}
+
+// * TestCancelQueueItem tests the CancelQueueItem function
+// Cancelling a finished or missing queue item is rejected
+func TestCancelQueueItem(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	vodService := vod.NewService(&database.Database{Client: client})
+	channelService := channel.NewService(&database.Database{Client: client})
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			QueueService: queue.NewService(&database.Database{Client: client}, vodService, channelService),
+		},
+	}
+
+	// Create a channel
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a vod with a finished queue item
+	dbVod, err := client.Vod.Create().SetTitle("test vod").SetExtID("123").SetWebThumbnailPath("").SetVideoPath("").SetChannel(dbChannel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbQueueItem, err := client.Queue.Create().SetVod(dbVod).SetProcessing(false).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		id   string
+		code int
+	}{
+		{id: dbQueueItem.ID.String(), code: http.StatusBadRequest},
+		{id: "00000000-0000-0000-0000-000000000000", code: http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/queue/"+test.id+"/cancel", nil)
+		rec := httptest.NewRecorder()
+		c := h.Server.NewContext(req, rec)
+		c.SetPath("/api/v1/queue/:id/cancel")
+		c.SetParamNames("id")
+		c.SetParamValues(test.id)
+
+		err := h.CancelQueueItem(c)
+		if assert.Error(t, err) {
+			httpErr, ok := err.(*echo.HTTPError)
+			if assert.True(t, ok) {
+				assert.Equal(t, test.code, httpErr.Code)
+			}
+		}
+	}
+}
+
+// * TestStreamQueueItemProgress tests the StreamQueueItemProgress function
+// The stream of a finished queue item sends the queue item once and ends
+func TestStreamQueueItemProgress(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	vodService := vod.NewService(&database.Database{Client: client})
+	channelService := channel.NewService(&database.Database{Client: client})
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			QueueService: queue.NewService(&database.Database{Client: client}, vodService, channelService),
+		},
+	}
+
+	// Create a channel
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a vod with a finished queue item
+	dbVod, err := client.Vod.Create().SetTitle("test vod").SetExtID("123").SetWebThumbnailPath("").SetVideoPath("").SetChannel(dbChannel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbQueueItem, err := client.Queue.Create().SetVod(dbVod).SetProcessing(false).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/queue/"+dbQueueItem.ID.String()+"/progress", nil)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+	c.SetPath("/api/v1/queue/:id/progress")
+	c.SetParamNames("id")
+	c.SetParamValues(dbQueueItem.ID.String())
+
+	if assert.NoError(t, h.StreamQueueItemProgress(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+
+		events := strings.Split(strings.TrimSpace(rec.Body.String()), "\n\n")
+		if assert.Len(t, events, 1) {
+			assert.True(t, strings.HasPrefix(events[0], "event: queue\ndata: "))
+
+			var response httpHandler.QueueItemResponse
+			err := json.Unmarshal([]byte(strings.TrimPrefix(events[0], "event: queue\ndata: ")), &response)
+			assert.NoError(t, err)
+			assert.Equal(t, dbQueueItem.ID, response.ID)
+			assert.Empty(t, response.Progress)
+		}
+	}
+	// unknown queue items are not found
+	unknownID := uuid.New().String()
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/queue/"+unknownID+"/progress", nil)
+	c = h.Server.NewContext(req, httptest.NewRecorder())
+	c.SetPath("/api/v1/queue/:id/progress")
+	c.SetParamNames("id")
+	c.SetParamValues(unknownID)
+	err = h.StreamQueueItemProgress(c)
+	if assert.Error(t, err) {
+		httpErr, ok := err.(*echo.HTTPError)
+		if assert.True(t, ok) {
+			assert.Equal(t, http.StatusNotFound, httpErr.Code)
+		}
+	}
+}
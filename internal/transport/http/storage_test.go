@@ -0,0 +1,284 @@
+package http_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/enttest"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/storage"
+	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
+	"github.com/zibbp/ganymede/internal/utils"
+	"github.com/zibbp/ganymede/internal/vod"
+)
+
+// FakeS3 is an in-memory S3 server supporting the requests of the S3 storage backend.
+// It records the credential scope of every signed request.
+type FakeS3 struct {
+	mu          sync.Mutex
+	objects     map[string][]byte
+	credentials []string
+}
+
+func NewFakeS3() *FakeS3 {
+	return &FakeS3{objects: map[string][]byte{}}
+}
+
+func (f *FakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		f.credentials = append(f.credentials, strings.Split(strings.TrimPrefix(auth, "AWS4-HMAC-SHA256 Credential="), ",")[0])
+	}
+
+	// path style: /bucket/key
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	key := ""
+	if len(parts) == 2 {
+		key = parts[1]
+	}
+
+	switch {
+	case r.Method == http.MethodGet && key == "" && r.URL.Query().Get("list-type") == "2":
+		type content struct {
+			Key          string
+			Size         int
+			LastModified string
+			ETag         string
+		}
+		result := struct {
+			XMLName     xml.Name `xml:"ListBucketResult"`
+			Name        string
+			Prefix      string
+			KeyCount    int
+			IsTruncated bool
+			Contents    []content
+		}{Name: parts[0], Prefix: r.URL.Query().Get("prefix")}
+		for k, data := range f.objects {
+			if strings.HasPrefix(k, result.Prefix) {
+				result.Contents = append(result.Contents, content{Key: k, Size: len(data), LastModified: time.Now().UTC().Format(time.RFC3339), ETag: `"etag"`})
+			}
+		}
+		result.KeyCount = len(result.Contents)
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(result)
+	case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+		source, _ := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+		sourceParts := strings.SplitN(strings.TrimPrefix(source, "/"), "/", 2)
+		data, ok := f.objects[sourceParts[1]]
+		if !ok {
+			f.notFound(w)
+			return
+		}
+		f.objects[key] = data
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprintf(w, `<CopyObjectResult><LastModified>%s</LastModified><ETag>"etag"</ETag></CopyObjectResult>`, time.Now().UTC().Format(time.RFC3339))
+	case r.Method == http.MethodPut:
+		data, err := readS3Body(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = data
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			f.notFound(w)
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Content-Type", "video/mp4")
+		http.ServeContent(w, r, key, time.Now(), bytes.NewReader(data))
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "not implemented", http.StatusNotImplemented)
+	}
+}
+
+func (f *FakeS3) notFound(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusNotFound)
+	fmt.Fprint(w, `<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+}
+
+// readS3Body returns the body of an upload, decoding the aws-chunked encoding of streaming signed uploads.
+func readS3Body(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+	var data []byte
+	reader := bufio.NewReader(r.Body)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.ParseInt(strings.SplitN(strings.TrimSpace(header), ";", 2)[0], 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return data, nil
+		}
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, chunk); err != nil {
+			return nil, err
+		}
+		data = append(data, chunk[:size]...)
+	}
+}
+
+// TestS3Storage moves a video to the S3 backend and plays it through the storage route.
+func TestS3Storage(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	fake := NewFakeS3()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	t.Setenv("S3_ACCESS_KEY", "access")
+	t.Setenv("S3_SECRET_KEY", "secret")
+	viper.Set("storage.s3.endpoint", server.URL)
+	viper.Set("storage.s3.bucket", "ganymede")
+	viper.Set("storage.s3.region", "eu-west-1")
+	viper.Set("storage.s3.prefix", "archive")
+	viper.Set("storage.s3.use_path_style", true)
+	viper.Set("storage.s3.playback", storage.S3PlaybackProxy)
+	viper.Set("storage.s3.presign_expiry_minutes", 60)
+	defer func() {
+		for _, key := range []string{"endpoint", "bucket", "region", "prefix", "use_path_style", "playback", "presign_expiry_minutes"} {
+			viper.Set("storage.s3."+key, nil)
+		}
+	}()
+
+	backend, err := storage.Get(utils.StorageS3)
+	assert.NoError(t, err)
+
+	// upload
+	ctx := context.Background()
+	src := filepath.Join(t.TempDir(), "video.mp4")
+	assert.NoError(t, os.WriteFile(src, []byte("0123456789"), 0644))
+	videoPath := "/vods/test/123/123-video.mp4"
+	assert.NoError(t, backend.MoveFile(ctx, src, videoPath))
+	assert.NoFileExists(t, src)
+	assert.Contains(t, fake.objects, "archive/test/123/123-video.mp4")
+	exists, err := backend.Exists(ctx, videoPath)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	// requests are signed for the configured region
+	date := time.Now().UTC().Format("20060102")
+	for _, credential := range fake.credentials {
+		assert.Equal(t, fmt.Sprintf("access/%s/eu-west-1/s3/aws4_request", date), credential)
+	}
+
+	dbChannel, err := client.Channel.Create().SetName("test").SetDisplayName("test").SetImagePath("/vods/test/profile.png").Save(ctx)
+	assert.NoError(t, err)
+	v, err := client.Vod.Create().SetChannel(dbChannel).SetExtID("123").SetTitle("test").SetType(utils.Archive).SetPlatform(utils.PlatformTwitch).SetStorageBackend(utils.StorageS3).SetVideoPath(videoPath).SetThumbnailPath("/vods/test/123/123-thumbnail.jpg").SetWebThumbnailPath("/vods/test/123/123-web_thumbnail.jpg").Save(ctx)
+	assert.NoError(t, err)
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			VodService: vod.NewService(&database.Database{Client: client}),
+		},
+	}
+
+	// the video is played through the storage route, other files are still on the local disk
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/vod/"+v.ID.String(), nil)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(v.ID.String())
+	if assert.NoError(t, h.GetVod(c)) {
+		var response map[string]interface{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, fmt.Sprintf("/api/v1/vod/%s/storage/123-video.mp4", v.ID), response["video_path"])
+		assert.Equal(t, "/vods/test/123/123-thumbnail.jpg", response["thumbnail_path"])
+	}
+
+	getFile := func(rangeHeader string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/vod/%s/storage/123-video.mp4", v.ID), nil)
+		if rangeHeader != "" {
+			req.Header.Set("Range", rangeHeader)
+		}
+		rec := httptest.NewRecorder()
+		c := h.Server.NewContext(req, rec)
+		c.SetParamNames("id", "*")
+		c.SetParamValues(v.ID.String(), "123-video.mp4")
+		assert.NoError(t, h.GetVodFile(c))
+		return rec
+	}
+
+	// proxied playback supports ranges for seeking
+	rec = getFile("bytes=2-5")
+	assert.Equal(t, http.StatusPartialContent, rec.Code)
+	assert.Equal(t, "2345", rec.Body.String())
+
+	// presigned playback redirects to the object
+	viper.Set("storage.s3.playback", storage.S3PlaybackPresign)
+	rec = getFile("")
+	assert.Equal(t, http.StatusTemporaryRedirect, rec.Code)
+	location, err := url.Parse(rec.Header().Get("Location"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "/ganymede/archive/test/123/123-video.mp4", location.Path)
+		assert.Equal(t, "AWS4-HMAC-SHA256", location.Query().Get("X-Amz-Algorithm"))
+		assert.Equal(t, fmt.Sprintf("access/%s/eu-west-1/s3/aws4_request", date), location.Query().Get("X-Amz-Credential"))
+		assert.Equal(t, "3600", location.Query().Get("X-Amz-Expires"))
+		assert.Len(t, location.Query().Get("X-Amz-Signature"), 64)
+
+		resp, err := http.Get(location.String())
+		if assert.NoError(t, err) {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			assert.Equal(t, "0123456789", string(body))
+		}
+	}
+
+	// paths outside the folder of the video are rejected
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	c = h.Server.NewContext(req, httptest.NewRecorder())
+	c.SetParamNames("id", "*")
+	c.SetParamValues(v.ID.String(), "../456/456-video.mp4")
+	assert.Error(t, h.GetVodFile(c))
+
+	// rename and delete folders
+	assert.NoError(t, backend.Rename(ctx, "/vods/test/123", "/vods/test/456"))
+	exists, err = backend.Exists(ctx, videoPath)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = backend.Exists(ctx, "/vods/test/456/123-video.mp4")
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	assert.NoError(t, backend.DeleteFolder(ctx, "/vods/test/456"))
+	assert.Empty(t, fake.objects)
+}
@@ -1,17 +1,29 @@
 package http
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/internal/task"
 )
 
 type TaskService interface {
 	StartTask(c echo.Context, task string) error
+	GetHealthReport(ctx context.Context) (*task.HealthReport, error)
+	VerifyVod(ctx context.Context, id uuid.UUID) (*ent.Vod, error)
+	StartImport(opts task.ImportOptions) (*task.ImportReport, error)
+	GetImportReport() (*task.ImportReport, error)
+	StartStorageMigration(ctx context.Context, dryRun bool) (*task.MigrationJournal, error)
+	RollbackStorageMigration(ctx context.Context) (*task.MigrationJournal, error)
+	GetStorageMigration() (*task.MigrationJournal, error)
+	PreviewPrune(ctx context.Context) (*task.PruneReport, error)
 }
 
 type StartTaskRequest struct {
-	Task string `json:"task" validate:"required,oneof=check_live check_vod get_jwks twitch_auth storage_migration prune_videos"`
+	Task string `json:"task" validate:"required,oneof=check_live check_vod get_jwks twitch_auth storage_migration prune_videos tier_videos import_chat verify_videos purge_trash"`
 }
 
 // StartTask godoc
@@ -39,3 +51,185 @@ func (h *Handler) StartTask(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusOK)
 }
+
+// GetHealthReport godoc
+//
+//	@Summary		Get video health report
+//	@Description	Get the number of videos by the result of their last integrity verification and the unhealthy videos
+//	@Tags			vods
+//	@Produce		json
+//	@Success		200	{object}	task.HealthReport
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/health [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetHealthReport(c echo.Context) error {
+	report, err := h.Service.TaskService.GetHealthReport(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// VerifyVod godoc
+//
+//	@Summary		Verify vod
+//	@Description	Verify that the files of a vod exist and that the video is playable
+//	@Tags			vods
+//	@Produce		json
+//	@Param			id	path		string	true	"Vod id"
+//	@Success		200	{object}	ent.Vod
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/verify [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) VerifyVod(c echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid id")
+	}
+	v, err := h.Service.TaskService.VerifyVod(c.Request().Context(), id)
+	if err != nil {
+		switch err.Error() {
+		case "vod not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "vod is processing":
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, v)
+}
+
+type StartImportRequest struct {
+	Path   string `json:"path" validate:"required"`
+	Mode   string `json:"mode" validate:"omitempty,oneof=move hardlink"`
+	DryRun bool   `json:"dry_run"`
+}
+
+// StartImport godoc
+//
+//	@Summary		Import existing archives
+//	@Description	Import videos archived outside of Ganymede from a directory. Files are matched to Twitch videos by their name or metadata. Imports run in the background, dry runs only report what would be imported. The report is read with GET /task/import.
+//	@Tags			task
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		StartImportRequest	true	"StartImportRequest"
+//	@Success		200		{object}	task.ImportReport
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Router			/task/import [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) StartImport(c echo.Context) error {
+	sir := new(StartImportRequest)
+	if err := c.Bind(sir); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := c.Validate(sir); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	report, err := h.Service.TaskService.StartImport(task.ImportOptions{Path: sir.Path, Mode: sir.Mode, DryRun: sir.DryRun})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// GetImportReport godoc
+//
+//	@Summary		Get import report
+//	@Description	Get the report of the last import with the imported, skipped and unmatched files
+//	@Tags			task
+//	@Produce		json
+//	@Success		200	{object}	task.ImportReport
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Router			/task/import [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetImportReport(c echo.Context) error {
+	report, err := h.Service.TaskService.GetImportReport()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+type StartStorageMigrationRequest struct {
+	DryRun bool `json:"dry_run"`
+}
+
+// StartStorageMigration godoc
+//
+//	@Summary		Start storage migration
+//	@Description	Rename the files and folders of all videos to the current storage templates. An interrupted migration is resumed. Dry runs return the planned renames and collisions without changing anything.
+//	@Tags			task
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		StartStorageMigrationRequest	true	"StartStorageMigrationRequest"
+//	@Success		200		{object}	task.MigrationJournal
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Router			/task/storage-migration [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) StartStorageMigration(c echo.Context) error {
+	ssr := new(StartStorageMigrationRequest)
+	if err := c.Bind(ssr); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	journal, err := h.Service.TaskService.StartStorageMigration(c.Request().Context(), ssr.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusOK, journal)
+}
+
+// RollbackStorageMigration godoc
+//
+//	@Summary		Roll back storage migration
+//	@Description	Restore the files and paths of the videos migrated by the last storage migration
+//	@Tags			task
+//	@Produce		json
+//	@Success		200	{object}	task.MigrationJournal
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Router			/task/storage-migration/rollback [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) RollbackStorageMigration(c echo.Context) error {
+	journal, err := h.Service.TaskService.RollbackStorageMigration(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return c.JSON(http.StatusOK, journal)
+}
+
+// GetStorageMigration godoc
+//
+//	@Summary		Get storage migration
+//	@Description	Get the progress and journal of the running or last storage migration
+//	@Tags			task
+//	@Produce		json
+//	@Success		200	{object}	task.MigrationJournal
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Router			/task/storage-migration [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetStorageMigration(c echo.Context) error {
+	journal, err := h.Service.TaskService.GetStorageMigration()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	return c.JSON(http.StatusOK, journal)
+}
+
+// PreviewPrune godoc
+//
+//	@Summary		Preview prune
+//	@Description	Get the videos the next prune would prune by the retention policies of the channels and the space it would free
+//	@Tags			task
+//	@Produce		json
+//	@Success		200	{object}	task.PruneReport
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/task/prune-videos/preview [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) PreviewPrune(c echo.Context) error {
+	report, err := h.Service.TaskService.PreviewPrune(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,208 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/enttest"
+	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/task"
+	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
+	"github.com/zibbp/ganymede/internal/utils"
+)
+
+// * TestStartStorageMigration tests the StartStorageMigration and GetStorageMigration functions
+// Plans a storage migration in a dry run and reports videos migrated to the same folder as collisions
+func TestStartStorageMigration(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			TaskService: task.NewService(&database.Database{Client: client}, nil, nil),
+		},
+	}
+
+	viper.Set("storage_templates.folder_template", "{{id}}")
+	viper.Set("storage_templates.file_template", "{{id}}")
+	viper.Set("storage_templates.migration_journal_path", filepath.Join(t.TempDir(), "storage-migration.json"))
+
+	// Create a channel
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a vod and two vods with the same id that would be migrated to the same folder
+	streamedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	dbVod, err := client.Vod.Create().SetTitle("test vod").SetExtID("123").SetWebThumbnailPath("/vods/test_channel/old-123/old-web_thumbnail.jpg").SetVideoPath("/vods/test_channel/old-123/old-video.mp4").SetChatPath("/vods/test_channel/old-123/old-chat.json").SetCaptionPath("/vods/test_channel/old-123/old-caption.vtt").SetStreamedAt(streamedAt).SetChannel(dbChannel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Create a vod with an hls playlist
+	hlsVod, err := client.Vod.Create().SetTitle("hls vod").SetExtID("789").SetWebThumbnailPath("/vods/test_channel/old-789/old-web_thumbnail.jpg").SetVideoHlsPath("/vods/test_channel/old-789/old-video_hls").SetVideoPath("/vods/test_channel/old-789/old-video_hls/789-video.m3u8").SetStreamedAt(streamedAt).SetChannel(dbChannel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err := client.Vod.Create().SetTitle("duplicate vod").SetExtID("456").SetWebThumbnailPath("/vods/test_channel/old-456/old-web_thumbnail.jpg").SetVideoPath("/vods/test_channel/old-456/old-video.mp4").SetStreamedAt(streamedAt).SetChannel(dbChannel).Save(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/task/storage-migration", strings.NewReader(`{"dry_run":true}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.StartStorageMigration(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response task.MigrationJournal
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.True(t, response.DryRun)
+		assert.Equal(t, 2, response.Total)
+		assert.Equal(t, 2, response.Collisions)
+		for _, video := range response.Videos {
+			if video.VodID == hlsVod.ID {
+				assert.Equal(t, task.MigrationVideoPending, video.Status)
+				assert.Equal(t, "/vods/test_channel/789/789-video_hls", video.NewPaths.VideoHlsPath)
+				assert.Equal(t, "/vods/test_channel/789/789-video_hls/789-video.m3u8", video.NewPaths.VideoPath)
+				continue
+			}
+			if video.VodID != dbVod.ID {
+				assert.Equal(t, task.MigrationVideoCollision, video.Status)
+				continue
+			}
+			assert.Equal(t, task.MigrationVideoPending, video.Status)
+			assert.Equal(t, "/vods/test_channel/old-123/old-video.mp4", video.OldPaths.VideoPath)
+			assert.Equal(t, "/vods/test_channel/123/123-video.mp4", video.NewPaths.VideoPath)
+			assert.Equal(t, "/vods/test_channel/123/123-chat.json", video.NewPaths.ChatPath)
+			assert.Equal(t, "/vods/test_channel/123/123-caption.vtt", video.NewPaths.CaptionPath)
+		}
+	}
+
+	// the paths are unchanged and no journal is saved in a dry run
+	dbVod, err = client.Vod.Get(context.Background(), dbVod.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "/vods/test_channel/old-123/old-video.mp4", dbVod.VideoPath)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/task/storage-migration", nil)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+
+	err = h.GetStorageMigration(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+	}
+}
+
+// * TestPreviewPrune tests the PreviewPrune function
+// Previews the videos pruned by the retention policy of a channel and skips protected videos
+func TestPreviewPrune(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			TaskService: task.NewService(&database.Database{Client: client}, nil, nil),
+		},
+	}
+
+	// Create a channel keeping the 2 newest videos and highlights for 7 days
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").
+		SetRetention(true).SetRetentionKeepLast(2).SetRetentionTypeDays(map[utils.VodType]int64{utils.Highlight: 7}).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	createVod := func(title string, vodType utils.VodType, age int, size int) *ent.Vod {
+		videoPath := filepath.Join(dir, title+"-video.mp4")
+		if err := os.WriteFile(videoPath, make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+		v, err := client.Vod.Create().SetTitle(title).SetExtID(title).SetType(vodType).SetWebThumbnailPath("").SetVideoPath(videoPath).SetCreatedAt(time.Now().Add(-time.Duration(age) * 24 * time.Hour)).SetChannel(dbChannel).Save(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	createVod("newest", utils.Archive, 0, 10)
+	highlight := createVod("highlight", utils.Highlight, 10, 50)
+	inPlaylist := createVod("playlist", utils.Archive, 20, 10)
+	old := createVod("old", utils.Archive, 30, 100)
+	locked := createVod("locked", utils.Archive, 40, 10)
+	if _, err := client.Playlist.Create().SetName("test playlist").AddVods(inPlaylist).Save(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := locked.Update().SetLocked(true).Save(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// a video in the trash uses space until the trash is purged
+	trashPath := filepath.Join(dir, "trash")
+	if err := os.MkdirAll(trashPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(trashPath, "trashed-video.mp4"), make([]byte, 20), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Vod.Create().SetTitle("trashed").SetExtID("trashed").SetType(utils.Archive).SetWebThumbnailPath("").SetVideoPath("").SetTrashPath(trashPath).SetDeletedAt(time.Now()).SetChannel(dbChannel).Save(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	viper.Set("trash.retention_days", 30)
+	t.Cleanup(func() {
+		viper.Set("trash.retention_days", 0)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/task/prune-videos/preview", nil)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.PreviewPrune(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response task.PruneReport
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, response.Videos)
+		assert.Equal(t, int64(150), response.FreedBytes)
+		if assert.Len(t, response.Channels, 1) && assert.Len(t, response.Channels[0].Videos, 2) {
+			assert.Equal(t, int64(200), response.Channels[0].UsedBytes)
+			assert.Equal(t, int64(20), response.Channels[0].TrashedBytes)
+			assert.NotNil(t, response.Channels[0].FreedAt)
+			assert.Equal(t, highlight.ID, response.Channels[0].Videos[0].VodID)
+			assert.Equal(t, "archived more than 7 days ago", response.Channels[0].Videos[0].Reason)
+			assert.Equal(t, old.ID, response.Channels[0].Videos[1].VodID)
+			assert.Equal(t, "not in the 2 newest videos", response.Channels[0].Videos[1].Reason)
+		}
+	}
+
+	// nothing is deleted by the preview
+	count, err := client.Vod.Query().Count(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 5, count)
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"fmt"
 	"net/http"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/internal/chat"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/utils"
 	"github.com/zibbp/ganymede/internal/vod"
 )
@@ -21,6 +23,9 @@ type VodService interface {
 	GetVodsByChannel(c echo.Context, cUUID uuid.UUID) ([]*ent.Vod, error)
 	GetVod(vID uuid.UUID, withChannel bool, withChapters bool, withMutedSegments bool) (*ent.Vod, error)
 	DeleteVod(c echo.Context, vID uuid.UUID, deleteFiles bool) error
+	TrashVod(c echo.Context, vID uuid.UUID, deleteFiles bool, force bool) error
+	RestoreVod(c echo.Context, vID uuid.UUID) error
+	GetTrashedVods(c echo.Context) ([]*ent.Vod, error)
 	UpdateVod(c echo.Context, vID uuid.UUID, vod vod.Vod, cID uuid.UUID) (*ent.Vod, error)
 	SearchVods(c echo.Context, query string, limit int, offset int) (vod.Pagination, error)
 	GetVodPlaylists(c echo.Context, vID uuid.UUID) ([]*ent.Playlist, error)
@@ -31,6 +36,8 @@ type VodService interface {
 	GetVodChatBadges(c echo.Context, vodID uuid.UUID) (*chat.GanymedeBadges, error)
 	GetNumberOfVodChatCommentsFromTime(c echo.Context, vodID uuid.UUID, start float64, commentCount int64) (*[]chat.Comment, error)
 	LockVod(c echo.Context, vID uuid.UUID, status bool) error
+	SearchVodChat(c echo.Context, vodID uuid.UUID, params vod.ChatSearchParams) (vod.ChatSearchPagination, error)
+	SearchChat(c echo.Context, params vod.ChatSearchParams) (vod.ChatSearchPagination, error)
 }
 
 type CreateVodRequest struct {
@@ -147,7 +154,7 @@ func (h *Handler) GetVods(c echo.Context) error {
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 		}
-		return c.JSON(http.StatusOK, v)
+		return c.JSON(http.StatusOK, storage.PlaybackVods(v))
 	}
 	cUUID, err := uuid.Parse(cID)
 	if err != nil {
@@ -157,7 +164,7 @@ func (h *Handler) GetVods(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
-	return c.JSON(http.StatusOK, v)
+	return c.JSON(http.StatusOK, storage.PlaybackVods(v))
 }
 
 // GetVod godoc
@@ -208,21 +215,24 @@ func (h *Handler) GetVod(c echo.Context) error {
 		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
-	return c.JSON(http.StatusOK, v)
+	return c.JSON(http.StatusOK, storage.PlaybackVod(v))
 }
 
 // DeleteVod godoc
 //
 //	@Summary		Delete a vod
-//	@Description	Delete a vod
+//	@Description	Move a vod to the trash, or delete it permanently
 //	@Tags			vods
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path	string	true	"Vod ID"
-//	@Param			delete_files	query	string	false	"Delete files"
+//	@Param			id				path	string	true	"Vod ID"
+//	@Param			delete_files	query	string	false	"Delete files, they are moved to the trash unless the vod is deleted permanently"
+//	@Param			force			query	string	false	"Move a locked vod or a vod in a playlist to the trash"
+//	@Param			permanent		query	string	false	"Delete the vod permanently"
 //	@Success		200
 //	@Failure		400	{object}	utils.ErrorResponse
 //	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
 //	@Failure		500	{object}	utils.ErrorResponse
 //	@Router			/vod/{id} [delete]
 //	@Security		ApiKeyCookieAuth
@@ -237,10 +247,64 @@ func (h *Handler) DeleteVod(c echo.Context) error {
 	if dF == "true" {
 		deleteFiles = true
 	}
-	err = h.Service.VodService.DeleteVod(c, vID, deleteFiles)
+	if c.QueryParam("permanent") == "true" {
+		err = h.Service.VodService.DeleteVod(c, vID, deleteFiles)
+	} else {
+		err = h.Service.VodService.TrashVod(c, vID, deleteFiles, c.QueryParam("force") == "true")
+	}
 	if err != nil {
-		if err.Error() == "vod not found" {
+		switch err.Error() {
+		case "vod not found":
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "vod is locked", "vod is in a playlist":
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// GetTrashedVods godoc
+//
+//	@Summary		Get trashed vods
+//	@Description	Get the vods in the trash, most recently deleted first
+//	@Tags			vods
+//	@Produce		json
+//	@Success		200	{object}	[]ent.Vod
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/trash [get]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) GetTrashedVods(c echo.Context) error {
+	vods, err := h.Service.VodService.GetTrashedVods(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, vods)
+}
+
+// RestoreVod godoc
+//
+//	@Summary		Restore a vod
+//	@Description	Restore a vod from the trash, its files are moved back to their folder
+//	@Tags			vods
+//	@Param			id	path	string	true	"Vod ID"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/restore [post]
+//	@Security		ApiKeyCookieAuth
+func (h *Handler) RestoreVod(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := h.Service.VodService.RestoreVod(c, vID); err != nil {
+		switch err.Error() {
+		case "vod not found":
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case "channel is in the trash":
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -344,6 +408,7 @@ func (h *Handler) SearchVods(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
+	v.Data = storage.PlaybackVods(v.Data)
 	return c.JSON(http.StatusOK, v)
 }
 
@@ -418,6 +483,7 @@ func (h *Handler) GetVodsPagination(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
+	v.Data = storage.PlaybackVods(v.Data)
 	return c.JSON(http.StatusOK, v)
 }
 
@@ -459,6 +525,7 @@ func (h *Handler) GetUserIdFromChat(c echo.Context) error {
 //	@Success		200		{array}		[]chat.Comment
 //	@Failure		400		{object}	utils.ErrorResponse
 //	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		503		{object}	utils.ErrorResponse
 //	@Failure		500		{object}	utils.ErrorResponse
 //	@Router			/vod/{id}/chat [get]
 func (h *Handler) GetVodChatComments(c echo.Context) error {
@@ -480,6 +547,9 @@ func (h *Handler) GetVodChatComments(c echo.Context) error {
 
 	v, err := h.Service.VodService.GetVodChatComments(c, vID, startFloat, endFloat)
 	if err != nil {
+		if err.Error() == "chat is being imported" {
+			return chatImportingError(c, err)
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	return c.JSON(http.StatusOK, v)
@@ -552,6 +622,7 @@ func (h *Handler) GetVodChatBadges(c echo.Context) error {
 //	@Success		200		{object}	[]chat.Comment
 //	@Failure		400		{object}	utils.ErrorResponse
 //	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		503		{object}	utils.ErrorResponse
 //	@Failure		500		{object}	utils.ErrorResponse
 //	@Router			/vod/{id}/chat/seek [get]
 func (h *Handler) GetNumberOfVodChatCommentsFromTime(c echo.Context) error {
@@ -576,6 +647,9 @@ func (h *Handler) GetNumberOfVodChatCommentsFromTime(c echo.Context) error {
 
 	v, err := h.Service.VodService.GetNumberOfVodChatCommentsFromTime(c, vID, startFloat, int64(countInt))
 	if err != nil {
+		if err.Error() == "chat is being imported" {
+			return chatImportingError(c, err)
+		}
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 	return c.JSON(http.StatusOK, v)
@@ -597,3 +671,43 @@ func (h *Handler) LockVod(c echo.Context) error {
 	}
 	return c.JSON(http.StatusOK, nil)
 }
+
+// GetVodFile godoc
+//
+//	@Summary		Get a vod file
+//	@Description	Get a media file of a vod from its storage backend. The path is relative to the folder of the vod.
+//	@Description	Files in object storage are redirected to a presigned url or proxied depending on the storage config.
+//	@Tags			vods
+//	@Param			id		path	string	true	"Vod ID"
+//	@Param			path	path	string	true	"File path"
+//	@Success		200
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		500	{object}	utils.ErrorResponse
+//	@Router			/vod/{id}/storage/{path} [get]
+func (h *Handler) GetVodFile(c echo.Context) error {
+	vID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	v, err := h.Service.VodService.GetVod(vID, false, false, false)
+	if err != nil {
+		if err.Error() == "vod not found" {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// restrict the path to the folder of the vod
+	root := storage.VodFolder(v)
+	filePath := path.Join(root, c.Param("*"))
+	if !strings.HasPrefix(filePath, root+"/") {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid path")
+	}
+
+	backend, err := storage.ForVod(v)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return backend.Serve(c, filePath)
+}
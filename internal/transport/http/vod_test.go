@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -16,6 +19,7 @@ import (
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/enttest"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/task"
 	httpHandler "github.com/zibbp/ganymede/internal/transport/http"
 	"github.com/zibbp/ganymede/internal/utils"
 	"github.com/zibbp/ganymede/internal/vod"
@@ -179,6 +183,178 @@ func TestGetVod(t *testing.T) {
 	}
 }
 
+// * TestGetVodFile tests the GetVodFile function
+// Serves a file of a vod stored on the local disk and rejects paths outside of the vod folder
+func TestGetVodFile(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			VodService: vod.NewService(&database.Database{Client: client}),
+		},
+	}
+
+	// Create video file
+	dir := t.TempDir()
+	videoPath := filepath.Join(dir, "123456789-video.mp4")
+	err := os.WriteFile(videoPath, []byte("video"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a channel
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a vod
+	dbVod, err := client.Vod.Create().SetChannel(dbChannel).SetExtID("123456789").SetPlatform("twitch").SetType("archive").SetTitle("Test Vod").SetWebThumbnailPath(filepath.Join(dir, "123456789-web_thumbnail.jpg")).SetVideoPath(videoPath).SetStreamedAt(time.Now()).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/vod/%s/storage/123456789-video.mp4", dbVod.ID.String()), nil)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+	c.SetParamNames("id", "*")
+	c.SetParamValues(dbVod.ID.String(), "123456789-video.mp4")
+
+	if assert.NoError(t, h.GetVodFile(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "video", rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/vod/%s/storage/../secret", dbVod.ID.String()), nil)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+	c.SetParamNames("id", "*")
+	c.SetParamValues(dbVod.ID.String(), "../secret")
+
+	err = h.GetVodFile(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
+
+// * TestGetVodChatComments tests the GetVodChatComments function
+// Imports the chat file of a vod and gets the comments in a time range
+func TestGetVodChatComments(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			VodService: vod.NewService(&database.Database{Client: client}),
+		},
+	}
+
+	// Create chat file
+	chatPath := filepath.Join(t.TempDir(), "123456789-chat.json")
+	chatJson := `{"streamer": {"name": "test_channel", "id": 1}, "comments": [
+		{"_id": "c", "content_offset_seconds": 30, "commenter": {"name": "user"}, "message": {"body": "third"}},
+		{"_id": "a", "content_offset_seconds": 10, "commenter": {"name": "user"}, "message": {"body": "first"}},
+		{"_id": "b", "content_offset_seconds": 20, "commenter": {"name": "user"}, "message": {"body": "second"}}
+	], "emotes": {"thirdParty": [], "firstParty": []}}`
+	err := os.WriteFile(chatPath, []byte(chatJson), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a channel
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a vod
+	dbVod, err := client.Vod.Create().SetChannel(dbChannel).SetExtID("123456789").SetPlatform("twitch").SetType("archive").SetTitle("Test Vod").SetWebThumbnailPath("/vods/test_channel/123456789/123456789-web_thumbnail.jpg").SetVideoPath("/vods/test_channel/123456789/123456789-video.mp4").SetChatPath(chatPath).SetStreamedAt(time.Now()).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first request imports the chat in the background
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/vod/%s/chat?start=15&end=40", dbVod.ID.String()), nil)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(dbVod.ID.String())
+
+	err = h.GetVodChatComments(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusServiceUnavailable, err.(*echo.HTTPError).Code)
+		assert.Equal(t, "10", rec.Header().Get("Retry-After"))
+	}
+	assert.Eventually(t, func() bool {
+		v, err := client.Vod.Get(context.Background(), dbVod.ID)
+		return err == nil && v.ChatImportedAt != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/vod/%s/chat?start=15&end=40", dbVod.ID.String()), nil)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(dbVod.ID.String())
+
+	if assert.NoError(t, h.GetVodChatComments(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response []map[string]interface{}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		if assert.Len(t, response, 2) {
+			assert.Equal(t, "b", response[0]["_id"])
+			assert.Equal(t, "c", response[1]["_id"])
+		}
+	}
+
+	// The streamer is read from the header of the chat file
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/vod/%s/chat/userid", dbVod.ID.String()), nil)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(dbVod.ID.String())
+
+	if assert.NoError(t, h.GetUserIdFromChat(c)) {
+		assert.Equal(t, "1", strings.TrimSpace(rec.Body.String()))
+	}
+
+	// The chat is imported so the file is no longer read
+	err = os.Remove(chatPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/vod/%s/chat/seek?start=25&count=5", dbVod.ID.String()), nil)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(dbVod.ID.String())
+
+	if assert.NoError(t, h.GetNumberOfVodChatCommentsFromTime(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response []map[string]interface{}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		if assert.Len(t, response, 2) {
+			assert.Equal(t, "a", response[0]["_id"])
+			assert.Equal(t, "b", response[1]["_id"])
+		}
+	}
+}
+
 // * TestDeleteVod tests the DeleteVod function
 // Deletes a vod
 func TestDeleteVod(t *testing.T) {
@@ -227,6 +403,88 @@ func TestDeleteVod(t *testing.T) {
 	}
 }
 
+// * TestTrashVod tests the DeleteVod, GetTrashedVods and RestoreVod functions
+// Moves a locked vod to the trash, which hides it from the vods, and restores it
+func TestTrashVod(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			VodService: vod.NewService(&database.Database{Client: client}),
+		},
+	}
+
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	// Create a channel with a locked vod
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbVod, err := client.Vod.Create().SetChannel(dbChannel).SetExtID("123456789").SetTitle("Test Vod").SetWebThumbnailPath("").SetVideoPath("").SetLocked(true).SetStreamedAt(time.Now()).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deleteVod := func(query string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/api/v1/vod/%s?%s", dbVod.ID, query), nil)
+		rec := httptest.NewRecorder()
+		c := h.Server.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(dbVod.ID.String())
+		return rec, h.DeleteVod(c)
+	}
+
+	// Locked vods are only moved to the trash when forced
+	_, err = deleteVod("")
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusConflict, err.(*echo.HTTPError).Code)
+	}
+	rec, err := deleteVod("force=true")
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	// The vod is hidden from the vods and listed in the trash
+	count, err := client.Vod.Query().Count(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/vod/trash", nil)
+	rec = httptest.NewRecorder()
+	if assert.NoError(t, h.GetTrashedVods(h.Server.NewContext(req, rec))) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var vods []ent.Vod
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &vods))
+		if assert.Len(t, vods, 1) {
+			assert.Equal(t, dbVod.ID, vods[0].ID)
+			assert.NotNil(t, vods[0].DeletedAt)
+		}
+	}
+
+	// Restore the vod
+	req = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v1/vod/%s/restore", dbVod.ID), nil)
+	rec = httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(dbVod.ID.String())
+
+	if assert.NoError(t, h.RestoreVod(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		v, err := client.Vod.Get(context.Background(), dbVod.ID)
+		assert.NoError(t, err)
+		assert.Nil(t, v.DeletedAt)
+	}
+}
+
 // * TestUpdateVod tests the UpdateVod function
 // Updates a vod
 func TestUpdateVod(t *testing.T) {
@@ -462,3 +720,183 @@ func TestGetVodsPagination(t *testing.T) {
 
 	}
 }
+
+// * TestVerifyVod tests the VerifyVod and GetHealthReport functions
+// Verifies a vod with a missing video file and reports it as unhealthy
+func TestVerifyVod(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			TaskService: task.NewService(&database.Database{Client: client}, nil, nil),
+		},
+	}
+
+	// Create a channel
+	dbChannel, err := client.Channel.Create().SetName("test_channel").SetDisplayName("Test Channel").SetImagePath("/vods/test_channel/test_channel.jpg").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create a vod whose thumbnail exists but whose video is missing
+	dir := t.TempDir()
+	thumbnailPath := filepath.Join(dir, "thumbnail.jpg")
+	if err := os.WriteFile(thumbnailPath, []byte("jpg"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	videoPath := filepath.Join(dir, "video.mp4")
+	dbVod, err := client.Vod.Create().SetTitle("test vod").SetExtID("123").SetWebThumbnailPath(thumbnailPath).SetVideoPath(videoPath).SetChannel(dbChannel).Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, utils.HealthUnverified, dbVod.Health)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/vod/"+dbVod.ID.String()+"/verify", nil)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+	c.SetPath("/api/v1/vod/:id/verify")
+	c.SetParamNames("id")
+	c.SetParamValues(dbVod.ID.String())
+
+	if assert.NoError(t, h.VerifyVod(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response ent.Vod
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, utils.HealthUnhealthy, response.Health)
+		assert.Equal(t, []string{fmt.Sprintf("video file %s is missing", videoPath)}, response.HealthIssues)
+		assert.NotNil(t, response.VerifiedAt)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/vod/health", nil)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+
+	if assert.NoError(t, h.GetHealthReport(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response task.HealthReport
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, response.Total)
+		assert.Equal(t, 1, response.Unhealthy)
+		if assert.Len(t, response.Videos, 1) {
+			assert.Equal(t, dbVod.ID, response.Videos[0].ID)
+		}
+	}
+}
+
+// * TestStartImport tests the StartImport function
+// Matches a video and its chat in a dry run and reports videos without a confirmed video id as unmatched
+func TestStartImport(t *testing.T) {
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+	}
+
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", opts...)
+	defer client.Close()
+
+	h := &httpHandler.Handler{
+		Server: echo.New(),
+		Service: httpHandler.Services{
+			TaskService: task.NewService(&database.Database{Client: client}, nil, nil),
+		},
+	}
+	h.Server.Validator = &utils.CustomValidator{Validator: validator.New()}
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "stream"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "other"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	videoPath := filepath.Join(dir, "stream", "video.mp4")
+	chatPath := filepath.Join(dir, "stream", "chat.json")
+	localizedVideoPath := filepath.Join(dir, "localized", "video.mp4")
+	localizedChatPath := filepath.Join(dir, "localized", "chat.json")
+	unmatchedPath := filepath.Join(dir, "other", "recording.mkv")
+	// a unix timestamp is not a video id
+	timestampPath := filepath.Join(dir, "other", "1705312345.mp4")
+	if err := os.MkdirAll(filepath.Join(dir, "localized"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		videoPath:          "video",
+		chatPath:           `{"streamer":{"name":"Test_Channel","id":1},"video":{"id":"1234567890","title":"test vod","created_at":"2023-01-02T03:04:05Z","length":3600},"comments":[{"content_id":"1234567890"}]}`,
+		localizedVideoPath: "video",
+		localizedChatPath:  `{"streamer":{"name":"テスト","id":2},"video":{"id":"1234567891","title":"localized vod","created_at":"2023-01-02T03:04:05Z","length":3600},"comments":[]}`,
+		unmatchedPath:      "video",
+		timestampPath:      "video",
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	body := fmt.Sprintf(`{"path":"%s","mode":"move","dry_run":true}`, dir)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/task/import", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := h.Server.NewContext(req, rec)
+
+	// the channel of the localized display name is matched on its id
+	_, err := client.Channel.Create().SetExtID("2").SetName("localized_channel").SetDisplayName("テスト").SetImagePath("/vods/localized_channel/profile.png").Save(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.NoError(t, h.StartImport(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.True(t, strings.Contains(rec.Body.String(), `"running":true`))
+
+		// dry runs run in the background
+		var response *task.ImportReport
+		assert.Eventually(t, func() bool {
+			response, err = h.Service.TaskService.GetImportReport()
+			return err == nil && !response.Running
+		}, 10*time.Second, 50*time.Millisecond)
+		assert.True(t, response.DryRun)
+		sort.Slice(response.Imported, func(i, j int) bool { return response.Imported[i].ExtID < response.Imported[j].ExtID })
+		if assert.Len(t, response.Imported, 2) {
+			assert.Equal(t, "1234567890", response.Imported[0].ExtID)
+			assert.Equal(t, "test_channel", response.Imported[0].Channel)
+			assert.Equal(t, "test vod", response.Imported[0].Title)
+			assert.Equal(t, "files", response.Imported[0].Metadata)
+			assert.ElementsMatch(t, []string{videoPath, chatPath}, response.Imported[0].Files)
+			assert.Nil(t, response.Imported[0].VodID)
+			assert.Equal(t, "localized_channel", response.Imported[1].Channel)
+		}
+		unmatched := map[string]string{}
+		for _, issue := range response.Unmatched {
+			unmatched[issue.Path] = issue.Reason
+		}
+		assert.Len(t, unmatched, 2)
+		assert.Contains(t, unmatched, unmatchedPath)
+		assert.Contains(t, unmatched[timestampPath], "not a known twitch video id")
+	}
+
+	// nothing is moved in a dry run
+	assert.FileExists(t, videoPath)
+	count, err := client.Vod.Query().Count(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/task/import", strings.NewReader(`{"path":"/does/not/exist"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c = h.Server.NewContext(req, rec)
+
+	err = h.StartImport(c)
+	if assert.Error(t, err) {
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+}
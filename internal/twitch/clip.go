@@ -0,0 +1,136 @@
+package twitch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/rs/zerolog/log"
+)
+
+type ClipResponse struct {
+	Data       []Clip     `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type Clip struct {
+	ID              string  `json:"id"`
+	URL             string  `json:"url"`
+	EmbedURL        string  `json:"embed_url"`
+	BroadcasterID   string  `json:"broadcaster_id"`
+	BroadcasterName string  `json:"broadcaster_name"`
+	CreatorID       string  `json:"creator_id"`
+	CreatorName     string  `json:"creator_name"`
+	VideoID         string  `json:"video_id"`
+	GameID          string  `json:"game_id"`
+	Language        string  `json:"language"`
+	Title           string  `json:"title"`
+	ViewCount       int64   `json:"view_count"`
+	CreatedAt       string  `json:"created_at"`
+	ThumbnailURL    string  `json:"thumbnail_url"`
+	Duration        float64 `json:"duration"`
+	VodOffset       int     `json:"vod_offset"`
+}
+
+func (s *Service) GetClipByID(id string) (Clip, error) {
+	log.Debug().Msgf("getting twitch clip by id: %s", id)
+
+	q := url.Values{}
+	q.Set("id", id)
+
+	clipResponse, err := clipsRequest(q)
+	if err != nil {
+		return Clip{}, err
+	}
+
+	// Check if clip is populated
+	if len(clipResponse.Data) == 0 {
+		return Clip{}, fmt.Errorf("clip not found")
+	}
+
+	return clipResponse.Data[0], nil
+}
+
+// GetClipsByUser returns the clips of a user created between startedAt and endedAt with at least minViews views.
+// Both times are required, Twitch ends the range a week after startedAt if endedAt is missing.
+func GetClipsByUser(userID string, startedAt string, endedAt string, minViews int) ([]Clip, error) {
+	log.Debug().Msgf("getting twitch clips for user: %s started at %s ended at %s", userID, startedAt, endedAt)
+
+	q := url.Values{}
+	q.Set("broadcaster_id", userID)
+	q.Set("started_at", startedAt)
+	q.Set("ended_at", endedAt)
+	q.Set("first", "100")
+
+	clipResponse, err := clipsRequest(q)
+	if err != nil {
+		return nil, err
+	}
+
+	var clips []Clip
+	done := appendClipsWithMinViews(&clips, clipResponse.Data, minViews)
+
+	// pagination
+	// clips are ordered by view count so stop once a page drops below the minimum
+	cursor := clipResponse.Pagination.Cursor
+	for cursor != "" && !done {
+		q.Set("after", cursor)
+		response, err := clipsRequest(q)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get twitch clips: %v", err)
+		}
+		done = appendClipsWithMinViews(&clips, response.Data, minViews)
+		cursor = response.Pagination.Cursor
+	}
+
+	return clips, nil
+}
+
+// appendClipsWithMinViews appends clips with at least minViews views and reports if a clip below the minimum was found.
+func appendClipsWithMinViews(clips *[]Clip, page []Clip, minViews int) bool {
+	for _, clip := range page {
+		if clip.ViewCount < int64(minViews) {
+			return true
+		}
+		*clips = append(*clips, clip)
+	}
+	return false
+}
+
+func clipsRequest(q url.Values) (*ClipResponse, error) {
+	client := &http.Client{}
+	req, err := http.NewRequest("GET", "https://api.twitch.tv/helix/clips", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Client-ID", os.Getenv("TWITCH_CLIENT_ID"))
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", os.Getenv("TWITCH_ACCESS_TOKEN")))
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get twitch clips: %v", err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get twitch clips: %s", body)
+	}
+
+	var clipResponse ClipResponse
+	err = json.Unmarshal(body, &clipResponse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %v", err)
+	}
+
+	return &clipResponse, nil
+}
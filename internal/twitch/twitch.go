@@ -136,6 +136,7 @@ type Category struct {
 type twitchAPI struct{}
 type TwitchAPI interface {
 	GetUserByLogin(login string) (Channel, error)
+	GetUserByID(id string) (Channel, error)
 }
 
 var (
@@ -201,8 +202,21 @@ func Authenticate() error {
 }
 func (t *twitchAPI) GetUserByLogin(cName string) (Channel, error) {
 	log.Debug().Msgf("getting user by login: %s", cName)
+	q := url.Values{}
+	q.Set("login", cName)
+	return getUser(q)
+}
+
+func (t *twitchAPI) GetUserByID(id string) (Channel, error) {
+	log.Debug().Msgf("getting user by id: %s", id)
+	q := url.Values{}
+	q.Set("id", id)
+	return getUser(q)
+}
+
+func getUser(q url.Values) (Channel, error) {
 	client := &http.Client{}
-	req, err := http.NewRequest("GET", fmt.Sprintf("https://api.twitch.tv/helix/users?login=%s", cName), nil)
+	req, err := http.NewRequest("GET", fmt.Sprintf("https://api.twitch.tv/helix/users?%s", q.Encode()), nil)
 	if err != nil {
 		return Channel{}, fmt.Errorf("failed to create request: %v", err)
 	}
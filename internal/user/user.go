@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/user"
+	"github.com/zibbp/ganymede/internal/audit"
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/utils"
 )
@@ -45,17 +46,27 @@ func (s *Service) AdminGetUser(c echo.Context, uID uuid.UUID) (*ent.User, error)
 }
 
 func (s *Service) AdminUpdateUser(c echo.Context, uDto User) (*ent.User, error) {
+	before, err := s.Store.Client.User.Get(c.Request().Context(), uDto.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error updating user: %v", err)
+	}
 	u, err := s.Store.Client.User.UpdateOneID(uDto.ID).SetUsername(uDto.Username).SetRole(uDto.Role).Save(c.Request().Context())
 	if err != nil {
 		return nil, fmt.Errorf("error updating user: %v", err)
 	}
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditUserUpdate, EntityType: "user", EntityID: u.ID.String(), Before: before, After: u})
 	return u, nil
 }
 
 func (s *Service) AdminDeleteUser(c echo.Context, uID uuid.UUID) error {
-	err := s.Store.Client.User.DeleteOneID(uID).Exec(c.Request().Context())
+	u, err := s.Store.Client.User.Get(c.Request().Context(), uID)
+	if err != nil {
+		return fmt.Errorf("error deleting user: %v", err)
+	}
+	err = s.Store.Client.User.DeleteOneID(uID).Exec(c.Request().Context())
 	if err != nil {
 		return fmt.Errorf("error deleting user: %v", err)
 	}
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditUserDelete, EntityType: "user", EntityID: uID.String(), Details: map[string]interface{}{"username": u.Username, "role": u.Role}})
 	return nil
 }
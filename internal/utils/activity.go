@@ -6,3 +6,17 @@ type ArchiveTwitchLiveChatStartSignal struct {
 type ArchiveTwitchLiveChatContinueSignal struct {
 	Continue bool
 }
+
+// StopLiveVideoDownloadSignal stops the video download of a live archive, the archive continues with the recorded video.
+const StopLiveVideoDownloadSignal = "stop-live-video-download"
+
+// StopLiveChatDownloadSignal stops the chat download of a live archive.
+const StopLiveChatDownloadSignal = "stop-live-chat-download"
+
+// Progress is the progress of a task parsed from the output of the program running it. Activities send it with their heartbeats.
+type Progress struct {
+	Percent float64 `json:"percent"`         // percent complete, 0 if unknown
+	Bytes   int64   `json:"bytes,omitempty"` // bytes written
+	Speed   string  `json:"speed,omitempty"` // e.g. "5.20 MiB/s" for downloads or "1.5x" for conversions
+	ETA     int64   `json:"eta,omitempty"`   // estimated seconds remaining
+}
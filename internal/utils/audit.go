@@ -0,0 +1,7 @@
+package utils
+
+// AuditChange is the value of a field before and after an action recorded in the audit log.
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
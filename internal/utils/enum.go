@@ -50,14 +50,15 @@ func (VodType) Values() (kinds []string) {
 type TaskStatus string
 
 const (
-	Success TaskStatus = "success"
-	Running TaskStatus = "running"
-	Pending TaskStatus = "pending"
-	Failed  TaskStatus = "failed"
+	Success   TaskStatus = "success"
+	Running   TaskStatus = "running"
+	Pending   TaskStatus = "pending"
+	Failed    TaskStatus = "failed"
+	Cancelled TaskStatus = "cancelled"
 )
 
 func (TaskStatus) Values() (kinds []string) {
-	for _, s := range []TaskStatus{Success, Running, Pending, Failed} {
+	for _, s := range []TaskStatus{Success, Running, Pending, Failed, Cancelled} {
 		kinds = append(kinds, string(s))
 	}
 	return
@@ -94,3 +95,171 @@ func (PlaybackStatus) Values() (kinds []string) {
 	}
 	return
 }
+
+type LiveVodReplacement string
+
+const (
+	LiveVodReplacementNone     LiveVodReplacement = "none"
+	LiveVodReplacementReplace  LiveVodReplacement = "replace"
+	LiveVodReplacementKeepBoth LiveVodReplacement = "keep_both"
+)
+
+func (LiveVodReplacement) Values() (kinds []string) {
+	for _, s := range []LiveVodReplacement{LiveVodReplacementNone, LiveVodReplacementReplace, LiveVodReplacementKeepBoth} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+type ProxyPolicy string
+
+const (
+	ProxyPolicyDefault  ProxyPolicy = "default"
+	ProxyPolicyEnabled  ProxyPolicy = "enabled"
+	ProxyPolicyDisabled ProxyPolicy = "disabled"
+)
+
+func (ProxyPolicy) Values() (kinds []string) {
+	for _, s := range []ProxyPolicy{ProxyPolicyDefault, ProxyPolicyEnabled, ProxyPolicyDisabled} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+type StorageBackend string
+
+const (
+	StorageLocal StorageBackend = "local"
+	StorageS3    StorageBackend = "s3"
+)
+
+func (StorageBackend) Values() (kinds []string) {
+	for _, s := range []StorageBackend{StorageLocal, StorageS3} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+type NotificationProvider string
+
+const (
+	NotificationWebhook NotificationProvider = "webhook"
+	NotificationDiscord NotificationProvider = "discord"
+	NotificationApprise NotificationProvider = "apprise"
+	NotificationNtfy    NotificationProvider = "ntfy"
+	NotificationGotify  NotificationProvider = "gotify"
+	NotificationSMTP    NotificationProvider = "smtp"
+	NotificationMatrix  NotificationProvider = "matrix"
+)
+
+func (NotificationProvider) Values() (kinds []string) {
+	for _, s := range []NotificationProvider{NotificationWebhook, NotificationDiscord, NotificationApprise, NotificationNtfy, NotificationGotify, NotificationSMTP, NotificationMatrix} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+type NotificationEvent string
+
+const (
+	NotificationVideoSuccess NotificationEvent = "video_success"
+	NotificationLiveSuccess  NotificationEvent = "live_success"
+	NotificationError        NotificationEvent = "error"
+	NotificationIsLive       NotificationEvent = "is_live"
+	NotificationPlaylistVod  NotificationEvent = "playlist_vod"
+)
+
+func (NotificationEvent) Values() (kinds []string) {
+	for _, s := range []NotificationEvent{NotificationVideoSuccess, NotificationLiveSuccess, NotificationError, NotificationIsLive, NotificationPlaylistVod} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// Priorities of queue items. Tasks of items with a higher priority are dispatched first.
+const (
+	QueuePriorityLow    = 0
+	QueuePriorityNormal = 50
+	QueuePriorityHigh   = 100
+)
+
+// VodHealth is the result of the last integrity verification of a video.
+type VodHealth string
+
+const (
+	HealthUnverified VodHealth = "unverified"
+	HealthHealthy    VodHealth = "healthy"
+	HealthUnhealthy  VodHealth = "unhealthy"
+)
+
+func (VodHealth) Values() (kinds []string) {
+	for _, s := range []VodHealth{HealthUnverified, HealthHealthy, HealthUnhealthy} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// RetentionAction is what the retention policy of a channel deletes from videos it prunes.
+type RetentionAction string
+
+const (
+	RetentionDelete          RetentionAction = "delete"            // delete the video and its files
+	RetentionDeleteVideo     RetentionAction = "delete_video"      // delete the video file but keep the metadata, chat and thumbnails
+	RetentionDeleteChatVideo RetentionAction = "delete_chat_video" // delete the rendered chat video
+)
+
+func (RetentionAction) Values() (kinds []string) {
+	for _, s := range []RetentionAction{RetentionDelete, RetentionDeleteVideo, RetentionDeleteChatVideo} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// ChannelAccess is the access a channel permission grants to the videos of a restricted channel.
+// Each level includes the levels before it.
+type ChannelAccess string
+
+const (
+	ChannelAccessView    ChannelAccess = "view"
+	ChannelAccessArchive ChannelAccess = "archive"
+	ChannelAccessEdit    ChannelAccess = "edit"
+)
+
+func (ChannelAccess) Values() (kinds []string) {
+	for _, s := range []ChannelAccess{ChannelAccessView, ChannelAccessArchive, ChannelAccessEdit} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
+
+// AuditAction is an administrative or destructive action recorded in the audit log.
+type AuditAction string
+
+const (
+	AuditVodDelete      AuditAction = "vod_delete"
+	AuditChannelDelete  AuditAction = "channel_delete"
+	AuditVodTrash       AuditAction = "vod_trash"
+	AuditVodRestore     AuditAction = "vod_restore"
+	AuditChannelTrash   AuditAction = "channel_trash"
+	AuditChannelRestore AuditAction = "channel_restore"
+	AuditConfigUpdate   AuditAction = "config_update"
+	AuditUserUpdate     AuditAction = "user_update"
+	AuditUserDelete     AuditAction = "user_delete"
+	AuditQueueStop      AuditAction = "queue_stop"
+	AuditQueueCancel    AuditAction = "queue_cancel"
+	AuditTaskStart      AuditAction = "task_start"
+	// AuditVodPrune is the deletion of the video or chat video file of a video by its retention policy.
+	AuditVodPrune                AuditAction = "vod_prune"
+	AuditApiTokenCreate          AuditAction = "api_token_create"
+	AuditApiTokenRevoke          AuditAction = "api_token_revoke"
+	AuditChannelPermissionGrant  AuditAction = "channel_permission_grant"
+	AuditChannelPermissionRevoke AuditAction = "channel_permission_revoke"
+	AuditChannelVisibility       AuditAction = "channel_visibility"
+)
+
+func (AuditAction) Values() (kinds []string) {
+	for _, s := range []AuditAction{AuditVodDelete, AuditChannelDelete, AuditVodTrash, AuditVodRestore, AuditChannelTrash, AuditChannelRestore, AuditConfigUpdate, AuditUserUpdate, AuditUserDelete, AuditQueueStop, AuditQueueCancel, AuditTaskStart, AuditVodPrune, AuditApiTokenCreate, AuditApiTokenRevoke, AuditChannelPermissionGrant, AuditChannelPermissionRevoke, AuditChannelVisibility} {
+		kinds = append(kinds, string(s))
+	}
+	return
+}
@@ -0,0 +1,169 @@
+package vod
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	entSql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/zibbp/ganymede/ent"
+	"github.com/zibbp/ganymede/ent/channel"
+	entChatMessage "github.com/zibbp/ganymede/ent/chatmessage"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/vod"
+	"github.com/zibbp/ganymede/internal/auth"
+	"github.com/zibbp/ganymede/internal/chat"
+)
+
+// ChatSearchParams are the filters of a chat search. Empty filters are not applied.
+type ChatSearchParams struct {
+	Query     string
+	ChannelID uuid.UUID
+	From      *time.Time
+	To        *time.Time
+	Commenter string
+	Badge     string
+	Limit     int
+	Offset    int
+}
+
+type ChatSearchResult struct {
+	VodID                uuid.UUID    `json:"vod_id"`
+	ContentOffsetSeconds float64      `json:"content_offset_seconds"`
+	Comment              chat.Comment `json:"comment"`
+}
+
+type ChatSearchPagination struct {
+	Offset     int                `json:"offset"`
+	Limit      int                `json:"limit"`
+	TotalCount int                `json:"total_count"`
+	Pages      int                `json:"pages"`
+	Data       []ChatSearchResult `json:"data"`
+	// NotImported is the number of searched videos whose chat is not imported yet and is missing from the results.
+	NotImported int `json:"not_imported"`
+}
+
+// SearchVodChat searches the chat of a video. Chats that are not imported yet are imported in the background.
+func (s *Service) SearchVodChat(c echo.Context, vodID uuid.UUID, params ChatSearchParams) (ChatSearchPagination, error) {
+	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID)).Only(c.Request().Context())
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return ChatSearchPagination{}, fmt.Errorf("vod not found")
+		}
+		return ChatSearchPagination{}, fmt.Errorf("error getting vod: %v", err)
+	}
+
+	err = chat.RequireChatImported(s.Store.Client, v)
+	if err != nil {
+		return ChatSearchPagination{}, err
+	}
+
+	return s.searchChat(c, append(chatSearchPredicates(params), entChatMessage.HasVodWith(vod.ID(vodID))), params.Limit, params.Offset)
+}
+
+// SearchChat searches the chat of all videos.
+// Only imported chats of the channels the user can see are searched, chats of older videos are imported by the import_chat task.
+// The number of videos whose chat is not imported yet is returned so they are not silently missing from the results.
+func (s *Service) SearchChat(c echo.Context, params ChatSearchParams) (ChatSearchPagination, error) {
+	pagination, err := s.searchChat(c, append(chatSearchPredicates(params), entChatMessage.HasVodWith(auth.VisibleVods(auth.Viewer(c))...)), params.Limit, params.Offset)
+	if err != nil {
+		return pagination, err
+	}
+
+	vodPredicates := append(auth.VisibleVods(auth.Viewer(c)), vod.ChatPathNEQ(""), vod.ChatImportedAtIsNil())
+	if params.ChannelID != uuid.Nil {
+		vodPredicates = append(vodPredicates, vod.HasChannelWith(channel.ID(params.ChannelID)))
+	}
+	if params.From != nil {
+		vodPredicates = append(vodPredicates, vod.StreamedAtGTE(*params.From))
+	}
+	if params.To != nil {
+		vodPredicates = append(vodPredicates, vod.StreamedAtLTE(*params.To))
+	}
+	pagination.NotImported, err = s.Store.Client.Vod.Query().Where(vodPredicates...).Count(c.Request().Context())
+	if err != nil {
+		log.Debug().Err(err).Msg("error getting not imported chat count")
+		return pagination, fmt.Errorf("error getting not imported chat count: %v", err)
+	}
+	return pagination, nil
+}
+
+func (s *Service) searchChat(c echo.Context, predicates []predicate.ChatMessage, limit int, offset int) (ChatSearchPagination, error) {
+	var pagination ChatSearchPagination
+
+	messages, err := s.Store.Client.ChatMessage.Query().
+		Where(predicates...).
+		WithVod().
+		Order(entChatMessage.ByVodField(vod.FieldStreamedAt, entSql.OrderDesc()), entChatMessage.ByContentOffsetSeconds()).
+		Limit(limit).
+		Offset(offset).
+		All(c.Request().Context())
+	if err != nil {
+		log.Debug().Err(err).Msg("error searching chat")
+		return pagination, fmt.Errorf("error searching chat: %v", err)
+	}
+
+	totalCount, err := s.Store.Client.ChatMessage.Query().Where(predicates...).Count(c.Request().Context())
+	if err != nil {
+		log.Debug().Err(err).Msg("error getting total chat message count")
+		return pagination, fmt.Errorf("error getting total chat message count: %v", err)
+	}
+
+	comments, err := chat.CommentsFromMessages(messages)
+	if err != nil {
+		return pagination, fmt.Errorf("error searching chat: %v", err)
+	}
+
+	results := make([]ChatSearchResult, 0, len(messages))
+	for i, m := range messages {
+		results = append(results, ChatSearchResult{
+			VodID:                m.Edges.Vod.ID,
+			ContentOffsetSeconds: m.ContentOffsetSeconds,
+			Comment:              comments[i],
+		})
+	}
+
+	pagination.TotalCount = totalCount
+	pagination.Limit = limit
+	pagination.Offset = offset
+	pagination.Pages = int(math.Ceil(float64(totalCount) / float64(limit)))
+	pagination.Data = results
+
+	return pagination, nil
+}
+
+func chatSearchPredicates(params ChatSearchParams) []predicate.ChatMessage {
+	var predicates []predicate.ChatMessage
+	if params.Query != "" {
+		predicates = append(predicates, entChatMessage.Or(
+			entChatMessage.BodyContainsFold(params.Query),
+			entChatMessage.CommenterNameContainsFold(params.Query),
+			entChatMessage.CommenterDisplayNameContainsFold(params.Query),
+		))
+	}
+	if params.Commenter != "" {
+		predicates = append(predicates, entChatMessage.Or(
+			entChatMessage.CommenterNameEqualFold(params.Commenter),
+			entChatMessage.CommenterDisplayNameEqualFold(params.Commenter),
+		))
+	}
+	if params.Badge != "" {
+		predicates = append(predicates, predicate.ChatMessage(func(s *entSql.Selector) {
+			s.Where(sqljson.ValueContains(s.C(entChatMessage.FieldCommenterBadges), params.Badge))
+		}))
+	}
+	if params.ChannelID != uuid.Nil {
+		predicates = append(predicates, entChatMessage.HasVodWith(vod.HasChannelWith(channel.ID(params.ChannelID))))
+	}
+	if params.From != nil {
+		predicates = append(predicates, entChatMessage.HasVodWith(vod.StreamedAtGTE(*params.From)))
+	}
+	if params.To != nil {
+		predicates = append(predicates, entChatMessage.HasVodWith(vod.StreamedAtLTE(*params.To)))
+	}
+	return predicates
+}
@@ -6,22 +6,28 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"path"
 	"runtime"
-	"sort"
 	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
 	"github.com/zibbp/ganymede/ent"
 	"github.com/zibbp/ganymede/ent/channel"
 	entChapter "github.com/zibbp/ganymede/ent/chapter"
+	entChatMessage "github.com/zibbp/ganymede/ent/chatmessage"
 	entMutedSegment "github.com/zibbp/ganymede/ent/mutedsegment"
+	"github.com/zibbp/ganymede/ent/predicate"
+	"github.com/zibbp/ganymede/ent/schema"
 	"github.com/zibbp/ganymede/ent/vod"
-	"github.com/zibbp/ganymede/internal/cache"
+	"github.com/zibbp/ganymede/internal/audit"
+	"github.com/zibbp/ganymede/internal/auth"
 	"github.com/zibbp/ganymede/internal/chat"
 	"github.com/zibbp/ganymede/internal/database"
+	"github.com/zibbp/ganymede/internal/storage"
 	"github.com/zibbp/ganymede/internal/utils"
 )
 
@@ -83,7 +89,7 @@ type MutedSegment struct {
 }
 
 func (s *Service) CreateVod(vodDto Vod, cUUID uuid.UUID) (*ent.Vod, error) {
-	v, err := s.Store.Client.Vod.Create().SetID(vodDto.ID).SetChannelID(cUUID).SetExtID(vodDto.ExtID).SetPlatform(vodDto.Platform).SetType(vodDto.Type).SetTitle(vodDto.Title).SetDuration(vodDto.Duration).SetViews(vodDto.Views).SetResolution(vodDto.Resolution).SetProcessing(vodDto.Processing).SetThumbnailPath(vodDto.ThumbnailPath).SetWebThumbnailPath(vodDto.WebThumbnailPath).SetVideoPath(vodDto.VideoPath).SetChatPath(vodDto.ChatPath).SetChatVideoPath(vodDto.ChatVideoPath).SetInfoPath(vodDto.InfoPath).SetCaptionPath(vodDto.CaptionPath).SetStreamedAt(vodDto.StreamedAt).SetFolderName(vodDto.FolderName).SetFileName(vodDto.FileName).SetLocked(vodDto.Locked).SetTmpVideoDownloadPath(vodDto.TmpVideoDownloadPath).SetTmpVideoConvertPath(vodDto.TmpVideoConvertPath).SetTmpChatDownloadPath(vodDto.TmpChatDownloadPath).SetTmpLiveChatDownloadPath(vodDto.TmpLiveChatDownloadPath).SetTmpLiveChatConvertPath(vodDto.TmpLiveChatConvertPath).SetTmpChatRenderPath(vodDto.TmpChatRenderPath).SetLiveChatPath(vodDto.LiveChatPath).SetLiveChatConvertPath(vodDto.LiveChatConvertPath).SetVideoHlsPath(vodDto.VideoHLSPath).SetTmpVideoHlsPath(vodDto.TmpVideoHLSPath).Save(context.Background())
+	v, err := s.Store.Client.Vod.Create().SetID(vodDto.ID).SetChannelID(cUUID).SetExtID(vodDto.ExtID).SetPlatform(vodDto.Platform).SetType(vodDto.Type).SetTitle(vodDto.Title).SetDuration(vodDto.Duration).SetViews(vodDto.Views).SetResolution(vodDto.Resolution).SetProcessing(vodDto.Processing).SetThumbnailPath(vodDto.ThumbnailPath).SetWebThumbnailPath(vodDto.WebThumbnailPath).SetVideoPath(vodDto.VideoPath).SetChatPath(vodDto.ChatPath).SetChatVideoPath(vodDto.ChatVideoPath).SetInfoPath(vodDto.InfoPath).SetCaptionPath(vodDto.CaptionPath).SetStreamedAt(vodDto.StreamedAt).SetFolderName(vodDto.FolderName).SetFileName(vodDto.FileName).SetLocked(vodDto.Locked).SetTmpVideoDownloadPath(vodDto.TmpVideoDownloadPath).SetTmpVideoConvertPath(vodDto.TmpVideoConvertPath).SetTmpChatDownloadPath(vodDto.TmpChatDownloadPath).SetTmpLiveChatDownloadPath(vodDto.TmpLiveChatDownloadPath).SetTmpLiveChatConvertPath(vodDto.TmpLiveChatConvertPath).SetTmpChatRenderPath(vodDto.TmpChatRenderPath).SetLiveChatPath(vodDto.LiveChatPath).SetLiveChatConvertPath(vodDto.LiveChatConvertPath).SetVideoHlsPath(vodDto.VideoHLSPath).SetTmpVideoHlsPath(vodDto.TmpVideoHLSPath).SetStorageBackend(storage.Default()).Save(context.Background())
 	if err != nil {
 		log.Debug().Err(err).Msg("error creating vod")
 		if _, ok := err.(*ent.ConstraintError); ok {
@@ -96,7 +102,7 @@ func (s *Service) CreateVod(vodDto Vod, cUUID uuid.UUID) (*ent.Vod, error) {
 }
 
 func (s *Service) GetVods(c echo.Context) ([]*ent.Vod, error) {
-	v, err := s.Store.Client.Vod.Query().WithChannel().Order(ent.Desc(vod.FieldStreamedAt)).All(c.Request().Context())
+	v, err := s.Store.Client.Vod.Query().Where(auth.VisibleVods(auth.Viewer(c))...).WithChannel().Order(ent.Desc(vod.FieldStreamedAt)).All(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vods")
 		return nil, fmt.Errorf("error getting vods: %v", err)
@@ -106,7 +112,7 @@ func (s *Service) GetVods(c echo.Context) ([]*ent.Vod, error) {
 }
 
 func (s *Service) GetVodsByChannel(c echo.Context, cUUID uuid.UUID) ([]*ent.Vod, error) {
-	v, err := s.Store.Client.Vod.Query().Where(vod.HasChannelWith(channel.ID(cUUID))).Order(ent.Desc(vod.FieldStreamedAt)).All(c.Request().Context())
+	v, err := s.Store.Client.Vod.Query().Where(vod.HasChannelWith(channel.ID(cUUID))).Where(auth.VisibleVods(auth.Viewer(c))...).Order(ent.Desc(vod.FieldStreamedAt)).All(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vods by channel")
 		return nil, fmt.Errorf("error getting vods by channel: %v", err)
@@ -142,10 +148,12 @@ func (s *Service) GetVod(vodID uuid.UUID, withChannel bool, withChapters bool, w
 	return v, nil
 }
 
+// DeleteVod permanently deletes a video, including a video in the trash.
 func (s *Service) DeleteVod(c echo.Context, vodID uuid.UUID, deleteFiles bool) error {
 	log.Debug().Msgf("deleting vod %s", vodID)
+	ctx := schema.SkipSoftDelete(c.Request().Context())
 	// delete vod and queue item
-	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID)).WithQueue().WithChannel().WithChapters().WithMutedSegments().Only(c.Request().Context())
+	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID)).WithQueue().WithChannel().WithChapters().WithMutedSegments().Only(ctx)
 	if err != nil {
 		if _, ok := err.(*ent.NotFoundError); ok {
 			return fmt.Errorf("vod not found")
@@ -153,19 +161,19 @@ func (s *Service) DeleteVod(c echo.Context, vodID uuid.UUID, deleteFiles bool) e
 		return fmt.Errorf("error deleting vod: %v", err)
 	}
 	if v.Edges.Queue != nil {
-		err = s.Store.Client.Queue.DeleteOneID(v.Edges.Queue.ID).Exec(c.Request().Context())
+		err = s.Store.Client.Queue.DeleteOneID(v.Edges.Queue.ID).Exec(ctx)
 		if err != nil {
 			return fmt.Errorf("error deleting queue item: %v", err)
 		}
 	}
 	if v.Edges.Chapters != nil {
-		_, err = s.Store.Client.Chapter.Delete().Where(entChapter.HasVodWith(vod.ID(vodID))).Exec(c.Request().Context())
+		_, err = s.Store.Client.Chapter.Delete().Where(entChapter.HasVodWith(vod.ID(vodID))).Exec(ctx)
 		if err != nil {
 			return fmt.Errorf("error deleting chapters: %v", err)
 		}
 	}
 	if v.Edges.MutedSegments != nil {
-		_, err = s.Store.Client.MutedSegment.Delete().Where(entMutedSegment.HasVodWith(vod.ID(vodID))).Exec(c.Request().Context())
+		_, err = s.Store.Client.MutedSegment.Delete().Where(entMutedSegment.HasVodWith(vod.ID(vodID))).Exec(ctx)
 		if err != nil {
 			return fmt.Errorf("error deleting muted segments: %v", err)
 		}
@@ -174,22 +182,139 @@ func (s *Service) DeleteVod(c echo.Context, vodID uuid.UUID, deleteFiles bool) e
 	// delete files
 	if deleteFiles {
 		log.Debug().Msgf("deleting files for vod %s", v.ID)
-		path := fmt.Sprintf("/vods/%s/%s", v.Edges.Channel.Name, v.FolderName)
-		err := utils.DeleteFolder(path)
+		path := vodFolder(v)
+		// the files of videos in the trash were moved to the trash folder
+		if v.TrashPath != "" {
+			path = v.TrashPath
+		}
+		err := storage.DeleteVodFolder(ctx, v, path)
 		if err != nil {
 			log.Debug().Err(err).Msg("error deleting files")
 			return err
 		}
 	}
 
-	err = s.Store.Client.Vod.DeleteOneID(vodID).Exec(c.Request().Context())
+	err = s.Store.Client.Vod.DeleteOneID(vodID).Exec(ctx)
 	if err != nil {
 		log.Debug().Err(err).Msg("error deleting vod")
 		return fmt.Errorf("error deleting vod: %v", err)
 	}
+
+	details := map[string]interface{}{"title": v.Title, "ext_id": v.ExtID, "type": v.Type, "delete_files": deleteFiles}
+	if v.Edges.Channel != nil {
+		details["channel"] = v.Edges.Channel.Name
+	}
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditVodDelete, EntityType: "vod", EntityID: vodID.String(), Details: details})
+	return nil
+}
+
+// TrashVod moves a video to the trash, see MoveToTrash.
+// Locked videos and videos in a playlist are only moved if force is set.
+func (s *Service) TrashVod(c echo.Context, vodID uuid.UUID, deleteFiles bool, force bool) error {
+	ctx := c.Request().Context()
+	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID)).WithChannel().WithPlaylists().Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return fmt.Errorf("vod not found")
+		}
+		return fmt.Errorf("error getting vod: %v", err)
+	}
+	if !force {
+		if v.Locked {
+			return fmt.Errorf("vod is locked")
+		}
+		if len(v.Edges.Playlists) > 0 {
+			return fmt.Errorf("vod is in a playlist")
+		}
+	}
+
+	if err := MoveToTrash(ctx, s.Store.Client, v, deleteFiles, time.Now()); err != nil {
+		return err
+	}
+
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditVodTrash, EntityType: "vod", EntityID: vodID.String(), Details: map[string]interface{}{"title": v.Title, "ext_id": v.ExtID, "channel": v.Edges.Channel.Name, "delete_files": deleteFiles}})
 	return nil
 }
 
+// RestoreVod moves a video out of the trash. Videos of a channel in the trash are restored with their channel.
+func (s *Service) RestoreVod(c echo.Context, vodID uuid.UUID) error {
+	ctx := schema.SkipSoftDelete(c.Request().Context())
+	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID), vod.DeletedAtNotNil()).WithChannel().Only(ctx)
+	if err != nil {
+		if _, ok := err.(*ent.NotFoundError); ok {
+			return fmt.Errorf("vod not found")
+		}
+		return fmt.Errorf("error getting vod: %v", err)
+	}
+	if v.Edges.Channel.DeletedAt != nil {
+		return fmt.Errorf("channel is in the trash")
+	}
+
+	if err := RestoreFromTrash(ctx, s.Store.Client, v); err != nil {
+		return err
+	}
+
+	audit.Record(c, s.Store.Client, audit.Event{Action: utils.AuditVodRestore, EntityType: "vod", EntityID: vodID.String(), Details: map[string]interface{}{"title": v.Title, "ext_id": v.ExtID, "channel": v.Edges.Channel.Name}})
+	return nil
+}
+
+// GetTrashedVods returns the videos in the trash, most recently deleted first.
+func (s *Service) GetTrashedVods(c echo.Context) ([]*ent.Vod, error) {
+	vods, err := s.Store.Client.Vod.Query().Where(vod.DeletedAtNotNil()).WithChannel().Order(ent.Desc(vod.FieldDeletedAt)).All(schema.SkipSoftDelete(c.Request().Context()))
+	if err != nil {
+		return nil, fmt.Errorf("error getting trashed vods: %v", err)
+	}
+	return vods, nil
+}
+
+// MoveToTrash marks a video as deleted at deletedAt, it is left out of all queries until it is restored or purged.
+// If deleteFiles is set the folder of the video is moved to the trash root, otherwise the files are kept in place.
+// The channel of the video must be loaded.
+func MoveToTrash(ctx context.Context, client *ent.Client, v *ent.Vod, deleteFiles bool, deletedAt time.Time) error {
+	update := client.Vod.UpdateOneID(v.ID).SetDeletedAt(deletedAt)
+	var trashPath string
+	if deleteFiles {
+		trashPath = fmt.Sprintf("%s/%s/%s", path.Clean(viper.GetString("storage.trash_root")), v.Edges.Channel.Name, v.ID)
+		if err := storage.MoveVodFolder(ctx, v, vodFolder(v), trashPath); err != nil {
+			return fmt.Errorf("error moving files to the trash: %v", err)
+		}
+		update.SetTrashPath(trashPath)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		if deleteFiles {
+			// move the files back so the paths stay valid
+			if moveErr := storage.MoveVodFolder(ctx, v, trashPath, vodFolder(v)); moveErr != nil {
+				log.Error().Err(moveErr).Msgf("error moving %s back to %s", trashPath, vodFolder(v))
+			}
+		}
+		return fmt.Errorf("error moving vod to the trash: %v", err)
+	}
+	return nil
+}
+
+// RestoreFromTrash moves the files of a video back from the trash and clears its deleted_at time.
+// The channel of the video must be loaded.
+func RestoreFromTrash(ctx context.Context, client *ent.Client, v *ent.Vod) error {
+	if v.TrashPath != "" {
+		if err := storage.MoveVodFolder(ctx, v, v.TrashPath, vodFolder(v)); err != nil {
+			return fmt.Errorf("error moving files out of the trash: %v", err)
+		}
+	}
+	if _, err := client.Vod.UpdateOneID(v.ID).ClearDeletedAt().ClearTrashPath().Save(ctx); err != nil {
+		return fmt.Errorf("error restoring vod: %v", err)
+	}
+	return nil
+}
+
+// vodFolder returns the folder of the files of a video. The channel of the video must be loaded.
+func vodFolder(v *ent.Vod) string {
+	// videos in cold storage are no longer in the /vods tree
+	if v.ColdStorageAt != nil {
+		return storage.VodFolder(v)
+	}
+	return fmt.Sprintf("/vods/%s/%s", v.Edges.Channel.Name, v.FolderName)
+}
+
 func (s *Service) UpdateVod(c echo.Context, vodID uuid.UUID, vodDto Vod, cUUID uuid.UUID) (*ent.Vod, error) {
 	v, err := s.Store.Client.Vod.UpdateOneID(vodID).SetChannelID(cUUID).SetExtID(vodDto.ExtID).SetPlatform(vodDto.Platform).SetType(vodDto.Type).SetTitle(vodDto.Title).SetDuration(vodDto.Duration).SetViews(vodDto.Views).SetResolution(vodDto.Resolution).SetProcessing(vodDto.Processing).SetThumbnailPath(vodDto.ThumbnailPath).SetWebThumbnailPath(vodDto.WebThumbnailPath).SetVideoPath(vodDto.VideoPath).SetChatPath(vodDto.ChatPath).SetChatVideoPath(vodDto.ChatVideoPath).SetInfoPath(vodDto.InfoPath).SetCaptionPath(vodDto.CaptionPath).SetStreamedAt(vodDto.StreamedAt).SetLocked(vodDto.Locked).Save(c.Request().Context())
 	if err != nil {
@@ -205,32 +330,42 @@ func (s *Service) UpdateVod(c echo.Context, vodID uuid.UUID, vodDto Vod, cUUID u
 	return v, nil
 }
 
-func (s *Service) CheckVodExists(extID string) (bool, error) {
-	_, err := s.Store.Client.Vod.Query().Where(vod.ExtID(extID)).Only(context.Background())
+// CheckVodExists returns whether a video with the external id was archived and whether it is in the trash.
+// Videos in the trash are archived too so they are not archived again until the trash is purged.
+func (s *Service) CheckVodExists(extID string) (bool, bool, error) {
+	exists, trashed, err := Exists(context.Background(), s.Store.Client, vod.ExtID(extID))
 	if err != nil {
-		log.Debug().Err(err).Msg("error checking vod exists")
-
-		// if vod not found
-		if _, ok := err.(*ent.NotFoundError); ok {
-			return false, nil
-		}
-		return false, fmt.Errorf("error checking vod exists: %v", err)
+		return false, false, fmt.Errorf("error checking vod exists: %v", err)
 	}
+	return exists, trashed, nil
+}
 
-	return true, nil
+// Exists returns whether a video matching the predicates exists, including the videos in the trash,
+// and whether all the matching videos are in the trash.
+func Exists(ctx context.Context, client *ent.Client, ps ...predicate.Vod) (bool, bool, error) {
+	ctx = schema.SkipSoftDelete(ctx)
+	exists, err := client.Vod.Query().Where(ps...).Exist(ctx)
+	if err != nil || !exists {
+		return false, false, err
+	}
+	active, err := client.Vod.Query().Where(ps...).Where(vod.DeletedAtIsNil()).Exist(ctx)
+	if err != nil {
+		return false, false, err
+	}
+	return true, !active, nil
 }
 
 func (s *Service) SearchVods(c echo.Context, term string, limit int, offset int) (Pagination, error) {
 
 	var pagination Pagination
 
-	v, err := s.Store.Client.Vod.Query().Where(vod.TitleContainsFold(term)).Order(ent.Desc(vod.FieldStreamedAt)).WithChannel().Limit(limit).Offset(offset).All(c.Request().Context())
+	v, err := s.Store.Client.Vod.Query().Where(vod.TitleContainsFold(term)).Where(auth.VisibleVods(auth.Viewer(c))...).Order(ent.Desc(vod.FieldStreamedAt)).WithChannel().Limit(limit).Offset(offset).All(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error searching vods")
 		return pagination, fmt.Errorf("error searching vods: %v", err)
 	}
 
-	totalCount, err := s.Store.Client.Vod.Query().Where(vod.TitleContainsFold(term)).Count(c.Request().Context())
+	totalCount, err := s.Store.Client.Vod.Query().Where(vod.TitleContainsFold(term)).Where(auth.VisibleVods(auth.Viewer(c))...).Count(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting total vod count")
 		return pagination, fmt.Errorf("error getting total vod count: %v", err)
@@ -258,8 +393,8 @@ func (s *Service) GetVodPlaylists(c echo.Context, vodID uuid.UUID) ([]*ent.Playl
 func (s *Service) GetVodsPagination(c echo.Context, limit int, offset int, channelId uuid.UUID, types []utils.VodType) (Pagination, error) {
 	var pagination Pagination
 
-	// Query builder
-	vodQuery := s.Store.Client.Vod.Query()
+	// Query builder, limited to the channels the user can see
+	vodQuery := s.Store.Client.Vod.Query().Where(auth.VisibleVods(auth.Viewer(c))...)
 
 	// If channel id is not nil
 	if channelId != uuid.Nil {
@@ -279,7 +414,7 @@ func (s *Service) GetVodsPagination(c echo.Context, limit int, offset int, chann
 
 	// Get total count
 	// Amount will differ depending on types supplied
-	totalCountQuery := s.Store.Client.Vod.Query()
+	totalCountQuery := s.Store.Client.Vod.Query().Where(auth.VisibleVods(auth.Viewer(c))...)
 
 	// If channel id is not nil
 	if channelId != uuid.Nil {
@@ -312,7 +447,7 @@ func (s *Service) GetUserIdFromChat(c echo.Context, vodID uuid.UUID) (*int64, er
 		log.Debug().Err(err).Msg("error getting vod")
 		return nil, fmt.Errorf("error getting vod: %v", err)
 	}
-	data, err := utils.ReadChatFile(v.ChatPath)
+	data, err := chat.ReadChatHeader(v.ChatPath, 0)
 	if err != nil {
 		log.Debug().Err(err).Msg("error reading chat file")
 		return nil, fmt.Errorf("error reading chat file: %v", err)
@@ -344,177 +479,68 @@ func (s *Service) GetUserIdFromChat(c echo.Context, vodID uuid.UUID) (*int64, er
 }
 
 func (s *Service) GetVodChatComments(c echo.Context, vodID uuid.UUID, start float64, end float64) (*[]chat.Comment, error) {
-	envDeployment := os.Getenv("ENV")
-
-	if envDeployment == "development" {
-		utils.PrintMemUsage()
-	}
-
 	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID)).Only(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vod chat")
 		return nil, fmt.Errorf("error getting vod chat: %v", err)
 	}
 
-	var chatData *chat.ChatNoEmotes
-	var comments []chat.Comment
-	cacheData, exists := cache.Cache().Get(v.ID.String())
-	if exists {
-		comments = cacheData.([]chat.Comment)
-	} else {
-		data, err := utils.ReadChatFile(v.ChatPath)
-		if err != nil {
-			log.Debug().Err(err).Msg("error getting vod chat")
-			return nil, fmt.Errorf("error getting vod chat: %v", err)
-		}
-		err = json.Unmarshal(data, &chatData)
-		if err != nil {
-			log.Debug().Err(err).Msg("error getting vod chat")
-			return nil, fmt.Errorf("error getting vod chat: %v", err)
-		}
-
-		comments = chatData.Comments
-		chatData = nil
-		runtime.GC()
-
-		// Sort the comments by their content offset seconds
-		sort.Slice(comments, func(i, j int) bool {
-			return comments[i].ContentOffsetSeconds < comments[j].ContentOffsetSeconds
-		})
-
-		// Set cache
-		err = cache.Cache().Set(v.ID.String(), comments, 10*time.Minute)
-		if err != nil {
-			log.Debug().Err(err).Msg("error setting cache")
-			return nil, fmt.Errorf("error setting cache: %v", err)
-		}
-
-		runtime.GC()
-
-	}
-
-	// Reset the cache
-	err = cache.Cache().Set(v.ID.String(), comments, 10*time.Minute)
+	err = chat.RequireChatImported(s.Store.Client, v)
 	if err != nil {
-		log.Debug().Err(err).Msg("error setting cache")
-		return nil, fmt.Errorf("error setting cache: %v", err)
+		return nil, err
 	}
 
-	var filteredComments []chat.Comment
-
-	// Use binary search to find the index of the first comment with an offset greater than the specified offset
-	// This is much faster than iterating through the entire slice
-	i := sort.Search(len(comments), func(i int) bool { return comments[i].ContentOffsetSeconds >= start })
-
-	// Iterate through the comments starting at the index found above
-	// Stop when we reach the end of the slice or the offset is greater than the specified end offset
-	for i < len(comments) && comments[i].ContentOffsetSeconds <= end {
-		filteredComments = append(filteredComments, comments[i])
-		i++
+	messages, err := s.Store.Client.ChatMessage.Query().
+		Where(entChatMessage.HasVodWith(vod.ID(vodID)), entChatMessage.ContentOffsetSecondsGTE(start), entChatMessage.ContentOffsetSecondsLTE(end)).
+		Order(ent.Asc(entChatMessage.FieldContentOffsetSeconds)).
+		All(c.Request().Context())
+	if err != nil {
+		log.Debug().Err(err).Msg("error getting vod chat")
+		return nil, fmt.Errorf("error getting vod chat: %v", err)
 	}
 
-	// Cleanup
-	chatData = nil
-	comments = nil
-
-	defer runtime.GC()
-
-	if envDeployment == "development" {
-		utils.PrintMemUsage()
+	comments, err := chat.CommentsFromMessages(messages)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vod chat: %v", err)
 	}
 
-	return &filteredComments, nil
+	return &comments, nil
 }
 
+// GetNumberOfVodChatCommentsFromTime returns the last commentCount comments before start, oldest first.
 func (s *Service) GetNumberOfVodChatCommentsFromTime(c echo.Context, vodID uuid.UUID, start float64, commentCount int64) (*[]chat.Comment, error) {
-	envDeployment := os.Getenv("ENV")
-
-	if envDeployment == "development" {
-		utils.PrintMemUsage()
-	}
-
 	v, err := s.Store.Client.Vod.Query().Where(vod.ID(vodID)).Only(c.Request().Context())
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vod chat")
 		return nil, fmt.Errorf("error getting vod chat: %v", err)
 	}
 
-	var chatData *chat.ChatNoEmotes
-	var comments []chat.Comment
-
-	cacheData, exists := cache.Cache().Get(v.ID.String())
-
-	if exists {
-		comments = cacheData.([]chat.Comment)
-	} else {
-		data, err := utils.ReadChatFile(v.ChatPath)
-		if err != nil {
-			log.Debug().Err(err).Msg("error getting vod chat")
-			return nil, fmt.Errorf("error getting vod chat: %v", err)
-		}
-		err = json.Unmarshal(data, &chatData)
-		if err != nil {
-			log.Debug().Err(err).Msg("error getting vod chat")
-			return nil, fmt.Errorf("error getting vod chat: %v", err)
-		}
-
-		comments = chatData.Comments
-		chatData = nil
-		runtime.GC()
-
-		// Sort the comments by their content offset seconds
-		sort.Slice(comments, func(i, j int) bool {
-			return comments[i].ContentOffsetSeconds < comments[j].ContentOffsetSeconds
-		})
-
-		err = cache.Cache().Set(v.ID.String(), comments, 10*time.Minute)
-		if err != nil {
-			log.Debug().Err(err).Msg("error setting cache")
-			return nil, fmt.Errorf("error setting cache: %v", err)
-		}
-
-		runtime.GC()
-
-	}
-
-	// Reset the cache
-	err = cache.Cache().Set(v.ID.String(), comments, 10*time.Minute)
+	err = chat.RequireChatImported(s.Store.Client, v)
 	if err != nil {
-		log.Debug().Err(err).Msg("error setting cache")
-		return nil, fmt.Errorf("error setting cache: %v", err)
+		return nil, err
 	}
 
-	var filteredComments []chat.Comment
-
-	// Use binary search to find the index of the first comment with an offset greater than the specified offset
-	// This is much faster than iterating through the entire slice
-	i := sort.Search(len(comments), func(i int) bool { return comments[i].ContentOffsetSeconds >= start })
-
-	// Iterate backwards from the index found above to get the last commentCount comments before the start time
-	for j := i; len(filteredComments) < int(commentCount); j-- {
-		if j < 0 || j >= len(comments) {
-			break
-		}
-		comment := comments[j]
-		filteredComments = append(filteredComments, comment)
+	messages, err := s.Store.Client.ChatMessage.Query().
+		Where(entChatMessage.HasVodWith(vod.ID(vodID)), entChatMessage.ContentOffsetSecondsLT(start)).
+		Order(ent.Desc(entChatMessage.FieldContentOffsetSeconds)).
+		Limit(int(commentCount)).
+		All(c.Request().Context())
+	if err != nil {
+		log.Debug().Err(err).Msg("error getting vod chat")
+		return nil, fmt.Errorf("error getting vod chat: %v", err)
 	}
 
-	// Check if the index is less than the number of comments we want to return
-	if i-int(commentCount) >= 0 {
-		filteredComments = comments[i-int(commentCount) : i]
+	// the messages are queried newest first to apply the limit
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
 	}
 
-	// Cleanup
-	chatData = nil
-	comments = nil
-	defer runtime.GC()
-
-	if envDeployment == "development" {
-		utils.PrintMemUsage()
+	comments, err := chat.CommentsFromMessages(messages)
+	if err != nil {
+		return nil, fmt.Errorf("error getting vod chat: %v", err)
 	}
 
-	return &filteredComments, nil
-
+	return &comments, nil
 }
 
 func (s *Service) GetVodChatEmotes(c echo.Context, vodID uuid.UUID) (*chat.GanymedeEmotes, error) {
@@ -523,7 +549,7 @@ func (s *Service) GetVodChatEmotes(c echo.Context, vodID uuid.UUID) (*chat.Ganym
 		log.Debug().Err(err).Msg("error getting vod chat emotes")
 		return nil, fmt.Errorf("error getting vod chat emotes: %v", err)
 	}
-	data, err := utils.ReadChatFile(v.ChatPath)
+	data, err := chat.ReadChatHeader(v.ChatPath, 0)
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vod chat emotes")
 		return nil, fmt.Errorf("error getting vod chat emotes: %v", err)
@@ -698,7 +724,7 @@ func (s *Service) GetVodChatBadges(c echo.Context, vodID uuid.UUID) (*chat.Ganym
 		log.Debug().Err(err).Msg("error getting vod chat emotes")
 		return nil, fmt.Errorf("error getting vod chat emotes: %v", err)
 	}
-	data, err := utils.ReadChatFile(v.ChatPath)
+	data, err := chat.ReadChatHeader(v.ChatPath, 0)
 	if err != nil {
 		log.Debug().Err(err).Msg("error getting vod chat emotes")
 		return nil, fmt.Errorf("error getting vod chat emotes: %v", err)
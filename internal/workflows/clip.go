@@ -0,0 +1,100 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/zibbp/ganymede/internal/activities"
+	"github.com/zibbp/ganymede/internal/dto"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// *Top Level Workflow*
+func ArchiveClipWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) (err error) {
+	defer func() { err = queueItemCancelledHandler(ctx, err, input) }()
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{})
+
+	// create directory
+	err = workflow.ExecuteChildWorkflow(ctx, CreateDirectoryWorkflow, input).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// download thumbnails
+	err = workflow.ExecuteChildWorkflow(ctx, DownloadTwitchClipThumbnailsWorkflow, input).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// save clip info
+	err = workflow.ExecuteChildWorkflow(ctx, SaveTwitchClipInfoWorkflow, input).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// archive video
+	videoFuture := workflow.ExecuteChildWorkflow(ctx, ArchiveTwitchVideoWorkflow, input)
+
+	if input.Queue.ChatProcessing {
+		chatFuture := workflow.ExecuteChildWorkflow(ctx, ArchiveTwitchChatWorkflow, input)
+		if err := chatFuture.Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	if err := videoFuture.Get(ctx, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// *Low Level Workflow*
+func DownloadTwitchClipThumbnailsWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    5,
+			MaximumInterval:    15 * time.Minute,
+		},
+	})
+
+	err := workflow.ExecuteActivity(ctx, activities.DownloadTwitchClipThumbnails, input).Get(ctx, nil)
+	if err != nil {
+		return workflowErrorHandler(err, input, "download-thumbnails")
+	}
+
+	err = checkIfTasksAreDone(input)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// *Low Level Workflow*
+func SaveTwitchClipInfoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    5,
+			MaximumInterval:    15 * time.Minute,
+		},
+	})
+
+	err := workflow.ExecuteActivity(ctx, activities.SaveTwitchClipInfo, input).Get(ctx, nil)
+	if err != nil {
+		return workflowErrorHandler(err, input, "save-video-info")
+	}
+
+	err = checkIfTasksAreDone(input)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
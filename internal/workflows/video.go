@@ -14,6 +14,7 @@ import (
 	"github.com/zibbp/ganymede/internal/database"
 	"github.com/zibbp/ganymede/internal/dto"
 	"github.com/zibbp/ganymede/internal/notification"
+	"github.com/zibbp/ganymede/internal/storage"
 	ganymedeTemporal "github.com/zibbp/ganymede/internal/temporal"
 	"github.com/zibbp/ganymede/internal/utils"
 	"go.temporal.io/sdk/temporal"
@@ -70,11 +71,63 @@ func checkIfTasksAreDone(input dto.ArchiveVideoInput) error {
 }
 
 func workflowErrorHandler(err error, input dto.ArchiveVideoInput, task string) error {
+	// cancelled archives are not errors
+	if temporal.IsCanceledError(err) {
+		return err
+	}
 	notification.SendErrorNotification(input.Channel, input.Vod, input.Queue, task)
 
 	return err
 }
 
+// queueItemCancelledHandler records a cancelled archive. The pending and running tasks of the queue item are set to cancelled and the queue item and video are no longer processing.
+// Top level workflows call it with their error before returning. The workflow context is cancelled so the activity runs on a disconnected context.
+func queueItemCancelledHandler(ctx workflow.Context, err error, input dto.ArchiveVideoInput) error {
+	if !temporal.IsCanceledError(err) {
+		return err
+	}
+	log.Info().Msgf("archive of video %s was cancelled", input.VideoID)
+
+	disconnectedCtx, _ := workflow.NewDisconnectedContext(ctx)
+	disconnectedCtx = workflow.WithActivityOptions(disconnectedCtx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    5,
+			MaximumInterval:    30 * time.Second,
+		},
+	})
+	if actErr := workflow.ExecuteActivity(disconnectedCtx, activities.CancelQueueItem, input).Get(disconnectedCtx, nil); actErr != nil {
+		log.Error().Err(actErr).Msgf("error recording cancelled archive of video %s", input.VideoID)
+	}
+
+	return err
+}
+
+// executeStoppableActivity executes an activity that is stopped when the workflow receives the signal.
+// Stopping cancels the context of the activity which stops its subprocess. The workflow waits for the activity to finish so it can keep what was downloaded.
+func executeStoppableActivity(ctx workflow.Context, signal string, activity interface{}, input dto.ArchiveVideoInput) error {
+	activityCtx, stop := workflow.WithCancel(ctx)
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		workflow.GetSignalChannel(ctx, signal).Receive(ctx, nil)
+		log.Info().Msgf("received %s signal for video %s", signal, input.VideoID)
+		stop()
+	})
+	return workflow.ExecuteActivity(activityCtx, activity, input).Get(ctx, nil)
+}
+
+// forwardSignal forwards a signal received by the workflow to a child workflow.
+func forwardSignal(ctx workflow.Context, signal string, child workflow.ChildWorkflowFuture) {
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		workflow.GetSignalChannel(ctx, signal).Receive(ctx, nil)
+		err := child.SignalChildWorkflow(ctx, signal, nil).Get(ctx, nil)
+		if err != nil {
+			log.Error().Err(err).Msgf("error forwarding %s signal", signal)
+		}
+	})
+}
+
 func cancelWorkflowAndCleanup(ctx context.Context, input dto.ArchiveVideoInput) error {
 	log.Info().Msg("no stream found for channel - cancelling workflow")
 	q, err := database.DB().Client.Queue.Query().Where(queue.ID(input.Queue.ID)).Only(context.Background())
@@ -93,7 +146,7 @@ func cancelWorkflowAndCleanup(ctx context.Context, input dto.ArchiveVideoInput)
 	}
 	// delete directory
 	path := fmt.Sprintf("/vods/%s/%s", input.Channel.Name, input.Vod.FolderName)
-	err = utils.DeleteFolder(path)
+	err = storage.DeleteVodFolder(ctx, input.Vod, path)
 	if err != nil {
 		log.Error().Err(err).Msg("error deleting files")
 		return err
@@ -115,11 +168,12 @@ func cancelWorkflowAndCleanup(ctx context.Context, input dto.ArchiveVideoInput)
 }
 
 // *Top Level Workflow*
-func ArchiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) error {
+func ArchiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) (err error) {
+	defer func() { err = queueItemCancelledHandler(ctx, err, input) }()
 	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{})
 
 	// create directory
-	err := workflow.ExecuteChildWorkflow(ctx, CreateDirectoryWorkflow, input).Get(ctx, nil)
+	err = workflow.ExecuteChildWorkflow(ctx, CreateDirectoryWorkflow, input).Get(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -150,15 +204,24 @@ func ArchiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) err
 		return err
 	}
 
+	// link the live archive this vod is replacing and delete it if it is replaced
+	if input.ReplaceVod != nil {
+		err = workflow.ExecuteChildWorkflow(ctx, DeleteReplacedLiveArchiveWorkflow, input).Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // *Top Level Workflow*
-func ArchiveLiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) error {
+func ArchiveLiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) (err error) {
+	defer func() { err = queueItemCancelledHandler(ctx, err, input) }()
 	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{})
 
 	// create directory
-	err := workflow.ExecuteChildWorkflow(ctx, CreateDirectoryWorkflow, input).Get(ctx, nil)
+	err = workflow.ExecuteChildWorkflow(ctx, CreateDirectoryWorkflow, input).Get(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -202,6 +265,7 @@ func ArchiveLiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput)
 
 	// archive video
 	videoFuture := workflow.ExecuteChildWorkflow(ctx, ArchiveTwitchLiveVideoWorkflow, input)
+	forwardSignal(ctx, utils.StopLiveVideoDownloadSignal, videoFuture)
 
 	if err := videoFuture.Get(ctx, nil); err != nil {
 		return err
@@ -403,7 +467,9 @@ func ArchiveTwitchVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInpu
 // *Mid Level Workflow*
 func ArchiveTwitchLiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) error {
 
-	err := workflow.ExecuteChildWorkflow(ctx, DownloadTwitchLiveVideoWorkflow, input).Get(ctx, nil)
+	downloadFuture := workflow.ExecuteChildWorkflow(ctx, DownloadTwitchLiveVideoWorkflow, input)
+	forwardSignal(ctx, utils.StopLiveVideoDownloadSignal, downloadFuture)
+	err := downloadFuture.Get(ctx, nil)
 	if err != nil {
 		return err
 	}
@@ -540,9 +606,10 @@ func DownloadTwitchLiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVide
 			MaximumAttempts:    1,
 			MaximumInterval:    15 * time.Minute,
 		},
+		WaitForCancellation: true,
 	})
 
-	err := workflow.ExecuteActivity(ctx, activities.DownloadTwitchLiveVideo, input).Get(ctx, nil)
+	err := executeStoppableActivity(ctx, utils.StopLiveVideoDownloadSignal, activities.DownloadTwitchLiveVideo, input)
 	if err != nil {
 		// cleanup archive if no stream found
 		if strings.Contains(err.Error(), "no playable streams found on this URL") {
@@ -551,7 +618,7 @@ func DownloadTwitchLiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVide
 			if err != nil {
 				return err
 			}
-			err = workflow.ExecuteActivity(ctx, activities.KillTwitchLiveChatDownload, input).Get(ctx, nil)
+			err = stopLiveChatDownload(ctx, input)
 			if err != nil {
 				return err
 			}
@@ -561,11 +628,11 @@ func DownloadTwitchLiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVide
 		return workflowErrorHandler(err, input, "download-video")
 	}
 
-	// kill live chat download if chat is being archived
+	// stop live chat download if chat is being archived
 	if input.Queue.ChatProcessing {
-		err = workflow.ExecuteActivity(ctx, activities.KillTwitchLiveChatDownload, input).Get(ctx, nil)
+		err = stopLiveChatDownload(ctx, input)
 		if err != nil {
-			return workflowErrorHandler(err, input, "kill-chat-download")
+			return workflowErrorHandler(err, input, "stop-chat-download")
 		}
 	}
 
@@ -594,6 +661,14 @@ func DownloadTwitchLiveVideoWorkflow(ctx workflow.Context, input dto.ArchiveVide
 	return nil
 }
 
+// stopLiveChatDownload signals the live chat download workflow to stop downloading chat.
+func stopLiveChatDownload(ctx workflow.Context, input dto.ArchiveVideoInput) error {
+	if input.LiveChatWorkflowId == "" {
+		return nil
+	}
+	return workflow.SignalExternalWorkflow(ctx, input.LiveChatWorkflowId, "", utils.StopLiveChatDownloadSignal, nil).Get(ctx, nil)
+}
+
 // *Low Level Workflow*
 func PostprocessVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) error {
 	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
@@ -685,7 +760,7 @@ func DownloadTwitchLiveChatWorkflow(ctx workflow.Context, input dto.ArchiveVideo
 			MaximumAttempts:    1,
 			MaximumInterval:    15 * time.Minute,
 		},
-		WaitForCancellation: false,
+		WaitForCancellation: true,
 	})
 
 	var signal utils.ArchiveTwitchLiveChatStartSignal
@@ -694,7 +769,8 @@ func DownloadTwitchLiveChatWorkflow(ctx workflow.Context, input dto.ArchiveVideo
 
 	log.Info().Msgf("Received signal: %v", signal)
 
-	err := workflow.ExecuteActivity(ctx, activities.DownloadTwitchLiveChat, input).Get(ctx, nil)
+	// the chat is downloaded until the video download finishes and stops it
+	err := executeStoppableActivity(ctx, utils.StopLiveChatDownloadSignal, activities.DownloadTwitchLiveChat, input)
 	if err != nil {
 		return err
 	}
@@ -810,3 +886,23 @@ func UpdateTwitchLiveStreamArchivesWithVodIds(ctx workflow.Context) error {
 
 	return nil
 }
+
+// *Low Level Workflow*
+func DeleteReplacedLiveArchiveWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Hour,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    3,
+			MaximumInterval:    15 * time.Minute,
+		},
+	})
+
+	err := workflow.ExecuteActivity(ctx, activities.DeleteReplacedLiveArchive, input).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/zibbp/ganymede/internal/activities"
+	"github.com/zibbp/ganymede/internal/dto"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// *Top Level Workflow*
+func ArchiveYoutubeVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) (err error) {
+	defer func() { err = queueItemCancelledHandler(ctx, err, input) }()
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{})
+
+	// create directory
+	err = workflow.ExecuteChildWorkflow(ctx, CreateDirectoryWorkflow, input).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// download thumbnails
+	err = workflow.ExecuteChildWorkflow(ctx, DownloadYoutubeThumbnailsWorkflow, input).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// save video info
+	err = workflow.ExecuteChildWorkflow(ctx, SaveYoutubeVideoInfoWorkflow, input).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// archive video
+	err = workflow.ExecuteChildWorkflow(ctx, DownloadYoutubeVideoWorkflow, input).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = workflow.ExecuteChildWorkflow(ctx, PostprocessVideoWorkflow, input).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = workflow.ExecuteChildWorkflow(ctx, MoveVideoWorkflow, input).Get(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// *Low Level Workflow*
+func DownloadYoutubeThumbnailsWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    5,
+			MaximumInterval:    15 * time.Minute,
+		},
+	})
+
+	err := workflow.ExecuteActivity(ctx, activities.DownloadYoutubeThumbnails, input).Get(ctx, nil)
+	if err != nil {
+		return workflowErrorHandler(err, input, "download-thumbnails")
+	}
+
+	err = checkIfTasksAreDone(input)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// *Low Level Workflow*
+func SaveYoutubeVideoInfoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) error {
+	// yt-dlp metadata extraction is slower than an API call
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    5,
+			MaximumInterval:    15 * time.Minute,
+		},
+	})
+
+	err := workflow.ExecuteActivity(ctx, activities.SaveYoutubeVideoInfo, input).Get(ctx, nil)
+	if err != nil {
+		return workflowErrorHandler(err, input, "save-video-info")
+	}
+
+	err = checkIfTasksAreDone(input)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// *Low Level Workflow*
+func DownloadYoutubeVideoWorkflow(ctx workflow.Context, input dto.ArchiveVideoInput) error {
+	cctx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "video-download",
+		HeartbeatTimeout:    90 * time.Second,
+		StartToCloseTimeout: 168 * time.Hour,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    1 * time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    3,
+			MaximumInterval:    15 * time.Minute,
+		},
+	})
+
+	err := workflow.ExecuteActivity(cctx, activities.DownloadYoutubeVideo, input).Get(ctx, nil)
+	if err != nil {
+		return workflowErrorHandler(err, input, "download-video")
+	}
+
+	err = checkIfTasksAreDone(input)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package youtube
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	osExec "os/exec"
+
+	"github.com/rs/zerolog/log"
+)
+
+type Service struct {
+}
+
+type Video struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	ChannelID   string    `json:"channel_id"`
+	Channel     string    `json:"channel"`
+	UploaderID  string    `json:"uploader_id"`
+	Duration    int       `json:"duration"`
+	ViewCount   int64     `json:"view_count"`
+	UploadDate  string    `json:"upload_date"`
+	Timestamp   int64     `json:"timestamp"`
+	Thumbnail   string    `json:"thumbnail"`
+	WebpageURL  string    `json:"webpage_url"`
+	LiveStatus  string    `json:"live_status"`
+	Chapters    []Chapter `json:"chapters"`
+}
+
+type Chapter struct {
+	Title     string  `json:"title"`
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+}
+
+type Channel struct {
+	ID            string      `json:"channel_id"`
+	Name          string      `json:"channel"`
+	Handle        string      `json:"uploader_id"`
+	URL           string      `json:"channel_url"`
+	Thumbnails    []Thumbnail `json:"thumbnails"`
+	FollowerCount int64       `json:"channel_follower_count"`
+}
+
+type Thumbnail struct {
+	ID     string `json:"id"`
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+func NewService() *Service {
+	return &Service{}
+}
+
+// VideoURL returns the watch URL for a YouTube video ID.
+func VideoURL(id string) string {
+	return fmt.Sprintf("https://www.youtube.com/watch?v=%s", id)
+}
+
+// ChannelURL returns the URL for a YouTube channel. Handles are prefixed with @, channel IDs are used as is.
+func ChannelURL(name string) string {
+	if strings.HasPrefix(name, "UC") && len(name) == 24 {
+		return fmt.Sprintf("https://www.youtube.com/channel/%s", name)
+	}
+	return fmt.Sprintf("https://www.youtube.com/@%s", strings.TrimPrefix(name, "@"))
+}
+
+// GetVideoByID fetches the metadata of a YouTube video using yt-dlp.
+func (s *Service) GetVideoByID(vID string) (Video, error) {
+	log.Debug().Msgf("getting youtube video by id: %s", vID)
+	out, err := ytdlpJson("--dump-json", "--skip-download", "--no-playlist", VideoURL(vID))
+	if err != nil {
+		return Video{}, fmt.Errorf("failed to get video: %v", err)
+	}
+
+	var video Video
+	err = json.Unmarshal(out, &video)
+	if err != nil {
+		return Video{}, fmt.Errorf("failed to unmarshal response: %v", err)
+	}
+
+	if video.ID == "" {
+		return Video{}, fmt.Errorf("video not found")
+	}
+
+	return video, nil
+}
+
+// GetChannel fetches the metadata of a YouTube channel by handle or channel ID using yt-dlp.
+func (s *Service) GetChannel(name string) (Channel, error) {
+	log.Debug().Msgf("getting youtube channel: %s", name)
+	out, err := ytdlpJson("--dump-single-json", "--flat-playlist", "--playlist-items", "0", ChannelURL(name))
+	if err != nil {
+		return Channel{}, fmt.Errorf("failed to get channel: %v", err)
+	}
+
+	var channel Channel
+	err = json.Unmarshal(out, &channel)
+	if err != nil {
+		return Channel{}, fmt.Errorf("failed to unmarshal response: %v", err)
+	}
+
+	if channel.ID == "" {
+		return Channel{}, fmt.Errorf("channel not found")
+	}
+
+	return channel, nil
+}
+
+// channelNamePrefix namespaces the names of YouTube channels in Ganymede.
+// Twitch logins can't contain a hyphen so the names never collide with Twitch channels.
+const channelNamePrefix = "yt-"
+
+// Login returns the channel name in Ganymede, used as the channel folder name, see channelName.
+func (c Channel) Login() string {
+	return channelName(c.Handle, c.ID)
+}
+
+// ProfileImageURL returns the channel avatar, falling back to the last thumbnail.
+func (c Channel) ProfileImageURL() string {
+	for _, thumbnail := range c.Thumbnails {
+		if thumbnail.ID == "avatar_uncropped" {
+			return thumbnail.URL
+		}
+	}
+	if len(c.Thumbnails) > 0 {
+		return c.Thumbnails[len(c.Thumbnails)-1].URL
+	}
+	return ""
+}
+
+// Login returns the channel name of the uploader in Ganymede, see channelName.
+func (v Video) Login() string {
+	return channelName(v.UploaderID, v.ChannelID)
+}
+
+// channelName returns the handle without the @ prefix, or the channel ID for channels without a handle, prefixed with yt-.
+func channelName(handle string, channelID string) string {
+	name := strings.ToLower(strings.TrimPrefix(handle, "@"))
+	if name == "" {
+		name = channelID
+	}
+	return channelNamePrefix + name
+}
+
+// CreatedAt returns the time the video was published, or streamed if it was a livestream.
+func (v Video) CreatedAt() time.Time {
+	if v.Timestamp > 0 {
+		return time.Unix(v.Timestamp, 0).UTC()
+	}
+	t, err := time.Parse("20060102", v.UploadDate)
+	if err != nil {
+		return time.Now()
+	}
+	return t
+}
+
+// Type returns the Ganymede video type. Past livestreams are archives, everything else is an upload.
+func (v Video) Type() string {
+	if v.LiveStatus == "was_live" {
+		return "archive"
+	}
+	return "upload"
+}
+
+func ytdlpJson(args ...string) ([]byte, error) {
+	cmd := osExec.Command("yt-dlp", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		if exitError, ok := err.(*osExec.ExitError); ok {
+			return nil, fmt.Errorf("yt-dlp exited with code %d: %s", exitError.ExitCode(), strings.TrimSpace(string(exitError.Stderr)))
+		}
+		return nil, err
+	}
+	return out, nil
+}